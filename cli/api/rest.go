@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"os"
 	"time"
 
 	. "github.com/netapp/trident/logging"
@@ -14,6 +15,23 @@ import (
 
 const HTTPClientTimeout = time.Second * 300
 
+// BearerTokenEnvVar names the environment variable holding the bearer token if none is set explicitly
+const BearerTokenEnvVar = "TRIDENTCTL_TOKEN"
+
+// BearerToken, if set, authenticates requests to the Trident REST API
+var BearerToken string
+
+// SetAuthorization adds the bearer token, if any, to a request.
+func SetAuthorization(request *http.Request) {
+	token := BearerToken
+	if token == "" {
+		token = os.Getenv(BearerTokenEnvVar)
+	}
+	if token != "" {
+		request.Header.Set("Authorization", "Bearer "+token)
+	}
+}
+
 func InvokeRESTAPI(method, url string, requestBody []byte) (*http.Response, []byte, error) {
 	var request *http.Request
 	var err error
@@ -28,6 +46,7 @@ func InvokeRESTAPI(method, url string, requestBody []byte) (*http.Response, []by
 	}
 
 	request.Header.Set("Content-Type", "application/json")
+	SetAuthorization(request)
 
 	LogHTTPRequest(request, requestBody)
 
@@ -0,0 +1,125 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	persistentstore "github.com/netapp/trident/persistent_store"
+)
+
+var (
+	backupStore     string
+	backupStorePath string
+)
+
+func init() {
+	RootCmd.AddCommand(backupCmd)
+	backupCmd.Flags().StringVar(&backupStore, "store", string(persistentstore.CRDV1Store),
+		"Type of the store to back up (crdv1 or bolt)")
+	backupCmd.Flags().StringVar(&backupStorePath, "store-path", "", "File of a bolt store")
+}
+
+var backupCmd = &cobra.Command{
+	Use:   "backup <file>",
+	Short: "Back up Trident state to an archive",
+	Long: `Back up Trident state to an archive
+
+Storage classes, backends, volumes, snapshots, group snapshots, nodes and
+volume publications are read from the persistent store and written to a
+versioned archive, with a checksum of each file.  Backend credentials are
+not written; each backend instead references the secret holding them, and
+those secrets must exist again, or their values be supplied, to restore it.
+
+The store must have no pending volume transactions, which Trident resolves
+as it runs.  A crdv1 store is reached through the kubeconfig.`,
+	Example: `  # Back up the Trident custom resources
+  tridentctl backup trident-backup.tgz`,
+	Args: cobra.ExactArgs(1),
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		initCmdLogging()
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return backup(args[0])
+	},
+}
+
+func backup(path string) error {
+	storeType := persistentstore.StoreType(backupStore)
+	client, err := persistentstore.NewMigrationClient(ctx(), storeType, migrateStoreLocation(storeType,
+		backupStorePath))
+	if err != nil {
+		return fmt.Errorf("could not open the %s store; %v", storeType, err)
+	}
+	defer func() { _ = client.Stop() }()
+
+	stateBackup, err := persistentstore.NewBackup(ctx(), client)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return fmt.Errorf("could not create backup file; %v", err)
+	}
+	if err = persistentstore.WriteBackup(file, stateBackup); err != nil {
+		_ = file.Close()
+		_ = os.Remove(path)
+		return fmt.Errorf("could not write backup file; %v", err)
+	}
+	if err = file.Close(); err != nil {
+		return fmt.Errorf("could not write backup file; %v", err)
+	}
+
+	WriteBackupManifest(stateBackup)
+	return nil
+}
+
+func WriteBackupManifest(stateBackup *persistentstore.Backup) {
+	switch OutputFormat {
+	case FormatJSON:
+		WriteJSON(stateBackup.Manifest)
+	case FormatYAML:
+		WriteYAML(stateBackup.Manifest)
+	case FormatName:
+		for _, secretRef := range stateBackup.BackendSecrets {
+			if secretRef.Required() {
+				fmt.Println(secretRef.Name)
+			}
+		}
+	default:
+		writeBackupSecretsTable(stateBackup)
+	}
+}
+
+// writeBackupSecretsTable lists the secrets that must exist to restore each backend.
+func writeBackupSecretsTable(stateBackup *persistentstore.Backup) {
+	fmt.Printf("Backed up %d backends and %d volumes from the %s store.\n",
+		len(stateBackup.Backends), len(stateBackup.Volumes), stateBackup.Manifest.Store)
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Backend", "Secret", "Type", "Keys"})
+	rows := 0
+	for _, secretRef := range stateBackup.BackendSecrets {
+		if !secretRef.Required() {
+			continue
+		}
+		table.Append([]string{
+			secretRef.Backend,
+			secretRef.Name,
+			secretRef.Type,
+			strings.Join(secretRef.Keys, ", "),
+		})
+		rows++
+	}
+	if rows > 0 {
+		fmt.Println("These secrets are needed to restore the backends:")
+		table.Render()
+	}
+}
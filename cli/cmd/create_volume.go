@@ -0,0 +1,134 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/spf13/cobra"
+
+	"github.com/netapp/trident/cli/api"
+	"github.com/netapp/trident/config"
+	"github.com/netapp/trident/frontend/rest"
+	"github.com/netapp/trident/storage"
+)
+
+var (
+	createVolumeStorageClass string
+	createVolumeSize         string
+	createVolumeProtocol     string
+	createVolumeAccessMode   string
+	createVolumeVolumeMode   string
+	createVolumeDryRun       bool
+)
+
+func init() {
+	createCmd.AddCommand(createVolumeCmd)
+	createVolumeCmd.Flags().StringVar(&createVolumeStorageClass, "storage-class", "", "Storage class of the volume")
+	createVolumeCmd.Flags().StringVar(&createVolumeSize, "size", "", "Size of the volume, such as 10Gi")
+	createVolumeCmd.Flags().StringVar(&createVolumeProtocol, "protocol", "", "Protocol of the volume (file or block)")
+	createVolumeCmd.Flags().StringVar(&createVolumeAccessMode, "access-mode", string(config.ReadWriteOnce),
+		"Access mode of the volume")
+	createVolumeCmd.Flags().StringVar(&createVolumeVolumeMode, "volume-mode", string(config.Filesystem),
+		"Volume mode of the volume (Filesystem or Block)")
+	createVolumeCmd.Flags().BoolVar(&createVolumeDryRun, "dry-run", false,
+		"Show the storage pools the volume would be created on, and why others would not be used")
+	_ = createVolumeCmd.MarkFlagRequired("storage-class")
+	_ = createVolumeCmd.MarkFlagRequired("size")
+}
+
+var createVolumeCmd = &cobra.Command{
+	Use:   "volume <name>",
+	Short: "Add a volume to Trident",
+	Long: `Add a volume to Trident
+
+With --dry-run, nothing is created; instead every storage pool is listed with
+the checks that accept or reject it, and eligible pools are numbered in the
+order they would be tried.  On Kubernetes, volumes should be created with a
+PVC, so this command is mostly useful there with --dry-run.`,
+	Aliases: []string{"v"},
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if OperatingMode == ModeTunnel {
+			command := []string{
+				"create", "volume", "--storage-class", createVolumeStorageClass, "--size", createVolumeSize,
+				"--access-mode", createVolumeAccessMode, "--volume-mode", createVolumeVolumeMode,
+			}
+			if createVolumeProtocol != "" {
+				command = append(command, "--protocol", createVolumeProtocol)
+			}
+			if createVolumeDryRun {
+				command = append(command, "--dry-run")
+			}
+			out, err := TunnelCommand(append(command, args...))
+			printOutput(cmd, out, err)
+			return err
+		} else {
+			return volumeCreate(args[0])
+		}
+	},
+}
+
+func volumeCreate(volumeName string) error {
+	volumeConfig := &storage.VolumeConfig{
+		Version:      config.OrchestratorAPIVersion,
+		Name:         volumeName,
+		Size:         createVolumeSize,
+		StorageClass: createVolumeStorageClass,
+		Protocol:     config.Protocol(createVolumeProtocol),
+		AccessMode:   config.AccessMode(createVolumeAccessMode),
+		VolumeMode:   config.VolumeMode(createVolumeVolumeMode),
+	}
+	if err := volumeConfig.Validate(); err != nil {
+		return err
+	}
+
+	postData, err := json.Marshal(volumeConfig)
+	if err != nil {
+		return err
+	}
+
+	if createVolumeDryRun {
+		return volumeCreateDryRun(volumeName, postData)
+	}
+
+	response, responseBody, err := api.InvokeRESTAPI("POST", BaseURL()+"/volume", postData)
+	if err != nil {
+		return err
+	} else if response.StatusCode != http.StatusCreated {
+		return fmt.Errorf("could not create volume %s: %v", volumeName,
+			GetErrorFromHTTPResponse(response, responseBody))
+	}
+
+	// Retrieve the newly created volume and write to stdout
+	volume, err := GetVolume(volumeName)
+	if err != nil {
+		return err
+	}
+	WriteVolumes([]storage.VolumeExternal{volume})
+
+	return nil
+}
+
+func volumeCreateDryRun(volumeName string, postData []byte) error {
+	response, responseBody, err := api.InvokeRESTAPI("POST", BaseURL()+"/volume/explain", postData)
+	if err != nil {
+		return err
+	} else if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("could not explain placement of volume %s: %v", volumeName,
+			GetErrorFromHTTPResponse(response, responseBody))
+	}
+
+	var explainResponse rest.ExplainPlacementResponse
+	if err = json.Unmarshal(responseBody, &explainResponse); err != nil {
+		return err
+	}
+	if explainResponse.Placement == nil {
+		return fmt.Errorf("could not explain placement of volume %s: no explanation returned", volumeName)
+	}
+
+	WritePlacementExplanation(explainResponse.Placement)
+	return nil
+}
@@ -5,11 +5,19 @@ package cmd
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
 
 	"github.com/ghodss/yaml"
 	"github.com/spf13/cobra"
+
+	"github.com/netapp/trident/cli/api"
 )
 
+// listPageSize is the number of objects requested at once from list routes
+const listPageSize = 500
+
 func init() {
 	RootCmd.AddCommand(getCmd)
 }
@@ -29,6 +37,35 @@ func WriteJSON(out interface{}) {
 	fmt.Println(string(jsonBytes))
 }
 
+// GetListPages requests each page of a list route in turn, passing each response body to readPage, which
+// returns the token continuing the list, or "" after the last page.
+func GetListPages(listURL, objectType string, readPage func(responseBody []byte) (string, error)) error {
+	pageURL, err := url.Parse(listURL)
+	if err != nil {
+		return err
+	}
+	query := pageURL.Query()
+	query.Set("limit", strconv.Itoa(listPageSize))
+
+	for {
+		pageURL.RawQuery = query.Encode()
+		response, responseBody, err := api.InvokeRESTAPI("GET", pageURL.String(), nil)
+		if err != nil {
+			return err
+		} else if response.StatusCode != http.StatusOK {
+			return fmt.Errorf("could not get %s: %v", objectType, GetErrorFromHTTPResponse(response, responseBody))
+		}
+
+		continueToken, err := readPage(responseBody)
+		if err != nil {
+			return err
+		} else if continueToken == "" {
+			return nil
+		}
+		query.Set("continue", continueToken)
+	}
+}
+
 func WriteYAML(out interface{}) {
 	jsonBytes, _ := json.Marshal(out)
 	yamlBytes, _ := yaml.JSONToYAML(jsonBytes)
@@ -75,21 +75,20 @@ func backendList(backendNames []string) error {
 func GetBackends() ([]string, error) {
 	url := BaseURL() + "/backend"
 
-	response, responseBody, err := api.InvokeRESTAPI("GET", url, nil)
-	if err != nil {
-		return nil, err
-	} else if response.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("could not get backends: %v",
-			GetErrorFromHTTPResponse(response, responseBody))
-	}
-
-	var listBackendsResponse rest.ListBackendsResponse
-	err = json.Unmarshal(responseBody, &listBackendsResponse)
+	backends := make([]string, 0)
+	err := GetListPages(url, "backends", func(responseBody []byte) (string, error) {
+		var listBackendsResponse rest.ListBackendsResponse
+		if err := json.Unmarshal(responseBody, &listBackendsResponse); err != nil {
+			return "", err
+		}
+		backends = append(backends, listBackendsResponse.Backends...)
+		return listBackendsResponse.Continue, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return listBackendsResponse.Backends, nil
+	return backends, nil
 }
 
 func GetBackend(backendName string) (storage.BackendExternal, error) {
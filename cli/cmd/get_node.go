@@ -75,21 +75,20 @@ func nodeList(nodeNames []string) error {
 func GetNodes() ([]string, error) {
 	url := BaseURL() + "/node"
 
-	response, responseBody, err := api.InvokeRESTAPI("GET", url, nil)
-	if err != nil {
-		return nil, err
-	} else if response.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("could not get nodes: %v",
-			GetErrorFromHTTPResponse(response, responseBody))
-	}
-
-	var listNodesResponse rest.ListNodesResponse
-	err = json.Unmarshal(responseBody, &listNodesResponse)
+	nodes := make([]string, 0)
+	err := GetListPages(url, "nodes", func(responseBody []byte) (string, error) {
+		var listNodesResponse rest.ListNodesResponse
+		if err := json.Unmarshal(responseBody, &listNodesResponse); err != nil {
+			return "", err
+		}
+		nodes = append(nodes, listNodesResponse.Nodes...)
+		return listNodesResponse.Continue, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return listNodesResponse.Nodes, nil
+	return nodes, nil
 }
 
 func GetNode(nodeName string) (*utils.NodeExternal, error) {
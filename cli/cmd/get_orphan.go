@@ -0,0 +1,237 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/dustin/go-humanize"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	"github.com/netapp/trident/cli/api"
+	"github.com/netapp/trident/frontend/rest"
+	"github.com/netapp/trident/storage"
+)
+
+var (
+	orphanBackend      string
+	orphanImport       bool
+	orphanDelete       bool
+	orphanStorageClass string
+	orphanVolumes      []string
+	orphanDryRun       bool
+)
+
+func init() {
+	getCmd.AddCommand(getOrphanCmd)
+	getOrphanCmd.Flags().StringVar(&orphanBackend, "backend", "", "Limit query to backend")
+	getOrphanCmd.Flags().BoolVar(&orphanImport, "import", false, "Import the untracked volumes into Trident")
+	getOrphanCmd.Flags().BoolVar(&orphanDelete, "delete", false, "Delete the untracked volumes from their backends")
+	getOrphanCmd.Flags().StringVar(&orphanStorageClass, "storage-class", "",
+		"Storage class of the imported volumes; required with --import")
+	getOrphanCmd.Flags().StringSliceVar(&orphanVolumes, "volume", []string{},
+		"Internal name of an untracked volume to import or delete. Default: all")
+	getOrphanCmd.Flags().BoolVar(&orphanDryRun, "dry-run", true,
+		"Report what --import or --delete would do without changing anything")
+	getOrphanCmd.MarkFlagsMutuallyExclusive("import", "delete")
+}
+
+var getOrphanCmd = &cobra.Command{
+	Use:   "orphan",
+	Short: "Get the volumes and snapshots that differ between Trident and its backends",
+	Long: `Get the volumes and snapshots that differ between Trident and its backends
+
+Untracked volumes exist on a backend but are unknown to Trident.  Missing
+volumes and snapshots are known to Trident but no longer exist on a backend.
+
+With --import or --delete, the untracked volumes are resolved, but only once
+--dry-run=false is also given.  On Kubernetes, imported volumes have no PVC;
+use 'tridentctl import volume' to import a volume for a specific PVC instead.`,
+	Aliases: []string{"orphans"},
+	Args:    cobra.ExactArgs(0),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if OperatingMode == ModeTunnel {
+			command := []string{"get", "orphan"}
+			if orphanBackend != "" {
+				command = append(command, "--backend", orphanBackend)
+			}
+			if orphanImport {
+				command = append(command, "--import")
+			}
+			if orphanDelete {
+				command = append(command, "--delete")
+			}
+			if orphanStorageClass != "" {
+				command = append(command, "--storage-class", orphanStorageClass)
+			}
+			for _, volume := range orphanVolumes {
+				command = append(command, "--volume", volume)
+			}
+			if !orphanDryRun {
+				command = append(command, "--dry-run=false")
+			}
+			out, err := TunnelCommand(command)
+			printOutput(cmd, out, err)
+			return err
+		} else {
+			switch {
+			case orphanImport:
+				return resolveOrphans(storage.OrphanActionImport)
+			case orphanDelete:
+				return resolveOrphans(storage.OrphanActionDelete)
+			default:
+				return orphanReportGet()
+			}
+		}
+	},
+}
+
+func orphanReportGet() error {
+	baseURL := BaseURL() + "/orphan"
+	if orphanBackend != "" {
+		baseURL += "?backend=" + url.QueryEscape(orphanBackend)
+	}
+
+	response, responseBody, err := api.InvokeRESTAPI("GET", baseURL, nil)
+	if err != nil {
+		return err
+	} else if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("could not get orphan report: %v", GetErrorFromHTTPResponse(response, responseBody))
+	}
+
+	var getReportResponse rest.GetOrphanReportResponse
+	if err = json.Unmarshal(responseBody, &getReportResponse); err != nil {
+		return err
+	}
+	if getReportResponse.Report == nil {
+		return fmt.Errorf("could not get orphan report: no report returned")
+	}
+
+	WriteOrphanReport(getReportResponse.Report)
+	return nil
+}
+
+func resolveOrphans(action string) error {
+	request := storage.ResolveOrphansRequest{
+		Backend:      orphanBackend,
+		Action:       action,
+		Volumes:      orphanVolumes,
+		StorageClass: orphanStorageClass,
+		DryRun:       &orphanDryRun,
+	}
+	if err := request.Validate(); err != nil {
+		return err
+	}
+
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	response, responseBody, err := api.InvokeRESTAPI("POST", BaseURL()+"/orphan", requestBytes)
+	if err != nil {
+		return err
+	} else if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("could not resolve orphans: %v", GetErrorFromHTTPResponse(response, responseBody))
+	}
+
+	var resolveResponse rest.ResolveOrphansResponse
+	if err = json.Unmarshal(responseBody, &resolveResponse); err != nil {
+		return err
+	}
+	if resolveResponse.Report == nil {
+		return fmt.Errorf("could not resolve orphans: no report returned")
+	}
+
+	WriteOrphanReport(resolveResponse.Report)
+	return nil
+}
+
+func WriteOrphanReport(report *storage.OrphanReport) {
+	switch OutputFormat {
+	case FormatJSON:
+		WriteJSON(report)
+	case FormatYAML:
+		WriteYAML(report)
+	case FormatName:
+		writeUntrackedVolumeNames(report.UntrackedVolumes)
+	default:
+		writeOrphanTables(report)
+	}
+}
+
+func writeOrphanTables(report *storage.OrphanReport) {
+	if len(report.UntrackedVolumes) == 0 && len(report.MissingVolumes) == 0 && len(report.MissingSnapshots) == 0 {
+		fmt.Println("No orphans found.")
+	}
+
+	if len(report.UntrackedVolumes) > 0 {
+		fmt.Println("Untracked volumes:")
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Internal Name", "Size", "Backend", "Resolution", "Error"})
+		for _, volume := range report.UntrackedVolumes {
+			volumeSize, _ := strconv.ParseUint(volume.Size, 10, 64)
+			table.Append([]string{
+				volume.InternalName,
+				humanize.IBytes(volumeSize),
+				volume.Backend,
+				volume.Resolution,
+				volume.Error,
+			})
+		}
+		table.Render()
+	}
+
+	if len(report.MissingVolumes) > 0 {
+		fmt.Println("Missing volumes:")
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Name", "Internal Name", "Backend", "Reason"})
+		for _, volume := range report.MissingVolumes {
+			table.Append([]string{
+				volume.Name,
+				volume.InternalName,
+				volume.Backend,
+				volume.Reason,
+			})
+		}
+		table.Render()
+	}
+
+	if len(report.MissingSnapshots) > 0 {
+		fmt.Println("Missing snapshots:")
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Name", "Internal Name", "Volume", "Backend"})
+		for _, snapshot := range report.MissingSnapshots {
+			table.Append([]string{
+				snapshot.Name,
+				snapshot.InternalName,
+				snapshot.VolumeName,
+				snapshot.Backend,
+			})
+		}
+		table.Render()
+	}
+
+	backendNames := make([]string, 0, len(report.BackendErrors))
+	for backendName := range report.BackendErrors {
+		backendNames = append(backendNames, backendName)
+	}
+	sort.Strings(backendNames)
+	for _, backendName := range backendNames {
+		fmt.Fprintf(os.Stderr, "Could not read the inventory of backend %s: %s\n", backendName,
+			report.BackendErrors[backendName])
+	}
+}
+
+func writeUntrackedVolumeNames(volumes []*storage.UntrackedVolume) {
+	for _, volume := range volumes {
+		fmt.Println(volume.InternalName)
+	}
+}
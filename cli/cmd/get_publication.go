@@ -110,26 +110,21 @@ func volumePublicationsList(cmd *cobra.Command) error {
 		url = BaseURL() + "/node/" + getNode + "/publication"
 	}
 
-	response, responseBody, err := api.InvokeRESTAPI("GET", url, nil)
-	if err != nil {
-		return err
-	}
-	if response.StatusCode != http.StatusOK {
-		return fmt.Errorf("could not get volume publications: %v",
-			GetErrorFromHTTPResponse(response, responseBody))
-	}
-
-	var listPublicationsResponse rest.VolumePublicationsResponse
-	err = json.Unmarshal(responseBody, &listPublicationsResponse)
+	pubs := make([]utils.VolumePublicationExternal, 0)
+	err := GetListPages(url, "volume publications", func(responseBody []byte) (string, error) {
+		var listPublicationsResponse rest.VolumePublicationsResponse
+		if err := json.Unmarshal(responseBody, &listPublicationsResponse); err != nil {
+			return "", err
+		}
+		for _, pub := range listPublicationsResponse.VolumePublications {
+			pubs = append(pubs, *pub)
+		}
+		return listPublicationsResponse.Continue, nil
+	})
 	if err != nil {
 		return err
 	}
 
-	pubs := make([]utils.VolumePublicationExternal, 0, len(listPublicationsResponse.VolumePublications))
-	for _, pub := range listPublicationsResponse.VolumePublications {
-		pubs = append(pubs, *pub)
-	}
-
 	WriteVolumePublications(pubs)
 
 	return nil
@@ -90,20 +90,20 @@ func GetSnapshots(volume string) ([]string, error) {
 		url = BaseURL() + "/volume/" + volume + "/snapshot"
 	}
 
-	response, responseBody, err := api.InvokeRESTAPI("GET", url, nil)
-	if err != nil {
-		return nil, err
-	} else if response.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("could not get snapshots: %v", GetErrorFromHTTPResponse(response, responseBody))
-	}
-
-	var listSnapshotsResponse rest.ListSnapshotsResponse
-	err = json.Unmarshal(responseBody, &listSnapshotsResponse)
+	snapshots := make([]string, 0)
+	err := GetListPages(url, "snapshots", func(responseBody []byte) (string, error) {
+		var listSnapshotsResponse rest.ListSnapshotsResponse
+		if err := json.Unmarshal(responseBody, &listSnapshotsResponse); err != nil {
+			return "", err
+		}
+		snapshots = append(snapshots, listSnapshotsResponse.Snapshots...)
+		return listSnapshotsResponse.Continue, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return listSnapshotsResponse.Snapshots, nil
+	return snapshots, nil
 }
 
 func GetSnapshot(snapshotID string) (storage.SnapshotExternal, error) {
@@ -7,17 +7,24 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/olekukonko/tablewriter"
 	"github.com/spf13/cobra"
 
 	"github.com/netapp/trident/cli/api"
 	"github.com/netapp/trident/frontend/rest"
+	storageclass "github.com/netapp/trident/storage_class"
 	"github.com/netapp/trident/utils/errors"
 )
 
+var explainStorageClass bool
+
 func init() {
 	getCmd.AddCommand(getStorageClassCmd)
+	getStorageClassCmd.Flags().BoolVar(&explainStorageClass, "explain", false,
+		"Show why each storage pool does or does not satisfy the storage class")
 }
 
 var getStorageClassCmd = &cobra.Command{
@@ -25,17 +32,49 @@ var getStorageClassCmd = &cobra.Command{
 	Short:   "Get one or more storage classes from Trident",
 	Aliases: []string{"sc", "storageclasses"},
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if explainStorageClass && len(args) != 1 {
+			return fmt.Errorf("--explain requires exactly one storage class name")
+		}
+
 		if OperatingMode == ModeTunnel {
 			command := []string{"get", "storageclass"}
+			if explainStorageClass {
+				command = append(command, "--explain")
+			}
 			out, err := TunnelCommand(append(command, args...))
 			printOutput(cmd, out, err)
 			return err
+		} else if explainStorageClass {
+			return storageClassExplain(args[0])
 		} else {
 			return storageClassList(args)
 		}
 	},
 }
 
+func storageClassExplain(storageClassName string) error {
+	url := BaseURL() + "/storageclass/" + storageClassName + "/explain"
+
+	response, responseBody, err := api.InvokeRESTAPI("GET", url, nil)
+	if err != nil {
+		return err
+	} else if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("could not explain storage class %s: %v", storageClassName,
+			GetErrorFromHTTPResponse(response, responseBody))
+	}
+
+	var explainResponse rest.ExplainPlacementResponse
+	if err = json.Unmarshal(responseBody, &explainResponse); err != nil {
+		return err
+	}
+	if explainResponse.Placement == nil {
+		return fmt.Errorf("could not explain storage class %s: no explanation returned", storageClassName)
+	}
+
+	WritePlacementExplanation(explainResponse.Placement)
+	return nil
+}
+
 func storageClassList(storageClassNames []string) error {
 	var err error
 
@@ -72,21 +111,20 @@ func storageClassList(storageClassNames []string) error {
 func GetStorageClasses() ([]string, error) {
 	url := BaseURL() + "/storageclass"
 
-	response, responseBody, err := api.InvokeRESTAPI("GET", url, nil)
-	if err != nil {
-		return nil, err
-	} else if response.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("could not get storage classes: %v",
-			GetErrorFromHTTPResponse(response, responseBody))
-	}
-
-	var listStorageClassesResponse rest.ListStorageClassesResponse
-	err = json.Unmarshal(responseBody, &listStorageClassesResponse)
+	storageClasses := make([]string, 0)
+	err := GetListPages(url, "storage classes", func(responseBody []byte) (string, error) {
+		var listStorageClassesResponse rest.ListStorageClassesResponse
+		if err := json.Unmarshal(responseBody, &listStorageClassesResponse); err != nil {
+			return "", err
+		}
+		storageClasses = append(storageClasses, listStorageClassesResponse.StorageClasses...)
+		return listStorageClassesResponse.Continue, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return listStorageClassesResponse.StorageClasses, nil
+	return storageClasses, nil
 }
 
 func GetStorageClass(storageClassName string) (api.StorageClass, error) {
@@ -146,3 +184,96 @@ func writeStorageClassNames(storageClasses []api.StorageClass) {
 		fmt.Println(sc.Config.Name)
 	}
 }
+
+func WritePlacementExplanation(placement *storageclass.PlacementExplanation) {
+	switch OutputFormat {
+	case FormatJSON:
+		WriteJSON(placement)
+	case FormatYAML:
+		WriteYAML(placement)
+	case FormatName:
+		writeEligiblePoolNames(placement)
+	case FormatWide:
+		writeWidePlacementTable(placement)
+	default:
+		writePlacementTable(placement)
+	}
+}
+
+func writePlacementTable(placement *storageclass.PlacementExplanation) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Backend", "Pool", "Eligible", "Order", "Reasons"})
+
+	for _, pool := range placement.Pools {
+		table.Append([]string{
+			pool.Backend,
+			pool.Pool,
+			strconv.FormatBool(pool.Eligible),
+			placementOrder(pool),
+			formatPoolChecks(pool.FailedChecks()),
+		})
+	}
+
+	table.Render()
+}
+
+func writeWidePlacementTable(placement *storageclass.PlacementExplanation) {
+	table := tablewriter.NewWriter(os.Stdout)
+	header := []string{
+		"Backend",
+		"Backend UUID",
+		"Pool",
+		"Eligible",
+		"Order",
+		"Checks",
+	}
+	table.SetHeader(header)
+
+	for _, pool := range placement.Pools {
+		table.Append([]string{
+			pool.Backend,
+			pool.BackendUUID,
+			pool.Pool,
+			strconv.FormatBool(pool.Eligible),
+			placementOrder(pool),
+			formatPoolChecks(pool.Checks),
+		})
+	}
+
+	table.Render()
+}
+
+func writeEligiblePoolNames(placement *storageclass.PlacementExplanation) {
+	for _, pool := range placement.Pools {
+		if pool.Eligible {
+			fmt.Println(pool.Backend + "/" + pool.Pool)
+		}
+	}
+}
+
+func placementOrder(pool *storageclass.PoolPlacement) string {
+	if pool.Order == 0 {
+		return ""
+	}
+	return strconv.Itoa(pool.Order)
+}
+
+// formatPoolChecks renders placement checks one per line, as "check: result" or "check: reason".
+func formatPoolChecks(checks []storageclass.PoolCheck) string {
+	lines := make([]string, 0, len(checks))
+	for _, check := range checks {
+		name := string(check.Check)
+		if check.Attribute != "" {
+			name += "[" + check.Attribute + "]"
+		}
+		result := check.Reason
+		if result == "" {
+			result = "passed"
+			if !check.Passed {
+				result = "failed"
+			}
+		}
+		lines = append(lines, name+": "+result)
+	}
+	return strings.Join(lines, "\n")
+}
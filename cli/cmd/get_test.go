@@ -0,0 +1,40 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package cmd
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netapp/trident/frontend/rest"
+)
+
+func TestGetVolumes_Paginated(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	pages := map[string]*rest.ListVolumesResponse{
+		"":       {Volumes: []string{"vol1", "vol2"}, Continue: "token1"},
+		"token1": {Volumes: []string{"vol3"}},
+	}
+	httpmock.RegisterResponder("GET", BaseURL()+"/volume", func(request *http.Request) (*http.Response, error) {
+		assert.Equal(t, "500", request.URL.Query().Get("limit"))
+		page, ok := pages[request.URL.Query().Get("continue")]
+		if !ok {
+			return httpmock.NewStringResponse(http.StatusBadRequest, `{"error":"invalid continue token"}`), nil
+		}
+		return httpmock.NewJsonResponse(http.StatusOK, page)
+	})
+
+	volumes, err := GetVolumes()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"vol1", "vol2", "vol3"}, volumes)
+	assert.Equal(t, 2, httpmock.GetTotalCallCount())
+
+	pages[""].Continue = "expired"
+	_, err = GetVolumes()
+	assert.ErrorContains(t, err, "invalid continue token")
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/netapp/trident/cli/api"
+	"github.com/netapp/trident/core"
 	"github.com/netapp/trident/frontend/rest"
 	"github.com/netapp/trident/storage"
 	"github.com/netapp/trident/utils/errors"
@@ -22,6 +23,7 @@ import (
 var (
 	getSourceVolume      string
 	getSubordinateVolume string
+	getVolumeWatch       bool
 	backendsByUUID       map[string]*storage.BackendExternal
 )
 
@@ -32,7 +34,11 @@ func init() {
 	getVolumeCmd.Flags().StringVar(&getSourceVolume, "subordinateOf", "", "Limit query to subordinates of volume")
 	getVolumeCmd.Flags().StringVar(&getSubordinateVolume, "parentOfSubordinate", "",
 		"Limit query to subordinate source volume")
+	getVolumeCmd.Flags().BoolVarP(&getVolumeWatch, "watch", "w", false,
+		"After listing the volumes, watch for changes to them")
 	getVolumeCmd.MarkFlagsMutuallyExclusive("subordinateOf", "parentOfSubordinate")
+	getVolumeCmd.MarkFlagsMutuallyExclusive("watch", "subordinateOf")
+	getVolumeCmd.MarkFlagsMutuallyExclusive("watch", "parentOfSubordinate")
 	backendsByUUID = make(map[string]*storage.BackendExternal)
 }
 
@@ -49,9 +55,14 @@ var getVolumeCmd = &cobra.Command{
 			if getSubordinateVolume != "" {
 				command = append(command, "--parentOfSubordinate", getSubordinateVolume)
 			}
+			if getVolumeWatch {
+				return TunnelCommandStream(append(append(command, "--watch"), args...))
+			}
 			out, err := TunnelCommand(append(command, args...))
 			printOutput(cmd, out, err)
 			return err
+		} else if getVolumeWatch {
+			return volumeWatch(args)
 		} else {
 			return volumeList(args)
 		}
@@ -103,6 +114,60 @@ func volumeList(volumeNames []string) error {
 	return nil
 }
 
+// volumeWatch lists the volumes, then writes each change to them until interrupted.
+func volumeWatch(volumeNames []string) error {
+	watched := make(map[string]bool)
+	for _, volumeName := range volumeNames {
+		watched[volumeName] = true
+	}
+
+	return WatchEvents([]core.EventKind{core.EventKindVolume},
+		func() error {
+			return volumeList(volumeNames)
+		},
+		func(event *rest.WatchEventResponse) error {
+			if len(watched) > 0 && !watched[event.Name] {
+				return nil
+			}
+			return writeVolumeEvent(event)
+		})
+}
+
+func writeVolumeEvent(event *rest.WatchEventResponse) error {
+	if OutputFormat == FormatJSON || OutputFormat == FormatYAML || OutputFormat == FormatName {
+		WriteEvent(event)
+		return nil
+	}
+
+	if event.Type == core.EventDeleted {
+		fmt.Printf("Volume %s deleted.\n", event.Name)
+		return nil
+	}
+
+	var volume storage.VolumeExternal
+	if err := json.Unmarshal(event.Object, &volume); err != nil {
+		return fmt.Errorf("could not parse volume %s; %v", event.Name, err)
+	}
+	if volume.Config == nil {
+		return fmt.Errorf("could not parse volume %s; no volume returned", event.Name)
+	}
+	if volume.State == storage.VolumeStateOnline {
+		volume.State = maskDisplayOfVolumeStateOnline
+	}
+
+	if OutputFormat == FormatWide && backendsByUUID[volume.BackendUUID] == nil {
+		backend, err := GetBackendByBackendUUID(volume.BackendUUID)
+		if err != nil {
+			return err
+		}
+		backendsByUUID[volume.BackendUUID] = &backend
+	}
+
+	fmt.Printf("Volume %s %s.\n", event.Name, event.Type)
+	WriteVolumes([]storage.VolumeExternal{volume})
+	return nil
+}
+
 func GetVolumes() ([]string, error) {
 	url := BaseURL() + "/volume"
 	if getSourceVolume != "" {
@@ -112,21 +177,20 @@ func GetVolumes() ([]string, error) {
 		url += "?parentOfSubordinate=" + getSubordinateVolume
 	}
 
-	response, responseBody, err := api.InvokeRESTAPI("GET", url, nil)
-	if err != nil {
-		return nil, err
-	} else if response.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("could not get volumes: %v",
-			GetErrorFromHTTPResponse(response, responseBody))
-	}
-
-	var listVolumesResponse rest.ListVolumesResponse
-	err = json.Unmarshal(responseBody, &listVolumesResponse)
+	volumes := make([]string, 0)
+	err := GetListPages(url, "volumes", func(responseBody []byte) (string, error) {
+		var listVolumesResponse rest.ListVolumesResponse
+		if err := json.Unmarshal(responseBody, &listVolumesResponse); err != nil {
+			return "", err
+		}
+		volumes = append(volumes, listVolumesResponse.Volumes...)
+		return listVolumesResponse.Continue, nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return listVolumesResponse.Volumes, nil
+	return volumes, nil
 }
 
 func GetVolume(volumeName string) (storage.VolumeExternal, error) {
@@ -5,6 +5,7 @@ package cmd
 import (
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"os"
 	"path"
 	"regexp"
@@ -37,12 +38,16 @@ const (
 	// CRD names
 	ActionMirrorUpdateCRDName    = "tridentactionmirrorupdates.trident.netapp.io"
 	ActionSnapshotRestoreCRDName = "tridentactionsnapshotrestores.trident.netapp.io"
+	ActionVolumeMoveCRDName      = "tridentactionvolumemoves.trident.netapp.io"
 	BackendConfigCRDName         = "tridentbackendconfigs.trident.netapp.io"
 	BackendCRDName               = "tridentbackends.trident.netapp.io"
+	GroupSnapshotCRDName         = "tridentgroupsnapshots.trident.netapp.io"
 	MirrorRelationshipCRDName    = "tridentmirrorrelationships.trident.netapp.io"
 	NodeCRDName                  = "tridentnodes.trident.netapp.io"
 	SnapshotCRDName              = "tridentsnapshots.trident.netapp.io"
 	SnapshotInfoCRDName          = "tridentsnapshotinfos.trident.netapp.io"
+	SnapshotPolicyCRDName        = "tridentsnapshotpolicies.trident.netapp.io"
+	SnapshotPolicyStateCRDName   = "tridentsnapshotpolicystates.trident.netapp.io"
 	StorageClassCRDName          = "tridentstorageclasses.trident.netapp.io"
 	TransactionCRDName           = "tridenttransactions.trident.netapp.io"
 	VersionCRDName               = "tridentversions.trident.netapp.io"
@@ -127,6 +132,8 @@ var (
 	iscsiSelfHealingInterval time.Duration
 	iscsiSelfHealingWaitTime time.Duration
 	k8sAPIQPS                int
+	tracingEndpoint          string
+	tracingSampleRatio       float64
 
 	// CLI-based K8S client
 	client k8sclient.KubernetesClient
@@ -176,13 +183,17 @@ var (
 		NodeCRDName,
 		VolumeReferenceCRDName,
 		SnapshotCRDName,
+		GroupSnapshotCRDName,
 		SnapshotInfoCRDName,
+		SnapshotPolicyCRDName,
+		SnapshotPolicyStateCRDName,
 		StorageClassCRDName,
 		TransactionCRDName,
 		VersionCRDName,
 		VolumeCRDName,
 		VolumePublicationCRDName,
 		ActionSnapshotRestoreCRDName,
+		ActionVolumeMoveCRDName,
 		ConfiguratorCRDName,
 	}
 )
@@ -253,6 +264,11 @@ func init() {
 	installCmd.Flags().IntVar(&k8sAPIQPS, "k8s-api-qps", 0, "The QPS used by the controller while talking "+
 		"with the Kubernetes API server. The Burst value is automatically set as a function of the QPS value.")
 
+	installCmd.Flags().StringVar(&tracingEndpoint, "tracing-endpoint", "", "URL of an OTLP/gRPC collector "+
+		"to which Trident exports traces; collectors at http:// URLs are reached without TLS.")
+	installCmd.Flags().Float64Var(&tracingSampleRatio, "tracing-sample-ratio", 1,
+		"The fraction of traces started by Trident that are exported.")
+
 	if err := installCmd.Flags().MarkHidden("skip-k8s-version-check"); err != nil {
 		_, _ = fmt.Fprintln(os.Stderr, err)
 	}
@@ -487,6 +503,17 @@ func validateInstallationArguments() error {
 		return fmt.Errorf("'%s' is not a valid cloud identity for the cloud provider '%s'", cloudIdentity, k8sclient.CloudProviderGCP)
 	}
 
+	if tracingEndpoint != "" {
+		endpoint, err := url.Parse(tracingEndpoint)
+		if err != nil || (endpoint.Scheme != "http" && endpoint.Scheme != "https") {
+			return fmt.Errorf("'%s' is not a valid tracing endpoint; an http:// or https:// URL is required",
+				tracingEndpoint)
+		}
+	}
+	if tracingSampleRatio < 0 || tracingSampleRatio > 1 {
+		return fmt.Errorf("tracing sample ratio %v is not between 0 and 1", tracingSampleRatio)
+	}
+
 	return nil
 }
 
@@ -681,6 +708,8 @@ func prepareYAMLFiles() error {
 		CloudProvider:           cloudProvider,
 		IdentityLabel:           identityLabel,
 		K8sAPIQPS:               k8sAPIQPS,
+		TracingEndpoint:         tracingEndpoint,
+		TracingSampleRatio:      tracingSampleRatio,
 	}
 	deploymentYAML := k8sclient.GetCSIDeploymentYAML(deploymentArgs)
 	if err = writeFile(deploymentPath, deploymentYAML); err != nil {
@@ -709,6 +738,8 @@ func prepareYAMLFiles() error {
 		ImagePullPolicy:          imagePullPolicy,
 		ISCSISelfHealingInterval: iscsiSelfHealingInterval.String(),
 		ISCSISelfHealingWaitTime: iscsiSelfHealingWaitTime.String(),
+		TracingEndpoint:          tracingEndpoint,
+		TracingSampleRatio:       tracingSampleRatio,
 	}
 	daemonSetYAML := k8sclient.GetCSIDaemonSetYAMLLinux(daemonArgs)
 	if err = writeFile(daemonsetPath, daemonSetYAML); err != nil {
@@ -1134,6 +1165,8 @@ func installTrident() (returnError error) {
 			CloudProvider:           cloudProvider,
 			IdentityLabel:           identityLabel,
 			K8sAPIQPS:               k8sAPIQPS,
+			TracingEndpoint:         tracingEndpoint,
+			TracingSampleRatio:      tracingSampleRatio,
 		}
 		returnError = client.CreateObjectByYAML(
 			k8sclient.GetCSIDeploymentYAML(deploymentArgs))
@@ -1236,6 +1269,8 @@ func installTrident() (returnError error) {
 			ImagePullPolicy:          imagePullPolicy,
 			ISCSISelfHealingInterval: iscsiSelfHealingInterval.String(),
 			ISCSISelfHealingWaitTime: iscsiSelfHealingWaitTime.String(),
+			TracingEndpoint:          tracingEndpoint,
+			TracingSampleRatio:       tracingSampleRatio,
 		}
 		returnError = client.CreateObjectByYAML(
 			k8sclient.GetCSIDaemonSetYAMLLinux(daemonSetArgs))
@@ -270,3 +270,34 @@ func TestValidateInstallationArguments(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateInstallationArguments_Tracing(t *testing.T) {
+	defer func(endpoint string, ratio float64) {
+		tracingEndpoint, tracingSampleRatio = endpoint, ratio
+	}(tracingEndpoint, tracingSampleRatio)
+
+	TridentPodNamespace, logFormat, imagePullPolicy, cloudProvider, cloudIdentity = "trident", "text", "IfNotPresent", "", ""
+
+	tests := []struct {
+		endpoint    string
+		sampleRatio float64
+		valid       bool
+	}{
+		{"", 1, true},
+		{"http://otel-collector.monitoring:4317", 1, true},
+		{"https://otel-collector.monitoring:4317", 0.1, true},
+		{"otel-collector.monitoring:4317", 1, false},
+		{"http://otel-collector.monitoring:4317", 1.5, false},
+		{"http://otel-collector.monitoring:4317", -1, false},
+	}
+
+	for _, test := range tests {
+		tracingEndpoint, tracingSampleRatio = test.endpoint, test.sampleRatio
+		err := validateInstallationArguments()
+		if test.valid {
+			assert.NoError(t, err, "should be valid")
+		} else {
+			assert.Error(t, err, "should be invalid")
+		}
+	}
+}
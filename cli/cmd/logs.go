@@ -36,10 +36,12 @@ const (
 	logNameTridentBackendConfig         = "tridentBackendConfig"
 	logNameTridentActionMirrorUpdate    = "tridentActionMirrorUpdate"
 	logNameTridentActionSnapshotRestore = "tridentActionSnapshotRestore"
+	logNameTridentActionVolumeMove      = "tridentActionVolumeMove"
 	logNameTridentBackend               = "tridentBackend"
 	logNameTridentMirrorRelationship    = "tridentMirrorRelationship"
 	logNameTridentNode                  = "tridentNode"
 	logNameTridentSnapshotInfo          = "tridentSnapshotInfo"
+	logNameTridentSnapshotPolicy        = "tridentSnapshotPolicy"
 	logNameTridentSnapshot              = "tridentSnapshot"
 	logNameTridentStorageClass          = "tridentStorageClass"
 	logNameTridentTransaction           = "tridentTransaction"
@@ -547,6 +549,10 @@ func getAllTridentResources() {
 		logErrors = appendErrorf(logErrors, "error retrieving TridentActionSnapshotRestore logs : %v", err)
 	}
 
+	if err := getAllTridentActionVolumeMoves(logNameTridentActionVolumeMove); err != nil {
+		logErrors = appendErrorf(logErrors, "error retrieving TridentActionVolumeMove logs : %v", err)
+	}
+
 	if err := getAllTridentBackends(logNameTridentBackend); err != nil {
 		logErrors = appendErrorf(logErrors, "error retrieving TridentBackend logs : %v", err)
 	}
@@ -563,6 +569,10 @@ func getAllTridentResources() {
 		logErrors = appendErrorf(logErrors, "error retrieving TridentSnapshotInfo logs : %v", err)
 	}
 
+	if err := getAllTridentSnapshotPolicies(logNameTridentSnapshotPolicy); err != nil {
+		logErrors = appendErrorf(logErrors, "error retrieving TridentSnapshotPolicy logs : %v", err)
+	}
+
 	if err := getAllTridentSnapshots(logNameTridentSnapshot); err != nil {
 		logErrors = appendErrorf(logErrors, "error retrieving TridentSnapshot logs : %v", err)
 	}
@@ -716,6 +726,40 @@ func getAllTridentActionSnapshotRestores(logName string) error {
 	return nil
 }
 
+func getAllTridentActionVolumeMoves(logName string) error {
+	tavms, err := crdClientset.TridentV1().TridentActionVolumeMoves(allNamespaces).List(ctx(), listOpts)
+	if err != nil {
+		return fmt.Errorf("error listing TridentActionVolumeMove; %v", err)
+	}
+
+	if len(tavms.Items) == 0 {
+		fmt.Println("resources of type 'TridentActionVolumeMove' not present")
+		return nil
+	}
+
+	for _, tavm := range tavms.Items {
+		getCommand := []string{"get", "tridentactionvolumemove", tavm.Name, "-o", "yaml", "-n", tavm.Namespace}
+		getCommandFileName := logName + "/" + "get-" + tavm.Name
+
+		describeCommand := []string{"describe", "tridentactionvolumemove", tavm.Name, "-n", tavm.Namespace}
+		describeCommandFileName := logName + "/" + "describe-" + tavm.Name
+
+		commands := map[string][]string{getCommandFileName: getCommand, describeCommandFileName: describeCommand}
+		for fileName, logsCommand := range commands {
+			logBytes, err := execKubernetesCLI(logsCommand...)
+			if err != nil {
+				logErrors = appendError(logErrors, logBytes)
+			} else {
+				if err = writeLogs(fileName, logBytes); err != nil {
+					logErrors = appendErrorf(logErrors, "could not write log %s; %v", logName, err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 func getAllTridentBackends(logName string) error {
 	tbes, err := crdClientset.TridentV1().TridentBackends(allNamespaces).List(ctx(), listOpts)
 	if err != nil {
@@ -852,6 +896,40 @@ func getAllTridentSnapshotInfos(logName string) error {
 	return nil
 }
 
+func getAllTridentSnapshotPolicies(logName string) error {
+	tsps, err := crdClientset.TridentV1().TridentSnapshotPolicies(allNamespaces).List(ctx(), listOpts)
+	if err != nil {
+		return fmt.Errorf("error listing TridentSnapshotPolicy; %v", err)
+	}
+
+	if len(tsps.Items) == 0 {
+		fmt.Println("resources of type 'TridentSnapshotPolicy' not present")
+		return nil
+	}
+
+	for _, tsp := range tsps.Items {
+		getCommand := []string{"get", "tridentsnapshotpolicy", tsp.Name, "-o", "yaml", "-n", tsp.Namespace}
+		getCommandFileName := logName + "/" + "get-" + tsp.Name
+
+		describeCommand := []string{"describe", "tridentsnapshotpolicy", tsp.Name, "-n", tsp.Namespace}
+		describeCommandFileName := logName + "/" + "describe-" + tsp.Name
+
+		commands := map[string][]string{getCommandFileName: getCommand, describeCommandFileName: describeCommand}
+		for fileName, logsCommand := range commands {
+			logBytes, err := execKubernetesCLI(logsCommand...)
+			if err != nil {
+				logErrors = appendError(logErrors, logBytes)
+			} else {
+				if err = writeLogs(fileName, logBytes); err != nil {
+					logErrors = appendErrorf(logErrors, "could not write log %s; %v", logName, err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 func getAllTridentSnapshots(logName string) error {
 	tsnaps, err := crdClientset.TridentV1().TridentSnapshots(allNamespaces).List(ctx(), listOpts)
 	if err != nil {
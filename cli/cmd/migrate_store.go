@@ -0,0 +1,139 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	"github.com/netapp/trident/config"
+	persistentstore "github.com/netapp/trident/persistent_store"
+)
+
+var (
+	migrateFrom     string
+	migrateTo       string
+	migrateFromPath string
+	migrateToPath   string
+	migrateDryRun   bool
+)
+
+func init() {
+	migrateCmd.AddCommand(migrateStoreCmd)
+	migrateStoreCmd.Flags().StringVar(&migrateFrom, "from", "", "Type of the source store (passthrough, crdv1 or bolt)")
+	migrateStoreCmd.Flags().StringVar(&migrateTo, "to", "", "Type of the destination store (crdv1 or bolt)")
+	migrateStoreCmd.Flags().StringVar(&migrateFromPath, "from-path", "",
+		"Backend config file or directory of a passthrough source store, or file of a bolt source store")
+	migrateStoreCmd.Flags().StringVar(&migrateToPath, "to-path", "", "File of a bolt destination store")
+	migrateStoreCmd.Flags().BoolVar(&migrateDryRun, "dry-run", false,
+		"Report what would be migrated without changing the destination store")
+	_ = migrateStoreCmd.MarkFlagRequired("from")
+	_ = migrateStoreCmd.MarkFlagRequired("to")
+}
+
+var migrateStoreCmd = &cobra.Command{
+	Use:   "store",
+	Short: "Copy Trident state from one persistent store to another",
+	Long: `Copy Trident state from one persistent store to another
+
+Storage classes, backends, volumes, snapshots, group snapshots, nodes and
+volume publications are copied, then read back from the destination store to
+verify them.  Objects already in the destination store are never overwritten;
+any that differ from the source are reported as conflicts.
+
+This command works on the stores directly, so Trident should be stopped on
+both while it runs.  A crdv1 store is reached through the kubeconfig, and
+the volumes of a passthrough store are read from its backends.`,
+	Example: `  # Move a Docker installation onto Kubernetes
+  tridentctl migrate store --from passthrough --from-path /etc/netappdvp --to crdv1 --dry-run`,
+	Args: cobra.ExactArgs(0),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return migrateStore()
+	},
+}
+
+func migrateStore() error {
+	fromType := persistentstore.StoreType(migrateFrom)
+	toType := persistentstore.StoreType(migrateTo)
+
+	if fromType == toType && migrateFromPath == migrateToPath {
+		return fmt.Errorf("the source and destination stores must differ")
+	}
+
+	// Passthrough stores are only used by Docker, so read their volumes as Docker would
+	if fromType == persistentstore.PassthroughStore {
+		config.CurrentDriverContext = config.ContextDocker
+	}
+
+	source, err := persistentstore.NewMigrationClient(ctx(), fromType, migrateStoreLocation(fromType,
+		migrateFromPath))
+	if err != nil {
+		return fmt.Errorf("could not open the source store; %v", err)
+	}
+	defer func() { _ = source.Stop() }()
+
+	dest, err := persistentstore.NewMigrationClient(ctx(), toType, migrateStoreLocation(toType, migrateToPath))
+	if err != nil {
+		return fmt.Errorf("could not open the destination store; %v", err)
+	}
+	defer func() { _ = dest.Stop() }()
+
+	report, err := persistentstore.NewDataMigrator(source, dest, migrateDryRun).Run(ctx())
+	if report != nil {
+		WriteMigrationReport(report)
+	}
+	if err != nil {
+		return err
+	}
+	if !report.Succeeded() {
+		return fmt.Errorf("some objects could not be migrated")
+	}
+	return nil
+}
+
+// migrateStoreLocation returns the location of a store, which for CRD stores is the kubeconfig.
+func migrateStoreLocation(storeType persistentstore.StoreType, path string) string {
+	if storeType == persistentstore.CRDV1Store {
+		return KubeConfigPath
+	}
+	return path
+}
+
+func WriteMigrationReport(report *persistentstore.MigrationReport) {
+	switch OutputFormat {
+	case FormatJSON:
+		WriteJSON(report)
+	case FormatYAML:
+		WriteYAML(report)
+	case FormatName:
+		for _, object := range report.Objects {
+			fmt.Printf("%s/%s\n", object.Kind, object.Name)
+		}
+	default:
+		writeMigrationTable(report)
+	}
+}
+
+func writeMigrationTable(report *persistentstore.MigrationReport) {
+	if len(report.Objects) == 0 {
+		fmt.Printf("No objects found in the %s store.\n", report.Source)
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Kind", "Name", "Result", "Differences", "Error"})
+	for _, object := range report.Objects {
+		table.Append([]string{
+			object.Kind,
+			object.Name,
+			string(object.Result),
+			strings.Join(object.Diff, ", "),
+			object.Error,
+		})
+	}
+	table.Render()
+}
@@ -0,0 +1,19 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package cmd
+
+import "github.com/spf13/cobra"
+
+func init() {
+	RootCmd.AddCommand(moveCmd)
+}
+
+var moveCmd = &cobra.Command{
+	Use:   "move",
+	Short: "Move a resource in Trident",
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		initCmdLogging()
+		err := discoverOperatingMode(cmd)
+		return err
+	},
+}
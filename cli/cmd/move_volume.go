@@ -0,0 +1,106 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/netapp/trident/cli/api"
+	"github.com/netapp/trident/frontend/rest"
+	"github.com/netapp/trident/storage"
+)
+
+const moveVolumePollInterval = 10 * time.Second
+
+var (
+	moveBackend string
+	movePool    string
+	moveWait    bool
+)
+
+func init() {
+	moveCmd.AddCommand(moveVolumeCmd)
+	moveVolumeCmd.Flags().StringVarP(&moveBackend, "backend", "", "",
+		"Name of the backend to move the volume to. Default: the volume's current backend")
+	moveVolumeCmd.Flags().StringVarP(&movePool, "pool", "", "", "Name of the storage pool to move the volume to")
+	moveVolumeCmd.Flags().BoolVarP(&moveWait, "wait", "", false, "Wait for the move to complete")
+	_ = moveVolumeCmd.MarkFlagRequired("pool")
+}
+
+var moveVolumeCmd = &cobra.Command{
+	Use:   "volume <name>",
+	Short: "Move a volume to another storage pool",
+	Long: `Move a volume to another storage pool
+
+The volume may be moved to another pool of its backend, or to a pool of a
+different backend of the same type.  Moves between backends replicate the
+volume and complete only once the volume is no longer in use.  A move may
+take a long time; repeat the command, or use --wait, to follow its progress.`,
+	Aliases: []string{"v"},
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if OperatingMode == ModeTunnel {
+			command := []string{"move", "volume", "--pool", movePool}
+			if moveBackend != "" {
+				command = append(command, "--backend", moveBackend)
+			}
+			if moveWait {
+				command = append(command, "--wait")
+			}
+			out, err := TunnelCommand(append(command, args...))
+			printOutput(cmd, out, err)
+			return err
+		} else {
+			return moveVolume(args[0], moveBackend, movePool, moveWait)
+		}
+	},
+}
+
+func moveVolume(volumeName, backendName, poolName string, wait bool) error {
+	url := BaseURL() + "/volume/" + volumeName + "/move"
+
+	request := storage.MoveVolumeRequest{
+		Backend: backendName,
+		Pool:    poolName,
+	}
+
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	for {
+		response, responseBody, err := api.InvokeRESTAPI("POST", url, requestBytes)
+		if err != nil {
+			return err
+		} else if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusAccepted {
+			return fmt.Errorf("failed to move volume %s, error: %v", volumeName,
+				GetErrorFromHTTPResponse(response, responseBody))
+		}
+
+		var moveVolResponse rest.MoveVolumeResponse
+		if err = json.Unmarshal(responseBody, &moveVolResponse); err != nil {
+			return err
+		}
+
+		if response.StatusCode == http.StatusOK || !wait {
+			if response.StatusCode == http.StatusAccepted {
+				fmt.Fprintln(os.Stderr, moveVolResponse.Status)
+			}
+			volumes := make([]storage.VolumeExternal, 0, 1)
+			if moveVolResponse.Volume != nil {
+				volumes = append(volumes, *moveVolResponse.Volume)
+			}
+			WriteVolumes(volumes)
+			return nil
+		}
+
+		time.Sleep(moveVolumePollInterval)
+	}
+}
@@ -143,6 +143,10 @@ func deleteCRs() error {
 		return err
 	}
 
+	if err := deleteGroupSnapshots(); err != nil {
+		return err
+	}
+
 	if err := deleteVolumePublications(); err != nil {
 		return err
 	}
@@ -155,6 +159,18 @@ func deleteCRs() error {
 		return err
 	}
 
+	if err := deleteActionVolumeMoves(); err != nil {
+		return err
+	}
+
+	if err := deleteSnapshotPolicies(); err != nil {
+		return err
+	}
+
+	if err := deleteSnapshotPolicyStates(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -841,6 +857,64 @@ func deleteSnapshots() error {
 	return nil
 }
 
+func deleteGroupSnapshots() error {
+	crd := "tridentgroupsnapshots.trident.netapp.io"
+	logFields := LogFields{"CRD": crd}
+
+	// See if CRD exists
+	exists, err := k8sClient.CheckCRDExists(crd)
+	if err != nil {
+		return err
+	} else if !exists {
+		Log().WithField("CRD", crd).Debug("CRD not present.")
+		return nil
+	}
+
+	groupSnapshots, err := crdClientset.TridentV1().TridentGroupSnapshots(allNamespaces).List(ctx(), listOpts)
+	if err != nil {
+		return err
+	} else if len(groupSnapshots.Items) == 0 {
+		Log().WithFields(logFields).Info("Resources not present.")
+		return nil
+	}
+
+	for _, groupSnapshot := range groupSnapshots.Items {
+		if groupSnapshot.DeletionTimestamp.IsZero() {
+			_ = crdClientset.TridentV1().TridentGroupSnapshots(groupSnapshot.Namespace).Delete(ctx(), groupSnapshot.Name,
+				deleteOpts)
+		}
+	}
+
+	groupSnapshots, err = crdClientset.TridentV1().TridentGroupSnapshots(allNamespaces).List(ctx(), listOpts)
+	if err != nil {
+		return err
+	}
+
+	for _, groupSnapshot := range groupSnapshots.Items {
+		if groupSnapshot.HasTridentFinalizers() {
+			crCopy := groupSnapshot.DeepCopy()
+			crCopy.RemoveTridentFinalizers()
+			_, err := crdClientset.TridentV1().TridentGroupSnapshots(groupSnapshot.Namespace).Update(ctx(), crCopy,
+				updateOpts)
+			if isNotFoundError(err) {
+				continue
+			} else if err != nil {
+				Log().Errorf("Problem removing finalizers: %v", err)
+				return err
+			}
+		}
+
+		deleteFunc := crdClientset.TridentV1().TridentGroupSnapshots(groupSnapshot.Namespace).Delete
+		if err := deleteWithRetry(deleteFunc, ctx(), groupSnapshot.Name, nil); err != nil {
+			Log().Errorf("Problem deleting resource: %v", err)
+			return err
+		}
+	}
+
+	Log().WithFields(logFields).Info("Resources deleted.")
+	return nil
+}
+
 func deleteVolumeReferences() error {
 	crd := "tridentvolumereferences.trident.netapp.io"
 	logFields := LogFields{"CRD": crd}
@@ -955,6 +1029,180 @@ func deleteActionSnapshotRestores() error {
 	return nil
 }
 
+func deleteActionVolumeMoves() error {
+	crd := "tridentactionvolumemoves.trident.netapp.io"
+	logFields := LogFields{"CRD": crd}
+
+	// See if CRD exists
+	exists, err := k8sClient.CheckCRDExists(crd)
+	if err != nil {
+		return err
+	} else if !exists {
+		Log().WithField("CRD", crd).Debug("CRD not present.")
+		return nil
+	}
+
+	vrefs, err := crdClientset.TridentV1().TridentActionVolumeMoves(allNamespaces).List(ctx(), listOpts)
+	if err != nil {
+		return err
+	} else if len(vrefs.Items) == 0 {
+		Log().WithFields(logFields).Info("Resources not present.")
+		return nil
+	}
+
+	for _, vref := range vrefs.Items {
+		if vref.DeletionTimestamp.IsZero() {
+			_ = crdClientset.TridentV1().TridentActionVolumeMoves(vref.Namespace).Delete(ctx(),
+				vref.Name, deleteOpts)
+		}
+	}
+
+	vrefs, err = crdClientset.TridentV1().TridentActionVolumeMoves(allNamespaces).List(ctx(), listOpts)
+	if err != nil {
+		return err
+	}
+
+	for _, vref := range vrefs.Items {
+		if vref.HasTridentFinalizers() {
+			crCopy := vref.DeepCopy()
+			crCopy.RemoveTridentFinalizers()
+			_, err := crdClientset.TridentV1().TridentActionVolumeMoves(vref.Namespace).Update(ctx(),
+				crCopy, updateOpts)
+			if isNotFoundError(err) {
+				continue
+			} else if err != nil {
+				Log().Errorf("Problem removing finalizers: %v", err)
+				return err
+			}
+		}
+
+		deleteFunc := crdClientset.TridentV1().TridentActionVolumeMoves(vref.Namespace).Delete
+		if err = deleteWithRetry(deleteFunc, ctx(), vref.Name, nil); err != nil {
+			Log().Errorf("Problem deleting resource: %v", err)
+			return err
+		}
+	}
+
+	Log().WithFields(logFields).Info("Resources deleted.")
+	return nil
+}
+
+func deleteSnapshotPolicies() error {
+	crd := "tridentsnapshotpolicies.trident.netapp.io"
+	logFields := LogFields{"CRD": crd}
+
+	// See if CRD exists
+	exists, err := k8sClient.CheckCRDExists(crd)
+	if err != nil {
+		return err
+	} else if !exists {
+		Log().WithField("CRD", crd).Debug("CRD not present.")
+		return nil
+	}
+
+	policies, err := crdClientset.TridentV1().TridentSnapshotPolicies(allNamespaces).List(ctx(), listOpts)
+	if err != nil {
+		return err
+	} else if len(policies.Items) == 0 {
+		Log().WithFields(logFields).Info("Resources not present.")
+		return nil
+	}
+
+	for _, policy := range policies.Items {
+		if policy.DeletionTimestamp.IsZero() {
+			_ = crdClientset.TridentV1().TridentSnapshotPolicies(policy.Namespace).Delete(ctx(),
+				policy.Name, deleteOpts)
+		}
+	}
+
+	policies, err = crdClientset.TridentV1().TridentSnapshotPolicies(allNamespaces).List(ctx(), listOpts)
+	if err != nil {
+		return err
+	}
+
+	for _, policy := range policies.Items {
+		if policy.HasTridentFinalizers() {
+			crCopy := policy.DeepCopy()
+			crCopy.RemoveTridentFinalizers()
+			_, err := crdClientset.TridentV1().TridentSnapshotPolicies(policy.Namespace).Update(ctx(),
+				crCopy, updateOpts)
+			if isNotFoundError(err) {
+				continue
+			} else if err != nil {
+				Log().Errorf("Problem removing finalizers: %v", err)
+				return err
+			}
+		}
+
+		deleteFunc := crdClientset.TridentV1().TridentSnapshotPolicies(policy.Namespace).Delete
+		if err = deleteWithRetry(deleteFunc, ctx(), policy.Name, nil); err != nil {
+			Log().Errorf("Problem deleting resource: %v", err)
+			return err
+		}
+	}
+
+	Log().WithFields(logFields).Info("Resources deleted.")
+	return nil
+}
+
+func deleteSnapshotPolicyStates() error {
+	crd := "tridentsnapshotpolicystates.trident.netapp.io"
+	logFields := LogFields{"CRD": crd}
+
+	// See if CRD exists
+	exists, err := k8sClient.CheckCRDExists(crd)
+	if err != nil {
+		return err
+	} else if !exists {
+		Log().WithField("CRD", crd).Debug("CRD not present.")
+		return nil
+	}
+
+	states, err := crdClientset.TridentV1().TridentSnapshotPolicyStates(allNamespaces).List(ctx(), listOpts)
+	if err != nil {
+		return err
+	} else if len(states.Items) == 0 {
+		Log().WithFields(logFields).Info("Resources not present.")
+		return nil
+	}
+
+	for _, state := range states.Items {
+		if state.DeletionTimestamp.IsZero() {
+			_ = crdClientset.TridentV1().TridentSnapshotPolicyStates(state.Namespace).Delete(ctx(),
+				state.Name, deleteOpts)
+		}
+	}
+
+	states, err = crdClientset.TridentV1().TridentSnapshotPolicyStates(allNamespaces).List(ctx(), listOpts)
+	if err != nil {
+		return err
+	}
+
+	for _, state := range states.Items {
+		if state.HasTridentFinalizers() {
+			crCopy := state.DeepCopy()
+			crCopy.RemoveTridentFinalizers()
+			_, err := crdClientset.TridentV1().TridentSnapshotPolicyStates(state.Namespace).Update(ctx(),
+				crCopy, updateOpts)
+			if isNotFoundError(err) {
+				continue
+			} else if err != nil {
+				Log().Errorf("Problem removing finalizers: %v", err)
+				return err
+			}
+		}
+
+		deleteFunc := crdClientset.TridentV1().TridentSnapshotPolicyStates(state.Namespace).Delete
+		if err = deleteWithRetry(deleteFunc, ctx(), state.Name, nil); err != nil {
+			Log().Errorf("Problem deleting resource: %v", err)
+			return err
+		}
+	}
+
+	Log().WithFields(logFields).Info("Resources deleted.")
+	return nil
+}
+
 func deleteCRDs() error {
 	crdNames := []string{
 		"tridentversions.trident.netapp.io",
@@ -968,9 +1216,13 @@ func deleteCRDs() error {
 		"tridentnodes.trident.netapp.io",
 		"tridenttransactions.trident.netapp.io",
 		"tridentsnapshots.trident.netapp.io",
+		"tridentgroupsnapshots.trident.netapp.io",
 		"tridentvolumepublications.trident.netapp.io",
 		"tridentvolumereferences.trident.netapp.io",
 		"tridentactionsnapshotrestores.trident.netapp.io",
+		"tridentactionvolumemoves.trident.netapp.io",
+		"tridentsnapshotpolicies.trident.netapp.io",
+		"tridentsnapshotpolicystates.trident.netapp.io",
 	}
 
 	for _, crdName := range crdNames {
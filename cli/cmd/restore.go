@@ -0,0 +1,153 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ghodss/yaml"
+	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
+
+	persistentstore "github.com/netapp/trident/persistent_store"
+)
+
+var (
+	restoreStore       string
+	restoreStorePath   string
+	restoreSecretsFile string
+	restoreDryRun      bool
+	restoreValidate    bool
+)
+
+func init() {
+	RootCmd.AddCommand(restoreCmd)
+	restoreCmd.Flags().StringVar(&restoreStore, "store", string(persistentstore.CRDV1Store),
+		"Type of the store to restore into (crdv1 or bolt)")
+	restoreCmd.Flags().StringVar(&restoreStorePath, "store-path", "", "File of a bolt store")
+	restoreCmd.Flags().StringVar(&restoreSecretsFile, "secrets-file", "",
+		"YAML or JSON file mapping backend secret names to their values")
+	restoreCmd.Flags().BoolVar(&restoreDryRun, "dry-run", false,
+		"Report what would be restored without changing the store")
+	restoreCmd.Flags().BoolVar(&restoreValidate, "validate", false,
+		"Check that each backed up volume still exists on its backend")
+}
+
+var restoreCmd = &cobra.Command{
+	Use:   "restore <file>",
+	Short: "Restore Trident state from a backup archive",
+	Long: `Restore Trident state from a backup archive
+
+The archive's checksums are verified, then its objects are copied into the
+persistent store and read back to verify them.  Objects already in the store
+are never overwritten; any that differ from the backup are reported as
+conflicts.  Restore into an empty install, with Trident stopped.
+
+Backend credentials are read from the secrets named in the backup, first in
+the secrets file, then in the store itself.  If any are missing, nothing is
+restored.  With --validate, each backend is initialized and asked whether
+each of its volumes still exists.`,
+	Example: `  # Check a backup against its backends without restoring it
+  tridentctl restore trident-backup.tgz --dry-run --validate`,
+	Args: cobra.ExactArgs(1),
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		initCmdLogging()
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return restore(args[0])
+	},
+}
+
+// RestoreResult is the output of a restore, for JSON and YAML formats.
+type RestoreResult struct {
+	Report  *persistentstore.MigrationReport   `json:"report"`
+	Volumes []*persistentstore.ValidatedVolume `json:"volumes,omitempty"`
+}
+
+func restore(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("could not open backup file; %v", err)
+	}
+	stateBackup, err := persistentstore.ReadBackup(file)
+	_ = file.Close()
+	if err != nil {
+		return err
+	}
+
+	secrets := make(map[string]map[string]string)
+	if restoreSecretsFile != "" {
+		secretsBytes, err := os.ReadFile(restoreSecretsFile)
+		if err != nil {
+			return fmt.Errorf("could not read secrets file; %v", err)
+		}
+		if err = yaml.Unmarshal(secretsBytes, &secrets); err != nil {
+			return fmt.Errorf("could not parse secrets file; %v", err)
+		}
+	}
+
+	storeType := persistentstore.StoreType(restoreStore)
+	dest, err := persistentstore.NewMigrationClient(ctx(), storeType, migrateStoreLocation(storeType,
+		restoreStorePath))
+	if err != nil {
+		return fmt.Errorf("could not open the %s store; %v", storeType, err)
+	}
+	defer func() { _ = dest.Stop() }()
+
+	restorer := persistentstore.NewBackupRestorer(stateBackup, dest, secrets, restoreDryRun)
+	result := &RestoreResult{}
+
+	if restoreValidate {
+		if result.Volumes, err = restorer.ValidateVolumes(ctx()); err != nil {
+			return err
+		}
+	}
+
+	result.Report, err = restorer.Run(ctx())
+	if result.Report != nil {
+		WriteRestoreResult(result)
+	}
+	if err != nil {
+		return err
+	}
+	if !result.Report.Succeeded() {
+		return fmt.Errorf("some objects could not be restored")
+	}
+	return nil
+}
+
+func WriteRestoreResult(result *RestoreResult) {
+	switch OutputFormat {
+	case FormatJSON:
+		WriteJSON(result)
+	case FormatYAML:
+		WriteYAML(result)
+	default:
+		WriteMigrationReport(result.Report)
+		if result.Volumes != nil && OutputFormat != FormatName {
+			writeVolumeValidationTable(result.Volumes)
+		}
+	}
+}
+
+func writeVolumeValidationTable(volumes []*persistentstore.ValidatedVolume) {
+	if len(volumes) == 0 {
+		fmt.Println("No volumes to validate.")
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Volume", "Internal Name", "Backend", "Result", "Error"})
+	for _, volume := range volumes {
+		table.Append([]string{
+			volume.Name,
+			volume.InternalName,
+			volume.Backend,
+			string(volume.Result),
+			volume.Error,
+		})
+	}
+	table.Render()
+}
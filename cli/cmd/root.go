@@ -107,6 +107,8 @@ func init() {
 		"Output format. One of json|yaml|name|wide|ps (default)")
 	RootCmd.PersistentFlags().StringVarP(&TridentPodNamespace, "namespace", "n", "", "Namespace of Trident deployment")
 	RootCmd.PersistentFlags().StringVarP(&KubeConfigPath, "kubeconfig", "k", "", "Kubernetes config path")
+	RootCmd.PersistentFlags().StringVar(&api.BearerToken, "token", "",
+		"Bearer token for the Trident REST interface, when not tunneling (default $"+api.BearerTokenEnvVar+")")
 	RootCmd.SetOut(os.Stdout)
 }
 
@@ -505,6 +507,39 @@ func TunnelCommand(commandArgs []string) ([]byte, error) {
 	return outbuff.Bytes(), err
 }
 
+// TunnelCommandStream runs a command in the Trident pod, writing its output as it runs, for commands such as
+// watches that may not end.
+func TunnelCommandStream(commandArgs []string) error {
+	// Build tunnel command to exec command in container
+	execCommand := []string{"exec", TridentPodName, "-n", TridentPodNamespace, "-c", config.ContainerTrident, "--"}
+	// Build CLI command
+	cliCommand := []string{"tridentctl"}
+	if Debug {
+		cliCommand = append(cliCommand, "--debug")
+	}
+
+	if OutputFormat != "" {
+		cliCommand = append(cliCommand, []string{"--output", OutputFormat}...)
+	}
+	cliCommand = append(cliCommand, commandArgs...)
+
+	// Combine tunnel and CLI commands
+	execCommand = append(execCommand, cliCommand...)
+
+	if Debug {
+		fmt.Printf("Invoking tunneled command: %s %v\n", KubernetesCLI, strings.Join(execCommand, " "))
+	}
+
+	// Invoke tridentctl inside the Trident pod
+	cmd := execKubernetesCLIRaw(execCommand...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	err := cmd.Run()
+
+	SetExitCodeFromError(err)
+	return err
+}
+
 func printOutput(cmd *cobra.Command, out []byte, err error) {
 	if err != nil {
 		cmd.PrintErr(string(out))
@@ -0,0 +1,136 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/netapp/trident/cli/api"
+	"github.com/netapp/trident/core"
+	"github.com/netapp/trident/frontend/rest"
+)
+
+const (
+	// eventStreamRetryInterval is how long to wait before reconnecting after an event stream ends
+	eventStreamRetryInterval = 2 * time.Second
+	// maxEventSize is the largest event accepted from an event stream
+	maxEventSize = 16 * 1024 * 1024
+)
+
+// WatchEvents passes each orchestrator event of the specified kinds to handle, until interrupted or until
+// either callback fails.  Once the event stream is open, list is called so that the caller may show the
+// objects as they are; it is called again whenever events were missed and the stream has begun afresh.
+func WatchEvents(kinds []core.EventKind, list func() error, handle func(*rest.WatchEventResponse) error) error {
+	var lastEventID uint64
+	for {
+		response, err := openEventStream(kinds, lastEventID)
+		if err != nil {
+			return err
+		}
+
+		if response.StatusCode == http.StatusGone {
+			// Trident restarted, or we fell too far behind, so start again from the current state
+			_ = response.Body.Close()
+			lastEventID = 0
+			continue
+		} else if response.StatusCode != http.StatusOK {
+			responseBody, _ := io.ReadAll(response.Body)
+			_ = response.Body.Close()
+			return fmt.Errorf("could not watch events: %v", GetErrorFromHTTPResponse(response, responseBody))
+		}
+
+		if lastEventID == 0 {
+			if err = list(); err != nil {
+				_ = response.Body.Close()
+				return err
+			}
+		}
+
+		lastEventID, err = readEvents(response.Body, lastEventID, handle)
+		_ = response.Body.Close()
+		if err != nil {
+			return err
+		}
+
+		time.Sleep(eventStreamRetryInterval)
+	}
+}
+
+func openEventStream(kinds []core.EventKind, lastEventID uint64) (*http.Response, error) {
+	query := url.Values{}
+	for _, kind := range kinds {
+		query.Add("kind", string(kind))
+	}
+
+	request, err := http.NewRequest("GET", BaseURL()+"/event?"+query.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Accept", "text/event-stream")
+	api.SetAuthorization(request)
+	if lastEventID > 0 {
+		request.Header.Set("Last-Event-ID", strconv.FormatUint(lastEventID, 10))
+	}
+
+	api.LogHTTPRequest(request, nil)
+
+	// The stream stays open indefinitely, so the client must not time out
+	response, err := (&http.Client{}).Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("error communicating with Trident REST API; %v", err)
+	}
+	return response, nil
+}
+
+// readEvents passes each event in a server-sent event stream to handle until the stream ends, and returns
+// the ID of the last event read.  Only errors from handle are returned, as the caller reconnects otherwise.
+func readEvents(
+	stream io.Reader, lastEventID uint64, handle func(*rest.WatchEventResponse) error,
+) (uint64, error) {
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxEventSize)
+
+	var data strings.Builder
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			if data.Len() == 0 {
+				continue
+			}
+			event := &rest.WatchEventResponse{}
+			if err := json.Unmarshal([]byte(data.String()), event); err != nil {
+				return lastEventID, fmt.Errorf("could not parse event; %v", err)
+			}
+			data.Reset()
+			if err := handle(event); err != nil {
+				return lastEventID, err
+			}
+			lastEventID = event.Sequence
+		case strings.HasPrefix(line, "data:"):
+			data.WriteString(strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+		}
+	}
+	return lastEventID, nil
+}
+
+// WriteEvent writes an event for the JSON, YAML and name output formats.
+func WriteEvent(event *rest.WatchEventResponse) {
+	switch OutputFormat {
+	case FormatJSON:
+		WriteJSON(event)
+	case FormatYAML:
+		fmt.Println("---")
+		WriteYAML(event)
+	default:
+		fmt.Println(event.Name)
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package cmd
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netapp/trident/core"
+	"github.com/netapp/trident/frontend/rest"
+)
+
+func TestReadEvents(t *testing.T) {
+	stream := ": keepalive\n\n" +
+		"id: 3\nevent: volume.added\ndata: {\"sequence\":3,\"type\":\"added\",\"kind\":\"volume\",\"name\":\"vol1\"}\n\n" +
+		"id: 4\nevent: volume.deleted\ndata: {\"sequence\":4,\"type\":\"deleted\",\"kind\":\"volume\",\"name\":\"vol1\"}\n\n"
+
+	var events []*rest.WatchEventResponse
+	lastEventID, err := readEvents(strings.NewReader(stream), 2, func(event *rest.WatchEventResponse) error {
+		events = append(events, event)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(4), lastEventID)
+	if assert.Len(t, events, 2) {
+		assert.Equal(t, core.EventAdded, events[0].Type)
+		assert.Equal(t, core.EventDeleted, events[1].Type)
+	}
+
+	// Handler errors end the stream
+	lastEventID, err = readEvents(strings.NewReader(stream), 2, func(event *rest.WatchEventResponse) error {
+		return errors.New("stop")
+	})
+	assert.Error(t, err)
+	assert.Equal(t, uint64(2), lastEventID)
+
+	_, err = readEvents(strings.NewReader("data: {\n\n"), 0, func(*rest.WatchEventResponse) error { return nil })
+	assert.Error(t, err, "Expected an invalid event to fail")
+}
+
+func TestWatchEvents(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := BaseURL() + "/event?kind=volume"
+	requests := 0
+	httpmock.RegisterResponder("GET", url, func(request *http.Request) (*http.Response, error) {
+		requests++
+		if requests == 1 {
+			return httpmock.NewStringResponse(http.StatusGone, `{"error":"expired"}`), nil
+		}
+		return httpmock.NewStringResponse(http.StatusOK,
+			"id: 8\ndata: {\"sequence\":8,\"type\":\"added\",\"kind\":\"volume\",\"name\":\"vol1\"}\n\n"), nil
+	})
+
+	lists := 0
+	var names []string
+	stop := errors.New("stop")
+	err := WatchEvents([]core.EventKind{core.EventKindVolume},
+		func() error {
+			lists++
+			return nil
+		},
+		func(event *rest.WatchEventResponse) error {
+			names = append(names, event.Name)
+			return stop
+		})
+	assert.Equal(t, stop, err)
+	assert.Equal(t, 2, requests, "Expected the stream to be reopened after events expired")
+	assert.Equal(t, 1, lists)
+	assert.Equal(t, []string{"vol1"}, names)
+
+	// Other failures are returned
+	httpmock.RegisterResponder("GET", url, httpmock.NewStringResponder(http.StatusServiceUnavailable,
+		`{"error":"not ready"}`))
+	err = WatchEvents([]core.EventKind{core.EventKindVolume}, func() error { return nil },
+		func(*rest.WatchEventResponse) error { return nil })
+	assert.ErrorContains(t, err, "not ready")
+}
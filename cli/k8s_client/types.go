@@ -177,6 +177,8 @@ type DeploymentYAMLArguments struct {
 	CloudProvider           string                `json:"cloudProvider"`
 	IdentityLabel           bool                  `json:"identityLabel"`
 	K8sAPIQPS               int                   `json:"k8sAPIQPS"`
+	TracingEndpoint         string                `json:"tracingEndpoint"`
+	TracingSampleRatio      float64               `json:"tracingSampleRatio"`
 }
 
 type DaemonsetYAMLArguments struct {
@@ -203,6 +205,8 @@ type DaemonsetYAMLArguments struct {
 	ImagePullPolicy          string                `json:"imagePullPolicy"`
 	ISCSISelfHealingInterval string                `json:"iscsiSelfHealingInterval"`
 	ISCSISelfHealingWaitTime string                `json:"iscsiSelfHealingWaitTime"`
+	TracingEndpoint          string                `json:"tracingEndpoint"`
+	TracingSampleRatio       float64               `json:"tracingSampleRatio"`
 }
 
 type TridentVersionPodYAMLArguments struct {
@@ -158,16 +158,27 @@ rules:
   - apiGroups: ["snapshot.storage.k8s.io"]
     resources: ["volumesnapshotcontents"]
     verbs: ["get", "list", "watch", "update", "patch"]
+  - apiGroups: ["groupsnapshot.storage.k8s.io"]
+    resources: ["volumegroupsnapshotclasses"]
+    verbs: ["get", "list", "watch"]
+  - apiGroups: ["groupsnapshot.storage.k8s.io"]
+    resources: ["volumegroupsnapshotcontents"]
+    verbs: ["get", "list", "watch", "update", "patch"]
+  - apiGroups: ["groupsnapshot.storage.k8s.io"]
+    resources: ["volumegroupsnapshotcontents/status"]
+    verbs: ["update", "patch"]
   - apiGroups: ["storage.k8s.io"]
     resources: ["csinodes"]
     verbs: ["get", "list", "watch"]
   - apiGroups: ["trident.netapp.io"]
     resources: ["tridentversions", "tridentbackends", "tridentstorageclasses", "tridentvolumes","tridentnodes",
-"tridenttransactions", "tridentsnapshots", "tridentbackendconfigs", "tridentbackendconfigs/status",
+"tridenttransactions", "tridentsnapshots", "tridentgroupsnapshots", "tridentbackendconfigs", "tridentbackendconfigs/status",
 "tridentmirrorrelationships", "tridentmirrorrelationships/status", "tridentsnapshotinfos",
 "tridentsnapshotinfos/status", "tridentvolumepublications", "tridentvolumereferences",
 "tridentactionmirrorupdates", "tridentactionmirrorupdates/status",
-"tridentactionsnapshotrestores", "tridentactionsnapshotrestores/status"]
+"tridentactionsnapshotrestores", "tridentactionsnapshotrestores/status", "tridentactionvolumemoves",
+"tridentactionvolumemoves/status", "tridentsnapshotpolicies", "tridentsnapshotpolicies/status",
+"tridentsnapshotpolicystates"]
     verbs: ["get", "list", "watch", "create", "delete", "update", "patch"]
   - apiGroups: ["policy"]
     resources: ["podsecuritypolicies"]
@@ -330,6 +341,10 @@ spec:
     protocol: TCP
     port: 9220
     targetPort: 8001
+  - name: grpc
+    protocol: TCP
+    port: 34572
+    targetPort: 8444
 `
 
 func GetResourceQuotaYAML(resourceQuotaName, namespace string, labels, controllingCRDetails map[string]string) string {
@@ -529,6 +544,8 @@ func GetCSIDeploymentYAML(args *DeploymentYAMLArguments) string {
 	deploymentYAML = strings.ReplaceAll(deploymentYAML, "{ENABLE_ACP}", enableACP)
 	deploymentYAML = strings.ReplaceAll(deploymentYAML, "{K8S_API_CLIENT_TRIDENT_THROTTLE}", K8sAPITridentThrottle)
 	deploymentYAML = strings.ReplaceAll(deploymentYAML, "{K8S_API_CLIENT_SIDECAR_THROTTLE}", K8sAPISidecarThrottle)
+	deploymentYAML = strings.ReplaceAll(deploymentYAML, "{TRACING}",
+		constructTracingArgs(args.TracingEndpoint, args.TracingSampleRatio))
 
 	// Log before secrets are inserted into YAML.
 	Log().WithField("yaml", deploymentYAML).Trace("CSI Deployment YAML.")
@@ -573,6 +590,7 @@ spec:
         ports:
         - containerPort: 8443
         - containerPort: 8001
+        - containerPort: 8444
         command:
         - /trident_orchestrator
         args:
@@ -580,6 +598,7 @@ spec:
         - "--k8s_pod"
         - "--https_rest"
         - "--https_port=8443"
+        - "--grpc_port=8444"
         - "--csi_node_name=$(KUBE_NODE_NAME)"
         - "--csi_endpoint=$(CSI_ENDPOINT)"
         - "--csi_role=controller"
@@ -593,6 +612,7 @@ spec:
         - "--enable_force_detach={ENABLE_FORCE_DETACH}"
         - "--metrics"
         {ENABLE_ACP}
+        {TRACING}
         {DEBUG}
         {K8S_API_CLIENT_TRIDENT_THROTTLE}
         livenessProbe:
@@ -827,6 +847,8 @@ func GetCSIDaemonSetYAMLWindows(args *DaemonsetYAMLArguments) string {
 	daemonSetYAML = strings.ReplaceAll(daemonSetYAML, "{DISABLE_AUDIT_LOG}", strconv.FormatBool(args.DisableAuditLog))
 	daemonSetYAML = strings.ReplaceAll(daemonSetYAML, "{PROBE_PORT}", args.ProbePort)
 	daemonSetYAML = strings.ReplaceAll(daemonSetYAML, "{HTTP_REQUEST_TIMEOUT}", args.HTTPRequestTimeout)
+	daemonSetYAML = strings.ReplaceAll(daemonSetYAML, "{TRACING}",
+		constructTracingArgs(args.TracingEndpoint, args.TracingSampleRatio))
 	daemonSetYAML = strings.ReplaceAll(daemonSetYAML, "{SERVICE_ACCOUNT}", args.ServiceAccountName)
 	daemonSetYAML = strings.ReplaceAll(daemonSetYAML, "{IMAGE_PULL_POLICY}", args.ImagePullPolicy)
 	daemonSetYAML = utils.ReplaceMultilineYAMLTag(daemonSetYAML, "NODE_SELECTOR", constructNodeSelector(args.NodeSelector))
@@ -901,6 +923,8 @@ func GetCSIDaemonSetYAMLLinux(args *DaemonsetYAMLArguments) string {
 	daemonSetYAML = strings.ReplaceAll(daemonSetYAML, "{LOG_LAYERS}", args.LogLayers)
 	daemonSetYAML = strings.ReplaceAll(daemonSetYAML, "{PROBE_PORT}", args.ProbePort)
 	daemonSetYAML = strings.ReplaceAll(daemonSetYAML, "{HTTP_REQUEST_TIMEOUT}", args.HTTPRequestTimeout)
+	daemonSetYAML = strings.ReplaceAll(daemonSetYAML, "{TRACING}",
+		constructTracingArgs(args.TracingEndpoint, args.TracingSampleRatio))
 	daemonSetYAML = strings.ReplaceAll(daemonSetYAML, "{SERVICE_ACCOUNT}", args.ServiceAccountName)
 	daemonSetYAML = strings.ReplaceAll(daemonSetYAML, "{IMAGE_PULL_POLICY}", args.ImagePullPolicy)
 	daemonSetYAML = strings.ReplaceAll(daemonSetYAML, "{ISCSI_SELF_HEALING_INTERVAL}", args.ISCSISelfHealingInterval)
@@ -974,7 +998,14 @@ spec:
         - "--enable_force_detach={FORCE_DETACH_BOOL}"
         - "--iscsi_self_healing_interval={ISCSI_SELF_HEALING_INTERVAL}"
         - "--iscsi_self_healing_wait_time={ISCSI_SELF_HEALING_WAIT_TIME}"
+        - "--metrics"
+        - "--metrics_port=17547"
+        {TRACING}
         {DEBUG}
+        ports:
+        - name: metrics
+          containerPort: 17547
+          protocol: TCP
         startupProbe:
           httpGet:
             path: /liveness
@@ -1168,6 +1199,7 @@ spec:
         - "--http_request_timeout={HTTP_REQUEST_TIMEOUT}"
         - "--https_rest"
         - "--https_port={PROBE_PORT}"
+        {TRACING}
         {DEBUG}
         # Windows requires named ports for it to actually bind
         ports:
@@ -1676,6 +1708,12 @@ func GetSnapshotCRDYAML() string {
 	return tridentSnapshotCRDYAMLv1
 }
 
+func GetGroupSnapshotCRDYAML() string {
+	Log().Trace(">>>> GetGroupSnapshotCRDYAML")
+	defer func() { Log().Trace("<<<< GetGroupSnapshotCRDYAML") }()
+	return tridentGroupSnapshotCRDYAMLv1
+}
+
 func GetVolumeReferenceCRDYAML() string {
 	Log().Trace(">>>> GetVolumeReferenceCRDYAML")
 	defer func() { Log().Trace("<<<< GetVolumeReferenceCRDYAML") }()
@@ -1688,6 +1726,24 @@ func GetActionSnapshotRestoreCRDYAML() string {
 	return tridentActionSnapshotRestoreCRDYAMLv1
 }
 
+func GetActionVolumeMoveCRDYAML() string {
+	Log().Trace(">>>> GetActionVolumeMoveCRDYAML")
+	defer func() { Log().Trace("<<<< GetActionVolumeMoveCRDYAML") }()
+	return tridentActionVolumeMoveCRDYAMLv1
+}
+
+func GetSnapshotPolicyCRDYAML() string {
+	Log().Trace(">>>> GetSnapshotPolicyCRDYAML")
+	defer func() { Log().Trace("<<<< GetSnapshotPolicyCRDYAML") }()
+	return tridentSnapshotPolicyCRDYAMLv1
+}
+
+func GetSnapshotPolicyStateCRDYAML() string {
+	Log().Trace(">>>> GetSnapshotPolicyStateCRDYAML")
+	defer func() { Log().Trace("<<<< GetSnapshotPolicyStateCRDYAML") }()
+	return tridentSnapshotPolicyStateCRDYAMLv1
+}
+
 func GetOrchestratorCRDYAML() string {
 	Log().Trace(">>>> GetOrchestratorCRDYAML")
 	defer func() { Log().Trace("<<<< GetOrchestratorCRDYAML") }()
@@ -1706,8 +1762,12 @@ kubectl delete crd tridentvolumepublications.trident.netapp.io --wait=false
 kubectl delete crd tridentnodes.trident.netapp.io --wait=false
 kubectl delete crd tridenttransactions.trident.netapp.io --wait=false
 kubectl delete crd tridentsnapshots.trident.netapp.io --wait=false
+kubectl delete crd tridentgroupsnapshots.trident.netapp.io --wait=false
 kubectl delete crd tridentvolumereferences.trident.netapp.io --wait=false
 kubectl delete crd tridentactionsnapshotrestores.trident.netapp.io --wait=false
+kubectl delete crd tridentactionvolumemoves.trident.netapp.io --wait=false
+kubectl delete crd tridentsnapshotpolicies.trident.netapp.io --wait=false
+kubectl delete crd tridentsnapshotpolicystates.trident.netapp.io --wait=false
 
 kubectl patch crd tridentversions.trident.netapp.io -p '{"metadata":{"finalizers": []}}' --type=merge
 kubectl patch crd tridentbackends.trident.netapp.io -p '{"metadata":{"finalizers": []}}' --type=merge
@@ -1720,8 +1780,12 @@ kubectl patch crd tridentvolumepublications.trident.netapp.io -p '{"metadata":{"
 kubectl patch crd tridentnodes.trident.netapp.io -p '{"metadata":{"finalizers": []}}' --type=merge
 kubectl patch crd tridenttransactions.trident.netapp.io -p '{"metadata":{"finalizers": []}}' --type=merge
 kubectl patch crd tridentsnapshots.trident.netapp.io -p '{"metadata":{"finalizers": []}}' --type=merge
+kubectl patch crd tridentgroupsnapshots.trident.netapp.io -p '{"metadata":{"finalizers": []}}' --type=merge
 kubectl patch crd tridentvolumereferences.trident.netapp.io -p '{"metadata":{"finalizers": []}}' --type=merge
 kubectl patch crd tridentactionsnapshotrestores.trident.netapp.io -p '{"metadata":{"finalizers": []}}' --type=merge
+kubectl patch crd tridentactionvolumemoves.trident.netapp.io -p '{"metadata":{"finalizers": []}}' --type=merge
+kubectl patch crd tridentsnapshotpolicies.trident.netapp.io -p '{"metadata":{"finalizers": []}}' --type=merge
+kubectl patch crd tridentsnapshotpolicystates.trident.netapp.io -p '{"metadata":{"finalizers": []}}' --type=merge
 
 kubectl delete crd tridentversions.trident.netapp.io
 kubectl delete crd tridentbackends.trident.netapp.io
@@ -1734,8 +1798,12 @@ kubectl delete crd tridentvolumepublications.trident.netapp.io
 kubectl delete crd tridentnodes.trident.netapp.io
 kubectl delete crd tridenttransactions.trident.netapp.io
 kubectl delete crd tridentsnapshots.trident.netapp.io
+kubectl delete crd tridentgroupsnapshots.trident.netapp.io
 kubectl delete crd tridentvolumereferences.trident.netapp.io
 kubectl delete crd tridentactionsnapshotrestores.trident.netapp.io
+kubectl delete crd tridentactionvolumemoves.trident.netapp.io
+kubectl delete crd tridentsnapshotpolicies.trident.netapp.io
+kubectl delete crd tridentsnapshotpolicystates.trident.netapp.io
 */
 
 const tridentVersionCRDYAMLv1 = `
@@ -2378,6 +2446,72 @@ spec:
     - trident
     - trident-internal`
 
+const tridentGroupSnapshotCRDYAMLv1 = `
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: tridentgroupsnapshots.trident.netapp.io
+spec:
+  group: trident.netapp.io
+  versions:
+    - name: v1
+      served: true
+      storage: true
+      schema:
+          openAPIV3Schema:
+              type: object
+              x-kubernetes-preserve-unknown-fields: true
+      additionalPrinterColumns:
+      - name: Created
+        type: string
+        description: The creation time of the group snapshot
+        priority: 1
+        jsonPath: .dateCreated
+  scope: Namespaced
+  names:
+    plural: tridentgroupsnapshots
+    singular: tridentgroupsnapshot
+    kind: TridentGroupSnapshot
+    shortNames:
+    - tgsnap
+    - tgroupsnapshot
+    categories:
+    - trident
+    - trident-internal`
+
+const tridentSnapshotPolicyStateCRDYAMLv1 = `
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: tridentsnapshotpolicystates.trident.netapp.io
+spec:
+  group: trident.netapp.io
+  versions:
+    - name: v1
+      served: true
+      storage: true
+      schema:
+        openAPIV3Schema:
+          type: object
+          x-kubernetes-preserve-unknown-fields: true
+      additionalPrinterColumns:
+      - description: NextRun
+        jsonPath: .nextRunTime
+        name: NextRun
+        type: date
+        priority: 0
+  scope: Namespaced
+  names:
+    plural: tridentsnapshotpolicystates
+    singular: tridentsnapshotpolicystate
+    kind: TridentSnapshotPolicyState
+    shortNames:
+    - tspstate
+    - tsnapshotpolicystate
+    categories:
+    - trident
+    - trident-internal`
+
 const tridentOrchestratorCRDYAMLv1 = `
 apiVersion: apiextensions.k8s.io/v1
 kind: CustomResourceDefinition
@@ -2501,6 +2635,113 @@ spec:
     - trident
     - trident-external`
 
+const tridentActionVolumeMoveCRDYAMLv1 = `
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: tridentactionvolumemoves.trident.netapp.io
+spec:
+  group: trident.netapp.io
+  versions:
+    - name: v1
+      served: true
+      storage: true
+      schema:
+        openAPIV3Schema:
+          type: object
+          x-kubernetes-preserve-unknown-fields: true
+      additionalPrinterColumns:
+      - description: Namespace
+        jsonPath: .metadata.namespace
+        name: Namespace
+        type: string
+        priority: 0
+      - description: PVC
+        jsonPath: .spec.pvcName
+        name: PVC
+        type: string
+        priority: 0
+      - description: Backend
+        jsonPath: .spec.destinationBackend
+        name: Backend
+        type: string
+        priority: 0
+      - description: Pool
+        jsonPath: .spec.destinationPool
+        name: Pool
+        type: string
+        priority: 0
+      - description: State
+        jsonPath: .status.state
+        name: State
+        type: string
+        priority: 0
+      - description: CompletionTime
+        jsonPath: .status.completionTime
+        name: CompletionTime
+        type: date
+        priority: 0
+      - description: Message
+        jsonPath: .status.message
+        name: Message
+        type: string
+        priority: 1
+  scope: Namespaced
+  names:
+    plural: tridentactionvolumemoves
+    singular: tridentactionvolumemove
+    kind: TridentActionVolumeMove
+    shortNames:
+    - tavm
+    categories:
+    - trident
+    - trident-external`
+
+const tridentSnapshotPolicyCRDYAMLv1 = `
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: tridentsnapshotpolicies.trident.netapp.io
+spec:
+  group: trident.netapp.io
+  versions:
+    - name: v1
+      served: true
+      storage: true
+      schema:
+        openAPIV3Schema:
+          type: object
+          x-kubernetes-preserve-unknown-fields: true
+      subresources:
+        status: {}
+      additionalPrinterColumns:
+      - description: Schedule
+        jsonPath: .spec.schedule
+        name: Schedule
+        type: string
+        priority: 0
+      - description: NextRun
+        jsonPath: .status.nextRunTime
+        name: NextRun
+        type: date
+        priority: 0
+      - description: Message
+        jsonPath: .status.message
+        name: Message
+        type: string
+        priority: 1
+  scope: Namespaced
+  names:
+    plural: tridentsnapshotpolicies
+    singular: tridentsnapshotpolicy
+    kind: TridentSnapshotPolicy
+    shortNames:
+    - tsp
+    - tsnappolicy
+    categories:
+    - trident
+    - trident-external`
+
 const customResourceDefinitionYAMLv1 = tridentVersionCRDYAMLv1 +
 	"\n---" + tridentBackendCRDYAMLv1 +
 	"\n---" + tridentBackendConfigCRDYAMLv1 +
@@ -2515,7 +2756,11 @@ const customResourceDefinitionYAMLv1 = tridentVersionCRDYAMLv1 +
 	"\n---" + tridentSnapshotCRDYAMLv1 +
 	"\n---" + tridentVolumeReferenceCRDYAMLv1 +
 	"\n---" + tridentActionSnapshotRestoreCRDYAMLv1 +
-	"\n---" + tridentConfiguratorCRDYAMLv1 + "\n"
+	"\n---" + tridentConfiguratorCRDYAMLv1 +
+	"\n---" + tridentGroupSnapshotCRDYAMLv1 +
+	"\n---" + tridentActionVolumeMoveCRDYAMLv1 +
+	"\n---" + tridentSnapshotPolicyCRDYAMLv1 +
+	"\n---" + tridentSnapshotPolicyStateCRDYAMLv1 + "\n"
 
 func GetCSIDriverYAML(name string, labels, controllingCRDetails map[string]string) string {
 	Log().WithFields(LogFields{
@@ -2740,3 +2985,12 @@ func getBurstValueForQPS(queriesPerSecond int) int {
 	// The burst value is set to twice the QPS value, which seems to be a common practice
 	return queriesPerSecond * 2
 }
+
+// constructTracingArgs returns the Trident arguments that export traces to an OTLP collector, if one is specified.
+func constructTracingArgs(endpoint string, sampleRatio float64) string {
+	if endpoint == "" {
+		return ""
+	}
+	return fmt.Sprintf("- \"--tracing_endpoint=%s\"\n        - \"--tracing_sample_ratio=%s\"",
+		endpoint, strconv.FormatFloat(sampleRatio, 'f', -1, 64))
+}
@@ -517,6 +517,61 @@ func TestGetCSIDeploymentYAML_K8sAPIQPS(t *testing.T) {
 	}
 }
 
+func TestGetCSIDeploymentYAML_Tracing(t *testing.T) {
+	args := &DeploymentYAMLArguments{
+		TracingEndpoint:    "http://otel-collector.monitoring:4317",
+		TracingSampleRatio: 0.25,
+	}
+	yamlData := GetCSIDeploymentYAML(args)
+	deployment := appsv1.Deployment{}
+	err := yaml.Unmarshal([]byte(yamlData), &deployment)
+	if err != nil {
+		t.Fatalf("expected valid YAML, got %s", yamlData)
+	}
+
+	tridentMainContainer := deployment.Spec.Template.Spec.Containers[0]
+	assert.Contains(t, tridentMainContainer.Args, "--tracing_endpoint=http://otel-collector.monitoring:4317")
+	assert.Contains(t, tridentMainContainer.Args, "--tracing_sample_ratio=0.25")
+
+	// Tracing is left off unless a collector is specified
+	yamlData = GetCSIDeploymentYAML(&DeploymentYAMLArguments{})
+	assert.NotContains(t, yamlData, "tracing")
+}
+
+func TestGetCSIDaemonSetYAMLLinux_Tracing(t *testing.T) {
+	args := &DaemonsetYAMLArguments{
+		TracingEndpoint:    "https://otel-collector.monitoring:4317",
+		TracingSampleRatio: 1,
+	}
+	yamlData := GetCSIDaemonSetYAMLLinux(args)
+	daemonSet := appsv1.DaemonSet{}
+	err := yaml.Unmarshal([]byte(yamlData), &daemonSet)
+	if err != nil {
+		t.Fatalf("expected valid YAML, got %s", yamlData)
+	}
+
+	tridentMainContainer := daemonSet.Spec.Template.Spec.Containers[0]
+	assert.Contains(t, tridentMainContainer.Args, "--tracing_endpoint=https://otel-collector.monitoring:4317")
+	assert.Contains(t, tridentMainContainer.Args, "--tracing_sample_ratio=1")
+}
+
+func TestGetCSIDaemonSetYAMLLinux_Metrics(t *testing.T) {
+	yamlData := GetCSIDaemonSetYAMLLinux(&DaemonsetYAMLArguments{})
+	daemonSet := appsv1.DaemonSet{}
+	err := yaml.Unmarshal([]byte(yamlData), &daemonSet)
+	if err != nil {
+		t.Fatalf("expected valid YAML, got %s", yamlData)
+	}
+
+	tridentMainContainer := daemonSet.Spec.Template.Spec.Containers[0]
+	assert.Contains(t, tridentMainContainer.Args, "--metrics")
+	assert.Contains(t, tridentMainContainer.Args, "--metrics_port=17547")
+	if assert.Len(t, tridentMainContainer.Ports, 1) {
+		assert.Equal(t, "metrics", tridentMainContainer.Ports[0].Name)
+		assert.Equal(t, int32(17547), tridentMainContainer.Ports[0].ContainerPort)
+	}
+}
+
 func TestGetCSIDaemonSetYAMLLinux(t *testing.T) {
 	versions := []string{"1.21.0", "1.23.0", "1.25.0"}
 
@@ -2264,6 +2319,217 @@ func TestGetCRDsYAML(t *testing.T) {
 			},
 		},
 	}
+	expected16 := apiextensionsv1.CustomResourceDefinition{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "CustomResourceDefinition",
+			APIVersion: "apiextensions.k8s.io/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "tridentgroupsnapshots.trident.netapp.io",
+		},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: "trident.netapp.io",
+			Names: apiextensionsv1.CustomResourceDefinitionNames{
+				Plural:     "tridentgroupsnapshots",
+				Singular:   "tridentgroupsnapshot",
+				Kind:       "TridentGroupSnapshot",
+				ShortNames: []string{"tgsnap", "tgroupsnapshot"},
+				Categories: []string{"trident", "trident-internal"},
+			},
+			Scope: "Namespaced",
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{
+					Name:    "v1",
+					Served:  true,
+					Storage: true,
+					Schema:  &schema1,
+					AdditionalPrinterColumns: []apiextensionsv1.CustomResourceColumnDefinition{
+						{
+							Name:        "Created",
+							Type:        "string",
+							Description: "The creation time of the group snapshot",
+							Priority:    int32(1),
+							JSONPath:    ".dateCreated",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expected17 := apiextensionsv1.CustomResourceDefinition{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "CustomResourceDefinition",
+			APIVersion: "apiextensions.k8s.io/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "tridentactionvolumemoves.trident.netapp.io",
+		},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: "trident.netapp.io",
+			Names: apiextensionsv1.CustomResourceDefinitionNames{
+				Plural:     "tridentactionvolumemoves",
+				Singular:   "tridentactionvolumemove",
+				Kind:       "TridentActionVolumeMove",
+				ShortNames: []string{"tavm"},
+				Categories: []string{"trident", "trident-external"},
+			},
+			Scope: "Namespaced",
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{
+					Name:    "v1",
+					Served:  true,
+					Storage: true,
+					Schema:  &schema1,
+					AdditionalPrinterColumns: []apiextensionsv1.CustomResourceColumnDefinition{
+						{
+							Name:        "Namespace",
+							Type:        "string",
+							Description: "Namespace",
+							Priority:    int32(0),
+							JSONPath:    ".metadata.namespace",
+						},
+						{
+							Name:        "PVC",
+							Type:        "string",
+							Description: "PVC",
+							Priority:    int32(0),
+							JSONPath:    ".spec.pvcName",
+						},
+						{
+							Name:        "Backend",
+							Type:        "string",
+							Description: "Backend",
+							Priority:    int32(0),
+							JSONPath:    ".spec.destinationBackend",
+						},
+						{
+							Name:        "Pool",
+							Type:        "string",
+							Description: "Pool",
+							Priority:    int32(0),
+							JSONPath:    ".spec.destinationPool",
+						},
+						{
+							Name:        "State",
+							Type:        "string",
+							Description: "State",
+							Priority:    int32(0),
+							JSONPath:    ".status.state",
+						},
+						{
+							Name:        "CompletionTime",
+							Type:        "date",
+							Description: "CompletionTime",
+							Priority:    int32(0),
+							JSONPath:    ".status.completionTime",
+						},
+						{
+							Name:        "Message",
+							Type:        "string",
+							Description: "Message",
+							Priority:    int32(1),
+							JSONPath:    ".status.message",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expected18 := apiextensionsv1.CustomResourceDefinition{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "CustomResourceDefinition",
+			APIVersion: "apiextensions.k8s.io/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "tridentsnapshotpolicies.trident.netapp.io",
+		},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: "trident.netapp.io",
+			Names: apiextensionsv1.CustomResourceDefinitionNames{
+				Plural:     "tridentsnapshotpolicies",
+				Singular:   "tridentsnapshotpolicy",
+				Kind:       "TridentSnapshotPolicy",
+				ShortNames: []string{"tsp", "tsnappolicy"},
+				Categories: []string{"trident", "trident-external"},
+			},
+			Scope: "Namespaced",
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{
+					Name:    "v1",
+					Served:  true,
+					Storage: true,
+					Schema:  &schema1,
+					Subresources: &apiextensionsv1.CustomResourceSubresources{
+						Status: &apiextensionsv1.CustomResourceSubresourceStatus{},
+						Scale:  nil,
+					},
+					AdditionalPrinterColumns: []apiextensionsv1.CustomResourceColumnDefinition{
+						{
+							Name:        "Schedule",
+							Type:        "string",
+							Description: "Schedule",
+							Priority:    int32(0),
+							JSONPath:    ".spec.schedule",
+						},
+						{
+							Name:        "NextRun",
+							Type:        "date",
+							Description: "NextRun",
+							Priority:    int32(0),
+							JSONPath:    ".status.nextRunTime",
+						},
+						{
+							Name:        "Message",
+							Type:        "string",
+							Description: "Message",
+							Priority:    int32(1),
+							JSONPath:    ".status.message",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expected19 := apiextensionsv1.CustomResourceDefinition{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "CustomResourceDefinition",
+			APIVersion: "apiextensions.k8s.io/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "tridentsnapshotpolicystates.trident.netapp.io",
+		},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: "trident.netapp.io",
+			Names: apiextensionsv1.CustomResourceDefinitionNames{
+				Plural:     "tridentsnapshotpolicystates",
+				Singular:   "tridentsnapshotpolicystate",
+				Kind:       "TridentSnapshotPolicyState",
+				ShortNames: []string{"tspstate", "tsnapshotpolicystate"},
+				Categories: []string{"trident", "trident-internal"},
+			},
+			Scope: "Namespaced",
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{
+					Name:    "v1",
+					Served:  true,
+					Storage: true,
+					Schema:  &schema1,
+					AdditionalPrinterColumns: []apiextensionsv1.CustomResourceColumnDefinition{
+						{
+							Name:        "NextRun",
+							Type:        "date",
+							Description: "NextRun",
+							Priority:    int32(0),
+							JSONPath:    ".nextRunTime",
+						},
+					},
+				},
+			},
+		},
+	}
 
 	// trident version
 	var actual1 apiextensionsv1.CustomResourceDefinition
@@ -2369,6 +2635,34 @@ func TestGetCRDsYAML(t *testing.T) {
 	assert.True(t, reflect.DeepEqual(expected15.TypeMeta, actual15.TypeMeta))
 	assert.True(t, reflect.DeepEqual(expected15.ObjectMeta, actual15.ObjectMeta))
 	assert.True(t, reflect.DeepEqual(expected15.Spec, actual15.Spec))
+
+	// trident group snapshots
+	var actual16 apiextensionsv1.CustomResourceDefinition
+	assert.Nil(t, yaml.Unmarshal([]byte(result[15]), &actual16), "invalid YAML")
+	assert.True(t, reflect.DeepEqual(expected16.TypeMeta, actual16.TypeMeta))
+	assert.True(t, reflect.DeepEqual(expected16.ObjectMeta, actual16.ObjectMeta))
+	assert.True(t, reflect.DeepEqual(expected16.Spec, actual16.Spec))
+
+	// trident action volume moves
+	var actual17 apiextensionsv1.CustomResourceDefinition
+	assert.Nil(t, yaml.Unmarshal([]byte(result[16]), &actual17), "invalid YAML")
+	assert.True(t, reflect.DeepEqual(expected17.TypeMeta, actual17.TypeMeta))
+	assert.True(t, reflect.DeepEqual(expected17.ObjectMeta, actual17.ObjectMeta))
+	assert.True(t, reflect.DeepEqual(expected17.Spec, actual17.Spec))
+
+	// trident snapshot policies
+	var actual18 apiextensionsv1.CustomResourceDefinition
+	assert.Nil(t, yaml.Unmarshal([]byte(result[17]), &actual18), "invalid YAML")
+	assert.True(t, reflect.DeepEqual(expected18.TypeMeta, actual18.TypeMeta))
+	assert.True(t, reflect.DeepEqual(expected18.ObjectMeta, actual18.ObjectMeta))
+	assert.True(t, reflect.DeepEqual(expected18.Spec, actual18.Spec))
+
+	// trident snapshot policy states
+	var actual19 apiextensionsv1.CustomResourceDefinition
+	assert.Nil(t, yaml.Unmarshal([]byte(result[18]), &actual19), "invalid YAML")
+	assert.True(t, reflect.DeepEqual(expected19.TypeMeta, actual19.TypeMeta))
+	assert.True(t, reflect.DeepEqual(expected19.ObjectMeta, actual19.ObjectMeta))
+	assert.True(t, reflect.DeepEqual(expected19.Spec, actual19.Spec))
 }
 
 func TestGetVersionCRDYAML(t *testing.T) {
@@ -3353,6 +3647,61 @@ func TestGetSnapshotCRDYAML(t *testing.T) {
 	assert.True(t, reflect.DeepEqual(expected.Spec, actual.Spec))
 }
 
+func TestGetGroupSnapshotCRDYAML(t *testing.T) {
+	preserveValue := true
+	schema := apiextensionsv1.CustomResourceValidation{
+		OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+			Type:                   "object",
+			XPreserveUnknownFields: &preserveValue,
+		},
+	}
+	expected := apiextensionsv1.CustomResourceDefinition{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "CustomResourceDefinition",
+			APIVersion: "apiextensions.k8s.io/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "tridentgroupsnapshots.trident.netapp.io",
+		},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: "trident.netapp.io",
+			Names: apiextensionsv1.CustomResourceDefinitionNames{
+				Plural:     "tridentgroupsnapshots",
+				Singular:   "tridentgroupsnapshot",
+				Kind:       "TridentGroupSnapshot",
+				ShortNames: []string{"tgsnap", "tgroupsnapshot"},
+				Categories: []string{"trident", "trident-internal"},
+			},
+			Scope: "Namespaced",
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{
+					Name:    "v1",
+					Served:  true,
+					Storage: true,
+					Schema:  &schema,
+					AdditionalPrinterColumns: []apiextensionsv1.CustomResourceColumnDefinition{
+						{
+							Name:        "Created",
+							Type:        "string",
+							Description: "The creation time of the group snapshot",
+							Priority:    int32(1),
+							JSONPath:    ".dateCreated",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	actualYAML := GetGroupSnapshotCRDYAML()
+
+	var actual apiextensionsv1.CustomResourceDefinition
+	assert.Nil(t, yaml.Unmarshal([]byte(actualYAML), &actual), "invalid YAML")
+	assert.True(t, reflect.DeepEqual(expected.TypeMeta, actual.TypeMeta))
+	assert.True(t, reflect.DeepEqual(expected.ObjectMeta, actual.ObjectMeta))
+	assert.True(t, reflect.DeepEqual(expected.Spec, actual.Spec))
+}
+
 func TestGetOrchestratorCRDYAML(t *testing.T) {
 	preserveValue := true
 	schema := apiextensionsv1.CustomResourceValidation{
@@ -3583,6 +3932,231 @@ func TestGetActionSnapshotRestoreCRDYAML(t *testing.T) {
 	assert.True(t, reflect.DeepEqual(expected.Spec, actual.Spec))
 }
 
+func TestGetActionVolumeMoveCRDYAML(t *testing.T) {
+	preserveValue := true
+	schema := apiextensionsv1.CustomResourceValidation{
+		OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+			Type:                   "object",
+			XPreserveUnknownFields: &preserveValue,
+		},
+	}
+	expected := apiextensionsv1.CustomResourceDefinition{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "CustomResourceDefinition",
+			APIVersion: "apiextensions.k8s.io/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "tridentactionvolumemoves.trident.netapp.io",
+		},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: "trident.netapp.io",
+			Names: apiextensionsv1.CustomResourceDefinitionNames{
+				Plural:     "tridentactionvolumemoves",
+				Singular:   "tridentactionvolumemove",
+				Kind:       "TridentActionVolumeMove",
+				ShortNames: []string{"tavm"},
+				Categories: []string{"trident", "trident-external"},
+			},
+			Scope: "Namespaced",
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{
+					Name:    "v1",
+					Served:  true,
+					Storage: true,
+					Schema:  &schema,
+					AdditionalPrinterColumns: []apiextensionsv1.CustomResourceColumnDefinition{
+						{
+							Name:        "Namespace",
+							Type:        "string",
+							Description: "Namespace",
+							Priority:    int32(0),
+							JSONPath:    ".metadata.namespace",
+						},
+						{
+							Name:        "PVC",
+							Type:        "string",
+							Description: "PVC",
+							Priority:    int32(0),
+							JSONPath:    ".spec.pvcName",
+						},
+						{
+							Name:        "Backend",
+							Type:        "string",
+							Description: "Backend",
+							Priority:    int32(0),
+							JSONPath:    ".spec.destinationBackend",
+						},
+						{
+							Name:        "Pool",
+							Type:        "string",
+							Description: "Pool",
+							Priority:    int32(0),
+							JSONPath:    ".spec.destinationPool",
+						},
+						{
+							Name:        "State",
+							Type:        "string",
+							Description: "State",
+							Priority:    int32(0),
+							JSONPath:    ".status.state",
+						},
+						{
+							Name:        "CompletionTime",
+							Type:        "date",
+							Description: "CompletionTime",
+							Priority:    int32(0),
+							JSONPath:    ".status.completionTime",
+						},
+						{
+							Name:        "Message",
+							Type:        "string",
+							Description: "Message",
+							Priority:    int32(1),
+							JSONPath:    ".status.message",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	actualYAML := GetActionVolumeMoveCRDYAML()
+
+	var actual apiextensionsv1.CustomResourceDefinition
+	assert.Nil(t, yaml.Unmarshal([]byte(actualYAML), &actual), "invalid YAML")
+	assert.True(t, reflect.DeepEqual(expected.TypeMeta, actual.TypeMeta))
+	assert.True(t, reflect.DeepEqual(expected.ObjectMeta, actual.ObjectMeta))
+	assert.True(t, reflect.DeepEqual(expected.Spec, actual.Spec))
+}
+
+func TestGetSnapshotPolicyCRDYAML(t *testing.T) {
+	preserveValue := true
+	schema := apiextensionsv1.CustomResourceValidation{
+		OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+			Type:                   "object",
+			XPreserveUnknownFields: &preserveValue,
+		},
+	}
+	expected := apiextensionsv1.CustomResourceDefinition{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "CustomResourceDefinition",
+			APIVersion: "apiextensions.k8s.io/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "tridentsnapshotpolicies.trident.netapp.io",
+		},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: "trident.netapp.io",
+			Names: apiextensionsv1.CustomResourceDefinitionNames{
+				Plural:     "tridentsnapshotpolicies",
+				Singular:   "tridentsnapshotpolicy",
+				Kind:       "TridentSnapshotPolicy",
+				ShortNames: []string{"tsp", "tsnappolicy"},
+				Categories: []string{"trident", "trident-external"},
+			},
+			Scope: "Namespaced",
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{
+					Name:    "v1",
+					Served:  true,
+					Storage: true,
+					Schema:  &schema,
+					Subresources: &apiextensionsv1.CustomResourceSubresources{
+						Status: &apiextensionsv1.CustomResourceSubresourceStatus{},
+						Scale:  nil,
+					},
+					AdditionalPrinterColumns: []apiextensionsv1.CustomResourceColumnDefinition{
+						{
+							Name:        "Schedule",
+							Type:        "string",
+							Description: "Schedule",
+							Priority:    int32(0),
+							JSONPath:    ".spec.schedule",
+						},
+						{
+							Name:        "NextRun",
+							Type:        "date",
+							Description: "NextRun",
+							Priority:    int32(0),
+							JSONPath:    ".status.nextRunTime",
+						},
+						{
+							Name:        "Message",
+							Type:        "string",
+							Description: "Message",
+							Priority:    int32(1),
+							JSONPath:    ".status.message",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	actualYAML := GetSnapshotPolicyCRDYAML()
+
+	var actual apiextensionsv1.CustomResourceDefinition
+	assert.Nil(t, yaml.Unmarshal([]byte(actualYAML), &actual), "invalid YAML")
+	assert.True(t, reflect.DeepEqual(expected.TypeMeta, actual.TypeMeta))
+	assert.True(t, reflect.DeepEqual(expected.ObjectMeta, actual.ObjectMeta))
+	assert.True(t, reflect.DeepEqual(expected.Spec, actual.Spec))
+}
+
+func TestGetSnapshotPolicyStateCRDYAML(t *testing.T) {
+	preserveValue := true
+	schema := apiextensionsv1.CustomResourceValidation{
+		OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+			Type:                   "object",
+			XPreserveUnknownFields: &preserveValue,
+		},
+	}
+	expected := apiextensionsv1.CustomResourceDefinition{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "CustomResourceDefinition",
+			APIVersion: "apiextensions.k8s.io/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "tridentsnapshotpolicystates.trident.netapp.io",
+		},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: "trident.netapp.io",
+			Names: apiextensionsv1.CustomResourceDefinitionNames{
+				Plural:     "tridentsnapshotpolicystates",
+				Singular:   "tridentsnapshotpolicystate",
+				Kind:       "TridentSnapshotPolicyState",
+				ShortNames: []string{"tspstate", "tsnapshotpolicystate"},
+				Categories: []string{"trident", "trident-internal"},
+			},
+			Scope: "Namespaced",
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{
+					Name:    "v1",
+					Served:  true,
+					Storage: true,
+					Schema:  &schema,
+					AdditionalPrinterColumns: []apiextensionsv1.CustomResourceColumnDefinition{
+						{
+							Name:        "NextRun",
+							Type:        "date",
+							Description: "NextRun",
+							Priority:    int32(0),
+							JSONPath:    ".nextRunTime",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	actualYAML := GetSnapshotPolicyStateCRDYAML()
+
+	var actual apiextensionsv1.CustomResourceDefinition
+	assert.Nil(t, yaml.Unmarshal([]byte(actualYAML), &actual), "invalid YAML")
+	assert.True(t, reflect.DeepEqual(expected.TypeMeta, actual.TypeMeta))
+	assert.True(t, reflect.DeepEqual(expected.ObjectMeta, actual.ObjectMeta))
+	assert.True(t, reflect.DeepEqual(expected.Spec, actual.Spec))
+}
+
 func TestGetCSIDriverYAML(t *testing.T) {
 	name := "csi.trident.netapp.io"
 	required := true
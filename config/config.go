@@ -192,6 +192,13 @@ const (
 	// BackendStoragePollInterval is an interval  that core layer attempts to poll storage backend periodically
 	BackendStoragePollInterval = 300 * time.Second
 
+	// VolumeHealthPollInterval is the interval at which the core layer checks the condition of each volume
+	VolumeHealthPollInterval = 300 * time.Second
+
+	// SnapshotPolicyPollInterval is the interval at which the core layer checks whether any snapshot policy is due,
+	// which is also the finest granularity at which snapshot schedules are honored
+	SnapshotPolicyPollInterval = 1 * time.Minute
+
 	// NVMeSelfHealingInterval is an interval with which the NVMe self-healing thread is called periodically
 	NVMeSelfHealingInterval = 300 * time.Second
 )
@@ -224,18 +231,21 @@ var (
 	OrchestratorVersion = versionutils.MustParseDate(version())
 
 	/* API Server and persistent store variables */
-	BaseURL          = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion
-	VersionURL       = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/version"
-	BackendURL       = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/backend"
-	BackendUUIDURL   = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/backendUUID"
-	VolumeURL        = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/volume"
-	TransactionURL   = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/txn"
-	StorageClassURL  = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/storageclass"
-	NodeURL          = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/node"
-	SnapshotURL      = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/snapshot"
-	ChapURL          = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/chap"
-	PublicationURL   = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/publication"
-	LoggingConfigURL = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/logging"
+	BaseURL           = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion
+	VersionURL        = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/version"
+	BackendURL        = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/backend"
+	BackendUUIDURL    = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/backendUUID"
+	VolumeURL         = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/volume"
+	TransactionURL    = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/txn"
+	StorageClassURL   = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/storageclass"
+	NodeURL           = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/node"
+	SnapshotURL       = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/snapshot"
+	SnapshotPolicyURL = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/snapshotpolicy"
+	ChapURL           = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/chap"
+	PublicationURL    = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/publication"
+	OrphanURL         = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/orphan"
+	LoggingConfigURL  = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/logging"
+	EventURL          = "/" + OrchestratorName + "/v" + OrchestratorAPIVersion + "/event"
 
 	UsingPassthroughStore bool
 	CurrentDriverContext  DriverContext
@@ -0,0 +1,370 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package core
+
+import (
+	"context"
+
+	"github.com/netapp/trident/config"
+	. "github.com/netapp/trident/logging"
+	persistentstore "github.com/netapp/trident/persistent_store"
+	"github.com/netapp/trident/storage"
+	storageclass "github.com/netapp/trident/storage_class"
+	"github.com/netapp/trident/utils"
+)
+
+// Kinds of objects audited that are not reported as events
+const (
+	auditKindGroupSnapshot  = "groupsnapshot"
+	auditKindSnapshotPolicy = "snapshotpolicy"
+	auditKindTransaction    = "transaction"
+	auditKindVersion        = "version"
+
+	// auditAllObjects names the objects removed by the store's bulk deletions
+	auditAllObjects = "*"
+)
+
+// backendAuditSummary is the part of a backend that is audited.  Backend configurations are left out, as they
+// may hold credentials that the redactor cannot recognize.
+type backendAuditSummary struct {
+	Name        string                   `json:"name"`
+	BackendUUID string                   `json:"backendUUID"`
+	Online      bool                     `json:"online"`
+	State       storage.BackendState     `json:"state"`
+	UserState   storage.UserBackendState `json:"userState"`
+	StateReason string                   `json:"stateReason"`
+	ConfigRef   string                   `json:"configRef"`
+}
+
+func newBackendAuditSummary(backend *storage.BackendPersistent) *backendAuditSummary {
+	if backend == nil {
+		return nil
+	}
+	return &backendAuditSummary{
+		Name:        backend.Name,
+		BackendUUID: backend.BackendUUID,
+		Online:      backend.Online,
+		State:       backend.State,
+		UserState:   backend.UserState,
+		StateReason: backend.StateReason,
+		ConfigRef:   backend.ConfigRef,
+	}
+}
+
+// transactionAuditSummary is the part of a volume transaction that is audited; the volume configurations it
+// holds are audited when the volumes themselves change.
+type transactionAuditSummary struct {
+	Name string                  `json:"name"`
+	Op   storage.VolumeOperation `json:"op"`
+}
+
+func newTransactionAuditSummary(txn *storage.VolumeTransaction) *transactionAuditSummary {
+	if txn == nil {
+		return nil
+	}
+	return &transactionAuditSummary{Name: txn.Name(), Op: txn.Op}
+}
+
+// auditStoreClient writes an audit record for each change it makes, or fails to make, to the persistent store
+// it wraps.  Every change to the orchestrator's state passes through the store, whether asked for by a
+// frontend or made by the orchestrator itself, such as when reaping transactions or reconciling backends.
+// Updates read the object from the store first, so that the record shows what changed.
+type auditStoreClient struct {
+	persistentstore.Client
+}
+
+func newAuditStoreClient(client persistentstore.Client) persistentstore.Client {
+	if client == nil {
+		return nil
+	}
+	return &auditStoreClient{Client: client}
+}
+
+func (c *auditStoreClient) record(
+	ctx context.Context, operation EventType, kind, name string, before, after interface{}, err error,
+) {
+	record := &AuditRecord{
+		Event:      AuditStateChange,
+		Operation:  string(operation),
+		ObjectKind: kind,
+		ObjectName: name,
+		Outcome:    AuditSuccess,
+	}
+	if err != nil {
+		record.Outcome = AuditFailure
+		record.Error = err.Error()
+	}
+	record.Before, record.After = AuditChanges(before, after)
+	Audit().Record(ctx, record)
+}
+
+func (c *auditStoreClient) SetVersion(ctx context.Context, version *config.PersistentStateVersion) error {
+	if !Audit().Enabled() {
+		return c.Client.SetVersion(ctx, version)
+	}
+	operation := EventUpdated
+	before, getErr := c.Client.GetVersion(ctx)
+	if getErr != nil {
+		operation, before = EventAdded, nil
+	}
+	err := c.Client.SetVersion(ctx, version)
+	c.record(ctx, operation, auditKindVersion, "", before, version, err)
+	return err
+}
+
+func (c *auditStoreClient) AddBackend(ctx context.Context, b storage.Backend) error {
+	err := c.Client.AddBackend(ctx, b)
+	if Audit().Enabled() {
+		c.record(ctx, EventAdded, string(EventKindBackend), b.Name(), nil,
+			newBackendAuditSummary(b.ConstructPersistent(ctx)), err)
+	}
+	return err
+}
+
+func (c *auditStoreClient) UpdateBackend(ctx context.Context, b storage.Backend) error {
+	if !Audit().Enabled() {
+		return c.Client.UpdateBackend(ctx, b)
+	}
+	before, _ := c.Client.GetBackend(ctx, b.Name())
+	err := c.Client.UpdateBackend(ctx, b)
+	c.record(ctx, EventUpdated, string(EventKindBackend), b.Name(), newBackendAuditSummary(before),
+		newBackendAuditSummary(b.ConstructPersistent(ctx)), err)
+	return err
+}
+
+func (c *auditStoreClient) DeleteBackend(ctx context.Context, b storage.Backend) error {
+	err := c.Client.DeleteBackend(ctx, b)
+	if Audit().Enabled() {
+		c.record(ctx, EventDeleted, string(EventKindBackend), b.Name(),
+			newBackendAuditSummary(b.ConstructPersistent(ctx)), nil, err)
+	}
+	return err
+}
+
+func (c *auditStoreClient) DeleteBackends(ctx context.Context) error {
+	err := c.Client.DeleteBackends(ctx)
+	if Audit().Enabled() {
+		c.record(ctx, EventDeleted, string(EventKindBackend), auditAllObjects, nil, nil, err)
+	}
+	return err
+}
+
+func (c *auditStoreClient) ReplaceBackendAndUpdateVolumes(
+	ctx context.Context, origBackend, newBackend storage.Backend,
+) error {
+	err := c.Client.ReplaceBackendAndUpdateVolumes(ctx, origBackend, newBackend)
+	if Audit().Enabled() {
+		c.record(ctx, EventUpdated, string(EventKindBackend), origBackend.Name(),
+			newBackendAuditSummary(origBackend.ConstructPersistent(ctx)),
+			newBackendAuditSummary(newBackend.ConstructPersistent(ctx)), err)
+	}
+	return err
+}
+
+func (c *auditStoreClient) AddVolume(ctx context.Context, vol *storage.Volume) error {
+	err := c.Client.AddVolume(ctx, vol)
+	if Audit().Enabled() {
+		c.record(ctx, EventAdded, string(EventKindVolume), vol.Config.Name, nil, vol.ConstructExternal(), err)
+	}
+	return err
+}
+
+func (c *auditStoreClient) UpdateVolume(ctx context.Context, vol *storage.Volume) error {
+	if !Audit().Enabled() {
+		return c.Client.UpdateVolume(ctx, vol)
+	}
+	before, _ := c.Client.GetVolume(ctx, vol.Config.Name)
+	err := c.Client.UpdateVolume(ctx, vol)
+	c.record(ctx, EventUpdated, string(EventKindVolume), vol.Config.Name, before, vol.ConstructExternal(), err)
+	return err
+}
+
+func (c *auditStoreClient) DeleteVolume(ctx context.Context, vol *storage.Volume) error {
+	err := c.Client.DeleteVolume(ctx, vol)
+	if Audit().Enabled() {
+		c.record(ctx, EventDeleted, string(EventKindVolume), vol.Config.Name, vol.ConstructExternal(), nil, err)
+	}
+	return err
+}
+
+func (c *auditStoreClient) DeleteVolumes(ctx context.Context) error {
+	err := c.Client.DeleteVolumes(ctx)
+	if Audit().Enabled() {
+		c.record(ctx, EventDeleted, string(EventKindVolume), auditAllObjects, nil, nil, err)
+	}
+	return err
+}
+
+func (c *auditStoreClient) AddVolumeTransaction(ctx context.Context, volTxn *storage.VolumeTransaction) error {
+	err := c.Client.AddVolumeTransaction(ctx, volTxn)
+	if Audit().Enabled() {
+		c.record(ctx, EventAdded, auditKindTransaction, volTxn.Name(), nil, newTransactionAuditSummary(volTxn), err)
+	}
+	return err
+}
+
+func (c *auditStoreClient) UpdateVolumeTransaction(ctx context.Context, volTxn *storage.VolumeTransaction) error {
+	if !Audit().Enabled() {
+		return c.Client.UpdateVolumeTransaction(ctx, volTxn)
+	}
+	before, _ := c.Client.GetVolumeTransaction(ctx, volTxn)
+	err := c.Client.UpdateVolumeTransaction(ctx, volTxn)
+	c.record(ctx, EventUpdated, auditKindTransaction, volTxn.Name(), newTransactionAuditSummary(before),
+		newTransactionAuditSummary(volTxn), err)
+	return err
+}
+
+func (c *auditStoreClient) DeleteVolumeTransaction(ctx context.Context, volTxn *storage.VolumeTransaction) error {
+	err := c.Client.DeleteVolumeTransaction(ctx, volTxn)
+	if Audit().Enabled() {
+		c.record(ctx, EventDeleted, auditKindTransaction, volTxn.Name(), newTransactionAuditSummary(volTxn), nil, err)
+	}
+	return err
+}
+
+func (c *auditStoreClient) AddStorageClass(ctx context.Context, sc *storageclass.StorageClass) error {
+	err := c.Client.AddStorageClass(ctx, sc)
+	if Audit().Enabled() {
+		c.record(ctx, EventAdded, string(EventKindStorageClass), sc.GetName(), nil, sc.ConstructPersistent(), err)
+	}
+	return err
+}
+
+func (c *auditStoreClient) DeleteStorageClass(ctx context.Context, sc *storageclass.StorageClass) error {
+	err := c.Client.DeleteStorageClass(ctx, sc)
+	if Audit().Enabled() {
+		c.record(ctx, EventDeleted, string(EventKindStorageClass), sc.GetName(), sc.ConstructPersistent(), nil, err)
+	}
+	return err
+}
+
+func (c *auditStoreClient) AddOrUpdateNode(ctx context.Context, node *utils.Node) error {
+	if !Audit().Enabled() {
+		return c.Client.AddOrUpdateNode(ctx, node)
+	}
+	operation := EventUpdated
+	var before *utils.NodeExternal
+	if existing, getErr := c.Client.GetNode(ctx, node.Name); getErr != nil {
+		operation = EventAdded
+	} else {
+		before = existing.ConstructExternal()
+	}
+	err := c.Client.AddOrUpdateNode(ctx, node)
+	c.record(ctx, operation, string(EventKindNode), node.Name, before, node.ConstructExternal(), err)
+	return err
+}
+
+func (c *auditStoreClient) DeleteNode(ctx context.Context, node *utils.Node) error {
+	err := c.Client.DeleteNode(ctx, node)
+	if Audit().Enabled() {
+		c.record(ctx, EventDeleted, string(EventKindNode), node.Name, node.ConstructExternal(), nil, err)
+	}
+	return err
+}
+
+func (c *auditStoreClient) AddVolumePublication(ctx context.Context, vp *utils.VolumePublication) error {
+	err := c.Client.AddVolumePublication(ctx, vp)
+	if Audit().Enabled() {
+		c.record(ctx, EventAdded, string(EventKindVolumePublication), vp.Name, nil, vp.ConstructExternal(), err)
+	}
+	return err
+}
+
+func (c *auditStoreClient) UpdateVolumePublication(ctx context.Context, vp *utils.VolumePublication) error {
+	if !Audit().Enabled() {
+		return c.Client.UpdateVolumePublication(ctx, vp)
+	}
+	var before *utils.VolumePublicationExternal
+	if existing, getErr := c.Client.GetVolumePublication(ctx, vp.Name); getErr == nil {
+		before = existing.ConstructExternal()
+	}
+	err := c.Client.UpdateVolumePublication(ctx, vp)
+	c.record(ctx, EventUpdated, string(EventKindVolumePublication), vp.Name, before, vp.ConstructExternal(), err)
+	return err
+}
+
+func (c *auditStoreClient) DeleteVolumePublication(ctx context.Context, vp *utils.VolumePublication) error {
+	err := c.Client.DeleteVolumePublication(ctx, vp)
+	if Audit().Enabled() {
+		c.record(ctx, EventDeleted, string(EventKindVolumePublication), vp.Name, vp.ConstructExternal(), nil, err)
+	}
+	return err
+}
+
+func (c *auditStoreClient) AddSnapshot(ctx context.Context, snapshot *storage.Snapshot) error {
+	err := c.Client.AddSnapshot(ctx, snapshot)
+	if Audit().Enabled() {
+		c.record(ctx, EventAdded, string(EventKindSnapshot), snapshot.ID(), nil, snapshot.ConstructExternal(), err)
+	}
+	return err
+}
+
+func (c *auditStoreClient) UpdateSnapshot(ctx context.Context, snapshot *storage.Snapshot) error {
+	if !Audit().Enabled() {
+		return c.Client.UpdateSnapshot(ctx, snapshot)
+	}
+	before, _ := c.Client.GetSnapshot(ctx, snapshot.Config.VolumeName, snapshot.Config.Name)
+	err := c.Client.UpdateSnapshot(ctx, snapshot)
+	c.record(ctx, EventUpdated, string(EventKindSnapshot), snapshot.ID(), before, snapshot.ConstructPersistent(), err)
+	return err
+}
+
+func (c *auditStoreClient) DeleteSnapshot(ctx context.Context, snapshot *storage.Snapshot) error {
+	err := c.Client.DeleteSnapshot(ctx, snapshot)
+	if Audit().Enabled() {
+		c.record(ctx, EventDeleted, string(EventKindSnapshot), snapshot.ID(), snapshot.ConstructExternal(), nil, err)
+	}
+	return err
+}
+
+func (c *auditStoreClient) DeleteSnapshots(ctx context.Context) error {
+	err := c.Client.DeleteSnapshots(ctx)
+	if Audit().Enabled() {
+		c.record(ctx, EventDeleted, string(EventKindSnapshot), auditAllObjects, nil, nil, err)
+	}
+	return err
+}
+
+func (c *auditStoreClient) AddGroupSnapshot(ctx context.Context, groupSnapshot *storage.GroupSnapshot) error {
+	err := c.Client.AddGroupSnapshot(ctx, groupSnapshot)
+	if Audit().Enabled() {
+		c.record(ctx, EventAdded, auditKindGroupSnapshot, groupSnapshot.ID(), nil,
+			groupSnapshot.ConstructExternal(), err)
+	}
+	return err
+}
+
+func (c *auditStoreClient) DeleteGroupSnapshot(ctx context.Context, groupSnapshot *storage.GroupSnapshot) error {
+	err := c.Client.DeleteGroupSnapshot(ctx, groupSnapshot)
+	if Audit().Enabled() {
+		c.record(ctx, EventDeleted, auditKindGroupSnapshot, groupSnapshot.ID(), groupSnapshot.ConstructExternal(),
+			nil, err)
+	}
+	return err
+}
+
+func (c *auditStoreClient) AddSnapshotPolicy(ctx context.Context, policy *storage.SnapshotPolicy) error {
+	err := c.Client.AddSnapshotPolicy(ctx, policy)
+	if Audit().Enabled() {
+		c.record(ctx, EventAdded, auditKindSnapshotPolicy, policy.ID(), nil, policy.ConstructExternal(), err)
+	}
+	return err
+}
+
+func (c *auditStoreClient) UpdateSnapshotPolicy(ctx context.Context, policy *storage.SnapshotPolicy) error {
+	if !Audit().Enabled() {
+		return c.Client.UpdateSnapshotPolicy(ctx, policy)
+	}
+	before, _ := c.Client.GetSnapshotPolicy(ctx, policy.ID())
+	err := c.Client.UpdateSnapshotPolicy(ctx, policy)
+	c.record(ctx, EventUpdated, auditKindSnapshotPolicy, policy.ID(), before, policy.ConstructPersistent(), err)
+	return err
+}
+
+func (c *auditStoreClient) DeleteSnapshotPolicy(ctx context.Context, policy *storage.SnapshotPolicy) error {
+	err := c.Client.DeleteSnapshotPolicy(ctx, policy)
+	if Audit().Enabled() {
+		c.record(ctx, EventDeleted, auditKindSnapshotPolicy, policy.ID(), policy.ConstructExternal(), nil, err)
+	}
+	return err
+}
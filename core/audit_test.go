@@ -0,0 +1,97 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package core
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netapp/trident/config"
+	. "github.com/netapp/trident/logging"
+	tu "github.com/netapp/trident/storage_drivers/fake/test_utils"
+)
+
+// testAuditSink keeps the audit records written to it.
+type testAuditSink struct {
+	records []*AuditRecord
+}
+
+func (s *testAuditSink) Name() string { return "test" }
+
+func (s *testAuditSink) Write(record []byte) error {
+	auditRecord := &AuditRecord{}
+	if err := json.Unmarshal(record, auditRecord); err != nil {
+		return err
+	}
+	s.records = append(s.records, auditRecord)
+	return nil
+}
+
+func (s *testAuditSink) Close() error { return nil }
+
+// find returns the records for an object.
+func (s *testAuditSink) find(kind EventKind, name string) []*AuditRecord {
+	records := make([]*AuditRecord, 0)
+	for _, record := range s.records {
+		if record.ObjectKind == string(kind) && record.ObjectName == name {
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+func TestAuditStoreClient(t *testing.T) {
+	const (
+		backendName = "auditBackend"
+		scName      = "auditSC"
+		volumeName  = "auditVolume"
+	)
+	o := getOrchestrator(t, false)
+	defer cleanup(t, o)
+
+	sink := &testAuditSink{}
+	InitAuditLogger(true, sink)
+	defer InitAuditLogger(true)
+
+	addBackendStorageClass(t, o, backendName, scName, config.File)
+	records := sink.find(EventKindBackend, backendName)
+	if assert.Len(t, records, 1) {
+		assert.Equal(t, string(EventAdded), records[0].Operation)
+		assert.Equal(t, AuditSuccess, records[0].Outcome)
+		assert.Nil(t, records[0].Before)
+		assert.Equal(t, backendName, records[0].After["name"])
+		assert.NotContains(t, records[0].After, "config", "Backend configurations should not be audited")
+	}
+
+	ctx := ContextWithAuditActor(ctx(), "alice")
+	_, err := o.AddVolume(ctx, tu.GenerateVolumeConfig(volumeName, 1, scName, config.File))
+	assert.NoError(t, err)
+	_, err = o.UpdateBackendState(ctx, backendName, "", "suspended")
+	assert.NoError(t, err)
+	assert.NoError(t, o.DeleteVolume(ctx, volumeName))
+
+	records = sink.find(EventKindVolume, volumeName)
+	if assert.Len(t, records, 2) {
+		assert.Equal(t, string(EventAdded), records[0].Operation)
+		assert.Equal(t, "alice", records[0].Actor)
+		assert.Equal(t, volumeName, records[0].After["Config.name"])
+
+		assert.Equal(t, string(EventDeleted), records[1].Operation)
+		assert.Equal(t, "alice", records[1].Actor)
+		assert.Equal(t, volumeName, records[1].Before["Config.name"])
+		assert.Nil(t, records[1].After)
+	}
+
+	// Updates show only what changed
+	records = sink.find(EventKindBackend, backendName)
+	if assert.Len(t, records, 2) {
+		assert.Equal(t, string(EventUpdated), records[1].Operation)
+		assert.Equal(t, map[string]interface{}{"userState": "normal"}, records[1].Before)
+		assert.Equal(t, map[string]interface{}{"userState": "suspended"}, records[1].After)
+	}
+
+	// Transactions around the volume changes are audited too
+	assert.NotEmpty(t, sink.find(auditKindTransaction, volumeName))
+}
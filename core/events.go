@@ -0,0 +1,334 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package core
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	. "github.com/netapp/trident/logging"
+	persistentstore "github.com/netapp/trident/persistent_store"
+	"github.com/netapp/trident/storage"
+	storageclass "github.com/netapp/trident/storage_class"
+	"github.com/netapp/trident/utils"
+	"github.com/netapp/trident/utils/errors"
+)
+
+// EventType says what happened to the object named by an event.
+type EventType string
+
+const (
+	EventAdded   = EventType("added")
+	EventUpdated = EventType("updated")
+	EventDeleted = EventType("deleted")
+)
+
+// EventKind is the kind of object named by an event.
+type EventKind string
+
+const (
+	EventKindBackend           = EventKind("backend")
+	EventKindVolume            = EventKind("volume")
+	EventKindSnapshot          = EventKind("snapshot")
+	EventKindNode              = EventKind("node")
+	EventKindVolumePublication = EventKind("publication")
+	EventKindStorageClass      = EventKind("storageclass")
+)
+
+const (
+	// eventHistorySize is the number of past events kept so that subscribers may resume after disconnecting
+	eventHistorySize = 1000
+	// eventSubscriberBuffer is the number of events a subscriber may fall behind before it is dropped
+	eventSubscriberBuffer = 256
+)
+
+// Event reports a change to an object in the persistent store.  Sequence numbers increase by one with each
+// event, starting again from one whenever Trident starts.  Object is the external form of the object, as
+// returned by the orchestrator's Get methods; for deletions, it is the last form known.
+type Event struct {
+	Sequence  uint64      `json:"sequence"`
+	Type      EventType   `json:"type"`
+	Kind      EventKind   `json:"kind"`
+	Name      string      `json:"name"`
+	Timestamp time.Time   `json:"timestamp"`
+	Object    interface{} `json:"object,omitempty"`
+}
+
+// EventSubscription delivers events to one subscriber.  Events is closed when the subscription is closed,
+// or if the subscriber falls too far behind, in which case it may subscribe again from the last sequence
+// number it received.
+type EventSubscription struct {
+	Events <-chan *Event
+	close  func()
+}
+
+// NewEventSubscription returns a subscription delivering the specified events, which calls closer when
+// closed.
+func NewEventSubscription(events <-chan *Event, closer func()) *EventSubscription {
+	return &EventSubscription{Events: events, close: closer}
+}
+
+// Close ends the subscription.  It is safe to call more than once.
+func (s *EventSubscription) Close() {
+	s.close()
+}
+
+// eventBus fans out events published by the persistent store update paths to all subscribers, and keeps a
+// bounded history of recent events for subscribers resuming from an earlier sequence number.
+type eventBus struct {
+	mutex       sync.Mutex
+	sequence    uint64
+	history     []*Event
+	subscribers map[chan *Event]struct{}
+}
+
+func newEventBus() *eventBus {
+	return &eventBus{
+		history:     make([]*Event, 0, eventHistorySize),
+		subscribers: make(map[chan *Event]struct{}),
+	}
+}
+
+func (b *eventBus) publish(ctx context.Context, eventType EventType, kind EventKind, name string, object interface{}) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.sequence++
+	event := &Event{
+		Sequence:  b.sequence,
+		Type:      eventType,
+		Kind:      kind,
+		Name:      name,
+		Timestamp: time.Now(),
+		Object:    object,
+	}
+
+	if len(b.history) == eventHistorySize {
+		copy(b.history, b.history[1:])
+		b.history = b.history[:eventHistorySize-1]
+	}
+	b.history = append(b.history, event)
+
+	for events := range b.subscribers {
+		select {
+		case events <- event:
+		default:
+			Logc(ctx).WithField("sequence", event.Sequence).Warning("Event subscriber fell behind, dropping it.")
+			delete(b.subscribers, events)
+			close(events)
+		}
+	}
+}
+
+// subscribe returns a subscription to events after the specified sequence number, or to new events only if
+// it is zero.  A NotFoundError is returned if the events after that sequence number are no longer held.
+func (b *eventBus) subscribe(since uint64) (*EventSubscription, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	var replay []*Event
+	if since > b.sequence {
+		return nil, errors.NotFoundError("event %d has not been sent; Trident may have restarted since", since)
+	} else if since > 0 && since < b.sequence {
+		if oldest := b.history[0].Sequence; since+1 < oldest {
+			return nil, errors.NotFoundError("events after %d are no longer available; the oldest is %d",
+				since, oldest)
+		}
+		replay = b.history[len(b.history)-int(b.sequence-since):]
+	}
+
+	events := make(chan *Event, eventSubscriberBuffer+len(replay))
+	for _, event := range replay {
+		events <- event
+	}
+	b.subscribers[events] = struct{}{}
+
+	return NewEventSubscription(events, func() { b.unsubscribe(events) }), nil
+}
+
+func (b *eventBus) unsubscribe(events chan *Event) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if _, ok := b.subscribers[events]; ok {
+		delete(b.subscribers, events)
+		close(events)
+	}
+}
+
+// eventStoreClient publishes an event for each object it successfully adds, updates or deletes in the
+// persistent store it wraps.
+type eventStoreClient struct {
+	persistentstore.Client
+	events *eventBus
+}
+
+func newEventStoreClient(client persistentstore.Client, events *eventBus) persistentstore.Client {
+	if client == nil {
+		return nil
+	}
+	return &eventStoreClient{Client: client, events: events}
+}
+
+func (c *eventStoreClient) AddBackend(ctx context.Context, b storage.Backend) error {
+	if err := c.Client.AddBackend(ctx, b); err != nil {
+		return err
+	}
+	c.events.publish(ctx, EventAdded, EventKindBackend, b.Name(), b.ConstructExternal(ctx))
+	return nil
+}
+
+func (c *eventStoreClient) UpdateBackend(ctx context.Context, b storage.Backend) error {
+	if err := c.Client.UpdateBackend(ctx, b); err != nil {
+		return err
+	}
+	c.events.publish(ctx, EventUpdated, EventKindBackend, b.Name(), b.ConstructExternal(ctx))
+	return nil
+}
+
+func (c *eventStoreClient) DeleteBackend(ctx context.Context, b storage.Backend) error {
+	if err := c.Client.DeleteBackend(ctx, b); err != nil {
+		return err
+	}
+	c.events.publish(ctx, EventDeleted, EventKindBackend, b.Name(), b.ConstructExternal(ctx))
+	return nil
+}
+
+func (c *eventStoreClient) ReplaceBackendAndUpdateVolumes(
+	ctx context.Context, origBackend, newBackend storage.Backend,
+) error {
+	if err := c.Client.ReplaceBackendAndUpdateVolumes(ctx, origBackend, newBackend); err != nil {
+		return err
+	}
+	if origBackend.Name() != newBackend.Name() {
+		c.events.publish(ctx, EventDeleted, EventKindBackend, origBackend.Name(), origBackend.ConstructExternal(ctx))
+		c.events.publish(ctx, EventAdded, EventKindBackend, newBackend.Name(), newBackend.ConstructExternal(ctx))
+	} else {
+		c.events.publish(ctx, EventUpdated, EventKindBackend, newBackend.Name(), newBackend.ConstructExternal(ctx))
+	}
+	return nil
+}
+
+func (c *eventStoreClient) AddVolume(ctx context.Context, vol *storage.Volume) error {
+	if err := c.Client.AddVolume(ctx, vol); err != nil {
+		return err
+	}
+	c.events.publish(ctx, EventAdded, EventKindVolume, vol.Config.Name, vol.ConstructExternal())
+	return nil
+}
+
+func (c *eventStoreClient) UpdateVolume(ctx context.Context, vol *storage.Volume) error {
+	if err := c.Client.UpdateVolume(ctx, vol); err != nil {
+		return err
+	}
+	c.events.publish(ctx, EventUpdated, EventKindVolume, vol.Config.Name, vol.ConstructExternal())
+	return nil
+}
+
+func (c *eventStoreClient) DeleteVolume(ctx context.Context, vol *storage.Volume) error {
+	if err := c.Client.DeleteVolume(ctx, vol); err != nil {
+		return err
+	}
+	c.events.publish(ctx, EventDeleted, EventKindVolume, vol.Config.Name, vol.ConstructExternal())
+	return nil
+}
+
+func (c *eventStoreClient) AddSnapshot(ctx context.Context, snapshot *storage.Snapshot) error {
+	if err := c.Client.AddSnapshot(ctx, snapshot); err != nil {
+		return err
+	}
+	c.events.publish(ctx, EventAdded, EventKindSnapshot, snapshot.ID(), snapshot.ConstructExternal())
+	return nil
+}
+
+func (c *eventStoreClient) UpdateSnapshot(ctx context.Context, snapshot *storage.Snapshot) error {
+	if err := c.Client.UpdateSnapshot(ctx, snapshot); err != nil {
+		return err
+	}
+	c.events.publish(ctx, EventUpdated, EventKindSnapshot, snapshot.ID(), snapshot.ConstructExternal())
+	return nil
+}
+
+func (c *eventStoreClient) DeleteSnapshot(ctx context.Context, snapshot *storage.Snapshot) error {
+	if err := c.Client.DeleteSnapshot(ctx, snapshot); err != nil {
+		return err
+	}
+	c.events.publish(ctx, EventDeleted, EventKindSnapshot, snapshot.ID(), snapshot.ConstructExternal())
+	return nil
+}
+
+func (c *eventStoreClient) AddStorageClass(ctx context.Context, sc *storageclass.StorageClass) error {
+	if err := c.Client.AddStorageClass(ctx, sc); err != nil {
+		return err
+	}
+	c.events.publish(ctx, EventAdded, EventKindStorageClass, sc.GetName(), sc.ConstructExternal(ctx))
+	return nil
+}
+
+func (c *eventStoreClient) DeleteStorageClass(ctx context.Context, sc *storageclass.StorageClass) error {
+	if err := c.Client.DeleteStorageClass(ctx, sc); err != nil {
+		return err
+	}
+	c.events.publish(ctx, EventDeleted, EventKindStorageClass, sc.GetName(), sc.ConstructExternal(ctx))
+	return nil
+}
+
+// AddOrUpdateNode reads the node first, since only the store knows whether it is new.
+func (c *eventStoreClient) AddOrUpdateNode(ctx context.Context, node *utils.Node) error {
+	eventType := EventUpdated
+	if _, err := c.Client.GetNode(ctx, node.Name); err != nil {
+		eventType = EventAdded
+	}
+	if err := c.Client.AddOrUpdateNode(ctx, node); err != nil {
+		return err
+	}
+	c.events.publish(ctx, eventType, EventKindNode, node.Name, node.ConstructExternal())
+	return nil
+}
+
+func (c *eventStoreClient) DeleteNode(ctx context.Context, node *utils.Node) error {
+	if err := c.Client.DeleteNode(ctx, node); err != nil {
+		return err
+	}
+	c.events.publish(ctx, EventDeleted, EventKindNode, node.Name, node.ConstructExternal())
+	return nil
+}
+
+func (c *eventStoreClient) AddVolumePublication(ctx context.Context, vp *utils.VolumePublication) error {
+	if err := c.Client.AddVolumePublication(ctx, vp); err != nil {
+		return err
+	}
+	c.events.publish(ctx, EventAdded, EventKindVolumePublication, vp.Name, vp.ConstructExternal())
+	return nil
+}
+
+func (c *eventStoreClient) UpdateVolumePublication(ctx context.Context, vp *utils.VolumePublication) error {
+	if err := c.Client.UpdateVolumePublication(ctx, vp); err != nil {
+		return err
+	}
+	c.events.publish(ctx, EventUpdated, EventKindVolumePublication, vp.Name, vp.ConstructExternal())
+	return nil
+}
+
+func (c *eventStoreClient) DeleteVolumePublication(ctx context.Context, vp *utils.VolumePublication) error {
+	if err := c.Client.DeleteVolumePublication(ctx, vp); err != nil {
+		return err
+	}
+	c.events.publish(ctx, EventDeleted, EventKindVolumePublication, vp.Name, vp.ConstructExternal())
+	return nil
+}
+
+// SubscribeEvents returns a subscription to changes made by the orchestrator to its persistent store, after
+// the specified sequence number, or from now on if it is zero.
+func (o *TridentOrchestrator) SubscribeEvents(ctx context.Context, since uint64) (*EventSubscription, error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return nil, o.bootstrapError
+	}
+
+	Logc(ctx).WithField("since", since).Debug("Subscribing to orchestrator events.")
+
+	return o.events.subscribe(since)
+}
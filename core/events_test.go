@@ -0,0 +1,151 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netapp/trident/config"
+	tu "github.com/netapp/trident/storage_drivers/fake/test_utils"
+	"github.com/netapp/trident/utils/errors"
+)
+
+// receiveEvents reads the specified number of events from a subscription, failing if they do not arrive.
+func receiveEvents(t *testing.T, subscription *EventSubscription, count int) []*Event {
+	events := make([]*Event, 0, count)
+	for len(events) < count {
+		select {
+		case event, ok := <-subscription.Events:
+			if !ok {
+				t.Fatalf("Subscription closed after %d of %d events", len(events), count)
+			}
+			events = append(events, event)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Received %d of %d events", len(events), count)
+		}
+	}
+	return events
+}
+
+func TestEventBus_Subscribe(t *testing.T) {
+	bus := newEventBus()
+	bus.publish(ctx(), EventAdded, EventKindVolume, "vol1", nil)
+
+	// A subscription from zero receives only new events
+	subscription, err := bus.subscribe(0)
+	assert.NoError(t, err)
+	bus.publish(ctx(), EventUpdated, EventKindVolume, "vol1", nil)
+	bus.publish(ctx(), EventDeleted, EventKindVolume, "vol1", nil)
+	events := receiveEvents(t, subscription, 2)
+	assert.Equal(t, uint64(2), events[0].Sequence)
+	assert.Equal(t, EventUpdated, events[0].Type)
+	assert.Equal(t, uint64(3), events[1].Sequence)
+	assert.Equal(t, EventDeleted, events[1].Type)
+
+	subscription.Close()
+	subscription.Close()
+	_, ok := <-subscription.Events
+	assert.False(t, ok, "Closed subscription should have no more events")
+	assert.Empty(t, bus.subscribers)
+
+	// A subscription from an earlier sequence number replays the events after it
+	subscription, err = bus.subscribe(1)
+	assert.NoError(t, err)
+	defer subscription.Close()
+	events = receiveEvents(t, subscription, 2)
+	assert.Equal(t, uint64(2), events[0].Sequence)
+	assert.Equal(t, uint64(3), events[1].Sequence)
+
+	// A subscription from the latest sequence number receives only new events
+	latest, err := bus.subscribe(3)
+	assert.NoError(t, err)
+	defer latest.Close()
+	assert.Empty(t, latest.Events)
+
+	_, err = bus.subscribe(4)
+	assert.True(t, errors.IsNotFoundError(err), "Expected a sequence number not yet sent to be rejected")
+}
+
+func TestEventBus_HistoryExpired(t *testing.T) {
+	bus := newEventBus()
+	for i := 0; i < eventHistorySize+10; i++ {
+		bus.publish(ctx(), EventAdded, EventKindNode, "node", nil)
+	}
+	assert.Len(t, bus.history, eventHistorySize)
+	assert.Equal(t, uint64(11), bus.history[0].Sequence)
+
+	_, err := bus.subscribe(9)
+	assert.True(t, errors.IsNotFoundError(err), "Expected expired events to be rejected")
+
+	subscription, err := bus.subscribe(10)
+	assert.NoError(t, err)
+	defer subscription.Close()
+	assert.Len(t, subscription.Events, eventHistorySize)
+	assert.Equal(t, uint64(11), (<-subscription.Events).Sequence)
+}
+
+func TestEventBus_SlowSubscriberDropped(t *testing.T) {
+	bus := newEventBus()
+	slow, err := bus.subscribe(0)
+	assert.NoError(t, err)
+
+	for i := 0; i <= eventSubscriberBuffer; i++ {
+		bus.publish(ctx(), EventAdded, EventKindNode, "node", nil)
+	}
+	assert.Empty(t, bus.subscribers)
+
+	received := 0
+	for range slow.Events {
+		received++
+	}
+	assert.Equal(t, eventSubscriberBuffer, received)
+
+	// Closing a dropped subscription is harmless, and it may resume where it left off
+	slow.Close()
+	resumed, err := bus.subscribe(uint64(received))
+	assert.NoError(t, err)
+	defer resumed.Close()
+	assert.Equal(t, uint64(received+1), (<-resumed.Events).Sequence)
+}
+
+func TestSubscribeEvents(t *testing.T) {
+	const (
+		backendName = "eventBackend"
+		scName      = "eventSC"
+		volumeName  = "eventVolume"
+	)
+	o := getOrchestrator(t, false)
+	defer cleanup(t, o)
+
+	subscription, err := o.SubscribeEvents(ctx(), 0)
+	assert.NoError(t, err)
+	defer subscription.Close()
+
+	addBackendStorageClass(t, o, backendName, scName, config.File)
+	events := receiveEvents(t, subscription, 2)
+	assert.Equal(t, EventKindBackend, events[0].Kind)
+	assert.Equal(t, EventAdded, events[0].Type)
+	assert.Equal(t, backendName, events[0].Name)
+	assert.Equal(t, EventKindStorageClass, events[1].Kind)
+	assert.Equal(t, scName, events[1].Name)
+
+	_, err = o.AddVolume(ctx(), tu.GenerateVolumeConfig(volumeName, 1, scName, config.File))
+	assert.NoError(t, err)
+	events = receiveEvents(t, subscription, 1)
+	assert.Equal(t, EventKindVolume, events[0].Kind)
+	assert.Equal(t, EventAdded, events[0].Type)
+	assert.Equal(t, volumeName, events[0].Name)
+
+	assert.NoError(t, o.DeleteVolume(ctx(), volumeName))
+	events = receiveEvents(t, subscription, 1)
+	assert.Equal(t, EventKindVolume, events[0].Kind)
+	assert.Equal(t, EventDeleted, events[0].Type)
+
+	o.bootstrapError = errors.NotReadyError()
+	_, err = o.SubscribeEvents(ctx(), 0)
+	assert.Error(t, err, "Expected an error before bootstrapping")
+	o.bootstrapError = nil
+}
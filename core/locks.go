@@ -0,0 +1,281 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package core
+
+import (
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/netapp/trident/storage"
+	"github.com/netapp/trident/utils/errors"
+)
+
+// The orchestrator serializes its work with several kinds of locks, so that operations on unrelated objects
+// may proceed in parallel:
+//
+//   - Volume locks are reader/writer locks that serialize operations on a volume.  An operation on a volume also
+//     read-locks the clone sources it depends on, so that those cannot be deleted beneath it.
+//   - Node locks serialize publications to, and state changes of, a node.
+//   - Backend locks are reader/writer locks.  An operation holds a backend's read lock while it uses the backend
+//     without holding the orchestrator mutex, and operations that update or remove a backend hold its write lock.
+//   - The orchestrator mutex is a reader/writer lock that guards the orchestrator's in-memory state.  Operations
+//     that may wait on a storage backend for a long time release it for the duration of the backend call (see
+//     unlockedDuring), relying on the volume and backend locks to keep the objects they use from changing.
+//
+// Locks are acquired in the order listed above, so a lock is never requested while one that follows it is held.
+// The orchestrator mutex is released while waiting for a backend lock so as to keep that order.
+
+// lockSet is a collection of reader/writer locks keyed by name.  A lock is created on first use and discarded
+// once it is no longer held or awaited, so the set does not grow with the number of objects ever locked.
+type lockSet struct {
+	mutex sync.Mutex
+	locks map[string]*refCountedLock
+}
+
+type refCountedLock struct {
+	sync.RWMutex
+	refs int
+}
+
+func newLockSet() *lockSet {
+	return &lockSet{locks: make(map[string]*refCountedLock)}
+}
+
+// Lock takes the write locks of the specified keys and returns a function that releases them.
+func (s *lockSet) Lock(keys ...string) (unlock func()) {
+	return s.LockShared(keys, nil)
+}
+
+// RLock takes the read locks of the specified keys and returns a function that releases them.
+func (s *lockSet) RLock(keys ...string) (unlock func()) {
+	return s.LockShared(nil, keys)
+}
+
+// LockShared takes the write locks of the exclusive keys and the read locks of the shared keys, and returns a
+// function that releases them.  A key listed in both is locked exclusively.  Keys are locked in sorted order, so
+// that callers locking overlapping sets of keys cannot deadlock.
+func (s *lockSet) LockShared(exclusive, shared []string) (unlock func()) {
+	modes := make(map[string]bool, len(exclusive)+len(shared))
+	for _, key := range shared {
+		modes[key] = false
+	}
+	for _, key := range exclusive {
+		modes[key] = true
+	}
+	delete(modes, "")
+
+	keys := make([]string, 0, len(modes))
+	for key := range modes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	held := make([]*refCountedLock, 0, len(keys))
+	for _, key := range keys {
+		l := s.acquire(key)
+		if modes[key] {
+			l.Lock()
+		} else {
+			l.RLock()
+		}
+		held = append(held, l)
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			for i := len(held) - 1; i >= 0; i-- {
+				if modes[keys[i]] {
+					held[i].Unlock()
+				} else {
+					held[i].RUnlock()
+				}
+				s.release(keys[i], held[i])
+			}
+		})
+	}
+}
+
+// acquire returns the lock for a key, creating it if necessary, and records that it is in use.
+func (s *lockSet) acquire(key string) *refCountedLock {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	l, ok := s.locks[key]
+	if !ok {
+		l = &refCountedLock{}
+		s.locks[key] = l
+	}
+	l.refs++
+	return l
+}
+
+// release records that a lock is no longer in use, discarding it if nobody else holds or awaits it.
+func (s *lockSet) release(key string, l *refCountedLock) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	l.refs--
+	if l.refs == 0 {
+		delete(s.locks, key)
+	}
+}
+
+// size returns the number of locks currently held or awaited.
+func (s *lockSet) size() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return len(s.locks)
+}
+
+// unlockedDuring releases the orchestrator mutex while a long-running operation runs, so that operations on
+// other objects are not blocked by it.  The caller must hold the orchestrator mutex for writing, as well as
+// the locks of any volumes and backend involved in the operation, and must not rely on any other state read
+// beforehand.
+func (o *TridentOrchestrator) unlockedDuring(operation func()) {
+	o.mutex.Unlock()
+	defer o.mutex.Lock()
+	operation()
+}
+
+// volumeLockNames returns the volumes that are locked along with the named volume.  These are the volume itself
+// and, for a subordinate volume, its source volume, which operations on the subordinate act upon, followed by
+// any clone sources that those depend on.
+func (o *TridentOrchestrator) volumeLockNames(volumeName string) (volumes, sources []string) {
+	volumes = []string{volumeName}
+	if volume, ok := o.subordinateVolumes[volumeName]; ok && volume != nil {
+		volumeName = volume.Config.ShareSourceVolume
+		volumes = append(volumes, volumeName)
+	}
+
+	for volume, ok := o.volumes[volumeName]; ok && volume != nil; volume, ok = o.volumes[volume.Config.CloneSourceVolume] {
+		source := volume.Config.CloneSourceVolume
+		if source == "" || slices.Contains(sources, source) {
+			break
+		}
+		sources = append(sources, source)
+	}
+	return volumes, sources
+}
+
+// lockVolumes takes the locks of the named volumes and returns a function that releases them.  The clone sources
+// the volumes depend on are read-locked, so that they cannot be deleted beneath them.  It must be called before
+// the orchestrator mutex is taken.
+func (o *TridentOrchestrator) lockVolumes(volumeNames ...string) (unlock func()) {
+	return o.lockVolumeSet(volumeNames, nil, false)
+}
+
+// lockVolumeSet takes the locks of the exclusive volumes, as lockVolumes does, plus the read locks of the shared
+// volumes and their sources, and returns a function that releases them.  Creating a clone only reads its source,
+// so clones of a single source volume may be created in parallel.  If exclusiveSources is set, the clone sources
+// of the exclusive volumes are locked exclusively as well, since deleting a clone may delete its source.  It must
+// be called before the orchestrator mutex is taken.
+func (o *TridentOrchestrator) lockVolumeSet(exclusive, shared []string, exclusiveSources bool) (unlock func()) {
+	resolve := func() (exclusiveNames, sharedNames []string) {
+		for _, volumeName := range exclusive {
+			volumes, sources := o.volumeLockNames(volumeName)
+			exclusiveNames = append(exclusiveNames, volumes...)
+			if exclusiveSources {
+				exclusiveNames = append(exclusiveNames, sources...)
+			} else {
+				sharedNames = append(sharedNames, sources...)
+			}
+		}
+		for _, volumeName := range shared {
+			volumes, sources := o.volumeLockNames(volumeName)
+			sharedNames = append(append(sharedNames, volumes...), sources...)
+		}
+		return exclusiveNames, sharedNames
+	}
+
+	for {
+		o.mutex.RLock()
+		exclusiveNames, sharedNames := resolve()
+		o.mutex.RUnlock()
+
+		unlock = o.volumeLocks.LockShared(exclusiveNames, sharedNames)
+
+		// The sources of a locked volume cannot change, but they may have changed before it was locked
+		o.mutex.RLock()
+		currentExclusiveNames, currentSharedNames := resolve()
+		o.mutex.RUnlock()
+
+		if slices.Equal(exclusiveNames, currentExclusiveNames) && slices.Equal(sharedNames, currentSharedNames) {
+			return unlock
+		}
+		unlock()
+	}
+}
+
+// lockBackend takes a backend's write lock for an operation that updates or removes the backend, waiting for
+// operations that are using it to finish.  The caller must hold the orchestrator mutex for writing, which is
+// released while waiting.
+func (o *TridentOrchestrator) lockBackend(backendUUID string) (unlock func()) {
+	o.unlockedDuring(func() {
+		unlock = o.backendLocks.Lock(backendUUID)
+	})
+	return unlock
+}
+
+// rLockBackend takes a backend's read lock for an operation that is about to use the backend without holding
+// the orchestrator mutex.  The caller must hold the orchestrator mutex for writing, which is released while
+// waiting.  If the backend was updated or removed in the meantime, no lock is held and an error is returned.
+func (o *TridentOrchestrator) rLockBackend(backend storage.Backend) (unlock func(), err error) {
+	return o.rLockBackendByUUID(backend.BackendUUID(), backend)
+}
+
+// rLockVolumeBackend takes the read lock of the backend of the named volume, or of its source volume if it is a
+// subordinate volume, as rLockBackend does.  If the volume or its backend is not found, no lock is taken, and the
+// caller is left to handle that case.
+func (o *TridentOrchestrator) rLockVolumeBackend(volumeName string) (unlock func(), err error) {
+	volume, ok := o.subordinateVolumes[volumeName]
+	if ok && volume != nil {
+		volume, ok = o.volumes[volume.Config.ShareSourceVolume]
+	} else {
+		volume, ok = o.volumes[volumeName]
+	}
+	if !ok || volume == nil {
+		return func() {}, nil
+	}
+
+	backend, ok := o.backends[volume.BackendUUID]
+	if !ok {
+		return func() {}, nil
+	}
+	return o.rLockBackendByUUID(volume.BackendUUID, backend)
+}
+
+func (o *TridentOrchestrator) rLockBackendByUUID(backendUUID string, backend storage.Backend) (func(), error) {
+	var unlock func()
+	o.unlockedDuring(func() {
+		unlock = o.backendLocks.RLock(backendUUID)
+	})
+
+	if current, ok := o.backends[backendUUID]; !ok || current != backend {
+		unlock()
+		return nil, errors.NotFoundError("backend %s was updated or removed", backendUUID)
+	}
+	return unlock, nil
+}
+
+// rLockBackends takes the read locks of several backends, as rLockBackend does.  If any of them was updated or
+// removed in the meantime, no lock is held and an error is returned.
+func (o *TridentOrchestrator) rLockBackends(backends ...storage.Backend) (unlock func(), err error) {
+	backendUUIDs := make([]string, 0, len(backends))
+	for _, backend := range backends {
+		backendUUIDs = append(backendUUIDs, backend.BackendUUID())
+	}
+
+	o.unlockedDuring(func() {
+		unlock = o.backendLocks.RLock(backendUUIDs...)
+	})
+
+	for _, backend := range backends {
+		if current, ok := o.backends[backend.BackendUUID()]; !ok || current != backend {
+			unlock()
+			return nil, errors.NotFoundError("backend %s was updated or removed", backend.BackendUUID())
+		}
+	}
+	return unlock, nil
+}
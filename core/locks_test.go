@@ -0,0 +1,202 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netapp/trident/config"
+	"github.com/netapp/trident/storage"
+	sa "github.com/netapp/trident/storage_attribute"
+	storageclass "github.com/netapp/trident/storage_class"
+	fakedriver "github.com/netapp/trident/storage_drivers/fake"
+	tu "github.com/netapp/trident/storage_drivers/fake/test_utils"
+)
+
+// lockedWithin reports whether the supplied lock function returns before the timeout expires.
+func lockedWithin(lock func() func(), timeout time.Duration) (unlock func(), locked bool) {
+	done := make(chan func(), 1)
+	go func() { done <- lock() }()
+
+	select {
+	case unlock = <-done:
+		return unlock, true
+	case <-time.After(timeout):
+		// Release the lock once it is eventually taken, so the test doesn't leak it
+		go func() { (<-done)() }()
+		return nil, false
+	}
+}
+
+func TestLockSet(t *testing.T) {
+	const wait = 100 * time.Millisecond
+	locks := newLockSet()
+
+	// Exclusive locks exclude each other
+	unlockA := locks.Lock("a")
+	_, locked := lockedWithin(func() func() { return locks.Lock("a") }, wait)
+	assert.False(t, locked, "exclusive lock was taken twice")
+	_, locked = lockedWithin(func() func() { return locks.RLock("a") }, wait)
+	assert.False(t, locked, "shared lock was taken while exclusively locked")
+
+	// Other keys are unaffected
+	unlockB, locked := lockedWithin(func() func() { return locks.Lock("b") }, wait)
+	assert.True(t, locked, "lock on an unrelated key was blocked")
+	unlockB()
+
+	// Unlocking twice is harmless
+	unlockA()
+	unlockA()
+
+	// Shared locks may be held together, but exclude an exclusive lock
+	unlockShared1 := locks.RLock("c")
+	unlockShared2, locked := lockedWithin(func() func() { return locks.RLock("c") }, wait)
+	assert.True(t, locked, "shared lock was blocked by another shared lock")
+	_, locked = lockedWithin(func() func() { return locks.Lock("c") }, wait)
+	assert.False(t, locked, "exclusive lock was taken while shared locks were held")
+	unlockShared1()
+	unlockShared2()
+
+	// A key requested both ways is locked exclusively, and empty keys are ignored
+	unlockMixed := locks.LockShared([]string{"d", ""}, []string{"d", "e"})
+	_, locked = lockedWithin(func() func() { return locks.RLock("d") }, wait)
+	assert.False(t, locked, "key requested both ways was not locked exclusively")
+	unlockE, locked := lockedWithin(func() func() { return locks.RLock("e") }, wait)
+	assert.True(t, locked, "shared key was locked exclusively")
+	unlockE()
+	unlockMixed()
+
+	// Locks are discarded once released
+	assert.Eventually(t, func() bool { return locks.size() == 0 }, time.Second, 10*time.Millisecond)
+}
+
+func TestLockSet_OverlappingSetsDoNotDeadlock(t *testing.T) {
+	locks := newLockSet()
+	keys := []string{"a", "b", "c", "d"}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			// Request overlapping sets of keys in differing orders
+			first, second := keys[i%len(keys)], keys[(i+1)%len(keys)]
+			unlock := locks.Lock(second, first)
+			time.Sleep(time.Millisecond)
+			unlock()
+		}(i)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("deadlock locking overlapping sets of keys")
+	}
+	assert.Equal(t, 0, locks.size())
+}
+
+// barrierCreateDriver is a fake driver whose creates wait until a number of them are in progress at once, which
+// only happens if the orchestrator does not serialize them.  Once enough have arrived, all creates proceed.
+type barrierCreateDriver struct {
+	*fakedriver.StorageDriver
+	parties int
+	timeout time.Duration
+
+	mutex   sync.Mutex
+	arrived int
+	release chan struct{}
+}
+
+func newBarrierCreateDriver(driver *fakedriver.StorageDriver, parties int) *barrierCreateDriver {
+	return &barrierCreateDriver{
+		StorageDriver: driver,
+		parties:       parties,
+		timeout:       10 * time.Second,
+		release:       make(chan struct{}),
+	}
+}
+
+func (d *barrierCreateDriver) Create(
+	ctx context.Context, volConfig *storage.VolumeConfig, pool storage.Pool, attributes map[string]sa.Request,
+) error {
+	d.mutex.Lock()
+	d.arrived++
+	if d.arrived == d.parties {
+		close(d.release)
+	}
+	d.mutex.Unlock()
+
+	select {
+	case <-d.release:
+	case <-time.After(d.timeout):
+		return fmt.Errorf("fewer than %d creates were in progress at once", d.parties)
+	}
+	return d.StorageDriver.Create(ctx, volConfig, pool, attributes)
+}
+
+// TestAddVolume_ConcurrentProvisioning provisions several volumes on one backend at once, and checks that the
+// orchestrator does not serialize the creates: each create waits until all of them have reached the backend.
+func TestAddVolume_ConcurrentProvisioning(t *testing.T) {
+	const volumeCount = 10
+
+	orchestrator := getOrchestrator(t, false)
+	defer cleanup(t, orchestrator)
+
+	cfg, err := fakedriver.NewFakeStorageDriverConfigJSON("barrier-create", config.File, tu.GenerateFakePools(2), nil)
+	if err != nil {
+		t.Fatal("Unable to generate config JSON: ", err)
+	}
+	backendExternal, err := orchestrator.AddBackend(ctx(), cfg, "")
+	if err != nil {
+		t.Fatal("Unable to add backend: ", err)
+	}
+
+	orchestrator.mutex.Lock()
+	backend := orchestrator.backends[backendExternal.BackendUUID].(*storage.StorageBackend)
+	backend.SetDriver(newBarrierCreateDriver(backend.Driver().(*fakedriver.StorageDriver), volumeCount))
+	orchestrator.mutex.Unlock()
+
+	if _, err = orchestrator.AddStorageClass(ctx(), &storageclass.Config{Name: "barrier"}); err != nil {
+		t.Fatal("Unable to add storage class: ", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < volumeCount; i++ {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			_, err := orchestrator.AddVolume(ctx(), tu.GenerateVolumeConfig(name, 1, "barrier", config.File))
+			assert.NoError(t, err, "failed to create volume %s", name)
+		}(fmt.Sprintf("parallel-%d", i))
+	}
+	wg.Wait()
+
+	volumes, err := orchestrator.ListVolumes(ctx())
+	assert.NoError(t, err)
+	assert.Len(t, volumes, volumeCount)
+
+	// Deletes may proceed in parallel as well
+	for _, volume := range volumes {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			assert.NoError(t, orchestrator.DeleteVolume(ctx(), name), "failed to delete volume %s", name)
+		}(volume.Config.Name)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 0, orchestrator.volumeLocks.size(), "volume locks were not released")
+	assert.Equal(t, 0, orchestrator.backendLocks.size(), "backend locks were not released")
+}
@@ -48,6 +48,22 @@ var (
 		},
 		[]string{"backend_type", "backend_uuid", "volume_state", "volume_type"},
 	)
+	orphanVolumesGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: config.OrchestratorName,
+			Name:      "orphan_volume_count",
+			Help:      "The number of volumes found untracked on, or missing from, backends by the last orphan report",
+		},
+		[]string{"backend_type", "backend_uuid", "orphan_type"},
+	)
+	orphanSnapshotsGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: config.OrchestratorName,
+			Name:      "orphan_snapshot_count",
+			Help:      "The number of snapshots found missing from backends by the last orphan report",
+		},
+		[]string{"backend_type", "backend_uuid"},
+	)
 	volumesTotalBytesGauge = promauto.NewGauge(
 		prometheus.GaugeOpts{
 			Namespace: config.OrchestratorName,
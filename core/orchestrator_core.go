@@ -16,6 +16,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/multierr"
 
 	"github.com/netapp/trident/config"
@@ -24,6 +25,7 @@ import (
 	controllerhelpers "github.com/netapp/trident/frontend/csi/controller_helpers"
 	. "github.com/netapp/trident/logging"
 	persistentstore "github.com/netapp/trident/persistent_store"
+	netappv1 "github.com/netapp/trident/persistent_store/crd/apis/netapp/v1"
 	"github.com/netapp/trident/storage"
 	"github.com/netapp/trident/storage/factory"
 	sa "github.com/netapp/trident/storage_attribute"
@@ -32,6 +34,7 @@ import (
 	"github.com/netapp/trident/storage_drivers/fake"
 	"github.com/netapp/trident/utils"
 	"github.com/netapp/trident/utils/errors"
+	"github.com/netapp/trident/utils/tracing"
 )
 
 const (
@@ -81,12 +84,17 @@ type TridentOrchestrator struct {
 	volumes                  map[string]*storage.Volume
 	subordinateVolumes       map[string]*storage.Volume
 	frontends                map[string]frontend.Plugin
-	mutex                    *sync.Mutex
+	mutex                    *sync.RWMutex
+	volumeLocks              *lockSet
+	nodeLocks                *lockSet
+	backendLocks             *lockSet
 	storageClasses           map[string]*storageclass.StorageClass
 	nodes                    cache.NodeCache
 	volumePublications       *cache.VolumePublicationCache
 	snapshots                map[string]*storage.Snapshot
+	groupSnapshots           map[string]*storage.GroupSnapshot
 	storeClient              persistentstore.Client
+	events                   *eventBus
 	bootstrapped             bool
 	bootstrapError           error
 	txnMonitorTicker         *time.Ticker
@@ -96,11 +104,16 @@ type TridentOrchestrator struct {
 	volumePublicationsSynced bool
 	stopNodeAccessLoop       chan bool
 	stopReconcileBackendLoop chan bool
+	stopVolumeHealthLoop     chan bool
+	stopSnapshotPolicyLoop   chan bool
+	volumeHealth             map[string]*storage.VolumeHealth
+	snapshotPolicies         map[string]*storage.SnapshotPolicy
 	uuid                     string
 }
 
 // NewTridentOrchestrator returns a storage orchestrator instance
 func NewTridentOrchestrator(client persistentstore.Client) *TridentOrchestrator {
+	events := newEventBus()
 	return &TridentOrchestrator{
 		backends:           make(map[string]storage.Backend), // key is UUID, not name
 		volumes:            make(map[string]*storage.Volume),
@@ -110,8 +123,15 @@ func NewTridentOrchestrator(client persistentstore.Client) *TridentOrchestrator
 		nodes:              *cache.NewNodeCache(),
 		volumePublications: cache.NewVolumePublicationCache(),
 		snapshots:          make(map[string]*storage.Snapshot), // key is ID, not name
-		mutex:              &sync.Mutex{},
-		storeClient:        client,
+		groupSnapshots:     make(map[string]*storage.GroupSnapshot),
+		volumeHealth:       make(map[string]*storage.VolumeHealth),
+		snapshotPolicies:   make(map[string]*storage.SnapshotPolicy),
+		mutex:              &sync.RWMutex{},
+		volumeLocks:        newLockSet(),
+		nodeLocks:          newLockSet(),
+		backendLocks:       newLockSet(),
+		storeClient:        newEventStoreClient(newAuditStoreClient(client), events),
+		events:             events,
 		bootstrapped:       false,
 		bootstrapError:     errors.NotReadyError(),
 	}
@@ -219,7 +239,9 @@ func (o *TridentOrchestrator) bootstrapBackends(ctx context.Context) error {
 			return err
 		}
 
+		o.mutex.Lock()
 		newBackendExternal, backendErr := o.addBackend(ctx, serializedConfig, b.BackendUUID, b.ConfigRef)
+		o.mutex.Unlock()
 		if backendErr == nil {
 			newBackendExternal.BackendUUID = b.BackendUUID
 		} else {
@@ -347,7 +369,7 @@ func (o *TridentOrchestrator) bootstrapVolumes(ctx context.Context) error {
 				}).Warning("Couldn't find backend. Setting state to MissingBackend.")
 				vol.State = storage.VolumeStateMissingBackend
 			} else {
-				backend.Volumes()[vol.Config.Name] = vol
+				backend.AddCachedVolume(vol)
 				if fakeDriver, ok := backend.Driver().(*fake.StorageDriver); ok {
 					fakeDriver.BootstrapVolume(ctx, vol)
 				}
@@ -404,6 +426,62 @@ func (o *TridentOrchestrator) bootstrapSnapshots(ctx context.Context) error {
 	return nil
 }
 
+func (o *TridentOrchestrator) bootstrapGroupSnapshots(ctx context.Context) error {
+	groupSnapshots, err := o.storeClient.GetGroupSnapshots(ctx)
+	if err != nil {
+		return err
+	}
+	for _, gs := range groupSnapshots {
+		groupSnapshot := storage.NewGroupSnapshot(gs.Config, gs.SnapshotIDs, gs.Created)
+
+		// A group whose creation was rolled back by the snapshot transactions has no snapshots left
+		remaining := 0
+		for _, snapshotID := range groupSnapshot.SnapshotIDs {
+			if _, ok := o.snapshots[snapshotID]; ok {
+				remaining++
+			}
+		}
+		if remaining == 0 {
+			Logc(ctx).WithField("groupSnapshot", groupSnapshot.ID()).Warning(
+				"Group snapshot has no remaining snapshots, removing it.")
+			if err = o.storeClient.DeleteGroupSnapshot(ctx, groupSnapshot); err != nil {
+				return err
+			}
+			continue
+		}
+		o.groupSnapshots[groupSnapshot.ID()] = groupSnapshot
+
+		Logc(ctx).WithFields(LogFields{
+			"groupSnapshot": groupSnapshot.ID(),
+			"volumes":       groupSnapshot.Config.VolumeNames,
+			"handler":       "Bootstrap",
+		}).Info("Added an existing group snapshot.")
+	}
+	return nil
+}
+
+func (o *TridentOrchestrator) bootstrapSnapshotPolicies(ctx context.Context) error {
+	policies, err := o.storeClient.GetSnapshotPolicies(ctx)
+	if err != nil {
+		return err
+	}
+	for _, p := range policies {
+		policy, err := p.ConstructSnapshotPolicy(time.Now())
+		if err != nil {
+			Logc(ctx).WithError(err).Error("Could not restore snapshot policy.")
+			continue
+		}
+		o.snapshotPolicies[policy.ID()] = policy
+
+		Logc(ctx).WithFields(LogFields{
+			"snapshotPolicy": policy.ID(),
+			"nextRunTime":    policy.NextRunTime,
+			"handler":        "Bootstrap",
+		}).Info("Added an existing snapshot policy.")
+	}
+	return nil
+}
+
 func (o *TridentOrchestrator) bootstrapVolTxns(ctx context.Context) error {
 	volTxns, err := o.storeClient.GetVolumeTransactions(ctx)
 	if err != nil && !persistentstore.MatchKeyNotFoundErr(err) {
@@ -510,6 +588,9 @@ func (o *TridentOrchestrator) bootstrap(ctx context.Context) error {
 		o.bootstrapStorageClasses, o.bootstrapVolumes, o.bootstrapSnapshots,
 		// Volume transactions require volumes and snapshots to be bootstrapped.
 		o.bootstrapVolTxns,
+		// Group snapshots require snapshots to be bootstrapped and their failed transactions rolled back.
+		o.bootstrapGroupSnapshots,
+		o.bootstrapSnapshotPolicies,
 		// Node access reconciliation is part of node bootstrap and requires volume publications to be bootstrapped.
 		o.bootstrapVolumePublications, o.bootstrapNodes,
 		// Subordinate volumes require volumes to be bootstrapped.
@@ -557,13 +638,19 @@ func (o *TridentOrchestrator) bootstrap(ctx context.Context) error {
 
 // Stop stops the orchestrator core.
 func (o *TridentOrchestrator) Stop() {
-	// Stop the node access and backends' state reconciliation background tasks
+	// Stop the node access, backends' state reconciliation, volume health and snapshot policy background tasks
 	if o.stopNodeAccessLoop != nil {
 		o.stopNodeAccessLoop <- true
 	}
 	if o.stopReconcileBackendLoop != nil {
 		o.stopReconcileBackendLoop <- true
 	}
+	if o.stopVolumeHealthLoop != nil {
+		o.stopVolumeHealthLoop <- true
+	}
+	if o.stopSnapshotPolicyLoop != nil {
+		o.stopSnapshotPolicyLoop <- true
+	}
 
 	// Stop transaction monitor
 	o.StopTransactionMonitor()
@@ -652,6 +739,14 @@ func (o *TridentOrchestrator) handleFailedTransaction(ctx context.Context, v *st
 			"backendUUID": v.VolumeCreatingConfig.BackendUUID,
 			"op":          v.Op,
 		}).Info("Processed volume creating transaction log.")
+	case storage.MoveVolume:
+		Logc(ctx).WithFields(LogFields{
+			"volume":                 v.VolumeMoveConfig.Name,
+			"sourceBackendUUID":      v.VolumeMoveConfig.SourceBackendUUID,
+			"destinationBackendUUID": v.VolumeMoveConfig.DestinationBackendUUID,
+			"state":                  v.VolumeMoveConfig.State,
+			"op":                     v.Op,
+		}).Info("Processed volume move transaction log.")
 	}
 
 	switch v.Op {
@@ -856,6 +951,15 @@ func (o *TridentOrchestrator) handleFailedTransaction(ctx context.Context, v *st
 
 	case storage.VolumeCreating:
 		// Do nothing
+
+	case storage.MoveVolume:
+		// A committed move has only to clean up after itself.  One that was not committed is left in place,
+		// since repeating the move resumes it.
+		if v.VolumeMoveConfig.State == storage.VolumeMoveStateCommitted {
+			if err := o.finishVolumeMove(ctx, v); err != nil {
+				return fmt.Errorf("unable to finish moving volume %s: %v", v.VolumeMoveConfig.Name, err)
+			}
+		}
 	}
 
 	return nil
@@ -927,6 +1031,8 @@ func (o *TridentOrchestrator) AddBackend(
 		return nil, o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.AddBackend")
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("backend_add", &err)()
 
 	o.mutex.Lock()
@@ -951,7 +1057,7 @@ func (o *TridentOrchestrator) AddBackend(
 }
 
 // addBackend creates a new storage backend. It assumes the mutex lock is
-// already held or not required (e.g., during bootstrapping).
+// already held.
 func (o *TridentOrchestrator) addBackend(
 	ctx context.Context, configJSON, backendUUID, configRef string,
 ) (backendExternal *storage.BackendExternal, err error) {
@@ -1112,6 +1218,8 @@ func (o *TridentOrchestrator) UpdateBackend(
 		return nil, o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.UpdateBackend", tracing.Backend(backendName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("backend_update", &err)()
 
 	o.mutex.Lock()
@@ -1160,6 +1268,8 @@ func (o *TridentOrchestrator) UpdateBackendByBackendUUID(
 		return nil, o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.UpdateBackendByBackendUUID", tracing.Backend(backendName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("backend_update", &err)()
 
 	o.mutex.Lock()
@@ -1192,6 +1302,9 @@ func (o *TridentOrchestrator) updateBackendByBackendUUID(
 ) (backendExternal *storage.BackendExternal, err error) {
 	var backend storage.Backend
 
+	// Wait for operations that are using the backend to finish, and keep new ones from starting until it is updated
+	defer o.lockBackend(backendUUID)()
+
 	// Check whether the backend exists.
 	originalBackend, found := o.backends[backendUUID]
 	if !found {
@@ -1383,7 +1496,7 @@ func (o *TridentOrchestrator) updateBackendByBackendUUID(
 					return nil, err
 				}
 			}
-			o.backends[backend.BackendUUID()].Volumes()[volName] = vol
+			o.backends[backend.BackendUUID()].AddCachedVolume(vol)
 		}
 	}
 
@@ -1422,6 +1535,8 @@ func (o *TridentOrchestrator) UpdateBackendState(
 		return nil, o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.UpdateBackendState", tracing.Backend(backendName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("backend_update_state", &err)()
 
 	// Extra check to ensure exactly one is set.
@@ -1439,6 +1554,9 @@ func (o *TridentOrchestrator) UpdateBackendState(
 		return nil, err
 	}
 
+	// Wait for operations that are using the backend to finish before changing its state
+	defer o.lockBackend(backendUUID)()
+
 	backend, found := o.backends[backendUUID]
 	if !found {
 		return nil, errors.NotFoundError("backend %v was not found", backendName)
@@ -1585,10 +1703,12 @@ func (o *TridentOrchestrator) GetBackend(
 		return nil, o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.GetBackend", tracing.Backend(backendName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("backend_get", &err)()
 
-	o.mutex.Lock()
-	defer o.mutex.Unlock()
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
 
 	backendUUID, err := o.getBackendUUIDByBackendName(backendName)
 	if err != nil {
@@ -1618,10 +1738,12 @@ func (o *TridentOrchestrator) GetBackendByBackendUUID(
 		return nil, o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.GetBackendByBackendUUID")
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("backend_get", &err)()
 
-	o.mutex.Lock()
-	defer o.mutex.Unlock()
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
 
 	backend, err := o.getBackendByBackendUUID(backendUUID)
 	if err != nil {
@@ -1651,10 +1773,12 @@ func (o *TridentOrchestrator) ListBackends(
 		return nil, o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.ListBackends")
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("backend_list", &err)()
 
-	o.mutex.Lock()
-	defer o.mutex.Unlock()
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
 
 	Logc(ctx).Debugf("About to list backends: %v", o.backends)
 	backends := make([]*storage.BackendExternal, 0)
@@ -1674,6 +1798,8 @@ func (o *TridentOrchestrator) DeleteBackend(ctx context.Context, backendName str
 		return o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.DeleteBackend", tracing.Backend(backendName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("backend_delete", &err)()
 
 	o.mutex.Lock()
@@ -1699,6 +1825,8 @@ func (o *TridentOrchestrator) DeleteBackendByBackendUUID(
 		return o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.DeleteBackendByBackendUUID", tracing.Backend(backendName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("backend_delete", &err)()
 
 	o.mutex.Lock()
@@ -1714,6 +1842,9 @@ func (o *TridentOrchestrator) deleteBackendByBackendUUID(ctx context.Context, ba
 		"backendUUID": backendUUID,
 	}).Debug("deleteBackendByBackendUUID")
 
+	// Wait for operations that are using the backend to finish
+	defer o.lockBackend(backendUUID)()
+
 	backend, found := o.backends[backendUUID]
 	if !found {
 		return errors.NotFoundError("backend %s not found", backendName)
@@ -1765,12 +1896,16 @@ func (o *TridentOrchestrator) deleteBackendByBackendUUID(ctx context.Context, ba
 func (o *TridentOrchestrator) RemoveBackendConfigRef(ctx context.Context, backendUUID, configRef string) (err error) {
 	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
 
+	ctx, span := tracing.StartSpan(ctx, "core.RemoveBackendConfigRef")
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("backend_update", &err)()
 
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
 	defer o.updateMetrics()
 
+	defer o.lockBackend(backendUUID)()
+
 	var b storage.Backend
 	if b, err = o.getBackendByBackendUUID(backendUUID); err != nil {
 		return errors.NotFoundError("backend with UUID '%s' not found", backendUUID)
@@ -1797,8 +1932,12 @@ func (o *TridentOrchestrator) AddVolume(
 		return nil, o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.AddVolume", tracing.Volume(volumeConfig.Name))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("volume_add", &err)()
 
+	defer o.lockVolumes(volumeConfig.Name, volumeConfig.ShareSourceVolume)()
+
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
 	defer o.updateMetrics()
@@ -1836,15 +1975,16 @@ func (o *TridentOrchestrator) addVolume(
 
 // addVolumeInitial continues the volume creation operation.
 // This method should only be called from AddVolume, as it does not take locks or otherwise do much validation
-// of the volume config.
+// of the volume config.  The orchestrator mutex is released while the backend creates the volume.
 func (o *TridentOrchestrator) addVolumeInitial(
 	ctx context.Context, volumeConfig *storage.VolumeConfig,
 ) (externalVol *storage.VolumeExternal, err error) {
 	var (
-		backend storage.Backend
-		vol     *storage.Volume
-		pool    storage.Pool
-		txn     *storage.VolumeTransaction
+		backend       storage.Backend
+		vol           *storage.Volume
+		pool          storage.Pool
+		txn           *storage.VolumeTransaction
+		unlockBackend = func() {}
 	)
 
 	Logc(ctx).WithFields(LogFields{
@@ -1871,8 +2011,22 @@ func (o *TridentOrchestrator) addVolumeInitial(
 		"PreferredTopologies": volumeConfig.PreferredTopologies,
 		"AccessMode":          volumeConfig.AccessMode,
 	}).Trace("Calling GetStoragePoolsForProtocolByBackend with args.")
+
+	// Ask the backends for the capacity of their pools without holding the lock, as they may be slow to answer;
+	// the volume itself stays locked, and the pools are matched again once the lock is retaken.
+	var capacities storageclass.PoolCapacities
+	candidatePools := sc.GetCandidateStoragePools(ctx, protocol, volumeConfig.RequisiteTopologies,
+		volumeConfig.AccessMode)
+	strategy := sc.GetPlacementStrategy()
+	o.unlockedDuring(func() {
+		capacities = storageclass.GetPoolCapacities(ctx, candidatePools, strategy)
+	})
+	if sc, ok = o.storageClasses[volumeConfig.StorageClass]; !ok {
+		return nil, fmt.Errorf("unknown storage class: %s", volumeConfig.StorageClass)
+	}
+
 	pools := sc.GetStoragePoolsForProtocolByBackend(ctx, protocol, volumeConfig.RequisiteTopologies,
-		volumeConfig.PreferredTopologies, volumeConfig.AccessMode)
+		volumeConfig.PreferredTopologies, volumeConfig.AccessMode, requestedSizeBytes(volumeConfig.Size), capacities)
 	if len(pools) == 0 {
 		return nil, fmt.Errorf("no available backends for storage class %s", volumeConfig.StorageClass)
 	}
@@ -1889,6 +2043,9 @@ func (o *TridentOrchestrator) addVolumeInitial(
 	// Copy the volume config into a working copy should any backend mutate the config but fail to create the volume.
 	mutableConfig := volumeConfig.ConstructClone()
 
+	// Release the lock of the last backend tried once any cleanup on it is done
+	defer func() { unlockBackend() }()
+
 	// Recovery functions in case of error
 	defer func() {
 		err = o.addVolumeCleanup(ctx, err, backend, vol, txn, mutableConfig)
@@ -1902,7 +2059,7 @@ func (o *TridentOrchestrator) addVolumeInitial(
 	var volumeCreateErrors error
 	ineligibleBackends := make(map[string]struct{})
 
-	// The pool lists are already shuffled, so just try them in order.
+	// The pool lists are already ordered by the storage class's placement strategy, so just try them in order.
 	// The loop terminates when creation on all matching pools has failed.
 	for _, pool = range pools {
 		backend = pool.Backend()
@@ -1918,6 +2075,19 @@ func (o *TridentOrchestrator) addVolumeInitial(
 			continue
 		}
 
+		// Keep the backend from being updated or removed while the volume is created on it
+		unlockBackend()
+		if unlockBackend, err = o.rLockBackend(backend); err != nil {
+			Logc(ctx).WithFields(LogFields{
+				"backend": backend.Name(),
+				"pool":    pool.Name(),
+				"volume":  mutableConfig.Name,
+			}).WithError(err).Debug("Skipping pool on a backend that changed.")
+			unlockBackend = func() {}
+			ineligibleBackends[backend.BackendUUID()] = struct{}{}
+			continue
+		}
+
 		// CreatePrepare has a side effect that updates the mutableConfig with the backend-specific internal name
 		backend.Driver().CreatePrepare(ctx, mutableConfig, pool)
 
@@ -1930,7 +2100,10 @@ func (o *TridentOrchestrator) addVolumeInitial(
 			return nil, err
 		}
 
-		vol, err = backend.AddVolume(ctx, mutableConfig, pool, sc.GetAttributes(), false)
+		attributes := sc.GetAttributes()
+		o.unlockedDuring(func() {
+			vol, err = backend.AddVolume(ctx, mutableConfig, pool, attributes, false)
+		})
 		if err != nil {
 
 			logFields := LogFields{
@@ -1979,6 +2152,19 @@ func (o *TridentOrchestrator) addVolumeInitial(
 	return nil, err
 }
 
+// requestedSizeBytes converts a volume config size to bytes, returning 0 if the size is unset or invalid.
+func requestedSizeBytes(size string) uint64 {
+	sizeString, err := utils.ConvertSizeToBytes(size)
+	if err != nil {
+		return 0
+	}
+	sizeBytes, err := strconv.ParseUint(sizeString, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return sizeBytes
+}
+
 // addVolumeRetry continues a volume creation operation that previously failed with a VolumeCreatingError.
 // This method should only be called from AddVolume, as it does not take locks or otherwise do much validation
 // of the volume config.
@@ -2010,6 +2196,12 @@ func (o *TridentOrchestrator) addVolumeRetry(
 			txn.VolumeCreatingConfig.Pool, txn.VolumeCreatingConfig.BackendUUID)
 	}
 
+	unlockBackend, err := o.rLockBackend(backend)
+	if err != nil {
+		return nil, err
+	}
+	defer unlockBackend()
+
 	// Recovery functions in case of error
 	defer func() {
 		err = o.addVolumeCleanup(ctx, err, backend, vol, txn, volumeConfig)
@@ -2018,7 +2210,9 @@ func (o *TridentOrchestrator) addVolumeRetry(
 		err = o.addVolumeRetryCleanup(ctx, err, backend, pool, txn, volumeConfig)
 	}()
 
-	vol, err = backend.AddVolume(ctx, volumeConfig, pool, make(map[string]sa.Request), true)
+	o.unlockedDuring(func() {
+		vol, err = backend.AddVolume(ctx, volumeConfig, pool, make(map[string]sa.Request), true)
+	})
 	if err != nil {
 
 		logFields := LogFields{
@@ -2094,6 +2288,8 @@ func (o *TridentOrchestrator) UpdateVolume(
 		return o.bootstrapError
 	}
 
+	defer o.lockVolumes(volumeName)()
+
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
 	defer o.updateMetrics()
@@ -2128,20 +2324,14 @@ func (o *TridentOrchestrator) UpdateVolume(
 		return err
 	}
 
-	if updatedVols != nil {
-		// Update persistent layer
-		for _, v := range updatedVols {
-			err := o.updateVolumeOnPersistentStore(ctx, v)
-			if err != nil {
-				Logc(ctx).WithError(err).Errorf(genericUpdateErr)
-				return err
-			}
-		}
-
-		// Update cache
-		for name, updatedVol := range updatedVols {
-			o.volumes[name] = updatedVol
+	// The backend returns updated copies of the volumes, which replace the cached volumes once persisted
+	for name, updatedVol := range updatedVols {
+		if err = o.updateVolumeOnPersistentStore(ctx, updatedVol); err != nil {
+			Logc(ctx).WithError(err).Errorf(genericUpdateErr)
+			return err
 		}
+		o.volumes[name] = updatedVol
+		backend.AddCachedVolume(updatedVol)
 	}
 
 	return nil
@@ -2155,6 +2345,8 @@ func (o *TridentOrchestrator) UpdateVolumeLUKSPassphraseNames(ctx context.Contex
 		return o.bootstrapError
 	}
 
+	defer o.lockVolumes(volume)()
+
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
 	defer o.updateMetrics()
@@ -2186,8 +2378,12 @@ func (o *TridentOrchestrator) CloneVolume(
 		return nil, o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.CloneVolume", tracing.Volume(volumeConfig.Name))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("volume_clone", &err)()
 
+	defer o.lockVolumeSet([]string{volumeConfig.Name}, []string{volumeConfig.CloneSourceVolume}, false)()
+
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
 	defer o.updateMetrics()
@@ -2392,6 +2588,13 @@ func (o *TridentOrchestrator) cloneVolumeInitial(
 		}
 	}
 
+	// Keep the backend from being updated or removed while the clone is created on it
+	unlockBackend, err := o.rLockBackend(backend)
+	if err != nil {
+		return nil, err
+	}
+	defer unlockBackend()
+
 	// Create the backend-specific internal names so they are saved in the transaction
 	backend.Driver().CreatePrepare(ctx, cloneConfig, pool)
 
@@ -2421,7 +2624,10 @@ func (o *TridentOrchestrator) cloneVolumeInitial(
 	}
 
 	// Create the clone
-	if vol, err = backend.CloneVolume(ctx, sourceVolume.Config, cloneConfig, pool, false); err != nil {
+	o.unlockedDuring(func() {
+		vol, err = backend.CloneVolume(ctx, sourceVolume.Config, cloneConfig, pool, false)
+	})
+	if err != nil {
 
 		logFields["error"] = err
 
@@ -2482,6 +2688,12 @@ func (o *TridentOrchestrator) cloneVolumeRetry(
 		pool = storage.NewStoragePool(backend, "")
 	}
 
+	unlockBackend, err := o.rLockBackend(backend)
+	if err != nil {
+		return nil, err
+	}
+	defer unlockBackend()
+
 	// Recovery functions in case of error
 	defer func() {
 		err = o.addVolumeCleanup(ctx, err, backend, vol, txn, cloneConfig)
@@ -2491,7 +2703,10 @@ func (o *TridentOrchestrator) cloneVolumeRetry(
 	}()
 
 	// Create the clone
-	if vol, err = backend.CloneVolume(ctx, sourceVolume.Config, cloneConfig, pool, true); err != nil {
+	o.unlockedDuring(func() {
+		vol, err = backend.CloneVolume(ctx, sourceVolume.Config, cloneConfig, pool, true)
+	})
+	if err != nil {
 
 		logFields := LogFields{
 			"backend":      backend.Name(),
@@ -2529,10 +2744,12 @@ func (o *TridentOrchestrator) GetVolumeForImport(
 		return nil, o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.GetVolumeForImport", tracing.Backend(backendName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("volume_get_for_import", &err)()
 
-	o.mutex.Lock()
-	defer o.mutex.Unlock()
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
 
 	Logc(ctx).WithFields(LogFields{
 		"volumeID":    volumeID,
@@ -2562,10 +2779,12 @@ func (o *TridentOrchestrator) GetVolumeByInternalName(
 ) (volume string, err error) {
 	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
 
+	ctx, span := tracing.StartSpan(ctx, "core.GetVolumeByInternalName")
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("volume_internal_get", &err)()
 
-	o.mutex.Lock()
-	defer o.mutex.Unlock()
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
 
 	for _, vol := range o.volumes {
 		if vol.Config.InternalName == volumeInternal {
@@ -2667,8 +2886,12 @@ func (o *TridentOrchestrator) ImportVolume(
 		return nil, fmt.Errorf("original name not specified")
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.ImportVolume", tracing.Volume(volumeConfig.Name))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("volume_import", &err)()
 
+	defer o.lockVolumes(volumeConfig.Name)()
+
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
 	defer o.updateMetrics()
@@ -2740,146 +2963,635 @@ func (o *TridentOrchestrator) ImportVolume(
 	return volExternal, nil
 }
 
-// AddVolumeTransaction is called from the volume create, clone, and resize
-// methods to save a record of the operation in case it fails and must be
-// cleaned up later.
-func (o *TridentOrchestrator) AddVolumeTransaction(ctx context.Context, volTxn *storage.VolumeTransaction) error {
-	// Check if a transaction already exists for this volume. This condition
-	// can occur if we failed to clean up the transaction object during the
-	// last operation on the volume. The check for a preexisting transaction
-	// allows recovery from a failure by repeating the operation and without
-	// having to restart Trident. It's important to note that repeating a
-	// failed transaction may have side-effects on the new transaction (e.g.,
-	// repeating a failed volume delete before a volume resize or clone).
-	// Therefore, it's important that the implementation can take care of such
-	// scenarios. The current implementation allows only one outstanding
-	// transaction per volume.
-	// If a transaction is found, we failed in cleaning up the transaction
-	// earlier and we need to call the bootstrap cleanup code. If this fails,
-	// return an error. If no transaction existed or the operation succeeds,
-	// log a new transaction in the persistent store and proceed.
+// GetOrphanReport compares the volumes on the storage backends, or on the named backend, with those tracked by
+// Trident.  It reports volumes on the backends that Trident does not track, volumes and snapshots that Trident
+// tracks but the backends no longer report, and volumes that Trident has marked as orphaned.  Reading a
+// backend's inventory may take a long time, so the orchestrator lock is released while doing so, and the report
+// is a best-effort view of a system that may be changing.
+func (o *TridentOrchestrator) GetOrphanReport(
+	ctx context.Context, backendName string,
+) (report *storage.OrphanReport, err error) {
 	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
 
-	oldTxn, err := o.storeClient.GetVolumeTransaction(ctx, volTxn)
+	if o.bootstrapError != nil {
+		return nil, o.bootstrapError
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "core.GetOrphanReport", tracing.Backend(backendName))
+	defer tracing.EndSpan(span, &err)
+	defer recordTiming("orphan_report", &err)()
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	scan, err := o.scanForOrphans(ctx, backendName)
 	if err != nil {
-		Logc(ctx).Errorf("Unable to check for a preexisting volume transaction: %v", err)
-		return err
+		return nil, err
 	}
-	if oldTxn != nil {
-		if oldTxn.Op != storage.VolumeCreating {
-			err = o.handleFailedTransaction(ctx, oldTxn)
-			if err != nil {
-				return fmt.Errorf("unable to process the preexisting transaction for volume %s:  %v",
-					volTxn.Config.Name, err)
+	return scan.report, nil
+}
+
+// ResolveOrphans imports into Trident, or deletes from their backends, the untracked volumes found by
+// GetOrphanReport.  Unless the request explicitly turns off its dry run, nothing is changed.  The outcome for
+// each volume acted upon is recorded in the returned report.
+func (o *TridentOrchestrator) ResolveOrphans(
+	ctx context.Context, request *storage.ResolveOrphansRequest,
+) (report *storage.OrphanReport, err error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return nil, o.bootstrapError
+	}
+	if err = request.Validate(); err != nil {
+		return nil, errors.InvalidInputError(err.Error())
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "core.ResolveOrphans")
+	defer tracing.EndSpan(span, &err)
+	defer recordTiming("orphan_resolve", &err)()
+
+	o.mutex.Lock()
+	scan, err := o.scanForOrphans(ctx, request.Backend)
+	o.mutex.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	selected := scan.report.UntrackedVolumes
+	if len(request.Volumes) > 0 {
+		selected = make([]*storage.UntrackedVolume, 0, len(request.Volumes))
+		for _, internalName := range request.Volumes {
+			found := false
+			for _, untracked := range scan.report.UntrackedVolumes {
+				if untracked.InternalName == internalName {
+					selected = append(selected, untracked)
+					found = true
+				}
+			}
+			if !found {
+				return nil, errors.NotFoundError("volume %s is not an untracked volume", internalName)
 			}
+		}
+	}
 
-			switch oldTxn.Op {
-			case storage.DeleteVolume, storage.DeleteSnapshot:
-				return fmt.Errorf(
-					"rejecting the %v transaction after successful completion of a preexisting %v transaction",
-					volTxn.Op, oldTxn.Op)
+	for _, untracked := range selected {
+		fields := LogFields{
+			"action":       request.Action,
+			"backend":      untracked.Backend,
+			"internalName": untracked.InternalName,
+		}
+
+		if request.IsDryRun() {
+			untracked.Resolution = storage.OrphanResolutionDryRun
+			Logc(ctx).WithFields(fields).Info("Dry run; untracked volume not resolved.")
+			continue
+		}
+
+		var resolveErr error
+		switch request.Action {
+		case storage.OrphanActionImport:
+			if resolveErr = o.importUntrackedVolume(ctx, untracked, request.StorageClass); resolveErr == nil {
+				untracked.Resolution = storage.OrphanResolutionImported
+			}
+		case storage.OrphanActionDelete:
+			if resolveErr = o.deleteUntrackedVolume(ctx, untracked, scan.configs[untracked]); resolveErr == nil {
+				untracked.Resolution = storage.OrphanResolutionDeleted
 			}
+		}
+
+		if resolveErr != nil {
+			Logc(ctx).WithFields(fields).WithError(resolveErr).Error("Could not resolve untracked volume.")
+			untracked.Error = resolveErr.Error()
 		} else {
-			return errors.FoundError("volume transaction already exists")
+			Logc(ctx).WithFields(fields).Info("Resolved untracked volume.")
 		}
 	}
 
-	return o.storeClient.AddVolumeTransaction(ctx, volTxn)
+	if !request.IsDryRun() {
+		o.mutex.Lock()
+		updateOrphanMetrics(scan, request.Backend == "")
+		o.mutex.Unlock()
+	}
+
+	return scan.report, nil
 }
 
-func (o *TridentOrchestrator) GetVolumeCreatingTransaction(
-	ctx context.Context, config *storage.VolumeConfig,
-) (*storage.VolumeTransaction, error) {
-	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+// orphanScan is the result of scanning backends for orphans, along with what is needed to act upon it.
+type orphanScan struct {
+	report   *storage.OrphanReport
+	backends []storage.Backend
+	configs  map[*storage.UntrackedVolume]*storage.VolumeConfig // backend's configs of the untracked volumes
+}
 
-	volTxn := &storage.VolumeTransaction{
-		VolumeCreatingConfig: &storage.VolumeCreatingConfig{
-			VolumeConfig: *config,
-		},
-		Op: storage.VolumeCreating,
+// scanForOrphans builds an orphan report for the named backend, or for all backends if no name is given, and
+// updates the orphan metrics to match.  The caller must hold the orchestrator mutex for writing, which is
+// released while each backend is read.
+func (o *TridentOrchestrator) scanForOrphans(ctx context.Context, backendName string) (*orphanScan, error) {
+	scan := &orphanScan{
+		report:   storage.NewOrphanReport(),
+		backends: make([]storage.Backend, 0),
+		configs:  make(map[*storage.UntrackedVolume]*storage.VolumeConfig),
 	}
 
-	if txn, err := o.storeClient.GetVolumeTransaction(ctx, volTxn); err != nil {
-		return nil, err
-	} else if txn != nil && txn.Op == storage.VolumeCreating {
-		return txn, nil
+	if backendName != "" {
+		backend, err := o.getBackendByBackendName(backendName)
+		if err != nil {
+			return nil, err
+		}
+		scan.backends = append(scan.backends, backend)
 	} else {
-		return nil, nil
+		scan.backends = o.getBackendsSortedByName()
 	}
-}
-
-func (o *TridentOrchestrator) GetVolumeTransaction(
-	ctx context.Context, volTxn *storage.VolumeTransaction,
-) (*storage.VolumeTransaction, error) {
-	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
-
-	return o.storeClient.GetVolumeTransaction(ctx, volTxn)
-}
 
-// DeleteVolumeTransaction deletes a volume transaction created by
-// addVolumeTransaction.
-func (o *TridentOrchestrator) DeleteVolumeTransaction(ctx context.Context, volTxn *storage.VolumeTransaction) error {
-	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+	for _, backend := range scan.backends {
+		if err := o.scanBackendForOrphans(ctx, backend, scan); err != nil {
+			Logc(ctx).WithField("backend", backend.Name()).WithError(err).Warn("Could not scan backend for orphans.")
+			scan.report.BackendErrors[backend.Name()] = err.Error()
+		}
+	}
 
-	return o.storeClient.DeleteVolumeTransaction(ctx, volTxn)
+	updateOrphanMetrics(scan, backendName == "")
+	return scan, nil
 }
 
-// addVolumeCleanup is used as a deferred method from the volume create/clone methods
-// to clean up in case anything goes wrong during the operation.
-func (o *TridentOrchestrator) addVolumeCleanup(
-	ctx context.Context, err error, backend storage.Backend, vol *storage.Volume,
-	volTxn *storage.VolumeTransaction, volumeConfig *storage.VolumeConfig,
+// scanBackendForOrphans adds the differences between a backend's inventory and the volumes and snapshots that
+// Trident tracks on it to the scan.  The caller must hold the orchestrator mutex for writing.
+func (o *TridentOrchestrator) scanBackendForOrphans(
+	ctx context.Context, backend storage.Backend, scan *orphanScan,
 ) error {
-	var cleanupErr, txErr error
+	if !backend.State().IsOnline() {
+		return fmt.Errorf("backend is %s", backend.State())
+	}
 
-	// If in a retry situation, we already handled the error in addVolumeRetryCleanup.
-	if errors.IsVolumeCreatingError(err) {
+	unlock, err := o.rLockBackend(backend)
+	if err != nil {
 		return err
 	}
+	defer unlock()
 
+	var inventory []*storage.VolumeExternal
+	o.unlockedDuring(func() {
+		inventory, err = getBackendInventory(ctx, backend)
+	})
 	if err != nil {
-		// We failed somewhere.  There are two possible cases:
-		// 1.  We failed to allocate on a backend and fell through to the
-		//     end of the function.  In this case, we don't need to roll
-		//     anything back.
-		// 2.  We failed to add the volume to the store.  In this case, we need
-		//     to remove the volume from the backend.
-		if backend != nil && vol != nil {
-			// We succeeded in adding the volume to the backend; now
-			// delete it.
-			cleanupErr = backend.RemoveVolume(ctx, vol.Config)
-			if cleanupErr != nil {
-				cleanupErr = fmt.Errorf("unable to delete volume from backend during cleanup:  %v", cleanupErr)
-			}
-		}
+		return err
 	}
-	if cleanupErr == nil {
-		// Only clean up the volume transaction if we've succeeded at
-		// cleaning up on the backend or if we didn't need to do so in the
-		// first place.
-		txErr = o.DeleteVolumeTransaction(ctx, volTxn)
-		if txErr != nil {
-			txErr = fmt.Errorf("unable to clean up add volume transaction; %v", txErr)
-		}
+
+	// Volumes being created, imported or moved may exist on the backend before Trident tracks them
+	pending, err := o.pendingVolumeInternalNames(ctx)
+	if err != nil {
+		return err
 	}
-	if cleanupErr != nil || txErr != nil {
-		// Remove the volume from memory, if it's there, so that the user
-		// can try to re-add.  This will trigger recovery code.
-		delete(o.volumes, volumeConfig.Name)
 
-		// Report on all errors we encountered.
-		errList := make([]string, 0, 3)
-		for _, e := range []error{err, cleanupErr, txErr} {
-			if e != nil {
-				errList = append(errList, e.Error())
-			}
-		}
-		err = fmt.Errorf(strings.Join(errList, ", "))
-		Logc(ctx).Warnf(
-			"Unable to clean up artifacts of volume creation; %v. Repeat creating the volume or restart %v.",
-			err, config.OrchestratorName)
+	onBackend := make(map[string]bool, len(inventory))
+	for _, volume := range inventory {
+		onBackend[volume.Config.InternalName] = true
 	}
-	return err
+
+	snapshotsByVolume := make(map[string][]*storage.SnapshotConfig)
+	for _, snapshot := range o.snapshots {
+		snapshotsByVolume[snapshot.Config.VolumeName] = append(snapshotsByVolume[snapshot.Config.VolumeName],
+			snapshot.Config)
+	}
+
+	// Backends that share storage, such as ONTAP backends on one SVM with the same storage prefix, may each
+	// report the others' volumes, so a volume is untracked only if no backend's volume has its name
+	tracked := make(map[string]bool, len(o.volumes))
+	unlisted := make([]*storage.Volume, 0)
+	present := make([]*storage.VolumeConfig, 0)
+	for _, volume := range o.volumes {
+		tracked[volume.Config.InternalName] = true
+		if volume.BackendUUID != backend.BackendUUID() {
+			continue
+		}
+
+		// A read-only clone has no volume of its own on the backend
+		if volume.Config.ReadOnlyClone {
+			continue
+		}
+		if !onBackend[volume.Config.InternalName] {
+			unlisted = append(unlisted, volume)
+		} else if volume.Orphaned {
+			scan.report.MissingVolumes = append(scan.report.MissingVolumes,
+				newMissingVolume(volume, backend, storage.OrphanReasonOrphaned))
+		} else if len(snapshotsByVolume[volume.Config.Name]) > 0 {
+			present = append(present, volume.Config.ConstructClone())
+		}
+	}
+
+	for _, volume := range inventory {
+		internalName := volume.Config.InternalName
+		if tracked[internalName] || pending[internalName] {
+			continue
+		}
+		name := volume.Config.Name
+		if name == "" {
+			name = internalName
+		}
+		untracked := &storage.UntrackedVolume{
+			Name:         name,
+			InternalName: internalName,
+			Size:         volume.Config.Size,
+			Backend:      backend.Name(),
+			BackendUUID:  backend.BackendUUID(),
+		}
+		scan.report.UntrackedVolumes = append(scan.report.UntrackedVolumes, untracked)
+		scan.configs[untracked] = volume.Config
+	}
+
+	// Not every volume Trident tracks is listed in the inventory, such as volumes imported without being
+	// managed, so look up the unlisted ones individually before reporting them missing.  A volume that could
+	// not be looked up is reported as a backend error instead, since it may well exist.
+	missing := make(map[string]bool)
+	unverified := make([]string, 0)
+	var missingSnapshots []*storage.SnapshotConfig
+	o.unlockedDuring(func() {
+		for _, volume := range unlisted {
+			if getErr := backend.Driver().Get(ctx, volume.Config.InternalName); errors.IsNotFoundError(getErr) {
+				missing[volume.Config.Name] = true
+			} else if getErr != nil {
+				unverified = append(unverified, fmt.Sprintf("could not check for volume %s; %v",
+					volume.Config.Name, getErr))
+			}
+		}
+		for _, volConfig := range present {
+			missingSnapshots = append(missingSnapshots,
+				getMissingSnapshots(ctx, backend, volConfig, snapshotsByVolume[volConfig.Name])...)
+		}
+	})
+
+	for _, volume := range unlisted {
+		if missing[volume.Config.Name] {
+			scan.report.MissingVolumes = append(scan.report.MissingVolumes,
+				newMissingVolume(volume, backend, storage.OrphanReasonNotFound))
+		} else if volume.Orphaned {
+			scan.report.MissingVolumes = append(scan.report.MissingVolumes,
+				newMissingVolume(volume, backend, storage.OrphanReasonOrphaned))
+		}
+	}
+	if len(unverified) > 0 {
+		sort.Strings(unverified)
+		scan.report.BackendErrors[backend.Name()] = strings.Join(unverified, "; ")
+	}
+	for _, snapConfig := range missingSnapshots {
+		scan.report.MissingSnapshots = append(scan.report.MissingSnapshots, &storage.MissingSnapshot{
+			Name:         snapConfig.Name,
+			InternalName: snapConfig.InternalName,
+			VolumeName:   snapConfig.VolumeName,
+			Backend:      backend.Name(),
+			BackendUUID:  backend.BackendUUID(),
+		})
+	}
+
+	sort.Slice(scan.report.UntrackedVolumes, func(i, j int) bool {
+		a, b := scan.report.UntrackedVolumes[i], scan.report.UntrackedVolumes[j]
+		return a.Backend < b.Backend || (a.Backend == b.Backend && a.InternalName < b.InternalName)
+	})
+	sort.Slice(scan.report.MissingVolumes, func(i, j int) bool {
+		return scan.report.MissingVolumes[i].Name < scan.report.MissingVolumes[j].Name
+	})
+	sort.Slice(scan.report.MissingSnapshots, func(i, j int) bool {
+		a, b := scan.report.MissingSnapshots[i], scan.report.MissingSnapshots[j]
+		return a.VolumeName < b.VolumeName || (a.VolumeName == b.VolumeName && a.Name < b.Name)
+	})
+
+	return nil
+}
+
+// pendingVolumeInternalNames returns the internal names of the volumes with outstanding transactions.
+func (o *TridentOrchestrator) pendingVolumeInternalNames(ctx context.Context) (map[string]bool, error) {
+	txns, err := o.storeClient.GetVolumeTransactions(ctx)
+	if err != nil && !persistentstore.MatchKeyNotFoundErr(err) {
+		return nil, err
+	}
+
+	pending := make(map[string]bool)
+	for _, txn := range txns {
+		if txn.Config != nil {
+			pending[txn.Config.InternalName] = true
+		}
+		if txn.VolumeCreatingConfig != nil {
+			pending[txn.VolumeCreatingConfig.InternalName] = true
+		}
+		if txn.VolumeMoveConfig != nil {
+			pending[txn.VolumeMoveConfig.InternalName] = true
+			if txn.VolumeMoveConfig.DestinationConfig != nil {
+				pending[txn.VolumeMoveConfig.DestinationConfig.InternalName] = true
+			}
+		}
+	}
+	delete(pending, "")
+	return pending, nil
+}
+
+// getBackendInventory reads all volumes owned by a backend's driver from the storage backend.
+func getBackendInventory(ctx context.Context, backend storage.Backend) ([]*storage.VolumeExternal, error) {
+	channel := make(chan *storage.VolumeExternalWrapper)
+	go backend.Driver().GetVolumeExternalWrappers(ctx, channel)
+
+	// Drain the channel even after an error, so that the driver can finish
+	volumes := make([]*storage.VolumeExternal, 0)
+	var err error
+	for wrapper := range channel {
+		if wrapper.Error != nil {
+			err = wrapper.Error
+		} else if wrapper.Volume != nil && wrapper.Volume.Config != nil {
+			volumes = append(volumes, wrapper.Volume)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read volumes from backend; %v", err)
+	}
+	return volumes, nil
+}
+
+// getMissingSnapshots returns those of the tracked snapshots of a volume that its backend does not report.
+func getMissingSnapshots(
+	ctx context.Context, backend storage.Backend, volConfig *storage.VolumeConfig,
+	tracked []*storage.SnapshotConfig,
+) []*storage.SnapshotConfig {
+	snapshots, err := backend.GetSnapshots(ctx, volConfig)
+	if err != nil {
+		Logc(ctx).WithFields(LogFields{
+			"backend": backend.Name(),
+			"volume":  volConfig.Name,
+		}).WithError(err).Warn("Could not read snapshots from backend.")
+		return nil
+	}
+
+	onBackend := make(map[string]bool, len(snapshots))
+	for _, snapshot := range snapshots {
+		onBackend[snapshot.Config.InternalName] = true
+	}
+
+	missing := make([]*storage.SnapshotConfig, 0)
+	for _, snapConfig := range tracked {
+		if !onBackend[snapConfig.InternalName] {
+			missing = append(missing, snapConfig)
+		}
+	}
+	return missing
+}
+
+func newMissingVolume(volume *storage.Volume, backend storage.Backend, reason string) *storage.MissingVolume {
+	return &storage.MissingVolume{
+		Name:         volume.Config.Name,
+		InternalName: volume.Config.InternalName,
+		Backend:      backend.Name(),
+		BackendUUID:  backend.BackendUUID(),
+		Orphaned:     volume.Orphaned,
+		Reason:       reason,
+	}
+}
+
+// importUntrackedVolume imports an untracked volume into Trident, under the name reported by its backend.
+func (o *TridentOrchestrator) importUntrackedVolume(
+	ctx context.Context, untracked *storage.UntrackedVolume, storageClass string,
+) error {
+	if _, err := o.GetVolume(ctx, untracked.Name); err == nil {
+		return errors.FoundError("a volume named %s already exists", untracked.Name)
+	}
+
+	volConfig := &storage.VolumeConfig{
+		Version:            config.OrchestratorAPIVersion,
+		Name:               untracked.Name,
+		Size:               untracked.Size,
+		StorageClass:       storageClass,
+		VolumeMode:         config.Filesystem,
+		AccessMode:         config.ModeAny,
+		Protocol:           config.ProtocolAny,
+		ImportOriginalName: untracked.InternalName,
+		ImportBackendUUID:  untracked.BackendUUID,
+	}
+	_, err := o.ImportVolume(ctx, volConfig)
+	return err
+}
+
+// deleteUntrackedVolume deletes an untracked volume from its backend, unless Trident has begun tracking it since
+// it was found.  A volume that any backend tracks is kept, since backends may share storage.
+func (o *TridentOrchestrator) deleteUntrackedVolume(
+	ctx context.Context, untracked *storage.UntrackedVolume, volConfig *storage.VolumeConfig,
+) error {
+	if volConfig == nil {
+		return fmt.Errorf("no configuration found for volume %s", untracked.InternalName)
+	}
+
+	defer o.lockVolumes(untracked.Name)()
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	backend, ok := o.backends[untracked.BackendUUID]
+	if !ok {
+		return errors.NotFoundError("backend %s not found", untracked.BackendUUID)
+	}
+	for _, volume := range o.volumes {
+		if volume.Config.InternalName == untracked.InternalName {
+			return errors.FoundError("volume %s is now tracked as %s", untracked.InternalName, volume.Config.Name)
+		}
+	}
+	pending, err := o.pendingVolumeInternalNames(ctx)
+	if err != nil {
+		return err
+	} else if pending[untracked.InternalName] {
+		return errors.FoundError("volume %s has a pending transaction", untracked.InternalName)
+	}
+
+	unlock, err := o.rLockBackend(backend)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	o.unlockedDuring(func() {
+		err = backend.RemoveVolume(ctx, volConfig.ConstructClone())
+	})
+	return err
+}
+
+// updateOrphanMetrics sets the orphan metrics of the scanned backends from the scan's report, discounting the
+// untracked volumes that have since been resolved.  If all backends were scanned, the metrics of any others are
+// cleared.
+func updateOrphanMetrics(scan *orphanScan, allBackends bool) {
+	if allBackends {
+		orphanVolumesGauge.Reset()
+		orphanSnapshotsGauge.Reset()
+	}
+
+	driverNames := make(map[string]string, len(scan.backends))
+	for _, backend := range scan.backends {
+		labels := prometheus.Labels{"backend_uuid": backend.BackendUUID()}
+		orphanVolumesGauge.DeletePartialMatch(labels)
+		orphanSnapshotsGauge.DeletePartialMatch(labels)
+
+		if _, failed := scan.report.BackendErrors[backend.Name()]; failed {
+			continue
+		}
+		driverNames[backend.BackendUUID()] = backend.GetDriverName()
+		orphanVolumesGauge.WithLabelValues(backend.GetDriverName(), backend.BackendUUID(), "untracked").Set(0)
+		orphanVolumesGauge.WithLabelValues(backend.GetDriverName(), backend.BackendUUID(), "missing").Set(0)
+		orphanSnapshotsGauge.WithLabelValues(backend.GetDriverName(), backend.BackendUUID()).Set(0)
+	}
+
+	for _, untracked := range scan.report.UntrackedVolumes {
+		if untracked.Resolution == storage.OrphanResolutionImported ||
+			untracked.Resolution == storage.OrphanResolutionDeleted {
+			continue
+		}
+		orphanVolumesGauge.WithLabelValues(driverNames[untracked.BackendUUID], untracked.BackendUUID,
+			"untracked").Inc()
+	}
+	for _, missing := range scan.report.MissingVolumes {
+		orphanVolumesGauge.WithLabelValues(driverNames[missing.BackendUUID], missing.BackendUUID, "missing").Inc()
+	}
+	for _, missing := range scan.report.MissingSnapshots {
+		orphanSnapshotsGauge.WithLabelValues(driverNames[missing.BackendUUID], missing.BackendUUID).Inc()
+	}
+}
+
+// AddVolumeTransaction is called from the volume create, clone, and resize
+// methods to save a record of the operation in case it fails and must be
+// cleaned up later.
+func (o *TridentOrchestrator) AddVolumeTransaction(ctx context.Context, volTxn *storage.VolumeTransaction) error {
+	// Check if a transaction already exists for this volume. This condition
+	// can occur if we failed to clean up the transaction object during the
+	// last operation on the volume. The check for a preexisting transaction
+	// allows recovery from a failure by repeating the operation and without
+	// having to restart Trident. It's important to note that repeating a
+	// failed transaction may have side-effects on the new transaction (e.g.,
+	// repeating a failed volume delete before a volume resize or clone).
+	// Therefore, it's important that the implementation can take care of such
+	// scenarios. The current implementation allows only one outstanding
+	// transaction per volume.
+	// If a transaction is found, we failed in cleaning up the transaction
+	// earlier and we need to call the bootstrap cleanup code. If this fails,
+	// return an error. If no transaction existed or the operation succeeds,
+	// log a new transaction in the persistent store and proceed.
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	oldTxn, err := o.storeClient.GetVolumeTransaction(ctx, volTxn)
+	if err != nil {
+		Logc(ctx).Errorf("Unable to check for a preexisting volume transaction: %v", err)
+		return err
+	}
+	if oldTxn != nil {
+		if oldTxn.Op == storage.MoveVolume && oldTxn.VolumeMoveConfig.State != storage.VolumeMoveStateCommitted {
+			// A volume move is resumable, so it must be completed or abandoned before anything else is done
+			return errors.VolumeStateError(fmt.Sprintf("volume %s is being moved", oldTxn.Name()))
+		} else if oldTxn.Op != storage.VolumeCreating {
+			err = o.handleFailedTransaction(ctx, oldTxn)
+			if err != nil {
+				return fmt.Errorf("unable to process the preexisting transaction for volume %s:  %v",
+					volTxn.Name(), err)
+			}
+
+			switch oldTxn.Op {
+			case storage.DeleteVolume, storage.DeleteSnapshot:
+				return fmt.Errorf(
+					"rejecting the %v transaction after successful completion of a preexisting %v transaction",
+					volTxn.Op, oldTxn.Op)
+			}
+		} else {
+			return errors.FoundError("volume transaction already exists")
+		}
+	}
+
+	return o.storeClient.AddVolumeTransaction(ctx, volTxn)
+}
+
+func (o *TridentOrchestrator) GetVolumeCreatingTransaction(
+	ctx context.Context, config *storage.VolumeConfig,
+) (*storage.VolumeTransaction, error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	volTxn := &storage.VolumeTransaction{
+		VolumeCreatingConfig: &storage.VolumeCreatingConfig{
+			VolumeConfig: *config,
+		},
+		Op: storage.VolumeCreating,
+	}
+
+	if txn, err := o.storeClient.GetVolumeTransaction(ctx, volTxn); err != nil {
+		return nil, err
+	} else if txn != nil && txn.Op == storage.VolumeCreating {
+		return txn, nil
+	} else {
+		return nil, nil
+	}
+}
+
+func (o *TridentOrchestrator) GetVolumeTransaction(
+	ctx context.Context, volTxn *storage.VolumeTransaction,
+) (*storage.VolumeTransaction, error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	return o.storeClient.GetVolumeTransaction(ctx, volTxn)
+}
+
+// DeleteVolumeTransaction deletes a volume transaction created by
+// addVolumeTransaction.
+func (o *TridentOrchestrator) DeleteVolumeTransaction(ctx context.Context, volTxn *storage.VolumeTransaction) error {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	return o.storeClient.DeleteVolumeTransaction(ctx, volTxn)
+}
+
+// addVolumeCleanup is used as a deferred method from the volume create/clone methods
+// to clean up in case anything goes wrong during the operation.
+func (o *TridentOrchestrator) addVolumeCleanup(
+	ctx context.Context, err error, backend storage.Backend, vol *storage.Volume,
+	volTxn *storage.VolumeTransaction, volumeConfig *storage.VolumeConfig,
+) error {
+	var cleanupErr, txErr error
+
+	// If in a retry situation, we already handled the error in addVolumeRetryCleanup.
+	if errors.IsVolumeCreatingError(err) {
+		return err
+	}
+
+	if err != nil {
+		// We failed somewhere.  There are two possible cases:
+		// 1.  We failed to allocate on a backend and fell through to the
+		//     end of the function.  In this case, we don't need to roll
+		//     anything back.
+		// 2.  We failed to add the volume to the store.  In this case, we need
+		//     to remove the volume from the backend.
+		if backend != nil && vol != nil {
+			// We succeeded in adding the volume to the backend; now
+			// delete it.
+			cleanupErr = backend.RemoveVolume(ctx, vol.Config)
+			if cleanupErr != nil {
+				cleanupErr = fmt.Errorf("unable to delete volume from backend during cleanup:  %v", cleanupErr)
+			}
+		}
+	}
+	if cleanupErr == nil {
+		// Only clean up the volume transaction if we've succeeded at
+		// cleaning up on the backend or if we didn't need to do so in the
+		// first place.
+		txErr = o.DeleteVolumeTransaction(ctx, volTxn)
+		if txErr != nil {
+			txErr = fmt.Errorf("unable to clean up add volume transaction; %v", txErr)
+		}
+	}
+	if cleanupErr != nil || txErr != nil {
+		// Remove the volume from memory, if it's there, so that the user
+		// can try to re-add.  This will trigger recovery code.
+		delete(o.volumes, volumeConfig.Name)
+
+		// Report on all errors we encountered.
+		errList := make([]string, 0, 3)
+		for _, e := range []error{err, cleanupErr, txErr} {
+			if e != nil {
+				errList = append(errList, e.Error())
+			}
+		}
+		err = fmt.Errorf(strings.Join(errList, ", "))
+		Logc(ctx).Warnf(
+			"Unable to clean up artifacts of volume creation; %v. Repeat creating the volume or restart %v.",
+			err, config.OrchestratorName)
+	}
+	return err
 }
 
 // addVolumeRetryCleanup is used as a deferred method from the volume create/clone methods
@@ -2999,10 +3711,12 @@ func (o *TridentOrchestrator) GetVolume(
 		return nil, o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.GetVolume", tracing.Volume(volumeName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("volume_get", &err)()
 
-	o.mutex.Lock()
-	defer o.mutex.Unlock()
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
 
 	return o.getVolume(ctx, volumeName)
 }
@@ -3019,28 +3733,124 @@ func (o *TridentOrchestrator) getVolume(
 	return nil, errors.NotFoundError("volume %v was not found", volumeName)
 }
 
-// driverTypeForBackend does the necessary work to get the driver type.  It does
-// not construct a transaction, nor does it take locks; it assumes that the
-// caller will take care of both of these.  It also assumes that the backend
-// exists in memory.
-func (o *TridentOrchestrator) driverTypeForBackend(backendUUID string) (string, error) {
-	if b, ok := o.backends[backendUUID]; ok {
-		return b.GetDriverName(), nil
-	}
-	return config.UnknownDriver, fmt.Errorf("unknown backend with UUID %s", backendUUID)
-}
-
-func (o *TridentOrchestrator) ListVolumes(ctx context.Context) (volumes []*storage.VolumeExternal, err error) {
+// GetVolumeHealth checks the condition of a volume on its storage backend and caches the result, so
+// that it may be reported later by ListVolumeHealth.
+func (o *TridentOrchestrator) GetVolumeHealth(
+	ctx context.Context, volumeName string,
+) (health *storage.VolumeHealth, err error) {
 	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
 
 	if o.bootstrapError != nil {
 		return nil, o.bootstrapError
 	}
 
-	defer recordTiming("volume_list", &err)()
+	ctx, span := tracing.StartSpan(ctx, "core.GetVolumeHealth", tracing.Volume(volumeName))
+	defer tracing.EndSpan(span, &err)
+	defer recordTiming("volume_health", &err)()
+
+	return o.getVolumeHealth(ctx, volumeName)
+}
+
+// getVolumeHealth checks and caches the condition of a volume.  A subordinate volume reports the
+// condition of its source volume.  The caller must not hold the orchestrator lock, which is taken
+// here and released while the backend checks the volume.
+func (o *TridentOrchestrator) getVolumeHealth(
+	ctx context.Context, volumeName string,
+) (*storage.VolumeHealth, error) {
+	defer o.lockVolumeSet(nil, []string{volumeName}, false)()
 
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
+
+	unlockBackend, err := o.rLockVolumeBackend(volumeName)
+	if err != nil {
+		return nil, err
+	}
+	defer unlockBackend()
+
+	volume, found := o.volumes[volumeName]
+	if !found {
+		subordinateVolume, found := o.subordinateVolumes[volumeName]
+		if !found {
+			return nil, errors.NotFoundError("volume %v was not found", volumeName)
+		}
+		if volume, found = o.volumes[subordinateVolume.Config.ShareSourceVolume]; !found {
+			return nil, errors.NotFoundError("source volume %v of subordinate volume %v was not found",
+				subordinateVolume.Config.ShareSourceVolume, volumeName)
+		}
+	}
+
+	var health *storage.VolumeHealth
+	if backend, found := o.backends[volume.BackendUUID]; !found {
+		health = storage.NewVolumeHealth(storage.VolumeHealthMissing,
+			fmt.Sprintf("backend %s of volume %s was not found", volume.BackendUUID, volumeName))
+	} else {
+		o.unlockedDuring(func() {
+			health, err = backend.GetVolumeHealth(ctx, volume.Config)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	o.volumeHealth[volumeName] = health
+	return health, nil
+}
+
+// ListVolumeHealth returns the most recently observed condition of each volume, keyed by volume name.
+// Volumes whose health has not yet been checked are omitted.
+func (o *TridentOrchestrator) ListVolumeHealth(
+	ctx context.Context,
+) (health map[string]*storage.VolumeHealth, err error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return nil, o.bootstrapError
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "core.ListVolumeHealth")
+	defer tracing.EndSpan(span, &err)
+	defer recordTiming("volume_health_list", &err)()
+
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
+
+	health = make(map[string]*storage.VolumeHealth, len(o.volumeHealth))
+	for volumeName, volumeHealth := range o.volumeHealth {
+		_, isVolume := o.volumes[volumeName]
+		_, isSubordinateVolume := o.subordinateVolumes[volumeName]
+		if isVolume || isSubordinateVolume {
+			health[volumeName] = volumeHealth
+		}
+	}
+
+	return health, nil
+}
+
+// driverTypeForBackend does the necessary work to get the driver type.  It does
+// not construct a transaction, nor does it take locks; it assumes that the
+// caller will take care of both of these.  It also assumes that the backend
+// exists in memory.
+func (o *TridentOrchestrator) driverTypeForBackend(backendUUID string) (string, error) {
+	if b, ok := o.backends[backendUUID]; ok {
+		return b.GetDriverName(), nil
+	}
+	return config.UnknownDriver, fmt.Errorf("unknown backend with UUID %s", backendUUID)
+}
+
+func (o *TridentOrchestrator) ListVolumes(ctx context.Context) (volumes []*storage.VolumeExternal, err error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return nil, o.bootstrapError
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "core.ListVolumes")
+	defer tracing.EndSpan(span, &err)
+	defer recordTiming("volume_list", &err)()
+
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
 	volumes = make([]*storage.VolumeExternal, 0, len(o.volumes)+len(o.subordinateVolumes))
 	for _, v := range o.volumes {
 		volumes = append(volumes, v.ConstructExternal())
@@ -3073,7 +3883,8 @@ func (o *TridentOrchestrator) volumeSnapshots(volumeName string) ([]*storage.Sna
 // deleteVolume does the necessary work to delete a volume entirely.  It does
 // not construct a transaction, nor does it take locks; it assumes that the
 // caller will take care of both of these.  It also assumes that the volume
-// exists in memory.
+// exists in memory.  The orchestrator mutex is released while the backend
+// deletes the volume.
 func (o *TridentOrchestrator) deleteVolume(ctx context.Context, volumeName string) error {
 	volume := o.volumes[volumeName]
 	volumeBackend := o.backends[volume.BackendUUID]
@@ -3110,13 +3921,17 @@ func (o *TridentOrchestrator) deleteVolume(ctx context.Context, volumeName strin
 			return err
 		}
 		delete(o.volumes, volumeName)
+		delete(o.volumeHealth, volumeName)
 		return nil
 	}
 
 	// Note that this call will only return an error if the backend actually
 	// fails to delete the volume.  If the volume does not exist on the backend,
 	// the driver will not return an error.  Thus, we're fine.
-	if err := volumeBackend.RemoveVolume(ctx, volume.Config); err != nil {
+	o.unlockedDuring(func() {
+		err = volumeBackend.RemoveVolume(ctx, volume.Config)
+	})
+	if err != nil {
 		if !errors.IsNotManagedError(err) {
 			Logc(ctx).WithFields(LogFields{
 				"volume":      volumeName,
@@ -3160,6 +3975,7 @@ func (o *TridentOrchestrator) deleteVolume(ctx context.Context, volumeName strin
 		delete(o.backends, volume.BackendUUID)
 	}
 	delete(o.volumes, volumeName)
+	delete(o.volumeHealth, volumeName)
 	return nil
 }
 
@@ -3173,12 +3989,22 @@ func (o *TridentOrchestrator) DeleteVolume(ctx context.Context, volumeName strin
 		return o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.DeleteVolume", tracing.Volume(volumeName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("volume_delete", &err)()
 
+	defer o.lockVolumeSet([]string{volumeName}, nil, true)()
+
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
 	defer o.updateMetrics()
 
+	unlockBackend, err := o.rLockVolumeBackend(volumeName)
+	if err != nil {
+		return err
+	}
+	defer unlockBackend()
+
 	if _, ok := o.subordinateVolumes[volumeName]; ok {
 		return o.deleteSubordinateVolume(ctx, volumeName)
 	}
@@ -3235,6 +4061,8 @@ func (o *TridentOrchestrator) PublishVolume(
 		return o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.PublishVolume", tracing.Volume(volumeName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("volume_publish", &err)()
 
 	fields := LogFields{
@@ -3243,9 +4071,18 @@ func (o *TridentOrchestrator) PublishVolume(
 	}
 	Logc(ctx).WithFields(fields).Info("Publishing volume to node.")
 
+	defer o.lockVolumes(volumeName)()
+	defer o.nodeLocks.Lock(publishInfo.HostName)()
+
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
 
+	unlockBackend, err := o.rLockVolumeBackend(volumeName)
+	if err != nil {
+		return err
+	}
+	defer unlockBackend()
+
 	return o.publishVolume(ctx, volumeName, publishInfo)
 }
 
@@ -3365,10 +4202,18 @@ func (o *TridentOrchestrator) publishVolume(
 		return err
 	}
 
-	err := backend.PublishVolume(ctx, volume.Config, publishInfo)
+	// The orchestrator mutex is released while the backend publishes the volume, so give the backend a copy
+	// of the volume config to update, since other operations may read the original in the meantime.
+	var err error
+	volumeConfig := volume.Config.ConstructClone()
+	o.unlockedDuring(func() {
+		err = backend.PublishVolume(ctx, volumeConfig, publishInfo)
+	})
 	if err != nil {
 		return err
 	}
+	volume.Config = volumeConfig
+
 	if err := o.updateVolumeOnPersistentStore(ctx, volume); err != nil {
 		Logc(ctx).WithFields(LogFields{
 			"volume": volume.Config.Name,
@@ -3418,6 +4263,8 @@ func (o *TridentOrchestrator) UnpublishVolume(ctx context.Context, volumeName, n
 		return o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.UnpublishVolume", tracing.Volume(volumeName), tracing.Node(nodeName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("volume_unpublish", &err)()
 
 	fields := LogFields{
@@ -3426,9 +4273,18 @@ func (o *TridentOrchestrator) UnpublishVolume(ctx context.Context, volumeName, n
 	}
 	Logc(ctx).WithFields(fields).Info("Unpublishing volume from node.") // audit trail
 
+	defer o.lockVolumes(volumeName)()
+	defer o.nodeLocks.Lock(nodeName)()
+
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
 
+	unlockBackend, err := o.rLockVolumeBackend(volumeName)
+	if err != nil {
+		return err
+	}
+	defer unlockBackend()
+
 	return o.unpublishVolume(ctx, volumeName, nodeName)
 }
 
@@ -3507,13 +4363,18 @@ func (o *TridentOrchestrator) unpublishVolume(ctx context.Context, volumeName, n
 		return fmt.Errorf("backend %s not found", volume.BackendUUID)
 	}
 
-	// Unpublish the volume.
-	if err := backend.UnpublishVolume(ctx, volume.Config, publishInfo); err != nil {
+	// Unpublish the volume, giving the backend a copy of the volume config as publishVolume does.
+	volumeConfig := volume.Config.ConstructClone()
+	o.unlockedDuring(func() {
+		err = backend.UnpublishVolume(ctx, volumeConfig, publishInfo)
+	})
+	if err != nil {
 		if !errors.IsNotFoundError(err) {
 			return err
 		}
 		Logc(ctx).Debug("Volume not found in backend during unpublish; continuing with unpublish.")
 	}
+	volume.Config = volumeConfig
 
 	if err := o.updateVolumeOnPersistentStore(ctx, volume); err != nil {
 		Logc(ctx).WithFields(LogFields{
@@ -3553,8 +4414,12 @@ func (o *TridentOrchestrator) AttachVolume(
 		return o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.AttachVolume", tracing.Volume(volumeName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("volume_attach", &err)()
 
+	defer o.lockVolumes(volumeName)()
+
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
 
@@ -3649,6 +4514,8 @@ func (o *TridentOrchestrator) DetachVolume(ctx context.Context, volumeName, moun
 		return o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.DetachVolume", tracing.Volume(volumeName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("volume_detach", &err)()
 
 	volume, ok := o.volumes[volumeName]
@@ -3700,8 +4567,12 @@ func (o *TridentOrchestrator) SetVolumeState(
 		return o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.SetVolumeState", tracing.Volume(volumeName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("volume_set_state", &err)()
 
+	defer o.lockVolumes(volumeName)()
+
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
 	defer o.updateMetrics()
@@ -3862,6 +4733,7 @@ func (o *TridentOrchestrator) deleteSubordinateVolume(ctx context.Context, volum
 	}
 
 	delete(o.subordinateVolumes, volumeName)
+	delete(o.volumeHealth, volumeName)
 	return nil
 }
 
@@ -3876,10 +4748,12 @@ func (o *TridentOrchestrator) ListSubordinateVolumes(
 		return nil, o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.ListSubordinateVolumes", tracing.Volume(sourceVolumeName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("subordinate_volume_list", &err)()
 
-	o.mutex.Lock()
-	defer o.mutex.Unlock()
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
 
 	var sourceVolumes map[string]*storage.Volume
 
@@ -3921,10 +4795,12 @@ func (o *TridentOrchestrator) GetSubordinateSourceVolume(
 		return nil, o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.GetSubordinateSourceVolume", tracing.Volume(subordinateVolumeName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("subordinate_source_volume_get", &err)()
 
-	o.mutex.Lock()
-	defer o.mutex.Unlock()
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
 
 	if subordinateVolume, ok := o.subordinateVolumes[subordinateVolumeName]; !ok {
 		return nil, errors.NotFoundError("subordinate volume %s not found", subordinateVolumeName)
@@ -4009,12 +4885,23 @@ func (o *TridentOrchestrator) CreateSnapshot(
 		return nil, o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.CreateSnapshot",
+		tracing.Volume(snapshotConfig.VolumeName), tracing.Snapshot(snapshotConfig.Name))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("snapshot_create", &err)()
 
+	defer o.lockVolumes(snapshotConfig.VolumeName)()
+
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
 	defer o.updateMetrics()
 
+	unlockBackend, err := o.rLockVolumeBackend(snapshotConfig.VolumeName)
+	if err != nil {
+		return nil, err
+	}
+	defer unlockBackend()
+
 	// Check if the snapshot already exists
 	if _, ok := o.snapshots[snapshotConfig.ID()]; ok {
 		return nil, fmt.Errorf("snapshot %s already exists", snapshotConfig.ID())
@@ -4067,7 +4954,9 @@ func (o *TridentOrchestrator) CreateSnapshot(
 	}()
 
 	// Create the snapshot
-	snapshot, err = backend.CreateSnapshot(ctx, snapshotConfig, volume.Config)
+	o.unlockedDuring(func() {
+		snapshot, err = backend.CreateSnapshot(ctx, snapshotConfig, volume.Config)
+	})
 	if err != nil {
 		if errors.IsMaxLimitReachedError(err) {
 			return nil, errors.MaxLimitReachedError(fmt.Sprintf("failed to create snapshot %s for volume %s on backend %s: %v",
@@ -4111,8 +5000,13 @@ func (o *TridentOrchestrator) ImportSnapshot(
 		return nil, o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.ImportSnapshot",
+		tracing.Volume(snapshotConfig.VolumeName), tracing.Snapshot(snapshotConfig.Name))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("snapshot_import", &err)()
 
+	defer o.lockVolumes(snapshotConfig.VolumeName)()
+
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
 	defer o.updateMetrics()
@@ -4226,6 +5120,8 @@ func (o *TridentOrchestrator) GetSnapshot(
 		return nil, o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.GetSnapshot", tracing.Volume(volumeName), tracing.Snapshot(snapshotName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("snapshot_get", &err)()
 
 	o.mutex.Lock()
@@ -4303,8 +5199,13 @@ func (o *TridentOrchestrator) RestoreSnapshot(ctx context.Context, volumeName, s
 		return o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.RestoreSnapshot",
+		tracing.Volume(volumeName), tracing.Snapshot(snapshotName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("snapshot_restore", &err)()
 
+	defer o.lockVolumes(volumeName)()
+
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
 	defer o.updateMetrics()
@@ -4350,7 +5251,8 @@ func (o *TridentOrchestrator) RestoreSnapshot(ctx context.Context, volumeName, s
 
 // deleteSnapshot does the necessary work to delete a snapshot entirely.  It does
 // not construct a transaction, nor does it take locks; it assumes that the caller will
-// take care of both of these.
+// take care of both of these.  The orchestrator mutex is released while the backend
+// deletes the snapshot.
 func (o *TridentOrchestrator) deleteSnapshot(ctx context.Context, snapshotConfig *storage.SnapshotConfig) error {
 	snapshotID := snapshotConfig.ID()
 	snapshot, ok := o.snapshots[snapshotID]
@@ -4372,7 +5274,11 @@ func (o *TridentOrchestrator) deleteSnapshot(ctx context.Context, snapshotConfig
 	// Note that this call will only return an error if the backend actually
 	// fails to delete the snapshot. If the snapshot does not exist on the backend,
 	// the driver will not return an error. Thus, we're fine.
-	if err := backend.DeleteSnapshot(ctx, snapshot.Config, volume.Config); err != nil {
+	var err error
+	o.unlockedDuring(func() {
+		err = backend.DeleteSnapshot(ctx, snapshot.Config, volume.Config)
+	})
+	if err != nil {
 		fields := LogFields{
 			"snapshotID":  snapshotID,
 			"volume":      volume.Config.Name,
@@ -4415,18 +5321,38 @@ func (o *TridentOrchestrator) DeleteSnapshot(ctx context.Context, volumeName, sn
 		return o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.DeleteSnapshot",
+		tracing.Volume(volumeName), tracing.Snapshot(snapshotName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("snapshot_delete", &err)()
 
+	// Deleting the last snapshot of a volume may delete the volume, and in turn its source volume
+	defer o.lockVolumeSet([]string{volumeName}, nil, true)()
+
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
 	defer o.updateMetrics()
 
+	unlockBackend, err := o.rLockVolumeBackend(volumeName)
+	if err != nil {
+		return err
+	}
+	defer unlockBackend()
+
 	snapshotID := storage.MakeSnapshotID(volumeName, snapshotName)
 	snapshot, ok := o.snapshots[snapshotID]
 	if !ok {
 		return errors.NotFoundError("snapshot %s not found on volume %s", snapshotName, volumeName)
 	}
 
+	// Snapshots in a group may only be deleted along with the group
+	if groupSnapshotName := snapshot.Config.GroupSnapshotName; groupSnapshotName != "" {
+		if _, ok = o.groupSnapshots[groupSnapshotName]; ok {
+			return errors.InvalidInputError(fmt.Sprintf("snapshot %s is part of group snapshot %s and cannot be "+
+				"deleted on its own", snapshotID, groupSnapshotName))
+		}
+	}
+
 	volume, ok := o.volumes[volumeName]
 	if !ok {
 		if !snapshot.State.IsMissingVolume() {
@@ -4523,10 +5449,12 @@ func (o *TridentOrchestrator) ListSnapshots(ctx context.Context) (snapshots []*s
 		return nil, o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.ListSnapshots")
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("snapshot_list", &err)()
 
-	o.mutex.Lock()
-	defer o.mutex.Unlock()
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
 
 	snapshots = make([]*storage.SnapshotExternal, 0, len(o.snapshots))
 	for _, s := range o.snapshots {
@@ -4545,10 +5473,12 @@ func (o *TridentOrchestrator) ListSnapshotsByName(
 		return nil, o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.ListSnapshotsByName", tracing.Snapshot(snapshotName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("snapshot_list_by_snapshot_name", &err)()
 
-	o.mutex.Lock()
-	defer o.mutex.Unlock()
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
 
 	snapshots = make([]*storage.SnapshotExternal, 0)
 	for _, s := range o.snapshots {
@@ -4569,10 +5499,12 @@ func (o *TridentOrchestrator) ListSnapshotsForVolume(
 		return nil, o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.ListSnapshotsForVolume", tracing.Volume(volumeName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("snapshot_list_by_volume_name", &err)()
 
-	o.mutex.Lock()
-	defer o.mutex.Unlock()
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
 
 	if _, ok := o.volumes[volumeName]; !ok {
 		return nil, errors.NotFoundError("volume %s not found", volumeName)
@@ -4597,6 +5529,8 @@ func (o *TridentOrchestrator) ReadSnapshotsForVolume(
 		return nil, o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.ReadSnapshotsForVolume", tracing.Volume(volumeName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("snapshot_read_by_volume", &err)()
 
 	volume, ok := o.volumes[volumeName]
@@ -4617,6 +5551,347 @@ func (o *TridentOrchestrator) ReadSnapshotsForVolume(
 	return externalSnapshots, nil
 }
 
+// CreateGroupSnapshot creates a crash-consistent set of snapshots of several volumes, all of which must reside
+// on one backend that supports group snapshots.
+func (o *TridentOrchestrator) CreateGroupSnapshot(
+	ctx context.Context, groupSnapshotConfig *storage.GroupSnapshotConfig,
+) (externalGroupSnapshot *storage.GroupSnapshotExternal, err error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	var (
+		backend   storage.Backend
+		snapshots []*storage.Snapshot
+		volTxns   []*storage.VolumeTransaction
+	)
+
+	if o.bootstrapError != nil {
+		return nil, o.bootstrapError
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "core.CreateGroupSnapshot")
+	defer tracing.EndSpan(span, &err)
+	defer recordTiming("group_snapshot_create", &err)()
+
+	if err = groupSnapshotConfig.Validate(); err != nil {
+		return nil, errors.InvalidInputError(err.Error())
+	}
+
+	defer o.lockVolumes(groupSnapshotConfig.VolumeNames...)()
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	defer o.updateMetrics()
+
+	unlockBackend, err := o.rLockVolumeBackend(groupSnapshotConfig.VolumeNames[0])
+	if err != nil {
+		return nil, err
+	}
+	defer unlockBackend()
+
+	// Check if the group snapshot already exists
+	if _, ok := o.groupSnapshots[groupSnapshotConfig.ID()]; ok {
+		return nil, errors.FoundError("group snapshot %s already exists", groupSnapshotConfig.ID())
+	}
+
+	// Complete a snapshot config for each volume, all of which must be on the same backend
+	snapshotConfigs := make([]*storage.SnapshotConfig, 0, len(groupSnapshotConfig.VolumeNames))
+	volumeConfigs := make([]*storage.VolumeConfig, 0, len(groupSnapshotConfig.VolumeNames))
+	for _, volumeName := range groupSnapshotConfig.VolumeNames {
+		volume, ok := o.volumes[volumeName]
+		if !ok {
+			return nil, errors.NotFoundError("source volume %s not found", volumeName)
+		}
+		if volume.State.IsDeleting() {
+			return nil, errors.VolumeStateError(fmt.Sprintf("source volume %s is deleting", volumeName))
+		}
+
+		if backend == nil {
+			if backend, ok = o.backends[volume.BackendUUID]; !ok {
+				// Should never get here but just to be safe
+				return nil, errors.NotFoundError("backend %s for the source volume not found: %s",
+					volume.BackendUUID, volumeName)
+			}
+		} else if volume.BackendUUID != backend.BackendUUID() {
+			return nil, errors.UnsupportedError(fmt.Sprintf(
+				"volumes in group snapshot %s must be on a single backend", groupSnapshotConfig.Name))
+		}
+
+		snapshotConfig := &storage.SnapshotConfig{
+			Version:             config.OrchestratorAPIVersion,
+			Name:                groupSnapshotConfig.Name,
+			InternalName:        groupSnapshotConfig.Name,
+			VolumeName:          volumeName,
+			VolumeInternalName:  volume.Config.InternalName,
+			LUKSPassphraseNames: volume.Config.LUKSPassphraseNames,
+			GroupSnapshotName:   groupSnapshotConfig.Name,
+		}
+		if _, ok = o.snapshots[snapshotConfig.ID()]; ok {
+			return nil, errors.FoundError("snapshot %s already exists", snapshotConfig.ID())
+		}
+
+		// Ensure a snapshot is even possible before creating the transactions
+		if err = backend.CanSnapshot(ctx, snapshotConfig, volume.Config); err != nil {
+			return nil, err
+		}
+
+		snapshotConfigs = append(snapshotConfigs, snapshotConfig)
+		volumeConfigs = append(volumeConfigs, volume.Config)
+	}
+
+	// Recovery function in case of error
+	defer func() {
+		err = o.addGroupSnapshotCleanup(ctx, err, backend, snapshots, volTxns, groupSnapshotConfig)
+	}()
+
+	// Add a snapshot transaction for each volume in case the operation must be rolled back later
+	for i, snapshotConfig := range snapshotConfigs {
+		txn := &storage.VolumeTransaction{
+			Config:         volumeConfigs[i],
+			SnapshotConfig: snapshotConfig,
+			Op:             storage.AddSnapshot,
+		}
+		if err = o.AddVolumeTransaction(ctx, txn); err != nil {
+			return nil, err
+		}
+		volTxns = append(volTxns, txn)
+	}
+
+	// Create the snapshots
+	o.unlockedDuring(func() {
+		snapshots, err = backend.CreateGroupSnapshot(ctx, groupSnapshotConfig, snapshotConfigs, volumeConfigs)
+	})
+	if err != nil {
+		if errors.IsMaxLimitReachedError(err) {
+			return nil, errors.MaxLimitReachedError(fmt.Sprintf(
+				"failed to create group snapshot %s on backend %s: %v", groupSnapshotConfig.Name, backend.Name(), err))
+		}
+		return nil, fmt.Errorf("failed to create group snapshot %s on backend %s; %w",
+			groupSnapshotConfig.Name, backend.Name(), err)
+	}
+
+	// Save references to the new snapshots and their group
+	snapshotIDs := make([]string, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		snapshot.Config.GroupSnapshotName = groupSnapshotConfig.Name
+		if err = o.storeClient.AddSnapshot(ctx, snapshot); err != nil {
+			return nil, err
+		}
+		snapshotIDs = append(snapshotIDs, snapshot.ID())
+	}
+
+	groupSnapshot := storage.NewGroupSnapshot(groupSnapshotConfig, snapshotIDs, time.Now().UTC().Format(time.RFC3339))
+	if err = o.storeClient.AddGroupSnapshot(ctx, groupSnapshot); err != nil {
+		return nil, err
+	}
+
+	for _, snapshot := range snapshots {
+		o.snapshots[snapshot.ID()] = snapshot
+	}
+	o.groupSnapshots[groupSnapshot.ID()] = groupSnapshot
+
+	return groupSnapshot.ConstructExternal(), nil
+}
+
+// addGroupSnapshotCleanup is used as a deferred method from the group snapshot create method
+// to clean up in case anything goes wrong during the operation.
+func (o *TridentOrchestrator) addGroupSnapshotCleanup(
+	ctx context.Context, err error, backend storage.Backend, snapshots []*storage.Snapshot,
+	volTxns []*storage.VolumeTransaction, groupSnapshotConfig *storage.GroupSnapshotConfig,
+) error {
+	cleanupErrs := make([]error, 0)
+	for _, volTxn := range volTxns {
+		if err != nil && backend != nil && snapshots != nil {
+			// We succeeded in adding the snapshots to the backend but failed to save them; now delete them.
+			if cleanupErr := backend.DeleteSnapshot(ctx, volTxn.SnapshotConfig, volTxn.Config); cleanupErr != nil {
+				cleanupErrs = append(cleanupErrs, fmt.Errorf(
+					"unable to delete snapshot %s from backend during cleanup:  %v", volTxn.SnapshotConfig.ID(),
+					cleanupErr))
+				continue
+			}
+			snapshot := &storage.Snapshot{Config: volTxn.SnapshotConfig}
+			if cleanupErr := o.storeClient.DeleteSnapshot(ctx, snapshot); cleanupErr != nil {
+				cleanupErrs = append(cleanupErrs, fmt.Errorf(
+					"unable to delete snapshot %s from store during cleanup:  %v", volTxn.SnapshotConfig.ID(),
+					cleanupErr))
+				continue
+			}
+		}
+		// Only clean up a snapshot transaction if we've succeeded at cleaning up its
+		// snapshot or if we didn't need to do so in the first place.
+		if txErr := o.DeleteVolumeTransaction(ctx, volTxn); txErr != nil {
+			cleanupErrs = append(cleanupErrs, fmt.Errorf("unable to clean up snapshot transaction: %v", txErr))
+		}
+	}
+	if len(cleanupErrs) > 0 {
+		// Report on all errors we encountered.
+		errList := make([]string, 0, len(cleanupErrs)+1)
+		if err != nil {
+			errList = append(errList, err.Error())
+		}
+		for _, e := range cleanupErrs {
+			errList = append(errList, e.Error())
+		}
+		err = fmt.Errorf(strings.Join(errList, ", "))
+		Logc(ctx).Warnf("Unable to clean up artifacts of group snapshot %s creation: %v. Repeat creating the "+
+			"group snapshot or restart %v.", groupSnapshotConfig.Name, err, config.OrchestratorName)
+	}
+	return err
+}
+
+func (o *TridentOrchestrator) GetGroupSnapshot(
+	ctx context.Context, groupSnapshotName string,
+) (groupSnapshotExternal *storage.GroupSnapshotExternal, err error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return nil, o.bootstrapError
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "core.GetGroupSnapshot")
+	defer tracing.EndSpan(span, &err)
+	defer recordTiming("group_snapshot_get", &err)()
+
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
+
+	groupSnapshot, ok := o.groupSnapshots[groupSnapshotName]
+	if !ok {
+		return nil, errors.NotFoundError("group snapshot %s not found", groupSnapshotName)
+	}
+	return groupSnapshot.ConstructExternal(), nil
+}
+
+func (o *TridentOrchestrator) ListGroupSnapshots(
+	ctx context.Context,
+) (groupSnapshots []*storage.GroupSnapshotExternal, err error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return nil, o.bootstrapError
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "core.ListGroupSnapshots")
+	defer tracing.EndSpan(span, &err)
+	defer recordTiming("group_snapshot_list", &err)()
+
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
+
+	groupSnapshots = make([]*storage.GroupSnapshotExternal, 0, len(o.groupSnapshots))
+	for _, gs := range o.groupSnapshots {
+		groupSnapshots = append(groupSnapshots, gs.ConstructExternal())
+	}
+	sort.Sort(storage.ByGroupSnapshotExternalID(groupSnapshots))
+	return groupSnapshots, nil
+}
+
+// DeleteGroupSnapshot deletes a group snapshot along with all of its snapshots
+func (o *TridentOrchestrator) DeleteGroupSnapshot(ctx context.Context, groupSnapshotName string) (err error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return o.bootstrapError
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "core.DeleteGroupSnapshot")
+	defer tracing.EndSpan(span, &err)
+	defer recordTiming("group_snapshot_delete", &err)()
+
+	// The volumes must be locked before the orchestrator mutex is taken, so find them first
+	o.mutex.RLock()
+	groupSnapshot, ok := o.groupSnapshots[groupSnapshotName]
+	o.mutex.RUnlock()
+	if !ok {
+		return errors.NotFoundError("group snapshot %s not found", groupSnapshotName)
+	}
+
+	// Deleting the last snapshot of a volume may delete the volume, and in turn its source volume
+	defer o.lockVolumeSet(groupSnapshot.Config.VolumeNames, nil, true)()
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	defer o.updateMetrics()
+
+	// The group snapshot may have been deleted, or replaced, while waiting for the locks
+	if current, ok := o.groupSnapshots[groupSnapshotName]; !ok {
+		return errors.NotFoundError("group snapshot %s not found", groupSnapshotName)
+	} else if current != groupSnapshot {
+		return fmt.Errorf("group snapshot %s changed during deletion; retry", groupSnapshotName)
+	}
+
+	// Check if any snapshot is a source for a read-only volume. If so, return error.
+	for _, snapshotID := range groupSnapshot.SnapshotIDs {
+		snapshot, ok := o.snapshots[snapshotID]
+		if !ok {
+			continue
+		}
+		for _, vol := range o.volumes {
+			if vol.Config.ReadOnlyClone && vol.Config.CloneSourceVolume == snapshot.Config.VolumeName &&
+				vol.Config.CloneSourceSnapshot == snapshot.Config.Name {
+				return fmt.Errorf("unable to delete group snapshot %s as snapshot %s is a source for read-only "+
+					"clone %s", groupSnapshotName, snapshotID, vol.Config.Name)
+			}
+		}
+	}
+
+	for _, snapshotID := range groupSnapshot.SnapshotIDs {
+		if err = o.deleteGroupSnapshotMember(ctx, snapshotID); err != nil {
+			return err
+		}
+	}
+
+	if err = o.storeClient.DeleteGroupSnapshot(ctx, groupSnapshot); err != nil {
+		return err
+	}
+	delete(o.groupSnapshots, groupSnapshotName)
+
+	return nil
+}
+
+// deleteGroupSnapshotMember deletes one snapshot of a group snapshot, as DeleteSnapshot would.  The caller must
+// hold the orchestrator mutex and the lock of the snapshot's volume.
+func (o *TridentOrchestrator) deleteGroupSnapshotMember(ctx context.Context, snapshotID string) (err error) {
+	snapshot, ok := o.snapshots[snapshotID]
+	if !ok {
+		// Nothing to do, the snapshot was already deleted
+		return nil
+	}
+
+	// If the snapshot's volume or backend no longer exists, just clean up the snapshot's record
+	volume, ok := o.volumes[snapshot.Config.VolumeName]
+	if ok {
+		_, ok = o.backends[volume.BackendUUID]
+	}
+	if !ok {
+		if err = o.storeClient.DeleteSnapshot(ctx, snapshot); err != nil {
+			return err
+		}
+		delete(o.snapshots, snapshotID)
+		return nil
+	}
+
+	unlockBackend, err := o.rLockVolumeBackend(snapshot.Config.VolumeName)
+	if err != nil {
+		return err
+	}
+	defer unlockBackend()
+
+	volTxn := &storage.VolumeTransaction{
+		Config:         volume.Config,
+		SnapshotConfig: snapshot.Config,
+		Op:             storage.DeleteSnapshot,
+	}
+	if err = o.AddVolumeTransaction(ctx, volTxn); err != nil {
+		return err
+	}
+
+	if err = o.deleteSnapshot(ctx, snapshot.Config); err != nil {
+		return err
+	}
+
+	return o.DeleteVolumeTransaction(ctx, volTxn)
+}
+
 func (o *TridentOrchestrator) ReloadVolumes(ctx context.Context) (err error) {
 	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
 
@@ -4624,6 +5899,8 @@ func (o *TridentOrchestrator) ReloadVolumes(ctx context.Context) (err error) {
 		return o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.ReloadVolumes")
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("volume_reload", &err)()
 
 	// Lock out all other workflows while we reload the volumes
@@ -4667,12 +5944,22 @@ func (o *TridentOrchestrator) ResizeVolume(ctx context.Context, volumeName, newS
 		return o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.ResizeVolume", tracing.Volume(volumeName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("volume_resize", &err)()
 
+	defer o.lockVolumes(volumeName)()
+
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
 	defer o.updateMetrics()
 
+	unlockBackend, err := o.rLockVolumeBackend(volumeName)
+	if err != nil {
+		return err
+	}
+	defer unlockBackend()
+
 	if _, ok := o.subordinateVolumes[volumeName]; ok {
 		return o.resizeSubordinateVolume(ctx, volumeName, newSize)
 	}
@@ -4734,8 +6021,14 @@ func (o *TridentOrchestrator) resizeVolume(ctx context.Context, volume *storage.
 
 	if volume.Config.Size != newSize {
 		// If the resize is successful the driver updates the volume.Config.Size, as a side effect, with the actual
-		// byte size of the expanded volume.
-		if err := volumeBackend.ResizeVolume(ctx, volume.Config, newSize); err != nil {
+		// byte size of the expanded volume.  The orchestrator mutex is released while the backend resizes the
+		// volume, so give the backend a copy of the config, since other operations may read the original.
+		var err error
+		volumeConfig := volume.Config.ConstructClone()
+		o.unlockedDuring(func() {
+			err = volumeBackend.ResizeVolume(ctx, volumeConfig, newSize)
+		})
+		if err != nil {
 			Logc(ctx).WithFields(LogFields{
 				"volume":          volume.Config.Name,
 				"volume_internal": volume.Config.InternalName,
@@ -4746,6 +6039,7 @@ func (o *TridentOrchestrator) resizeVolume(ctx context.Context, volume *storage.
 			}).Error("Unable to resize the volume.")
 			return fmt.Errorf("unable to resize the volume: %v", err)
 		}
+		volume.Config = volumeConfig
 	}
 
 	if err := o.updateVolumeOnPersistentStore(ctx, volume); err != nil {
@@ -4759,42 +6053,538 @@ func (o *TridentOrchestrator) resizeVolume(ctx context.Context, volume *storage.
 	return nil
 }
 
-// resizeVolumeCleanup is used to clean up artifacts of volume resize in case
-// anything goes wrong during the operation.
-func (o *TridentOrchestrator) resizeVolumeCleanup(
-	ctx context.Context, err error, vol *storage.Volume, volTxn *storage.VolumeTransaction,
-) error {
-	if vol == nil || err == nil || vol.Config.Size != volTxn.Config.Size {
-		// We either succeeded or failed to resize the volume on the
-		// backend. There are two possible failure cases:
-		// 1.  We failed to resize the volume. In this case, we just need to
-		//     remove the volume transaction.
-		// 2.  We failed to update the volume on persistent store. In this
-		//     case, we leave the volume transaction around so that we can
-		//     update the persistent store later.
-		txErr := o.DeleteVolumeTransaction(ctx, volTxn)
-		if txErr != nil {
-			txErr = fmt.Errorf("unable to clean up resize transaction:  %v", txErr)
+// resizeVolumeCleanup is used to clean up artifacts of volume resize in case
+// anything goes wrong during the operation.
+func (o *TridentOrchestrator) resizeVolumeCleanup(
+	ctx context.Context, err error, vol *storage.Volume, volTxn *storage.VolumeTransaction,
+) error {
+	if vol == nil || err == nil || vol.Config.Size != volTxn.Config.Size {
+		// We either succeeded or failed to resize the volume on the
+		// backend. There are two possible failure cases:
+		// 1.  We failed to resize the volume. In this case, we just need to
+		//     remove the volume transaction.
+		// 2.  We failed to update the volume on persistent store. In this
+		//     case, we leave the volume transaction around so that we can
+		//     update the persistent store later.
+		txErr := o.DeleteVolumeTransaction(ctx, volTxn)
+		if txErr != nil {
+			txErr = fmt.Errorf("unable to clean up resize transaction:  %v", txErr)
+		}
+		errList := make([]string, 0, 2)
+		for _, e := range []error{err, txErr} {
+			if e != nil {
+				errList = append(errList, e.Error())
+			}
+		}
+		if len(errList) > 0 {
+			err = fmt.Errorf(strings.Join(errList, ", "))
+			Logc(ctx).Warnf(
+				"Unable to clean up artifacts of volume resize: %v. Repeat resizing the volume or restart %v.",
+				err, config.OrchestratorName)
+		}
+	} else {
+		// We get here only when we fail to update the volume size in
+		// persistent store after successfully updating the volume on the
+		// backend. We leave the transaction object around so that the
+		// persistent store can be updated in the future through retries.
+	}
+	return err
+}
+
+// MoveVolume relocates a volume to another storage pool, either on its own backend or on a different one, without
+// changing its name.  Moves within a backend are left to the storage driver, while moves between backends
+// replicate the volume to a new volume on the destination backend and cut over to it once the volume is no longer
+// published.  Moves may take a long time, so this returns an InProgressError until the move is complete, and
+// callers should repeat the request to drive the move forward.  The move is tracked by a transaction, so it may
+// be resumed after a restart.  If backendName is empty, the volume is moved within its current backend.  A move
+// between backends that was not yet committed is abandoned by asking for the volume to be moved to its source pool.
+func (o *TridentOrchestrator) MoveVolume(
+	ctx context.Context, volumeName, backendName, poolName string,
+) (externalVol *storage.VolumeExternal, err error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return nil, o.bootstrapError
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "core.MoveVolume",
+		tracing.Volume(volumeName), tracing.Backend(backendName), tracing.Pool(poolName))
+	defer tracing.EndSpan(span, &err)
+	defer recordTiming("volume_move", &err)()
+
+	defer o.lockVolumes(volumeName)()
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	defer o.updateMetrics()
+
+	volume, ok := o.volumes[volumeName]
+	if !ok {
+		return nil, errors.NotFoundError("volume %s not found", volumeName)
+	}
+
+	// Resume a move that is already under way
+	volTxn := &storage.VolumeTransaction{
+		VolumeMoveConfig: &storage.VolumeMoveConfig{VolumeConfig: *volume.Config},
+		Op:               storage.MoveVolume,
+	}
+	existingTxn, err := o.storeClient.GetVolumeTransaction(ctx, volTxn)
+	if err != nil {
+		return nil, err
+	}
+	if existingTxn != nil && existingTxn.Op == storage.MoveVolume {
+		moveConfig := existingTxn.VolumeMoveConfig
+		if moveConfig.State == storage.VolumeMoveStateCommitted {
+			// The volume was moved, so only the source remains to be cleaned up
+			if err = o.finishVolumeMove(ctx, existingTxn); err != nil {
+				return nil, err
+			}
+			volume = o.volumes[volumeName]
+		} else {
+			// Moving the volume back to where it came from abandons a move between backends, which has only
+			// replicated the volume so far
+			sourceBackend, ok := o.backends[moveConfig.SourceBackendUUID]
+			toSource := poolName == moveConfig.SourcePool &&
+				(backendName == "" || (ok && sourceBackend.Name() == backendName))
+			if moveConfig.IsCrossBackend() && toSource {
+				if err = o.abortVolumeMove(ctx, existingTxn); err != nil {
+					return nil, err
+				}
+				Logc(ctx).WithField("volume", volumeName).Info("Abandoned volume move.")
+				return volume.ConstructExternal(), nil
+			}
+
+			destBackend, ok := o.backends[moveConfig.DestinationBackendUUID]
+			if !ok {
+				return nil, errors.NotFoundError("backend %s not found", moveConfig.DestinationBackendUUID)
+			}
+			if moveConfig.DestinationPool != poolName || (backendName != "" && destBackend.Name() != backendName) {
+				return nil, errors.VolumeStateError(fmt.Sprintf("volume %s is already moving to pool %s of backend %s",
+					volumeName, moveConfig.DestinationPool, destBackend.Name()))
+			}
+			return o.continueVolumeMove(ctx, volume, existingTxn)
+		}
+	}
+
+	sourceBackend, ok := o.backends[volume.BackendUUID]
+	if !ok {
+		return nil, errors.NotFoundError("backend %s not found", volume.BackendUUID)
+	}
+	destBackend := sourceBackend
+	if backendName != "" {
+		if destBackend, err = o.getBackendByBackendName(backendName); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = o.validateVolumeMove(volume, sourceBackend, destBackend, poolName); err != nil {
+		return nil, err
+	}
+
+	// Nothing to do if the volume is already where it was asked to be
+	if destBackend.BackendUUID() == volume.BackendUUID && poolName == volume.Pool {
+		return volume.ConstructExternal(), nil
+	}
+
+	volTxn.VolumeMoveConfig = &storage.VolumeMoveConfig{
+		StartTime:              time.Now(),
+		SourceBackendUUID:      sourceBackend.BackendUUID(),
+		SourcePool:             volume.Pool,
+		DestinationBackendUUID: destBackend.BackendUUID(),
+		DestinationPool:        poolName,
+		VolumeConfig:           *volume.Config.ConstructClone(),
+	}
+	if err = o.AddVolumeTransaction(ctx, volTxn); err != nil {
+		return nil, err
+	}
+
+	Logc(ctx).WithFields(LogFields{
+		"volume":             volumeName,
+		"sourceBackend":      sourceBackend.Name(),
+		"sourcePool":         volume.Pool,
+		"destinationBackend": destBackend.Name(),
+		"destinationPool":    poolName,
+	}).Info("Starting volume move.")
+
+	return o.continueVolumeMove(ctx, volume, volTxn)
+}
+
+// validateVolumeMove checks whether a volume may be moved to the named pool of a backend.
+func (o *TridentOrchestrator) validateVolumeMove(
+	volume *storage.Volume, sourceBackend, destBackend storage.Backend, poolName string,
+) error {
+	volumeName := volume.Config.Name
+
+	if volume.State.IsDeleting() {
+		return errors.VolumeStateError(fmt.Sprintf("volume %s is deleting", volumeName))
+	}
+	if volume.Config.ImportNotManaged {
+		return errors.NotManagedError("volume %s is not managed by Trident", volumeName)
+	}
+	if volume.Config.IsMirrorDestination {
+		return errors.VolumeStateError(fmt.Sprintf("volume %s is a mirror destination", volumeName))
+	}
+	if volume.Config.ReadOnlyClone {
+		return errors.UnsupportedError(fmt.Sprintf("volume %s is a read-only clone", volumeName))
+	}
+	if len(volume.Config.SubordinateVolumes) > 0 {
+		return errors.VolumeStateError(fmt.Sprintf("volume %s has subordinate volumes", volumeName))
+	}
+	for _, v := range o.volumes {
+		if v.Config.CloneSourceVolume == volumeName {
+			return errors.VolumeStateError(fmt.Sprintf("volume %s has clones", volumeName))
+		}
+	}
+
+	if !destBackend.State().IsOnline() {
+		return errors.VolumeStateError(fmt.Sprintf("backend %s is not online", destBackend.Name()))
+	}
+	if _, ok := destBackend.Storage()[poolName]; !ok {
+		return errors.NotFoundError("pool %s not found on backend %s", poolName, destBackend.Name())
+	}
+
+	if destBackend.BackendUUID() == sourceBackend.BackendUUID() {
+		return nil
+	}
+
+	// Moves between backends replicate the volume to a new one, which the volume's snapshots and clone
+	// relationships cannot follow
+	if !sourceBackend.CanMirror() || !destBackend.CanMirror() {
+		return errors.UnsupportedError(fmt.Sprintf("volume %s cannot be moved from backend %s to backend %s, "+
+			"as both must support mirroring", volumeName, sourceBackend.Name(), destBackend.Name()))
+	}
+	if sourceBackend.GetDriverName() != destBackend.GetDriverName() {
+		return errors.UnsupportedError(fmt.Sprintf("volume %s cannot be moved between backends of type %s and %s",
+			volumeName, sourceBackend.GetDriverName(), destBackend.GetDriverName()))
+	}
+	if volume.Config.CloneSourceVolume != "" {
+		return errors.UnsupportedError(fmt.Sprintf("clone %s cannot be moved to another backend", volumeName))
+	}
+	for _, snapshot := range o.snapshots {
+		if snapshot.Config.VolumeName == volumeName {
+			return errors.UnsupportedError(fmt.Sprintf("volume %s has snapshots and cannot be moved to another "+
+				"backend", volumeName))
+		}
+	}
+	return nil
+}
+
+// continueVolumeMove drives a volume move forward from the state recorded in its transaction.  It assumes that
+// the caller holds the volume lock and the orchestrator mutex.
+func (o *TridentOrchestrator) continueVolumeMove(
+	ctx context.Context, volume *storage.Volume, volTxn *storage.VolumeTransaction,
+) (*storage.VolumeExternal, error) {
+	moveConfig := volTxn.VolumeMoveConfig
+
+	sourceBackend, ok := o.backends[moveConfig.SourceBackendUUID]
+	if !ok {
+		return nil, errors.NotFoundError("backend %s not found", moveConfig.SourceBackendUUID)
+	}
+	destBackend, ok := o.backends[moveConfig.DestinationBackendUUID]
+	if !ok {
+		return nil, errors.NotFoundError("backend %s not found", moveConfig.DestinationBackendUUID)
+	}
+
+	unlockBackends, err := o.rLockBackends(sourceBackend, destBackend)
+	if err != nil {
+		return nil, err
+	}
+	defer unlockBackends()
+
+	if moveConfig.IsCrossBackend() {
+		err = o.moveVolumeAcrossBackends(ctx, volume, volTxn, sourceBackend, destBackend)
+	} else {
+		// The orchestrator mutex is released while the backend moves the volume, so give the backend a copy
+		// of the config, since other operations may read the original.
+		volumeConfig := volume.Config.ConstructClone()
+		o.unlockedDuring(func() {
+			err = sourceBackend.MoveVolume(ctx, volumeConfig, moveConfig.DestinationPool)
+		})
+		if err == nil {
+			moveConfig.DestinationConfig = volumeConfig
+			moveConfig.State = storage.VolumeMoveStateCommitted
+			err = o.storeClient.UpdateVolumeTransaction(ctx, volTxn)
+		} else if !errors.IsInProgressError(err) {
+			// Nothing has changed, so the move may simply be requested again
+			if txnErr := o.DeleteVolumeTransaction(ctx, volTxn); txnErr != nil {
+				Logc(ctx).WithField("volume", volume.Config.Name).WithError(txnErr).Warning(
+					"Could not clean up volume move transaction.")
+			}
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Finishing the move takes the lock of the source backend itself
+	unlockBackends()
+	if err = o.finishVolumeMove(ctx, volTxn); err != nil {
+		return nil, err
+	}
+
+	Logc(ctx).WithFields(LogFields{
+		"volume":  moveConfig.Name,
+		"backend": destBackend.Name(),
+		"pool":    moveConfig.DestinationPool,
+	}).Info("Orchestrator moved the volume.")
+
+	return o.volumes[moveConfig.Name].ConstructExternal(), nil
+}
+
+// moveVolumeAcrossBackends performs one step of moving a volume to another backend.  The volume is replicated to
+// a mirror destination volume on the destination backend, and once the mirror is established and the volume is
+// not published, a final transfer is made and the destination is promoted.  Each step records its progress in
+// the move transaction, and all but the last return an InProgressError.  On success, the move is committed.
+func (o *TridentOrchestrator) moveVolumeAcrossBackends(
+	ctx context.Context, volume *storage.Volume, volTxn *storage.VolumeTransaction,
+	sourceBackend, destBackend storage.Backend,
+) (err error) {
+	moveConfig := volTxn.VolumeMoveConfig
+	volumeName := moveConfig.Name
+
+	sourceMirrorer, sourceOK := sourceBackend.(storage.Mirrorer)
+	destMirrorer, destOK := destBackend.(storage.Mirrorer)
+	if !sourceOK || !destOK {
+		return errors.UnsupportedError("both backends must support mirroring to move a volume between them")
+	}
+
+	var sourceSVM string
+	o.unlockedDuring(func() {
+		_, _, sourceSVM, err = sourceMirrorer.GetReplicationDetails(ctx, moveConfig.InternalName, "")
+	})
+	if err != nil {
+		return fmt.Errorf("could not get the volume handle of volume %s; %v", volumeName, err)
+	}
+	sourceHandle := sourceSVM + ":" + moveConfig.InternalName
+
+	switch moveConfig.State {
+	case storage.VolumeMoveStateStarting:
+		destPool, ok := destBackend.Storage()[moveConfig.DestinationPool]
+		if !ok {
+			return errors.NotFoundError("pool %s not found on backend %s", moveConfig.DestinationPool,
+				destBackend.Name())
+		}
+
+		// CreatePrepare has a side effect that updates the config with the backend-specific internal name
+		destConfig := moveConfig.VolumeConfig.ConstructClone()
+		destConfig.IsMirrorDestination = true
+		destConfig.PeerVolumeHandle = sourceHandle
+		destBackend.Driver().CreatePrepare(ctx, destConfig, destPool)
+
+		moveConfig.DestinationConfig = destConfig
+		if err = o.storeClient.UpdateVolumeTransaction(ctx, volTxn); err != nil {
+			return err
+		}
+
+		o.unlockedDuring(func() {
+			if _, err = destBackend.AddVolume(ctx, destConfig, destPool, make(map[string]sa.Request),
+				false); err != nil {
+				return
+			}
+			if err = destMirrorer.EstablishMirror(ctx, destConfig.InternalName, sourceHandle, "", ""); err != nil {
+				if cleanupErr := destBackend.RemoveVolume(ctx, destConfig); cleanupErr != nil {
+					Logc(ctx).WithField("volume", destConfig.InternalName).WithError(cleanupErr).Warning(
+						"Could not clean up destination volume of failed move. It may have to be removed manually.")
+				}
+			}
+		})
+		if err != nil {
+			if txnErr := o.DeleteVolumeTransaction(ctx, volTxn); txnErr != nil {
+				Logc(ctx).WithField("volume", volumeName).WithError(txnErr).Warning(
+					"Could not clean up volume move transaction.")
+			}
+			return fmt.Errorf("could not replicate volume %s to backend %s; %v", volumeName,
+				destBackend.Name(), err)
+		}
+
+		moveConfig.State = storage.VolumeMoveStateReplicating
+		if err = o.storeClient.UpdateVolumeTransaction(ctx, volTxn); err != nil {
+			return err
+		}
+		return errors.InProgressError(fmt.Sprintf("volume %s is replicating to backend %s", volumeName,
+			destBackend.Name()))
+
+	case storage.VolumeMoveStateReplicating:
+		var mirrorState string
+		o.unlockedDuring(func() {
+			mirrorState, err = destMirrorer.GetMirrorStatus(ctx, moveConfig.DestinationConfig.InternalName,
+				sourceHandle)
+		})
+		if err != nil {
+			return err
+		}
+		if mirrorState != netappv1.MirrorStateEstablished {
+			return errors.InProgressError(fmt.Sprintf("volume %s is replicating to backend %s", volumeName,
+				destBackend.Name()))
+		}
+		if len(o.volumePublications.ListPublicationsForVolume(volumeName)) > 0 {
+			return errors.InProgressError(fmt.Sprintf("volume %s must be unpublished to complete its move to "+
+				"backend %s", volumeName, destBackend.Name()))
+		}
+
+		// Transfer the last changes made to the volume
+		o.unlockedDuring(func() {
+			err = destMirrorer.UpdateMirror(ctx, moveConfig.DestinationConfig.InternalName, "")
+		})
+		if err != nil && !errors.IsInProgressError(err) {
+			return err
+		}
+
+		moveConfig.State = storage.VolumeMoveStateCuttingOver
+		if err = o.storeClient.UpdateVolumeTransaction(ctx, volTxn); err != nil {
+			return err
+		}
+		return errors.InProgressError(fmt.Sprintf("volume %s is cutting over to backend %s", volumeName,
+			destBackend.Name()))
+
+	case storage.VolumeMoveStateCuttingOver:
+		// If the volume was published again, writes may have been made since the final transfer began
+		if len(o.volumePublications.ListPublicationsForVolume(volumeName)) > 0 {
+			moveConfig.State = storage.VolumeMoveStateReplicating
+			if err = o.storeClient.UpdateVolumeTransaction(ctx, volTxn); err != nil {
+				return err
+			}
+			return errors.InProgressError(fmt.Sprintf("volume %s must be unpublished to complete its move to "+
+				"backend %s", volumeName, destBackend.Name()))
+		}
+
+		destConfig := moveConfig.DestinationConfig.ConstructClone()
+		o.unlockedDuring(func() {
+			if _, err = destMirrorer.CheckMirrorTransferState(ctx, destConfig.InternalName); err != nil {
+				return
+			}
+			var waitingForSnapshot bool
+			if waitingForSnapshot, err = destMirrorer.PromoteMirror(ctx, destConfig.InternalName, sourceHandle,
+				""); err != nil {
+				return
+			} else if waitingForSnapshot {
+				err = errors.InProgressError(fmt.Sprintf("volume %s is cutting over to backend %s", volumeName,
+					destBackend.Name()))
+				return
+			}
+
+			// The promoted volume is now writable, so complete its setup as for any new volume
+			destConfig.IsMirrorDestination = false
+			destConfig.PeerVolumeHandle = ""
+			err = destBackend.Driver().CreateFollowup(ctx, destConfig)
+		})
+		if err != nil {
+			return err
+		}
+
+		moveConfig.DestinationConfig = destConfig
+		moveConfig.State = storage.VolumeMoveStateCommitted
+		return o.storeClient.UpdateVolumeTransaction(ctx, volTxn)
+
+	default:
+		return fmt.Errorf("unexpected state %s of volume %s move", moveConfig.State, volumeName)
+	}
+}
+
+// finishVolumeMove completes a committed volume move by pointing the volume at its new location, removing the
+// source volume if it was moved between backends, and deleting the move transaction.  It is idempotent, so it may
+// be repeated after a failure.  The caller must hold the volume lock and the orchestrator mutex, but not the lock
+// of the source backend, which is taken while the source volume is removed.
+func (o *TridentOrchestrator) finishVolumeMove(ctx context.Context, volTxn *storage.VolumeTransaction) error {
+	moveConfig := volTxn.VolumeMoveConfig
+
+	volume, ok := o.volumes[moveConfig.Name]
+	if !ok {
+		return errors.NotFoundError("volume %s not found", moveConfig.Name)
+	}
+
+	if volume.BackendUUID != moveConfig.DestinationBackendUUID || volume.Pool != moveConfig.DestinationPool {
+		movedConfig := volume.Config
+		if moveConfig.DestinationConfig != nil {
+			movedConfig = moveConfig.DestinationConfig.ConstructClone()
+		}
+		movedVolume := storage.NewVolume(movedConfig, moveConfig.DestinationBackendUUID,
+			moveConfig.DestinationPool, volume.Orphaned, volume.State)
+		if err := o.updateVolumeOnPersistentStore(ctx, movedVolume); err != nil {
+			return err
+		}
+
+		if sourceBackend, ok := o.backends[moveConfig.SourceBackendUUID]; ok {
+			sourceBackend.RemoveCachedVolume(moveConfig.Name)
+		}
+		if destBackend, ok := o.backends[moveConfig.DestinationBackendUUID]; ok {
+			destBackend.AddCachedVolume(movedVolume)
+		}
+		o.volumes[moveConfig.Name] = movedVolume
+	}
+
+	if moveConfig.IsCrossBackend() {
+		sourceBackend, ok := o.backends[moveConfig.SourceBackendUUID]
+		if !ok {
+			Logc(ctx).WithFields(LogFields{
+				"volume":      moveConfig.Name,
+				"backendUUID": moveConfig.SourceBackendUUID,
+			}).Warning("Source backend of moved volume not found. The source volume may have to be removed " +
+				"manually.")
+		} else {
+			unlockBackend, err := o.rLockBackend(sourceBackend)
+			if err != nil {
+				return err
+			}
+			o.unlockedDuring(func() {
+				err = sourceBackend.RemoveVolume(ctx, &moveConfig.VolumeConfig)
+			})
+			unlockBackend()
+			if err != nil {
+				return fmt.Errorf("could not remove source volume %s of moved volume %s; %v",
+					moveConfig.InternalName, moveConfig.Name, err)
+			}
+		}
+	}
+
+	if err := o.DeleteVolumeTransaction(ctx, volTxn); err != nil {
+		return fmt.Errorf("failed to clean up volume move transaction: %v", err)
+	}
+	return nil
+}
+
+// abortVolumeMove abandons a volume move that was not committed, removing any destination volume that was
+// created for it.  The volume remains where it was.  The caller must hold the volume lock and the orchestrator
+// mutex, but not the locks of the move's backends, which are taken while the backends are called.
+func (o *TridentOrchestrator) abortVolumeMove(ctx context.Context, volTxn *storage.VolumeTransaction) error {
+	moveConfig := volTxn.VolumeMoveConfig
+
+	if moveConfig.IsCrossBackend() && moveConfig.DestinationConfig != nil {
+		destBackend, ok := o.backends[moveConfig.DestinationBackendUUID]
+		if !ok {
+			return errors.NotFoundError("backend %s not found", moveConfig.DestinationBackendUUID)
 		}
-		errList := make([]string, 0, 2)
-		for _, e := range []error{err, txErr} {
-			if e != nil {
-				errList = append(errList, e.Error())
-			}
+		backends := []storage.Backend{destBackend}
+		var sourceMirrorer storage.Mirrorer
+		if sourceBackend, ok := o.backends[moveConfig.SourceBackendUUID]; ok {
+			backends = append(backends, sourceBackend)
+			sourceMirrorer, _ = sourceBackend.(storage.Mirrorer)
 		}
-		if len(errList) > 0 {
-			err = fmt.Errorf(strings.Join(errList, ", "))
-			Logc(ctx).Warnf(
-				"Unable to clean up artifacts of volume resize: %v. Repeat resizing the volume or restart %v.",
-				err, config.OrchestratorName)
+
+		unlockBackends, err := o.rLockBackends(backends...)
+		if err != nil {
+			return err
+		}
+		destConfig := moveConfig.DestinationConfig.ConstructClone()
+		o.unlockedDuring(func() {
+			if err = destBackend.RemoveVolume(ctx, destConfig); err != nil {
+				return
+			}
+			if sourceMirrorer != nil {
+				if releaseErr := sourceMirrorer.ReleaseMirror(ctx, moveConfig.InternalName); releaseErr != nil {
+					Logc(ctx).WithField("volume", moveConfig.Name).WithError(releaseErr).Warning(
+						"Could not release the mirror of the source volume.")
+				}
+			}
+		})
+		unlockBackends()
+		if err != nil {
+			return fmt.Errorf("could not remove destination volume %s of volume %s move; %v",
+				destConfig.InternalName, moveConfig.Name, err)
 		}
-	} else {
-		// We get here only when we fail to update the volume size in
-		// persistent store after successfully updating the volume on the
-		// backend. We leave the transaction object around so that the
-		// persistent store can be updated in the future through retries.
 	}
-	return err
+
+	return o.DeleteVolumeTransaction(ctx, volTxn)
 }
 
 // getProtocol returns the appropriate protocol based on a specified volume mode, access mode and protocol, or
@@ -4903,12 +6693,18 @@ func (o *TridentOrchestrator) AddStorageClass(
 		return nil, o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.AddStorageClass")
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("storageclass_add", &err)()
 
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
 	defer o.updateMetrics()
 
+	if _, err = storageclass.ParsePlacementStrategy(scConfig.Placement); err != nil {
+		return nil, errors.InvalidInputError(err.Error())
+	}
+
 	sc := storageclass.New(scConfig)
 	if _, ok := o.storageClasses[sc.GetName()]; ok {
 		return nil, fmt.Errorf("storage class %s already exists", sc.GetName())
@@ -4934,6 +6730,60 @@ func (o *TridentOrchestrator) AddStorageClass(
 	return sc.ConstructExternal(ctx), nil
 }
 
+// GetStorageCapacity returns the space available to new volumes of the supplied storage class.  The storage
+// class is not registered; only online backend pools that match it, offer the requested protocol, and are
+// accessible from the supplied topology segment are considered.
+func (o *TridentOrchestrator) GetStorageCapacity(
+	ctx context.Context, scConfig *storageclass.Config, protocol config.Protocol, topology map[string]string,
+) (capacity *storageclass.Capacity, err error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return nil, o.bootstrapError
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "core.GetStorageCapacity")
+	defer tracing.EndSpan(span, &err)
+	defer recordTiming("storage_capacity_get", &err)()
+
+	if scConfig == nil {
+		return nil, errors.InvalidInputError("storage class config is required")
+	}
+
+	sc := storageclass.New(scConfig)
+
+	// Only find the matching pools under the lock, as asking the backends for their capacity may be slow
+	o.mutex.RLock()
+	pools := make([]storage.Pool, 0)
+	for _, backend := range o.backends {
+		if !backend.State().IsOnline() {
+			continue
+		}
+		if protocol != config.ProtocolAny && backend.GetProtocol(ctx) != protocol {
+			continue
+		}
+		for _, pool := range backend.Storage() {
+			if sc.Matches(ctx, pool) {
+				pools = append(pools, pool)
+			}
+		}
+	}
+	o.mutex.RUnlock()
+
+	if len(topology) > 0 {
+		pools = storageclass.FilterPoolsOnTopology(ctx, pools, []map[string]string{topology})
+	}
+	pools = storageclass.FilterPoolsOnNasType(ctx, pools, sc.GetAttributes())
+
+	Logc(ctx).WithFields(LogFields{
+		"protocol": protocol,
+		"topology": topology,
+		"pools":    len(pools),
+	}).Debug("Found storage pools for capacity request.")
+
+	return storageclass.GetPoolsCapacity(ctx, pools)
+}
+
 func (o *TridentOrchestrator) GetStorageClass(
 	ctx context.Context, scName string,
 ) (scExternal *storageclass.External, err error) {
@@ -4943,10 +6793,12 @@ func (o *TridentOrchestrator) GetStorageClass(
 		return nil, o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.GetStorageClass", tracing.StorageClass(scName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("storageclass_get", &err)()
 
-	o.mutex.Lock()
-	defer o.mutex.Unlock()
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
 
 	sc, found := o.storageClasses[scName]
 	if !found {
@@ -4957,6 +6809,87 @@ func (o *TridentOrchestrator) GetStorageClass(
 	return sc.ConstructExternal(ctx), nil
 }
 
+// ExplainStorageClass reports which storage pools satisfy a storage class, and why every other pool does not.
+func (o *TridentOrchestrator) ExplainStorageClass(
+	ctx context.Context, scName string,
+) (explanation *storageclass.PlacementExplanation, err error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return nil, o.bootstrapError
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "core.ExplainStorageClass", tracing.StorageClass(scName))
+	defer tracing.EndSpan(span, &err)
+	defer recordTiming("storageclass_explain", &err)()
+
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
+
+	sc, found := o.storageClasses[scName]
+	if !found {
+		return nil, errors.NotFoundError("storage class %v was not found", scName)
+	}
+
+	return sc.ExplainPlacement(ctx, o.getBackendsSortedByName(), nil), nil
+}
+
+// ExplainVolumePlacement reports the storage pools on which a new volume would be created, in the order they
+// would be tried, and why every other pool would not be used.  Nothing is created.
+func (o *TridentOrchestrator) ExplainVolumePlacement(
+	ctx context.Context, volumeConfig *storage.VolumeConfig,
+) (explanation *storageclass.PlacementExplanation, err error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return nil, o.bootstrapError
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "core.ExplainVolumePlacement", tracing.Volume(volumeConfig.Name))
+	defer tracing.EndSpan(span, &err)
+	defer recordTiming("volume_explain", &err)()
+
+	if volumeConfig.ShareSourceVolume != "" {
+		return nil, errors.InvalidInputError(fmt.Sprintf("subordinate volume %s is placed with its source volume %s",
+			volumeConfig.Name, volumeConfig.ShareSourceVolume))
+	}
+
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
+
+	protocol, err := o.getProtocol(ctx, volumeConfig.VolumeMode, volumeConfig.AccessMode, volumeConfig.Protocol)
+	if err != nil {
+		return nil, errors.InvalidInputError(err.Error())
+	}
+
+	sc, found := o.storageClasses[volumeConfig.StorageClass]
+	if !found {
+		return nil, errors.NotFoundError("storage class %v was not found", volumeConfig.StorageClass)
+	}
+
+	return sc.ExplainPlacement(ctx, o.getBackendsSortedByName(), &storageclass.PlacementRequest{
+		Volume:              volumeConfig.Name,
+		Protocol:            protocol,
+		AccessMode:          volumeConfig.AccessMode,
+		RequisiteTopologies: volumeConfig.RequisiteTopologies,
+		PreferredTopologies: volumeConfig.PreferredTopologies,
+		RequestedBytes:      requestedSizeBytes(volumeConfig.Size),
+		MirrorDestination:   volumeConfig.IsMirrorDestination,
+	}), nil
+}
+
+// getBackendsSortedByName returns all backends, ordered by name.  The caller must hold the orchestrator mutex.
+func (o *TridentOrchestrator) getBackendsSortedByName() []storage.Backend {
+	backends := make([]storage.Backend, 0, len(o.backends))
+	for _, backend := range o.backends {
+		backends = append(backends, backend)
+	}
+	sort.Slice(backends, func(i, j int) bool {
+		return backends[i].Name() < backends[j].Name()
+	})
+	return backends
+}
+
 func (o *TridentOrchestrator) ListStorageClasses(ctx context.Context) (
 	scExternals []*storageclass.External, err error,
 ) {
@@ -4966,10 +6899,12 @@ func (o *TridentOrchestrator) ListStorageClasses(ctx context.Context) (
 		return nil, o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.ListStorageClasses")
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("storageclass_list", &err)()
 
-	o.mutex.Lock()
-	defer o.mutex.Unlock()
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
 
 	storageClasses := make([]*storageclass.External, 0, len(o.storageClasses))
 	for _, sc := range o.storageClasses {
@@ -4985,6 +6920,8 @@ func (o *TridentOrchestrator) DeleteStorageClass(ctx context.Context, scName str
 		return o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.DeleteStorageClass", tracing.StorageClass(scName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("storageclass_delete", &err)()
 
 	o.mutex.Lock()
@@ -5011,6 +6948,192 @@ func (o *TridentOrchestrator) DeleteStorageClass(ctx context.Context, scName str
 	return nil
 }
 
+// AddSnapshotPolicy registers a snapshot schedule and retention policy.
+func (o *TridentOrchestrator) AddSnapshotPolicy(
+	ctx context.Context, policyConfig *storage.SnapshotPolicyConfig,
+) (policyExternal *storage.SnapshotPolicyExternal, err error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return nil, o.bootstrapError
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "core.AddSnapshotPolicy")
+	defer tracing.EndSpan(span, &err)
+	defer recordTiming("snapshot_policy_add", &err)()
+
+	if policyConfig == nil {
+		return nil, errors.InvalidInputError("snapshot policy config is required")
+	}
+	if err = policyConfig.Validate(); err != nil {
+		return nil, errors.InvalidInputError(err.Error())
+	}
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if _, ok := o.snapshotPolicies[policyConfig.Name]; ok {
+		return nil, errors.FoundError("snapshot policy %s already exists", policyConfig.Name)
+	}
+
+	policy, err := storage.NewSnapshotPolicy(policyConfig, time.Now())
+	if err != nil {
+		return nil, errors.InvalidInputError(err.Error())
+	}
+	if err = o.storeClient.AddSnapshotPolicy(ctx, policy); err != nil {
+		return nil, err
+	}
+	o.snapshotPolicies[policyConfig.Name] = policy
+
+	Logc(ctx).WithFields(LogFields{
+		"snapshotPolicy": policyConfig.Name,
+		"schedule":       policyConfig.Schedule,
+		"nextRunTime":    policy.NextRunTime,
+	}).Info("Added snapshot policy.")
+
+	return policy.ConstructExternal(), nil
+}
+
+// UpdateSnapshotPolicy replaces the configuration of an existing snapshot policy.  The last-run status of
+// volumes that remain governed by the policy is retained, and the next run is rescheduled only if the
+// schedule changed.
+func (o *TridentOrchestrator) UpdateSnapshotPolicy(
+	ctx context.Context, policyConfig *storage.SnapshotPolicyConfig,
+) (policyExternal *storage.SnapshotPolicyExternal, err error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return nil, o.bootstrapError
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "core.UpdateSnapshotPolicy")
+	defer tracing.EndSpan(span, &err)
+	defer recordTiming("snapshot_policy_update", &err)()
+
+	if policyConfig == nil {
+		return nil, errors.InvalidInputError("snapshot policy config is required")
+	}
+	if err = policyConfig.Validate(); err != nil {
+		return nil, errors.InvalidInputError(err.Error())
+	}
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	policy, ok := o.snapshotPolicies[policyConfig.Name]
+	if !ok {
+		return nil, errors.NotFoundError("snapshot policy %s not found", policyConfig.Name)
+	}
+
+	updatedPolicy := &storage.SnapshotPolicy{
+		Config:      policyConfig,
+		NextRunTime: policy.NextRunTime,
+		Volumes:     make(map[string]*storage.SnapshotPolicyVolumeStatus, len(policy.Volumes)),
+	}
+	if policy.Config.Schedule != policyConfig.Schedule {
+		updatedPolicy.ScheduleNextRun(time.Now())
+	}
+	for volumeName, status := range policy.Volumes {
+		if volume, ok := o.volumes[volumeName]; ok && policyConfig.Matches(volume.Config) {
+			updatedPolicy.Volumes[volumeName] = status
+		}
+	}
+
+	// Frontends reapply their policies periodically, so only changes are written to the store
+	if !reflect.DeepEqual(updatedPolicy.ConstructPersistent(), policy.ConstructPersistent()) {
+		if err = o.storeClient.UpdateSnapshotPolicy(ctx, updatedPolicy); err != nil {
+			return nil, err
+		}
+	}
+	o.snapshotPolicies[policyConfig.Name] = updatedPolicy
+
+	Logc(ctx).WithFields(LogFields{
+		"snapshotPolicy": policyConfig.Name,
+		"schedule":       policyConfig.Schedule,
+		"nextRunTime":    updatedPolicy.NextRunTime,
+	}).Debug("Updated snapshot policy.")
+
+	return updatedPolicy.ConstructExternal(), nil
+}
+
+func (o *TridentOrchestrator) GetSnapshotPolicy(
+	ctx context.Context, policyName string,
+) (policyExternal *storage.SnapshotPolicyExternal, err error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return nil, o.bootstrapError
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "core.GetSnapshotPolicy")
+	defer tracing.EndSpan(span, &err)
+	defer recordTiming("snapshot_policy_get", &err)()
+
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
+
+	policy, ok := o.snapshotPolicies[policyName]
+	if !ok {
+		return nil, errors.NotFoundError("snapshot policy %s not found", policyName)
+	}
+	return policy.ConstructExternal(), nil
+}
+
+func (o *TridentOrchestrator) ListSnapshotPolicies(
+	ctx context.Context,
+) (policyExternals []*storage.SnapshotPolicyExternal, err error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return nil, o.bootstrapError
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "core.ListSnapshotPolicies")
+	defer tracing.EndSpan(span, &err)
+	defer recordTiming("snapshot_policy_list", &err)()
+
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
+
+	policyExternals = make([]*storage.SnapshotPolicyExternal, 0, len(o.snapshotPolicies))
+	for _, policy := range o.snapshotPolicies {
+		policyExternals = append(policyExternals, policy.ConstructExternal())
+	}
+	sort.Slice(policyExternals, func(i, j int) bool {
+		return policyExternals[i].Config.Name < policyExternals[j].Config.Name
+	})
+	return policyExternals, nil
+}
+
+// DeleteSnapshotPolicy unregisters a snapshot policy.  Snapshots already taken by the policy are left in place.
+func (o *TridentOrchestrator) DeleteSnapshotPolicy(ctx context.Context, policyName string) (err error) {
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCore)
+
+	if o.bootstrapError != nil {
+		return o.bootstrapError
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "core.DeleteSnapshotPolicy")
+	defer tracing.EndSpan(span, &err)
+	defer recordTiming("snapshot_policy_delete", &err)()
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	policy, ok := o.snapshotPolicies[policyName]
+	if !ok {
+		return errors.NotFoundError("snapshot policy %s not found", policyName)
+	}
+	if err = o.storeClient.DeleteSnapshotPolicy(ctx, policy); err != nil {
+		return err
+	}
+	delete(o.snapshotPolicies, policyName)
+
+	Logc(ctx).WithField("snapshotPolicy", policyName).Info("Deleted snapshot policy.")
+
+	return nil
+}
+
 func (o *TridentOrchestrator) reconcileNodeAccessOnAllBackends(ctx context.Context) error {
 	if config.CurrentDriverContext != config.ContextCSI {
 		return nil
@@ -5186,6 +7309,77 @@ func (o *TridentOrchestrator) PeriodicallyReconcileBackendState(pollInterval tim
 	}
 }
 
+// PeriodicallyCheckVolumeHealth refreshes the cached condition of every volume at the specified interval,
+// so that volume health may be listed without querying each storage backend.
+func (o *TridentOrchestrator) PeriodicallyCheckVolumeHealth(pollInterval time.Duration) {
+	ctx := GenerateRequestContext(context.Background(), "", ContextSourcePeriodic, WorkflowCoreVolumeHealth,
+		LogLayerCore)
+
+	// Provision to disable volume health checks, just in case
+	if pollInterval <= 0 {
+		Logc(ctx).Debug("Periodic volume health checks are disabled.")
+		return
+	}
+
+	Logc(ctx).Info("Starting periodic volume health check service.")
+	defer Logc(ctx).Info("Stopping periodic volume health check service.")
+
+	o.stopVolumeHealthLoop = make(chan bool)
+	volumeHealthTimer := time.NewTimer(pollInterval)
+	defer func(t *time.Timer) {
+		if !t.Stop() {
+			<-t.C
+		}
+	}(volumeHealthTimer)
+
+	for {
+		select {
+		case <-o.stopVolumeHealthLoop:
+			// Exit on shutdown signal.
+			return
+
+		case <-volumeHealthTimer.C:
+			Logc(ctx).Trace("Periodic volume health check loop beginning.")
+			o.checkVolumeHealth(ctx)
+			// reset the timer so that next poll would start after pollInterval.
+			volumeHealthTimer.Reset(pollInterval)
+		}
+	}
+}
+
+// checkVolumeHealth refreshes the cached condition of every volume.  The orchestrator lock is taken
+// separately for each volume, so that a slow backend does not block other operations for the whole sweep.
+func (o *TridentOrchestrator) checkVolumeHealth(ctx context.Context) {
+	if o.bootstrapError != nil {
+		return
+	}
+
+	o.mutex.RLock()
+	volumeNames := make([]string, 0, len(o.volumes)+len(o.subordinateVolumes))
+	for volumeName := range o.volumes {
+		volumeNames = append(volumeNames, volumeName)
+	}
+	for volumeName := range o.subordinateVolumes {
+		volumeNames = append(volumeNames, volumeName)
+	}
+	o.mutex.RUnlock()
+
+	for _, volumeName := range volumeNames {
+		health, err := o.getVolumeHealth(ctx, volumeName)
+
+		if err != nil {
+			// The volume may have been deleted since the sweep began; log and keep going.
+			Logc(ctx).WithField("volume", volumeName).WithError(err).Debug("Could not check volume health.")
+		} else if health.IsAbnormal() {
+			Logc(ctx).WithFields(LogFields{
+				"volume":    volumeName,
+				"condition": health.Condition,
+				"message":   health.Message,
+			}).Warning("Volume is unhealthy.")
+		}
+	}
+}
+
 func (o *TridentOrchestrator) AddNode(
 	ctx context.Context, node *utils.Node, nodeEventCallback NodeEventCallback,
 ) (err error) {
@@ -5195,8 +7389,12 @@ func (o *TridentOrchestrator) AddNode(
 		return o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.AddNode", tracing.Node(node.Name))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("node_add", &err)()
 
+	defer o.nodeLocks.Lock(node.Name)()
+
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
 	defer o.updateMetrics()
@@ -5243,8 +7441,12 @@ func (o *TridentOrchestrator) UpdateNode(
 		return errors.NotReadyError()
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.UpdateNode", tracing.Node(nodeName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("node_update", &err)()
 
+	defer o.nodeLocks.Lock(nodeName)()
+
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
 	defer o.updateMetrics()
@@ -5312,6 +7514,8 @@ func (o *TridentOrchestrator) GetNode(ctx context.Context, nodeName string) (nod
 		return nil, o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.GetNode", tracing.Node(nodeName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("node_get", &err)()
 
 	n := o.nodes.Get(nodeName)
@@ -5331,6 +7535,8 @@ func (o *TridentOrchestrator) ListNodes(ctx context.Context) (nodes []*utils.Nod
 		return nil, o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.ListNodes")
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("node_list", &err)()
 
 	internalNodes := o.nodes.List()
@@ -5348,8 +7554,12 @@ func (o *TridentOrchestrator) DeleteNode(ctx context.Context, nodeName string) (
 		return o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.DeleteNode", tracing.Node(nodeName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("node_delete", &err)()
 
+	defer o.nodeLocks.Lock(nodeName)()
+
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
 	defer o.updateMetrics()
@@ -5418,6 +7628,8 @@ func (o *TridentOrchestrator) ReconcileVolumePublications(
 		return o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.ReconcileVolumePublications")
+	defer tracing.EndSpan(span, &reconcileErr)
 	defer recordTiming("reconcile_legacy_vol_pubs", &reconcileErr)()
 
 	o.mutex.Lock()
@@ -5491,6 +7703,8 @@ func (o *TridentOrchestrator) GetVolumePublication(
 		return nil, o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.GetVolumePublication", tracing.Volume(volumeName), tracing.Node(nodeName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("vol_pub_get", &err)()
 
 	volumePublication, found := o.volumePublications.TryGet(volumeName, nodeName)
@@ -5514,6 +7728,8 @@ func (o *TridentOrchestrator) ListVolumePublications(
 		return nil, o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.ListVolumePublications")
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("vol_pub_list", &err)()
 
 	// Get all publications as a list.
@@ -5539,6 +7755,8 @@ func (o *TridentOrchestrator) ListVolumePublicationsForVolume(
 		return nil, o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.ListVolumePublicationsForVolume", tracing.Volume(volumeName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("vol_pub_list_for_vol", &err)()
 
 	// Get all publications for a volume as a list.
@@ -5606,6 +7824,8 @@ func (o *TridentOrchestrator) ListVolumePublicationsForNode(
 	Logc(ctx).WithFields(fields).Debug(">>>>>> ListVolumePublicationsForNode")
 	defer Logc(ctx).Debug("<<<<<< ListVolumePublicationsForNode")
 
+	ctx, span := tracing.StartSpan(ctx, "core.ListVolumePublicationsForNode", tracing.Node(nodeName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("vol_pub_list_for_node", &err)()
 
 	// Retrieve only publications on the node.
@@ -5625,8 +7845,14 @@ func (o *TridentOrchestrator) DeleteVolumePublication(ctx context.Context, volum
 		return o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.DeleteVolumePublication",
+		tracing.Volume(volumeName), tracing.Node(nodeName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("vol_pub_delete", &err)()
 
+	defer o.lockVolumes(volumeName)()
+	defer o.nodeLocks.Lock(nodeName)()
+
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
 	defer o.updateMetrics()
@@ -5734,6 +7960,8 @@ func (o *TridentOrchestrator) EstablishMirror(
 	if o.bootstrapError != nil {
 		return o.bootstrapError
 	}
+	ctx, span := tracing.StartSpan(ctx, "core.EstablishMirror")
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("mirror_establish", &err)()
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
@@ -5761,6 +7989,8 @@ func (o *TridentOrchestrator) ReestablishMirror(
 	if o.bootstrapError != nil {
 		return o.bootstrapError
 	}
+	ctx, span := tracing.StartSpan(ctx, "core.ReestablishMirror")
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("mirror_reestablish", &err)()
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
@@ -5787,6 +8017,8 @@ func (o *TridentOrchestrator) PromoteMirror(
 	if o.bootstrapError != nil {
 		return false, o.bootstrapError
 	}
+	ctx, span := tracing.StartSpan(ctx, "core.PromoteMirror")
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("mirror_promote", &err)()
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
@@ -5812,6 +8044,8 @@ func (o *TridentOrchestrator) GetMirrorStatus(
 	if o.bootstrapError != nil {
 		return "", o.bootstrapError
 	}
+	ctx, span := tracing.StartSpan(ctx, "core.GetMirrorStatus")
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("mirror_status", &err)()
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
@@ -5834,6 +8068,8 @@ func (o *TridentOrchestrator) CanBackendMirror(ctx context.Context, backendUUID
 	if o.bootstrapError != nil {
 		return false, o.bootstrapError
 	}
+	ctx, span := tracing.StartSpan(ctx, "core.CanBackendMirror")
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("mirror_capable", &err)()
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
@@ -5855,6 +8091,8 @@ func (o *TridentOrchestrator) ReleaseMirror(
 	if o.bootstrapError != nil {
 		return o.bootstrapError
 	}
+	ctx, span := tracing.StartSpan(ctx, "core.ReleaseMirror")
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("mirror_release", &err)()
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
@@ -5880,6 +8118,8 @@ func (o *TridentOrchestrator) GetReplicationDetails(
 	if o.bootstrapError != nil {
 		return "", "", "", o.bootstrapError
 	}
+	ctx, span := tracing.StartSpan(ctx, "core.GetReplicationDetails")
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("replication_details", &err)()
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
@@ -5904,6 +8144,9 @@ func (o *TridentOrchestrator) UpdateMirror(ctx context.Context, pvcVolumeName, s
 	if o.bootstrapError != nil {
 		return o.bootstrapError
 	}
+	ctx, span := tracing.StartSpan(ctx, "core.UpdateMirror",
+		tracing.Volume(pvcVolumeName), tracing.Snapshot(snapshotName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("update_mirror", &err)()
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
@@ -5944,6 +8187,8 @@ func (o *TridentOrchestrator) CheckMirrorTransferState(
 	if o.bootstrapError != nil {
 		return nil, o.bootstrapError
 	}
+	ctx, span := tracing.StartSpan(ctx, "core.CheckMirrorTransferState", tracing.Volume(pvcVolumeName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("check_mirror_transfer_state", &err)()
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
@@ -5977,6 +8222,8 @@ func (o *TridentOrchestrator) GetMirrorTransferTime(
 	if o.bootstrapError != nil {
 		return nil, o.bootstrapError
 	}
+	ctx, span := tracing.StartSpan(ctx, "core.GetMirrorTransferTime", tracing.Volume(pvcVolumeName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("check_mirror_transfer_state", &err)()
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
@@ -6010,9 +8257,11 @@ func (o *TridentOrchestrator) GetCHAP(
 		return nil, o.bootstrapError
 	}
 
+	ctx, span := tracing.StartSpan(ctx, "core.GetCHAP", tracing.Volume(volumeName), tracing.Node(nodeName))
+	defer tracing.EndSpan(span, &err)
 	defer recordTiming("get_chap", &err)()
-	o.mutex.Lock()
-	defer o.mutex.Unlock()
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
 
 	volume, err := o.getVolume(ctx, volumeName)
 	if err != nil {
@@ -89,6 +89,19 @@ func cleanup(t *testing.T, o *TridentOrchestrator) {
 	if err != nil && !persistentstore.MatchKeyNotFoundErr(err) {
 		t.Fatal("Unable to clean up snapshots: ", err)
 	}
+	snapshotPolicies, err := o.storeClient.GetSnapshotPolicies(ctx())
+	if err != nil {
+		t.Fatal("Unable to retrieve snapshot policies: ", err)
+	}
+	for _, persistentPolicy := range snapshotPolicies {
+		policy, err := persistentPolicy.ConstructSnapshotPolicy(time.Now())
+		if err != nil {
+			t.Fatalf("Unable to restore snapshot policy %s: %v", persistentPolicy.ID(), err)
+		}
+		if err = o.storeClient.DeleteSnapshotPolicy(ctx(), policy); err != nil {
+			t.Fatalf("Unable to clean up snapshot policy %s: %v", policy.ID(), err)
+		}
+	}
 
 	// Clear the InMemoryClient state so that it looks like we're
 	// bootstrapping afresh next time.
@@ -825,6 +838,49 @@ func TestAddStorageClassVolumes(t *testing.T) {
 	cleanup(t, orchestrator)
 }
 
+func TestGetStorageCapacity(t *testing.T) {
+	orchestrator := getOrchestrator(t, false)
+	defer cleanup(t, orchestrator)
+
+	const poolBytes = 100 * 1024 * 1024 * 1024
+
+	blockConfig, err := fakedriver.NewFakeStorageDriverConfigJSON("fast-block", config.Block,
+		tu.GenerateFakePools(2), make([]fake.Volume, 0))
+	assert.NoError(t, err)
+	_, err = orchestrator.AddBackend(ctx(), blockConfig, "")
+	assert.NoError(t, err)
+
+	fileConfig, err := fakedriver.NewFakeStorageDriverConfigJSON("fast-file", config.File,
+		tu.GenerateFakePools(1), make([]fake.Volume, 0))
+	assert.NoError(t, err)
+	_, err = orchestrator.AddBackend(ctx(), fileConfig, "")
+	assert.NoError(t, err)
+
+	scConfig := &storageclass.Config{
+		Attributes: map[string]sa.Request{sa.ProvisioningType: sa.NewStringRequest("thin")},
+	}
+
+	capacity, err := orchestrator.GetStorageCapacity(ctx(), scConfig, config.Block, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2*poolBytes), capacity.AvailableBytes)
+	assert.Equal(t, uint64(poolBytes), capacity.MaximumVolumeBytes)
+
+	capacity, err = orchestrator.GetStorageCapacity(ctx(), scConfig, config.ProtocolAny, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3*poolBytes), capacity.AvailableBytes)
+	assert.Equal(t, 3, capacity.Pools)
+
+	// The storage class must not have been registered
+	_, err = orchestrator.GetStorageClass(ctx(), scConfig.Name)
+	assert.True(t, errors.IsNotFoundError(err))
+
+	// No matching pools yields no capacity
+	scConfig.Attributes[sa.IOPS] = sa.NewIntRequest(1000)
+	capacity, err = orchestrator.GetStorageCapacity(ctx(), scConfig, config.ProtocolAny, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), capacity.AvailableBytes)
+}
+
 func TestUpdateVolume_SnapshotDir_Success(t *testing.T) {
 	ctx := context.Background()
 	backendUUID := "45e44b30-8f53-498d-8555-2cf006760ba6"
@@ -870,6 +926,7 @@ func TestUpdateVolume_SnapshotDir_Success(t *testing.T) {
 	mockStoreClient := mockpersistentstore.NewMockStoreClient(mockCtrl)
 	orchestrator.storeClient = mockStoreClient
 	mockStoreClient.EXPECT().UpdateVolume(gomock.Any(), updatedVol).Return(nil)
+	mockBackend.EXPECT().AddCachedVolume(updatedVol)
 
 	// Update volume
 	result := orchestrator.UpdateVolume(ctx, volName, updateInfo)
@@ -2584,6 +2641,73 @@ func TestDeleteSnapshotRecovery(t *testing.T) {
 	cleanup(t, orchestrator)
 }
 
+func TestExplainPlacement(t *testing.T) {
+	orchestrator := getOrchestrator(t, false)
+	defer cleanup(t, orchestrator)
+
+	blockConfig, err := fakedriver.NewFakeStorageDriverConfigJSON("fast-block", config.Block,
+		tu.GenerateFakePools(2), make([]fake.Volume, 0))
+	assert.NoError(t, err)
+	_, err = orchestrator.AddBackend(ctx(), blockConfig, "")
+	assert.NoError(t, err)
+
+	fileConfig, err := fakedriver.NewFakeStorageDriverConfigJSON("fast-file", config.File,
+		tu.GenerateFakePools(1), make([]fake.Volume, 0))
+	assert.NoError(t, err)
+	_, err = orchestrator.AddBackend(ctx(), fileConfig, "")
+	assert.NoError(t, err)
+
+	_, err = orchestrator.AddStorageClass(ctx(), &storageclass.Config{
+		Name:       "thin",
+		Attributes: map[string]sa.Request{sa.ProvisioningType: sa.NewStringRequest("thin")},
+	})
+	assert.NoError(t, err)
+
+	explanation, err := orchestrator.ExplainStorageClass(ctx(), "thin")
+	assert.NoError(t, err)
+	assert.Len(t, explanation.Pools, 3)
+	for _, pool := range explanation.Pools {
+		assert.True(t, pool.Eligible, "pool %s should be eligible", pool.Pool)
+	}
+
+	_, err = orchestrator.ExplainStorageClass(ctx(), "thick")
+	assert.True(t, errors.IsNotFoundError(err))
+
+	volConfig := tu.GenerateVolumeConfig("explained", 1, "thin", config.File)
+	explanation, err = orchestrator.ExplainVolumePlacement(ctx(), volConfig)
+	assert.NoError(t, err)
+	assert.Equal(t, "explained", explanation.Volume)
+	assert.Equal(t, config.File, explanation.Protocol)
+	if assert.Len(t, explanation.Pools, 3) {
+		assert.Equal(t, "fast-file", explanation.Pools[0].Backend)
+		assert.Equal(t, 1, explanation.Pools[0].Order)
+		for _, pool := range explanation.Pools[1:] {
+			assert.Equal(t, "fast-block", pool.Backend)
+			assert.False(t, pool.Eligible)
+			assert.Equal(t, storageclass.PoolCheckProtocol, pool.FailedChecks()[0].Check)
+		}
+	}
+
+	// A volume larger than any pool is rejected everywhere
+	volConfig.Size = "1Ti"
+	explanation, err = orchestrator.ExplainVolumePlacement(ctx(), volConfig)
+	assert.NoError(t, err)
+	if assert.Len(t, explanation.Pools, 3) {
+		filePool := explanation.Pools[2]
+		assert.Equal(t, "fast-file", filePool.Backend)
+		assert.False(t, filePool.Eligible)
+		assert.Equal(t, storageclass.PoolCheckCapacity, filePool.FailedChecks()[0].Check)
+	}
+
+	// Nothing was created
+	_, err = orchestrator.GetVolume(ctx(), "explained")
+	assert.True(t, errors.IsNotFoundError(err))
+
+	volConfig.StorageClass = "thick"
+	_, err = orchestrator.ExplainVolumePlacement(ctx(), volConfig)
+	assert.True(t, errors.IsNotFoundError(err))
+}
+
 // The next series of tests test that bootstrap doesn't exit early if it
 // encounters a key error for one of the main types of entries.
 func TestStorageClassOnlyBootstrap(t *testing.T) {
@@ -4888,7 +5012,9 @@ func TestPublishVolume(t *testing.T) {
 			}
 
 			// Run the test
+			o.mutex.Lock()
 			err := o.publishVolume(coreCtx, tr.volumeName, &utils.VolumePublishInfo{HostName: nodeName})
+			o.mutex.Unlock()
 			if !tr.wantErr(t, err, "Unexpected Result") {
 				return
 			}
@@ -5142,7 +5268,9 @@ func TestUnpublishVolume(t *testing.T) {
 
 			tr.mocks(mockBackend, mockStoreClient)
 
+			o.mutex.Lock()
 			err := o.unpublishVolume(coreCtx, tr.volumeName, tr.nodeName)
+			o.mutex.Unlock()
 			if !tr.wantErr(t, err, "Unexpected Result") {
 				return
 			}
@@ -6218,7 +6346,9 @@ func TestHandleFailedTranxResizeVolume(t *testing.T) {
 			if tt.name == "ResizeVolumeSuccess" || tt.name == "ResizeVolumeFail" {
 				o.volumes = map[string]*storage.Volume{"fakeVol": vol}
 			}
+			o.mutex.Lock()
 			err := o.handleFailedTransaction(ctx(), svt)
+			o.mutex.Unlock()
 			tt.wantErr(t, err, "Unexpected Result")
 		})
 	}
@@ -6284,7 +6414,9 @@ func TestDeleteSubordinateVolume(t *testing.T) {
 				o.subordinateVolumes[subVolName] = subordVolume
 			}
 
+			o.mutex.Lock()
 			err := o.deleteSubordinateVolume(coreCtx, subVolName)
+			o.mutex.Unlock()
 			tt.wantErr(t, err, "Unexpected result")
 		})
 	}
@@ -6323,6 +6455,210 @@ func TestDeleteSubordinateVolume(t *testing.T) {
 	})
 }
 
+func TestMoveVolume_WithinBackend(t *testing.T) {
+	backendUUID := "abcd"
+	volName := "fakeVol"
+	volConfig := tu.GenerateVolumeConfig(volName, 1, "fakeSC", config.File)
+	volConfig.InternalName = "trident_fakeVol"
+
+	mockCtrl := gomock.NewController(t)
+	mockBackend := mockstorage.NewMockBackend(mockCtrl)
+	mockBackend.EXPECT().BackendUUID().Return(backendUUID).AnyTimes()
+	mockBackend.EXPECT().Name().Return("mockBackend").AnyTimes()
+	mockBackend.EXPECT().GetDriverName().Return("ontap-nas").AnyTimes()
+	mockBackend.EXPECT().State().Return(storage.Online).AnyTimes()
+	mockBackend.EXPECT().Storage().Return(map[string]storage.Pool{
+		"aggr1": storage.NewStoragePool(mockBackend, "aggr1"),
+		"aggr2": storage.NewStoragePool(mockBackend, "aggr2"),
+	}).AnyTimes()
+
+	o := getOrchestrator(t, false)
+	o.storeClient = persistentstore.NewInMemoryClient()
+	o.backends[backendUUID] = mockBackend
+	vol := storage.NewVolume(volConfig, backendUUID, "aggr1", false, storage.VolumeStateOnline)
+	o.volumes[volName] = vol
+	assert.NoError(t, o.storeClient.AddVolume(ctx(), vol))
+
+	// The move starts and is recorded in a transaction
+	mockBackend.EXPECT().MoveVolume(gomock.Any(), gomock.Any(), "aggr2").
+		Return(errors.InProgressError("moving"))
+	_, err := o.MoveVolume(ctx(), volName, "", "aggr2")
+	assert.True(t, errors.IsInProgressError(err))
+
+	txns, err := o.storeClient.GetVolumeTransactions(ctx())
+	assert.NoError(t, err)
+	if assert.Len(t, txns, 1) {
+		assert.Equal(t, storage.MoveVolume, txns[0].Op)
+		assert.Equal(t, "aggr2", txns[0].VolumeMoveConfig.DestinationPool)
+	}
+
+	// Other operations on the volume are refused while it moves
+	err = o.AddVolumeTransaction(ctx(), &storage.VolumeTransaction{Config: volConfig, Op: storage.ResizeVolume})
+	assert.True(t, errors.IsVolumeStateError(err))
+
+	// So is a move to somewhere else
+	_, err = o.MoveVolume(ctx(), volName, "", "aggr3")
+	assert.True(t, errors.IsVolumeStateError(err))
+
+	// The move completes
+	mockBackend.EXPECT().MoveVolume(gomock.Any(), gomock.Any(), "aggr2").Return(nil)
+	mockBackend.EXPECT().RemoveCachedVolume(volName)
+	mockBackend.EXPECT().AddCachedVolume(gomock.Any())
+	result, err := o.MoveVolume(ctx(), volName, "", "aggr2")
+	assert.NoError(t, err)
+	if assert.NotNil(t, result) {
+		assert.Equal(t, "aggr2", result.Pool)
+		assert.Equal(t, backendUUID, result.BackendUUID)
+	}
+
+	storedVol, err := o.storeClient.GetVolume(ctx(), volName)
+	assert.NoError(t, err)
+	assert.Equal(t, "aggr2", storedVol.Pool)
+
+	txns, _ = o.storeClient.GetVolumeTransactions(ctx())
+	assert.Empty(t, txns)
+
+	// Moving it again to the same pool does nothing
+	_, err = o.MoveVolume(ctx(), volName, "", "aggr2")
+	assert.NoError(t, err)
+}
+
+func TestMoveVolume_Invalid(t *testing.T) {
+	backendUUID := "abcd"
+	volName := "fakeVol"
+
+	mockCtrl := gomock.NewController(t)
+	mockBackend := mockstorage.NewMockBackend(mockCtrl)
+	mockBackend.EXPECT().BackendUUID().Return(backendUUID).AnyTimes()
+	mockBackend.EXPECT().Name().Return("mockBackend").AnyTimes()
+	mockBackend.EXPECT().State().Return(storage.Online).AnyTimes()
+	mockBackend.EXPECT().Storage().Return(map[string]storage.Pool{
+		"aggr1": storage.NewStoragePool(mockBackend, "aggr1"),
+	}).AnyTimes()
+
+	otherBackend := mockstorage.NewMockBackend(mockCtrl)
+	otherBackend.EXPECT().BackendUUID().Return("efgh").AnyTimes()
+	otherBackend.EXPECT().Name().Return("otherBackend").AnyTimes()
+	otherBackend.EXPECT().State().Return(storage.Online).AnyTimes()
+	otherBackend.EXPECT().CanMirror().Return(true).AnyTimes()
+	otherBackend.EXPECT().GetDriverName().Return("ontap-nas").AnyTimes()
+	otherBackend.EXPECT().Storage().Return(map[string]storage.Pool{
+		"aggr9": storage.NewStoragePool(otherBackend, "aggr9"),
+	}).AnyTimes()
+	mockBackend.EXPECT().CanMirror().Return(true).AnyTimes()
+	mockBackend.EXPECT().GetDriverName().Return("ontap-nas").AnyTimes()
+
+	o := getOrchestrator(t, false)
+	o.storeClient = persistentstore.NewInMemoryClient()
+	o.backends[backendUUID] = mockBackend
+	o.backends["efgh"] = otherBackend
+
+	volConfig := tu.GenerateVolumeConfig(volName, 1, "fakeSC", config.File)
+	o.volumes[volName] = storage.NewVolume(volConfig, backendUUID, "aggr1", false, storage.VolumeStateOnline)
+
+	// Unknown volume, backend and pool
+	_, err := o.MoveVolume(ctx(), "otherVol", "", "aggr1")
+	assert.True(t, errors.IsNotFoundError(err))
+	_, err = o.MoveVolume(ctx(), volName, "missingBackend", "aggr1")
+	assert.True(t, errors.IsNotFoundError(err))
+	_, err = o.MoveVolume(ctx(), volName, "", "aggr2")
+	assert.True(t, errors.IsNotFoundError(err))
+
+	// Volumes with snapshots cannot move to another backend
+	snapConfig := &storage.SnapshotConfig{Name: "snap", VolumeName: volName}
+	o.snapshots[snapConfig.ID()] = &storage.Snapshot{Config: snapConfig}
+	_, err = o.MoveVolume(ctx(), volName, "otherBackend", "aggr9")
+	assert.True(t, errors.IsUnsupportedError(err))
+	delete(o.snapshots, snapConfig.ID())
+
+	// Nor can mirror destinations, or volumes that are being deleted
+	volConfig.IsMirrorDestination = true
+	_, err = o.MoveVolume(ctx(), volName, "otherBackend", "aggr9")
+	assert.True(t, errors.IsVolumeStateError(err))
+	volConfig.IsMirrorDestination = false
+
+	o.volumes[volName].State = storage.VolumeStateDeleting
+	_, err = o.MoveVolume(ctx(), volName, "", "aggr1")
+	assert.True(t, errors.IsVolumeStateError(err))
+
+	txns, _ := o.storeClient.GetVolumeTransactions(ctx())
+	assert.Empty(t, txns)
+}
+
+func TestHandleFailedTransaction_VolumeMove(t *testing.T) {
+	volName := "fakeVol"
+	sourceConfig := tu.GenerateVolumeConfig(volName, 1, "fakeSC", config.File)
+	sourceConfig.InternalName = "trident_source"
+	destConfig := sourceConfig.ConstructClone()
+	destConfig.InternalName = "trident_dest"
+
+	mockCtrl := gomock.NewController(t)
+	sourceBackend := mockstorage.NewMockBackend(mockCtrl)
+	sourceBackend.EXPECT().BackendUUID().Return("source").AnyTimes()
+	sourceBackend.EXPECT().GetDriverName().Return("ontap-nas").AnyTimes()
+	destBackend := mockstorage.NewMockBackend(mockCtrl)
+	destBackend.EXPECT().BackendUUID().Return("dest").AnyTimes()
+	destBackend.EXPECT().GetDriverName().Return("ontap-nas").AnyTimes()
+
+	o := getOrchestrator(t, false)
+	o.storeClient = persistentstore.NewInMemoryClient()
+	o.backends["source"] = sourceBackend
+	o.backends["dest"] = destBackend
+	vol := storage.NewVolume(sourceConfig, "source", "aggr1", false, storage.VolumeStateOnline)
+	o.volumes[volName] = vol
+	assert.NoError(t, o.storeClient.AddVolume(ctx(), vol))
+
+	txn := &storage.VolumeTransaction{
+		VolumeMoveConfig: &storage.VolumeMoveConfig{
+			StartTime:              time.Now(),
+			SourceBackendUUID:      "source",
+			SourcePool:             "aggr1",
+			DestinationBackendUUID: "dest",
+			DestinationPool:        "aggr9",
+			DestinationConfig:      destConfig,
+			State:                  storage.VolumeMoveStateCuttingOver,
+			VolumeConfig:           *sourceConfig,
+		},
+		Op: storage.MoveVolume,
+	}
+	assert.NoError(t, o.storeClient.AddVolumeTransaction(ctx(), txn))
+
+	// A move that was not committed is left to be resumed
+	o.mutex.Lock()
+	assert.NoError(t, o.handleFailedTransaction(ctx(), txn))
+	o.mutex.Unlock()
+	assert.Equal(t, "source", o.volumes[volName].BackendUUID)
+	storedTxn, err := o.storeClient.GetVolumeTransaction(ctx(), txn)
+	assert.NoError(t, err)
+	assert.NotNil(t, storedTxn)
+
+	// A committed move switches the volume to its destination and removes the source
+	txn.VolumeMoveConfig.State = storage.VolumeMoveStateCommitted
+	assert.NoError(t, o.storeClient.UpdateVolumeTransaction(ctx(), txn))
+	sourceBackend.EXPECT().RemoveCachedVolume(volName)
+	destBackend.EXPECT().AddCachedVolume(gomock.Any())
+	sourceBackend.EXPECT().RemoveVolume(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, volConfig *storage.VolumeConfig) error {
+			assert.Equal(t, "trident_source", volConfig.InternalName)
+			return nil
+		})
+
+	o.mutex.Lock()
+	assert.NoError(t, o.handleFailedTransaction(ctx(), txn))
+	o.mutex.Unlock()
+	assert.Equal(t, "dest", o.volumes[volName].BackendUUID)
+	assert.Equal(t, "aggr9", o.volumes[volName].Pool)
+	assert.Equal(t, "trident_dest", o.volumes[volName].Config.InternalName)
+
+	storedVol, err := o.storeClient.GetVolume(ctx(), volName)
+	assert.NoError(t, err)
+	assert.Equal(t, "dest", storedVol.BackendUUID)
+
+	storedTxn, err = o.storeClient.GetVolumeTransaction(ctx(), txn)
+	assert.NoError(t, err)
+	assert.Nil(t, storedTxn)
+}
+
 func TestDeleteVolume(t *testing.T) {
 	backendUUID := "abcd"
 	srcVolName := "fakeSrcVol"
@@ -6479,7 +6815,9 @@ func TestDeleteVolume(t *testing.T) {
 				o.volumes[cloneVolName] = cloneVolume
 			}
 
+			o.mutex.Lock()
 			err := o.deleteVolume(coreCtx, srcVolName)
+			o.mutex.Unlock()
 			tt.wantErr(t, err, "Unexpected result")
 		})
 	}
@@ -6512,7 +6850,9 @@ func TestHandleFailedDeleteVolumeError(t *testing.T) {
 	o.backends[backendUUID] = mockBackend
 	o.volumes[srcVolName] = sourceVolume
 
+	o.mutex.Lock()
 	err := o.handleFailedTransaction(ctx(), tnx)
+	o.mutex.Unlock()
 	assert.Error(t, err, "failed to delete volume transaction")
 }
 
@@ -7385,7 +7725,9 @@ func TestDeleteSnapshotError(t *testing.T) {
 				o.volumes[volName] = tt.volume
 			}
 
+			o.mutex.Lock()
 			err := o.deleteSnapshot(coreCtx, snapConfig)
+			o.mutex.Unlock()
 			assert.Error(t, err, "Unexpected error")
 		})
 	}
@@ -7419,7 +7761,9 @@ func TestHandleFailedSnapshot(t *testing.T) {
 	mockBackend.EXPECT().DeleteSnapshot(coreCtx, gomock.Any(),
 		gomock.Any()).Return(errors.New("failed to delete snapshot"))
 	mockBackend.EXPECT().Name().Return("abc")
+	o.mutex.Lock()
 	err := o.handleFailedTransaction(ctx(), vt)
+	o.mutex.Unlock()
 	assert.Error(t, err, "Delete volume error")
 
 	delete(o.snapshots, snapID)
@@ -7429,7 +7773,9 @@ func TestHandleFailedSnapshot(t *testing.T) {
 	mockBackend.EXPECT().Name().Return("abc")
 	mockBackend.EXPECT().DeleteSnapshot(coreCtx, gomock.Any(),
 		gomock.Any()).Return(errors.New("failed to delete snapshot"))
+	o.mutex.Lock()
 	err = o.handleFailedTransaction(ctx(), vt)
+	o.mutex.Unlock()
 	assert.Error(t, err, "Delete snapshot error")
 
 	// DeleteSnapshot returns UnSupported error
@@ -7439,7 +7785,9 @@ func TestHandleFailedSnapshot(t *testing.T) {
 		gomock.Any()).Return(errors.UnsupportedError("failed to delete snapshot"))
 	mockStoreClient.EXPECT().DeleteVolumeTransaction(coreCtx,
 		gomock.Any()).Return(errors.New("failed to delete transaction"))
+	o.mutex.Lock()
 	err = o.handleFailedTransaction(ctx(), vt)
+	o.mutex.Unlock()
 	assert.Error(t, err, "Delete snapshot error")
 
 	// DeleteSnapshot returns NotFound error
@@ -7449,7 +7797,9 @@ func TestHandleFailedSnapshot(t *testing.T) {
 		gomock.Any()).Return(errors.NotFoundError("failed to delete snapshot"))
 	mockStoreClient.EXPECT().DeleteVolumeTransaction(coreCtx,
 		gomock.Any()).Return(errors.New("failed to delete transaction"))
+	o.mutex.Lock()
 	err = o.handleFailedTransaction(ctx(), vt)
+	o.mutex.Unlock()
 	assert.Error(t, err, "Delete snapshot error")
 
 	delete(o.backends, "xyz")
@@ -7457,7 +7807,9 @@ func TestHandleFailedSnapshot(t *testing.T) {
 	mockBackend.EXPECT().DeleteSnapshot(coreCtx, gomock.Any(), gomock.Any()).Return(nil)
 	mockStoreClient.EXPECT().DeleteVolumeTransaction(coreCtx,
 		gomock.Any()).Return(errors.New("failed to delete transaction"))
+	o.mutex.Lock()
 	err = o.handleFailedTransaction(ctx(), vt)
+	o.mutex.Unlock()
 	assert.Error(t, err, "Delete volume transaction error")
 
 	// storage.DeleteSnapshot switch case tests
@@ -7469,7 +7821,9 @@ func TestHandleFailedSnapshot(t *testing.T) {
 	mockBackend.EXPECT().Name().Return("abc")
 	mockStoreClient.EXPECT().DeleteVolumeTransaction(coreCtx,
 		gomock.Any()).Return(errors.New("failed to delete transaction"))
+	o.mutex.Lock()
 	err = o.handleFailedTransaction(ctx(), vt)
+	o.mutex.Unlock()
 	assert.Error(t, err, "Delete volume transaction error")
 }
 
@@ -7496,13 +7850,16 @@ func TestListLogWorkflows(t *testing.T) {
 
 	flows, err := o.ListLoggingWorkflows(ctx())
 	expected := []string{
-		"backend=create,delete,get,list,update", "controller=get_capabilities,publish,unpublish",
-		"core=bootstrap,init,node_reconcile,version", "cr=reconcile", "crd_controller=create", "grpc=trace",
+		"backend=create,delete,get,get_orphans,list,resolve_orphans,update",
+		"controller=get_capabilities,get_capacity,publish,unpublish",
+		"core=bootstrap,init,node_reconcile,version", "cr=reconcile", "crd_controller=create",
+		"group_snapshot=create,delete,get,get_capabilities", "grpc=trace",
 		"k8s_client=trace_api,trace_factory", "node=create,delete,get,get_capabilities,get_info,get_response,list,update",
 		"node_server=publish,stage,unpublish,unstage", "plugin=activate,create,deactivate,get,list",
-		"snapshot=clone_from,create,delete,get,list,update", "storage_class=create,delete,get,list,update",
+		"snapshot=clone_from,create,delete,get,list,update", "snapshot_policy=create,delete,get,list,update",
+		"storage_class=create,delete,get,list,update",
 		"storage_client=create", "trident_rest=logger",
-		"volume=clone,create,delete,get,get_capabilities,get_path,get_stats,import,list,mount,resize,unmount,update,upgrade",
+		"volume=clone,create,delete,get,get_capabilities,get_path,get_stats,import,list,mount,move,resize,unmount,update,upgrade",
 	}
 	assert.Equal(t, expected, flows)
 	assert.NoError(t, err)
@@ -7513,9 +7870,9 @@ func TestListLogLayers(t *testing.T) {
 
 	layers, err := o.ListLogLayers(ctx())
 	expected := []string{
-		"all", "azure-netapp-files", "azure-netapp-files-subvolume", "core", "crd_frontend",
-		"csi_frontend", "docker_frontend", "fake", "gcp-cvs", "ontap-nas", "ontap-nas-economy", "ontap-nas-flexgroup",
-		"ontap-san", "ontap-san-economy", "persistent_store", "rest_frontend", "solidfire-san",
+		"all", "azure-netapp-files", "azure-netapp-files-subvolume", "controlplane_frontend", "core",
+		"crd_frontend", "csi_frontend", "docker_frontend", "fake", "gcp-cvs", "ontap-nas", "ontap-nas-economy",
+		"ontap-nas-flexgroup", "ontap-san", "ontap-san-economy", "persistent_store", "rest_frontend", "solidfire-san",
 	}
 	assert.Equal(t, expected, layers)
 	assert.NoError(t, err)
@@ -8666,3 +9023,638 @@ func TestUpdateBackendState(t *testing.T) {
 	_, err = o.UpdateBackendState(ctx, "something", "", "suspended")
 	assert.NoError(t, err, "update to userState via tridentctl should be allowed when there's no tbc linked to this tbe yet")
 }
+
+func TestGetVolumeHealth(t *testing.T) {
+	backendUUID := "45e44b30-8f53-498d-8555-2cf006760ba6"
+	vol := &storage.Volume{
+		Config:      &storage.VolumeConfig{Name: "vol1", InternalName: "trident_vol1"},
+		BackendUUID: backendUUID,
+	}
+	subordinateVol := &storage.Volume{
+		Config: &storage.VolumeConfig{Name: "vol2", ShareSourceVolume: "vol1"},
+	}
+	orphanVol := &storage.Volume{
+		Config:      &storage.VolumeConfig{Name: "vol3"},
+		BackendUUID: "missing",
+	}
+
+	orchestrator := getOrchestrator(t, false)
+	defer cleanup(t, orchestrator)
+	orchestrator.volumes[vol.Config.Name] = vol
+	orchestrator.volumes[orphanVol.Config.Name] = orphanVol
+	orchestrator.subordinateVolumes[subordinateVol.Config.Name] = subordinateVol
+
+	mockCtrl := gomock.NewController(t)
+	mockBackend := mockstorage.NewMockBackend(mockCtrl)
+	orchestrator.backends[backendUUID] = mockBackend
+
+	// Nothing has been checked yet
+	health, err := orchestrator.ListVolumeHealth(ctx())
+	assert.NoError(t, err)
+	assert.Empty(t, health)
+
+	// Volume health is checked on its backend and cached
+	mockBackend.EXPECT().GetVolumeHealth(gomock.Any(), vol.Config).Return(
+		storage.NewVolumeHealth(storage.VolumeHealthReadOnly, "read-only"), nil)
+	volumeHealth, err := orchestrator.GetVolumeHealth(ctx(), "vol1")
+	assert.NoError(t, err)
+	assert.Equal(t, storage.VolumeHealthReadOnly, volumeHealth.Condition)
+
+	// Subordinate volumes report the health of their source volume
+	mockBackend.EXPECT().GetVolumeHealth(gomock.Any(), vol.Config).Return(
+		storage.NewVolumeHealth(storage.VolumeHealthNormal, ""), nil)
+	volumeHealth, err = orchestrator.GetVolumeHealth(ctx(), "vol2")
+	assert.NoError(t, err)
+	assert.False(t, volumeHealth.IsAbnormal())
+
+	// Volumes without a backend are missing
+	volumeHealth, err = orchestrator.GetVolumeHealth(ctx(), "vol3")
+	assert.NoError(t, err)
+	assert.Equal(t, storage.VolumeHealthMissing, volumeHealth.Condition)
+
+	// Backend errors are returned and not cached
+	mockBackend.EXPECT().GetVolumeHealth(gomock.Any(), vol.Config).Return(nil, errors.New("failed"))
+	_, err = orchestrator.GetVolumeHealth(ctx(), "vol1")
+	assert.Error(t, err)
+
+	_, err = orchestrator.GetVolumeHealth(ctx(), "unknown")
+	assert.True(t, errors.IsNotFoundError(err))
+
+	health, err = orchestrator.ListVolumeHealth(ctx())
+	assert.NoError(t, err)
+	assert.Len(t, health, 3)
+	assert.Equal(t, storage.VolumeHealthReadOnly, health["vol1"].Condition)
+
+	// Health of removed volumes is no longer listed
+	delete(orchestrator.volumes, orphanVol.Config.Name)
+	health, err = orchestrator.ListVolumeHealth(ctx())
+	assert.NoError(t, err)
+	assert.Len(t, health, 2)
+	assert.NotContains(t, health, "vol3")
+
+	// A periodic sweep refreshes every volume
+	mockBackend.EXPECT().GetVolumeHealth(gomock.Any(), vol.Config).Return(
+		storage.NewVolumeHealth(storage.VolumeHealthNormal, ""), nil).Times(2)
+	orchestrator.checkVolumeHealth(ctx())
+	health, err = orchestrator.ListVolumeHealth(ctx())
+	assert.NoError(t, err)
+	assert.Equal(t, storage.VolumeHealthNormal, health["vol1"].Condition)
+}
+
+func prepGroupSnapshotTest(t *testing.T, o *TridentOrchestrator, backendName, scName string, volumeNames ...string) {
+	cfg, err := fakedriver.NewFakeStorageDriverConfigJSON(backendName, config.File, tu.GenerateFakePools(1), nil)
+	if err != nil {
+		t.Fatal("Unable to generate config JSON: ", err)
+	}
+	if _, err = o.AddBackend(ctx(), cfg, ""); err != nil {
+		t.Fatal("Unable to add backend: ", err)
+	}
+	if _, err = o.AddStorageClass(ctx(), &storageclass.Config{
+		Name:            scName,
+		AdditionalPools: map[string][]string{backendName: {".*"}},
+	}); err != nil {
+		t.Fatal("Unable to add storage class: ", err)
+	}
+	for _, volumeName := range volumeNames {
+		if _, err = o.AddVolume(ctx(), tu.GenerateVolumeConfig(volumeName, 1, scName, config.File)); err != nil {
+			t.Fatal("Unable to add volume: ", err)
+		}
+	}
+}
+
+func TestGroupSnapshot(t *testing.T) {
+	const (
+		backendName       = "groupSnapshotBackend"
+		scName            = "groupSnapshotSC"
+		groupSnapshotName = "groupsnapshot-1234"
+	)
+	volumeNames := []string{"groupSnapshotVol1", "groupSnapshotVol2"}
+
+	orchestrator := getOrchestrator(t, false)
+	defer cleanup(t, orchestrator)
+	prepGroupSnapshotTest(t, orchestrator, backendName, scName, volumeNames...)
+
+	groupSnapshotConfig := &storage.GroupSnapshotConfig{
+		Version:     config.OrchestratorAPIVersion,
+		Name:        groupSnapshotName,
+		VolumeNames: volumeNames,
+	}
+	groupSnapshot, err := orchestrator.CreateGroupSnapshot(ctx(), groupSnapshotConfig)
+	if err != nil {
+		t.Fatal("Unable to create group snapshot: ", err)
+	}
+	assert.Len(t, groupSnapshot.SnapshotIDs, len(volumeNames))
+
+	// Each volume has a snapshot that knows its group
+	for _, volumeName := range volumeNames {
+		snapshot, err := orchestrator.GetSnapshot(ctx(), volumeName, groupSnapshotName)
+		assert.NoError(t, err)
+		assert.Equal(t, groupSnapshotName, snapshot.Config.GroupSnapshotName)
+		assert.Contains(t, groupSnapshot.SnapshotIDs, snapshot.ID())
+	}
+
+	// Creating the group again fails
+	_, err = orchestrator.CreateGroupSnapshot(ctx(), groupSnapshotConfig)
+	assert.True(t, errors.IsFoundError(err), "expected found error, got %v", err)
+
+	// Member snapshots may not be deleted on their own
+	err = orchestrator.DeleteSnapshot(ctx(), volumeNames[0], groupSnapshotName)
+	assert.True(t, errors.IsInvalidInputError(err), "expected invalid input error, got %v", err)
+
+	groupSnapshots, err := orchestrator.ListGroupSnapshots(ctx())
+	assert.NoError(t, err)
+	assert.Len(t, groupSnapshots, 1)
+
+	// The group snapshot survives a restart
+	newOrchestrator := getOrchestrator(t, false)
+	bootstrapped, err := newOrchestrator.GetGroupSnapshot(ctx(), groupSnapshotName)
+	if err != nil {
+		t.Fatal("Group snapshot not bootstrapped: ", err)
+	}
+	assert.Equal(t, groupSnapshot, bootstrapped)
+
+	// Deleting the group deletes its snapshots
+	if err = newOrchestrator.DeleteGroupSnapshot(ctx(), groupSnapshotName); err != nil {
+		t.Fatal("Unable to delete group snapshot: ", err)
+	}
+	for _, volumeName := range volumeNames {
+		_, err = newOrchestrator.GetSnapshot(ctx(), volumeName, groupSnapshotName)
+		assert.True(t, errors.IsNotFoundError(err), "expected snapshot of %s to be deleted", volumeName)
+	}
+	_, err = newOrchestrator.GetGroupSnapshot(ctx(), groupSnapshotName)
+	assert.True(t, errors.IsNotFoundError(err), "expected not found error, got %v", err)
+	_, err = newOrchestrator.storeClient.GetGroupSnapshot(ctx(), groupSnapshotName)
+	assert.True(t, persistentstore.MatchKeyNotFoundErr(err), "group snapshot still in store")
+
+	err = newOrchestrator.DeleteGroupSnapshot(ctx(), groupSnapshotName)
+	assert.True(t, errors.IsNotFoundError(err), "expected not found error, got %v", err)
+
+	if txns, err := newOrchestrator.storeClient.GetVolumeTransactions(ctx()); err != nil {
+		t.Error("Unable to retrieve transactions from backing store: ", err)
+	} else {
+		assert.Empty(t, txns, "transactions not cleared from the backing store")
+	}
+}
+
+func TestCreateGroupSnapshot_Invalid(t *testing.T) {
+	orchestrator := getOrchestrator(t, false)
+	defer cleanup(t, orchestrator)
+	prepGroupSnapshotTest(t, orchestrator, "groupSnapshotBackendA", "groupSnapshotSCA", "volA")
+	prepGroupSnapshotTest(t, orchestrator, "groupSnapshotBackendB", "groupSnapshotSCB", "volB")
+
+	tests := []struct {
+		name        string
+		volumeNames []string
+		verifyError func(error) bool
+	}{
+		{"no volumes", nil, errors.IsInvalidInputError},
+		{"duplicate volumes", []string{"volA", "volA"}, errors.IsInvalidInputError},
+		{"missing volume", []string{"volA", "missing"}, errors.IsNotFoundError},
+		{"several backends", []string{"volA", "volB"}, errors.IsUnsupportedError},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := orchestrator.CreateGroupSnapshot(ctx(), &storage.GroupSnapshotConfig{
+				Name:        "groupsnapshot-invalid",
+				VolumeNames: test.volumeNames,
+			})
+			assert.True(t, test.verifyError(err), "unexpected error %v", err)
+		})
+	}
+
+	// Nothing was left behind
+	snapshots, err := orchestrator.ListSnapshots(ctx())
+	assert.NoError(t, err)
+	assert.Empty(t, snapshots)
+	groupSnapshots, err := orchestrator.ListGroupSnapshots(ctx())
+	assert.NoError(t, err)
+	assert.Empty(t, groupSnapshots)
+}
+
+func TestBootstrapGroupSnapshots_RollsBackIncompleteGroup(t *testing.T) {
+	const groupSnapshotName = "groupsnapshot-rollback"
+	volumeNames := []string{"rollbackVol1", "rollbackVol2"}
+
+	orchestrator := getOrchestrator(t, false)
+	defer cleanup(t, orchestrator)
+	prepGroupSnapshotTest(t, orchestrator, "rollbackBackend", "rollbackSC", volumeNames...)
+
+	if _, err := orchestrator.CreateGroupSnapshot(ctx(), &storage.GroupSnapshotConfig{
+		Name:        groupSnapshotName,
+		VolumeNames: volumeNames,
+	}); err != nil {
+		t.Fatal("Unable to create group snapshot: ", err)
+	}
+
+	// Reinject the snapshot transactions, as if Trident stopped before the create completed
+	for _, volumeName := range volumeNames {
+		volume, err := orchestrator.GetVolume(ctx(), volumeName)
+		if err != nil {
+			t.Fatal("Unable to get volume: ", err)
+		}
+		snapshot, err := orchestrator.GetSnapshot(ctx(), volumeName, groupSnapshotName)
+		if err != nil {
+			t.Fatal("Unable to get snapshot: ", err)
+		}
+		if err = orchestrator.storeClient.AddVolumeTransaction(ctx(), &storage.VolumeTransaction{
+			Config:         volume.Config,
+			SnapshotConfig: snapshot.Config,
+			Op:             storage.AddSnapshot,
+		}); err != nil {
+			t.Fatal("Unable to create volume transaction: ", err)
+		}
+	}
+
+	newOrchestrator := getOrchestrator(t, false)
+
+	snapshots, err := newOrchestrator.ListSnapshots(ctx())
+	assert.NoError(t, err)
+	assert.Empty(t, snapshots, "snapshots were not rolled back")
+
+	_, err = newOrchestrator.GetGroupSnapshot(ctx(), groupSnapshotName)
+	assert.True(t, errors.IsNotFoundError(err), "expected not found error, got %v", err)
+	_, err = newOrchestrator.storeClient.GetGroupSnapshot(ctx(), groupSnapshotName)
+	assert.True(t, persistentstore.MatchKeyNotFoundErr(err), "group snapshot still in store")
+}
+
+func TestSnapshotPolicyLifecycle(t *testing.T) {
+	orchestrator := getOrchestrator(t, false)
+	defer cleanup(t, orchestrator)
+
+	policyConfig := &storage.SnapshotPolicyConfig{
+		Version:        config.OrchestratorAPIVersion,
+		Name:           "hourly",
+		Schedule:       "@hourly",
+		RetentionCount: 24,
+		VolumeNames:    []string{"vol1"},
+	}
+
+	_, err := orchestrator.AddSnapshotPolicy(ctx(), &storage.SnapshotPolicyConfig{Name: "invalid", Schedule: "never"})
+	assert.True(t, errors.IsInvalidInputError(err), "expected invalid input error, got %v", err)
+
+	policy, err := orchestrator.AddSnapshotPolicy(ctx(), policyConfig)
+	if err != nil {
+		t.Fatal("Unable to add snapshot policy: ", err)
+	}
+	assert.NotEmpty(t, policy.NextRunTime)
+	persistentPolicy, err := orchestrator.storeClient.GetSnapshotPolicy(ctx(), "hourly")
+	assert.NoError(t, err, "snapshot policy was not persisted")
+	assert.Equal(t, policy.NextRunTime, persistentPolicy.NextRunTime)
+
+	_, err = orchestrator.AddSnapshotPolicy(ctx(), policyConfig)
+	assert.True(t, errors.IsFoundError(err), "expected found error, got %v", err)
+
+	updatedConfig := *policyConfig
+	updatedConfig.RetentionCount = 12
+	policy, err = orchestrator.UpdateSnapshotPolicy(ctx(), &updatedConfig)
+	assert.NoError(t, err)
+	assert.Equal(t, 12, policy.Config.RetentionCount)
+
+	_, err = orchestrator.UpdateSnapshotPolicy(ctx(), &storage.SnapshotPolicyConfig{
+		Name: "missing", Schedule: "@daily", RetentionCount: 1,
+	})
+	assert.True(t, errors.IsNotFoundError(err), "expected not found error, got %v", err)
+
+	policy, err = orchestrator.GetSnapshotPolicy(ctx(), "hourly")
+	assert.NoError(t, err)
+	assert.Equal(t, 12, policy.Config.RetentionCount)
+
+	policies, err := orchestrator.ListSnapshotPolicies(ctx())
+	assert.NoError(t, err)
+	assert.Len(t, policies, 1)
+
+	// The policy survives a restart
+	newOrchestrator := getOrchestrator(t, false)
+	restoredPolicy, err := newOrchestrator.GetSnapshotPolicy(ctx(), "hourly")
+	assert.NoError(t, err)
+	assert.Equal(t, policy, restoredPolicy)
+
+	assert.NoError(t, orchestrator.DeleteSnapshotPolicy(ctx(), "hourly"))
+	_, err = orchestrator.storeClient.GetSnapshotPolicy(ctx(), "hourly")
+	assert.True(t, persistentstore.MatchKeyNotFoundErr(err), "snapshot policy still in store")
+	_, err = orchestrator.GetSnapshotPolicy(ctx(), "hourly")
+	assert.True(t, errors.IsNotFoundError(err), "expected not found error, got %v", err)
+	err = orchestrator.DeleteSnapshotPolicy(ctx(), "hourly")
+	assert.True(t, errors.IsNotFoundError(err), "expected not found error, got %v", err)
+}
+
+func TestSnapshotPolicyStoreError(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockStoreClient := mockpersistentstore.NewMockStoreClient(mockCtrl)
+	orchestrator := getOrchestrator(t, false)
+	defer cleanup(t, orchestrator)
+
+	policyConfig := &storage.SnapshotPolicyConfig{Name: "hourly", Schedule: "@hourly", RetentionCount: 24}
+	if _, err := orchestrator.AddSnapshotPolicy(ctx(), policyConfig); err != nil {
+		t.Fatal("Unable to add snapshot policy: ", err)
+	}
+
+	// The cached policies only change once the store has
+	orchestrator.storeClient = mockStoreClient
+	mockStoreClient.EXPECT().AddSnapshotPolicy(gomock.Any(), gomock.Any()).Return(fmt.Errorf("fake error"))
+	mockStoreClient.EXPECT().UpdateSnapshotPolicy(gomock.Any(), gomock.Any()).Return(fmt.Errorf("fake error"))
+	mockStoreClient.EXPECT().DeleteSnapshotPolicy(gomock.Any(), gomock.Any()).Return(fmt.Errorf("fake error"))
+
+	_, err := orchestrator.AddSnapshotPolicy(ctx(), &storage.SnapshotPolicyConfig{
+		Name: "daily", Schedule: "@daily", RetentionCount: 7,
+	})
+	assert.Error(t, err)
+	_, err = orchestrator.GetSnapshotPolicy(ctx(), "daily")
+	assert.True(t, errors.IsNotFoundError(err), "expected not found error, got %v", err)
+
+	updatedConfig := *policyConfig
+	updatedConfig.RetentionCount = 12
+	_, err = orchestrator.UpdateSnapshotPolicy(ctx(), &updatedConfig)
+	assert.Error(t, err)
+
+	assert.Error(t, orchestrator.DeleteSnapshotPolicy(ctx(), "hourly"))
+	policy, err := orchestrator.GetSnapshotPolicy(ctx(), "hourly")
+	assert.NoError(t, err)
+	assert.Equal(t, 24, policy.Config.RetentionCount)
+
+	orchestrator.storeClient = inMemoryClient
+}
+
+func TestRunSnapshotPolicies(t *testing.T) {
+	const (
+		backendName = "snapshotPolicyBackend"
+		scName      = "snapshotPolicySC"
+		otherSCName = "snapshotPolicyOtherSC"
+		otherVolume = "snapshotPolicyVol3"
+	)
+	volumeNames := []string{"snapshotPolicyVol1", "snapshotPolicyVol2"}
+
+	orchestrator := getOrchestrator(t, false)
+	defer cleanup(t, orchestrator)
+	prepGroupSnapshotTest(t, orchestrator, backendName, scName, volumeNames...)
+
+	// A volume outside the policy's storage class is left alone
+	if _, err := orchestrator.AddStorageClass(ctx(), &storageclass.Config{
+		Name:            otherSCName,
+		AdditionalPools: map[string][]string{backendName: {".*"}},
+	}); err != nil {
+		t.Fatal("Unable to add storage class: ", err)
+	}
+	if _, err := orchestrator.AddVolume(ctx(), tu.GenerateVolumeConfig(otherVolume, 1, otherSCName,
+		config.File)); err != nil {
+		t.Fatal("Unable to add volume: ", err)
+	}
+
+	_, err := orchestrator.AddSnapshotPolicy(ctx(), &storage.SnapshotPolicyConfig{
+		Name:           "hourly",
+		Schedule:       "@hourly",
+		RetentionCount: 2,
+		StorageClasses: []string{scName},
+	})
+	if err != nil {
+		t.Fatal("Unable to add snapshot policy: ", err)
+	}
+
+	// Nothing is due yet
+	orchestrator.runSnapshotPolicies(ctx(), time.Now())
+	snapshots, err := orchestrator.ListSnapshots(ctx())
+	assert.NoError(t, err)
+	assert.Empty(t, snapshots)
+
+	// Run the policy three times, an hour apart
+	now := time.Now().Add(time.Hour)
+	for i := 0; i < 3; i++ {
+		orchestrator.runSnapshotPolicies(ctx(), now.Add(time.Duration(i)*time.Hour))
+	}
+
+	policy, err := orchestrator.GetSnapshotPolicy(ctx(), "hourly")
+	assert.NoError(t, err)
+	assert.Len(t, policy.Volumes, len(volumeNames))
+
+	lastSnapshotName := policy.Config.SnapshotName(now.Add(2 * time.Hour))
+	for _, volumeName := range volumeNames {
+		snapshots, err = orchestrator.ListSnapshotsForVolume(ctx(), volumeName)
+		assert.NoError(t, err)
+		assert.Len(t, snapshots, 2, "expected only the newest snapshots to be retained")
+
+		status := policy.Volumes[volumeName]
+		if assert.NotNil(t, status) {
+			assert.Equal(t, lastSnapshotName, status.LastSnapshotName)
+			assert.Equal(t, 2, status.RetainedSnapshots)
+			assert.Empty(t, status.LastError)
+		}
+
+		_, err = orchestrator.GetSnapshot(ctx(), volumeName, policy.Config.SnapshotName(now))
+		assert.True(t, errors.IsNotFoundError(err), "expected oldest snapshot to be deleted")
+	}
+
+	// The status of each run is persisted
+	persistentPolicy, err := orchestrator.storeClient.GetSnapshotPolicy(ctx(), "hourly")
+	assert.NoError(t, err)
+	assert.Equal(t, policy.NextRunTime, persistentPolicy.NextRunTime)
+	assert.Equal(t, policy.Volumes, persistentPolicy.Volumes)
+
+	snapshots, err = orchestrator.ListSnapshotsForVolume(ctx(), otherVolume)
+	assert.NoError(t, err)
+	assert.Empty(t, snapshots)
+
+	// Removing the policy leaves its snapshots in place
+	assert.NoError(t, orchestrator.DeleteSnapshotPolicy(ctx(), "hourly"))
+	orchestrator.runSnapshotPolicies(ctx(), now.Add(3*time.Hour))
+	snapshots, err = orchestrator.ListSnapshots(ctx())
+	assert.NoError(t, err)
+	assert.Len(t, snapshots, 2*len(volumeNames))
+}
+
+func TestOrphanReport(t *testing.T) {
+	const (
+		backendName = "orphanBackend"
+		scName      = "orphanSC"
+		snapName    = "orphanSnap"
+		strayName   = "orphan_stray"
+	)
+	volumeNames := []string{"orphanVol1", "orphanVol2"}
+
+	orchestrator := getOrchestrator(t, false)
+	defer cleanup(t, orchestrator)
+	prepGroupSnapshotTest(t, orchestrator, backendName, scName, volumeNames...)
+
+	_, err := orchestrator.CreateSnapshot(ctx(), &storage.SnapshotConfig{
+		Version:    config.OrchestratorAPIVersion,
+		Name:       snapName,
+		VolumeName: volumeNames[0],
+	})
+	if err != nil {
+		t.Fatal("Unable to create snapshot: ", err)
+	}
+
+	backend, err := orchestrator.getBackendByBackendName(backendName)
+	if err != nil {
+		t.Fatal("Unable to get backend: ", err)
+	}
+	driver, ok := backend.Driver().(*fakedriver.StorageDriver)
+	if !ok {
+		t.Fatalf("%e", errors.TypeAssertionError("backend.Driver().(*fakedriver.StorageDriver)"))
+	}
+	vol1, _ := orchestrator.GetVolume(ctx(), volumeNames[0])
+	vol2, _ := orchestrator.GetVolume(ctx(), volumeNames[1])
+
+	// Leave a volume on the backend that Trident doesn't know, and remove a volume and a snapshot behind its back
+	driver.Volumes[strayName] = fake.Volume{Name: strayName, SizeBytes: 1073741824}
+	delete(driver.Volumes, vol2.Config.InternalName)
+	delete(driver.Snapshots, vol1.Config.InternalName)
+
+	report, err := orchestrator.GetOrphanReport(ctx(), "")
+	assert.NoError(t, err)
+	assert.Empty(t, report.BackendErrors)
+	if assert.Len(t, report.UntrackedVolumes, 1) {
+		assert.Equal(t, strayName, report.UntrackedVolumes[0].InternalName)
+		assert.Equal(t, backendName, report.UntrackedVolumes[0].Backend)
+		assert.Equal(t, "1073741824", report.UntrackedVolumes[0].Size)
+	}
+	if assert.Len(t, report.MissingVolumes, 1) {
+		assert.Equal(t, volumeNames[1], report.MissingVolumes[0].Name)
+		assert.Equal(t, storage.OrphanReasonNotFound, report.MissingVolumes[0].Reason)
+	}
+	if assert.Len(t, report.MissingSnapshots, 1) {
+		assert.Equal(t, snapName, report.MissingSnapshots[0].Name)
+		assert.Equal(t, volumeNames[0], report.MissingSnapshots[0].VolumeName)
+	}
+
+	_, err = orchestrator.GetOrphanReport(ctx(), "unknown")
+	assert.True(t, errors.IsNotFoundError(err), "expected not found error, got %v", err)
+
+	// A dry run changes nothing
+	report, err = orchestrator.ResolveOrphans(ctx(), &storage.ResolveOrphansRequest{
+		Action: storage.OrphanActionDelete,
+	})
+	assert.NoError(t, err)
+	if assert.Len(t, report.UntrackedVolumes, 1) {
+		assert.Equal(t, storage.OrphanResolutionDryRun, report.UntrackedVolumes[0].Resolution)
+	}
+	assert.Contains(t, driver.Volumes, strayName)
+
+	// Only untracked volumes may be resolved
+	dryRun := false
+	_, err = orchestrator.ResolveOrphans(ctx(), &storage.ResolveOrphansRequest{
+		Action:  storage.OrphanActionDelete,
+		Volumes: []string{vol1.Config.InternalName},
+		DryRun:  &dryRun,
+	})
+	assert.True(t, errors.IsNotFoundError(err), "expected not found error, got %v", err)
+
+	_, err = orchestrator.ResolveOrphans(ctx(), &storage.ResolveOrphansRequest{Action: storage.OrphanActionImport})
+	assert.True(t, errors.IsInvalidInputError(err), "expected invalid input error, got %v", err)
+
+	// Import the stray, then leave another and delete it
+	report, err = orchestrator.ResolveOrphans(ctx(), &storage.ResolveOrphansRequest{
+		Backend:      backendName,
+		Action:       storage.OrphanActionImport,
+		StorageClass: scName,
+		DryRun:       &dryRun,
+	})
+	assert.NoError(t, err)
+	if assert.Len(t, report.UntrackedVolumes, 1) {
+		assert.Equal(t, storage.OrphanResolutionImported, report.UntrackedVolumes[0].Resolution)
+		assert.Empty(t, report.UntrackedVolumes[0].Error)
+	}
+	imported, err := orchestrator.GetVolume(ctx(), report.UntrackedVolumes[0].Name)
+	if assert.NoError(t, err) {
+		assert.Equal(t, strayName, imported.Config.InternalName)
+	}
+
+	driver.Volumes["orphan_stray2"] = fake.Volume{
+		Name:          "orphan_stray2",
+		RequestedPool: vol1.Pool,
+		PhysicalPool:  vol1.Pool,
+		SizeBytes:     1073741824,
+	}
+	report, err = orchestrator.ResolveOrphans(ctx(), &storage.ResolveOrphansRequest{
+		Action:  storage.OrphanActionDelete,
+		Volumes: []string{"orphan_stray2"},
+		DryRun:  &dryRun,
+	})
+	assert.NoError(t, err)
+	if assert.Len(t, report.UntrackedVolumes, 1) {
+		assert.Equal(t, storage.OrphanResolutionDeleted, report.UntrackedVolumes[0].Resolution)
+	}
+	assert.NotContains(t, driver.Volumes, "orphan_stray2")
+}
+
+func TestOrphanReport_SharedStorage(t *testing.T) {
+	orchestrator := getOrchestrator(t, false)
+	defer cleanup(t, orchestrator)
+	prepGroupSnapshotTest(t, orchestrator, "sharedBackendA", "sharedSCA", "sharedVolA")
+	prepGroupSnapshotTest(t, orchestrator, "sharedBackendB", "sharedSCB", "sharedVolB")
+
+	backendA, err := orchestrator.getBackendByBackendName("sharedBackendA")
+	if err != nil {
+		t.Fatal("Unable to get backend: ", err)
+	}
+	driverA, ok := backendA.Driver().(*fakedriver.StorageDriver)
+	if !ok {
+		t.Fatalf("%e", errors.TypeAssertionError("backend.Driver().(*fakedriver.StorageDriver)"))
+	}
+	volB, _ := orchestrator.GetVolume(ctx(), "sharedVolB")
+
+	// Backends that share storage and a storage prefix each list the other's volumes
+	driverA.Volumes[volB.Config.InternalName] = fake.Volume{Name: volB.Config.InternalName, SizeBytes: 1073741824}
+
+	report, err := orchestrator.GetOrphanReport(ctx(), "")
+	assert.NoError(t, err)
+	assert.Empty(t, report.BackendErrors)
+	assert.Empty(t, report.UntrackedVolumes)
+	assert.Empty(t, report.MissingVolumes)
+
+	dryRun := false
+	_, err = orchestrator.ResolveOrphans(ctx(), &storage.ResolveOrphansRequest{
+		Backend: "sharedBackendA",
+		Action:  storage.OrphanActionDelete,
+		Volumes: []string{volB.Config.InternalName},
+		DryRun:  &dryRun,
+	})
+	assert.True(t, errors.IsNotFoundError(err), "expected not found error, got %v", err)
+
+	// A volume found untracked on one backend is kept if another backend has since begun tracking it
+	err = orchestrator.deleteUntrackedVolume(ctx(), &storage.UntrackedVolume{
+		Name:         volB.Config.InternalName,
+		InternalName: volB.Config.InternalName,
+		Backend:      backendA.Name(),
+		BackendUUID:  backendA.BackendUUID(),
+	}, volB.Config)
+	assert.True(t, errors.IsFoundError(err), "expected found error, got %v", err)
+	assert.Contains(t, driverA.Volumes, volB.Config.InternalName)
+}
+
+func TestOrphanReport_UnverifiedVolume(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockDriver := mockstorage.NewMockDriver(mockCtrl)
+	mockBackend := mockstorage.NewMockBackend(mockCtrl)
+	mockBackend.EXPECT().BackendUUID().Return("backend-uuid").AnyTimes()
+	mockBackend.EXPECT().Name().Return("backend").AnyTimes()
+	mockBackend.EXPECT().GetDriverName().Return("fake").AnyTimes()
+	mockBackend.EXPECT().State().Return(storage.Online).AnyTimes()
+	mockBackend.EXPECT().Driver().Return(mockDriver).AnyTimes()
+
+	// The inventory lists neither volume, and only one is known to be gone
+	mockDriver.EXPECT().GetVolumeExternalWrappers(gomock.Any(), gomock.Any()).Do(
+		func(_ context.Context, channel chan *storage.VolumeExternalWrapper) {
+			close(channel)
+		})
+	mockDriver.EXPECT().Get(gomock.Any(), "trident_gone").Return(errors.NotFoundError("volume not found"))
+	mockDriver.EXPECT().Get(gomock.Any(), "trident_unreachable").Return(fmt.Errorf("connection timed out"))
+
+	orchestrator := getOrchestrator(t, false)
+	orchestrator.storeClient = persistentstore.NewInMemoryClient()
+	orchestrator.backends["backend-uuid"] = mockBackend
+	for _, name := range []string{"gone", "unreachable"} {
+		volConfig := tu.GenerateVolumeConfig(name, 1, "fakeSC", config.File)
+		volConfig.InternalName = "trident_" + name
+		orchestrator.volumes[name] = storage.NewVolume(volConfig, "backend-uuid", "pool", false,
+			storage.VolumeStateOnline)
+	}
+
+	report, err := orchestrator.GetOrphanReport(ctx(), "")
+	assert.NoError(t, err)
+	if assert.Len(t, report.MissingVolumes, 1) {
+		assert.Equal(t, "gone", report.MissingVolumes[0].Name)
+		assert.Equal(t, storage.OrphanReasonNotFound, report.MissingVolumes[0].Reason)
+	}
+	assert.Contains(t, report.BackendErrors["backend"], "unreachable")
+	assert.Contains(t, report.BackendErrors["backend"], "connection timed out")
+}
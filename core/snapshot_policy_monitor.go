@@ -0,0 +1,165 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package core
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/netapp/trident/config"
+	. "github.com/netapp/trident/logging"
+	"github.com/netapp/trident/storage"
+)
+
+// snapshotPolicyRun is the work gathered for one due snapshot policy.
+type snapshotPolicyRun struct {
+	config      *storage.SnapshotPolicyConfig
+	volumeNames []string
+}
+
+// PeriodicallyRunSnapshotPolicies checks at the specified interval whether any snapshot policy is due,
+// and if so creates a snapshot of each volume governed by the policy and deletes any of the policy's
+// snapshots that fall outside its retention rules.
+func (o *TridentOrchestrator) PeriodicallyRunSnapshotPolicies(pollInterval time.Duration) {
+	ctx := GenerateRequestContext(context.Background(), "", ContextSourcePeriodic, WorkflowCoreSnapshotPolicy,
+		LogLayerCore)
+
+	// Provision to disable snapshot policies, just in case
+	if pollInterval <= 0 {
+		Logc(ctx).Debug("Periodic snapshot policies are disabled.")
+		return
+	}
+
+	Logc(ctx).Info("Starting periodic snapshot policy service.")
+	defer Logc(ctx).Info("Stopping periodic snapshot policy service.")
+
+	o.stopSnapshotPolicyLoop = make(chan bool)
+	snapshotPolicyTimer := time.NewTimer(pollInterval)
+	defer func(t *time.Timer) {
+		if !t.Stop() {
+			<-t.C
+		}
+	}(snapshotPolicyTimer)
+
+	for {
+		select {
+		case <-o.stopSnapshotPolicyLoop:
+			// Exit on shutdown signal.
+			return
+
+		case <-snapshotPolicyTimer.C:
+			Logc(ctx).Trace("Periodic snapshot policy loop beginning.")
+			o.runSnapshotPolicies(ctx, time.Now())
+			// reset the timer so that next poll would start after pollInterval.
+			snapshotPolicyTimer.Reset(pollInterval)
+		}
+	}
+}
+
+// runSnapshotPolicies runs every snapshot policy that is due at the specified time.  The snapshots are
+// created and deleted via the public orchestrator methods, so the orchestrator lock is not held across
+// backend calls, and a policy deleted while it is running simply has its results discarded.
+func (o *TridentOrchestrator) runSnapshotPolicies(ctx context.Context, now time.Time) {
+	if o.bootstrapError != nil {
+		return
+	}
+
+	o.mutex.RLock()
+	runs := make([]snapshotPolicyRun, 0)
+	for _, policy := range o.snapshotPolicies {
+		if !policy.IsDue(now) {
+			continue
+		}
+		run := snapshotPolicyRun{config: policy.Config, volumeNames: make([]string, 0)}
+		for volumeName, volume := range o.volumes {
+			if volume.IsDeleting() || volume.Config.IsMirrorDestination || volume.Config.ImportNotManaged {
+				continue
+			}
+			if policy.Config.Matches(volume.Config) {
+				run.volumeNames = append(run.volumeNames, volumeName)
+			}
+		}
+		runs = append(runs, run)
+	}
+	o.mutex.RUnlock()
+
+	for _, run := range runs {
+		Logc(ctx).WithFields(LogFields{
+			"snapshotPolicy": run.config.Name,
+			"volumes":        len(run.volumeNames),
+		}).Debug("Running snapshot policy.")
+
+		results := make(map[string]*storage.SnapshotPolicyVolumeStatus, len(run.volumeNames))
+		for _, volumeName := range run.volumeNames {
+			results[volumeName] = o.runSnapshotPolicyOnVolume(ctx, run.config, volumeName, now)
+		}
+
+		o.mutex.Lock()
+		if policy, ok := o.snapshotPolicies[run.config.Name]; ok {
+			for volumeName, status := range results {
+				policy.Volumes[volumeName] = status
+			}
+			policy.ScheduleNextRun(now)
+
+			// The policy has already run, so its status is kept even if it cannot be saved
+			if err := o.storeClient.UpdateSnapshotPolicy(ctx, policy); err != nil {
+				Logc(ctx).WithField("snapshotPolicy", run.config.Name).WithError(err).Error(
+					"Could not save snapshot policy status.")
+			}
+		}
+		o.mutex.Unlock()
+	}
+}
+
+// runSnapshotPolicyOnVolume creates a policy snapshot of a single volume and prunes the volume's policy
+// snapshots, returning the outcome.  Failures are recorded in the status rather than returned, so that
+// one volume does not prevent the policy from running against the others.
+func (o *TridentOrchestrator) runSnapshotPolicyOnVolume(
+	ctx context.Context, policyConfig *storage.SnapshotPolicyConfig, volumeName string, now time.Time,
+) *storage.SnapshotPolicyVolumeStatus {
+	fields := LogFields{"snapshotPolicy": policyConfig.Name, "volume": volumeName}
+	status := &storage.SnapshotPolicyVolumeStatus{LastRunTime: now.UTC().Format(time.RFC3339)}
+	failures := make([]string, 0)
+
+	snapshotConfig := &storage.SnapshotConfig{
+		Version:    config.OrchestratorAPIVersion,
+		Name:       policyConfig.SnapshotName(now),
+		VolumeName: volumeName,
+	}
+	if _, err := o.CreateSnapshot(ctx, snapshotConfig); err != nil {
+		Logc(ctx).WithFields(fields).WithError(err).Error("Could not create policy snapshot.")
+		failures = append(failures, err.Error())
+	} else {
+		Logc(ctx).WithFields(fields).WithField("snapshot", snapshotConfig.Name).Info("Created policy snapshot.")
+		status.LastSnapshotName = snapshotConfig.Name
+	}
+
+	snapshots, err := o.ListSnapshotsForVolume(ctx, volumeName)
+	if err != nil {
+		Logc(ctx).WithFields(fields).WithError(err).Error("Could not list snapshots for retention.")
+		failures = append(failures, err.Error())
+	} else {
+		retained := 0
+		for _, snapshot := range snapshots {
+			if policyConfig.IsPolicySnapshot(snapshot.Config.Name) {
+				retained++
+			}
+		}
+		for _, snapshot := range policyConfig.SnapshotsToDelete(snapshots, now) {
+			if err = o.DeleteSnapshot(ctx, volumeName, snapshot.Config.Name); err != nil {
+				Logc(ctx).WithFields(fields).WithField("snapshot", snapshot.Config.Name).WithError(err).Error(
+					"Could not delete expired policy snapshot.")
+				failures = append(failures, err.Error())
+				continue
+			}
+			Logc(ctx).WithFields(fields).WithField("snapshot", snapshot.Config.Name).Info(
+				"Deleted expired policy snapshot.")
+			retained--
+		}
+		status.RetainedSnapshots = retained
+	}
+
+	status.LastError = strings.Join(failures, "; ")
+	return status
+}
@@ -78,6 +78,8 @@ func (o *TridentOrchestrator) checkLongRunningTransactions(ctx context.Context,
 		switch txn.Op {
 		case storage.VolumeCreating:
 			txnMap[txn] = txn.VolumeCreatingConfig.StartTime
+		case storage.MoveVolume:
+			txnMap[txn] = txn.VolumeMoveConfig.StartTime
 		default:
 			continue
 		}
@@ -104,6 +106,9 @@ func (o *TridentOrchestrator) checkLongRunningTransactions(ctx context.Context,
 // reapLongRunningTransaction cleans up any transactions that have expired so that any
 // storage resources associated with them are not orphaned indefinitely.
 func (o *TridentOrchestrator) reapLongRunningTransaction(ctx context.Context, txn *storage.VolumeTransaction) {
+	// Wait for any operation still working on the volume, such as a slow create, to finish
+	defer o.lockVolumes(txn.Name())()
+
 	o.mutex.Lock()
 	defer o.mutex.Unlock()
 
@@ -112,6 +117,18 @@ func (o *TridentOrchestrator) reapLongRunningTransaction(ctx context.Context, tx
 		"name": txn.Name(),
 	}).Debug("Transaction monitor reaping transaction.")
 
+	// The operation may have completed, or moved on, while waiting for the volume lock, so act upon the
+	// transaction as it stands now
+	currentTxn, err := o.storeClient.GetVolumeTransaction(ctx, txn)
+	if err != nil {
+		Logc(ctx).WithField("name", txn.Name()).WithError(err).Error("Could not read transaction to reap it.")
+		return
+	} else if currentTxn == nil {
+		Logc(ctx).WithField("name", txn.Name()).Debug("Transaction completed before it could be reaped.")
+		return
+	}
+	txn = currentTxn
+
 	// Clean up any resources associated with the transaction.
 	switch txn.Op {
 	case storage.VolumeCreating:
@@ -148,6 +165,23 @@ func (o *TridentOrchestrator) reapLongRunningTransaction(ctx context.Context, tx
 			break
 		}
 
+	case storage.MoveVolume:
+
+		// A move under way is left to whoever requested it, who may yet complete or abandon it.  A committed move
+		// is finished, since the volume already lives at its destination.
+		if txn.VolumeMoveConfig.State != storage.VolumeMoveStateCommitted {
+			Logc(ctx).WithField("volume", txn.VolumeMoveConfig.Name).Debug(
+				"Volume move is under way and will not be reaped.")
+			return
+		}
+		if err := o.finishVolumeMove(ctx, txn); err != nil {
+			Logc(ctx).WithFields(LogFields{
+				"volume": txn.VolumeMoveConfig.Name,
+				"error":  err,
+			}).Error("Could not clean up expired volume move. Volumes may have to be removed manually.")
+		}
+		return
+
 	default:
 		break
 	}
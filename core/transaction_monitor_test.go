@@ -3,14 +3,18 @@
 package core
 
 import (
+	"context"
 	"testing"
 	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/netapp/trident/config"
 	. "github.com/netapp/trident/logging"
+	mockstorage "github.com/netapp/trident/mocks/mock_storage"
 	persistentstore "github.com/netapp/trident/persistent_store"
+	"github.com/netapp/trident/storage"
 	"github.com/netapp/trident/storage/fake"
 	sa "github.com/netapp/trident/storage_attribute"
 	storageclass "github.com/netapp/trident/storage_class"
@@ -314,3 +318,118 @@ func restartTransactionMonitor(o *TridentOrchestrator) {
 	o.StartTransactionMonitor(ctx(), period, maxAge)
 	time.Sleep(1 * time.Second)
 }
+
+// setupVolumeMove returns an orchestrator with a volume being moved between two mock backends, and the move's
+// transaction, which is recorded in the persistent store in the specified state.
+func setupVolumeMove(
+	t *testing.T, state storage.VolumeMoveState,
+) (*TridentOrchestrator, *mockstorage.MockBackend, *mockstorage.MockBackend, *storage.VolumeTransaction) {
+	volName := "fakeVol"
+	sourceConfig := tu.GenerateVolumeConfig(volName, 1, "fakeSC", config.File)
+	sourceConfig.InternalName = "trident_source"
+	destConfig := sourceConfig.ConstructClone()
+	destConfig.InternalName = "trident_dest"
+
+	mockCtrl := gomock.NewController(t)
+	newBackend := func(backendUUID, name string) *mockstorage.MockBackend {
+		backend := mockstorage.NewMockBackend(mockCtrl)
+		backend.EXPECT().BackendUUID().Return(backendUUID).AnyTimes()
+		backend.EXPECT().Name().Return(name).AnyTimes()
+		backend.EXPECT().GetDriverName().Return("ontap-nas").AnyTimes()
+		backend.EXPECT().State().Return(storage.Online).AnyTimes()
+		return backend
+	}
+	sourceBackend := newBackend("source", "sourceBackend")
+	destBackend := newBackend("dest", "destBackend")
+
+	o := getOrchestrator(t, false)
+	o.storeClient = persistentstore.NewInMemoryClient()
+	o.backends["source"] = sourceBackend
+	o.backends["dest"] = destBackend
+	vol := storage.NewVolume(sourceConfig, "source", "aggr1", false, storage.VolumeStateOnline)
+	o.volumes[volName] = vol
+	assert.NoError(t, o.storeClient.AddVolume(ctx(), vol))
+
+	txn := &storage.VolumeTransaction{
+		VolumeMoveConfig: &storage.VolumeMoveConfig{
+			StartTime:              time.Now().Add(-2 * txnMonitorMaxAge),
+			SourceBackendUUID:      "source",
+			SourcePool:             "aggr1",
+			DestinationBackendUUID: "dest",
+			DestinationPool:        "aggr9",
+			DestinationConfig:      destConfig,
+			State:                  state,
+			VolumeConfig:           *sourceConfig,
+		},
+		Op: storage.MoveVolume,
+	}
+	assert.NoError(t, o.storeClient.AddVolumeTransaction(ctx(), txn))
+	return o, sourceBackend, destBackend, txn
+}
+
+// TestReapLongRunningTransaction_VolumeMoveCommitted reaps a move that was committed after the transaction monitor
+// read it, but whose cleanup failed.  The move must be finished rather than abandoned, since abandoning it would
+// delete the destination volume, which is now the live one.
+func TestReapLongRunningTransaction_VolumeMoveCommitted(t *testing.T) {
+	o, sourceBackend, destBackend, staleTxn := setupVolumeMove(t, storage.VolumeMoveStateCuttingOver)
+
+	committedTxn := &storage.VolumeTransaction{Op: storage.MoveVolume, VolumeMoveConfig: &storage.VolumeMoveConfig{}}
+	*committedTxn.VolumeMoveConfig = *staleTxn.VolumeMoveConfig
+	committedTxn.VolumeMoveConfig.State = storage.VolumeMoveStateCommitted
+	assert.NoError(t, o.storeClient.UpdateVolumeTransaction(ctx(), committedTxn))
+
+	// The destination volume must be kept, and the source volume removed
+	sourceBackend.EXPECT().RemoveCachedVolume("fakeVol")
+	destBackend.EXPECT().AddCachedVolume(gomock.Any())
+	sourceBackend.EXPECT().RemoveVolume(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, volConfig *storage.VolumeConfig) error {
+			assert.Equal(t, "trident_source", volConfig.InternalName)
+			return nil
+		})
+
+	o.reapLongRunningTransaction(ctx(), staleTxn)
+
+	assert.Equal(t, "dest", o.volumes["fakeVol"].BackendUUID)
+	assert.Equal(t, "trident_dest", o.volumes["fakeVol"].Config.InternalName)
+	storedTxn, err := o.storeClient.GetVolumeTransaction(ctx(), staleTxn)
+	assert.NoError(t, err)
+	assert.Nil(t, storedTxn)
+}
+
+// TestReapLongRunningTransaction_VolumeMoveInProgress checks that a move that is under way is not abandoned,
+// however long it has taken.
+func TestReapLongRunningTransaction_VolumeMoveInProgress(t *testing.T) {
+	o, _, _, txn := setupVolumeMove(t, storage.VolumeMoveStateReplicating)
+
+	// The mock backends fail the test if either volume is removed
+	o.checkLongRunningTransactions(ctx(), txnMonitorMaxAge)
+
+	assert.Equal(t, "source", o.volumes["fakeVol"].BackendUUID)
+	storedTxn, err := o.storeClient.GetVolumeTransaction(ctx(), txn)
+	assert.NoError(t, err)
+	assert.NotNil(t, storedTxn)
+}
+
+// TestMoveVolume_Abandon abandons a move between backends by asking for the volume to be moved to where it was.
+func TestMoveVolume_Abandon(t *testing.T) {
+	o, _, destBackend, txn := setupVolumeMove(t, storage.VolumeMoveStateReplicating)
+
+	// A move elsewhere is refused while the move is under way
+	_, err := o.MoveVolume(ctx(), "fakeVol", "sourceBackend", "aggr2")
+	assert.True(t, errors.IsVolumeStateError(err))
+
+	destBackend.EXPECT().RemoveVolume(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, volConfig *storage.VolumeConfig) error {
+			assert.Equal(t, "trident_dest", volConfig.InternalName)
+			return nil
+		})
+
+	result, err := o.MoveVolume(ctx(), "fakeVol", "", "aggr1")
+	assert.NoError(t, err)
+	assert.Equal(t, "source", result.BackendUUID)
+	assert.Equal(t, "aggr1", result.Pool)
+
+	storedTxn, err := o.storeClient.GetVolumeTransaction(ctx(), txn)
+	assert.NoError(t, err)
+	assert.Nil(t, storedTxn)
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/netapp/trident/config"
 	"github.com/netapp/trident/frontend"
 	"github.com/netapp/trident/storage"
 	storageclass "github.com/netapp/trident/storage_class"
@@ -19,6 +20,7 @@ type Orchestrator interface {
 	AddFrontend(ctx context.Context, f frontend.Plugin)
 	GetFrontend(ctx context.Context, name string) (frontend.Plugin, error)
 	GetVersion(ctx context.Context) (string, error)
+	SubscribeEvents(ctx context.Context, since uint64) (*EventSubscription, error)
 
 	AddBackend(ctx context.Context, configJSON, configRef string) (*storage.BackendExternal, error)
 	DeleteBackend(ctx context.Context, backend string) error
@@ -47,11 +49,16 @@ type Orchestrator interface {
 	GetVolume(ctx context.Context, volumeName string) (*storage.VolumeExternal, error)
 	GetVolumeByInternalName(ctx context.Context, volumeInternal string) (volume string, err error)
 	GetVolumeForImport(ctx context.Context, volumeID, backendName string) (*storage.VolumeExternal, error)
+	GetVolumeHealth(ctx context.Context, volumeName string) (*storage.VolumeHealth, error)
 	ImportVolume(ctx context.Context, volumeConfig *storage.VolumeConfig) (*storage.VolumeExternal, error)
 	ListVolumes(ctx context.Context) ([]*storage.VolumeExternal, error)
+	ListVolumeHealth(ctx context.Context) (map[string]*storage.VolumeHealth, error)
 	PublishVolume(ctx context.Context, volumeName string, publishInfo *utils.VolumePublishInfo) error
 	UnpublishVolume(ctx context.Context, volumeName, nodeName string) error
 	ResizeVolume(ctx context.Context, volumeName, newSize string) error
+	MoveVolume(ctx context.Context, volumeName, backendName, poolName string) (*storage.VolumeExternal, error)
+	GetOrphanReport(ctx context.Context, backendName string) (*storage.OrphanReport, error)
+	ResolveOrphans(ctx context.Context, request *storage.ResolveOrphansRequest) (*storage.OrphanReport, error)
 	SetVolumeState(ctx context.Context, volumeName string, state storage.VolumeState) error
 	ReloadVolumes(ctx context.Context) error
 
@@ -70,10 +77,34 @@ type Orchestrator interface {
 	RestoreSnapshot(ctx context.Context, volumeName, snapshotName string) error
 	DeleteSnapshot(ctx context.Context, volumeName, snapshotName string) error
 
+	CreateGroupSnapshot(
+		ctx context.Context, groupSnapshotConfig *storage.GroupSnapshotConfig,
+	) (*storage.GroupSnapshotExternal, error)
+	GetGroupSnapshot(ctx context.Context, groupSnapshotName string) (*storage.GroupSnapshotExternal, error)
+	ListGroupSnapshots(ctx context.Context) ([]*storage.GroupSnapshotExternal, error)
+	DeleteGroupSnapshot(ctx context.Context, groupSnapshotName string) error
+
 	AddStorageClass(ctx context.Context, scConfig *storageclass.Config) (*storageclass.External, error)
 	DeleteStorageClass(ctx context.Context, scName string) error
 	GetStorageClass(ctx context.Context, scName string) (*storageclass.External, error)
+	ExplainStorageClass(ctx context.Context, scName string) (*storageclass.PlacementExplanation, error)
+	ExplainVolumePlacement(
+		ctx context.Context, volumeConfig *storage.VolumeConfig,
+	) (*storageclass.PlacementExplanation, error)
 	ListStorageClasses(ctx context.Context) ([]*storageclass.External, error)
+	GetStorageCapacity(
+		ctx context.Context, scConfig *storageclass.Config, protocol config.Protocol, topology map[string]string,
+	) (*storageclass.Capacity, error)
+
+	AddSnapshotPolicy(
+		ctx context.Context, policyConfig *storage.SnapshotPolicyConfig,
+	) (*storage.SnapshotPolicyExternal, error)
+	UpdateSnapshotPolicy(
+		ctx context.Context, policyConfig *storage.SnapshotPolicyConfig,
+	) (*storage.SnapshotPolicyExternal, error)
+	GetSnapshotPolicy(ctx context.Context, policyName string) (*storage.SnapshotPolicyExternal, error)
+	ListSnapshotPolicies(ctx context.Context) ([]*storage.SnapshotPolicyExternal, error)
+	DeleteSnapshotPolicy(ctx context.Context, policyName string) error
 
 	AddNode(ctx context.Context, node *utils.Node, nodeEventCallback NodeEventCallback) error
 	UpdateNode(ctx context.Context, nodeName string, flags *utils.NodePublicationStateFlags) error
@@ -82,6 +113,8 @@ type Orchestrator interface {
 	DeleteNode(ctx context.Context, nodeName string) error
 	PeriodicallyReconcileNodeAccessOnBackends()
 	PeriodicallyReconcileBackendState(duration time.Duration)
+	PeriodicallyCheckVolumeHealth(duration time.Duration)
+	PeriodicallyRunSnapshotPolicies(duration time.Duration)
 
 	ReconcileVolumePublications(ctx context.Context, attachedLegacyVolumes []*utils.VolumePublicationExternal) error
 	GetVolumePublication(ctx context.Context, volumeName, nodeName string) (*utils.VolumePublication, error)
@@ -86,6 +86,14 @@ func makeStorageClass(ctx context.Context, options map[string]string) (*storagec
 		}
 	}
 
+	if p, ok := options[sa.PlacementStrategy]; ok {
+		if _, err := storageclass.ParsePlacementStrategy(p); err != nil {
+			return nil, err
+		}
+		scConfig.Placement = p
+		delete(options, sa.PlacementStrategy)
+	}
+
 	// Map options to storage class attributes
 	scConfig.Attributes = make(map[string]sa.Request)
 	for k, v := range options {
@@ -116,6 +124,17 @@ func makeStorageClass(ctx context.Context, options map[string]string) (*storagec
 	return scConfig, nil
 }
 
+// MakeStorageClassConfig accepts a list of volume creation options and returns a
+// matching storage class config without registering it with the orchestrator.
+// The supplied options are not modified.
+func MakeStorageClassConfig(ctx context.Context, options map[string]string) (*storageclass.Config, error) {
+	optionsCopy := make(map[string]string, len(options))
+	for k, v := range options {
+		optionsCopy[k] = v
+	}
+	return makeStorageClass(ctx, optionsCopy)
+}
+
 // GetVolumeConfig accepts a set of parameters describing a volume creation request
 // and returns a volume config structure suitable for passing to the orchestrator core.
 func GetVolumeConfig(
@@ -0,0 +1,204 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package controlplane
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/netapp/trident/config"
+	"github.com/netapp/trident/core"
+	controllerAPIv1 "github.com/netapp/trident/frontend/csi/controller_api/v1"
+	controllerhelpers "github.com/netapp/trident/frontend/csi/controller_helpers"
+	. "github.com/netapp/trident/logging"
+	"github.com/netapp/trident/utils"
+	"github.com/netapp/trident/utils/errors"
+)
+
+// autogrowInterval is the least time between automatic resizes of a volume, so that each resize shows up in
+// the nodes' usage reports before the volume is considered again.
+const autogrowInterval = 10 * time.Minute
+
+// Reasons for the events recorded on automatically grown volumes
+const (
+	autogrowSuccessReason      = "AutogrowSuccess"
+	autogrowFailedReason       = "AutogrowFailed"
+	autogrowLimitReachedReason = "AutogrowLimitReached"
+)
+
+// autogrower grows volumes as the nodes to which they are published report them filling up, as directed by
+// their autogrow policies.  Only file volumes are grown, as the filesystem on a block volume is only expanded
+// on the node when the container orchestrator asks.
+type autogrower struct {
+	orchestrator core.Orchestrator
+	interval     time.Duration
+
+	mutex    sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+func newAutogrower(orchestrator core.Orchestrator) *autogrower {
+	return &autogrower{
+		orchestrator: orchestrator,
+		interval:     autogrowInterval,
+		limiters:     make(map[string]*rate.Limiter),
+	}
+}
+
+// report grows any of the volumes in a node's usage report that have reached their autogrow thresholds.
+func (a *autogrower) report(ctx context.Context, report *controllerAPIv1.ReportVolumeUsageRequest) {
+	for _, usage := range report.Volumes {
+		if err := a.autogrow(ctx, usage); err != nil {
+			Logc(ctx).WithFields(LogFields{
+				"node":   report.Node,
+				"volume": usage.Volume,
+			}).WithError(err).Warning("Could not grow volume automatically.")
+		}
+	}
+}
+
+// autogrow grows a volume if it has reached its autogrow threshold, and has not been grown too recently.
+func (a *autogrower) autogrow(ctx context.Context, usage controllerAPIv1.VolumeUsage) error {
+	volume, err := a.orchestrator.GetVolume(ctx, usage.Volume)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			a.forget(usage.Volume)
+			return nil
+		}
+		return err
+	}
+
+	policy := volume.Config.Autogrow
+	if policy == nil || volume.Config.Protocol != config.File || volume.Config.ShareSourceVolume != "" {
+		return nil
+	}
+	if !policy.ThresholdReached(usage.UsedBytes, usage.TotalBytes) || !a.allow(usage.Volume) {
+		return nil
+	}
+
+	logFields := LogFields{
+		"volume":     usage.Volume,
+		"usedBytes":  usage.UsedBytes,
+		"totalBytes": usage.TotalBytes,
+		"threshold":  policy.ThresholdPercent,
+	}
+
+	currentSize, err := strconv.ParseUint(volume.Config.Size, 10, 64)
+	if err != nil {
+		return fmt.Errorf("could not parse size %s of volume %s; %v", volume.Config.Size, usage.Volume, err)
+	}
+	newSize, err := policy.NextSize(currentSize)
+	if err != nil {
+		return err
+	}
+	limit, err := a.getBackendVolumeSizeLimit(ctx, volume.BackendUUID)
+	if err != nil {
+		return err
+	}
+	if limit > 0 && newSize > limit {
+		newSize = limit
+	}
+
+	if newSize <= currentSize {
+		Logc(ctx).WithFields(logFields).Debug("Volume may grow no further.")
+		a.recordEvent(ctx, usage.Volume, controllerhelpers.EventTypeWarning, autogrowLimitReachedReason,
+			fmt.Sprintf("volume is %d%% full and may grow no larger than %d bytes", usedPercent(usage), currentSize))
+		return nil
+	}
+
+	Logc(ctx).WithFields(logFields).WithField("newSize", newSize).Info("Growing volume automatically.")
+
+	if err = a.resize(ctx, usage.Volume, newSize); err != nil {
+		a.recordEvent(ctx, usage.Volume, controllerhelpers.EventTypeWarning, autogrowFailedReason,
+			fmt.Sprintf("could not grow volume to %d bytes; %v", newSize, err))
+		return err
+	}
+	message := fmt.Sprintf("volume was %d%% full, so it is growing from %d to %d bytes",
+		usedPercent(usage), currentSize, newSize)
+	a.recordEvent(ctx, usage.Volume, controllerhelpers.EventTypeNormal, autogrowSuccessReason, message)
+	return nil
+}
+
+// resize grows a volume through the container orchestrator, if it tracks volume sizes, so that it shows the
+// new size and accepts later expansions from there.  Otherwise the volume is resized directly.
+func (a *autogrower) resize(ctx context.Context, volume string, newSize uint64) error {
+	if helper, err := getControllerHelper(ctx, a.orchestrator); err == nil {
+		if expander, ok := helper.(controllerhelpers.VolumeExpander); ok {
+			return expander.ExpandVolume(ctx, volume, newSize)
+		}
+	}
+	return a.orchestrator.ResizeVolume(ctx, volume, strconv.FormatUint(newSize, 10))
+}
+
+// allow returns whether a volume may be grown now, given when it was last grown.
+func (a *autogrower) allow(volume string) bool {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	limiter, ok := a.limiters[volume]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Every(a.interval), 1)
+		a.limiters[volume] = limiter
+	}
+	return limiter.Allow()
+}
+
+// forget discards the rate limit of a volume that no longer exists.
+func (a *autogrower) forget(volume string) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	delete(a.limiters, volume)
+}
+
+// getBackendVolumeSizeLimit returns the largest volume a backend may create, or zero if there is no limit.
+func (a *autogrower) getBackendVolumeSizeLimit(ctx context.Context, backendUUID string) (uint64, error) {
+	backend, err := a.orchestrator.GetBackendByBackendUUID(ctx, backendUUID)
+	if err != nil {
+		return 0, err
+	}
+
+	// Every driver's config embeds the common config, which holds the limit
+	configJSON, err := json.Marshal(backend.Config)
+	if err != nil {
+		return 0, fmt.Errorf("could not read config of backend %s; %v", backend.Name, err)
+	}
+	var commonConfig struct {
+		LimitVolumeSize string `json:"limitVolumeSize"`
+	}
+	if err = json.Unmarshal(configJSON, &commonConfig); err != nil {
+		return 0, fmt.Errorf("could not read config of backend %s; %v", backend.Name, err)
+	}
+	if commonConfig.LimitVolumeSize == "" {
+		return 0, nil
+	}
+
+	limit, err := utils.ConvertSizeToBytes(commonConfig.LimitVolumeSize)
+	if err != nil {
+		return 0, fmt.Errorf("invalid limitVolumeSize of backend %s; %v", backend.Name, err)
+	}
+	return strconv.ParseUint(limit, 10, 64)
+}
+
+// recordEvent records an event on a volume through the CSI helper, if there is one.
+func (a *autogrower) recordEvent(ctx context.Context, volume, eventType, reason, message string) {
+	helper, err := getControllerHelper(ctx, a.orchestrator)
+	if err != nil {
+		Logc(ctx).WithError(err).Debug("Could not record volume event.")
+		return
+	}
+	helper.RecordVolumeEvent(ctx, volume, eventType, reason, message)
+}
+
+// usedPercent returns the percentage of a volume's space reported used.
+func usedPercent(usage controllerAPIv1.VolumeUsage) int64 {
+	if usage.TotalBytes <= 0 {
+		return 0
+	}
+	return usage.UsedBytes * 100 / usage.TotalBytes
+}
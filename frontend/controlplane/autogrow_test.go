@@ -0,0 +1,154 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package controlplane
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netapp/trident/config"
+	controllerAPIv1 "github.com/netapp/trident/frontend/csi/controller_api/v1"
+	controllerhelpers "github.com/netapp/trident/frontend/csi/controller_helpers"
+	mockcore "github.com/netapp/trident/mocks/mock_core"
+	mockhelpers "github.com/netapp/trident/mocks/mock_frontend/mock_csi/mock_controller_helpers"
+	"github.com/netapp/trident/storage"
+	"github.com/netapp/trident/utils/errors"
+)
+
+// fakeHelperFrontend presents a mock controller helper as the Kubernetes helper frontend.
+type fakeHelperFrontend struct {
+	*mockhelpers.MockControllerHelper
+}
+
+func (fakeHelperFrontend) Activate() error   { return nil }
+func (fakeHelperFrontend) Deactivate() error { return nil }
+func (fakeHelperFrontend) GetName() string   { return controllerhelpers.KubernetesHelper }
+
+func TestAutogrower_Report(t *testing.T) {
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+	mockHelper := mockhelpers.NewMockControllerHelper(mockCtrl)
+	mockOrchestrator.EXPECT().GetFrontend(gomock.Any(), controllerhelpers.KubernetesHelper).Return(
+		fakeHelperFrontend{mockHelper}, nil).AnyTimes()
+
+	policy := &storage.AutogrowPolicy{ThresholdPercent: 80, Increment: "50%"}
+	fileVolume := func(name, size string) *storage.VolumeExternal {
+		return &storage.VolumeExternal{
+			Config: &storage.VolumeConfig{
+				Name:     name,
+				Size:     size,
+				Protocol: config.File,
+				Autogrow: policy,
+			},
+			BackendUUID: "backend-uuid",
+		}
+	}
+	backend := &storage.BackendExternal{
+		Name:   "nas",
+		Config: map[string]interface{}{"storageDriverName": "ontap-nas", "limitVolumeSize": "12Gi"},
+	}
+	report := func(volume string, used int64) *controllerAPIv1.ReportVolumeUsageRequest {
+		return &controllerAPIv1.ReportVolumeUsageRequest{
+			Node:    "node1",
+			Volumes: []controllerAPIv1.VolumeUsage{{Volume: volume, UsedBytes: used, TotalBytes: 100}},
+		}
+	}
+
+	a := newAutogrower(mockOrchestrator)
+
+	// Volumes below their threshold are left alone
+	mockOrchestrator.EXPECT().GetVolume(gomock.Any(), "vol1").Return(fileVolume("vol1", "10737418240"), nil)
+	a.report(ctx, report("vol1", 79))
+
+	// Volumes grow by their increment, but no larger than the backend allows
+	mockOrchestrator.EXPECT().GetVolume(gomock.Any(), "vol1").Return(fileVolume("vol1", "10737418240"), nil)
+	mockOrchestrator.EXPECT().GetBackendByBackendUUID(gomock.Any(), "backend-uuid").Return(backend, nil)
+	mockOrchestrator.EXPECT().ResizeVolume(gomock.Any(), "vol1", "12884901888").Return(nil)
+	mockHelper.EXPECT().RecordVolumeEvent(gomock.Any(), "vol1", controllerhelpers.EventTypeNormal,
+		autogrowSuccessReason, gomock.Any())
+	a.report(ctx, report("vol1", 80))
+
+	// Volumes are not grown again until the interval passes
+	mockOrchestrator.EXPECT().GetVolume(gomock.Any(), "vol1").Return(fileVolume("vol1", "12884901888"), nil)
+	a.report(ctx, report("vol1", 95))
+
+	// Volumes that may grow no further are flagged
+	mockOrchestrator.EXPECT().GetVolume(gomock.Any(), "vol2").Return(fileVolume("vol2", "12884901888"), nil)
+	mockOrchestrator.EXPECT().GetBackendByBackendUUID(gomock.Any(), "backend-uuid").Return(backend, nil)
+	mockHelper.EXPECT().RecordVolumeEvent(gomock.Any(), "vol2", controllerhelpers.EventTypeWarning,
+		autogrowLimitReachedReason, gomock.Any())
+	a.report(ctx, report("vol2", 90))
+
+	// Failed resizes are flagged
+	mockOrchestrator.EXPECT().GetVolume(gomock.Any(), "vol3").Return(fileVolume("vol3", "1073741824"), nil)
+	mockOrchestrator.EXPECT().GetBackendByBackendUUID(gomock.Any(), "backend-uuid").Return(backend, nil)
+	mockOrchestrator.EXPECT().ResizeVolume(gomock.Any(), "vol3", "1610612736").Return(errors.New("failed"))
+	mockHelper.EXPECT().RecordVolumeEvent(gomock.Any(), "vol3", controllerhelpers.EventTypeWarning,
+		autogrowFailedReason, gomock.Any())
+	a.report(ctx, report("vol3", 90))
+
+	// Block volumes and volumes without a policy are not grown
+	blockVolume := fileVolume("vol4", "1073741824")
+	blockVolume.Config.Protocol = config.Block
+	mockOrchestrator.EXPECT().GetVolume(gomock.Any(), "vol4").Return(blockVolume, nil)
+	a.report(ctx, report("vol4", 90))
+
+	noPolicyVolume := fileVolume("vol5", "1073741824")
+	noPolicyVolume.Config.Autogrow = nil
+	mockOrchestrator.EXPECT().GetVolume(gomock.Any(), "vol5").Return(noPolicyVolume, nil)
+	a.report(ctx, report("vol5", 90))
+
+	// Deleted volumes are forgotten
+	mockOrchestrator.EXPECT().GetVolume(gomock.Any(), "vol1").Return(nil, errors.NotFoundError("not found"))
+	a.report(ctx, report("vol1", 90))
+	assert.NotContains(t, a.limiters, "vol1")
+}
+
+// expandingHelperFrontend is a helper frontend whose container orchestrator expands volumes itself.
+type expandingHelperFrontend struct {
+	fakeHelperFrontend
+	expanded map[string]uint64
+	err      error
+}
+
+func (f expandingHelperFrontend) ExpandVolume(_ context.Context, name string, sizeBytes uint64) error {
+	f.expanded[name] = sizeBytes
+	return f.err
+}
+
+func TestAutogrower_ExpandThroughOrchestrator(t *testing.T) {
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+	mockHelper := mockhelpers.NewMockControllerHelper(mockCtrl)
+	helper := expandingHelperFrontend{fakeHelperFrontend: fakeHelperFrontend{mockHelper}, expanded: map[string]uint64{}}
+	mockOrchestrator.EXPECT().GetFrontend(gomock.Any(), controllerhelpers.KubernetesHelper).Return(
+		helper, nil).AnyTimes()
+
+	volume := &storage.VolumeExternal{
+		Config: &storage.VolumeConfig{
+			Name:     "vol1",
+			Size:     "1073741824",
+			Protocol: config.File,
+			Autogrow: &storage.AutogrowPolicy{ThresholdPercent: 80, Increment: "1Gi"},
+		},
+		BackendUUID: "backend-uuid",
+	}
+	backend := &storage.BackendExternal{Name: "nas", Config: map[string]interface{}{}}
+	report := &controllerAPIv1.ReportVolumeUsageRequest{
+		Node:    "node1",
+		Volumes: []controllerAPIv1.VolumeUsage{{Volume: "vol1", UsedBytes: 90, TotalBytes: 100}},
+	}
+
+	// Kubernetes is asked to expand the volume, so that its PV and PVC show the new size
+	mockOrchestrator.EXPECT().GetVolume(gomock.Any(), "vol1").Return(volume, nil)
+	mockOrchestrator.EXPECT().GetBackendByBackendUUID(gomock.Any(), "backend-uuid").Return(backend, nil)
+	mockHelper.EXPECT().RecordVolumeEvent(gomock.Any(), "vol1", controllerhelpers.EventTypeNormal,
+		autogrowSuccessReason, gomock.Any())
+	newAutogrower(mockOrchestrator).report(ctx, report)
+	assert.Equal(t, uint64(2147483648), helper.expanded["vol1"])
+}
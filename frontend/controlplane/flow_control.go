@@ -0,0 +1,104 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package controlplane
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	controllerAPIv1 "github.com/netapp/trident/frontend/csi/controller_api/v1"
+	. "github.com/netapp/trident/logging"
+)
+
+const (
+	// The node methods are limited as they are by the REST frontend, so that every node plugin re-registering
+	// after the controller restarts cannot overwhelm it.  Watches are established at the same time, so they are
+	// limited alike.
+	registerNodeRateLimit = 50.0    // requests per second
+	registerNodeBurst     = 100     // maximum request burst
+	watchNodeRateLimit    = 50.0    // requests per second
+	watchNodeBurst        = 100     // maximum request burst
+	updateNodeRateLimit   = 10000.0 // requests per second
+	updateNodeBurst       = 10000   // maximum request burst
+	getNodeRateLimit      = 10000.0 // requests per second
+	getNodeBurst          = 10000   // maximum request burst
+
+	logInterval = 10 * time.Second
+)
+
+// flowControl rejects calls to a method made faster than its rate limit allows, telling callers how long to
+// wait before trying again.  To avoid spamming logs, sustained rejections are logged at a rate of 1/logInterval.
+type flowControl struct {
+	limiters     map[string]*rate.Limiter
+	logSometimes rate.Sometimes
+}
+
+func newFlowControl() *flowControl {
+	return &flowControl{
+		limiters: map[string]*rate.Limiter{
+			controllerAPIv1.FullMethodName("RegisterNode"): rate.NewLimiter(registerNodeRateLimit, registerNodeBurst),
+			controllerAPIv1.FullMethodName("WatchNode"):    rate.NewLimiter(watchNodeRateLimit, watchNodeBurst),
+			controllerAPIv1.FullMethodName("UpdateNode"):   rate.NewLimiter(updateNodeRateLimit, updateNodeBurst),
+			controllerAPIv1.FullMethodName("GetNode"):      rate.NewLimiter(getNodeRateLimit, getNodeBurst),
+		},
+		logSometimes: rate.Sometimes{First: 1, Interval: logInterval},
+	}
+}
+
+// admit returns nil if a call to the method may proceed, or else a ResourceExhausted error and the trailer
+// telling the caller how long to wait.
+func (f *flowControl) admit(ctx context.Context, method string) (metadata.MD, error) {
+	limiter, ok := f.limiters[method]
+	if !ok {
+		return nil, nil
+	}
+
+	reservation := limiter.Reserve()
+	if reservation.OK() && reservation.Delay() == 0 {
+		return nil, nil
+	}
+
+	// Only this caller's turn is refused, so give back its place
+	delay := reservation.Delay()
+	reservation.Cancel()
+
+	f.logSometimes.Do(func() {
+		Logc(ctx).WithField("method", method).Warn("Too many requests")
+	})
+
+	var trailer metadata.MD
+	if reservation.OK() {
+		trailer = metadata.Pairs(controllerAPIv1.RetryAfterKey, delay.String())
+	}
+	return trailer, status.Error(codes.ResourceExhausted, "too many requests")
+}
+
+func (f *flowControl) unaryInterceptor(
+	ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if trailer, err := f.admit(ctx, info.FullMethod); err != nil {
+		if trailer != nil {
+			_ = grpc.SetTrailer(ctx, trailer)
+		}
+		return nil, err
+	}
+	return handler(ctx, request)
+}
+
+func (f *flowControl) streamInterceptor(
+	srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler,
+) error {
+	if trailer, err := f.admit(stream.Context(), info.FullMethod); err != nil {
+		if trailer != nil {
+			stream.SetTrailer(trailer)
+		}
+		return err
+	}
+	return handler(srv, stream)
+}
@@ -0,0 +1,175 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package controlplane
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	controllerAPIv1 "github.com/netapp/trident/frontend/csi/controller_api/v1"
+	. "github.com/netapp/trident/logging"
+)
+
+// freezeRequestBuffer is the number of freeze and thaw requests held for each node watch
+const freezeRequestBuffer = 8
+
+// pendingFreeze collects the reports of the nodes asked to freeze a filesystem.
+type pendingFreeze struct {
+	nodes   map[string]struct{}
+	reports chan *controllerAPIv1.ReportFreezeRequest
+}
+
+// freezeCoordinator asks node plugins to freeze and thaw volume filesystems through their node watches, and
+// collects their reports.
+type freezeCoordinator struct {
+	mutex sync.Mutex
+	// watches holds the request channels of each node's watches, oldest first
+	watches map[string][]chan *controllerAPIv1.NodeEvent
+	pending map[string]*pendingFreeze
+}
+
+func newFreezeCoordinator() *freezeCoordinator {
+	return &freezeCoordinator{
+		watches: make(map[string][]chan *controllerAPIv1.NodeEvent),
+		pending: make(map[string]*pendingFreeze),
+	}
+}
+
+// watch returns a channel delivering the freeze and thaw requests for a node, and a function to call once
+// the node's watch ends.
+func (f *freezeCoordinator) watch(nodeName string) (<-chan *controllerAPIv1.NodeEvent, func()) {
+	requests := make(chan *controllerAPIv1.NodeEvent, freezeRequestBuffer)
+
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.watches[nodeName] = append(f.watches[nodeName], requests)
+
+	return requests, func() {
+		f.mutex.Lock()
+		defer f.mutex.Unlock()
+		watches := f.watches[nodeName]
+		for i := range watches {
+			if watches[i] == requests {
+				watches = append(watches[:i], watches[i+1:]...)
+				break
+			}
+		}
+		if len(watches) == 0 {
+			delete(f.watches, nodeName)
+		} else {
+			f.watches[nodeName] = watches
+		}
+	}
+}
+
+// send queues a request on one of a node's watches, returning false if none accepted it.  A node has more
+// than one watch only while reconnecting, so the newest watch is tried first.
+func (f *freezeCoordinator) send(nodeName string, event *controllerAPIv1.NodeEvent) bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	watches := f.watches[nodeName]
+	for i := len(watches) - 1; i >= 0; i-- {
+		select {
+		case watches[i] <- event:
+			return true
+		default:
+		}
+	}
+	return false
+}
+
+// FreezeFilesystem asks each of the nodes to freeze the filesystem of a volume, and waits for them all to
+// report that they have.  Nodes thaw the filesystem by themselves once the timeout passes, so at most half
+// of it is spent waiting, leaving the rest for the work done while the filesystem is frozen.  If any node
+// cannot freeze the filesystem, it is thawed on every node and an error is returned.  Otherwise, the
+// function returned thaws it.
+func (f *freezeCoordinator) FreezeFilesystem(
+	ctx context.Context, volume string, nodes []string, timeout time.Duration,
+) (func(context.Context), error) {
+	freeze := &controllerAPIv1.FilesystemFreeze{ID: uuid.NewString(), Volume: volume, Timeout: timeout}
+	logFields := LogFields{"volume": volume, "nodes": nodes, "freezeID": freeze.ID}
+
+	pending := &pendingFreeze{
+		nodes:   make(map[string]struct{}, len(nodes)),
+		reports: make(chan *controllerAPIv1.ReportFreezeRequest, len(nodes)),
+	}
+	for _, node := range nodes {
+		pending.nodes[node] = struct{}{}
+	}
+
+	f.mutex.Lock()
+	f.pending[freeze.ID] = pending
+	f.mutex.Unlock()
+	defer func() {
+		f.mutex.Lock()
+		delete(f.pending, freeze.ID)
+		f.mutex.Unlock()
+	}()
+
+	thaw := func(ctx context.Context) {
+		for _, node := range nodes {
+			if !f.send(node, &controllerAPIv1.NodeEvent{Type: controllerAPIv1.NodeEventThaw, Freeze: freeze}) {
+				Logc(ctx).WithFields(logFields).WithField("node", node).Warning(
+					"Could not ask node to thaw filesystem; it will be thawed when the freeze times out.")
+			}
+		}
+		Logc(ctx).WithFields(logFields).Debug("Asked nodes to thaw filesystem.")
+	}
+
+	for _, node := range nodes {
+		if !f.send(node, &controllerAPIv1.NodeEvent{Type: controllerAPIv1.NodeEventFreeze, Freeze: freeze}) {
+			thaw(ctx)
+			return nil, fmt.Errorf("node %s is not watching for freeze requests", node)
+		}
+	}
+
+	Logc(ctx).WithFields(logFields).Debug("Asked nodes to freeze filesystem.")
+
+	timer := time.NewTimer(timeout / 2)
+	defer timer.Stop()
+
+	for remaining := len(nodes); remaining > 0; remaining-- {
+		select {
+		case report := <-pending.reports:
+			if report.Error != "" {
+				thaw(ctx)
+				return nil, fmt.Errorf("could not freeze filesystem of volume %s on node %s; %s",
+					volume, report.Node, report.Error)
+			}
+		case <-timer.C:
+			thaw(ctx)
+			return nil, fmt.Errorf("timed out waiting for nodes to freeze filesystem of volume %s", volume)
+		case <-ctx.Done():
+			thaw(ctx)
+			return nil, ctx.Err()
+		}
+	}
+
+	Logc(ctx).WithFields(logFields).Info("Froze filesystem.")
+
+	return thaw, nil
+}
+
+// report delivers a node's report on a freeze still awaited.
+func (f *freezeCoordinator) report(report *controllerAPIv1.ReportFreezeRequest) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	pending, ok := f.pending[report.ID]
+	if !ok {
+		return status.Errorf(codes.NotFound, "freeze %s is no longer awaited", report.ID)
+	}
+	if _, ok = pending.nodes[report.Node]; !ok {
+		return status.Errorf(codes.InvalidArgument, "node %s was not asked to freeze", report.Node)
+	}
+	delete(pending.nodes, report.Node)
+	pending.reports <- report
+	return nil
+}
@@ -0,0 +1,376 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package controlplane
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/netapp/trident/core"
+	controllerAPIv1 "github.com/netapp/trident/frontend/csi/controller_api/v1"
+	controllerhelpers "github.com/netapp/trident/frontend/csi/controller_helpers"
+	k8shelper "github.com/netapp/trident/frontend/csi/controller_helpers/kubernetes"
+	. "github.com/netapp/trident/logging"
+	"github.com/netapp/trident/utils"
+	"github.com/netapp/trident/utils/errors"
+)
+
+// AddNode registers a node with the orchestrator, after labelling it with its topology as known to the
+// container orchestrator.  It is shared by the REST and gRPC frontends.
+func AddNode(ctx context.Context, orchestrator core.Orchestrator, node *utils.Node) error {
+	helper, err := getControllerHelper(ctx, orchestrator)
+	if err != nil {
+		return err
+	}
+	topologyLabels, err := helper.GetNodeTopologyLabels(ctx, node.Name)
+	if err != nil {
+		return err
+	}
+	node.TopologyLabels = topologyLabels
+	Logc(ctx).WithField("node", node.Name).Info("Determined topology labels for node: ", topologyLabels)
+
+	nodeEventCallback := func(eventType, reason, message string) {
+		helper.RecordNodeEvent(ctx, node.Name, eventType, reason, message)
+	}
+
+	return orchestrator.AddNode(ctx, node, nodeEventCallback)
+}
+
+// getControllerHelper returns the CSI helper frontend, whether for Kubernetes or plain CSI.
+func getControllerHelper(
+	ctx context.Context, orchestrator core.Orchestrator,
+) (controllerhelpers.ControllerHelper, error) {
+	csiFrontend, err := orchestrator.GetFrontend(ctx, controllerhelpers.KubernetesHelper)
+	if err != nil {
+		csiFrontend, err = orchestrator.GetFrontend(ctx, controllerhelpers.PlainCSIHelper)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not get CSI helper frontend")
+	}
+
+	helper, ok := csiFrontend.(controllerhelpers.ControllerHelper)
+	if !ok {
+		return nil, fmt.Errorf("could not get CSI hybrid frontend")
+	}
+	return helper, nil
+}
+
+// UpdateNodePublicationState updates a node's publication state flags.  Flags not set by the caller are taken
+// from the node's just-in-time state in Kubernetes.  It is shared by the REST and gRPC frontends.
+func UpdateNodePublicationState(
+	ctx context.Context, orchestrator core.Orchestrator, nodeName string, nodeState *utils.NodePublicationStateFlags,
+) error {
+	k8sFrontend, err := orchestrator.GetFrontend(ctx, controllerhelpers.KubernetesHelper)
+	if err != nil {
+		return err
+	}
+	k8s, ok := k8sFrontend.(k8shelper.K8SControllerHelperPlugin)
+	if !ok {
+		return fmt.Errorf("unable to obtain Kubernetes frontend")
+	}
+
+	k8sNodePublicationState, err := k8s.GetNodePublicationState(ctx, nodeName)
+	if err != nil {
+		return err
+	}
+
+	// If the nodePublicationState came from another actor (like tridentctl, or trident node pod)
+	// Then we should use those values. If those values are set, these values will be used. Otherwise,
+	// we should use the k8sNodePublication state.
+	if k8sNodePublicationState != nil {
+		if nodeState.OrchestratorReady == nil && k8sNodePublicationState.OrchestratorReady != nil {
+			nodeState.OrchestratorReady = k8sNodePublicationState.OrchestratorReady
+		}
+		if nodeState.AdministratorReady == nil && k8sNodePublicationState.AdministratorReady != nil {
+			nodeState.AdministratorReady = k8sNodePublicationState.AdministratorReady
+		}
+	}
+
+	return orchestrator.UpdateNode(ctx, nodeName, nodeState)
+}
+
+// nodeControlServer implements version 1 of the node control service.
+type nodeControlServer struct {
+	orchestrator core.Orchestrator
+	freezes      *freezeCoordinator
+	autogrower   *autogrower
+}
+
+func newNodeControlServer(orchestrator core.Orchestrator) *nodeControlServer {
+	return &nodeControlServer{
+		orchestrator: orchestrator,
+		freezes:      newFreezeCoordinator(),
+		autogrower:   newAutogrower(orchestrator),
+	}
+}
+
+func (s *nodeControlServer) RegisterNode(
+	ctx context.Context, request *controllerAPIv1.RegisterNodeRequest,
+) (*controllerAPIv1.RegisterNodeResponse, error) {
+	ctx = GenerateRequestContext(ctx, "", "", WorkflowNodeCreate, LogLayerControlPlaneFrontend)
+
+	node := request.Node
+	if node == nil || node.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "node not specified")
+	}
+	if err := AddNode(ctx, s.orchestrator, node); err != nil {
+		return nil, statusFromError(err)
+	}
+
+	Logc(ctx).WithField("node", node.Name).Info("Added a new node.")
+
+	return &controllerAPIv1.RegisterNodeResponse{
+		TopologyLabels: node.TopologyLabels,
+		LoggingConfig: controllerAPIv1.LoggingConfig{
+			LogLevel:     node.LogLevel,
+			LogWorkflows: node.LogWorkflows,
+			LogLayers:    node.LogLayers,
+		},
+	}, nil
+}
+
+func (s *nodeControlServer) GetNode(
+	ctx context.Context, request *controllerAPIv1.NodeRequest,
+) (*controllerAPIv1.GetNodeResponse, error) {
+	ctx = GenerateRequestContext(ctx, "", "", WorkflowNodeGet, LogLayerControlPlaneFrontend)
+
+	node, err := s.orchestrator.GetNode(ctx, request.Name)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+	return &controllerAPIv1.GetNodeResponse{Node: node}, nil
+}
+
+// UpdateNode updates a node's publication state.  Unlike its REST counterpart, it completes the update before
+// returning, as flow control rather than the caller's patience protects the controller.
+func (s *nodeControlServer) UpdateNode(
+	ctx context.Context, request *controllerAPIv1.UpdateNodeRequest,
+) (*controllerAPIv1.Empty, error) {
+	ctx = GenerateRequestContext(ctx, "", "", WorkflowNodeUpdate, LogLayerControlPlaneFrontend)
+
+	if request.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "node name not specified")
+	}
+	if request.State == nil {
+		request.State = &utils.NodePublicationStateFlags{}
+	}
+	if err := UpdateNodePublicationState(ctx, s.orchestrator, request.Name, request.State); err != nil {
+		return nil, statusFromError(err)
+	}
+
+	Logc(ctx).WithField("node", request.Name).Info("Updated a node.")
+
+	return &controllerAPIv1.Empty{}, nil
+}
+
+func (s *nodeControlServer) GetCHAP(
+	ctx context.Context, request *controllerAPIv1.GetCHAPRequest,
+) (*controllerAPIv1.GetCHAPResponse, error) {
+	ctx = GenerateRequestContext(ctx, "", "", WorkflowVolumeGet, LogLayerControlPlaneFrontend)
+
+	chapInfo, err := s.orchestrator.GetCHAP(ctx, request.Volume, request.Node)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+	return &controllerAPIv1.GetCHAPResponse{CHAP: chapInfo}, nil
+}
+
+func (s *nodeControlServer) ListVolumePublicationsForNode(
+	ctx context.Context, request *controllerAPIv1.NodeRequest,
+) (*controllerAPIv1.ListVolumePublicationsResponse, error) {
+	ctx = GenerateRequestContext(ctx, "", "", WorkflowNodeGet, LogLayerControlPlaneFrontend)
+
+	publications, err := s.orchestrator.ListVolumePublicationsForNode(ctx, request.Name)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+	return &controllerAPIv1.ListVolumePublicationsResponse{VolumePublications: publications}, nil
+}
+
+func (s *nodeControlServer) UpdateVolumeLUKSPassphraseNames(
+	ctx context.Context, request *controllerAPIv1.UpdateVolumeLUKSPassphraseNamesRequest,
+) (*controllerAPIv1.Empty, error) {
+	ctx = GenerateRequestContext(ctx, "", "", WorkflowVolumeUpdate, LogLayerControlPlaneFrontend)
+
+	passphraseNames := request.PassphraseNames
+	if passphraseNames == nil {
+		passphraseNames = []string{}
+	}
+	if err := s.orchestrator.UpdateVolumeLUKSPassphraseNames(ctx, request.Volume, &passphraseNames); err != nil {
+		return nil, statusFromError(err)
+	}
+	return &controllerAPIv1.Empty{}, nil
+}
+
+func (s *nodeControlServer) GetLoggingConfig(
+	ctx context.Context, _ *controllerAPIv1.Empty,
+) (*controllerAPIv1.LoggingConfig, error) {
+	ctx = GenerateRequestContext(ctx, "", "", WorkflowTridentRESTLogger, LogLayerControlPlaneFrontend)
+
+	logLevel, err := s.orchestrator.GetLogLevel(ctx)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+	logWorkflows, err := s.orchestrator.GetSelectedLoggingWorkflows(ctx)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+	logLayers, err := s.orchestrator.GetSelectedLogLayers(ctx)
+	if err != nil {
+		return nil, statusFromError(err)
+	}
+
+	return &controllerAPIv1.LoggingConfig{
+		LogLevel:     logLevel,
+		LogWorkflows: logWorkflows,
+		LogLayers:    logLayers,
+	}, nil
+}
+
+// WatchNode streams changes to a node and its volume publications, along with any requests to freeze or
+// thaw the filesystems of its volumes.  A watch beginning afresh first receives the node's current state.  The stream ends with codes.Aborted if the caller falls too far behind, in which
+// case it may resume from the last event received, or with codes.OutOfRange if the events it asked to resume
+// from are no longer held, in which case it must begin afresh.
+func (s *nodeControlServer) WatchNode(
+	request *controllerAPIv1.WatchNodeRequest, stream controllerAPIv1.WatchNodeServer,
+) error {
+	ctx := GenerateRequestContext(stream.Context(), "", "", WorkflowNodeGet, LogLayerControlPlaneFrontend)
+
+	if request.Name == "" {
+		return status.Error(codes.InvalidArgument, "node name not specified")
+	}
+
+	subscription, err := s.orchestrator.SubscribeEvents(ctx, request.Since)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return status.Error(codes.OutOfRange, err.Error())
+		}
+		return statusFromError(err)
+	}
+	defer subscription.Close()
+
+	if request.Since == 0 {
+		node, err := s.orchestrator.GetNode(ctx, request.Name)
+		if err != nil && !errors.IsNotFoundError(err) {
+			return statusFromError(err)
+		}
+		if err = stream.Send(&controllerAPIv1.NodeEvent{Type: controllerAPIv1.NodeEventSync, Node: node}); err != nil {
+			return err
+		}
+	}
+
+	freezeRequests, unwatch := s.freezes.watch(request.Name)
+	defer unwatch()
+
+	Logc(ctx).WithField("node", request.Name).Debug("Watching node.")
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case freezeRequest := <-freezeRequests:
+			if err = stream.Send(freezeRequest); err != nil {
+				return err
+			}
+		case event, ok := <-subscription.Events:
+			if !ok {
+				return status.Error(codes.Aborted, "node watch fell behind")
+			}
+			if nodeEvent := nodeEventFor(request.Name, event); nodeEvent != nil {
+				if err = stream.Send(nodeEvent); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// ReportFreeze receives a node's report on whether it froze the filesystem it was asked to freeze.
+func (s *nodeControlServer) ReportFreeze(
+	ctx context.Context, request *controllerAPIv1.ReportFreezeRequest,
+) (*controllerAPIv1.Empty, error) {
+	ctx = GenerateRequestContext(ctx, "", "", WorkflowSnapshotCreate, LogLayerControlPlaneFrontend)
+
+	Logc(ctx).WithFields(LogFields{
+		"node":     request.Node,
+		"volume":   request.Volume,
+		"freezeID": request.ID,
+		"error":    request.Error,
+	}).Debug("Node reported on filesystem freeze.")
+
+	if err := s.freezes.report(request); err != nil {
+		return nil, err
+	}
+	return &controllerAPIv1.Empty{}, nil
+}
+
+// ReportVolumeUsage receives the space used on the volumes published to a node, and grows any that have reached
+// their autogrow thresholds.  Volumes are grown in the background, so that the node need not wait on resizes.
+func (s *nodeControlServer) ReportVolumeUsage(
+	ctx context.Context, request *controllerAPIv1.ReportVolumeUsageRequest,
+) (*controllerAPIv1.Empty, error) {
+	ctx = GenerateRequestContext(ctx, "", "", WorkflowVolumeResize, LogLayerControlPlaneFrontend)
+
+	if request.Node == "" {
+		return nil, status.Error(codes.InvalidArgument, "node not specified")
+	}
+	Logc(ctx).WithFields(LogFields{
+		"node":    request.Node,
+		"volumes": len(request.Volumes),
+	}).Trace("Node reported volume usage.")
+
+	growCtx := GenerateRequestContext(context.Background(), "", ContextSourceInternal, WorkflowVolumeResize,
+		LogLayerControlPlaneFrontend)
+	go s.autogrower.report(growCtx, request)
+
+	return &controllerAPIv1.Empty{}, nil
+}
+
+// nodeEventFor returns the node event for an orchestrator event concerning the named node, or nil.
+func nodeEventFor(nodeName string, event *core.Event) *controllerAPIv1.NodeEvent {
+	nodeEvent := &controllerAPIv1.NodeEvent{
+		Sequence: event.Sequence,
+		Type:     controllerAPIv1.NodeEventType(event.Type),
+	}
+
+	switch event.Kind {
+	case core.EventKindNode:
+		node, ok := event.Object.(*utils.NodeExternal)
+		if !ok || event.Name != nodeName {
+			return nil
+		}
+		nodeEvent.Node = node
+	case core.EventKindVolumePublication:
+		publication, ok := event.Object.(*utils.VolumePublicationExternal)
+		if !ok || publication.NodeName != nodeName {
+			return nil
+		}
+		nodeEvent.VolumePublication = publication
+	default:
+		return nil
+	}
+	return nodeEvent
+}
+
+// statusFromError returns the gRPC status for an orchestrator error.
+func statusFromError(err error) error {
+	var code codes.Code
+	switch {
+	case errors.IsNotFoundError(err):
+		code = codes.NotFound
+	case errors.IsNotReadyError(err), errors.IsBootstrapError(err):
+		code = codes.Unavailable
+	case errors.IsInvalidInputError(err):
+		code = codes.InvalidArgument
+	case errors.IsUnsupportedError(err):
+		code = codes.Unimplemented
+	case errors.IsTooManyRequestsError(err):
+		code = codes.ResourceExhausted
+	default:
+		code = codes.Internal
+	}
+	return status.Error(code, err.Error())
+}
@@ -0,0 +1,197 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package controlplane
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/netapp/trident/config"
+	"github.com/netapp/trident/core"
+	"github.com/netapp/trident/frontend"
+	"github.com/netapp/trident/frontend/csi"
+	controllerAPIv1 "github.com/netapp/trident/frontend/csi/controller_api/v1"
+	controllerhelpers "github.com/netapp/trident/frontend/csi/controller_helpers"
+	. "github.com/netapp/trident/logging"
+)
+
+const (
+	// maxConcurrentStreams is the number of calls and watches each node plugin connection may have open
+	maxConcurrentStreams = 100
+	// keepaliveMinTime is the most often node plugins may ping an idle connection
+	keepaliveMinTime = 10 * time.Second
+	// keepaliveTime is how long a connection may be idle before the server pings it
+	keepaliveTime = 2 * time.Minute
+	// keepaliveTimeout is how long the server waits for a ping to be answered before closing the connection
+	keepaliveTimeout = 20 * time.Second
+)
+
+// Server is the gRPC control-plane frontend, through which node plugins register their nodes and learn of
+// changes to them, and through which the controller asks them to freeze filesystems.  Callers must present
+// the Trident client certificate.
+type Server struct {
+	server  *grpc.Server
+	service *nodeControlServer
+	address string
+}
+
+var (
+	_ frontend.Plugin       = &Server{}
+	_ csi.FilesystemFreezer = &Server{}
+)
+
+// NewServer returns a gRPC control-plane server.
+func NewServer(
+	orchestrator core.Orchestrator, address, port, caCertFile, serverCertFile, serverKeyFile string,
+) (*Server, error) {
+	tlsConfig := &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		MinVersion: config.MinServerTLSVersion,
+	}
+
+	cert, err := tls.LoadX509KeyPair(serverCertFile, serverKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("could not load server certificate: %v", err)
+	}
+	tlsConfig.Certificates = []tls.Certificate{cert}
+
+	if caCertFile != "" {
+		caCert, err := os.ReadFile(caCertFile)
+		if err != nil {
+			return nil, fmt.Errorf("could not read CA certificate file: %v", err)
+		}
+		caCertPool := x509.NewCertPool()
+		caCertPool.AppendCertsFromPEM(caCert)
+		tlsConfig.ClientCAs = caCertPool
+	}
+
+	s := &Server{
+		address: fmt.Sprintf("%s:%s", address, port),
+		service: newNodeControlServer(orchestrator),
+	}
+	s.server = newGRPCServer(s.service, newFlowControl(),
+		grpc.Creds(credentials.NewTLS(tlsConfig)),
+		grpc.ChainUnaryInterceptor(authorizeUnary),
+		grpc.ChainStreamInterceptor(authorizeStream),
+	)
+
+	Log().WithField("address", s.address).Info("Initializing gRPC control-plane frontend.")
+
+	return s, nil
+}
+
+// newGRPCServer returns a gRPC server offering the node control service with flow control, after any
+// options specified.
+func newGRPCServer(service *nodeControlServer, limits *flowControl, opts ...grpc.ServerOption) *grpc.Server {
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(limits.unaryInterceptor),
+		grpc.ChainStreamInterceptor(limits.streamInterceptor),
+		grpc.MaxConcurrentStreams(maxConcurrentStreams),
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             keepaliveMinTime,
+			PermitWithoutStream: true,
+		}),
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			Time:    keepaliveTime,
+			Timeout: keepaliveTimeout,
+		}),
+	)
+
+	server := grpc.NewServer(opts...)
+	controllerAPIv1.RegisterNodeControlServer(server, service)
+	return server
+}
+
+func (s *Server) Activate() error {
+	listener, err := net.Listen("tcp", s.address)
+	if err != nil {
+		return fmt.Errorf("could not listen on %s; %v", s.address, err)
+	}
+
+	go func() {
+		Log().WithField("address", s.address).Info("Activating gRPC control-plane frontend.")
+
+		if err := s.server.Serve(listener); err != nil && err != grpc.ErrServerStopped {
+			Log().Fatal(err)
+		}
+		Log().WithField("address", s.address).Info("gRPC control-plane frontend server has closed.")
+	}()
+	return nil
+}
+
+// Deactivate stops the server, waiting for calls in progress unless they take too long.  Watches never end
+// by themselves, so they are cut off.
+func (s *Server) Deactivate() error {
+	Log().WithField("address", s.address).Info("Deactivating gRPC control-plane frontend.")
+
+	stopped := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-time.After(config.HTTPTimeout):
+		s.server.Stop()
+	}
+	return nil
+}
+
+func (s *Server) GetName() string {
+	return controllerhelpers.ControlPlaneFrontend
+}
+
+func (s *Server) Version() string {
+	return controllerAPIv1.ServiceName
+}
+
+// FreezeFilesystem asks node plugins to freeze the filesystem of a volume, returning a function that thaws it.
+func (s *Server) FreezeFilesystem(
+	ctx context.Context, volume string, nodes []string, timeout time.Duration,
+) (func(context.Context), error) {
+	return s.service.freezes.FreezeFilesystem(ctx, volume, nodes, timeout)
+}
+
+// authorize admits callers presenting the Trident client certificate.
+func authorize(ctx context.Context) error {
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			certs := tlsInfo.State.PeerCertificates
+			if len(certs) > 0 && certs[0].Subject.CommonName == config.ClientCertName {
+				return nil
+			}
+		}
+	}
+	return status.Error(codes.Unauthenticated, "the Trident client certificate is required")
+}
+
+func authorizeUnary(
+	ctx context.Context, request interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if err := authorize(ctx); err != nil {
+		return nil, err
+	}
+	return handler(ctx, request)
+}
+
+func authorizeStream(
+	srv interface{}, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler,
+) error {
+	if err := authorize(stream.Context()); err != nil {
+		return err
+	}
+	return handler(srv, stream)
+}
@@ -0,0 +1,301 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package controlplane
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/netapp/trident/config"
+	"github.com/netapp/trident/core"
+	controllerAPIv1 "github.com/netapp/trident/frontend/csi/controller_api/v1"
+	mockcore "github.com/netapp/trident/mocks/mock_core"
+	"github.com/netapp/trident/utils"
+	"github.com/netapp/trident/utils/errors"
+)
+
+// startTestServer serves the node control service over an in-memory connection and returns a client of it.
+func startTestServer(
+	t *testing.T, service *nodeControlServer, limits *flowControl,
+) controllerAPIv1.NodeControlClient {
+	listener := bufconn.Listen(1024 * 1024)
+	server := newGRPCServer(service, limits)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return controllerAPIv1.NewNodeControlClient(conn)
+}
+
+func TestNodeControl_GetNode(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+	client := startTestServer(t, newNodeControlServer(mockOrchestrator), newFlowControl())
+
+	node := &utils.NodeExternal{Name: "node1", PublicationState: utils.NodeDirty}
+	mockOrchestrator.EXPECT().GetNode(gomock.Any(), "node1").Return(node, nil)
+	response, err := client.GetNode(context.Background(), &controllerAPIv1.NodeRequest{Name: "node1"})
+	assert.NoError(t, err)
+	assert.Equal(t, node, response.Node)
+
+	mockOrchestrator.EXPECT().GetNode(gomock.Any(), "node2").Return(nil, errors.NotFoundError("not found"))
+	_, err = client.GetNode(context.Background(), &controllerAPIv1.NodeRequest{Name: "node2"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	mockOrchestrator.EXPECT().GetNode(gomock.Any(), "node3").Return(nil, errors.NotReadyError())
+	_, err = client.GetNode(context.Background(), &controllerAPIv1.NodeRequest{Name: "node3"})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestNodeControl_UpdateVolumeLUKSPassphraseNames(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+	client := startTestServer(t, newNodeControlServer(mockOrchestrator), newFlowControl())
+
+	mockOrchestrator.EXPECT().UpdateVolumeLUKSPassphraseNames(gomock.Any(), "vol1", &[]string{"A", "B"}).Return(nil)
+	_, err := client.UpdateVolumeLUKSPassphraseNames(context.Background(),
+		&controllerAPIv1.UpdateVolumeLUKSPassphraseNamesRequest{Volume: "vol1", PassphraseNames: []string{"A", "B"}})
+	assert.NoError(t, err)
+}
+
+func TestNodeControl_GetLoggingConfig(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+	client := startTestServer(t, newNodeControlServer(mockOrchestrator), newFlowControl())
+
+	mockOrchestrator.EXPECT().GetLogLevel(gomock.Any()).Return("debug", nil)
+	mockOrchestrator.EXPECT().GetSelectedLoggingWorkflows(gomock.Any()).Return("node=all", nil)
+	mockOrchestrator.EXPECT().GetSelectedLogLayers(gomock.Any()).Return("csi_frontend", nil)
+	loggingConfig, err := client.GetLoggingConfig(context.Background(), &controllerAPIv1.Empty{})
+	assert.NoError(t, err)
+	assert.Equal(t, &controllerAPIv1.LoggingConfig{
+		LogLevel:     "debug",
+		LogWorkflows: "node=all",
+		LogLayers:    "csi_frontend",
+	}, loggingConfig)
+}
+
+func TestNodeControl_FlowControl(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+	limits := newFlowControl()
+	limits.limiters[controllerAPIv1.FullMethodName("RegisterNode")] = rate.NewLimiter(rate.Every(time.Minute), 1)
+	client := startTestServer(t, newNodeControlServer(mockOrchestrator), limits)
+
+	// The first call is admitted, though it fails for want of a node
+	_, err := client.RegisterNode(context.Background(), &controllerAPIv1.RegisterNodeRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// The next is turned away, and told when to try again
+	var trailer metadata.MD
+	_, err = client.RegisterNode(context.Background(), &controllerAPIv1.RegisterNodeRequest{}, grpc.Trailer(&trailer))
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	if assert.Len(t, trailer.Get(controllerAPIv1.RetryAfterKey), 1) {
+		retryAfter, err := time.ParseDuration(trailer.Get(controllerAPIv1.RetryAfterKey)[0])
+		assert.NoError(t, err)
+		assert.Greater(t, retryAfter, 50*time.Second)
+	}
+
+	// Other methods are unaffected
+	mockOrchestrator.EXPECT().GetNode(gomock.Any(), "node1").Return(&utils.NodeExternal{Name: "node1"}, nil)
+	_, err = client.GetNode(context.Background(), &controllerAPIv1.NodeRequest{Name: "node1"})
+	assert.NoError(t, err)
+}
+
+func TestNodeControl_WatchNode(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+	client := startTestServer(t, newNodeControlServer(mockOrchestrator), newFlowControl())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events := make(chan *core.Event, 4)
+	closed := make(chan struct{})
+	subscription := core.NewEventSubscription(events, func() { close(closed) })
+
+	node := &utils.NodeExternal{Name: "node1", PublicationState: utils.NodeClean}
+	mockOrchestrator.EXPECT().SubscribeEvents(gomock.Any(), uint64(0)).Return(subscription, nil)
+	mockOrchestrator.EXPECT().GetNode(gomock.Any(), "node1").Return(node, nil)
+
+	stream, err := client.WatchNode(ctx, &controllerAPIv1.WatchNodeRequest{Name: "node1"})
+	assert.NoError(t, err)
+
+	// A new watch begins with the node's current state
+	event, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, &controllerAPIv1.NodeEvent{Type: controllerAPIv1.NodeEventSync, Node: node}, event)
+
+	// Only changes to the node and its publications follow
+	dirtyNode := &utils.NodeExternal{Name: "node1", PublicationState: utils.NodeDirty}
+	publication := &utils.VolumePublicationExternal{Name: "vol1.node1", VolumeName: "vol1", NodeName: "node1"}
+	events <- &core.Event{Sequence: 1, Type: core.EventUpdated, Kind: core.EventKindNode, Name: "node2",
+		Object: &utils.NodeExternal{Name: "node2"}}
+	events <- &core.Event{Sequence: 2, Type: core.EventUpdated, Kind: core.EventKindNode, Name: "node1",
+		Object: dirtyNode}
+	events <- &core.Event{Sequence: 3, Type: core.EventAdded, Kind: core.EventKindVolume, Name: "vol1"}
+	events <- &core.Event{Sequence: 4, Type: core.EventDeleted, Kind: core.EventKindVolumePublication,
+		Name: publication.Name, Object: publication}
+
+	event, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, &controllerAPIv1.NodeEvent{Sequence: 2, Type: controllerAPIv1.NodeEventUpdated, Node: dirtyNode},
+		event)
+	event, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, &controllerAPIv1.NodeEvent{
+		Sequence: 4, Type: controllerAPIv1.NodeEventDeleted, VolumePublication: publication,
+	}, event)
+
+	// A watch that falls behind is ended so that it may be resumed
+	close(events)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.Aborted, status.Code(err))
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "subscription was not closed")
+	}
+
+	// Watches may not be resumed from events no longer held
+	mockOrchestrator.EXPECT().SubscribeEvents(gomock.Any(), uint64(4)).Return(nil,
+		errors.NotFoundError("events after 4 are no longer available"))
+	stream, err = client.WatchNode(ctx, &controllerAPIv1.WatchNodeRequest{Name: "node1", Since: 4})
+	assert.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.OutOfRange, status.Code(err))
+}
+
+func TestNodeControl_FreezeFilesystem(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+	service := newNodeControlServer(mockOrchestrator)
+	client := startTestServer(t, service, newFlowControl())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Nodes not watching cannot be asked to freeze
+	_, err := service.freezes.FreezeFilesystem(ctx, "vol1", []string{"node1"}, time.Minute)
+	assert.ErrorContains(t, err, "node node1 is not watching")
+
+	subscription := core.NewEventSubscription(make(chan *core.Event), func() {})
+	mockOrchestrator.EXPECT().SubscribeEvents(gomock.Any(), uint64(0)).Return(subscription, nil)
+	mockOrchestrator.EXPECT().GetNode(gomock.Any(), "node1").Return(&utils.NodeExternal{Name: "node1"}, nil)
+
+	stream, err := client.WatchNode(ctx, &controllerAPIv1.WatchNodeRequest{Name: "node1"})
+	assert.NoError(t, err)
+	_, err = stream.Recv()
+	assert.NoError(t, err)
+
+	// freeze asks node1 to freeze vol1 and reports as the node plugin would
+	freeze := func(reportErr string) (func(context.Context), error) {
+		type result struct {
+			thaw func(context.Context)
+			err  error
+		}
+		results := make(chan result, 1)
+		go func() {
+			thaw, err := service.freezes.FreezeFilesystem(ctx, "vol1", []string{"node1"}, time.Minute)
+			results <- result{thaw, err}
+		}()
+
+		event, err := stream.Recv()
+		if !assert.NoError(t, err) || !assert.NotNil(t, event.Freeze) {
+			t.FailNow()
+		}
+		assert.Equal(t, controllerAPIv1.NodeEventFreeze, event.Type)
+		assert.Equal(t, uint64(0), event.Sequence)
+		assert.Equal(t, "vol1", event.Freeze.Volume)
+		assert.Equal(t, time.Minute, event.Freeze.Timeout)
+
+		_, err = client.ReportFreeze(ctx, &controllerAPIv1.ReportFreezeRequest{
+			ID: event.Freeze.ID, Node: "node1", Volume: "vol1", Error: reportErr,
+		})
+		assert.NoError(t, err)
+
+		r := <-results
+		return r.thaw, r.err
+	}
+
+	thaw, err := freeze("")
+	assert.NoError(t, err)
+	thaw(ctx)
+	event, err := stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, controllerAPIv1.NodeEventThaw, event.Type)
+
+	// A node that cannot freeze the filesystem is asked to thaw it all the same
+	_, err = freeze("not mounted")
+	assert.ErrorContains(t, err, "not mounted")
+	event, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, controllerAPIv1.NodeEventThaw, event.Type)
+
+	// Reports on freezes no longer awaited are refused
+	_, err = client.ReportFreeze(ctx, &controllerAPIv1.ReportFreezeRequest{ID: "1", Node: "node1", Volume: "vol1"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestFreezeCoordinator_OneWatchPerNode(t *testing.T) {
+	freezes := newFreezeCoordinator()
+
+	// While a node reconnects it has two watches, but each request goes to only one of them
+	oldWatch, endOldWatch := freezes.watch("node1")
+	newWatch, endNewWatch := freezes.watch("node1")
+
+	event := &controllerAPIv1.NodeEvent{Type: controllerAPIv1.NodeEventFreeze}
+	assert.True(t, freezes.send("node1", event))
+	assert.Len(t, newWatch, 1)
+	assert.Len(t, oldWatch, 0)
+
+	// Once the new watch ends, the remaining one receives the requests
+	endNewWatch()
+	assert.True(t, freezes.send("node1", event))
+	assert.Len(t, oldWatch, 1)
+
+	endOldWatch()
+	assert.False(t, freezes.send("node1", event))
+	assert.Empty(t, freezes.watches)
+}
+
+func TestAuthorize(t *testing.T) {
+	peerWithCertificate := func(commonName string) context.Context {
+		return peer.NewContext(context.Background(), &peer.Peer{
+			AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{
+				PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: commonName}}},
+			}},
+		})
+	}
+
+	assert.NoError(t, authorize(peerWithCertificate(config.ClientCertName)))
+	assert.Equal(t, codes.Unauthenticated, status.Code(authorize(peerWithCertificate("someone-else"))))
+	assert.Equal(t, codes.Unauthenticated, status.Code(authorize(context.Background())))
+}
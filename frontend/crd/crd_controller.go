@@ -53,6 +53,8 @@ const (
 	ObjectTypeTridentActionMirrorUpdate    string = "TridentActionMirrorUpdate"
 	ObjectTypeTridentSnapshotInfo          string = "TridentSnapshotInfo"
 	ObjectTypeTridentActionSnapshotRestore string = "TridentActionSnapshotRestore"
+	ObjectTypeTridentActionVolumeMove      string = "TridentActionVolumeMove"
+	ObjectTypeTridentSnapshotPolicy        string = "TridentSnapshotPolicy"
 
 	OperationStatusSuccess string = "Success"
 	OperationStatusFailed  string = "Failed"
@@ -61,7 +63,8 @@ const (
 	controllerAgentName    = "trident-crd-controller"
 	crdControllerQueueName = "trident-crd-workqueue"
 
-	transactionSyncPeriod = 60 * time.Second
+	transactionSyncPeriod    = 60 * time.Second
+	snapshotPolicySyncPeriod = 60 * time.Second
 )
 
 type KeyItem struct {
@@ -102,6 +105,8 @@ type TridentCrdController struct {
 	txnInformerFactory tridentinformers.SharedInformerFactory
 	txnInformer        tridentinformersv1.Interface
 
+	policyInformerFactory tridentinformers.SharedInformerFactory
+
 	kubeInformerFactory goinformer.SharedInformerFactory
 	kubeInformer        goinformerv1.Interface
 
@@ -153,6 +158,14 @@ type TridentCrdController struct {
 	snapshotsLister listers.TridentSnapshotLister
 	snapshotsSynced cache.InformerSynced
 
+	// TridentGroupSnapshot CRD handling
+	groupSnapshotsLister listers.TridentGroupSnapshotLister
+	groupSnapshotsSynced cache.InformerSynced
+
+	// TridentSnapshotPolicyState CRD handling
+	snapshotPolicyStatesLister listers.TridentSnapshotPolicyStateLister
+	snapshotPolicyStatesSynced cache.InformerSynced
+
 	// Secret handling
 	secretsLister v1.SecretLister
 	secretsSynced cache.InformerSynced
@@ -161,6 +174,14 @@ type TridentCrdController struct {
 	actionSnapshotRestoreLister listers.TridentActionSnapshotRestoreLister
 	actionSnapshotRestoreSynced cache.InformerSynced
 
+	// TridentActionVolumeMove CRD handling
+	actionVolumeMoveLister listers.TridentActionVolumeMoveLister
+	actionVolumeMoveSynced cache.InformerSynced
+
+	// TridentSnapshotPolicy CRD handling
+	snapshotPolicyLister listers.TridentSnapshotPolicyLister
+	snapshotPolicySynced cache.InformerSynced
+
 	// workqueue is a rate limited work queue. This is used to queue work to be
 	// processed instead of performing it as soon as a change happens. This
 	// means we can ensure we only process a fixed amount of resources at a
@@ -209,6 +230,10 @@ func newTridentCrdControllerImpl(
 	txnInformerFactory := tridentinformers.NewSharedInformerFactory(crdClientset, transactionSyncPeriod)
 	txnInformer := tridentinformersv1.New(txnInformerFactory, tridentNamespace, nil)
 
+	// Set resync to 60 seconds so that snapshot policies pick up new PVCs and report the latest run
+	policyInformerFactory := tridentinformers.NewSharedInformerFactory(crdClientset, snapshotPolicySyncPeriod)
+	policyInformer := tridentinformersv1.New(policyInformerFactory, corev1.NamespaceAll, nil)
+
 	// Set resync to 0 sec so that reconciliation is on demand
 	kubeInformerFactory := goinformer.NewSharedInformerFactory(kubeClientset, time.Second*0)
 	kubeInformer := goinformerv1.New(kubeInformerFactory, tridentNamespace, nil)
@@ -225,8 +250,12 @@ func newTridentCrdControllerImpl(
 	volumeInformer := crdInformer.TridentVolumes()
 	volumePublicationInformer := crdInformer.TridentVolumePublications()
 	snapshotInformer := crdInformer.TridentSnapshots()
+	groupSnapshotInformer := crdInformer.TridentGroupSnapshots()
+	snapshotPolicyStateInformer := crdInformer.TridentSnapshotPolicyStates()
 	secretInformer := kubeInformer.Secrets()
 	actionSnapshotRestoreInformer := allNSCrdInformer.TridentActionSnapshotRestores()
+	actionVolumeMoveInformer := allNSCrdInformer.TridentActionVolumeMoves()
+	snapshotPolicyInformer := policyInformer.TridentSnapshotPolicies()
 
 	// Create event broadcaster
 	// Add our types to the default Kubernetes Scheme so Events can be logged.
@@ -246,6 +275,7 @@ func newTridentCrdControllerImpl(
 		crdInformer:                 crdInformer,
 		txnInformerFactory:          txnInformerFactory,
 		txnInformer:                 txnInformer,
+		policyInformerFactory:       policyInformerFactory,
 		kubeInformerFactory:         kubeInformerFactory,
 		kubeInformer:                kubeInformer,
 		backendsLister:              backendInformer.Lister(),
@@ -272,10 +302,18 @@ func newTridentCrdControllerImpl(
 		volumePublicationsSynced:    volumePublicationInformer.Informer().HasSynced,
 		snapshotsLister:             snapshotInformer.Lister(),
 		snapshotsSynced:             snapshotInformer.Informer().HasSynced,
+		groupSnapshotsLister:        groupSnapshotInformer.Lister(),
+		groupSnapshotsSynced:        groupSnapshotInformer.Informer().HasSynced,
+		snapshotPolicyStatesLister:  snapshotPolicyStateInformer.Lister(),
+		snapshotPolicyStatesSynced:  snapshotPolicyStateInformer.Informer().HasSynced,
 		secretsLister:               secretInformer.Lister(),
 		secretsSynced:               secretInformer.Informer().HasSynced,
 		actionSnapshotRestoreLister: actionSnapshotRestoreInformer.Lister(),
 		actionSnapshotRestoreSynced: actionSnapshotRestoreInformer.Informer().HasSynced,
+		actionVolumeMoveLister:      actionVolumeMoveInformer.Lister(),
+		actionVolumeMoveSynced:      actionVolumeMoveInformer.Informer().HasSynced,
+		snapshotPolicyLister:        snapshotPolicyInformer.Lister(),
+		snapshotPolicySynced:        snapshotPolicyInformer.Informer().HasSynced,
 		workqueue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(),
 			crdControllerQueueName),
 		recorder: recorder,
@@ -318,6 +356,16 @@ func newTridentCrdControllerImpl(
 		AddFunc: controller.addCRHandler,
 	})
 
+	_, _ = actionVolumeMoveInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: controller.addCRHandler,
+	})
+
+	_, _ = snapshotPolicyInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    controller.addCRHandler,
+		UpdateFunc: controller.updateSnapshotPolicyHandler,
+		DeleteFunc: controller.deleteCRHandler,
+	})
+
 	_, _ = secretInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		// Do not handle AddFunc here otherwise everytime trident is restarted,
 		// there will be unwarranted reconciles and backend initializations
@@ -335,6 +383,8 @@ func newTridentCrdControllerImpl(
 		volumeInformer.Informer(),
 		volumePublicationInformer.Informer(),
 		snapshotInformer.Informer(),
+		groupSnapshotInformer.Informer(),
+		snapshotPolicyStateInformer.Informer(),
 		transactionInformer.Informer(),
 	}
 	for _, informer := range informers {
@@ -357,6 +407,7 @@ func (c *TridentCrdController) Activate() error {
 	if c.crdControllerStopChan != nil {
 		c.crdInformerFactory.Start(c.crdControllerStopChan)
 		c.txnInformerFactory.Start(c.crdControllerStopChan)
+		c.policyInformerFactory.Start(c.crdControllerStopChan)
 		c.kubeInformerFactory.Start(c.crdControllerStopChan)
 		go c.Run(ctx, 1, c.crdControllerStopChan)
 	}
@@ -408,6 +459,8 @@ func (c *TridentCrdController) Run(ctx context.Context, threadiness int, stopCh
 		c.volumePublicationsSynced,
 		c.mirrorSynced,
 		c.snapshotsSynced,
+		c.groupSnapshotsSynced,
+		c.snapshotPolicyStatesSynced,
 		c.snapshotInfoSynced,
 		c.secretsSynced); !ok {
 		waitErr := fmt.Errorf("failed to wait for caches to sync")
@@ -610,6 +663,10 @@ func (c *TridentCrdController) processNextWorkItem() bool {
 			handleFunction = c.handleTridentSnapshotInfo
 		case ObjectTypeTridentActionSnapshotRestore:
 			handleFunction = c.handleActionSnapshotRestore
+		case ObjectTypeTridentActionVolumeMove:
+			handleFunction = c.handleActionVolumeMove
+		case ObjectTypeTridentSnapshotPolicy:
+			handleFunction = c.handleTridentSnapshotPolicy
 		default:
 			return fmt.Errorf("unknown objectType in the workqueue: %v", keyItem.objectType)
 		}
@@ -744,6 +801,14 @@ func (c *TridentCrdController) removeFinalizers(ctx context.Context, obj interfa
 		if force || !crd.ObjectMeta.DeletionTimestamp.IsZero() {
 			return c.removeSnapshotFinalizers(ctx, crd)
 		}
+	case *tridentv1.TridentGroupSnapshot:
+		if force || !crd.ObjectMeta.DeletionTimestamp.IsZero() {
+			return c.removeGroupSnapshotFinalizers(ctx, crd)
+		}
+	case *tridentv1.TridentSnapshotPolicyState:
+		if force || !crd.ObjectMeta.DeletionTimestamp.IsZero() {
+			return c.removeSnapshotPolicyStateFinalizers(ctx, crd)
+		}
 	case *tridentv1.TridentMirrorRelationship:
 		if force || !crd.ObjectMeta.DeletionTimestamp.IsZero() {
 			return c.removeTMRFinalizers(ctx, crd)
@@ -752,6 +817,10 @@ func (c *TridentCrdController) removeFinalizers(ctx context.Context, obj interfa
 		if force || !crd.ObjectMeta.DeletionTimestamp.IsZero() {
 			return c.removeTSIFinalizers(ctx, crd)
 		}
+	case *tridentv1.TridentSnapshotPolicy:
+		if force || !crd.ObjectMeta.DeletionTimestamp.IsZero() {
+			return c.removeSnapshotPolicyFinalizers(ctx, crd)
+		}
 	default:
 		Logx(ctx).Warnf("unexpected type %T", crd)
 		return fmt.Errorf("unexpected type %T", crd)
@@ -954,6 +1023,58 @@ func (c *TridentCrdController) removeSnapshotFinalizers(
 	return
 }
 
+// removeGroupSnapshotFinalizers removes Trident's finalizers from TridentGroupSnapshot CRs
+func (c *TridentCrdController) removeGroupSnapshotFinalizers(
+	ctx context.Context, groupSnap *tridentv1.TridentGroupSnapshot,
+) (err error) {
+	Logx(ctx).WithFields(LogFields{
+		"groupSnap.ResourceVersion":              groupSnap.ResourceVersion,
+		"groupSnap.ObjectMeta.DeletionTimestamp": groupSnap.ObjectMeta.DeletionTimestamp,
+	}).Trace("removeGroupSnapshotFinalizers")
+
+	if groupSnap.HasTridentFinalizers() {
+		Logx(ctx).Trace("Has finalizers, removing them.")
+		groupSnapCopy := groupSnap.DeepCopy()
+		groupSnapCopy.RemoveTridentFinalizers()
+		_, err = c.crdClientset.TridentV1().TridentGroupSnapshots(groupSnap.Namespace).Update(ctx, groupSnapCopy,
+			updateOpts)
+		if err != nil {
+			Logx(ctx).Errorf("Problem removing finalizers: %v", err)
+			return
+		}
+	} else {
+		Logx(ctx).Trace("No finalizers to remove.")
+	}
+
+	return
+}
+
+// removeSnapshotPolicyStateFinalizers removes Trident's finalizers from TridentSnapshotPolicyState CRs
+func (c *TridentCrdController) removeSnapshotPolicyStateFinalizers(
+	ctx context.Context, policyState *tridentv1.TridentSnapshotPolicyState,
+) (err error) {
+	Logx(ctx).WithFields(LogFields{
+		"policyState.ResourceVersion":              policyState.ResourceVersion,
+		"policyState.ObjectMeta.DeletionTimestamp": policyState.ObjectMeta.DeletionTimestamp,
+	}).Trace("removeSnapshotPolicyStateFinalizers")
+
+	if policyState.HasTridentFinalizers() {
+		Logx(ctx).Trace("Has finalizers, removing them.")
+		policyStateCopy := policyState.DeepCopy()
+		policyStateCopy.RemoveTridentFinalizers()
+		_, err = c.crdClientset.TridentV1().TridentSnapshotPolicyStates(policyState.Namespace).Update(ctx,
+			policyStateCopy, updateOpts)
+		if err != nil {
+			Logx(ctx).Errorf("Problem removing finalizers: %v", err)
+			return
+		}
+	} else {
+		Logx(ctx).Trace("No finalizers to remove.")
+	}
+
+	return
+}
+
 // removeTMRFinalizers removes Trident's finalizers from TridentMirrorRelationship CRs
 func (c *TridentCrdController) removeTMRFinalizers(
 	ctx context.Context, tmr *tridentv1.TridentMirrorRelationship,
@@ -1003,3 +1124,29 @@ func (c *TridentCrdController) removeTSIFinalizers(
 
 	return
 }
+
+// removeSnapshotPolicyFinalizers removes Trident's finalizers from TridentSnapshotPolicy CRs
+func (c *TridentCrdController) removeSnapshotPolicyFinalizers(
+	ctx context.Context, policy *tridentv1.TridentSnapshotPolicy,
+) (err error) {
+	Logx(ctx).WithFields(LogFields{
+		"policy.ResourceVersion":              policy.ResourceVersion,
+		"policy.ObjectMeta.DeletionTimestamp": policy.ObjectMeta.DeletionTimestamp,
+	}).Trace("removeSnapshotPolicyFinalizers")
+
+	if policy.HasTridentFinalizers() {
+		Logx(ctx).Trace("Has finalizers, removing them.")
+		policyCopy := policy.DeepCopy()
+		policyCopy.RemoveTridentFinalizers()
+		_, err = c.crdClientset.TridentV1().TridentSnapshotPolicies(policy.Namespace).Update(ctx, policyCopy,
+			updateOpts)
+		if err != nil {
+			Logx(ctx).Errorf("Problem removing finalizers: %v", err)
+			return
+		}
+	} else {
+		Logx(ctx).Trace("No finalizers to remove.")
+	}
+
+	return
+}
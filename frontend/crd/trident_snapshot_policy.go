@@ -0,0 +1,242 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package crd
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	k8sapierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/netapp/trident/config"
+	. "github.com/netapp/trident/logging"
+	netappv1 "github.com/netapp/trident/persistent_store/crd/apis/netapp/v1"
+	"github.com/netapp/trident/storage"
+	"github.com/netapp/trident/utils"
+	"github.com/netapp/trident/utils/errors"
+)
+
+// updateSnapshotPolicyHandler is the update handler for TridentSnapshotPolicy watchers.  Unlike other CRs, a
+// snapshot policy is also reconciled on every informer resync, so that PVCs created since the last pass are
+// picked up and the last-run status reported by the core is copied to the CR.  Updates to the status alone
+// are ignored.
+func (c *TridentCrdController) updateSnapshotPolicyHandler(old, new interface{}) {
+	ctx := GenerateRequestContext(nil, "", ContextSourceCRD, WorkflowCRReconcile, LogLayerCRDFrontend)
+	ctx = context.WithValue(ctx, CRDControllerEvent, string(EventUpdate))
+
+	oldPolicy, ok := old.(*netappv1.TridentSnapshotPolicy)
+	if !ok {
+		Logx(ctx).Errorf("Incorrect type (%T) provided to updateSnapshotPolicyHandler, cannot process old CR", old)
+		return
+	}
+	newPolicy, ok := new.(*netappv1.TridentSnapshotPolicy)
+	if !ok {
+		Logx(ctx).Errorf("Incorrect type (%T) provided to updateSnapshotPolicyHandler, cannot process new CR", new)
+		return
+	}
+
+	isResync := newPolicy.ResourceVersion == oldPolicy.ResourceVersion
+	if !isResync && newPolicy.Generation == oldPolicy.Generation && newPolicy.DeletionTimestamp.IsZero() {
+		Logx(ctx).WithField("name", newPolicy.Name).Trace("No required update for TridentSnapshotPolicy.")
+		return
+	}
+
+	key, err := cache.MetaNamespaceKeyFunc(new)
+	if err != nil {
+		Logx(ctx).Error(err)
+		return
+	}
+
+	c.addEventToWorkqueue(key, EventUpdate, ctx, newPolicy.GetKind())
+}
+
+// handleTridentSnapshotPolicy registers a TridentSnapshotPolicy, along with the Trident volumes bound to the
+// PVCs it selects, with the core, and reports the core's last-run status for each volume on the CR.
+func (c *TridentCrdController) handleTridentSnapshotPolicy(keyItem *KeyItem) error {
+	key := keyItem.key
+	ctx := keyItem.ctx
+
+	// Convert the namespace/name string into a distinct namespace and name
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		Logx(ctx).WithField("key", key).Error("Invalid key.")
+		return nil
+	}
+
+	policy, err := c.snapshotPolicyLister.TridentSnapshotPolicies(namespace).Get(name)
+	if err != nil {
+		// The resource may no longer exist, in which case ensure the core has forgotten it as well.
+		if k8sapierrors.IsNotFound(err) {
+			Logx(ctx).WithField("key", key).Debug("Object in work queue no longer exists.")
+			return c.deleteCoreSnapshotPolicy(ctx, (&netappv1.TridentSnapshotPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			}).CoreName())
+		}
+		return err
+	}
+
+	policyCopy := policy.DeepCopy()
+	if !policyCopy.ObjectMeta.DeletionTimestamp.IsZero() {
+		Logx(ctx).WithField("TridentSnapshotPolicy", key).Debug("Snapshot policy is being deleted.")
+		if err = c.deleteCoreSnapshotPolicy(ctx, policyCopy.CoreName()); err != nil {
+			return err
+		}
+		return c.removeFinalizers(ctx, policyCopy, false)
+	}
+
+	if !policyCopy.HasTridentFinalizers() {
+		Logx(ctx).WithField("TridentSnapshotPolicy", key).Trace("Adding finalizer.")
+		policyCopy.AddTridentFinalizers()
+
+		if policyCopy, err = c.crdClientset.TridentV1().TridentSnapshotPolicies(namespace).Update(ctx,
+			policyCopy, updateOpts); err != nil {
+			return fmt.Errorf("error setting finalizer; %v", err)
+		}
+	}
+
+	status := netappv1.TridentSnapshotPolicyStatus{ObservedGeneration: int(policyCopy.Generation)}
+
+	pvcNames, err := c.getVolumesForSnapshotPolicy(ctx, policyCopy)
+	if err != nil {
+		return errors.WrapWithReconcileDeferredError(err, "reconcile deferred")
+	}
+
+	policyConfig := &storage.SnapshotPolicyConfig{
+		Version:         config.OrchestratorAPIVersion,
+		Name:            policyCopy.CoreName(),
+		Schedule:        policyCopy.Spec.Schedule,
+		RetentionCount:  policyCopy.Spec.RetentionCount,
+		RetentionMaxAge: policyCopy.Spec.RetentionMaxAge,
+		VolumeNames:     make([]string, 0, len(pvcNames)),
+	}
+	for volumeName := range pvcNames {
+		policyConfig.VolumeNames = append(policyConfig.VolumeNames, volumeName)
+	}
+	sort.Strings(policyConfig.VolumeNames)
+
+	var policyExternal *storage.SnapshotPolicyExternal
+	if err = c.validateSnapshotPolicySpec(policyCopy); err == nil {
+		err = policyConfig.Validate()
+	}
+	if err != nil {
+		Logx(ctx).WithField("TridentSnapshotPolicy", key).WithError(err).Warn(
+			"Invalid TridentSnapshotPolicy provided.")
+		c.recorder.Eventf(policyCopy, corev1.EventTypeWarning, netappv1.SnapshotPolicyInvalid, err.Error())
+		status.Message = err.Error()
+
+		// Stop running a policy whose spec is no longer valid
+		if err = c.deleteCoreSnapshotPolicy(ctx, policyConfig.Name); err != nil {
+			return err
+		}
+	} else {
+		policyExternal, err = c.orchestrator.UpdateSnapshotPolicy(ctx, policyConfig)
+		if errors.IsNotFoundError(err) {
+			policyExternal, err = c.orchestrator.AddSnapshotPolicy(ctx, policyConfig)
+		}
+		if err != nil {
+			if errors.IsNotReadyError(err) {
+				return errors.WrapWithReconcileDeferredError(err, "reconcile deferred")
+			}
+			c.recorder.Eventf(policyCopy, corev1.EventTypeWarning, netappv1.SnapshotPolicyUpdateFailed, err.Error())
+			status.Message = err.Error()
+		}
+	}
+
+	if policyExternal != nil {
+		status.NextRunTime = policyExternal.NextRunTime
+		status.Volumes = make([]netappv1.TridentSnapshotPolicyVolumeStatus, 0, len(pvcNames))
+		for _, volumeName := range policyConfig.VolumeNames {
+			volumeStatus := netappv1.TridentSnapshotPolicyVolumeStatus{
+				PVCName:    pvcNames[volumeName],
+				VolumeName: volumeName,
+			}
+			if coreStatus, ok := policyExternal.Volumes[volumeName]; ok {
+				volumeStatus.LastRunTime = coreStatus.LastRunTime
+				volumeStatus.LastSnapshotName = coreStatus.LastSnapshotName
+				volumeStatus.RetainedSnapshots = coreStatus.RetainedSnapshots
+				volumeStatus.LastError = coreStatus.LastError
+			}
+			status.Volumes = append(status.Volumes, volumeStatus)
+		}
+		sort.Slice(status.Volumes, func(i, j int) bool {
+			return status.Volumes[i].PVCName < status.Volumes[j].PVCName
+		})
+	}
+
+	// Only write the status if it changed, as every resync would otherwise update the CR
+	if reflect.DeepEqual(status, policyCopy.Status) {
+		return nil
+	}
+	policyCopy.Status = status
+	if _, err = c.crdClientset.TridentV1().TridentSnapshotPolicies(namespace).UpdateStatus(ctx, policyCopy,
+		updateOpts); err != nil {
+		err = fmt.Errorf("could not update TridentSnapshotPolicy status; %v", err)
+		Logx(ctx).Error(err)
+		return err
+	}
+	return nil
+}
+
+// validateSnapshotPolicySpec checks the parts of a TridentSnapshotPolicy spec that the core does not see.
+func (c *TridentCrdController) validateSnapshotPolicySpec(policy *netappv1.TridentSnapshotPolicy) error {
+	if policy.Spec.PVCSelector == nil && len(policy.Spec.StorageClasses) == 0 {
+		return fmt.Errorf("snapshot policy must specify a PVC selector, storage classes, or both")
+	}
+	if policy.Spec.PVCSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(policy.Spec.PVCSelector); err != nil {
+			return fmt.Errorf("invalid PVC selector; %v", err)
+		}
+	}
+	return nil
+}
+
+// getVolumesForSnapshotPolicy returns the names of the volumes bound to the PVCs in the policy's namespace that
+// match both the policy's PVC selector and its storage classes, where specified, mapped to the PVC names.
+func (c *TridentCrdController) getVolumesForSnapshotPolicy(
+	ctx context.Context, policy *netappv1.TridentSnapshotPolicy,
+) (map[string]string, error) {
+	volumes := make(map[string]string)
+
+	if c.validateSnapshotPolicySpec(policy) != nil {
+		return volumes, nil
+	}
+
+	selector := labels.Everything()
+	if policy.Spec.PVCSelector != nil {
+		selector, _ = metav1.LabelSelectorAsSelector(policy.Spec.PVCSelector)
+	}
+
+	pvcs, err := c.kubeClientset.CoreV1().PersistentVolumeClaims(policy.Namespace).List(ctx,
+		metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pvc := range pvcs.Items {
+		if pvc.Status.Phase != corev1.ClaimBound || pvc.Spec.VolumeName == "" {
+			continue
+		}
+		if len(policy.Spec.StorageClasses) > 0 {
+			if pvc.Spec.StorageClassName == nil ||
+				!utils.SliceContainsString(policy.Spec.StorageClasses, *pvc.Spec.StorageClassName) {
+				continue
+			}
+		}
+		volumes[pvc.Spec.VolumeName] = pvc.Name
+	}
+	return volumes, nil
+}
+
+// deleteCoreSnapshotPolicy removes a snapshot policy from the core, if it is registered there.
+func (c *TridentCrdController) deleteCoreSnapshotPolicy(ctx context.Context, policyName string) error {
+	if err := c.orchestrator.DeleteSnapshotPolicy(ctx, policyName); err != nil && !errors.IsNotFoundError(err) {
+		return errors.WrapWithReconcileDeferredError(err, "reconcile deferred")
+	}
+	return nil
+}
@@ -0,0 +1,260 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package crd
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mockcore "github.com/netapp/trident/mocks/mock_core"
+	netappv1 "github.com/netapp/trident/persistent_store/crd/apis/netapp/v1"
+	"github.com/netapp/trident/storage"
+	"github.com/netapp/trident/utils/errors"
+)
+
+const (
+	tsp1          = "tsp1"
+	tspCoreName   = namespace1 + "_" + tsp1
+	tspPVC1       = "pvc1"
+	tspPV1        = "pv1"
+	tspPVC2       = "pvc2"
+	tspPV2        = "pv2"
+	tspGold       = "gold"
+	tspSilver     = "silver"
+	tspLabelKey   = "backup"
+	tspLabelValue = "nightly"
+)
+
+func fakeTSP(name, namespace string, selector *metav1.LabelSelector, storageClasses ...string) *netappv1.TridentSnapshotPolicy {
+	return &netappv1.TridentSnapshotPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "trident.netapp.io/v1",
+			Kind:       "TridentSnapshotPolicy",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			Namespace:  namespace,
+			Generation: 1,
+		},
+		Spec: netappv1.TridentSnapshotPolicySpec{
+			Schedule:        "@hourly",
+			RetentionCount:  24,
+			RetentionMaxAge: "72h",
+			PVCSelector:     selector,
+			StorageClasses:  storageClasses,
+		},
+	}
+}
+
+func fakeTSPPVC(pvcName, pvName, storageClass string, labels map[string]string) *v1.PersistentVolumeClaim {
+	pvc := fakeSnapRestorePVC(pvcName, namespace1, pvName)
+	pvc.Labels = labels
+	pvc.Spec.StorageClassName = &storageClass
+	return pvc
+}
+
+func waitForTSPStatus(
+	t *testing.T, crdController *TridentCrdController, done func(*netappv1.TridentSnapshotPolicy) bool,
+) *netappv1.TridentSnapshotPolicy {
+	var tsp *netappv1.TridentSnapshotPolicy
+	var err error
+
+	for i := 0; i < 20; i++ {
+		time.Sleep(250 * time.Millisecond)
+
+		tsp, err = crdController.crdClientset.TridentV1().TridentSnapshotPolicies(namespace1).Get(ctx(), tsp1, getOpts)
+		if err == nil && done(tsp) {
+			break
+		}
+	}
+
+	assert.NoError(t, err, "err should be nil")
+	return tsp
+}
+
+func TestHandleTridentSnapshotPolicy(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	orchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+
+	kubeClient := GetTestKubernetesClientset()
+	crdClient := GetTestCrdClientset()
+	crdController, err := newTridentCrdControllerImpl(orchestrator, "trident", kubeClient,
+		GetTestSnapshotClientset(), crdClient)
+	if err != nil {
+		t.Fatalf("cannot create Trident CRD controller frontend; %v", err)
+	}
+
+	// Only the labeled PVC in the gold storage class is selected
+	labels := map[string]string{tspLabelKey: tspLabelValue}
+	_, _ = kubeClient.CoreV1().PersistentVolumeClaims(namespace1).Create(ctx(),
+		fakeTSPPVC(tspPVC1, tspPV1, tspGold, labels), createOpts)
+	_, _ = kubeClient.CoreV1().PersistentVolumeClaims(namespace1).Create(ctx(),
+		fakeTSPPVC(tspPVC2, tspPV2, tspSilver, labels), createOpts)
+	_, _ = kubeClient.CoreV1().PersistentVolumeClaims(namespace1).Create(ctx(),
+		fakeTSPPVC("pvc3", "pv3", tspGold, nil), createOpts)
+
+	expectedConfig := &storage.SnapshotPolicyConfig{
+		Version:         "1",
+		Name:            tspCoreName,
+		Schedule:        "@hourly",
+		RetentionCount:  24,
+		RetentionMaxAge: "72h",
+		VolumeNames:     []string{tspPV1},
+	}
+	policyExternal := &storage.SnapshotPolicyExternal{
+		Config:      expectedConfig,
+		NextRunTime: "2024-10-16T13:00:00Z",
+		Volumes: map[string]*storage.SnapshotPolicyVolumeStatus{
+			tspPV1: {
+				LastRunTime:       "2024-10-16T12:00:00Z",
+				LastSnapshotName:  tspCoreName + "-20241016T120000Z",
+				RetainedSnapshots: 12,
+			},
+		},
+	}
+
+	orchestrator.EXPECT().UpdateSnapshotPolicy(gomock.Any(), expectedConfig).
+		Return(nil, errors.NotFoundError("not found")).Times(1)
+	orchestrator.EXPECT().AddSnapshotPolicy(gomock.Any(), expectedConfig).Return(policyExternal, nil).Times(1)
+	orchestrator.EXPECT().UpdateSnapshotPolicy(gomock.Any(), expectedConfig).Return(policyExternal, nil).AnyTimes()
+
+	if err = crdController.Activate(); err != nil {
+		t.Fatalf("error while activating; %v", err)
+	}
+	time.Sleep(250 * time.Millisecond)
+
+	tsp := fakeTSP(tsp1, namespace1, &metav1.LabelSelector{MatchLabels: labels}, tspGold)
+	_, _ = crdClient.TridentV1().TridentSnapshotPolicies(namespace1).Create(ctx(), tsp, createOpts)
+
+	tsp = waitForTSPStatus(t, crdController, func(tsp *netappv1.TridentSnapshotPolicy) bool {
+		return len(tsp.Status.Volumes) > 0
+	})
+
+	assert.True(t, tsp.HasTridentFinalizers(), "Finalizers should have been added")
+	assert.Equal(t, "2024-10-16T13:00:00Z", tsp.Status.NextRunTime)
+	assert.Empty(t, tsp.Status.Message)
+	if assert.Len(t, tsp.Status.Volumes, 1) {
+		assert.Equal(t, netappv1.TridentSnapshotPolicyVolumeStatus{
+			PVCName:           tspPVC1,
+			VolumeName:        tspPV1,
+			LastRunTime:       "2024-10-16T12:00:00Z",
+			LastSnapshotName:  tspCoreName + "-20241016T120000Z",
+			RetainedSnapshots: 12,
+		}, tsp.Status.Volumes[0])
+	}
+
+	// Deleting the CR removes the policy from the core, both before and after the finalizers are removed
+	deleted := make(chan struct{})
+	var deleteOnce sync.Once
+	orchestrator.EXPECT().DeleteSnapshotPolicy(gomock.Any(), tspCoreName).DoAndReturn(
+		func(_ interface{}, _ string) error {
+			deleteOnce.Do(func() { close(deleted) })
+			return nil
+		}).MinTimes(1)
+	_ = crdClient.TridentV1().TridentSnapshotPolicies(namespace1).Delete(ctx(), tsp1, metav1.DeleteOptions{})
+
+	select {
+	case <-deleted:
+	case <-time.After(5 * time.Second):
+		t.Error("Snapshot policy was not deleted from the core")
+	}
+}
+
+func TestHandleTridentSnapshotPolicy_Invalid(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	orchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+
+	crdClient := GetTestCrdClientset()
+	crdController, err := newTridentCrdControllerImpl(orchestrator, "trident", GetTestKubernetesClientset(),
+		GetTestSnapshotClientset(), crdClient)
+	if err != nil {
+		t.Fatalf("cannot create Trident CRD controller frontend; %v", err)
+	}
+
+	// An invalid policy is not run, and any earlier version of it is removed from the core
+	orchestrator.EXPECT().DeleteSnapshotPolicy(gomock.Any(), tspCoreName).
+		Return(errors.NotFoundError("not found")).MinTimes(1)
+
+	if err = crdController.Activate(); err != nil {
+		t.Fatalf("error while activating; %v", err)
+	}
+	time.Sleep(250 * time.Millisecond)
+
+	tsp := fakeTSP(tsp1, namespace1, nil, tspGold)
+	tsp.Spec.Schedule = "0 25 * * *"
+	_, _ = crdClient.TridentV1().TridentSnapshotPolicies(namespace1).Create(ctx(), tsp, createOpts)
+
+	tsp = waitForTSPStatus(t, crdController, func(tsp *netappv1.TridentSnapshotPolicy) bool {
+		return tsp.Status.Message != ""
+	})
+	assert.Contains(t, tsp.Status.Message, "invalid schedule")
+	assert.Empty(t, tsp.Status.Volumes)
+}
+
+func TestGetVolumesForSnapshotPolicy(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	orchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+
+	kubeClient := GetTestKubernetesClientset()
+	crdController, err := newTridentCrdControllerImpl(orchestrator, "trident", kubeClient,
+		GetTestSnapshotClientset(), GetTestCrdClientset())
+	if err != nil {
+		t.Fatalf("cannot create Trident CRD controller frontend; %v", err)
+	}
+
+	labels := map[string]string{tspLabelKey: tspLabelValue}
+	_, _ = kubeClient.CoreV1().PersistentVolumeClaims(namespace1).Create(ctx(),
+		fakeTSPPVC(tspPVC1, tspPV1, tspGold, labels), createOpts)
+	_, _ = kubeClient.CoreV1().PersistentVolumeClaims(namespace1).Create(ctx(),
+		fakeTSPPVC(tspPVC2, tspPV2, tspSilver, nil), createOpts)
+
+	// An unbound PVC is never selected
+	unbound := fakeTSPPVC("pvc3", "", tspGold, labels)
+	unbound.Status.Phase = v1.ClaimPending
+	_, _ = kubeClient.CoreV1().PersistentVolumeClaims(namespace1).Create(ctx(), unbound, createOpts)
+
+	tests := []struct {
+		name     string
+		tsp      *netappv1.TridentSnapshotPolicy
+		expected map[string]string
+	}{
+		{
+			"selector",
+			fakeTSP(tsp1, namespace1, &metav1.LabelSelector{MatchLabels: labels}),
+			map[string]string{tspPV1: tspPVC1},
+		},
+		{
+			"storage classes",
+			fakeTSP(tsp1, namespace1, nil, tspGold, tspSilver),
+			map[string]string{tspPV1: tspPVC1, tspPV2: tspPVC2},
+		},
+		{
+			"selector and storage class",
+			fakeTSP(tsp1, namespace1, &metav1.LabelSelector{MatchLabels: labels}, tspSilver),
+			map[string]string{},
+		},
+		{
+			"other namespace",
+			fakeTSP(tsp1, "other", nil, tspGold),
+			map[string]string{},
+		},
+		{
+			"no selection",
+			fakeTSP(tsp1, namespace1, nil),
+			map[string]string{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			volumes, err := crdController.getVolumesForSnapshotPolicy(ctx(), test.tsp)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, volumes)
+		})
+	}
+}
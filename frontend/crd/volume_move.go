@@ -0,0 +1,220 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package crd
+
+import (
+	"context"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	. "github.com/netapp/trident/logging"
+	netappv1 "github.com/netapp/trident/persistent_store/crd/apis/netapp/v1"
+	"github.com/netapp/trident/utils/errors"
+)
+
+func (c *TridentCrdController) handleActionVolumeMove(keyItem *KeyItem) (moveError error) {
+	Logc(keyItem.ctx).Debug(">>>> TridentCrdController#handleActionVolumeMove")
+	defer Logc(keyItem.ctx).Debug("<<<< TridentCrdController#handleActionVolumeMove")
+
+	key := keyItem.key
+	ctx := keyItem.ctx
+
+	// This one-shot action runs on Add and does not need Update or Delete
+	if keyItem.event != EventAdd {
+		return nil
+	}
+
+	// Convert the namespace/name string into a distinct namespace and name.  If this fails, no
+	// retry is likely to succeed, so return the error to forget this action.  We can't determine
+	// the CR, so no CR update is possible.
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		Logc(ctx).WithField("key", key).Error("Invalid key.")
+		return err
+	}
+
+	// Ensure the CR is new and valid.  This may return ReconcileDeferred if it should be retried.
+	// Any other error returned here indicates a problem with the CR (i.e. it's not new or has been
+	// deleted), so no CR update is needed.
+	actionCR, err := c.validateActionVolumeMoveCR(ctx, namespace, name)
+	if err != nil {
+		return err
+	}
+
+	// Now that all prechecks that don't require a CR update are done, we can add a deferred
+	// function that updates the CR based on any additional errors encountered.
+	defer func() {
+		// If we want to be retried, we won't update the CR.
+		if errors.IsReconcileDeferredError(moveError) {
+			return
+		}
+
+		// Update CR with finalizer removal and success/failed status
+		if err = c.updateActionVolumeMoveCRComplete(ctx, namespace, name, moveError); err != nil {
+			Logc(ctx).WithField("key", key).WithError(err).Error(
+				"Could not update volume move action CR with final result.")
+		}
+	}()
+
+	// Unlike a snapshot restore, a volume move is tracked by a persistent transaction in the orchestrator,
+	// so a CR found in progress at startup is simply resumed by requesting the same move again.
+	if actionCR.InProgress() && !keyItem.isRetry {
+		Logc(ctx).WithField("key", key).Info("Resuming in-progress volume move action.")
+	}
+
+	// Get the PV corresponding to the PVC.  This is a quick pre-check, so we do it before setting
+	// the CR to in-progress.  Any error here will update the CR with a failed status and the
+	// operation will not be retried.
+	tridentVolume, moveError := c.getKubernetesObjectsForActionVolumeMove(ctx, actionCR)
+	if moveError != nil {
+		return
+	}
+
+	// Update CR with finalizers and in-progress status
+	if !actionCR.InProgress() {
+		if moveError = c.updateActionVolumeMoveCRInProgress(ctx, namespace, name); moveError != nil {
+			return
+		}
+	}
+
+	// Invoke volume move, which returns InProgress until the move completes
+	_, moveError = c.orchestrator.MoveVolume(ctx, tridentVolume, actionCR.Spec.DestinationBackend,
+		actionCR.Spec.DestinationPool)
+	if errors.IsInProgressError(moveError) {
+		moveError = errors.WrapWithReconcileDeferredError(moveError, "reconcile deferred")
+	}
+
+	return
+}
+
+func (c *TridentCrdController) validateActionVolumeMoveCR(
+	ctx context.Context, namespace, name string,
+) (*netappv1.TridentActionVolumeMove, error) {
+	// Get the resource with this namespace/name
+	actionCR, err := c.crdClientset.TridentV1().TridentActionVolumeMoves(namespace).Get(ctx, name, getOpts)
+	if apierrors.IsNotFound(err) {
+		Logc(ctx).Debug("Volume move action in work queue no longer exists.")
+		return nil, err
+	}
+	if err != nil {
+		return nil, errors.WrapWithReconcileDeferredError(err, "reconcile deferred")
+	}
+
+	if !actionCR.IsNew() && !actionCR.InProgress() {
+		return nil, fmt.Errorf("volume move action %s/%s is not new or in progress", namespace, name)
+	}
+
+	return actionCR, nil
+}
+
+func (c *TridentCrdController) updateActionVolumeMoveCRInProgress(
+	ctx context.Context, namespace, name string,
+) error {
+	// Get the resource with this namespace/name
+	actionCR, err := c.crdClientset.TridentV1().TridentActionVolumeMoves(namespace).Get(ctx, name, getOpts)
+	if apierrors.IsNotFound(err) {
+		Logc(ctx).Debug("Volume move action in work queue no longer exists.")
+		return err
+	}
+	if err != nil {
+		return errors.WrapWithReconcileDeferredError(err, "reconcile deferred")
+	}
+
+	if !actionCR.HasTridentFinalizers() {
+		actionCR.AddTridentFinalizers()
+	}
+	actionCR.Status.State = netappv1.TridentActionStateInProgress
+	actionCR.Status.Message = ""
+	startTime := metav1.Now()
+	actionCR.Status.StartTime = &startTime
+
+	_, err = c.crdClientset.TridentV1().TridentActionVolumeMoves(namespace).Update(ctx, actionCR, updateOpts)
+	if apierrors.IsNotFound(err) {
+		Logc(ctx).Debug("Volume move action in work queue no longer exists.")
+		return err
+	}
+	if err != nil {
+		return errors.WrapWithReconcileDeferredError(err, "reconcile deferred")
+	}
+
+	return nil
+}
+
+func (c *TridentCrdController) updateActionVolumeMoveCRComplete(
+	ctx context.Context, namespace, name string, moveError error,
+) error {
+	// Get the resource with this namespace/name
+	actionCR, err := c.crdClientset.TridentV1().TridentActionVolumeMoves(namespace).Get(ctx, name, getOpts)
+	if apierrors.IsNotFound(err) {
+		Logc(ctx).Debug("Volume move action in work queue no longer exists.")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if actionCR.HasTridentFinalizers() {
+		actionCR.RemoveTridentFinalizers()
+	}
+
+	if moveError == nil {
+		actionCR.Status.State = netappv1.TridentActionStateSucceeded
+		actionCR.Status.Message = ""
+	} else {
+		actionCR.Status.State = netappv1.TridentActionStateFailed
+		actionCR.Status.Message = moveError.Error()
+	}
+
+	completionTime := metav1.Now()
+	actionCR.Status.CompletionTime = &completionTime
+
+	_, err = c.crdClientset.TridentV1().TridentActionVolumeMoves(namespace).Update(ctx, actionCR, updateOpts)
+	if apierrors.IsNotFound(err) {
+		Logc(ctx).Debug("Volume move action in work queue no longer exists.")
+		return nil
+	}
+	return err
+}
+
+func (c *TridentCrdController) getKubernetesObjectsForActionVolumeMove(
+	ctx context.Context, actionCR *netappv1.TridentActionVolumeMove,
+) (tridentVolume string, err error) {
+	if actionCR.Spec.DestinationPool == "" {
+		err = fmt.Errorf("volume move action %s/%s does not specify a destination pool",
+			actionCR.Namespace, actionCR.Name)
+		return
+	}
+
+	// Get PVC
+	pvc, err := c.kubeClientset.CoreV1().PersistentVolumeClaims(actionCR.Namespace).Get(
+		ctx, actionCR.Spec.PVCName, getOpts)
+	if err != nil {
+		return
+	}
+
+	// Ensure PVC is bound
+	if pvc.Status.Phase != v1.ClaimBound {
+		err = fmt.Errorf("PVC %s/%s is not bound to a PV", pvc.Namespace, pvc.Name)
+		return
+	}
+
+	// Get the PV to which the PVC is bound and validate its status
+	pv, err := c.kubeClientset.CoreV1().PersistentVolumes().Get(ctx, pvc.Spec.VolumeName, getOpts)
+	if err != nil {
+		return
+	}
+
+	// Ensure PV is bound to the PVC
+	if pv.Status.Phase != v1.VolumeBound || pv.Spec.ClaimRef == nil ||
+		pv.Spec.ClaimRef.Namespace != pvc.Namespace || pv.Spec.ClaimRef.Name != pvc.Name {
+		err = fmt.Errorf("PV %s is not bound to PVC %s/%s", pv.Name, pvc.Namespace, pvc.Name)
+		return
+	}
+
+	tridentVolume = pv.Name
+	return
+}
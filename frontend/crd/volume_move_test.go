@@ -0,0 +1,231 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package crd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	mockcore "github.com/netapp/trident/mocks/mock_core"
+	netappv1 "github.com/netapp/trident/persistent_store/crd/apis/netapp/v1"
+	"github.com/netapp/trident/utils/errors"
+)
+
+const (
+	volMovePVC1    = "pvc1"
+	volMovePV1     = "pv1"
+	volMoveBackend = "backend2"
+	volMovePool    = "aggr2"
+	tavm1          = "tavm1"
+)
+
+func fakeTAVM(name, namespace, pvcName, backendName, poolName string) *netappv1.TridentActionVolumeMove {
+	return &netappv1.TridentActionVolumeMove{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "trident.netapp.io/v1",
+			Kind:       "TridentActionVolumeMove",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: netappv1.TridentActionVolumeMoveSpec{
+			PVCName:            pvcName,
+			DestinationBackend: backendName,
+			DestinationPool:    poolName,
+		},
+	}
+}
+
+func waitForTAVMComplete(t *testing.T, crdController *TridentCrdController) *netappv1.TridentActionVolumeMove {
+	var tavm *netappv1.TridentActionVolumeMove
+	var err error
+
+	for i := 0; i < 20; i++ {
+		time.Sleep(250 * time.Millisecond)
+
+		tavm, err = crdController.crdClientset.TridentV1().TridentActionVolumeMoves(namespace1).Get(ctx(), tavm1,
+			getOpts)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			break
+		} else if tavm.IsComplete() {
+			break
+		}
+	}
+
+	assert.NoError(t, err, "err should be nil")
+	return tavm
+}
+
+func TestHandleActionVolumeMove(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	orchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+
+	tridentNamespace := "trident"
+	kubeClient := GetTestKubernetesClientset()
+	snapClient := GetTestSnapshotClientset()
+	crdClient := GetTestCrdClientset()
+	crdController, err := newTridentCrdControllerImpl(orchestrator, tridentNamespace, kubeClient, snapClient, crdClient)
+	if err != nil {
+		t.Fatalf("cannot create Trident CRD controller frontend; %v", err)
+	}
+
+	// Report the move as in progress a couple times, expecting a retry, then succeed
+	orchestrator.EXPECT().MoveVolume(gomock.Any(), volMovePV1, volMoveBackend, volMovePool).
+		Return(nil, errors.InProgressError("replicating")).Times(2)
+	orchestrator.EXPECT().MoveVolume(gomock.Any(), volMovePV1, volMoveBackend, volMovePool).
+		Return(nil, nil).Times(1)
+
+	// Activate the CRD controller and start monitoring
+	if err = crdController.Activate(); err != nil {
+		t.Fatalf("error while activating; %v", err)
+	}
+	time.Sleep(250 * time.Millisecond)
+
+	pvc := fakeSnapRestorePVC(volMovePVC1, namespace1, volMovePV1)
+	_, _ = kubeClient.CoreV1().PersistentVolumeClaims(namespace1).Create(ctx(), pvc, createOpts)
+
+	pv := fakePV(volMovePVC1, namespace1, volMovePV1)
+	_, _ = kubeClient.CoreV1().PersistentVolumes().Create(ctx(), pv, createOpts)
+
+	tavm := fakeTAVM(tavm1, namespace1, volMovePVC1, volMoveBackend, volMovePool)
+	_, _ = crdClient.TridentV1().TridentActionVolumeMoves(namespace1).Create(ctx(), tavm, createOpts)
+
+	tavm = waitForTAVMComplete(t, crdController)
+
+	assert.True(t, tavm.Succeeded(), "TAVM operation failed")
+	assert.NotZero(t, tavm.Status.StartTime.Time, "Start time should not be zero")
+	assert.False(t, tavm.Status.CompletionTime.Time.Before(tavm.Status.StartTime.Time),
+		"Completion time is before start time")
+	assert.False(t, tavm.HasTridentFinalizers(), "Finalizers should have been removed")
+
+	_, err = crdController.validateActionVolumeMoveCR(ctx(), namespace1, tavm1)
+	assert.Error(t, err, "Completed TAVM should not have been accepted")
+}
+
+func TestHandleActionVolumeMove_Failed(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	orchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+
+	tridentNamespace := "trident"
+	kubeClient := GetTestKubernetesClientset()
+	snapClient := GetTestSnapshotClientset()
+	crdClient := GetTestCrdClientset()
+	crdController, err := newTridentCrdControllerImpl(orchestrator, tridentNamespace, kubeClient, snapClient, crdClient)
+	if err != nil {
+		t.Fatalf("cannot create Trident CRD controller frontend; %v", err)
+	}
+
+	orchestrator.EXPECT().MoveVolume(gomock.Any(), volMovePV1, "", volMovePool).
+		Return(nil, errors.VolumeStateError("volume pv1 has clones")).Times(1)
+
+	if err = crdController.Activate(); err != nil {
+		t.Fatalf("error while activating; %v", err)
+	}
+	time.Sleep(250 * time.Millisecond)
+
+	pvc := fakeSnapRestorePVC(volMovePVC1, namespace1, volMovePV1)
+	_, _ = kubeClient.CoreV1().PersistentVolumeClaims(namespace1).Create(ctx(), pvc, createOpts)
+
+	pv := fakePV(volMovePVC1, namespace1, volMovePV1)
+	_, _ = kubeClient.CoreV1().PersistentVolumes().Create(ctx(), pv, createOpts)
+
+	tavm := fakeTAVM(tavm1, namespace1, volMovePVC1, "", volMovePool)
+	_, _ = crdClient.TridentV1().TridentActionVolumeMoves(namespace1).Create(ctx(), tavm, createOpts)
+
+	tavm = waitForTAVMComplete(t, crdController)
+
+	assert.True(t, tavm.Failed(), "TAVM operation did not fail")
+	assert.Contains(t, tavm.Status.Message, "has clones")
+}
+
+func TestHandleActionVolumeMove_InProgressAtStartup(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	orchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+
+	tridentNamespace := "trident"
+	kubeClient := GetTestKubernetesClientset()
+	snapClient := GetTestSnapshotClientset()
+	crdClient := GetTestCrdClientset()
+	crdController, err := newTridentCrdControllerImpl(orchestrator, tridentNamespace, kubeClient, snapClient, crdClient)
+	if err != nil {
+		t.Fatalf("cannot create Trident CRD controller frontend; %v", err)
+	}
+
+	// An in-progress move is resumed rather than failed
+	orchestrator.EXPECT().MoveVolume(gomock.Any(), volMovePV1, volMoveBackend, volMovePool).
+		Return(nil, nil).Times(1)
+
+	pvc := fakeSnapRestorePVC(volMovePVC1, namespace1, volMovePV1)
+	_, _ = kubeClient.CoreV1().PersistentVolumeClaims(namespace1).Create(ctx(), pvc, createOpts)
+
+	pv := fakePV(volMovePVC1, namespace1, volMovePV1)
+	_, _ = kubeClient.CoreV1().PersistentVolumes().Create(ctx(), pv, createOpts)
+
+	tavm := fakeTAVM(tavm1, namespace1, volMovePVC1, volMoveBackend, volMovePool)
+	tavm.Status.State = netappv1.TridentActionStateInProgress
+	_, _ = crdClient.TridentV1().TridentActionVolumeMoves(namespace1).Create(ctx(), tavm, createOpts)
+
+	if err = crdController.Activate(); err != nil {
+		t.Fatalf("error while activating; %v", err)
+	}
+
+	tavm = waitForTAVMComplete(t, crdController)
+
+	assert.True(t, tavm.Succeeded(), "TAVM operation failed")
+}
+
+func TestGetKubernetesObjectsForActionVolumeMove(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	orchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+
+	kubeClient := GetTestKubernetesClientset()
+	crdController, err := newTridentCrdControllerImpl(orchestrator, "trident", kubeClient,
+		GetTestSnapshotClientset(), GetTestCrdClientset())
+	if err != nil {
+		t.Fatalf("cannot create Trident CRD controller frontend; %v", err)
+	}
+
+	// Missing pool
+	_, err = crdController.getKubernetesObjectsForActionVolumeMove(ctx(),
+		fakeTAVM(tavm1, namespace1, volMovePVC1, "", ""))
+	assert.Error(t, err)
+
+	// Missing PVC
+	_, err = crdController.getKubernetesObjectsForActionVolumeMove(ctx(),
+		fakeTAVM(tavm1, namespace1, volMovePVC1, "", volMovePool))
+	assert.Error(t, err)
+
+	pvc := fakeSnapRestorePVC(volMovePVC1, namespace1, volMovePV1)
+	_, _ = kubeClient.CoreV1().PersistentVolumeClaims(namespace1).Create(ctx(), pvc, createOpts)
+
+	// Missing PV
+	_, err = crdController.getKubernetesObjectsForActionVolumeMove(ctx(),
+		fakeTAVM(tavm1, namespace1, volMovePVC1, "", volMovePool))
+	assert.Error(t, err)
+
+	// PV bound to another PVC
+	pv := fakePV("otherPVC", namespace1, volMovePV1)
+	_, _ = kubeClient.CoreV1().PersistentVolumes().Create(ctx(), pv, createOpts)
+
+	_, err = crdController.getKubernetesObjectsForActionVolumeMove(ctx(),
+		fakeTAVM(tavm1, namespace1, volMovePVC1, "", volMovePool))
+	assert.Error(t, err)
+
+	// Success
+	pv = fakePV(volMovePVC1, namespace1, volMovePV1)
+	_, _ = kubeClient.CoreV1().PersistentVolumes().Update(ctx(), pv, updateOpts)
+
+	tridentVolume, err := crdController.getKubernetesObjectsForActionVolumeMove(ctx(),
+		fakeTAVM(tavm1, namespace1, volMovePVC1, "", volMovePool))
+	assert.NoError(t, err)
+	assert.Equal(t, volMovePV1, tridentVolume)
+}
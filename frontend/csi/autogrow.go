@@ -0,0 +1,106 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package csi
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/container-storage-interface/spec/lib/go/csi"
+
+	controllerAPIv1 "github.com/netapp/trident/frontend/csi/controller_api/v1"
+	. "github.com/netapp/trident/logging"
+	"github.com/netapp/trident/utils/errors"
+)
+
+// volumeUsageReportInterval is how often the node reports to the controller the space used on its volumes,
+// so that the controller may grow any volumes filling up.
+const volumeUsageReportInterval = time.Minute
+
+// startReportingVolumeUsage starts the thread that periodically reports the space used on the node's volumes.
+// The thread stops by itself if the controller cannot receive the reports.
+func (p *Plugin) startReportingVolumeUsage(ctx context.Context) {
+	Logc(ctx).WithField("interval", volumeUsageReportInterval).Info("Reporting volume usage to the controller.")
+
+	p.volumeUsageTicker = time.NewTicker(volumeUsageReportInterval)
+	p.stopVolumeUsageReports = make(chan struct{})
+
+	go func() {
+		ctx = GenerateRequestContext(nil, "", ContextSourcePeriodic, WorkflowVolumeGetStats, LogLayerCSIFrontend)
+
+		for {
+			select {
+			case <-p.volumeUsageTicker.C:
+				if err := p.reportVolumeUsage(ctx); err != nil {
+					if errors.IsUnsupportedError(err) {
+						Logc(ctx).WithError(err).Info("Controller cannot receive volume usage; reports stopped.")
+						p.volumeUsageTicker.Stop()
+						return
+					}
+					Logc(ctx).WithError(err).Warning("Could not report volume usage to the controller.")
+				}
+			case <-p.stopVolumeUsageReports:
+				Logc(ctx).Info("Volume usage reports stopped.")
+				return
+			}
+		}
+	}()
+}
+
+// stopReportingVolumeUsage stops the thread that reports the space used on the node's volumes.
+func (p *Plugin) stopReportingVolumeUsage(_ context.Context) {
+	if p.volumeUsageTicker != nil {
+		p.volumeUsageTicker.Stop()
+	}
+	if p.stopVolumeUsageReports != nil {
+		close(p.stopVolumeUsageReports)
+	}
+}
+
+// reportVolumeUsage reports to the controller the space used on the filesystem of each volume published to the
+// node, as NodeGetVolumeStats finds it.  Raw block volumes have no filesystem, so they are not reported.
+func (p *Plugin) reportVolumeUsage(ctx context.Context) error {
+	trackingInfo, err := p.nodeHelper.ListVolumeTrackingInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	report := &controllerAPIv1.ReportVolumeUsageRequest{Node: p.nodeName}
+	for volumeID, info := range trackingInfo {
+		if len(info.PublishedPaths) == 0 {
+			continue
+		}
+		paths := make([]string, 0, len(info.PublishedPaths))
+		for path := range info.PublishedPaths {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+
+		stats, err := p.NodeGetVolumeStats(ctx, &csi.NodeGetVolumeStatsRequest{
+			VolumeId:          volumeID,
+			VolumePath:        paths[0],
+			StagingTargetPath: info.StagingTargetPath,
+		})
+		if err != nil {
+			Logc(ctx).WithField("volume", volumeID).WithError(err).Debug("Could not get volume usage.")
+			continue
+		}
+		for _, usage := range stats.GetUsage() {
+			if usage.GetUnit() == csi.VolumeUsage_BYTES && usage.GetTotal() > 0 {
+				report.Volumes = append(report.Volumes, controllerAPIv1.VolumeUsage{
+					Volume:     volumeID,
+					UsedBytes:  usage.GetUsed(),
+					TotalBytes: usage.GetTotal(),
+				})
+			}
+		}
+	}
+
+	if len(report.Volumes) == 0 {
+		return nil
+	}
+	sort.Slice(report.Volumes, func(i, j int) bool { return report.Volumes[i].Volume < report.Volumes[j].Volume })
+
+	return p.restClient.ReportVolumeUsage(ctx, report)
+}
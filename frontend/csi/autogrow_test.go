@@ -0,0 +1,67 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package csi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	controllerAPIv1 "github.com/netapp/trident/frontend/csi/controller_api/v1"
+	mockControllerAPI "github.com/netapp/trident/mocks/mock_frontend/mock_csi/mock_controller_api"
+	mockNodeHelpers "github.com/netapp/trident/mocks/mock_frontend/mock_csi/mock_node_helpers"
+	"github.com/netapp/trident/utils"
+	"github.com/netapp/trident/utils/errors"
+)
+
+func TestReportVolumeUsage(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockClient := mockControllerAPI.NewMockTridentController(mockCtrl)
+	mockNodeHelper := mockNodeHelpers.NewMockNodeHelper(mockCtrl)
+	p := &Plugin{nodeName: "node1", restClient: mockClient, nodeHelper: mockNodeHelper}
+
+	nfsVolume := &utils.VolumeTrackingInfo{
+		VolumePublishInfo: utils.VolumePublishInfo{
+			VolumeAccessInfo: utils.VolumeAccessInfo{
+				NfsAccessInfo: utils.NfsAccessInfo{NfsServerIP: "1.1.1.1", NfsPath: "/vol1"},
+			},
+		},
+		StagingTargetPath: "/staging/vol1",
+		PublishedPaths:    map[string]struct{}{t.TempDir(): {}},
+	}
+	rawVolume := &utils.VolumeTrackingInfo{
+		VolumePublishInfo: utils.VolumePublishInfo{FilesystemType: "raw"},
+		StagingTargetPath: "/staging/vol2",
+		PublishedPaths:    map[string]struct{}{t.TempDir(): {}},
+	}
+	unpublishedVolume := &utils.VolumeTrackingInfo{StagingTargetPath: "/staging/vol3"}
+
+	mockNodeHelper.EXPECT().ListVolumeTrackingInfo(gomock.Any()).Return(map[string]*utils.VolumeTrackingInfo{
+		"vol1": nfsVolume, "vol2": rawVolume, "vol3": unpublishedVolume,
+	}, nil)
+	mockNodeHelper.EXPECT().ReadTrackingInfo(gomock.Any(), "vol1").Return(nfsVolume, nil)
+	mockNodeHelper.EXPECT().ReadTrackingInfo(gomock.Any(), "vol2").Return(rawVolume, nil)
+
+	// Only volumes with a filesystem are reported
+	mockClient.EXPECT().ReportVolumeUsage(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, report *controllerAPIv1.ReportVolumeUsageRequest) error {
+			assert.Equal(t, "node1", report.Node)
+			if assert.Len(t, report.Volumes, 1) {
+				assert.Equal(t, "vol1", report.Volumes[0].Volume)
+				assert.Positive(t, report.Volumes[0].TotalBytes)
+			}
+			return nil
+		})
+	assert.NoError(t, p.reportVolumeUsage(ctx))
+
+	// Nothing is reported if no volume is published
+	mockNodeHelper.EXPECT().ListVolumeTrackingInfo(gomock.Any()).Return(map[string]*utils.VolumeTrackingInfo{
+		"vol3": unpublishedVolume,
+	}, nil)
+	assert.NoError(t, p.reportVolumeUsage(ctx))
+
+	mockNodeHelper.EXPECT().ListVolumeTrackingInfo(gomock.Any()).Return(nil, errors.New("failed"))
+	assert.Error(t, p.reportVolumeUsage(ctx))
+}
@@ -0,0 +1,394 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package controllerAPI
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"net/http"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	controllerAPIv1 "github.com/netapp/trident/frontend/csi/controller_api/v1"
+	. "github.com/netapp/trident/logging"
+	"github.com/netapp/trident/utils"
+	"github.com/netapp/trident/utils/errors"
+)
+
+const (
+	// maxRetryJitter is the most added to the wait requested by the controller's flow control, so that node
+	// plugins turned away together do not all return together
+	maxRetryJitter = time.Second
+	// watchEventBuffer is the number of node events held for a slow consumer
+	watchEventBuffer = 16
+
+	keepaliveTime    = time.Minute
+	keepaliveTimeout = 20 * time.Second
+)
+
+// watchRetryInterval is how long to wait before resuming a watch that ended
+var watchRetryInterval = 5 * time.Second
+
+// ControllerGRPCClient calls the CSI controller through its gRPC control-plane API.  Controllers that do not
+// offer that API, such as those not yet upgraded, are called through the REST API instead.
+type ControllerGRPCClient struct {
+	client controllerAPIv1.NodeControlClient
+	rest   TridentController
+}
+
+// CreateTLSGRPCClient returns a client of the controller's gRPC control-plane API at the specified address,
+// which falls back to the specified REST client.
+func CreateTLSGRPCClient(
+	address, caFile, certFile, keyFile string, rest TridentController,
+) (TridentController, error) {
+	tlsConfig, err := newTLSConfig(caFile, certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := grpc.NewClient(address,
+		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:    keepaliveTime,
+			Timeout: keepaliveTimeout,
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not create gRPC client; %v", err)
+	}
+
+	return NewControllerGRPCClient(controllerAPIv1.NewNodeControlClient(conn), rest), nil
+}
+
+// NewControllerGRPCClient returns a controller client using the specified gRPC and REST clients.
+func NewControllerGRPCClient(client controllerAPIv1.NodeControlClient, rest TridentController) *ControllerGRPCClient {
+	return &ControllerGRPCClient{client: client, rest: rest}
+}
+
+// call makes a gRPC call, waiting and trying again for as long as the controller's flow control turns it
+// away.  If the controller cannot be reached through gRPC, the call is made through REST instead.
+func (c *ControllerGRPCClient) call(
+	ctx context.Context, method string, grpcCall func(context.Context, ...grpc.CallOption) error,
+	restCall func() error,
+) error {
+	for {
+		var trailer metadata.MD
+		callCtx, cancel := context.WithTimeout(ctx, HTTPClientTimeout)
+		err := grpcCall(callCtx, grpc.Trailer(&trailer))
+		cancel()
+
+		switch status.Code(err) {
+		case codes.OK:
+			return nil
+		case codes.ResourceExhausted:
+			retryAfter := retryAfterWithJitter(trailer)
+			Logc(ctx).WithField("method", method).Debugf("Request rejected due to flow control, retrying in %v.",
+				retryAfter)
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(retryAfter):
+			}
+		case codes.Unavailable, codes.Unimplemented:
+			Logc(ctx).WithError(err).WithField("method", method).Debug(
+				"Could not call the Trident CSI Controller through gRPC, falling back to REST.")
+			return restCall()
+		default:
+			return errorFromStatus(err)
+		}
+	}
+}
+
+// retryAfterWithJitter returns how long the controller asked a caller turned away to wait, plus some jitter.
+func retryAfterWithJitter(trailer metadata.MD) time.Duration {
+	var retryAfter time.Duration
+	if values := trailer.Get(controllerAPIv1.RetryAfterKey); len(values) > 0 {
+		retryAfter, _ = time.ParseDuration(values[0])
+	}
+
+	jitter := maxRetryJitter
+	if n, err := rand.Int(rand.Reader, big.NewInt(int64(maxRetryJitter))); err == nil {
+		jitter = time.Duration(n.Int64())
+	}
+	return retryAfter + jitter
+}
+
+// errorFromStatus returns an error for a failed gRPC call like those of the REST client.
+func errorFromStatus(err error) error {
+	grpcStatus := status.Convert(err)
+	switch grpcStatus.Code() {
+	case codes.NotFound:
+		return errors.NotFoundError(grpcStatus.Message())
+	case codes.InvalidArgument:
+		return errors.InvalidInputError(grpcStatus.Message())
+	default:
+		return fmt.Errorf("error communicating with Trident CSI Controller; %s", grpcStatus.Message())
+	}
+}
+
+// InvokeAPI makes a REST call, as there is no gRPC equivalent.
+func (c *ControllerGRPCClient) InvokeAPI(
+	ctx context.Context, requestBody []byte, method, resourcePath string, redactRequestBody,
+	redactResponseBody bool,
+) (*http.Response, []byte, error) {
+	return c.rest.InvokeAPI(ctx, requestBody, method, resourcePath, redactRequestBody, redactResponseBody)
+}
+
+// CreateNode registers the node with the CSI controller server
+func (c *ControllerGRPCClient) CreateNode(ctx context.Context, node *utils.Node) (CreateNodeResponse, error) {
+	var createResponse CreateNodeResponse
+	err := c.call(ctx, "RegisterNode",
+		func(ctx context.Context, opts ...grpc.CallOption) error {
+			response, err := c.client.RegisterNode(ctx, &controllerAPIv1.RegisterNodeRequest{Node: node}, opts...)
+			if err == nil {
+				createResponse = CreateNodeResponse{
+					TopologyLabels: response.TopologyLabels,
+					LogLevel:       response.LogLevel,
+					LogWorkflows:   response.LogWorkflows,
+					LogLayers:      response.LogLayers,
+				}
+			}
+			return err
+		},
+		func() (err error) {
+			createResponse, err = c.rest.CreateNode(ctx, node)
+			return err
+		},
+	)
+	if err != nil {
+		return CreateNodeResponse{}, fmt.Errorf("could not add CSI node; %v", err)
+	}
+	return createResponse, nil
+}
+
+func (c *ControllerGRPCClient) GetNode(ctx context.Context, nodeName string) (*utils.NodeExternal, error) {
+	var node *utils.NodeExternal
+	err := c.call(ctx, "GetNode",
+		func(ctx context.Context, opts ...grpc.CallOption) error {
+			response, err := c.client.GetNode(ctx, &controllerAPIv1.NodeRequest{Name: nodeName}, opts...)
+			if err == nil {
+				node = response.Node
+			}
+			return err
+		},
+		func() (err error) {
+			node, err = c.rest.GetNode(ctx, nodeName)
+			return err
+		},
+	)
+	return node, err
+}
+
+func (c *ControllerGRPCClient) UpdateNode(
+	ctx context.Context, nodeName string, nodeState *utils.NodePublicationStateFlags,
+) error {
+	return c.call(ctx, "UpdateNode",
+		func(ctx context.Context, opts ...grpc.CallOption) error {
+			request := &controllerAPIv1.UpdateNodeRequest{Name: nodeName, State: nodeState}
+			_, err := c.client.UpdateNode(ctx, request, opts...)
+			return err
+		},
+		func() error {
+			return c.rest.UpdateNode(ctx, nodeName, nodeState)
+		},
+	)
+}
+
+// GetNodes makes a REST call, as node plugins have no need to list nodes through gRPC.
+func (c *ControllerGRPCClient) GetNodes(ctx context.Context) ([]string, error) {
+	return c.rest.GetNodes(ctx)
+}
+
+// DeleteNode makes a REST call, as node plugins have no need to delete nodes through gRPC.
+func (c *ControllerGRPCClient) DeleteNode(ctx context.Context, name string) error {
+	return c.rest.DeleteNode(ctx, name)
+}
+
+// GetChap requests the current CHAP credentials for a given volume/node pair from the Trident controller
+func (c *ControllerGRPCClient) GetChap(ctx context.Context, volume, node string) (*utils.IscsiChapInfo, error) {
+	var chapInfo *utils.IscsiChapInfo
+	err := c.call(ctx, "GetCHAP",
+		func(ctx context.Context, opts ...grpc.CallOption) error {
+			request := &controllerAPIv1.GetCHAPRequest{Volume: volume, Node: node}
+			response, err := c.client.GetCHAP(ctx, request, opts...)
+			if err == nil {
+				chapInfo = response.CHAP
+			}
+			return err
+		},
+		func() (err error) {
+			chapInfo, err = c.rest.GetChap(ctx, volume, node)
+			return err
+		},
+	)
+	return chapInfo, err
+}
+
+func (c *ControllerGRPCClient) UpdateVolumeLUKSPassphraseNames(
+	ctx context.Context, volume string, passphraseNames []string,
+) error {
+	return c.call(ctx, "UpdateVolumeLUKSPassphraseNames",
+		func(ctx context.Context, opts ...grpc.CallOption) error {
+			request := &controllerAPIv1.UpdateVolumeLUKSPassphraseNamesRequest{
+				Volume:          volume,
+				PassphraseNames: passphraseNames,
+			}
+			_, err := c.client.UpdateVolumeLUKSPassphraseNames(ctx, request, opts...)
+			return err
+		},
+		func() error {
+			return c.rest.UpdateVolumeLUKSPassphraseNames(ctx, volume, passphraseNames)
+		},
+	)
+}
+
+// ListVolumePublicationsForNode requests volume publications that exist on the host node from Trident controller.
+func (c *ControllerGRPCClient) ListVolumePublicationsForNode(
+	ctx context.Context, nodeName string,
+) ([]*utils.VolumePublicationExternal, error) {
+	var publications []*utils.VolumePublicationExternal
+	err := c.call(ctx, "ListVolumePublicationsForNode",
+		func(ctx context.Context, opts ...grpc.CallOption) error {
+			request := &controllerAPIv1.NodeRequest{Name: nodeName}
+			response, err := c.client.ListVolumePublicationsForNode(ctx, request, opts...)
+			if err == nil {
+				publications = response.VolumePublications
+			}
+			return err
+		},
+		func() (err error) {
+			publications, err = c.rest.ListVolumePublicationsForNode(ctx, nodeName)
+			return err
+		},
+	)
+	return publications, err
+}
+
+// GetLoggingConfig retrieves the current logging configuration for Trident.
+func (c *ControllerGRPCClient) GetLoggingConfig(ctx context.Context) (string, string, string, error) {
+	var logLevel, logWorkflows, logLayers string
+	err := c.call(ctx, "GetLoggingConfig",
+		func(ctx context.Context, opts ...grpc.CallOption) error {
+			response, err := c.client.GetLoggingConfig(ctx, &controllerAPIv1.Empty{}, opts...)
+			if err == nil {
+				logLevel, logWorkflows, logLayers = response.LogLevel, response.LogWorkflows, response.LogLayers
+			}
+			return err
+		},
+		func() (err error) {
+			logLevel, logWorkflows, logLayers, err = c.rest.GetLoggingConfig(ctx)
+			return err
+		},
+	)
+	return logLevel, logWorkflows, logLayers, err
+}
+
+// ReportFreeze reports to the controller whether the node froze the filesystem it was asked to freeze.
+func (c *ControllerGRPCClient) ReportFreeze(ctx context.Context, report *controllerAPIv1.ReportFreezeRequest) error {
+	return c.call(ctx, "ReportFreeze",
+		func(ctx context.Context, opts ...grpc.CallOption) error {
+			_, err := c.client.ReportFreeze(ctx, report, opts...)
+			return err
+		},
+		func() error {
+			return c.rest.ReportFreeze(ctx, report)
+		},
+	)
+}
+
+// ReportVolumeUsage reports to the controller the space used on the volumes published to the node.
+func (c *ControllerGRPCClient) ReportVolumeUsage(
+	ctx context.Context, report *controllerAPIv1.ReportVolumeUsageRequest,
+) error {
+	return c.call(ctx, "ReportVolumeUsage",
+		func(ctx context.Context, opts ...grpc.CallOption) error {
+			_, err := c.client.ReportVolumeUsage(ctx, report, opts...)
+			return err
+		},
+		func() error {
+			return c.rest.ReportVolumeUsage(ctx, report)
+		},
+	)
+}
+
+// WatchNode returns a channel delivering changes to the node and its volume publications until the context
+// is done, when the channel is closed.  Interrupted watches are resumed where they left off.  If the watch
+// must begin afresh, a sync event carrying the node's current state is delivered first.  The channel is
+// also closed if the controller does not offer watches, as when it has not yet been upgraded.
+func (c *ControllerGRPCClient) WatchNode(
+	ctx context.Context, nodeName string,
+) (<-chan *controllerAPIv1.NodeEvent, error) {
+	events := make(chan *controllerAPIv1.NodeEvent, watchEventBuffer)
+
+	go func() {
+		defer close(events)
+
+		var since uint64
+		for {
+			var retryAfter time.Duration
+			var err error
+			since, retryAfter, err = c.watchNode(ctx, nodeName, since, events)
+			if status.Code(err) == codes.Unimplemented {
+				Logc(ctx).WithError(err).Debug("The Trident CSI Controller cannot watch nodes.")
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(watchRetryInterval + retryAfter):
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// watchNode delivers node events after the specified sequence number until the watch ends.  It returns the
+// sequence number from which to resume, how long the controller asked to wait before resuming, and why the
+// watch ended.
+func (c *ControllerGRPCClient) watchNode(
+	ctx context.Context, nodeName string, since uint64, events chan<- *controllerAPIv1.NodeEvent,
+) (uint64, time.Duration, error) {
+	logFields := LogFields{"node": nodeName, "since": since}
+
+	request := &controllerAPIv1.WatchNodeRequest{Name: nodeName, Since: since}
+	stream, err := c.client.WatchNode(ctx, request)
+	if err != nil {
+		Logc(ctx).WithFields(logFields).WithError(err).Debug("Could not watch node.")
+		return since, 0, err
+	}
+
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			Logc(ctx).WithFields(logFields).WithError(err).Debug("Node watch ended.")
+			switch status.Code(err) {
+			case codes.OutOfRange:
+				// The controller restarted, or we fell too far behind, so begin afresh
+				return 0, 0, err
+			case codes.ResourceExhausted:
+				return since, retryAfterWithJitter(stream.Trailer()), err
+			}
+			return since, 0, err
+		}
+
+		if event.Sequence > 0 {
+			since = event.Sequence
+		}
+
+		select {
+		case <-ctx.Done():
+			return since, 0, ctx.Err()
+		case events <- event:
+		}
+	}
+}
@@ -0,0 +1,285 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package controllerAPI
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/netapp/trident/config"
+	controllerAPIv1 "github.com/netapp/trident/frontend/csi/controller_api/v1"
+	"github.com/netapp/trident/utils"
+)
+
+// fakeNodeControl is a node control server whose methods fail until told otherwise.
+type fakeNodeControl struct {
+	mutex           sync.Mutex
+	registerNodeErr []error
+	registrations   int
+	watchRequests   []*controllerAPIv1.WatchNodeRequest
+	watches         []func(controllerAPIv1.WatchNodeServer) error
+	freezeReports   []*controllerAPIv1.ReportFreezeRequest
+	usageReports    []*controllerAPIv1.ReportVolumeUsageRequest
+}
+
+func (f *fakeNodeControl) RegisterNode(
+	_ context.Context, request *controllerAPIv1.RegisterNodeRequest,
+) (*controllerAPIv1.RegisterNodeResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.registrations++
+	if len(f.registerNodeErr) > 0 {
+		err := f.registerNodeErr[0]
+		f.registerNodeErr = f.registerNodeErr[1:]
+		return nil, err
+	}
+	return &controllerAPIv1.RegisterNodeResponse{
+		TopologyLabels: map[string]string{"topology.kubernetes.io/zone": "zone1"},
+		LoggingConfig:  controllerAPIv1.LoggingConfig{LogLevel: "debug"},
+	}, nil
+}
+
+func (f *fakeNodeControl) GetNode(
+	_ context.Context, request *controllerAPIv1.NodeRequest,
+) (*controllerAPIv1.GetNodeResponse, error) {
+	return nil, status.Errorf(codes.NotFound, "node %s was not found", request.Name)
+}
+
+func (f *fakeNodeControl) UpdateNode(
+	context.Context, *controllerAPIv1.UpdateNodeRequest,
+) (*controllerAPIv1.Empty, error) {
+	return &controllerAPIv1.Empty{}, nil
+}
+
+func (f *fakeNodeControl) GetCHAP(
+	context.Context, *controllerAPIv1.GetCHAPRequest,
+) (*controllerAPIv1.GetCHAPResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "not implemented")
+}
+
+func (f *fakeNodeControl) ListVolumePublicationsForNode(
+	context.Context, *controllerAPIv1.NodeRequest,
+) (*controllerAPIv1.ListVolumePublicationsResponse, error) {
+	return &controllerAPIv1.ListVolumePublicationsResponse{}, nil
+}
+
+func (f *fakeNodeControl) UpdateVolumeLUKSPassphraseNames(
+	context.Context, *controllerAPIv1.UpdateVolumeLUKSPassphraseNamesRequest,
+) (*controllerAPIv1.Empty, error) {
+	return &controllerAPIv1.Empty{}, nil
+}
+
+func (f *fakeNodeControl) GetLoggingConfig(
+	context.Context, *controllerAPIv1.Empty,
+) (*controllerAPIv1.LoggingConfig, error) {
+	return &controllerAPIv1.LoggingConfig{LogLevel: "debug", LogWorkflows: "node=all", LogLayers: "all"}, nil
+}
+
+func (f *fakeNodeControl) WatchNode(
+	request *controllerAPIv1.WatchNodeRequest, stream controllerAPIv1.WatchNodeServer,
+) error {
+	f.mutex.Lock()
+	f.watchRequests = append(f.watchRequests, request)
+	if len(f.watches) == 0 {
+		f.mutex.Unlock()
+		<-stream.Context().Done()
+		return nil
+	}
+	watch := f.watches[0]
+	f.watches = f.watches[1:]
+	f.mutex.Unlock()
+
+	return watch(stream)
+}
+
+func (f *fakeNodeControl) ReportFreeze(
+	_ context.Context, request *controllerAPIv1.ReportFreezeRequest,
+) (*controllerAPIv1.Empty, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.freezeReports = append(f.freezeReports, request)
+	return &controllerAPIv1.Empty{}, nil
+}
+
+func (f *fakeNodeControl) ReportVolumeUsage(
+	_ context.Context, request *controllerAPIv1.ReportVolumeUsageRequest,
+) (*controllerAPIv1.Empty, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.usageReports = append(f.usageReports, request)
+	return &controllerAPIv1.Empty{}, nil
+}
+
+// newTestGRPCClient returns a client of a fake node control server, falling back to the specified REST URL.
+func newTestGRPCClient(t *testing.T, server controllerAPIv1.NodeControlServer, restURL string) *ControllerGRPCClient {
+	listener := bufconn.Listen(1024 * 1024)
+	grpcServer := grpc.NewServer()
+	controllerAPIv1.RegisterNodeControlServer(grpcServer, server)
+	go func() { _ = grpcServer.Serve(listener) }()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	restClient, err := CreateTLSRestClient(restURL, "", "", "")
+	assert.NoError(t, err)
+
+	return NewControllerGRPCClient(controllerAPIv1.NewNodeControlClient(conn), restClient)
+}
+
+func TestControllerGRPCClient_CreateNode(t *testing.T) {
+	// The first attempt is turned away by flow control
+	rejected := status.Error(codes.ResourceExhausted, "too many requests")
+	server := &fakeNodeControl{registerNodeErr: []error{rejected}}
+	client := newTestGRPCClient(t, server, "")
+
+	response, err := client.CreateNode(ctx, &utils.Node{Name: "node1"})
+	assert.NoError(t, err)
+	assert.Equal(t, CreateNodeResponse{
+		TopologyLabels: map[string]string{"topology.kubernetes.io/zone": "zone1"},
+		LogLevel:       "debug",
+	}, response)
+	assert.Equal(t, 2, server.registrations)
+
+	// Other failures are not retried
+	server.registerNodeErr = []error{status.Error(codes.Internal, "failed")}
+	_, err = client.CreateNode(ctx, &utils.Node{Name: "node1"})
+	assert.Error(t, err)
+	assert.Equal(t, 3, server.registrations)
+}
+
+func TestControllerGRPCClient_Errors(t *testing.T) {
+	client := newTestGRPCClient(t, &fakeNodeControl{}, "")
+
+	_, err := client.GetNode(ctx, "node1")
+	assert.ErrorContains(t, err, "node node1 was not found")
+}
+
+func TestControllerGRPCClient_RESTFallback(t *testing.T) {
+	chapInfo := &utils.IscsiChapInfo{UseCHAP: true, IscsiUsername: "user"}
+	restServer := getHttpServer(config.ChapURL+"/vol1/node1", func(w http.ResponseWriter, r *http.Request) {
+		createResponse(w, GetCHAPResponse{CHAP: chapInfo}, http.StatusOK)
+	})
+	defer restServer.Close()
+
+	// The fake server does not implement GetCHAP
+	client := newTestGRPCClient(t, &fakeNodeControl{}, restServer.URL)
+	result, err := client.GetChap(ctx, "vol1", "node1")
+	assert.NoError(t, err)
+	assert.Equal(t, chapInfo, result)
+
+	// Unreachable controllers are called through REST too
+	restClient, err := CreateTLSRestClient(restServer.URL, "", "", "")
+	assert.NoError(t, err)
+	conn, err := grpc.NewClient("passthrough:///unreachable",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return nil, net.UnknownNetworkError("unreachable")
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+	client = NewControllerGRPCClient(controllerAPIv1.NewNodeControlClient(conn), restClient)
+	result, err = client.GetChap(ctx, "vol1", "node1")
+	assert.NoError(t, err)
+	assert.Equal(t, chapInfo, result)
+}
+
+func TestControllerGRPCClient_ReportFreeze(t *testing.T) {
+	server := &fakeNodeControl{}
+	client := newTestGRPCClient(t, server, "")
+
+	report := &controllerAPIv1.ReportFreezeRequest{ID: "1", Node: "node1", Volume: "vol1", Error: "not mounted"}
+	assert.NoError(t, client.ReportFreeze(ctx, report))
+	assert.Equal(t, []*controllerAPIv1.ReportFreezeRequest{report}, server.freezeReports)
+}
+
+func TestControllerGRPCClient_ReportVolumeUsage(t *testing.T) {
+	server := &fakeNodeControl{}
+	client := newTestGRPCClient(t, server, "")
+
+	report := &controllerAPIv1.ReportVolumeUsageRequest{
+		Node:    "node1",
+		Volumes: []controllerAPIv1.VolumeUsage{{Volume: "vol1", UsedBytes: 80, TotalBytes: 100}},
+	}
+	assert.NoError(t, client.ReportVolumeUsage(ctx, report))
+	assert.Equal(t, []*controllerAPIv1.ReportVolumeUsageRequest{report}, server.usageReports)
+}
+
+func TestControllerGRPCClient_WatchNode(t *testing.T) {
+	dirtyNode := &utils.NodeExternal{Name: "node1", PublicationState: utils.NodeDirty}
+	freeze := &controllerAPIv1.FilesystemFreeze{ID: "1", Volume: "vol1", Timeout: 30 * time.Second}
+	server := &fakeNodeControl{
+		watches: []func(controllerAPIv1.WatchNodeServer) error{
+			func(stream controllerAPIv1.WatchNodeServer) error {
+				_ = stream.Send(&controllerAPIv1.NodeEvent{Type: controllerAPIv1.NodeEventSync})
+				_ = stream.Send(&controllerAPIv1.NodeEvent{
+					Sequence: 7, Type: controllerAPIv1.NodeEventUpdated, Node: dirtyNode,
+				})
+				_ = stream.Send(&controllerAPIv1.NodeEvent{Type: controllerAPIv1.NodeEventFreeze, Freeze: freeze})
+				return status.Error(codes.Aborted, "node watch fell behind")
+			},
+			func(stream controllerAPIv1.WatchNodeServer) error {
+				stream.SetTrailer(metadata.Pairs(controllerAPIv1.RetryAfterKey, "1ms"))
+				return status.Error(codes.ResourceExhausted, "too many requests")
+			},
+			func(stream controllerAPIv1.WatchNodeServer) error {
+				return status.Error(codes.OutOfRange, "events after 7 are no longer available")
+			},
+			func(stream controllerAPIv1.WatchNodeServer) error {
+				return status.Error(codes.Unimplemented, "not implemented")
+			},
+		},
+	}
+	client := newTestGRPCClient(t, server, "")
+
+	defer func(interval time.Duration) { watchRetryInterval = interval }(watchRetryInterval)
+	watchRetryInterval = time.Millisecond
+
+	watchCtx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+	events, err := client.WatchNode(watchCtx, "node1")
+	assert.NoError(t, err)
+
+	var received []*controllerAPIv1.NodeEvent
+	for event := range events {
+		received = append(received, event)
+	}
+
+	assert.Equal(t, []*controllerAPIv1.NodeEvent{
+		{Type: controllerAPIv1.NodeEventSync},
+		{Sequence: 7, Type: controllerAPIv1.NodeEventUpdated, Node: dirtyNode},
+		{Type: controllerAPIv1.NodeEventFreeze, Freeze: freeze},
+	}, received)
+
+	// Watches are resumed after the last event in the controller's stream, unless that is no longer possible
+	assert.Equal(t, []*controllerAPIv1.WatchNodeRequest{
+		{Name: "node1"},
+		{Name: "node1", Since: 7},
+		{Name: "node1", Since: 7},
+		{Name: "node1"},
+	}, server.watchRequests)
+}
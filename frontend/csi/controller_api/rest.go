@@ -15,8 +15,10 @@ import (
 	"time"
 
 	"github.com/netapp/trident/config"
+	controllerAPIv1 "github.com/netapp/trident/frontend/csi/controller_api/v1"
 	. "github.com/netapp/trident/logging"
 	"github.com/netapp/trident/utils"
+	"github.com/netapp/trident/utils/errors"
 )
 
 const HTTPClientTimeout = time.Second * 30
@@ -27,6 +29,23 @@ type ControllerRestClient struct {
 }
 
 func CreateTLSRestClient(url, caFile, certFile, keyFile string) (TridentController, error) {
+	tlsConfig, err := newTLSConfig(caFile, certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	return &ControllerRestClient{
+		url: url,
+		httpClient: http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: tlsConfig,
+			},
+			Timeout: HTTPClientTimeout,
+		},
+	}, nil
+}
+
+// newTLSConfig returns the TLS configuration with which node plugins call the controller.
+func newTLSConfig(caFile, certFile, keyFile string) (*tls.Config, error) {
 	tlsConfig := &tls.Config{MinVersion: config.MinClientTLSVersion}
 	if "" != caFile {
 		caCert, err := os.ReadFile(caFile)
@@ -47,15 +66,7 @@ func CreateTLSRestClient(url, caFile, certFile, keyFile string) (TridentControll
 		}
 		tlsConfig.Certificates = []tls.Certificate{cert}
 	}
-	return &ControllerRestClient{
-		url: url,
-		httpClient: http.Client{
-			Transport: &http.Transport{
-				TLSClientConfig: tlsConfig,
-			},
-			Timeout: HTTPClientTimeout,
-		},
-	}, nil
+	return tlsConfig, nil
 }
 
 // InvokeAPI makes a REST call to the CSI Controller REST endpoint. The body must be a marshaled JSON byte array (
@@ -385,47 +396,74 @@ func (c *ControllerRestClient) UpdateVolumeLUKSPassphraseNames(
 	return nil
 }
 
-/*TODO (bpresnel) Enable with rate-limiting later?
+type getLogLevelResponse struct {
+	LogLevel string `json:"logLevel"`
+	Error    string `json:"error,omitempty"`
+}
+
+type getLoggingWorkflowsResponse struct {
+	LogWorkflows string `json:"logWorkflows"`
+	Error        string `json:"error,omitempty"`
+}
+
+type getLoggingLayersResponse struct {
+	LogLayers string `json:"logLayers"`
+	Error     string `json:"error,omitempty"`
+}
+
 // GetLoggingConfig retrieves the current logging configuration for Trident.
 func (c *ControllerRestClient) GetLoggingConfig(ctx context.Context) (string, string, string, error) {
 	urlFmt := "%s/%s"
 
 	logLevelUrl := fmt.Sprintf(urlFmt, config.LoggingConfigURL, "level")
-	resp, respBody, err := c.InvokeAPI(ctx, nil, "GET", logLevelUrl, false, false)
-	if err != nil || resp.StatusCode != http.StatusOK {
-		Logc(ctx).WithError(err).Error("Error getting controller's log level.")
-		return "", "", "", err
-	}
-	logLevelResp := &common.GetLogLevelResponse{}
-	if err = json.Unmarshal(respBody, logLevelResp); err != nil {
-		Logc(ctx).WithError(err).Error("Error getting unmarshalling GetLogLevel response.")
-		return "", "", "", err
+	logLevelResp := &getLogLevelResponse{}
+	if err := c.getLoggingConfigItem(ctx, logLevelUrl, logLevelResp); err != nil {
+		return "", "", "", fmt.Errorf("could not get the controller's log level; %v", err)
 	}
 
-	logWorkflowsUrl := fmt.Sprintf(urlFmt, config.LoggingConfigURL, "workflows")
-	resp, respBody, err = c.InvokeAPI(ctx, nil, "GET", logWorkflowsUrl, false, false)
-	if err != nil || resp.StatusCode != http.StatusOK {
-		Logc(ctx).WithError(err).Error("Error getting controller's selected logging workflows.")
-		return "", "", "", err
+	logWorkflowsUrl := fmt.Sprintf(urlFmt, config.LoggingConfigURL, "workflows/selected")
+	getWorkflowsResp := &getLoggingWorkflowsResponse{}
+	if err := c.getLoggingConfigItem(ctx, logWorkflowsUrl, getWorkflowsResp); err != nil {
+		return "", "", "", fmt.Errorf("could not get the controller's selected logging workflows; %v", err)
 	}
-	getWorkflowsResp := &common.GetLoggingWorkflowsResponse{}
-	if err = json.Unmarshal(respBody, getWorkflowsResp); err != nil {
-		Logc(ctx).WithError(err).Error("Error getting unmarshalling GetSelectedLoggingWorkflows response.")
-		return "", "", "", err
+
+	logLayersUrl := fmt.Sprintf(urlFmt, config.LoggingConfigURL, "layers/selected")
+	getLayersResp := &getLoggingLayersResponse{}
+	if err := c.getLoggingConfigItem(ctx, logLayersUrl, getLayersResp); err != nil {
+		return "", "", "", fmt.Errorf("could not get the controller's selected logging layers; %v", err)
 	}
 
-	logLayersUrl := fmt.Sprintf(urlFmt, config.LoggingConfigURL, "layers")
-	resp, respBody, err = c.InvokeAPI(ctx, nil, "GET", logLayersUrl, false, false)
-	if err != nil || resp.StatusCode != http.StatusOK {
-		Logc(ctx).WithError(err).Error("Error getting controller's selected logging layers.")
-		return "", "", "", err
+	return logLevelResp.LogLevel, getWorkflowsResp.LogWorkflows, getLayersResp.LogLayers, nil
+}
+
+func (c *ControllerRestClient) getLoggingConfigItem(ctx context.Context, url string, response interface{}) error {
+	resp, respBody, err := c.InvokeAPI(ctx, nil, "GET", url, false, false)
+	if err != nil {
+		return fmt.Errorf("could not communicate with the Trident CSI Controller: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected response status %s", resp.Status)
 	}
-	getLayersResp := &common.GetLoggingLayersResponse{}
-	if err = json.Unmarshal(respBody, getLayersResp); err != nil {
-		Logc(ctx).WithError(err).Error("Error getting unmarshalling GetSelectedLoggingLayers response.")
-		return "", "", "", err
+	if err = json.Unmarshal(respBody, response); err != nil {
+		return fmt.Errorf("could not parse response: %v", err)
 	}
+	return nil
+}
 
-	return logLevelResp.LogLevel, getWorkflowsResp.LogWorkflows, getLayersResp.LogLayers, nil
+// WatchNode is not offered by the REST API.
+func (c *ControllerRestClient) WatchNode(
+	_ context.Context, _ string,
+) (<-chan *controllerAPIv1.NodeEvent, error) {
+	return nil, errors.UnsupportedError("the REST API cannot watch nodes")
+}
+
+// ReportFreeze is not offered by the REST API, as filesystems are only frozen through node watches.
+func (c *ControllerRestClient) ReportFreeze(_ context.Context, _ *controllerAPIv1.ReportFreezeRequest) error {
+	return errors.UnsupportedError("the REST API cannot report filesystem freezes")
+}
+
+// ReportVolumeUsage is not offered by the REST API, as volumes are only grown automatically through the gRPC
+// control plane.
+func (c *ControllerRestClient) ReportVolumeUsage(_ context.Context, _ *controllerAPIv1.ReportVolumeUsageRequest) error {
+	return errors.UnsupportedError("the REST API cannot report volume usage")
 }
-*/
@@ -861,3 +861,32 @@ func TestRequestAndRetrySucceedsAfterTooManyRequests(t *testing.T) {
 	wg.Wait()
 	assert.NoError(t, err, "expected no error")
 }
+
+func TestGetLoggingConfig(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case config.LoggingConfigURL + "/level":
+			createResponse(w, getLogLevelResponse{LogLevel: "debug"}, http.StatusOK)
+		case config.LoggingConfigURL + "/workflows/selected":
+			createResponse(w, getLoggingWorkflowsResponse{LogWorkflows: "node=all"}, http.StatusOK)
+		case config.LoggingConfigURL + "/layers/selected":
+			createResponse(w, getLoggingLayersResponse{LogLayers: "csi_frontend"}, http.StatusOK)
+		default:
+			createResponse(w, "", http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	controllerRestClient := ControllerRestClient{url: server.URL, httpClient: *server.Client()}
+	logLevel, logWorkflows, logLayers, err := controllerRestClient.GetLoggingConfig(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "debug", logLevel)
+	assert.Equal(t, "node=all", logWorkflows)
+	assert.Equal(t, "csi_frontend", logLayers)
+
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		createResponse(w, getLogLevelResponse{Error: "not ready"}, http.StatusServiceUnavailable)
+	})
+	_, _, _, err = controllerRestClient.GetLoggingConfig(ctx)
+	assert.Error(t, err)
+}
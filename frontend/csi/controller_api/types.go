@@ -8,6 +8,7 @@ import (
 	"context"
 	"net/http"
 
+	controllerAPIv1 "github.com/netapp/trident/frontend/csi/controller_api/v1"
 	"github.com/netapp/trident/utils"
 )
 
@@ -24,6 +25,8 @@ type TridentController interface {
 	GetChap(ctx context.Context, volume, node string) (*utils.IscsiChapInfo, error)
 	UpdateVolumeLUKSPassphraseNames(ctx context.Context, volume string, passphraseNames []string) error
 	ListVolumePublicationsForNode(ctx context.Context, nodeName string) ([]*utils.VolumePublicationExternal, error)
-	// TODO (bpresnel) Enable later with rate-limiting?
-	// GetLoggingConfig(ctx context.Context) (string, string, string, error)
+	GetLoggingConfig(ctx context.Context) (string, string, string, error)
+	WatchNode(ctx context.Context, nodeName string) (<-chan *controllerAPIv1.NodeEvent, error)
+	ReportFreeze(ctx context.Context, report *controllerAPIv1.ReportFreezeRequest) error
+	ReportVolumeUsage(ctx context.Context, report *controllerAPIv1.ReportVolumeUsageRequest) error
 }
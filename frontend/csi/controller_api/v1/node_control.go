@@ -0,0 +1,378 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+// Package v1 defines version 1 of the gRPC control-plane API between Trident node plugins and the Trident
+// controller.  Messages are the JSON forms of the types already exchanged over the REST API, so the service
+// is described here directly rather than generated from a protobuf definition.
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+
+	"github.com/netapp/trident/utils"
+)
+
+const (
+	// ServiceName is the fully qualified name of the node control service
+	ServiceName = "trident.controller.v1.NodeControl"
+	// CodecName is the content subtype of the node control service's messages
+	CodecName = "json"
+
+	// RetryAfterKey is the trailer metadata key naming how long a caller rejected by flow control should wait
+	RetryAfterKey = "retry-after"
+)
+
+// FullMethodName returns the name by which gRPC identifies one of the service's methods.
+func FullMethodName(method string) string {
+	return "/" + ServiceName + "/" + method
+}
+
+func init() {
+	encoding.RegisterCodec(jsonCodec{})
+}
+
+// jsonCodec encodes messages as JSON.
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+func (jsonCodec) Name() string {
+	return CodecName
+}
+
+type Empty struct{}
+
+type NodeRequest struct {
+	Name string `json:"name"`
+}
+
+type RegisterNodeRequest struct {
+	Node *utils.Node `json:"node"`
+}
+
+type RegisterNodeResponse struct {
+	TopologyLabels map[string]string `json:"topologyLabels,omitempty"`
+	LoggingConfig
+}
+
+type GetNodeResponse struct {
+	Node *utils.NodeExternal `json:"node"`
+}
+
+type UpdateNodeRequest struct {
+	Name  string                           `json:"name"`
+	State *utils.NodePublicationStateFlags `json:"state"`
+}
+
+type GetCHAPRequest struct {
+	Volume string `json:"volume"`
+	Node   string `json:"node"`
+}
+
+type GetCHAPResponse struct {
+	CHAP *utils.IscsiChapInfo `json:"chap"`
+}
+
+type ListVolumePublicationsResponse struct {
+	VolumePublications []*utils.VolumePublicationExternal `json:"volumePublications"`
+}
+
+type UpdateVolumeLUKSPassphraseNamesRequest struct {
+	Volume          string   `json:"volume"`
+	PassphraseNames []string `json:"passphraseNames"`
+}
+
+type LoggingConfig struct {
+	LogLevel     string `json:"logLevel,omitempty"`
+	LogWorkflows string `json:"logWorkflows,omitempty"`
+	LogLayers    string `json:"logLayers,omitempty"`
+}
+
+// WatchNodeRequest asks for changes to a node and its volume publications after the specified event
+// sequence number, or from now on if it is zero.
+type WatchNodeRequest struct {
+	Name  string `json:"name"`
+	Since uint64 `json:"since,omitempty"`
+}
+
+// NodeEventType says what happened to the object named by a node event.
+type NodeEventType string
+
+const (
+	// NodeEventSync carries the current state of the node when a watch begins afresh
+	NodeEventSync    = NodeEventType("sync")
+	NodeEventAdded   = NodeEventType("added")
+	NodeEventUpdated = NodeEventType("updated")
+	NodeEventDeleted = NodeEventType("deleted")
+
+	// NodeEventFreeze asks the node to freeze the filesystem of a volume, and NodeEventThaw to thaw it
+	NodeEventFreeze = NodeEventType("freeze")
+	NodeEventThaw   = NodeEventType("thaw")
+)
+
+// NodeEvent reports a change to a node or to one of its volume publications.  Sequence is that of the
+// controller's event stream, so a watch may be resumed after the last event received.  Requests to freeze
+// or thaw a filesystem are not part of that stream and carry no sequence number.
+type NodeEvent struct {
+	Sequence          uint64                           `json:"sequence"`
+	Type              NodeEventType                    `json:"type"`
+	Node              *utils.NodeExternal              `json:"node,omitempty"`
+	VolumePublication *utils.VolumePublicationExternal `json:"volumePublication,omitempty"`
+	Freeze            *FilesystemFreeze                `json:"freeze,omitempty"`
+}
+
+// FilesystemFreeze names the volume whose filesystem a node is asked to freeze or thaw.  A node thaws the
+// filesystem by itself once the timeout passes, whether or not it is asked to.
+type FilesystemFreeze struct {
+	ID      string        `json:"id"`
+	Volume  string        `json:"volume"`
+	Timeout time.Duration `json:"timeout,omitempty"`
+}
+
+// ReportFreezeRequest reports whether a node froze the filesystem it was asked to freeze.
+type ReportFreezeRequest struct {
+	ID     string `json:"id"`
+	Node   string `json:"node"`
+	Volume string `json:"volume"`
+	Error  string `json:"error,omitempty"`
+}
+
+// ReportVolumeUsageRequest reports the space used on the filesystems of the volumes published to a node.
+type ReportVolumeUsageRequest struct {
+	Node    string        `json:"node"`
+	Volumes []VolumeUsage `json:"volumes"`
+}
+
+// VolumeUsage is the space used on a volume's filesystem, as seen by a node.
+type VolumeUsage struct {
+	Volume     string `json:"volume"`
+	UsedBytes  int64  `json:"usedBytes"`
+	TotalBytes int64  `json:"totalBytes"`
+}
+
+// NodeControlServer is the server API for the node control service.
+type NodeControlServer interface {
+	RegisterNode(context.Context, *RegisterNodeRequest) (*RegisterNodeResponse, error)
+	GetNode(context.Context, *NodeRequest) (*GetNodeResponse, error)
+	UpdateNode(context.Context, *UpdateNodeRequest) (*Empty, error)
+	GetCHAP(context.Context, *GetCHAPRequest) (*GetCHAPResponse, error)
+	ListVolumePublicationsForNode(context.Context, *NodeRequest) (*ListVolumePublicationsResponse, error)
+	UpdateVolumeLUKSPassphraseNames(context.Context, *UpdateVolumeLUKSPassphraseNamesRequest) (*Empty, error)
+	GetLoggingConfig(context.Context, *Empty) (*LoggingConfig, error)
+	WatchNode(*WatchNodeRequest, WatchNodeServer) error
+	ReportFreeze(context.Context, *ReportFreezeRequest) (*Empty, error)
+	ReportVolumeUsage(context.Context, *ReportVolumeUsageRequest) (*Empty, error)
+}
+
+// WatchNodeServer is the server side of a WatchNode stream.
+type WatchNodeServer interface {
+	Send(*NodeEvent) error
+	grpc.ServerStream
+}
+
+type watchNodeServer struct {
+	grpc.ServerStream
+}
+
+func (s *watchNodeServer) Send(event *NodeEvent) error {
+	return s.ServerStream.SendMsg(event)
+}
+
+// RegisterNodeControlServer registers an implementation of the node control service with a gRPC server.
+func RegisterNodeControlServer(s grpc.ServiceRegistrar, srv NodeControlServer) {
+	s.RegisterService(&ServiceDesc, srv)
+}
+
+// ServiceDesc describes the node control service to gRPC.
+var ServiceDesc = grpc.ServiceDesc{
+	ServiceName: ServiceName,
+	HandlerType: (*NodeControlServer)(nil),
+	Methods: []grpc.MethodDesc{
+		unaryMethod("RegisterNode", NodeControlServer.RegisterNode),
+		unaryMethod("GetNode", NodeControlServer.GetNode),
+		unaryMethod("UpdateNode", NodeControlServer.UpdateNode),
+		unaryMethod("GetCHAP", NodeControlServer.GetCHAP),
+		unaryMethod("ListVolumePublicationsForNode", NodeControlServer.ListVolumePublicationsForNode),
+		unaryMethod("UpdateVolumeLUKSPassphraseNames", NodeControlServer.UpdateVolumeLUKSPassphraseNames),
+		unaryMethod("GetLoggingConfig", NodeControlServer.GetLoggingConfig),
+		unaryMethod("ReportFreeze", NodeControlServer.ReportFreeze),
+		unaryMethod("ReportVolumeUsage", NodeControlServer.ReportVolumeUsage),
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName: "WatchNode",
+			Handler: func(srv interface{}, stream grpc.ServerStream) error {
+				request := new(WatchNodeRequest)
+				if err := stream.RecvMsg(request); err != nil {
+					return err
+				}
+				return srv.(NodeControlServer).WatchNode(request, &watchNodeServer{stream})
+			},
+			ServerStreams: true,
+		},
+	},
+}
+
+// unaryMethod describes a unary method, decoding its request and passing it through any interceptor.
+func unaryMethod[Request, Response any](
+	name string, call func(NodeControlServer, context.Context, *Request) (*Response, error),
+) grpc.MethodDesc {
+	return grpc.MethodDesc{
+		MethodName: name,
+		Handler: func(
+			srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor,
+		) (interface{}, error) {
+			request := new(Request)
+			if err := dec(request); err != nil {
+				return nil, err
+			}
+			if interceptor == nil {
+				return call(srv.(NodeControlServer), ctx, request)
+			}
+			info := &grpc.UnaryServerInfo{Server: srv, FullMethod: FullMethodName(name)}
+			handler := func(ctx context.Context, request interface{}) (interface{}, error) {
+				return call(srv.(NodeControlServer), ctx, request.(*Request))
+			}
+			return interceptor(ctx, request, info, handler)
+		},
+	}
+}
+
+// NodeControlClient is the client API for the node control service.
+type NodeControlClient interface {
+	RegisterNode(context.Context, *RegisterNodeRequest, ...grpc.CallOption) (*RegisterNodeResponse, error)
+	GetNode(context.Context, *NodeRequest, ...grpc.CallOption) (*GetNodeResponse, error)
+	UpdateNode(context.Context, *UpdateNodeRequest, ...grpc.CallOption) (*Empty, error)
+	GetCHAP(context.Context, *GetCHAPRequest, ...grpc.CallOption) (*GetCHAPResponse, error)
+	ListVolumePublicationsForNode(
+		context.Context, *NodeRequest, ...grpc.CallOption,
+	) (*ListVolumePublicationsResponse, error)
+	UpdateVolumeLUKSPassphraseNames(
+		context.Context, *UpdateVolumeLUKSPassphraseNamesRequest, ...grpc.CallOption,
+	) (*Empty, error)
+	GetLoggingConfig(context.Context, *Empty, ...grpc.CallOption) (*LoggingConfig, error)
+	WatchNode(context.Context, *WatchNodeRequest, ...grpc.CallOption) (WatchNodeClient, error)
+	ReportFreeze(context.Context, *ReportFreezeRequest, ...grpc.CallOption) (*Empty, error)
+	ReportVolumeUsage(context.Context, *ReportVolumeUsageRequest, ...grpc.CallOption) (*Empty, error)
+}
+
+// WatchNodeClient is the client side of a WatchNode stream.
+type WatchNodeClient interface {
+	Recv() (*NodeEvent, error)
+	grpc.ClientStream
+}
+
+type watchNodeClient struct {
+	grpc.ClientStream
+}
+
+func (c *watchNodeClient) Recv() (*NodeEvent, error) {
+	event := new(NodeEvent)
+	if err := c.ClientStream.RecvMsg(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+type nodeControlClient struct {
+	cc grpc.ClientConnInterface
+}
+
+// NewNodeControlClient returns a client of the node control service.  Its calls use the service's codec.
+func NewNodeControlClient(cc grpc.ClientConnInterface) NodeControlClient {
+	return &nodeControlClient{cc: cc}
+}
+
+// invoke makes a unary call with the service's codec.
+func invoke[Response any](
+	ctx context.Context, cc grpc.ClientConnInterface, method string, request interface{}, opts []grpc.CallOption,
+) (*Response, error) {
+	response := new(Response)
+	opts = append([]grpc.CallOption{grpc.CallContentSubtype(CodecName)}, opts...)
+	if err := cc.Invoke(ctx, FullMethodName(method), request, response, opts...); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *nodeControlClient) RegisterNode(
+	ctx context.Context, in *RegisterNodeRequest, opts ...grpc.CallOption,
+) (*RegisterNodeResponse, error) {
+	return invoke[RegisterNodeResponse](ctx, c.cc, "RegisterNode", in, opts)
+}
+
+func (c *nodeControlClient) GetNode(
+	ctx context.Context, in *NodeRequest, opts ...grpc.CallOption,
+) (*GetNodeResponse, error) {
+	return invoke[GetNodeResponse](ctx, c.cc, "GetNode", in, opts)
+}
+
+func (c *nodeControlClient) UpdateNode(
+	ctx context.Context, in *UpdateNodeRequest, opts ...grpc.CallOption,
+) (*Empty, error) {
+	return invoke[Empty](ctx, c.cc, "UpdateNode", in, opts)
+}
+
+func (c *nodeControlClient) GetCHAP(
+	ctx context.Context, in *GetCHAPRequest, opts ...grpc.CallOption,
+) (*GetCHAPResponse, error) {
+	return invoke[GetCHAPResponse](ctx, c.cc, "GetCHAP", in, opts)
+}
+
+func (c *nodeControlClient) ListVolumePublicationsForNode(
+	ctx context.Context, in *NodeRequest, opts ...grpc.CallOption,
+) (*ListVolumePublicationsResponse, error) {
+	return invoke[ListVolumePublicationsResponse](ctx, c.cc, "ListVolumePublicationsForNode", in, opts)
+}
+
+func (c *nodeControlClient) UpdateVolumeLUKSPassphraseNames(
+	ctx context.Context, in *UpdateVolumeLUKSPassphraseNamesRequest, opts ...grpc.CallOption,
+) (*Empty, error) {
+	return invoke[Empty](ctx, c.cc, "UpdateVolumeLUKSPassphraseNames", in, opts)
+}
+
+func (c *nodeControlClient) GetLoggingConfig(
+	ctx context.Context, in *Empty, opts ...grpc.CallOption,
+) (*LoggingConfig, error) {
+	return invoke[LoggingConfig](ctx, c.cc, "GetLoggingConfig", in, opts)
+}
+
+func (c *nodeControlClient) WatchNode(
+	ctx context.Context, in *WatchNodeRequest, opts ...grpc.CallOption,
+) (WatchNodeClient, error) {
+	opts = append([]grpc.CallOption{grpc.CallContentSubtype(CodecName)}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ServiceDesc.Streams[0], FullMethodName("WatchNode"), opts...)
+	if err != nil {
+		return nil, err
+	}
+	client := &watchNodeClient{stream}
+	if err = client.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err = client.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+func (c *nodeControlClient) ReportFreeze(
+	ctx context.Context, in *ReportFreezeRequest, opts ...grpc.CallOption,
+) (*Empty, error) {
+	return invoke[Empty](ctx, c.cc, "ReportFreeze", in, opts)
+}
+
+func (c *nodeControlClient) ReportVolumeUsage(
+	ctx context.Context, in *ReportVolumeUsageRequest, opts ...grpc.CallOption,
+) (*Empty, error) {
+	return invoke[Empty](ctx, c.cc, "ReportVolumeUsage", in, opts)
+}
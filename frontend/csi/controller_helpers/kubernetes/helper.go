@@ -4,6 +4,7 @@ package kubernetes
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -14,6 +15,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 
 	"github.com/netapp/trident/config"
 	frontendcommon "github.com/netapp/trident/frontend/common"
@@ -22,6 +24,7 @@ import (
 	. "github.com/netapp/trident/logging"
 	netappv1 "github.com/netapp/trident/persistent_store/crd/apis/netapp/v1"
 	"github.com/netapp/trident/storage"
+	storageclass "github.com/netapp/trident/storage_class"
 	"github.com/netapp/trident/utils"
 	"github.com/netapp/trident/utils/errors"
 )
@@ -409,6 +412,15 @@ func (h *helper) matchNamespaceToAnnotation(namespace, shareToAnnotation string)
 	return false
 }
 
+// GetStorageClassConfig accepts the storage class parameters passed by the CSI provisioner
+// with a capacity request and returns the equivalent Trident storage class config.  The
+// storage class is not registered with the orchestrator.
+func (h *helper) GetStorageClassConfig(
+	ctx context.Context, parameters map[string]string,
+) (*storageclass.Config, error) {
+	return getStorageClassConfigFromParameters(ctx, "", parameters)
+}
+
 // GetSnapshotConfigForCreate accepts the attributes of a snapshot being requested by the CSI
 // provisioner and returns a SnapshotConfig structure as needed by Trident to create a new snapshot.
 func (h *helper) GetSnapshotConfigForCreate(volumeName, snapshotName string) (*storage.SnapshotConfig, error) {
@@ -519,6 +531,49 @@ func (h *helper) RecordVolumeEvent(ctx context.Context, name, eventType, reason,
 	}
 }
 
+// ExpandVolume asks Kubernetes to expand a CSI volume (i.e. a PV) by raising the storage requested by its PVC.
+// The external resizer then resizes the volume through Trident and records the new capacity on the PV and PVC,
+// just as if a user had expanded the PVC.
+func (h *helper) ExpandVolume(ctx context.Context, name string, sizeBytes uint64) error {
+	pvc, err := h.getPVCForCSIVolume(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	newSize := resource.NewQuantity(int64(sizeBytes), resource.BinarySI)
+	if requested, ok := pvc.Spec.Resources.Requests[v1.ResourceStorage]; ok && requested.Cmp(*newSize) >= 0 {
+		Logc(ctx).WithFields(LogFields{
+			"name":      name,
+			"requested": requested.String(),
+		}).Debug("PVC already requests the expanded size.")
+		return nil
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"resources": map[string]interface{}{
+				"requests": map[string]string{string(v1.ResourceStorage): newSize.String()},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if _, err = h.kubeClient.CoreV1().PersistentVolumeClaims(pvc.Namespace).Patch(ctx, pvc.Name,
+		k8stypes.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("could not expand PVC %s/%s; %v", pvc.Namespace, pvc.Name, err)
+	}
+
+	Logc(ctx).WithFields(LogFields{
+		"name":      name,
+		"pvc":       pvc.Name,
+		"namespace": pvc.Namespace,
+		"size":      newSize.String(),
+	}).Info("Requested PVC expansion.")
+
+	return nil
+}
+
 // RecordNodeEvent accepts the name of a CSI volume (i.e. a PV name), finds the associated
 // PVC, and posts and event message on the PVC object with the K8S API server.
 func (h *helper) RecordNodeEvent(ctx context.Context, name, eventType, reason, message string) {
@@ -621,6 +676,11 @@ func getVolumeConfig(
 		}
 	}
 
+	autogrow, err := getAutogrowPolicyFromParameters(storageClass.Parameters)
+	if err != nil {
+		Logc(ctx).WithError(err).Warning("Unable to parse autogrow policy from storage class.")
+	}
+
 	return &storage.VolumeConfig{
 		Name:                name,
 		Size:                fmt.Sprintf("%d", size.Value()),
@@ -646,6 +706,7 @@ func getVolumeConfig(
 		PreferredTopologies: preferredTopology,
 		Namespace:           pvc.Namespace,
 		RequestName:         pvc.Name,
+		Autogrow:            autogrow,
 	}
 }
 
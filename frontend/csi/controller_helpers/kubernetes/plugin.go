@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -976,12 +977,46 @@ func removeSCParameterPrefix(key string) string {
 func (h *helper) processAddedStorageClass(ctx context.Context, sc *k8sstoragev1.StorageClass) {
 	Logc(ctx).Trace(">>>> processAddedStorageClass")
 	defer Logc(ctx).Trace("<<<< processAddedStorageClass")
+
+	scConfig, err := getStorageClassConfigFromParameters(ctx, sc.Name, sc.Parameters)
+	if err != nil {
+		Logc(ctx).WithFields(LogFields{
+			"name":        sc.Name,
+			"provisioner": sc.Provisioner,
+			"parameters":  sc.Parameters,
+			"error":       err,
+		}).Error("K8S helper could not process the storage class parameters.")
+		return
+	}
+
+	// Add the storage class
+	if _, err := h.orchestrator.AddStorageClass(ctx, scConfig); err != nil {
+		Logc(ctx).WithFields(LogFields{
+			"name":        sc.Name,
+			"provisioner": sc.Provisioner,
+			"parameters":  sc.Parameters,
+		}).Warningf("K8S helper could not add a storage class: %s", err)
+		return
+	}
+
+	Logc(ctx).WithFields(LogFields{
+		"name":        sc.Name,
+		"provisioner": sc.Provisioner,
+		"parameters":  sc.Parameters,
+	}).Trace("K8S helper added a storage class.")
+}
+
+// getStorageClassConfigFromParameters converts the parameters of a Kubernetes storage class into
+// a Trident storage class config.  An error is returned if any attribute is invalid.
+func getStorageClassConfigFromParameters(
+	ctx context.Context, name string, parameters map[string]string,
+) (*storageclass.Config, error) {
 	scConfig := new(storageclass.Config)
-	scConfig.Name = sc.Name
+	scConfig.Name = name
 	scConfig.Attributes = make(map[string]storageattribute.Request)
 
 	// Populate storage class config attributes and backend storage pools
-	for k, v := range sc.Parameters {
+	for k, v := range parameters {
 
 		// Ignore Kubernetes-defined storage class parameters handled by CSI
 		if strings.HasPrefix(k, CSIParameterPrefix) || k == K8sFsType {
@@ -996,10 +1031,9 @@ func (h *helper) processAddedStorageClass(ctx context.Context, sc *k8sstoragev1.
 			additionalPools, err := storageattribute.CreateBackendStoragePoolsMapFromEncodedString(v)
 			if err != nil {
 				Logc(ctx).WithFields(LogFields{
-					"name":        sc.Name,
-					"provisioner": sc.Provisioner,
-					"parameters":  sc.Parameters,
-					"error":       err,
+					"name":       name,
+					"parameters": parameters,
+					"error":      err,
 				}).Errorf("K8S helper could not process the storage class parameter %s", newKey)
 			}
 			scConfig.AdditionalPools = additionalPools
@@ -1009,10 +1043,9 @@ func (h *helper) processAddedStorageClass(ctx context.Context, sc *k8sstoragev1.
 			excludeStoragePools, err := storageattribute.CreateBackendStoragePoolsMapFromEncodedString(v)
 			if err != nil {
 				Logc(ctx).WithFields(LogFields{
-					"name":        sc.Name,
-					"provisioner": sc.Provisioner,
-					"parameters":  sc.Parameters,
-					"error":       err,
+					"name":       name,
+					"parameters": parameters,
+					"error":      err,
 				}).Errorf("K8S helper could not process the storage class parameter %s", newKey)
 			}
 			scConfig.ExcludePools = excludeStoragePools
@@ -1022,45 +1055,59 @@ func (h *helper) processAddedStorageClass(ctx context.Context, sc *k8sstoragev1.
 			pools, err := storageattribute.CreateBackendStoragePoolsMapFromEncodedString(v)
 			if err != nil {
 				Logc(ctx).WithFields(LogFields{
-					"name":        sc.Name,
-					"provisioner": sc.Provisioner,
-					"parameters":  sc.Parameters,
-					"error":       err,
+					"name":       name,
+					"parameters": parameters,
+					"error":      err,
 				}).Errorf("K8S helper could not process the storage class parameter %s", newKey)
 			}
 			scConfig.Pools = pools
 
+		case storageattribute.PlacementStrategy:
+			// format:  placementStrategy: "leastUsed"
+			scConfig.Placement = v
+
+		case storageattribute.FreezeFilesystem:
+			// format:  freezeFilesystem: "true"
+			freezeFS, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil, fmt.Errorf("could not process the storage class parameter %s; %v", newKey, err)
+			}
+			scConfig.FreezeFS = freezeFS
+
+		case storageattribute.AutogrowThreshold, storageattribute.AutogrowIncrement, storageattribute.AutogrowMaxSize:
+			// Stored with each volume of the storage class, see getAutogrowPolicyFromParameters
+
 		default:
 			// format:  attribute: "value"
 			req, err := storageattribute.CreateAttributeRequestFromAttributeValue(newKey, v)
 			if err != nil {
-				Logc(ctx).WithFields(LogFields{
-					"name":        sc.Name,
-					"provisioner": sc.Provisioner,
-					"parameters":  sc.Parameters,
-					"error":       err,
-				}).Errorf("K8S helper could not process the storage class attribute %s", newKey)
-				return
+				return nil, fmt.Errorf("could not process the storage class attribute %s; %v", newKey, err)
 			}
 			scConfig.Attributes[newKey] = req
 		}
 	}
 
-	// Add the storage class
-	if _, err := h.orchestrator.AddStorageClass(ctx, scConfig); err != nil {
-		Logc(ctx).WithFields(LogFields{
-			"name":        sc.Name,
-			"provisioner": sc.Provisioner,
-			"parameters":  sc.Parameters,
-		}).Warningf("K8S helper could not add a storage class: %s", err)
-		return
+	if _, err := getAutogrowPolicyFromParameters(parameters); err != nil {
+		return nil, fmt.Errorf("could not process the storage class autogrow policy; %v", err)
 	}
 
-	Logc(ctx).WithFields(LogFields{
-		"name":        sc.Name,
-		"provisioner": sc.Provisioner,
-		"parameters":  sc.Parameters,
-	}).Trace("K8S helper added a storage class.")
+	return scConfig, nil
+}
+
+// getAutogrowPolicyFromParameters returns the autogrow policy set by a storage class's parameters, if any.
+func getAutogrowPolicyFromParameters(parameters map[string]string) (*storage.AutogrowPolicy, error) {
+	var threshold, increment, maxSize string
+	for k, v := range parameters {
+		switch removeSCParameterPrefix(k) {
+		case storageattribute.AutogrowThreshold:
+			threshold = v
+		case storageattribute.AutogrowIncrement:
+			increment = v
+		case storageattribute.AutogrowMaxSize:
+			maxSize = v
+		}
+	}
+	return storage.NewAutogrowPolicy(threshold, increment, maxSize)
 }
 
 // processDeletedStorageClass informs the orchestrator of a deleted storage class.
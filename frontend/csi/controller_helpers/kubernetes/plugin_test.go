@@ -18,10 +18,12 @@ import (
 	v1 "k8s.io/api/core/v1"
 	k8sstoragev1 "k8s.io/api/storage/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
 	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
 
 	"github.com/netapp/trident/config"
 	"github.com/netapp/trident/frontend/csi"
@@ -641,6 +643,60 @@ func TestProcessAddedStorageClass_CreateAttributeRequestFromAttributeValue_Failu
 	}
 }
 
+func TestGetStorageClassConfig(t *testing.T) {
+	_, plugin := newMockPlugin(t)
+	ctx := context.TODO()
+
+	parameters := map[string]string{
+		"csi.storage.k8s.io/fstype":           "ext4",
+		"trident.netapp.io/backendType":       "ontap-nas",
+		"storagePools":                        "backend1:pool1",
+		"trident.netapp.io/placementStrategy": "leastUsed",
+		"trident.netapp.io/freezeFilesystem":  "true",
+		"trident.netapp.io/autogrowThreshold": "80",
+	}
+
+	scConfig, err := plugin.GetStorageClassConfig(ctx, parameters)
+	assert.NoError(t, err)
+	assert.Equal(t, "", scConfig.Name)
+	assert.Equal(t, map[string][]string{"backend1": {"pool1"}}, scConfig.Pools)
+	assert.Equal(t, "leastUsed", scConfig.Placement)
+	assert.True(t, scConfig.FreezeFS)
+	assert.Len(t, scConfig.Attributes, 1)
+	assert.Contains(t, scConfig.Attributes, "backendType")
+
+	parameters["IOPS"] = "10.52"
+	_, err = plugin.GetStorageClassConfig(ctx, parameters)
+	assert.Error(t, err)
+
+	delete(parameters, "IOPS")
+	parameters["trident.netapp.io/freezeFilesystem"] = "sometimes"
+	_, err = plugin.GetStorageClassConfig(ctx, parameters)
+	assert.Error(t, err)
+
+	parameters["trident.netapp.io/freezeFilesystem"] = "true"
+	parameters["trident.netapp.io/autogrowThreshold"] = "120"
+	_, err = plugin.GetStorageClassConfig(ctx, parameters)
+	assert.Error(t, err)
+}
+
+func TestGetAutogrowPolicyFromParameters(t *testing.T) {
+	policy, err := getAutogrowPolicyFromParameters(map[string]string{"backendType": "ontap-nas"})
+	assert.NoError(t, err)
+	assert.Nil(t, policy)
+
+	policy, err = getAutogrowPolicyFromParameters(map[string]string{
+		"trident.netapp.io/autogrowThreshold": "85%",
+		"autogrowIncrement":                   "2Gi",
+		"trident.netapp.io/autogrowMaxSize":   "50Gi",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, &storage.AutogrowPolicy{ThresholdPercent: 85, Increment: "2Gi", MaxSize: "50Gi"}, policy)
+
+	_, err = getAutogrowPolicyFromParameters(map[string]string{"autogrowMaxSize": "50Gi"})
+	assert.Error(t, err)
+}
+
 func TestListVolumeAttachments(t *testing.T) {
 	ctx := context.Background()
 	volume := "bar"
@@ -1408,3 +1464,36 @@ func TestValidateStorageClassParameters(t *testing.T) {
 		})
 	}
 }
+
+func TestExpandVolume(t *testing.T) {
+	ctx := context.Background()
+	pvc := &v1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "data",
+			Namespace: "app",
+			UID:       "e9748b6b-8240-4fd8-97bc-868bf064ecd4",
+		},
+		Spec: v1.PersistentVolumeClaimSpec{
+			Resources: v1.ResourceRequirements{
+				Requests: v1.ResourceList{v1.ResourceStorage: resource.MustParse("1Gi")},
+			},
+		},
+	}
+	pvcIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{uidIndex: MetaUIDKeyFunc})
+	assert.NoError(t, pvcIndexer.Add(pvc))
+	kubeClient := k8sfake.NewSimpleClientset(pvc)
+	h := &helper{kubeClient: kubeClient, pvcIndexer: pvcIndexer}
+
+	// The PVC is expanded, leaving the external resizer to resize the volume
+	err := h.ExpandVolume(ctx, "pvc-e9748b6b-8240-4fd8-97bc-868bf064ecd4", 2147483648)
+	assert.NoError(t, err)
+	expanded, err := kubeClient.CoreV1().PersistentVolumeClaims("app").Get(ctx, "data", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "2Gi", expanded.Spec.Resources.Requests.Storage().String())
+
+	// PVCs already requesting as much are left alone
+	kubeClient.ClearActions()
+	err = h.ExpandVolume(ctx, "pvc-e9748b6b-8240-4fd8-97bc-868bf064ecd4", 1073741824)
+	assert.NoError(t, err)
+	assert.Empty(t, kubeClient.Actions())
+}
@@ -16,6 +16,7 @@ import (
 	controllerhelpers "github.com/netapp/trident/frontend/csi/controller_helpers"
 	. "github.com/netapp/trident/logging"
 	"github.com/netapp/trident/storage"
+	storageclass "github.com/netapp/trident/storage_class"
 	"github.com/netapp/trident/utils"
 )
 
@@ -97,6 +98,14 @@ func (h *helper) GetVolumeConfig(
 		volumeMode, requisiteTopology, preferredTopology)
 }
 
+// GetStorageClassConfig accepts the parameters of a capacity request from the CSI
+// provisioner and returns a matching storage class config without registering it.
+func (h *helper) GetStorageClassConfig(
+	ctx context.Context, parameters map[string]string,
+) (*storageclass.Config, error) {
+	return frontendcommon.MakeStorageClassConfig(ctx, parameters)
+}
+
 // GetSnapshotConfigForCreate accepts the attributes of a snapshot being requested by the CSI
 // provisioner and returns a SnapshotConfig structure as needed by Trident to create a new snapshot.
 func (h *helper) GetSnapshotConfigForCreate(volumeName, snapshotName string) (*storage.SnapshotConfig, error) {
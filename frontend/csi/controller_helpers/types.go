@@ -9,6 +9,7 @@ import (
 
 	"github.com/netapp/trident/config"
 	"github.com/netapp/trident/storage"
+	storageclass "github.com/netapp/trident/storage_class"
 	"github.com/netapp/trident/utils"
 )
 
@@ -16,6 +17,9 @@ const (
 	KubernetesHelper = "k8s_csi_helper"
 	PlainCSIHelper   = "plain_csi_helper"
 
+	// ControlPlaneFrontend is the name of the frontend through which the controller reaches node plugins
+	ControlPlaneFrontend = "gRPC control plane"
+
 	EventTypeNormal  = "Normal"
 	EventTypeWarning = "Warning"
 )
@@ -35,6 +39,11 @@ type ControllerHelper interface {
 		requisiteTopology, preferredTopology, accessibleTopology []map[string]string,
 	) (*storage.VolumeConfig, error)
 
+	// GetStorageClassConfig accepts the storage class parameters passed by the CSI provisioner and
+	// returns a storage class config suitable for matching storage pools.  The storage class is not
+	// registered with the orchestrator.
+	GetStorageClassConfig(ctx context.Context, parameters map[string]string) (*storageclass.Config, error)
+
 	// GetSnapshotConfigForCreate accepts the attributes of a snapshot being requested by the CSI
 	// provisioner, adds in any CO-specific details about the new snapshot, and returns
 	// a SnapshotConfig structure as needed by Trident to create a new snapshot.
@@ -72,3 +81,11 @@ type ControllerHelper interface {
 	// CSI version in the plain-CSI case.  This value is reported in Trident's telemetry.
 	Version() string
 }
+
+// VolumeExpander is implemented by helpers for container orchestrators that track the size of each volume, so
+// that volumes grown by Trident itself are expanded through the container orchestrator, which records the new
+// size, rather than resized behind its back.
+type VolumeExpander interface {
+	// ExpandVolume asks the container orchestrator to expand the named CSI volume to the specified size in bytes.
+	ExpandVolume(ctx context.Context, name string, sizeBytes uint64) error
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/container-storage-interface/spec/lib/go/csi"
 	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/golang/protobuf/ptypes/wrappers"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
@@ -602,6 +603,13 @@ func (p *Plugin) ListVolumes(
 		return nil, p.getCSIErrorForOrchestratorError(err)
 	}
 
+	// Volume health is reported from the core's cache, since checking each volume here would be too slow
+	volumeHealth, err := p.orchestrator.ListVolumeHealth(ctx)
+	if err != nil {
+		Logc(ctx).WithError(err).Warning("Could not list volume health.")
+		volumeHealth = make(map[string]*storage.VolumeHealth)
+	}
+
 	entries := make([]*csi.ListVolumesResponse_Entry, 0)
 	maxPageEntries := int(req.MaxEntries)
 	encounteredStartingToken := req.StartingToken == ""
@@ -628,18 +636,13 @@ func (p *Plugin) ListVolumes(
 			if csiVolume, err := p.getCSIVolumeFromTridentVolume(ctx, volume); err == nil {
 				entry := &csi.ListVolumesResponse_Entry{Volume: csiVolume}
 				// We must always include the volume status when we report LIST_VOLUMES_PUBLISHED_NODES capability
-				entry.Status = &csi.ListVolumesResponse_VolumeStatus{
-					PublishedNodeIds: []string{},
-				}
-				// Find all the nodes to which this volume has been published
-				publications, err := p.orchestrator.ListVolumePublicationsForVolume(ctx, csiVolume.VolumeId)
+				publishedNodeIDs, err := p.getPublishedNodeIDs(ctx, csiVolume.VolumeId)
 				if err != nil {
-					msg := fmt.Sprintf("error listing volume publications for volume %s", csiVolume.VolumeId)
-					Logc(ctx).WithError(err).Error(msg)
-					return nil, status.Error(codes.Internal, msg)
+					return nil, err
 				}
-				for _, publication := range publications {
-					entry.Status.PublishedNodeIds = append(entry.Status.PublishedNodeIds, publication.NodeName)
+				entry.Status = &csi.ListVolumesResponse_VolumeStatus{
+					PublishedNodeIds: publishedNodeIDs,
+					VolumeCondition:  getCSIVolumeCondition(volumeHealth[volume.Config.Name]),
 				}
 				entries = append(entries, entry)
 			}
@@ -652,9 +655,81 @@ func (p *Plugin) ListVolumes(
 	return &csi.ListVolumesResponse{Entries: entries, NextToken: nextToken}, nil
 }
 
-func (p *Plugin) GetCapacity(_ context.Context, _ *csi.GetCapacityRequest) (*csi.GetCapacityResponse, error) {
-	// Trident doesn't report pool capacities
-	return nil, status.Error(codes.Unimplemented, "")
+func (p *Plugin) GetCapacity(
+	ctx context.Context, req *csi.GetCapacityRequest,
+) (*csi.GetCapacityResponse, error) {
+	ctx = SetContextWorkflow(ctx, WorkflowControllerGetCapacity)
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCSIFrontend)
+
+	fields := LogFields{"Method": "GetCapacity", "Type": "CSI_Controller"}
+	Logc(ctx).WithFields(fields).Trace(">>>> GetCapacity")
+	defer Logc(ctx).WithFields(fields).Trace("<<<< GetCapacity")
+
+	// Translate the volume capabilities into a protocol, as is done for CreateVolume
+	volumeMode := tridentconfig.Filesystem
+	for _, capability := range req.GetVolumeCapabilities() {
+		if capability.GetBlock() != nil {
+			volumeMode = tridentconfig.RawBlock
+		}
+	}
+
+	var isBlockProtocol, isFileProtocol bool
+	for _, capability := range req.GetVolumeCapabilities() {
+		switch p.getProtocolForCSIAccessMode(capability.GetAccessMode().GetMode(), volumeMode) {
+		case tridentconfig.Block:
+			isBlockProtocol = true
+		case tridentconfig.File:
+			isFileProtocol = true
+		}
+	}
+
+	protocol := tridentconfig.ProtocolAny
+	if isBlockProtocol && isFileProtocol {
+		return nil, status.Error(codes.InvalidArgument,
+			"specified volume capabilities translate to both file and block protocols")
+	} else if isBlockProtocol {
+		protocol = tridentconfig.Block
+	} else if isFileProtocol {
+		protocol = tridentconfig.File
+	}
+
+	// Build a transient storage class from the request parameters
+	scConfig, err := p.controllerHelper.GetStorageClassConfig(ctx, req.GetParameters())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid storage class parameters; %v", err)
+	}
+
+	topology := req.GetAccessibleTopology().GetSegments()
+
+	capacity, err := p.orchestrator.GetStorageCapacity(ctx, scConfig, protocol, topology)
+	if err != nil {
+		if errors.IsUnsupportedError(err) {
+			// GET_CAPACITY is advertised, so report that no capacity is known to be available rather than failing
+			Logc(ctx).WithError(err).Debug("No matching storage pools report their capacity.")
+			return &csi.GetCapacityResponse{AvailableCapacity: 0}, nil
+		}
+		return nil, p.getCSIErrorForOrchestratorError(err)
+	}
+
+	Logc(ctx).WithFields(LogFields{
+		"protocol":           protocol,
+		"topology":           topology,
+		"availableBytes":     capacity.AvailableBytes,
+		"maximumVolumeBytes": capacity.MaximumVolumeBytes,
+	}).Debug("Calculated available capacity.")
+
+	return &csi.GetCapacityResponse{
+		AvailableCapacity: capacityToInt64(capacity.AvailableBytes),
+		MaximumVolumeSize: &wrappers.Int64Value{Value: capacityToInt64(capacity.MaximumVolumeBytes)},
+	}, nil
+}
+
+// capacityToInt64 converts a byte count to the signed type used by CSI, saturating rather than overflowing.
+func capacityToInt64(bytes uint64) int64 {
+	if bytes > math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return int64(bytes)
 }
 
 func (p *Plugin) ControllerGetCapabilities(
@@ -725,6 +800,24 @@ func (p *Plugin) CreateSnapshot(
 		return nil, p.getCSIErrorForOrchestratorError(err)
 	}
 
+	// Freeze the volume's filesystem while it is snapshotted, if asked to
+	freeze, err := p.shouldFreezeFilesystem(ctx, volumeName, req.GetParameters())
+	if err != nil {
+		if errors.IsInvalidInputError(err) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, p.getCSIErrorForOrchestratorError(err)
+	}
+	var freezeDeadline time.Time
+	if freeze {
+		var thaw func(context.Context)
+		thaw, freezeDeadline, err = p.freezeFilesystem(ctx, volumeName)
+		if err != nil {
+			return nil, status.Errorf(codes.Aborted, "could not freeze filesystem; %v", err)
+		}
+		defer thaw(ctx)
+	}
+
 	// Create the snapshot
 	newSnapshot, err := p.orchestrator.CreateSnapshot(ctx, snapshotConfig)
 	if err != nil {
@@ -742,6 +835,18 @@ func (p *Plugin) CreateSnapshot(
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	// A snapshot finished once the nodes may have thawed the filesystem is not application-consistent
+	if !freezeDeadline.IsZero() && time.Now().After(freezeDeadline) {
+		err = fmt.Errorf("snapshot %s of volume %s took longer than the filesystem could stay frozen",
+			snapshotName, volumeName)
+		Logc(ctx).WithError(err).Warning("Deleting snapshot that may not be application-consistent.")
+		if deleteErr := p.orchestrator.DeleteSnapshot(ctx, volumeName, snapshotName); deleteErr != nil {
+			Logc(ctx).WithError(deleteErr).Error("Could not delete snapshot.")
+			return nil, status.Errorf(codes.Internal, "%v; could not delete snapshot; %v", err, deleteErr)
+		}
+		return nil, status.Error(codes.Aborted, err.Error())
+	}
+
 	if csiSnapshot, err := p.getCSISnapshotFromTridentSnapshot(ctx, newSnapshot); err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	} else {
@@ -781,7 +886,10 @@ func (p *Plugin) DeleteSnapshot(
 		}).Debugf("Could not delete snapshot.")
 
 		// In CSI, delete is idempotent, so don't return an error if the snapshot doesn't exist
-		if !errors.IsNotFoundError(err) {
+		if errors.IsInvalidInputError(err) {
+			// Snapshots belonging to a group snapshot may only be deleted with the group
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		} else if !errors.IsNotFoundError(err) {
 			return nil, p.getCSIErrorForOrchestratorError(err)
 		}
 	}
@@ -1014,10 +1122,189 @@ func (p *Plugin) ControllerExpandVolume(
 }
 
 func (p *Plugin) ControllerGetVolume(
-	_ context.Context, _ *csi.ControllerGetVolumeRequest,
+	ctx context.Context, req *csi.ControllerGetVolumeRequest,
 ) (*csi.ControllerGetVolumeResponse, error) {
-	// Trident doesn't support ControllerGetVolume
-	return nil, status.Error(codes.Unimplemented, "")
+	ctx = SetContextWorkflow(ctx, WorkflowVolumeGet)
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCSIFrontend)
+
+	fields := LogFields{"Method": "ControllerGetVolume", "Type": "CSI_Controller", "volumeID": req.GetVolumeId()}
+	Logc(ctx).WithFields(fields).Trace(">>>> ControllerGetVolume")
+	defer Logc(ctx).WithFields(fields).Trace("<<<< ControllerGetVolume")
+
+	volumeID := req.GetVolumeId()
+	if volumeID == "" {
+		return nil, status.Error(codes.InvalidArgument, "no volume ID provided")
+	}
+
+	volume, err := p.orchestrator.GetVolume(ctx, volumeID)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, p.getCSIErrorForOrchestratorError(err)
+	}
+
+	csiVolume, err := p.getCSIVolumeFromTridentVolume(ctx, volume)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	publishedNodeIDs, err := p.getPublishedNodeIDs(ctx, volumeID)
+	if err != nil {
+		return nil, err
+	}
+
+	// A failed health check is itself reported as an abnormal condition
+	var condition *csi.VolumeCondition
+	if health, err := p.orchestrator.GetVolumeHealth(ctx, volumeID); err == nil {
+		condition = getCSIVolumeCondition(health)
+	} else if errors.IsNotFoundError(err) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else {
+		Logc(ctx).WithFields(fields).WithError(err).Warning("Could not check volume health.")
+		condition = &csi.VolumeCondition{Abnormal: true, Message: fmt.Sprintf("could not check volume health; %v", err)}
+	}
+
+	return &csi.ControllerGetVolumeResponse{
+		Volume: csiVolume,
+		Status: &csi.ControllerGetVolumeResponse_VolumeStatus{
+			PublishedNodeIds: publishedNodeIDs,
+			VolumeCondition:  condition,
+		},
+	}, nil
+}
+
+func (p *Plugin) ControllerModifyVolume(
+	ctx context.Context, req *csi.ControllerModifyVolumeRequest,
+) (*csi.ControllerModifyVolumeResponse, error) {
+	ctx = SetContextWorkflow(ctx, WorkflowVolumeUpdate)
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCSIFrontend)
+
+	fields := LogFields{"Method": "ControllerModifyVolume", "Type": "CSI_Controller", "volumeID": req.GetVolumeId()}
+	Logc(ctx).WithFields(fields).Debug(">>>> ControllerModifyVolume")
+	defer Logc(ctx).WithFields(fields).Debug("<<<< ControllerModifyVolume")
+
+	volumeID := req.GetVolumeId()
+	if volumeID == "" {
+		return nil, status.Error(codes.InvalidArgument, "no volume ID provided")
+	}
+
+	if len(req.GetMutableParameters()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no mutable parameters provided")
+	}
+
+	updateInfo, err := getVolumeUpdateInfoFromMutableParameters(req.GetMutableParameters())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	volume, err := p.orchestrator.GetVolume(ctx, volumeID)
+	if err != nil {
+		return nil, p.getCSIErrorForOrchestratorError(err)
+	}
+
+	// The changes are recorded in the volume config, so there is nothing to do if they were already made
+	if volumeConfigHasUpdates(volume.Config, updateInfo) {
+		Logc(ctx).WithFields(fields).Debug("Volume already has the requested attributes.")
+		return &csi.ControllerModifyVolumeResponse{}, nil
+	}
+
+	if err = p.orchestrator.UpdateVolume(ctx, volumeID, updateInfo); err != nil {
+		Logc(ctx).WithFields(fields).WithError(err).Error("Could not modify volume.")
+		if errors.IsInvalidInputError(err) || errors.IsUnsupportedError(err) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, p.getCSIErrorForOrchestratorError(err)
+	}
+
+	return &csi.ControllerModifyVolumeResponse{}, nil
+}
+
+// getVolumeUpdateInfoFromMutableParameters converts the mutable parameters of a VolumeAttributesClass
+// into a volume update request.
+func getVolumeUpdateInfoFromMutableParameters(params map[string]string) (*utils.VolumeUpdateInfo, error) {
+	updateInfo := &utils.VolumeUpdateInfo{}
+
+	for key, value := range params {
+		if value == "" {
+			return nil, fmt.Errorf("no value provided for mutable parameter %s", key)
+		}
+		switch key {
+		case "qosPolicy":
+			updateInfo.QosPolicy = value
+		case "adaptiveQosPolicy":
+			updateInfo.AdaptiveQosPolicy = value
+		case "tieringPolicy":
+			updateInfo.TieringPolicy = value
+		case "snapshotPolicy":
+			updateInfo.SnapshotPolicy = value
+		case "serviceLevel":
+			updateInfo.ServiceLevel = value
+		case "poolLevel":
+			poolLevel, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value %s for mutable parameter %s", value, key)
+			}
+			updateInfo.PoolLevel = poolLevel
+		default:
+			return nil, fmt.Errorf("unsupported mutable parameter %s", key)
+		}
+	}
+
+	if updateInfo.QosPolicy != "" && updateInfo.AdaptiveQosPolicy != "" {
+		return nil, fmt.Errorf("only one kind of QoS policy group may be defined")
+	}
+
+	return updateInfo, nil
+}
+
+// volumeConfigHasUpdates reports whether a volume config already reflects all the requested changes.
+func volumeConfigHasUpdates(volConfig *storage.VolumeConfig, updateInfo *utils.VolumeUpdateInfo) bool {
+	if updateInfo.QosPolicy != "" || updateInfo.AdaptiveQosPolicy != "" {
+		if volConfig.QosPolicy != updateInfo.QosPolicy || volConfig.AdaptiveQosPolicy != updateInfo.AdaptiveQosPolicy {
+			return false
+		}
+	}
+	if updateInfo.TieringPolicy != "" && volConfig.TieringPolicy != updateInfo.TieringPolicy {
+		return false
+	}
+	if updateInfo.SnapshotPolicy != "" && volConfig.SnapshotPolicy != updateInfo.SnapshotPolicy {
+		return false
+	}
+	if updateInfo.ServiceLevel != "" && !strings.EqualFold(volConfig.ServiceLevel, updateInfo.ServiceLevel) {
+		return false
+	}
+	return true
+}
+
+// getPublishedNodeIDs returns the names of all the nodes to which a volume has been published.
+func (p *Plugin) getPublishedNodeIDs(ctx context.Context, volumeID string) ([]string, error) {
+	publications, err := p.orchestrator.ListVolumePublicationsForVolume(ctx, volumeID)
+	if err != nil {
+		msg := fmt.Sprintf("error listing volume publications for volume %s", volumeID)
+		Logc(ctx).WithError(err).Error(msg)
+		return nil, status.Error(codes.Internal, msg)
+	}
+
+	nodeIDs := make([]string, 0, len(publications))
+	for _, publication := range publications {
+		nodeIDs = append(nodeIDs, publication.NodeName)
+	}
+	return nodeIDs, nil
+}
+
+// getCSIVolumeCondition converts a volume's health into a CSI volume condition.  A volume whose health
+// has not yet been checked is assumed to be normal.
+func getCSIVolumeCondition(health *storage.VolumeHealth) *csi.VolumeCondition {
+	if health == nil {
+		return &csi.VolumeCondition{Abnormal: false, Message: "volume health has not been checked"}
+	}
+
+	message := health.Message
+	if message == "" {
+		message = string(health.Condition)
+	}
+	return &csi.VolumeCondition{Abnormal: health.IsAbnormal(), Message: message}
 }
 
 func (p *Plugin) getCSIVolumeFromTridentVolume(
@@ -1095,11 +1382,12 @@ func (p *Plugin) getCSISnapshotFromTridentSnapshot(
 	}
 
 	return &csi.Snapshot{
-		SizeBytes:      size,
-		SnapshotId:     storage.MakeSnapshotID(snapshot.Config.VolumeName, snapshot.Config.Name),
-		SourceVolumeId: snapshot.Config.VolumeName,
-		CreationTime:   &timestamp.Timestamp{Seconds: createdSeconds.Unix()},
-		ReadyToUse:     snapshot.State == storage.SnapshotStateOnline,
+		SizeBytes:       size,
+		SnapshotId:      storage.MakeSnapshotID(snapshot.Config.VolumeName, snapshot.Config.Name),
+		SourceVolumeId:  snapshot.Config.VolumeName,
+		CreationTime:    &timestamp.Timestamp{Seconds: createdSeconds.Unix()},
+		ReadyToUse:      snapshot.State == storage.SnapshotStateOnline,
+		GroupSnapshotId: snapshot.Config.GroupSnapshotName,
 	}, nil
 }
 
@@ -20,6 +20,7 @@ import (
 	mockhelpers "github.com/netapp/trident/mocks/mock_frontend/mock_csi/mock_controller_helpers"
 	"github.com/netapp/trident/storage"
 	sa "github.com/netapp/trident/storage_attribute"
+	storageclass "github.com/netapp/trident/storage_class"
 	"github.com/netapp/trident/utils"
 	"github.com/netapp/trident/utils/errors"
 )
@@ -722,3 +723,223 @@ func TestControllerGetListSnapshots_DoesNotExceedMaxEntries(t *testing.T) {
 	assert.NotEmpty(t, entries)
 	assert.NotEqual(t, math.MaxInt16, len(entries))
 }
+
+func TestControllerGetCapacity(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+	mockHelper := mockhelpers.NewMockControllerHelper(mockCtrl)
+	controllerServer := generateController(mockOrchestrator, mockHelper)
+
+	parameters := map[string]string{"media": "ssd"}
+	topology := map[string]string{"topology.kubernetes.io/zone": "us-east-1a"}
+	scConfig := &storageclass.Config{Name: "sc"}
+	req := &csi.GetCapacityRequest{
+		VolumeCapabilities: []*csi.VolumeCapability{
+			{
+				AccessMode: &csi.VolumeCapability_AccessMode{
+					Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER,
+				},
+				AccessType: &csi.VolumeCapability_Mount{Mount: &csi.VolumeCapability_MountVolume{}},
+			},
+		},
+		Parameters:         parameters,
+		AccessibleTopology: &csi.Topology{Segments: topology},
+	}
+
+	mockHelper.EXPECT().GetStorageClassConfig(gomock.Any(), parameters).Return(scConfig, nil)
+	mockOrchestrator.EXPECT().GetStorageCapacity(gomock.Any(), scConfig, tridentconfig.File, topology).Return(
+		&storageclass.Capacity{AvailableBytes: 3000, MaximumVolumeBytes: 2000, Pools: 2}, nil)
+
+	resp, err := controllerServer.GetCapacity(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3000), resp.GetAvailableCapacity())
+	assert.Equal(t, int64(2000), resp.GetMaximumVolumeSize().GetValue())
+}
+
+func TestControllerGetCapacity_Errors(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+	mockHelper := mockhelpers.NewMockControllerHelper(mockCtrl)
+	controllerServer := generateController(mockOrchestrator, mockHelper)
+
+	blockCapability := &csi.VolumeCapability{
+		AccessMode: &csi.VolumeCapability_AccessMode{Mode: csi.VolumeCapability_AccessMode_MULTI_NODE_MULTI_WRITER},
+		AccessType: &csi.VolumeCapability_Block{Block: &csi.VolumeCapability_BlockVolume{}},
+	}
+	scConfig := &storageclass.Config{}
+
+	// Invalid storage class parameters
+	mockHelper.EXPECT().GetStorageClassConfig(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("bad attribute"))
+	_, err := controllerServer.GetCapacity(ctx, &csi.GetCapacityRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// No pools report their capacity
+	mockHelper.EXPECT().GetStorageClassConfig(gomock.Any(), gomock.Any()).Return(scConfig, nil)
+	mockOrchestrator.EXPECT().GetStorageCapacity(gomock.Any(), scConfig, tridentconfig.Block, gomock.Any()).Return(
+		nil, errors.UnsupportedError("unsupported"))
+	resp, err := controllerServer.GetCapacity(ctx,
+		&csi.GetCapacityRequest{VolumeCapabilities: []*csi.VolumeCapability{blockCapability}})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), resp.GetAvailableCapacity())
+
+	// Orchestrator not ready
+	mockHelper.EXPECT().GetStorageClassConfig(gomock.Any(), gomock.Any()).Return(scConfig, nil)
+	mockOrchestrator.EXPECT().GetStorageCapacity(gomock.Any(), scConfig, tridentconfig.ProtocolAny, gomock.Any()).
+		Return(nil, errors.NotReadyError())
+	_, err = controllerServer.GetCapacity(ctx, &csi.GetCapacityRequest{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestControllerGetVolume(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+	mockHelper := mockhelpers.NewMockControllerHelper(mockCtrl)
+	controllerServer := generateController(mockOrchestrator, mockHelper)
+
+	volume := &storage.VolumeExternal{
+		Config: &storage.VolumeConfig{Name: "pvc-1", InternalName: "trident_pvc_1", Size: "1073741824"},
+	}
+	publications := []*utils.VolumePublicationExternal{{VolumeName: "pvc-1", NodeName: "node-1"}}
+
+	// Healthy volume
+	mockOrchestrator.EXPECT().GetVolume(gomock.Any(), "pvc-1").Return(volume, nil)
+	mockOrchestrator.EXPECT().ListVolumePublicationsForVolume(gomock.Any(), "pvc-1").Return(publications, nil)
+	mockOrchestrator.EXPECT().GetVolumeHealth(gomock.Any(), "pvc-1").Return(
+		storage.NewVolumeHealth(storage.VolumeHealthNormal, ""), nil)
+
+	resp, err := controllerServer.ControllerGetVolume(ctx, &csi.ControllerGetVolumeRequest{VolumeId: "pvc-1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "pvc-1", resp.GetVolume().GetVolumeId())
+	assert.Equal(t, int64(1073741824), resp.GetVolume().GetCapacityBytes())
+	assert.Equal(t, []string{"node-1"}, resp.GetStatus().GetPublishedNodeIds())
+	assert.False(t, resp.GetStatus().GetVolumeCondition().GetAbnormal())
+
+	// Unhealthy volume
+	mockOrchestrator.EXPECT().GetVolume(gomock.Any(), "pvc-1").Return(volume, nil)
+	mockOrchestrator.EXPECT().ListVolumePublicationsForVolume(gomock.Any(), "pvc-1").Return(nil, nil)
+	mockOrchestrator.EXPECT().GetVolumeHealth(gomock.Any(), "pvc-1").Return(
+		storage.NewVolumeHealth(storage.VolumeHealthOffline, "volume is offline"), nil)
+
+	resp, err = controllerServer.ControllerGetVolume(ctx, &csi.ControllerGetVolumeRequest{VolumeId: "pvc-1"})
+	assert.NoError(t, err)
+	assert.Empty(t, resp.GetStatus().GetPublishedNodeIds())
+	assert.True(t, resp.GetStatus().GetVolumeCondition().GetAbnormal())
+	assert.Equal(t, "volume is offline", resp.GetStatus().GetVolumeCondition().GetMessage())
+
+	// Health check failure
+	mockOrchestrator.EXPECT().GetVolume(gomock.Any(), "pvc-1").Return(volume, nil)
+	mockOrchestrator.EXPECT().ListVolumePublicationsForVolume(gomock.Any(), "pvc-1").Return(nil, nil)
+	mockOrchestrator.EXPECT().GetVolumeHealth(gomock.Any(), "pvc-1").Return(nil, fmt.Errorf("backend error"))
+
+	resp, err = controllerServer.ControllerGetVolume(ctx, &csi.ControllerGetVolumeRequest{VolumeId: "pvc-1"})
+	assert.NoError(t, err)
+	assert.True(t, resp.GetStatus().GetVolumeCondition().GetAbnormal())
+
+	// Missing volume ID
+	_, err = controllerServer.ControllerGetVolume(ctx, &csi.ControllerGetVolumeRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Volume not found
+	mockOrchestrator.EXPECT().GetVolume(gomock.Any(), "pvc-2").Return(nil, errors.NotFoundError("not found"))
+	_, err = controllerServer.ControllerGetVolume(ctx, &csi.ControllerGetVolumeRequest{VolumeId: "pvc-2"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestControllerModifyVolume(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+	mockHelper := mockhelpers.NewMockControllerHelper(mockCtrl)
+	controllerServer := generateController(mockOrchestrator, mockHelper)
+
+	volume := &storage.VolumeExternal{
+		Config: &storage.VolumeConfig{Name: "pvc-1", QosPolicy: "silver", TieringPolicy: "none"},
+	}
+	params := map[string]string{"qosPolicy": "gold", "tieringPolicy": "auto", "poolLevel": "true"}
+
+	// Changed attributes are passed to the orchestrator
+	mockOrchestrator.EXPECT().GetVolume(gomock.Any(), "pvc-1").Return(volume, nil)
+	mockOrchestrator.EXPECT().UpdateVolume(gomock.Any(), "pvc-1", &utils.VolumeUpdateInfo{
+		QosPolicy:     "gold",
+		TieringPolicy: "auto",
+		PoolLevel:     true,
+	}).Return(nil)
+
+	_, err := controllerServer.ControllerModifyVolume(ctx,
+		&csi.ControllerModifyVolumeRequest{VolumeId: "pvc-1", MutableParameters: params})
+	assert.NoError(t, err)
+
+	// Nothing to do if the volume already has the requested attributes
+	volume.Config.QosPolicy = "gold"
+	volume.Config.TieringPolicy = "auto"
+	mockOrchestrator.EXPECT().GetVolume(gomock.Any(), "pvc-1").Return(volume, nil)
+
+	_, err = controllerServer.ControllerModifyVolume(ctx,
+		&csi.ControllerModifyVolumeRequest{VolumeId: "pvc-1", MutableParameters: params})
+	assert.NoError(t, err)
+
+	// Driver rejects the change
+	mockOrchestrator.EXPECT().GetVolume(gomock.Any(), "pvc-1").Return(volume, nil)
+	mockOrchestrator.EXPECT().UpdateVolume(gomock.Any(), "pvc-1", gomock.Any()).
+		Return(errors.UnsupportedError("service level may not be changed"))
+
+	_, err = controllerServer.ControllerModifyVolume(ctx, &csi.ControllerModifyVolumeRequest{
+		VolumeId: "pvc-1", MutableParameters: map[string]string{"serviceLevel": "Premium"},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Volume not found
+	mockOrchestrator.EXPECT().GetVolume(gomock.Any(), "pvc-2").
+		Return(nil, errors.NotFoundError("volume %s not found", "pvc-2"))
+
+	_, err = controllerServer.ControllerModifyVolume(ctx,
+		&csi.ControllerModifyVolumeRequest{VolumeId: "pvc-2", MutableParameters: params})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Invalid requests
+	invalidRequests := []*csi.ControllerModifyVolumeRequest{
+		{MutableParameters: params},
+		{VolumeId: "pvc-1"},
+		{VolumeId: "pvc-1", MutableParameters: map[string]string{"unknown": "value"}},
+		{VolumeId: "pvc-1", MutableParameters: map[string]string{"snapshotPolicy": ""}},
+		{VolumeId: "pvc-1", MutableParameters: map[string]string{"poolLevel": "maybe"}},
+		{VolumeId: "pvc-1", MutableParameters: map[string]string{"qosPolicy": "gold", "adaptiveQosPolicy": "aqos"}},
+	}
+	for _, req := range invalidRequests {
+		_, err = controllerServer.ControllerModifyVolume(ctx, req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), "request %v", req)
+	}
+}
+
+func TestListVolumes_VolumeCondition(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+	mockHelper := mockhelpers.NewMockControllerHelper(mockCtrl)
+	controllerServer := generateController(mockOrchestrator, mockHelper)
+
+	volumes := []*storage.VolumeExternal{
+		{Config: &storage.VolumeConfig{Name: "pvc-1", Size: "1024"}},
+		{Config: &storage.VolumeConfig{Name: "pvc-2", Size: "1024"}},
+		{Config: &storage.VolumeConfig{Name: "pvc-3", Size: "1024"}},
+	}
+	health := map[string]*storage.VolumeHealth{
+		"pvc-1": storage.NewVolumeHealth(storage.VolumeHealthNormal, ""),
+		"pvc-2": storage.NewVolumeHealth(storage.VolumeHealthMissing, "volume is missing"),
+	}
+
+	mockOrchestrator.EXPECT().ListVolumes(gomock.Any()).Return(volumes, nil)
+	mockOrchestrator.EXPECT().ListVolumeHealth(gomock.Any()).Return(health, nil)
+	mockOrchestrator.EXPECT().ListVolumePublicationsForVolume(gomock.Any(), gomock.Any()).Return(nil, nil).Times(3)
+
+	resp, err := controllerServer.ListVolumes(ctx, &csi.ListVolumesRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetEntries(), 3)
+
+	conditions := make(map[string]*csi.VolumeCondition)
+	for _, entry := range resp.GetEntries() {
+		conditions[entry.GetVolume().GetVolumeId()] = entry.GetStatus().GetVolumeCondition()
+	}
+	assert.False(t, conditions["pvc-1"].GetAbnormal())
+	assert.True(t, conditions["pvc-2"].GetAbnormal())
+	assert.Equal(t, "volume is missing", conditions["pvc-2"].GetMessage())
+	assert.False(t, conditions["pvc-3"].GetAbnormal(), "unchecked volumes should be reported as normal")
+}
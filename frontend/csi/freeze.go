@@ -0,0 +1,223 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package csi
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	tridentconfig "github.com/netapp/trident/config"
+	controllerAPIv1 "github.com/netapp/trident/frontend/csi/controller_api/v1"
+	controllerhelpers "github.com/netapp/trident/frontend/csi/controller_helpers"
+	. "github.com/netapp/trident/logging"
+	sa "github.com/netapp/trident/storage_attribute"
+	"github.com/netapp/trident/utils"
+	"github.com/netapp/trident/utils/errors"
+)
+
+// filesystemFreezeTimeout is the longest a filesystem stays frozen for a snapshot before nodes thaw it
+var filesystemFreezeTimeout = 30 * time.Second
+
+// FilesystemFreezer freezes the filesystem of a volume on the nodes to which it is published, so that a
+// snapshot taken meanwhile is application-consistent.  The function returned thaws the filesystem.
+type FilesystemFreezer interface {
+	FreezeFilesystem(
+		ctx context.Context, volume string, nodes []string, timeout time.Duration,
+	) (func(context.Context), error)
+}
+
+// frozenFilesystem is a filesystem frozen on the node at the controller's request.
+type frozenFilesystem struct {
+	id         string
+	mountpoint string
+	autoThaw   *time.Timer
+}
+
+// shouldFreezeFilesystem returns whether a volume's filesystem should be frozen while it is snapshotted, as set
+// by the snapshot class, or failing that, by the volume's storage class.
+func (p *Plugin) shouldFreezeFilesystem(
+	ctx context.Context, volumeName string, parameters map[string]string,
+) (bool, error) {
+	if value, ok := parameters[sa.FreezeFilesystem]; ok {
+		freeze, err := strconv.ParseBool(value)
+		if err != nil {
+			return false, errors.InvalidInputError(fmt.Sprintf("invalid snapshot class parameter %s; %v",
+				sa.FreezeFilesystem, err))
+		}
+		return freeze, nil
+	}
+
+	volume, err := p.orchestrator.GetVolume(ctx, volumeName)
+	if err != nil {
+		return false, err
+	}
+	if volume.Config.StorageClass == "" {
+		return false, nil
+	}
+	storageClass, err := p.orchestrator.GetStorageClass(ctx, volume.Config.StorageClass)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return storageClass.Config.FreezeFS, nil
+}
+
+// freezeFilesystem freezes a volume's filesystem on the nodes to which it is published, returning a function
+// that thaws it and the time by which the nodes may thaw it by themselves.  Nodes start their timers only once
+// asked to freeze, so the filesystem is frozen at least until then.
+func (p *Plugin) freezeFilesystem(
+	ctx context.Context, volumeName string,
+) (thaw func(context.Context), deadline time.Time, err error) {
+	publications, err := p.orchestrator.ListVolumePublicationsForVolume(ctx, volumeName)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	if len(publications) == 0 {
+		Logc(ctx).WithField("volume", volumeName).Debug("Volume is not published, so there is nothing to freeze.")
+		return func(context.Context) {}, time.Time{}, nil
+	}
+	nodes := make([]string, 0, len(publications))
+	for _, publication := range publications {
+		nodes = append(nodes, publication.NodeName)
+	}
+
+	controlPlane, err := p.orchestrator.GetFrontend(ctx, controllerhelpers.ControlPlaneFrontend)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("filesystems may only be frozen through the gRPC control plane; %v", err)
+	}
+	freezer, ok := controlPlane.(FilesystemFreezer)
+	if !ok {
+		return nil, time.Time{}, fmt.Errorf("the %s frontend cannot freeze filesystems", controlPlane.GetName())
+	}
+
+	deadline = time.Now().Add(filesystemFreezeTimeout)
+	thaw, err = freezer.FreezeFilesystem(ctx, volumeName, nodes, filesystemFreezeTimeout)
+	return thaw, deadline, err
+}
+
+// handleFilesystemFreeze freezes or thaws a filesystem as the controller asked, reporting on any freeze.
+func (p *Plugin) handleFilesystemFreeze(
+	ctx context.Context, eventType controllerAPIv1.NodeEventType, freeze *controllerAPIv1.FilesystemFreeze,
+) {
+	switch eventType {
+	case controllerAPIv1.NodeEventFreeze:
+		report := &controllerAPIv1.ReportFreezeRequest{ID: freeze.ID, Node: p.nodeName, Volume: freeze.Volume}
+		if err := p.freezeVolumeFilesystem(ctx, freeze); err != nil {
+			Logc(ctx).WithField("volume", freeze.Volume).WithError(err).Error("Could not freeze filesystem.")
+			report.Error = err.Error()
+		}
+		if err := p.restClient.ReportFreeze(ctx, report); err != nil {
+			Logc(ctx).WithField("volume", freeze.Volume).WithError(err).Warning(
+				"Could not report filesystem freeze to the controller.")
+		}
+	case controllerAPIv1.NodeEventThaw:
+		p.thawVolumeFilesystem(ctx, freeze.ID, freeze.Volume)
+	}
+}
+
+// freezeVolumeFilesystem freezes the filesystem of a volume published on the node, and arranges for it to be
+// thawed once the freeze times out.  Volumes without a local filesystem, such as NFS and raw block volumes,
+// have nothing to freeze.
+func (p *Plugin) freezeVolumeFilesystem(ctx context.Context, freeze *controllerAPIv1.FilesystemFreeze) error {
+	p.frozenFilesystemsLock.Lock()
+	defer p.frozenFilesystemsLock.Unlock()
+
+	if p.frozenFilesystems == nil {
+		p.frozenFilesystems = make(map[string]*frozenFilesystem)
+	}
+	if frozen, ok := p.frozenFilesystems[freeze.Volume]; ok {
+		// The same request may arrive more than once, such as on both watches of a node reconnecting
+		if frozen.id == freeze.ID {
+			return nil
+		}
+		return fmt.Errorf("filesystem of volume %s is already frozen", freeze.Volume)
+	}
+
+	mountpoint, err := p.getFreezableMountpoint(ctx, freeze.Volume)
+	if err != nil {
+		return err
+	}
+	if mountpoint == "" {
+		Logc(ctx).WithField("volume", freeze.Volume).Debug("Volume has no filesystem on the node to freeze.")
+		return nil
+	}
+
+	if err = utils.FreezeFilesystem(ctx, mountpoint); err != nil {
+		// A freeze that timed out may yet take effect, so make sure the filesystem is not left frozen
+		_ = utils.ThawFilesystem(ctx, mountpoint)
+		return err
+	}
+
+	timeout := freeze.Timeout
+	if timeout <= 0 || timeout > filesystemFreezeTimeout {
+		timeout = filesystemFreezeTimeout
+	}
+	p.frozenFilesystems[freeze.Volume] = &frozenFilesystem{
+		id:         freeze.ID,
+		mountpoint: mountpoint,
+		autoThaw: time.AfterFunc(timeout, func() {
+			Logc(ctx).WithField("volume", freeze.Volume).Warning("Filesystem freeze timed out.")
+			p.thawVolumeFilesystem(ctx, freeze.ID, freeze.Volume)
+		}),
+	}
+
+	Logc(ctx).WithFields(LogFields{
+		"volume":     freeze.Volume,
+		"mountpoint": mountpoint,
+		"timeout":    timeout,
+	}).Info("Froze filesystem.")
+
+	return nil
+}
+
+// thawVolumeFilesystem thaws the filesystem of a volume, if it is still frozen by the specified freeze.
+func (p *Plugin) thawVolumeFilesystem(ctx context.Context, id, volume string) {
+	p.frozenFilesystemsLock.Lock()
+	defer p.frozenFilesystemsLock.Unlock()
+
+	frozen, ok := p.frozenFilesystems[volume]
+	if !ok || frozen.id != id {
+		return
+	}
+	frozen.autoThaw.Stop()
+	delete(p.frozenFilesystems, volume)
+
+	if err := utils.ThawFilesystem(ctx, frozen.mountpoint); err != nil {
+		Logc(ctx).WithField("volume", volume).WithError(err).Error("Could not thaw filesystem.")
+		return
+	}
+	Logc(ctx).WithFields(LogFields{"volume": volume, "mountpoint": frozen.mountpoint}).Info("Thawed filesystem.")
+}
+
+// getFreezableMountpoint returns a path at which the filesystem of a volume is mounted on the node, or an empty
+// string if the volume has no filesystem of its own there.  A filesystem is frozen as a whole, so any of the
+// paths to which the volume is published will do.
+func (p *Plugin) getFreezableMountpoint(ctx context.Context, volume string) (string, error) {
+	trackingInfo, err := p.nodeHelper.ReadTrackingInfo(ctx, volume)
+	if err != nil {
+		return "", fmt.Errorf("could not read tracking info for volume %s; %v", volume, err)
+	}
+
+	protocol, err := getVolumeProtocolFromPublishInfo(&trackingInfo.VolumePublishInfo)
+	if err != nil {
+		return "", err
+	}
+	if protocol == tridentconfig.File || trackingInfo.FilesystemType == tridentconfig.FsRaw {
+		return "", nil
+	}
+
+	paths := make([]string, 0, len(trackingInfo.PublishedPaths))
+	for path := range trackingInfo.PublishedPaths {
+		paths = append(paths, path)
+	}
+	if len(paths) == 0 {
+		return "", nil
+	}
+	sort.Strings(paths)
+	return paths[0], nil
+}
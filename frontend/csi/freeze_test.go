@@ -0,0 +1,237 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package csi
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/netapp/trident/frontend"
+	controllerAPIv1 "github.com/netapp/trident/frontend/csi/controller_api/v1"
+	controllerhelpers "github.com/netapp/trident/frontend/csi/controller_helpers"
+	mockcore "github.com/netapp/trident/mocks/mock_core"
+	mockControllerAPI "github.com/netapp/trident/mocks/mock_frontend/mock_csi/mock_controller_api"
+	mockhelpers "github.com/netapp/trident/mocks/mock_frontend/mock_csi/mock_controller_helpers"
+	mockNodeHelpers "github.com/netapp/trident/mocks/mock_frontend/mock_csi/mock_node_helpers"
+	"github.com/netapp/trident/storage"
+	storageclass "github.com/netapp/trident/storage_class"
+	"github.com/netapp/trident/utils"
+	"github.com/netapp/trident/utils/errors"
+)
+
+// fakeFreezer is a control-plane frontend that records the filesystems it is asked to freeze.
+type fakeFreezer struct {
+	frontend.Plugin
+	err    error
+	nodes  []string
+	thawed bool
+}
+
+func (f *fakeFreezer) FreezeFilesystem(
+	_ context.Context, _ string, nodes []string, _ time.Duration,
+) (func(context.Context), error) {
+	f.nodes = nodes
+	if f.err != nil {
+		return nil, f.err
+	}
+	return func(context.Context) { f.thawed = true }, nil
+}
+
+func TestShouldFreezeFilesystem(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+	p := generateController(mockOrchestrator, mockhelpers.NewMockControllerHelper(mockCtrl))
+
+	// The snapshot class decides, if it says
+	freeze, err := p.shouldFreezeFilesystem(ctx, "vol1", map[string]string{"freezeFilesystem": "true"})
+	assert.NoError(t, err)
+	assert.True(t, freeze)
+
+	_, err = p.shouldFreezeFilesystem(ctx, "vol1", map[string]string{"freezeFilesystem": "maybe"})
+	assert.True(t, errors.IsInvalidInputError(err))
+
+	// Otherwise the volume's storage class does
+	volume := generateFakeVolumeExternal("vol1")
+	volume.Config.StorageClass = "gold"
+	mockOrchestrator.EXPECT().GetVolume(gomock.Any(), "vol1").Return(volume, nil)
+	mockOrchestrator.EXPECT().GetStorageClass(gomock.Any(), "gold").Return(
+		&storageclass.External{Config: &storageclass.Config{Name: "gold", FreezeFS: true}}, nil)
+	freeze, err = p.shouldFreezeFilesystem(ctx, "vol1", nil)
+	assert.NoError(t, err)
+	assert.True(t, freeze)
+
+	mockOrchestrator.EXPECT().GetVolume(gomock.Any(), "vol1").Return(volume, nil)
+	mockOrchestrator.EXPECT().GetStorageClass(gomock.Any(), "gold").Return(nil, errors.NotFoundError("not found"))
+	freeze, err = p.shouldFreezeFilesystem(ctx, "vol1", nil)
+	assert.NoError(t, err)
+	assert.False(t, freeze)
+}
+
+func TestCreateSnapshot_FreezeFilesystem(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+	mockHelper := mockhelpers.NewMockControllerHelper(mockCtrl)
+	p := generateController(mockOrchestrator, mockHelper)
+
+	req := &csi.CreateSnapshotRequest{
+		SourceVolumeId: "vol1",
+		Name:           "snap1",
+		Parameters:     map[string]string{"freezeFilesystem": "true"},
+	}
+	snapshotConfig := &storage.SnapshotConfig{Name: "snap1", VolumeName: "vol1"}
+	freezer := &fakeFreezer{}
+
+	mockOrchestrator.EXPECT().GetSnapshot(gomock.Any(), "vol1", "snap1").Return(nil,
+		errors.NotFoundError("not found")).Times(2)
+	mockOrchestrator.EXPECT().ListSnapshotsByName(gomock.Any(), "snap1").Return(nil, nil).Times(2)
+	mockHelper.EXPECT().GetSnapshotConfigForCreate("vol1", "snap1").Return(snapshotConfig, nil).Times(2)
+	mockOrchestrator.EXPECT().ListVolumePublicationsForVolume(gomock.Any(), "vol1").Return(
+		[]*utils.VolumePublicationExternal{{VolumeName: "vol1", NodeName: "node1"}}, nil).Times(2)
+	mockOrchestrator.EXPECT().GetFrontend(gomock.Any(), controllerhelpers.ControlPlaneFrontend).Return(
+		freezer, nil).Times(2)
+
+	// The snapshot is taken while the filesystem is frozen
+	volume := generateFakeVolumeExternal("vol1")
+	volume.Config.Size = "1Gi"
+	mockOrchestrator.EXPECT().CreateSnapshot(gomock.Any(), snapshotConfig).DoAndReturn(
+		func(context.Context, *storage.SnapshotConfig) (*storage.SnapshotExternal, error) {
+			assert.False(t, freezer.thawed, "filesystem was thawed before the snapshot was taken")
+			return &storage.SnapshotExternal{Snapshot: storage.Snapshot{Config: snapshotConfig}}, nil
+		})
+	mockOrchestrator.EXPECT().GetVolume(gomock.Any(), "vol1").Return(volume, nil)
+
+	_, err := p.CreateSnapshot(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"node1"}, freezer.nodes)
+	assert.True(t, freezer.thawed)
+
+	// No snapshot is taken if the filesystem cannot be frozen
+	freezer.err = fmt.Errorf("node node1 is not watching for freeze requests")
+	_, err = p.CreateSnapshot(ctx, req)
+	assert.Equal(t, codes.Aborted, status.Code(err))
+}
+
+func TestCreateSnapshot_FreezeExpired(t *testing.T) {
+	defer func(timeout time.Duration) { filesystemFreezeTimeout = timeout }(filesystemFreezeTimeout)
+	filesystemFreezeTimeout = 10 * time.Millisecond
+
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+	mockHelper := mockhelpers.NewMockControllerHelper(mockCtrl)
+	p := generateController(mockOrchestrator, mockHelper)
+
+	req := &csi.CreateSnapshotRequest{
+		SourceVolumeId: "vol1",
+		Name:           "snap1",
+		Parameters:     map[string]string{"freezeFilesystem": "true"},
+	}
+	snapshotConfig := &storage.SnapshotConfig{Name: "snap1", VolumeName: "vol1"}
+	freezer := &fakeFreezer{}
+
+	mockOrchestrator.EXPECT().GetSnapshot(gomock.Any(), "vol1", "snap1").Return(nil,
+		errors.NotFoundError("not found"))
+	mockOrchestrator.EXPECT().ListSnapshotsByName(gomock.Any(), "snap1").Return(nil, nil)
+	mockHelper.EXPECT().GetSnapshotConfigForCreate("vol1", "snap1").Return(snapshotConfig, nil)
+	mockOrchestrator.EXPECT().ListVolumePublicationsForVolume(gomock.Any(), "vol1").Return(
+		[]*utils.VolumePublicationExternal{{VolumeName: "vol1", NodeName: "node1"}}, nil)
+	mockOrchestrator.EXPECT().GetFrontend(gomock.Any(), controllerhelpers.ControlPlaneFrontend).Return(
+		freezer, nil)
+
+	// A snapshot finished after the nodes may have thawed the filesystem is deleted
+	mockOrchestrator.EXPECT().CreateSnapshot(gomock.Any(), snapshotConfig).DoAndReturn(
+		func(context.Context, *storage.SnapshotConfig) (*storage.SnapshotExternal, error) {
+			time.Sleep(2 * filesystemFreezeTimeout)
+			return &storage.SnapshotExternal{Snapshot: storage.Snapshot{Config: snapshotConfig}}, nil
+		})
+	mockOrchestrator.EXPECT().DeleteSnapshot(gomock.Any(), "vol1", "snap1").Return(nil)
+
+	_, err := p.CreateSnapshot(ctx, req)
+	assert.Equal(t, codes.Aborted, status.Code(err))
+	assert.True(t, freezer.thawed)
+}
+
+func TestFreezeVolumeFilesystem_AlreadyFrozen(t *testing.T) {
+	p := &Plugin{nodeName: "node1"}
+	p.frozenFilesystems = map[string]*frozenFilesystem{
+		"vol1": {id: "1", mountpoint: "/pods/1/vol1", autoThaw: time.NewTimer(time.Minute)},
+	}
+	defer p.frozenFilesystems["vol1"].autoThaw.Stop()
+
+	// The same freeze may be requested again, such as while the node reconnects
+	err := p.freezeVolumeFilesystem(ctx, &controllerAPIv1.FilesystemFreeze{ID: "1", Volume: "vol1"})
+	assert.NoError(t, err)
+
+	// but another freeze must wait for the first to end
+	err = p.freezeVolumeFilesystem(ctx, &controllerAPIv1.FilesystemFreeze{ID: "2", Volume: "vol1"})
+	assert.ErrorContains(t, err, "already frozen")
+}
+
+func TestHandleFilesystemFreeze_NothingToFreeze(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockClient := mockControllerAPI.NewMockTridentController(mockCtrl)
+	mockNodeHelper := mockNodeHelpers.NewMockNodeHelper(mockCtrl)
+	p := &Plugin{nodeName: "node1", restClient: mockClient, nodeHelper: mockNodeHelper}
+
+	freeze := &controllerAPIv1.FilesystemFreeze{ID: "1", Volume: "vol1", Timeout: time.Minute}
+
+	// NFS volumes have no filesystem of their own on the node
+	nfsVolume := &utils.VolumeTrackingInfo{
+		VolumePublishInfo: utils.VolumePublishInfo{
+			VolumeAccessInfo: utils.VolumeAccessInfo{
+				NfsAccessInfo: utils.NfsAccessInfo{NfsServerIP: "1.1.1.1", NfsPath: "/vol1"},
+			},
+		},
+		PublishedPaths: map[string]struct{}{"/pods/1/vol1": {}},
+	}
+	mockNodeHelper.EXPECT().ReadTrackingInfo(gomock.Any(), "vol1").Return(nfsVolume, nil)
+	mockClient.EXPECT().ReportFreeze(gomock.Any(), &controllerAPIv1.ReportFreezeRequest{
+		ID: "1", Node: "node1", Volume: "vol1",
+	}).Return(nil)
+	p.handleFilesystemFreeze(ctx, controllerAPIv1.NodeEventFreeze, freeze)
+	assert.Empty(t, p.frozenFilesystems)
+
+	// Volumes not on the node cannot be frozen
+	mockNodeHelper.EXPECT().ReadTrackingInfo(gomock.Any(), "vol1").Return(nil, errors.NotFoundError("not found"))
+	mockClient.EXPECT().ReportFreeze(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, report *controllerAPIv1.ReportFreezeRequest) error {
+			assert.Contains(t, report.Error, "could not read tracking info for volume vol1")
+			return nil
+		})
+	p.handleFilesystemFreeze(ctx, controllerAPIv1.NodeEventFreeze, freeze)
+}
+
+func TestGetFreezableMountpoint(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockNodeHelper := mockNodeHelpers.NewMockNodeHelper(mockCtrl)
+	p := &Plugin{nodeName: "node1", nodeHelper: mockNodeHelper}
+
+	iscsiVolume := func(fsType string) *utils.VolumeTrackingInfo {
+		return &utils.VolumeTrackingInfo{
+			VolumePublishInfo: utils.VolumePublishInfo{
+				FilesystemType: fsType,
+				VolumeAccessInfo: utils.VolumeAccessInfo{
+					IscsiAccessInfo: utils.IscsiAccessInfo{IscsiTargetIQN: "iqn.1992-08.com.netapp:sn.1"},
+				},
+			},
+			PublishedPaths: map[string]struct{}{"/pods/2/vol1": {}, "/pods/1/vol1": {}},
+		}
+	}
+
+	mockNodeHelper.EXPECT().ReadTrackingInfo(gomock.Any(), "vol1").Return(iscsiVolume("ext4"), nil)
+	mountpoint, err := p.getFreezableMountpoint(ctx, "vol1")
+	assert.NoError(t, err)
+	assert.Equal(t, "/pods/1/vol1", mountpoint)
+
+	mockNodeHelper.EXPECT().ReadTrackingInfo(gomock.Any(), "vol1").Return(iscsiVolume("raw"), nil)
+	mountpoint, err = p.getFreezableMountpoint(ctx, "vol1")
+	assert.NoError(t, err)
+	assert.Empty(t, mountpoint)
+}
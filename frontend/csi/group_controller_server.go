@@ -0,0 +1,238 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package csi
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	tridentconfig "github.com/netapp/trident/config"
+	. "github.com/netapp/trident/logging"
+	"github.com/netapp/trident/storage"
+	"github.com/netapp/trident/utils/errors"
+)
+
+func (p *Plugin) GroupControllerGetCapabilities(
+	ctx context.Context, _ *csi.GroupControllerGetCapabilitiesRequest,
+) (*csi.GroupControllerGetCapabilitiesResponse, error) {
+	ctx = SetContextWorkflow(ctx, WorkflowGroupSnapshotGetCapabilities)
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCSIFrontend)
+
+	fields := LogFields{"Method": "GroupControllerGetCapabilities", "Type": "CSI_GroupController"}
+	Logc(ctx).WithFields(fields).Trace(">>>> GroupControllerGetCapabilities")
+	defer Logc(ctx).WithFields(fields).Trace("<<<< GroupControllerGetCapabilities")
+
+	return &csi.GroupControllerGetCapabilitiesResponse{Capabilities: p.gcsCap}, nil
+}
+
+func (p *Plugin) CreateVolumeGroupSnapshot(
+	ctx context.Context, req *csi.CreateVolumeGroupSnapshotRequest,
+) (*csi.CreateVolumeGroupSnapshotResponse, error) {
+	ctx = SetContextWorkflow(ctx, WorkflowGroupSnapshotCreate)
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCSIFrontend)
+
+	fields := LogFields{"Method": "CreateVolumeGroupSnapshot", "Type": "CSI_GroupController"}
+	Logc(ctx).WithFields(fields).Debug(">>>> CreateVolumeGroupSnapshot")
+	defer Logc(ctx).WithFields(fields).Debug("<<<< CreateVolumeGroupSnapshot")
+
+	groupSnapshotName := req.GetName()
+	if groupSnapshotName == "" {
+		return nil, status.Error(codes.InvalidArgument, "no group snapshot name provided")
+	}
+
+	volumeNames := req.GetSourceVolumeIds()
+	if len(volumeNames) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no source volume IDs provided")
+	}
+
+	// Check for a pre-existing group snapshot with the same name
+	existingGroupSnapshot, err := p.orchestrator.GetGroupSnapshot(ctx, groupSnapshotName)
+	if err != nil && !errors.IsNotFoundError(err) {
+		return nil, p.getCSIErrorForOrchestratorError(err)
+	}
+
+	// If a pre-existing group snapshot of the same volumes is found, just return it
+	if existingGroupSnapshot != nil {
+		if !sameNames(existingGroupSnapshot.Config.VolumeNames, volumeNames) {
+			return nil, status.Error(codes.AlreadyExists, "group snapshot exists with a different set of volumes")
+		}
+		return p.getCreateVolumeGroupSnapshotResponse(ctx, existingGroupSnapshot)
+	}
+
+	groupSnapshotConfig := &storage.GroupSnapshotConfig{
+		Version:     tridentconfig.OrchestratorAPIVersion,
+		Name:        groupSnapshotName,
+		VolumeNames: volumeNames,
+	}
+
+	// Create the group snapshot
+	newGroupSnapshot, err := p.orchestrator.CreateGroupSnapshot(ctx, groupSnapshotConfig)
+	if err != nil {
+		if errors.IsNotFoundError(err) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		} else if errors.IsInvalidInputError(err) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		} else if errors.IsFoundError(err) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		} else if errors.IsUnsupportedError(err) || errors.IsVolumeStateError(err) {
+			// CSI snapshotter has no exponential backoff for retries, so slow it down here
+			time.Sleep(10 * time.Second)
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		} else if errors.IsMaxLimitReachedError(err) {
+			// CSI snapshotter has no exponential backoff for retries, so slow it down here
+			time.Sleep(10 * time.Second)
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return p.getCreateVolumeGroupSnapshotResponse(ctx, newGroupSnapshot)
+}
+
+func (p *Plugin) DeleteVolumeGroupSnapshot(
+	ctx context.Context, req *csi.DeleteVolumeGroupSnapshotRequest,
+) (*csi.DeleteVolumeGroupSnapshotResponse, error) {
+	ctx = SetContextWorkflow(ctx, WorkflowGroupSnapshotDelete)
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCSIFrontend)
+
+	fields := LogFields{"Method": "DeleteVolumeGroupSnapshot", "Type": "CSI_GroupController"}
+	Logc(ctx).WithFields(fields).Debug(">>>> DeleteVolumeGroupSnapshot")
+	defer Logc(ctx).WithFields(fields).Debug("<<<< DeleteVolumeGroupSnapshot")
+
+	groupSnapshotName := req.GetGroupSnapshotId()
+	if groupSnapshotName == "" {
+		return nil, status.Error(codes.InvalidArgument, "no group snapshot ID provided")
+	}
+
+	groupSnapshot, err := p.orchestrator.GetGroupSnapshot(ctx, groupSnapshotName)
+	if err != nil {
+		// In CSI, delete is idempotent, so don't return an error if the group snapshot doesn't exist
+		if errors.IsNotFoundError(err) {
+			return &csi.DeleteVolumeGroupSnapshotResponse{}, nil
+		}
+		return nil, p.getCSIErrorForOrchestratorError(err)
+	}
+
+	if len(req.GetSnapshotIds()) > 0 && !sameNames(groupSnapshot.SnapshotIDs, req.GetSnapshotIds()) {
+		return nil, status.Error(codes.InvalidArgument, "snapshot IDs do not match those of the group snapshot")
+	}
+
+	// Delete the group snapshot
+	if err = p.orchestrator.DeleteGroupSnapshot(ctx, groupSnapshotName); err != nil {
+
+		Logc(ctx).WithFields(LogFields{
+			"groupSnapshotName": groupSnapshotName,
+			"error":             err,
+		}).Debugf("Could not delete group snapshot.")
+
+		if !errors.IsNotFoundError(err) {
+			return nil, p.getCSIErrorForOrchestratorError(err)
+		}
+	}
+
+	return &csi.DeleteVolumeGroupSnapshotResponse{}, nil
+}
+
+func (p *Plugin) GetVolumeGroupSnapshot(
+	ctx context.Context, req *csi.GetVolumeGroupSnapshotRequest,
+) (*csi.GetVolumeGroupSnapshotResponse, error) {
+	ctx = SetContextWorkflow(ctx, WorkflowGroupSnapshotGet)
+	ctx = GenerateRequestContextForLayer(ctx, LogLayerCSIFrontend)
+
+	fields := LogFields{"Method": "GetVolumeGroupSnapshot", "Type": "CSI_GroupController"}
+	Logc(ctx).WithFields(fields).Debug(">>>> GetVolumeGroupSnapshot")
+	defer Logc(ctx).WithFields(fields).Debug("<<<< GetVolumeGroupSnapshot")
+
+	groupSnapshotName := req.GetGroupSnapshotId()
+	if groupSnapshotName == "" {
+		return nil, status.Error(codes.InvalidArgument, "no group snapshot ID provided")
+	}
+
+	groupSnapshot, err := p.orchestrator.GetGroupSnapshot(ctx, groupSnapshotName)
+	if err != nil {
+		return nil, p.getCSIErrorForOrchestratorError(err)
+	}
+
+	if len(req.GetSnapshotIds()) > 0 && !sameNames(groupSnapshot.SnapshotIDs, req.GetSnapshotIds()) {
+		return nil, status.Error(codes.InvalidArgument, "snapshot IDs do not match those of the group snapshot")
+	}
+
+	csiGroupSnapshot, err := p.getCSIGroupSnapshotFromTridentGroupSnapshot(ctx, groupSnapshot)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &csi.GetVolumeGroupSnapshotResponse{GroupSnapshot: csiGroupSnapshot}, nil
+}
+
+func (p *Plugin) getCreateVolumeGroupSnapshotResponse(
+	ctx context.Context, groupSnapshot *storage.GroupSnapshotExternal,
+) (*csi.CreateVolumeGroupSnapshotResponse, error) {
+	csiGroupSnapshot, err := p.getCSIGroupSnapshotFromTridentGroupSnapshot(ctx, groupSnapshot)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &csi.CreateVolumeGroupSnapshotResponse{GroupSnapshot: csiGroupSnapshot}, nil
+}
+
+func (p *Plugin) getCSIGroupSnapshotFromTridentGroupSnapshot(
+	ctx context.Context, groupSnapshot *storage.GroupSnapshotExternal,
+) (*csi.VolumeGroupSnapshot, error) {
+	createdSeconds, err := time.Parse(time.RFC3339, groupSnapshot.Created)
+	if err != nil {
+		Logc(ctx).WithField("time", groupSnapshot.Created).Error("Could not parse RFC3339 group snapshot time.")
+		createdSeconds = time.Now()
+	}
+
+	readyToUse := true
+	csiSnapshots := make([]*csi.Snapshot, 0, len(groupSnapshot.SnapshotIDs))
+
+	for _, snapshotID := range groupSnapshot.SnapshotIDs {
+		volumeName, snapshotName, err := storage.ParseSnapshotID(snapshotID)
+		if err != nil {
+			return nil, err
+		}
+		snapshot, err := p.orchestrator.GetSnapshot(ctx, volumeName, snapshotName)
+		if err != nil {
+			return nil, fmt.Errorf("could not get snapshot %s of group snapshot %s; %v",
+				snapshotID, groupSnapshot.ID(), err)
+		}
+		csiSnapshot, err := p.getCSISnapshotFromTridentSnapshot(ctx, snapshot)
+		if err != nil {
+			return nil, err
+		}
+		readyToUse = readyToUse && csiSnapshot.ReadyToUse
+		csiSnapshots = append(csiSnapshots, csiSnapshot)
+	}
+
+	return &csi.VolumeGroupSnapshot{
+		GroupSnapshotId: groupSnapshot.ID(),
+		Snapshots:       csiSnapshots,
+		CreationTime:    &timestamp.Timestamp{Seconds: createdSeconds.Unix()},
+		ReadyToUse:      readyToUse,
+	}, nil
+}
+
+// sameNames reports whether two lists contain the same names, regardless of order.
+func sameNames(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sortedA := append([]string(nil), a...)
+	sortedB := append([]string(nil), b...)
+	sort.Strings(sortedA)
+	sort.Strings(sortedB)
+	for i := range sortedA {
+		if sortedA[i] != sortedB[i] {
+			return false
+		}
+	}
+	return true
+}
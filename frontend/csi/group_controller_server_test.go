@@ -0,0 +1,207 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package csi
+
+import (
+	"testing"
+
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mockcore "github.com/netapp/trident/mocks/mock_core"
+	mockhelpers "github.com/netapp/trident/mocks/mock_frontend/mock_csi/mock_controller_helpers"
+	"github.com/netapp/trident/storage"
+	"github.com/netapp/trident/utils/errors"
+)
+
+func generateFakeGroupSnapshot(name string, volumeNames ...string) (
+	*storage.GroupSnapshotExternal, []*storage.SnapshotExternal,
+) {
+	snapshots := make([]*storage.SnapshotExternal, 0, len(volumeNames))
+	snapshotIDs := make([]string, 0, len(volumeNames))
+	for _, volumeName := range volumeNames {
+		snapshot := &storage.Snapshot{
+			Config: &storage.SnapshotConfig{
+				Name:              name,
+				VolumeName:        volumeName,
+				GroupSnapshotName: name,
+			},
+			Created:   "2024-05-15T17:04:09Z",
+			SizeBytes: 1024,
+			State:     storage.SnapshotStateOnline,
+		}
+		snapshots = append(snapshots, snapshot.ConstructExternal())
+		snapshotIDs = append(snapshotIDs, snapshot.ID())
+	}
+
+	groupSnapshot := storage.NewGroupSnapshot(&storage.GroupSnapshotConfig{
+		Name:        name,
+		VolumeNames: volumeNames,
+	}, snapshotIDs, "2024-05-15T17:04:09Z")
+
+	return groupSnapshot.ConstructExternal(), snapshots
+}
+
+func expectGroupSnapshotMembers(mockOrchestrator *mockcore.MockOrchestrator, snapshots []*storage.SnapshotExternal) {
+	volume := &storage.Volume{Config: &storage.VolumeConfig{Size: "1Gi"}}
+	for _, snapshot := range snapshots {
+		mockOrchestrator.EXPECT().GetSnapshot(gomock.Any(), snapshot.Config.VolumeName, snapshot.Config.Name).
+			Return(snapshot, nil)
+		mockOrchestrator.EXPECT().GetVolume(gomock.Any(), snapshot.Config.VolumeName).
+			Return(volume.ConstructExternal(), nil)
+	}
+}
+
+func TestCreateVolumeGroupSnapshot(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+	mockHelper := mockhelpers.NewMockControllerHelper(mockCtrl)
+	controllerServer := generateController(mockOrchestrator, mockHelper)
+
+	groupSnapshot, snapshots := generateFakeGroupSnapshot("groupsnap", "vol1", "vol2")
+	req := &csi.CreateVolumeGroupSnapshotRequest{Name: "groupsnap", SourceVolumeIds: []string{"vol1", "vol2"}}
+
+	// New group snapshot
+	mockOrchestrator.EXPECT().GetGroupSnapshot(gomock.Any(), "groupsnap").
+		Return(nil, errors.NotFoundError("group snapshot %s not found", "groupsnap"))
+	mockOrchestrator.EXPECT().CreateGroupSnapshot(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ any, config *storage.GroupSnapshotConfig) (*storage.GroupSnapshotExternal, error) {
+			assert.Equal(t, "groupsnap", config.Name)
+			assert.Equal(t, []string{"vol1", "vol2"}, config.VolumeNames)
+			return groupSnapshot, nil
+		})
+	expectGroupSnapshotMembers(mockOrchestrator, snapshots)
+
+	resp, err := controllerServer.CreateVolumeGroupSnapshot(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, "groupsnap", resp.GroupSnapshot.GroupSnapshotId)
+	assert.True(t, resp.GroupSnapshot.ReadyToUse)
+	if assert.Len(t, resp.GroupSnapshot.Snapshots, 2) {
+		assert.Equal(t, "vol1/groupsnap", resp.GroupSnapshot.Snapshots[0].SnapshotId)
+		assert.Equal(t, "groupsnap", resp.GroupSnapshot.Snapshots[0].GroupSnapshotId)
+	}
+
+	// Repeating the request returns the existing group snapshot
+	mockOrchestrator.EXPECT().GetGroupSnapshot(gomock.Any(), "groupsnap").Return(groupSnapshot, nil)
+	expectGroupSnapshotMembers(mockOrchestrator, snapshots)
+
+	resp, err = controllerServer.CreateVolumeGroupSnapshot(ctx,
+		&csi.CreateVolumeGroupSnapshotRequest{Name: "groupsnap", SourceVolumeIds: []string{"vol2", "vol1"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "groupsnap", resp.GroupSnapshot.GroupSnapshotId)
+
+	// The same name with different volumes is a conflict
+	mockOrchestrator.EXPECT().GetGroupSnapshot(gomock.Any(), "groupsnap").Return(groupSnapshot, nil)
+
+	_, err = controllerServer.CreateVolumeGroupSnapshot(ctx,
+		&csi.CreateVolumeGroupSnapshotRequest{Name: "groupsnap", SourceVolumeIds: []string{"vol1", "vol3"}})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+}
+
+func TestCreateVolumeGroupSnapshot_Errors(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+	mockHelper := mockhelpers.NewMockControllerHelper(mockCtrl)
+	controllerServer := generateController(mockOrchestrator, mockHelper)
+
+	// Missing arguments
+	_, err := controllerServer.CreateVolumeGroupSnapshot(ctx,
+		&csi.CreateVolumeGroupSnapshotRequest{SourceVolumeIds: []string{"vol1"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = controllerServer.CreateVolumeGroupSnapshot(ctx, &csi.CreateVolumeGroupSnapshotRequest{Name: "groupsnap"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Orchestrator errors
+	tests := []struct {
+		name     string
+		err      error
+		expected codes.Code
+	}{
+		{"volume not found", errors.NotFoundError("volume %s not found", "vol1"), codes.NotFound},
+		{"invalid config", errors.InvalidInputError("invalid"), codes.InvalidArgument},
+		{"snapshot exists", errors.FoundError("snapshot %s exists", "vol1/groupsnap"), codes.AlreadyExists},
+		{"other failure", errors.New("failed"), codes.Internal},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockOrchestrator.EXPECT().GetGroupSnapshot(gomock.Any(), "groupsnap").
+				Return(nil, errors.NotFoundError("group snapshot %s not found", "groupsnap"))
+			mockOrchestrator.EXPECT().CreateGroupSnapshot(gomock.Any(), gomock.Any()).Return(nil, test.err)
+
+			_, err := controllerServer.CreateVolumeGroupSnapshot(ctx,
+				&csi.CreateVolumeGroupSnapshotRequest{Name: "groupsnap", SourceVolumeIds: []string{"vol1", "vol2"}})
+			assert.Equal(t, test.expected, status.Code(err))
+		})
+	}
+}
+
+func TestDeleteVolumeGroupSnapshot(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+	mockHelper := mockhelpers.NewMockControllerHelper(mockCtrl)
+	controllerServer := generateController(mockOrchestrator, mockHelper)
+
+	groupSnapshot, _ := generateFakeGroupSnapshot("groupsnap", "vol1", "vol2")
+
+	// Missing group snapshot ID
+	_, err := controllerServer.DeleteVolumeGroupSnapshot(ctx, &csi.DeleteVolumeGroupSnapshotRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Mismatched snapshot IDs
+	mockOrchestrator.EXPECT().GetGroupSnapshot(gomock.Any(), "groupsnap").Return(groupSnapshot, nil)
+	_, err = controllerServer.DeleteVolumeGroupSnapshot(ctx, &csi.DeleteVolumeGroupSnapshotRequest{
+		GroupSnapshotId: "groupsnap", SnapshotIds: []string{"vol1/groupsnap"},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Successful delete
+	mockOrchestrator.EXPECT().GetGroupSnapshot(gomock.Any(), "groupsnap").Return(groupSnapshot, nil)
+	mockOrchestrator.EXPECT().DeleteGroupSnapshot(gomock.Any(), "groupsnap").Return(nil)
+	_, err = controllerServer.DeleteVolumeGroupSnapshot(ctx, &csi.DeleteVolumeGroupSnapshotRequest{
+		GroupSnapshotId: "groupsnap", SnapshotIds: []string{"vol2/groupsnap", "vol1/groupsnap"},
+	})
+	assert.NoError(t, err)
+
+	// Deleting a missing group snapshot succeeds
+	mockOrchestrator.EXPECT().GetGroupSnapshot(gomock.Any(), "groupsnap").
+		Return(nil, errors.NotFoundError("group snapshot %s not found", "groupsnap"))
+	_, err = controllerServer.DeleteVolumeGroupSnapshot(ctx,
+		&csi.DeleteVolumeGroupSnapshotRequest{GroupSnapshotId: "groupsnap"})
+	assert.NoError(t, err)
+}
+
+func TestGetVolumeGroupSnapshot(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+	mockHelper := mockhelpers.NewMockControllerHelper(mockCtrl)
+	controllerServer := generateController(mockOrchestrator, mockHelper)
+
+	groupSnapshot, snapshots := generateFakeGroupSnapshot("groupsnap", "vol1", "vol2")
+
+	// Found
+	mockOrchestrator.EXPECT().GetGroupSnapshot(gomock.Any(), "groupsnap").Return(groupSnapshot, nil)
+	expectGroupSnapshotMembers(mockOrchestrator, snapshots)
+	resp, err := controllerServer.GetVolumeGroupSnapshot(ctx,
+		&csi.GetVolumeGroupSnapshotRequest{GroupSnapshotId: "groupsnap"})
+	assert.NoError(t, err)
+	assert.Len(t, resp.GroupSnapshot.Snapshots, 2)
+
+	// Not found
+	mockOrchestrator.EXPECT().GetGroupSnapshot(gomock.Any(), "groupsnap").
+		Return(nil, errors.NotFoundError("group snapshot %s not found", "groupsnap"))
+	_, err = controllerServer.GetVolumeGroupSnapshot(ctx,
+		&csi.GetVolumeGroupSnapshotRequest{GroupSnapshotId: "groupsnap"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Mismatched snapshot IDs
+	mockOrchestrator.EXPECT().GetGroupSnapshot(gomock.Any(), "groupsnap").Return(groupSnapshot, nil)
+	_, err = controllerServer.GetVolumeGroupSnapshot(ctx, &csi.GetVolumeGroupSnapshotRequest{
+		GroupSnapshotId: "groupsnap", SnapshotIds: []string{"vol1/groupsnap", "vol3/groupsnap"},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,258 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package csi
+
+import (
+	"context"
+	"runtime"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	tridentconfig "github.com/netapp/trident/config"
+	. "github.com/netapp/trident/logging"
+	sa "github.com/netapp/trident/storage_attribute"
+	"github.com/netapp/trident/utils"
+)
+
+const nodeMetricsSubsystem = "node"
+
+// Protocols by which node operations are recorded
+const (
+	metricsProtocolNFS         = "nfs"
+	metricsProtocolSMB         = "smb"
+	metricsProtocolISCSI       = "iscsi"
+	metricsProtocolNVMe        = "nvme"
+	metricsProtocolBlockOnFile = "block-on-file"
+)
+
+// States of the iSCSI sessions to published portals
+const (
+	iscsiSessionHealthy   = "healthy"
+	iscsiSessionStale     = "stale"
+	iscsiSessionUnhealthy = "unhealthy"
+)
+
+var (
+	nodeOperationDurationHistogram = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: tridentconfig.OrchestratorName,
+			Subsystem: nodeMetricsSubsystem,
+			Name:      "operation_duration_seconds",
+			Help:      "The duration of CSI node operations by protocol",
+			Buckets:   []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 20, 30, 60, 120, 300},
+		},
+		[]string{"operation", "protocol", "success"},
+	)
+	iscsiSessionsGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: tridentconfig.OrchestratorName,
+			Subsystem: nodeMetricsSubsystem,
+			Name:      "iscsi_sessions",
+			Help:      "The iSCSI sessions to published portals by state, as of the last self-healing inspection",
+		},
+		[]string{"portal", "state"},
+	)
+	iscsiPathsGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: tridentconfig.OrchestratorName,
+			Subsystem: nodeMetricsSubsystem,
+			Name:      "iscsi_paths",
+			Help:      "The LUNs reached through each iSCSI portal, as of the last self-healing inspection",
+		},
+		[]string{"portal"},
+	)
+	nvmeSubsystemPathsGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: tridentconfig.OrchestratorName,
+			Subsystem: nodeMetricsSubsystem,
+			Name:      "nvme_subsystem_paths",
+			Help:      "The paths to each NVMe subsystem by state, as of the last self-healing inspection",
+		},
+		[]string{"subsystem", "state"},
+	)
+	selfHealingActionsCounter = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: tridentconfig.OrchestratorName,
+			Subsystem: nodeMetricsSubsystem,
+			Name:      "self_healing_actions_total",
+			Help:      "The self-healing actions taken on the node's iSCSI and NVMe sessions",
+		},
+		[]string{"protocol", "action", "success"},
+	)
+)
+
+// loopDevicesDesc describes the loop devices attached, and mounted, on the node, which loopDeviceCollector
+// counts whenever metrics are gathered.
+var loopDevicesDesc = prometheus.NewDesc(
+	prometheus.BuildFQName(tridentconfig.OrchestratorName, nodeMetricsSubsystem, "loop_devices"),
+	"The loop devices attached, and mounted, on the node",
+	[]string{"state"}, nil,
+)
+
+// recordNodeTiming records the duration of a node operation, by the protocol it finds once the operation is done.
+func recordNodeTiming(operation string, protocol *string, err *error) func() {
+	startTime := time.Now()
+	return func() {
+		success := strconv.FormatBool(*err == nil)
+		nodeOperationDurationHistogram.WithLabelValues(operation, *protocol, success).
+			Observe(time.Since(startTime).Seconds())
+	}
+}
+
+// metricsProtocolFromPublishContext returns the protocol by which a volume is being staged or published.
+func metricsProtocolFromPublishContext(publishContext map[string]string) string {
+	switch publishContext["protocol"] {
+	case string(tridentconfig.File):
+		if publishContext["filesystemType"] == utils.SMB {
+			return metricsProtocolSMB
+		}
+		return metricsProtocolNFS
+	case string(tridentconfig.Block):
+		if publishContext["SANType"] == sa.NVMe {
+			return metricsProtocolNVMe
+		}
+		return metricsProtocolISCSI
+	case string(tridentconfig.BlockOnFile):
+		return metricsProtocolBlockOnFile
+	}
+	return ""
+}
+
+// metricsProtocolFromPublishInfo returns the protocol by which a staged volume was attached.
+func metricsProtocolFromPublishInfo(publishInfo *utils.VolumePublishInfo) string {
+	protocol, err := getVolumeProtocolFromPublishInfo(publishInfo)
+	if err != nil {
+		return ""
+	}
+
+	switch protocol {
+	case tridentconfig.File:
+		if publishInfo.FilesystemType == utils.SMB {
+			return metricsProtocolSMB
+		}
+		return metricsProtocolNFS
+	case tridentconfig.Block:
+		if publishInfo.SANType == sa.NVMe {
+			return metricsProtocolNVMe
+		}
+		return metricsProtocolISCSI
+	case tridentconfig.BlockOnFile:
+		return metricsProtocolBlockOnFile
+	}
+	return ""
+}
+
+// updateISCSISessionMetrics records the state of the sessions to each published portal, and the LUNs reached
+// through each portal, as found by iSCSI self-healing.
+func updateISCSISessionMetrics(published, current *utils.ISCSISessions, stalePortals, nonStalePortals []string) {
+	iscsiSessionsGauge.Reset()
+	iscsiPathsGauge.Reset()
+
+	states := make(map[string]string)
+	if !published.IsEmpty() {
+		for portal := range published.Info {
+			states[portal] = iscsiSessionHealthy
+		}
+	}
+	for _, portal := range stalePortals {
+		states[portal] = iscsiSessionStale
+	}
+	for _, portal := range nonStalePortals {
+		states[portal] = iscsiSessionUnhealthy
+	}
+
+	for portal, state := range states {
+		for _, s := range []string{iscsiSessionHealthy, iscsiSessionStale, iscsiSessionUnhealthy} {
+			value := 0.0
+			if s == state {
+				value = 1
+			}
+			iscsiSessionsGauge.WithLabelValues(portal, s).Set(value)
+		}
+	}
+
+	if !current.IsEmpty() {
+		for portal, sessionData := range current.Info {
+			if sessionData == nil {
+				continue
+			}
+			iscsiPathsGauge.WithLabelValues(portal).Set(float64(len(sessionData.LUNs.Info)))
+		}
+	}
+}
+
+// updateNVMeSessionMetrics records the state of the paths to each NVMe subsystem, as found by NVMe self-healing.
+func updateNVMeSessionMetrics(current *utils.NVMeSessions) {
+	nvmeSubsystemPathsGauge.Reset()
+
+	if current == nil || current.IsEmpty() {
+		return
+	}
+	for nqn, sessionData := range current.Info {
+		if sessionData == nil {
+			continue
+		}
+		for _, path := range sessionData.Subsystem.Paths {
+			nvmeSubsystemPathsGauge.WithLabelValues(nqn, path.State).Inc()
+		}
+	}
+}
+
+// loopDeviceCollector counts the loop devices on the node when metrics are gathered, so the count does not
+// depend on any of the node's background tasks being enabled.
+type loopDeviceCollector struct{}
+
+// registerLoopDeviceMetrics starts reporting the node's loop devices.
+func registerLoopDeviceMetrics(ctx context.Context) {
+	if runtime.GOOS == "windows" {
+		return
+	}
+	if err := prometheus.Register(loopDeviceCollector{}); err != nil {
+		if _, ok := err.(prometheus.AlreadyRegisteredError); !ok {
+			Logc(ctx).WithError(err).Warning("Could not register loop device metrics.")
+		}
+	}
+}
+
+func (loopDeviceCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- loopDevicesDesc
+}
+
+func (loopDeviceCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx := GenerateRequestContext(nil, "", ContextSourceInternal, WorkflowNone, LogLayerCSIFrontend)
+
+	devices, err := utils.GetAllLoopDevices(ctx)
+	if err != nil {
+		Logc(ctx).WithError(err).Debug("Could not list loop devices for metrics.")
+		return
+	}
+	mountedDevices, err := utils.GetMountedLoopDevices(ctx)
+	if err != nil {
+		Logc(ctx).WithError(err).Debug("Could not list mounted loop devices for metrics.")
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(loopDevicesDesc, prometheus.GaugeValue, float64(len(devices)), "attached")
+	ch <- prometheus.MustNewConstMetric(loopDevicesDesc, prometheus.GaugeValue, float64(len(mountedDevices)), "mounted")
+}
+
+// iscsiActionMetricsLabel returns the label by which an iSCSI self-healing action is counted.
+func iscsiActionMetricsLabel(action utils.ISCSIAction) string {
+	switch action {
+	case utils.Scan:
+		return "scan"
+	case utils.LoginScan:
+		return "login_scan"
+	case utils.LogoutLoginScan:
+		return "logout_login_scan"
+	}
+	return "none"
+}
+
+// recordSelfHealingAction counts an action taken by iSCSI or NVMe self-healing.
+func recordSelfHealingAction(protocol, action string, err error) {
+	selfHealingActionsCounter.WithLabelValues(protocol, action, strconv.FormatBool(err == nil)).Inc()
+}
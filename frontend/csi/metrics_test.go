@@ -0,0 +1,132 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package csi
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netapp/trident/utils"
+)
+
+func TestMetricsProtocolFromPublishContext(t *testing.T) {
+	tests := map[string]struct {
+		publishContext map[string]string
+		expected       string
+	}{
+		"NFS":         {map[string]string{"protocol": "file"}, metricsProtocolNFS},
+		"SMB":         {map[string]string{"protocol": "file", "filesystemType": utils.SMB}, metricsProtocolSMB},
+		"iSCSI":       {map[string]string{"protocol": "block"}, metricsProtocolISCSI},
+		"NVMe":        {map[string]string{"protocol": "block", "SANType": "nvme"}, metricsProtocolNVMe},
+		"BlockOnFile": {map[string]string{"protocol": "blockOnFile"}, metricsProtocolBlockOnFile},
+		"Unknown":     {map[string]string{}, ""},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, metricsProtocolFromPublishContext(test.publishContext))
+		})
+	}
+}
+
+func TestMetricsProtocolFromPublishInfo(t *testing.T) {
+	publishInfo := &utils.VolumePublishInfo{}
+	publishInfo.IscsiTargetIQN = "iqn.1992-08.com.netapp:sn.1"
+	assert.Equal(t, metricsProtocolISCSI, metricsProtocolFromPublishInfo(publishInfo))
+
+	assert.Equal(t, "", metricsProtocolFromPublishInfo(&utils.VolumePublishInfo{}))
+}
+
+func TestRecordNodeTiming(t *testing.T) {
+	nodeOperationDurationHistogram.Reset()
+
+	f := func() (err error) {
+		protocol := ""
+		defer recordNodeTiming("NodeStageVolume", &protocol, &err)()
+
+		protocol = metricsProtocolISCSI
+		return errors.New("login failed")
+	}
+	assert.Error(t, f())
+
+	// The operation is recorded by the protocol found once it is done
+	assert.Equal(t, 1, testutil.CollectAndCount(nodeOperationDurationHistogram))
+	assert.True(t, nodeOperationDurationHistogram.DeleteLabelValues("NodeStageVolume", metricsProtocolISCSI, "false"))
+}
+
+func TestUpdateISCSISessionMetrics(t *testing.T) {
+	published := &utils.ISCSISessions{Info: map[string]*utils.ISCSISessionData{
+		"10.0.0.1": {}, "10.0.0.2": {}, "10.0.0.3": {},
+	}}
+	current := &utils.ISCSISessions{Info: map[string]*utils.ISCSISessionData{
+		"10.0.0.1": {LUNs: utils.LUNs{Info: map[int32]string{0: "vol1", 1: "vol2"}}},
+		"10.0.0.2": {},
+	}}
+
+	updateISCSISessionMetrics(published, current, []string{"10.0.0.2"}, []string{"10.0.0.3"})
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(iscsiSessionsGauge.WithLabelValues("10.0.0.1", iscsiSessionHealthy)))
+	assert.Equal(t, 0.0, testutil.ToFloat64(iscsiSessionsGauge.WithLabelValues("10.0.0.1", iscsiSessionStale)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(iscsiSessionsGauge.WithLabelValues("10.0.0.2", iscsiSessionStale)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(iscsiSessionsGauge.WithLabelValues("10.0.0.3", iscsiSessionUnhealthy)))
+	assert.Equal(t, 2.0, testutil.ToFloat64(iscsiPathsGauge.WithLabelValues("10.0.0.1")))
+	assert.Equal(t, 0.0, testutil.ToFloat64(iscsiPathsGauge.WithLabelValues("10.0.0.2")))
+
+	// Portals no longer published are no longer reported
+	updateISCSISessionMetrics(&utils.ISCSISessions{}, &utils.ISCSISessions{}, nil, nil)
+	assert.Equal(t, 0, testutil.CollectAndCount(iscsiSessionsGauge))
+	assert.Equal(t, 0, testutil.CollectAndCount(iscsiPathsGauge))
+}
+
+func TestUpdateNVMeSessionMetrics(t *testing.T) {
+	current := &utils.NVMeSessions{Info: map[string]*utils.NVMeSessionData{
+		"nqn.1": {Subsystem: utils.NVMeSubsystem{Paths: []utils.Path{
+			{Address: "10.0.0.1", State: "live"},
+			{Address: "10.0.0.2", State: "live"},
+			{Address: "10.0.0.3", State: "connecting"},
+		}}},
+	}}
+
+	updateNVMeSessionMetrics(current)
+
+	assert.Equal(t, 2.0, testutil.ToFloat64(nvmeSubsystemPathsGauge.WithLabelValues("nqn.1", "live")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(nvmeSubsystemPathsGauge.WithLabelValues("nqn.1", "connecting")))
+
+	updateNVMeSessionMetrics(nil)
+	assert.Equal(t, 0, testutil.CollectAndCount(nvmeSubsystemPathsGauge))
+}
+
+func TestRecordSelfHealingAction(t *testing.T) {
+	selfHealingActionsCounter.Reset()
+
+	recordSelfHealingAction(metricsProtocolISCSI, iscsiActionMetricsLabel(utils.LoginScan), nil)
+	recordSelfHealingAction(metricsProtocolISCSI, iscsiActionMetricsLabel(utils.LoginScan), errors.New("failed"))
+	recordSelfHealingAction(metricsProtocolNVMe, "connect", nil)
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(
+		selfHealingActionsCounter.WithLabelValues(metricsProtocolISCSI, "login_scan", "true")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(
+		selfHealingActionsCounter.WithLabelValues(metricsProtocolISCSI, "login_scan", "false")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(
+		selfHealingActionsCounter.WithLabelValues(metricsProtocolNVMe, "connect", "true")))
+}
+
+func TestRegisterLoopDeviceMetrics(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("loop devices are not reported on Windows")
+	}
+
+	// Registering again, as when the plugin is reactivated, is harmless
+	registerLoopDeviceMetrics(context.Background())
+	registerLoopDeviceMetrics(context.Background())
+	assert.True(t, prometheus.Unregister(loopDeviceCollector{}), "collector not registered")
+
+	// The node may have no loop support at all, in which case nothing is reported rather than zeros
+	count := testutil.CollectAndCount(loopDeviceCollector{})
+	assert.True(t, count == 0 || count == 2, "unexpected loop device metrics %d", count)
+}
@@ -21,6 +21,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	tridentconfig "github.com/netapp/trident/config"
+	controllerAPIv1 "github.com/netapp/trident/frontend/csi/controller_api/v1"
 	. "github.com/netapp/trident/logging"
 	sa "github.com/netapp/trident/storage_attribute"
 	"github.com/netapp/trident/utils"
@@ -65,7 +66,7 @@ func attemptLock(ctx context.Context, lockContext string, lockTimeout time.Durat
 
 func (p *Plugin) NodeStageVolume(
 	ctx context.Context, req *csi.NodeStageVolumeRequest,
-) (*csi.NodeStageVolumeResponse, error) {
+) (resp *csi.NodeStageVolumeResponse, err error) {
 	ctx = SetContextWorkflow(ctx, WorkflowNodeStage)
 	ctx = GenerateRequestContextForLayer(ctx, LogLayerCSIFrontend)
 
@@ -73,6 +74,9 @@ func (p *Plugin) NodeStageVolume(
 	Logc(ctx).WithFields(fields).Debug(">>>> NodeStageVolume")
 	defer Logc(ctx).WithFields(fields).Debug("<<<< NodeStageVolume")
 
+	metricsProtocol := metricsProtocolFromPublishContext(req.PublishContext)
+	defer recordNodeTiming("NodeStageVolume", &metricsProtocol, &err)()
+
 	lockContext := "NodeStageVolume-" + req.GetVolumeId()
 	defer utils.Unlock(ctx, lockContext, lockID)
 
@@ -107,7 +111,7 @@ func (p *Plugin) NodeUnstageVolume(
 // nodeUnstageVolume detaches a volume from a node. Setting force=true may cause data loss.
 func (p *Plugin) nodeUnstageVolume(
 	ctx context.Context, req *csi.NodeUnstageVolumeRequest, force bool,
-) (*csi.NodeUnstageVolumeResponse, error) {
+) (resp *csi.NodeUnstageVolumeResponse, err error) {
 	fields := LogFields{
 		"Method": "NodeUnstageVolume",
 		"Type":   "CSI_Node",
@@ -116,6 +120,9 @@ func (p *Plugin) nodeUnstageVolume(
 	Logc(ctx).WithFields(fields).Debug(">>>> NodeUnstageVolume")
 	defer Logc(ctx).WithFields(fields).Debug("<<<< NodeUnstageVolume")
 
+	var metricsProtocol string
+	defer recordNodeTiming("NodeUnstageVolume", &metricsProtocol, &err)()
+
 	lockContext := "NodeUnstageVolume-" + req.GetVolumeId()
 	defer utils.Unlock(ctx, lockContext, lockID)
 
@@ -152,6 +159,7 @@ func (p *Plugin) nodeUnstageVolume(
 	}
 
 	publishInfo := &trackingInfo.VolumePublishInfo
+	metricsProtocol = metricsProtocolFromPublishInfo(publishInfo)
 
 	protocol, err := getVolumeProtocolFromPublishInfo(publishInfo)
 	if err != nil {
@@ -191,7 +199,7 @@ func (p *Plugin) nodeUnstageVolume(
 
 func (p *Plugin) NodePublishVolume(
 	ctx context.Context, req *csi.NodePublishVolumeRequest,
-) (*csi.NodePublishVolumeResponse, error) {
+) (resp *csi.NodePublishVolumeResponse, err error) {
 	ctx = SetContextWorkflow(ctx, WorkflowNodePublish)
 	ctx = GenerateRequestContextForLayer(ctx, LogLayerCSIFrontend)
 
@@ -199,6 +207,9 @@ func (p *Plugin) NodePublishVolume(
 	Logc(ctx).WithFields(fields).Debug(">>>> NodePublishVolume")
 	defer Logc(ctx).WithFields(fields).Debug("<<<< NodePublishVolume")
 
+	metricsProtocol := metricsProtocolFromPublishContext(req.PublishContext)
+	defer recordNodeTiming("NodePublishVolume", &metricsProtocol, &err)()
+
 	lockContext := "NodePublishVolume-" + req.GetVolumeId()
 	defer utils.Unlock(ctx, lockContext, lockID)
 
@@ -234,7 +245,7 @@ func (p *Plugin) NodePublishVolume(
 
 func (p *Plugin) NodeUnpublishVolume(
 	ctx context.Context, req *csi.NodeUnpublishVolumeRequest,
-) (*csi.NodeUnpublishVolumeResponse, error) {
+) (resp *csi.NodeUnpublishVolumeResponse, err error) {
 	ctx = SetContextWorkflow(ctx, WorkflowNodeUnpublish)
 	ctx = GenerateRequestContextForLayer(ctx, LogLayerCSIFrontend)
 
@@ -242,6 +253,10 @@ func (p *Plugin) NodeUnpublishVolume(
 	Logc(ctx).WithFields(fields).Debug(">>>> NodeUnpublishVolume")
 	defer Logc(ctx).WithFields(fields).Debug("<<<< NodeUnpublishVolume")
 
+	// Unpublishing is the same for every protocol
+	var metricsProtocol string
+	defer recordNodeTiming("NodeUnpublishVolume", &metricsProtocol, &err)()
+
 	lockContext := "NodeUnpublishVolume-" + req.GetVolumeId()
 	defer utils.Unlock(ctx, lockContext, lockID)
 
@@ -323,10 +338,13 @@ func (p *Plugin) NodeUnpublishVolume(
 
 func (p *Plugin) NodeGetVolumeStats(
 	ctx context.Context, req *csi.NodeGetVolumeStatsRequest,
-) (*csi.NodeGetVolumeStatsResponse, error) {
+) (resp *csi.NodeGetVolumeStatsResponse, err error) {
 	ctx = SetContextWorkflow(ctx, WorkflowVolumeGetStats)
 	ctx = GenerateRequestContextForLayer(ctx, LogLayerCSIFrontend)
 
+	var metricsProtocol string
+	defer recordNodeTiming("NodeGetVolumeStats", &metricsProtocol, &err)()
+
 	if req.GetVolumeId() == "" {
 		return nil, status.Error(codes.InvalidArgument, "empty volume id provided")
 	}
@@ -354,11 +372,13 @@ func (p *Plugin) NodeGetVolumeStats(
 			}
 		}
 		publishInfo := &trackingInfo.VolumePublishInfo
+		metricsProtocol = metricsProtocolFromPublishInfo(publishInfo)
 
 		isRawBlock = publishInfo.FilesystemType == tridentconfig.FsRaw
 	}
 	if isRawBlock {
-		// Return no capacity info for raw block volumes, we cannot reliably determine the capacity.
+		// Return no capacity info for raw block volumes, we cannot reliably determine the capacity.  Nor is there
+		// a filesystem mount whose condition could be checked.
 		return &csi.NodeGetVolumeStatsResponse{}, nil
 	} else {
 		// If filesystem, return usage reported by FS.
@@ -368,6 +388,10 @@ func (p *Plugin) NodeGetVolumeStats(
 			Logc(ctx).Errorf("unable to get filesystem stats at path: %s; %v", req.GetVolumePath(), err)
 			return nil, status.Error(codes.Unknown, "Failed to get filesystem stats")
 		}
+		condition := p.getNodeVolumeCondition(ctx, req.GetVolumePath())
+		if !condition.Abnormal && capacity > 0 && available <= 0 {
+			condition = &csi.VolumeCondition{Abnormal: true, Message: "filesystem has no free space"}
+		}
 		return &csi.NodeGetVolumeStatsResponse{
 			Usage: []*csi.VolumeUsage{
 				{
@@ -383,17 +407,45 @@ func (p *Plugin) NodeGetVolumeStats(
 					Used:      inodesUsed,
 				},
 			},
+			VolumeCondition: condition,
 		}, nil
 	}
 }
 
+// getNodeVolumeCondition reports the condition of a volume published at the specified path on this node.
+func (p *Plugin) getNodeVolumeCondition(ctx context.Context, volumePath string) *csi.VolumeCondition {
+	mounts, err := utils.GetSelfMountInfo(ctx)
+	if err != nil {
+		Logc(ctx).WithError(err).Debug("Could not read mount info; volume condition not checked.")
+		return &csi.VolumeCondition{Abnormal: false, Message: "volume condition not checked"}
+	}
+	return getVolumeConditionFromMounts(volumePath, mounts)
+}
+
+// getVolumeConditionFromMounts inspects the mount of a published volume.  A filesystem whose superblock is
+// read-only while the mount itself was requested read-write has usually been remounted read-only by the
+// kernel following I/O errors, so it is reported as abnormal.
+func getVolumeConditionFromMounts(volumePath string, mounts []utils.MountInfo) *csi.VolumeCondition {
+	for _, mount := range mounts {
+		if mount.MountPoint != volumePath {
+			continue
+		}
+		if utils.SliceContainsString(mount.SuperOptions, "ro") && !utils.SliceContainsString(mount.MountOptions, "ro") {
+			return &csi.VolumeCondition{Abnormal: true, Message: "filesystem has been remounted read-only"}
+		}
+		return &csi.VolumeCondition{Abnormal: false, Message: "volume is mounted"}
+	}
+
+	return &csi.VolumeCondition{Abnormal: true, Message: fmt.Sprintf("no mount found at path %s", volumePath)}
+}
+
 // NodeExpandVolume handles volume expansion for Block (i.e. iSCSI) volumes.  The CO only calls NodeExpandVolume
 // for the Block protocol as the filesystem has to be mounted to perform the resize. This is enforced in our
 // ControllerExpandVolume method where we return true for nodeExpansionRequired when the protocol is Block and
 // return false when the protocol is file.
 func (p *Plugin) NodeExpandVolume(
 	ctx context.Context, req *csi.NodeExpandVolumeRequest,
-) (*csi.NodeExpandVolumeResponse, error) {
+) (resp *csi.NodeExpandVolumeResponse, err error) {
 	ctx = SetContextWorkflow(ctx, WorkflowVolumeResize)
 	ctx = GenerateRequestContextForLayer(ctx, LogLayerCSIFrontend)
 
@@ -401,6 +453,9 @@ func (p *Plugin) NodeExpandVolume(
 	Logc(ctx).WithFields(fields).Debug(">>>> NodeExpandVolume")
 	defer Logc(ctx).WithFields(fields).Debug("<<<< NodeExpandVolume")
 
+	var metricsProtocol string
+	defer recordNodeTiming("NodeExpandVolume", &metricsProtocol, &err)()
+
 	volumeId := req.GetVolumeId()
 	if volumeId == "" {
 		return nil, status.Error(codes.InvalidArgument, "no volume ID provided")
@@ -435,6 +490,7 @@ func (p *Plugin) NodeExpandVolume(
 	}
 
 	stagingTargetPath := trackingInfo.StagingTargetPath
+	metricsProtocol = metricsProtocolFromPublishInfo(&trackingInfo.VolumePublishInfo)
 
 	// Current K8S behavior is to send the volumePath as the stagingTargetPath. Log what is received if the
 	// two variables don't match.
@@ -617,7 +673,7 @@ func nodePrepareBlockOnFileVolumeForExpansion(
 
 func (p *Plugin) NodeGetCapabilities(
 	ctx context.Context, _ *csi.NodeGetCapabilitiesRequest,
-) (*csi.NodeGetCapabilitiesResponse, error) {
+) (resp *csi.NodeGetCapabilitiesResponse, err error) {
 	ctx = SetContextWorkflow(ctx, WorkflowNodeGetCapabilities)
 	ctx = GenerateRequestContextForLayer(ctx, LogLayerCSIFrontend)
 
@@ -625,12 +681,15 @@ func (p *Plugin) NodeGetCapabilities(
 	Logc(ctx).WithFields(fields).Trace(">>>> NodeGetCapabilities")
 	defer Logc(ctx).WithFields(fields).Trace("<<<< NodeGetCapabilities")
 
+	var metricsProtocol string
+	defer recordNodeTiming("NodeGetCapabilities", &metricsProtocol, &err)()
+
 	return &csi.NodeGetCapabilitiesResponse{Capabilities: p.nsCap}, nil
 }
 
 func (p *Plugin) NodeGetInfo(
 	ctx context.Context, _ *csi.NodeGetInfoRequest,
-) (*csi.NodeGetInfoResponse, error) {
+) (resp *csi.NodeGetInfoResponse, err error) {
 	ctx = SetContextWorkflow(ctx, WorkflowNodeGetInfo)
 	ctx = GenerateRequestContextForLayer(ctx, LogLayerCSIFrontend)
 
@@ -638,6 +697,9 @@ func (p *Plugin) NodeGetInfo(
 	Logc(ctx).WithFields(fields).Trace(">>>> NodeGetInfo")
 	defer Logc(ctx).WithFields(fields).Trace("<<<< NodeGetInfo")
 
+	var metricsProtocol string
+	defer recordNodeTiming("NodeGetInfo", &metricsProtocol, &err)()
+
 	return &csi.NodeGetInfoResponse{
 		NodeId: p.nodeName,
 		AccessibleTopology: &csi.Topology{
@@ -1753,6 +1815,15 @@ func (p *Plugin) startReconcilingNodePublications(ctx context.Context) {
 	go func() {
 		ctx = GenerateRequestContext(nil, "", ContextSourcePeriodic, WorkflowNodeReconcilePubs, LogLayerCSIFrontend)
 
+		reconcile := func() {
+			Logc(ctx).Debug("Reconciling node publication state.")
+			if err := p.reconcileNodePublicationState(ctx); err != nil {
+				Logc(ctx).WithError(err).Debug("Failed to reconcile node publication state.")
+				return
+			}
+			Logc(ctx).Debug("Reconciled node publication state.")
+		}
+
 		for {
 			select {
 			case <-p.stopNodePublicationLoop:
@@ -1760,12 +1831,17 @@ func (p *Plugin) startReconcilingNodePublications(ctx context.Context) {
 				return
 
 			case <-p.nodePublicationTimer.C:
-				Logc(ctx).Debug("Reconciling node publication state.")
-				if err := p.reconcileNodePublicationState(ctx); err != nil {
-					Logc(ctx).WithError(err).Debug("Failed to reconcile node publication state.")
-					continue
+				reconcile()
+
+			case <-p.reconcileNodePublicationsNow:
+				// The controller reported a change to the node, so don't wait for the timer
+				if !p.nodePublicationTimer.Stop() {
+					select {
+					case <-p.nodePublicationTimer.C:
+					default:
+					}
 				}
-				Logc(ctx).Debug("Reconciled node publication state.")
+				reconcile()
 			}
 		}
 	}()
@@ -1790,6 +1866,56 @@ func (p *Plugin) stopReconcilingNodePublications(ctx context.Context) {
 	}
 }
 
+// startWatchingNode starts a background task that watches the node for changes made by the controller, if the
+// controller offers watches.  Whenever the node is found not to be clean, node publication state is reconciled
+// at once, and whenever the watch begins afresh, the node's logging configuration is updated from the
+// controller's.  Filesystems are frozen and thawed as the controller asks.
+func (p *Plugin) startWatchingNode(ctx context.Context) {
+	watchCtx, cancel := context.WithCancel(context.Background())
+	events, err := p.restClient.WatchNode(watchCtx, p.nodeName)
+	if err != nil {
+		cancel()
+		Logc(ctx).WithError(err).Debug("Could not watch node; relying on periodic reconciliation.")
+		return
+	}
+	p.stopNodeWatch = cancel
+
+	Logc(ctx).Info("Activating node watch.")
+
+	go func() {
+		ctx := GenerateRequestContext(nil, "", ContextSourceInternal, WorkflowNodeReconcilePubs, LogLayerCSIFrontend)
+
+		for event := range events {
+			if event.Freeze != nil {
+				go p.handleFilesystemFreeze(ctx, event.Type, event.Freeze)
+				continue
+			}
+			if event.Type == controllerAPIv1.NodeEventSync {
+				p.updateNodeLoggingConfig(ctx)
+			}
+			if event.Node == nil || event.Node.PublicationState == utils.NodeClean {
+				continue
+			}
+
+			Logc(ctx).WithField("publicationState", event.Node.PublicationState).Debug(
+				"Node publication state changed.")
+			select {
+			case p.reconcileNodePublicationsNow <- struct{}{}:
+			default:
+				// A reconciliation is already due
+			}
+		}
+	}()
+}
+
+// stopWatchingNode stops the node watch, if there is one.
+func (p *Plugin) stopWatchingNode(ctx context.Context) {
+	if p.stopNodeWatch != nil {
+		Logc(ctx).Info("Stopping the node watch.")
+		p.stopNodeWatch()
+	}
+}
+
 // reconcileNodePublicationState cleans any stale published path for volumes on the node by rectifying the actual state
 // of publications (published paths on the node) against the desired state of publications from the CSI controller.
 // If all published paths are cleaned successfully and the node is cleanable, it updates the Trident node CR via
@@ -2150,6 +2276,7 @@ func (p *Plugin) performISCSISelfHealing(ctx context.Context) {
 	// SELF-HEAL STEP 1: Identify all sorted candidate stale portals and sorted candidate non-stale portals.
 	staleISCSIPortals, nonStaleISCSIPortals := utils.InspectAllISCSISessions(ctx, &publishedISCSISessions,
 		&currentISCSISessions, p.iSCSISelfHealingWaitTime)
+	updateISCSISessionMetrics(&publishedISCSISessions, &currentISCSISessions, staleISCSIPortals, nonStaleISCSIPortals)
 
 	// SELF-HEAL STEP 2: Attempt to fix all the stale portals.
 	p.fixISCSISessions(ctx, staleISCSIPortals, "stale", stopSelfHealingAt)
@@ -2196,7 +2323,9 @@ func (p *Plugin) fixISCSISessions(ctx context.Context, portals []string, portalT
 		// First thing to do is to update the lastAccessTime
 		publishedISCSISessions.Info[portal].PortalInfo.LastAccessTime = time.Now()
 
-		if err := p.selfHealingRectifySession(ctx, portal, fixAction); err != nil {
+		err := p.selfHealingRectifySession(ctx, portal, fixAction)
+		recordSelfHealingAction(metricsProtocolISCSI, iscsiActionMetricsLabel(fixAction), err)
+		if err != nil {
 			Logc(ctx).WithError(err).Errorf("Encountered error while attempting to fix portal %v.", portal)
 		} else {
 			Logc(ctx).Debugf("Fixed portal %v it required %s", portal, fixAction)
@@ -2207,29 +2336,12 @@ func (p *Plugin) fixISCSISessions(ctx context.Context, portals []string, portalT
 	}
 }
 
-/*TODO (bpresnel) Enable later, with rate limiting?
-func (p *Plugin) startLoggingConfigReconcile() {
-	ticker := time.NewTicker(LoggingConfigReconcilePeriod)
-	done := make(chan bool)
-
-	go func() {
-		for {
-			select {
-			case <-done:
-				return
-			case <-ticker.C:
-				p.updateNodeLoggingConfig()
-			}
-		}
-	}()
-}
-
-func (p *Plugin) updateNodeLoggingConfig() {
-	bgCtx := context.Background()
-	logLevel, loggingWorkflows, loggingLayers, err := p.restClient.GetLoggingConfig(bgCtx)
+// updateNodeLoggingConfig sets the node's log level, logging workflows and logging layers to the controller's.
+func (p *Plugin) updateNodeLoggingConfig(ctx context.Context) {
+	logLevel, loggingWorkflows, loggingLayers, err := p.restClient.GetLoggingConfig(ctx)
 	if err != nil {
 		warnMsg := "Could not retrieve the current log level, logging workflows and logging layers from the controller."
-		Log().WithError(err).Warnf(warnMsg)
+		Logc(ctx).WithError(err).Warn(warnMsg)
 		return
 	}
 
@@ -2256,7 +2368,7 @@ func (p *Plugin) updateNodeLoggingConfig() {
 	if GetSelectedLogLayers() != loggingLayers {
 		if err = SetLogLayers(loggingLayers); err != nil {
 			msg := "Could not set node logging layers to the controller's selected log layers: %s\n"
-			Log().Warnf(msg, loggingWorkflows)
+			Log().Warnf(msg, loggingLayers)
 			return
 		}
 		layersNeededUpdate = true
@@ -2276,8 +2388,6 @@ func (p *Plugin) updateNodeLoggingConfig() {
 		Log().Infof(layersFmt, loggingLayers)
 	}
 }
-*/
-
 func (p *Plugin) nodeStageNVMeVolume(
 	ctx context.Context, req *csi.NodeStageVolumeRequest,
 	publishInfo *utils.VolumePublishInfo,
@@ -2595,6 +2705,7 @@ func (p *Plugin) performNVMeSelfHealing(ctx context.Context) {
 	Logc(ctx).Debugf("Current NVMe sessions %v.", currentNVMeSessions)
 
 	subsToFix := p.nvmeHandler.InspectNVMeSessions(ctx, &publishedNVMeSessions, &currentNVMeSessions)
+	updateNVMeSessionMetrics(&currentNVMeSessions)
 
 	Logc(ctx).Debug("Start NVMe healing.")
 	p.fixNVMeSessions(ctx, stopSelfHealingAt, subsToFix)
@@ -2616,6 +2727,7 @@ func (p *Plugin) fixNVMeSessions(ctx context.Context, stopAt time.Time, subsyste
 			break
 		}
 
-		p.nvmeHandler.RectifyNVMeSession(ctx, sub, &publishedNVMeSessions)
+		err := p.nvmeHandler.RectifyNVMeSession(ctx, sub, &publishedNVMeSessions)
+		recordSelfHealingAction(metricsProtocolNVMe, "connect", err)
 	}
 }
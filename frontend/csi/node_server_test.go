@@ -1154,3 +1154,34 @@ func TestOutdatedAccessControlInUse(t *testing.T) {
 		})
 	}
 }
+
+func TestGetVolumeConditionFromMounts(t *testing.T) {
+	path := "/var/lib/kubelet/pods/uid/volumes/kubernetes.io~csi/pvc-1/mount"
+	mounts := []utils.MountInfo{
+		{MountPoint: "/", MountOptions: []string{"rw"}, SuperOptions: []string{"rw"}},
+	}
+
+	tests := []struct {
+		name     string
+		mount    *utils.MountInfo
+		abnormal bool
+	}{
+		{"readWrite", &utils.MountInfo{MountOptions: []string{"rw"}, SuperOptions: []string{"rw"}}, false},
+		{"readOnlyByRequest", &utils.MountInfo{MountOptions: []string{"ro"}, SuperOptions: []string{"ro"}}, false},
+		{"remountedReadOnly", &utils.MountInfo{MountOptions: []string{"rw"}, SuperOptions: []string{"ro"}}, true},
+		{"notMounted", nil, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			testMounts := mounts
+			if test.mount != nil {
+				test.mount.MountPoint = path
+				testMounts = append(testMounts, *test.mount)
+			}
+			condition := getVolumeConditionFromMounts(path, testMounts)
+			assert.Equal(t, test.abnormal, condition.GetAbnormal())
+			assert.NotEmpty(t, condition.GetMessage())
+		})
+	}
+}
@@ -5,6 +5,7 @@ package csi
 import (
 	"context"
 	"fmt"
+	"net"
 	"os"
 	"runtime"
 	"strings"
@@ -32,6 +33,11 @@ const (
 )
 
 type Plugin struct {
+	csi.UnimplementedIdentityServer
+	csi.UnimplementedControllerServer
+	csi.UnimplementedNodeServer
+	csi.UnimplementedGroupControllerServer
+
 	orchestrator core.Orchestrator
 
 	name     string
@@ -53,9 +59,10 @@ type Plugin struct {
 
 	grpc NonBlockingGRPCServer
 
-	csCap []*csi.ControllerServiceCapability
-	nsCap []*csi.NodeServiceCapability
-	vCap  []*csi.VolumeCapability_AccessMode
+	csCap  []*csi.ControllerServiceCapability
+	gcsCap []*csi.GroupControllerServiceCapability
+	nsCap  []*csi.NodeServiceCapability
+	vCap   []*csi.VolumeCapability_AccessMode
 
 	opCache sync.Map
 
@@ -66,8 +73,16 @@ type Plugin struct {
 	iSCSISelfHealingInterval time.Duration
 	iSCSISelfHealingWaitTime time.Duration
 
-	stopNodePublicationLoop chan bool
-	nodePublicationTimer    *time.Timer
+	stopNodePublicationLoop      chan bool
+	nodePublicationTimer         *time.Timer
+	reconcileNodePublicationsNow chan struct{}
+	stopNodeWatch                context.CancelFunc
+
+	frozenFilesystems     map[string]*frozenFilesystem
+	frozenFilesystemsLock sync.Mutex
+
+	volumeUsageTicker      *time.Ticker
+	stopVolumeUsageReports chan struct{}
 
 	nvmeHandler utils.NVMeInterface
 
@@ -107,12 +122,21 @@ func NewControllerPlugin(
 		csi.ControllerServiceCapability_RPC_CREATE_DELETE_VOLUME,
 		csi.ControllerServiceCapability_RPC_PUBLISH_UNPUBLISH_VOLUME,
 		csi.ControllerServiceCapability_RPC_LIST_VOLUMES,
+		csi.ControllerServiceCapability_RPC_GET_CAPACITY,
 		csi.ControllerServiceCapability_RPC_CREATE_DELETE_SNAPSHOT,
 		csi.ControllerServiceCapability_RPC_LIST_SNAPSHOTS,
 		csi.ControllerServiceCapability_RPC_EXPAND_VOLUME,
 		csi.ControllerServiceCapability_RPC_CLONE_VOLUME,
 		csi.ControllerServiceCapability_RPC_LIST_VOLUMES_PUBLISHED_NODES,
 		csi.ControllerServiceCapability_RPC_SINGLE_NODE_MULTI_WRITER,
+		csi.ControllerServiceCapability_RPC_GET_VOLUME,
+		csi.ControllerServiceCapability_RPC_VOLUME_CONDITION,
+		csi.ControllerServiceCapability_RPC_MODIFY_VOLUME,
+	})
+
+	// Define group controller capabilities
+	p.addGroupControllerServiceCapabilities(ctx, []csi.GroupControllerServiceCapability_RPC_Type{
+		csi.GroupControllerServiceCapability_RPC_CREATE_DELETE_GET_VOLUME_GROUP_SNAPSHOT,
 	})
 
 	// Define volume capabilities
@@ -147,20 +171,21 @@ func NewNodePlugin(
 	Logc(ctx).Info(msg)
 
 	p := &Plugin{
-		orchestrator:             orchestrator,
-		name:                     Provisioner,
-		nodeName:                 nodeName,
-		version:                  tridentconfig.OrchestratorVersion.ShortString(),
-		endpoint:                 endpoint,
-		role:                     CSINode,
-		nodeHelper:               *helper,
-		enableForceDetach:        enableForceDetach,
-		unsafeDetach:             unsafeDetach,
-		opCache:                  sync.Map{},
-		iSCSISelfHealingInterval: iSCSISelfHealingInterval,
-		iSCSISelfHealingWaitTime: iSCSIStaleSessionWaitTime,
-		nvmeHandler:              utils.NewNVMeHandler(),
-		nvmeSelfHealingInterval:  nvmeSelfHealingInterval,
+		orchestrator:                 orchestrator,
+		name:                         Provisioner,
+		nodeName:                     nodeName,
+		version:                      tridentconfig.OrchestratorVersion.ShortString(),
+		endpoint:                     endpoint,
+		role:                         CSINode,
+		nodeHelper:                   *helper,
+		enableForceDetach:            enableForceDetach,
+		unsafeDetach:                 unsafeDetach,
+		opCache:                      sync.Map{},
+		reconcileNodePublicationsNow: make(chan struct{}, 1),
+		iSCSISelfHealingInterval:     iSCSISelfHealingInterval,
+		iSCSISelfHealingWaitTime:     iSCSIStaleSessionWaitTime,
+		nvmeHandler:                  utils.NewNVMeHandler(),
+		nvmeSelfHealingInterval:      nvmeSelfHealingInterval,
 	}
 
 	if runtime.GOOS == "windows" {
@@ -177,6 +202,7 @@ func NewNodePlugin(
 				csi.NodeServiceCapability_RPC_SINGLE_NODE_MULTI_WRITER,
 				csi.NodeServiceCapability_RPC_EXPAND_VOLUME,
 				csi.NodeServiceCapability_RPC_GET_VOLUME_STATS,
+				csi.NodeServiceCapability_RPC_VOLUME_CONDITION,
 			},
 		)
 	}
@@ -198,6 +224,17 @@ func NewNodePlugin(
 		return nil, err
 	}
 
+	// Use the gRPC control plane if the controller's service offers it, falling back to REST otherwise
+	if grpcPort := os.Getenv("TRIDENT_CSI_SERVICE_PORT_GRPC"); grpcPort != "" {
+		grpcAddress := net.JoinHostPort(hostname, grpcPort)
+		p.restClient, err = controllerAPI.CreateTLSGRPCClient(grpcAddress, caCert, clientCert, clientKey,
+			p.restClient)
+		if err != nil {
+			return nil, err
+		}
+		Logc(ctx).WithField("address", grpcAddress).Info("Using the gRPC control plane.")
+	}
+
 	p.aesKey, err = ReadAESKey(ctx, aesKeyFile)
 	if err != nil {
 		return nil, err
@@ -231,20 +268,21 @@ func NewAllInOnePlugin(
 	Logc(ctx).Info("Initializing CSI all-in-one frontend.")
 
 	p := &Plugin{
-		orchestrator:             orchestrator,
-		name:                     Provisioner,
-		nodeName:                 nodeName,
-		version:                  tridentconfig.OrchestratorVersion.ShortString(),
-		endpoint:                 endpoint,
-		role:                     CSIAllInOne,
-		unsafeDetach:             unsafeDetach,
-		controllerHelper:         *controllerHelper,
-		nodeHelper:               *nodeHelper,
-		opCache:                  sync.Map{},
-		iSCSISelfHealingInterval: iSCSISelfHealingInterval,
-		iSCSISelfHealingWaitTime: iSCSIStaleSessionWaitTime,
-		nvmeHandler:              utils.NewNVMeHandler(),
-		nvmeSelfHealingInterval:  nvmeSelfHealingInterval,
+		orchestrator:                 orchestrator,
+		name:                         Provisioner,
+		nodeName:                     nodeName,
+		version:                      tridentconfig.OrchestratorVersion.ShortString(),
+		endpoint:                     endpoint,
+		role:                         CSIAllInOne,
+		unsafeDetach:                 unsafeDetach,
+		controllerHelper:             *controllerHelper,
+		nodeHelper:                   *nodeHelper,
+		opCache:                      sync.Map{},
+		reconcileNodePublicationsNow: make(chan struct{}, 1),
+		iSCSISelfHealingInterval:     iSCSISelfHealingInterval,
+		iSCSISelfHealingWaitTime:     iSCSIStaleSessionWaitTime,
+		nvmeHandler:                  utils.NewNVMeHandler(),
+		nvmeSelfHealingInterval:      nvmeSelfHealingInterval,
 	}
 
 	// Define controller capabilities
@@ -252,12 +290,21 @@ func NewAllInOnePlugin(
 		csi.ControllerServiceCapability_RPC_CREATE_DELETE_VOLUME,
 		csi.ControllerServiceCapability_RPC_PUBLISH_UNPUBLISH_VOLUME,
 		csi.ControllerServiceCapability_RPC_LIST_VOLUMES,
+		csi.ControllerServiceCapability_RPC_GET_CAPACITY,
 		csi.ControllerServiceCapability_RPC_CREATE_DELETE_SNAPSHOT,
 		csi.ControllerServiceCapability_RPC_LIST_SNAPSHOTS,
 		csi.ControllerServiceCapability_RPC_EXPAND_VOLUME,
 		csi.ControllerServiceCapability_RPC_CLONE_VOLUME,
 		csi.ControllerServiceCapability_RPC_LIST_VOLUMES_PUBLISHED_NODES,
 		csi.ControllerServiceCapability_RPC_SINGLE_NODE_MULTI_WRITER,
+		csi.ControllerServiceCapability_RPC_GET_VOLUME,
+		csi.ControllerServiceCapability_RPC_VOLUME_CONDITION,
+		csi.ControllerServiceCapability_RPC_MODIFY_VOLUME,
+	})
+
+	// Define group controller capabilities
+	p.addGroupControllerServiceCapabilities(ctx, []csi.GroupControllerServiceCapability_RPC_Type{
+		csi.GroupControllerServiceCapability_RPC_CREATE_DELETE_GET_VOLUME_GROUP_SNAPSHOT,
 	})
 
 	p.addNodeServiceCapabilities([]csi.NodeServiceCapability_RPC_Type{
@@ -265,6 +312,7 @@ func NewAllInOnePlugin(
 		csi.NodeServiceCapability_RPC_EXPAND_VOLUME,
 		csi.NodeServiceCapability_RPC_GET_VOLUME_STATS,
 		csi.NodeServiceCapability_RPC_SINGLE_NODE_MULTI_WRITER,
+		csi.NodeServiceCapability_RPC_VOLUME_CONDITION,
 	})
 	port := "34571"
 	for _, envVar := range os.Environ() {
@@ -326,8 +374,11 @@ func (p *Plugin) Activate() error {
 			if p.enableForceDetach {
 				p.startReconcilingNodePublications(ctx)
 			}
+			p.startWatchingNode(ctx)
+			p.startReportingVolumeUsage(ctx)
+			registerLoopDeviceMetrics(ctx)
 		}
-		p.grpc.Start(p.endpoint, p, p, p)
+		p.grpc.Start(p.endpoint, p, p, p, p)
 	}()
 	return nil
 }
@@ -337,6 +388,8 @@ func (p *Plugin) Deactivate() error {
 
 	// stopReconcilingNodePublications
 	if p.role == CSINode || p.role == CSIAllInOne {
+		p.stopReportingVolumeUsage(ctx)
+		p.stopWatchingNode(ctx)
 		if p.enableForceDetach {
 			p.stopReconcilingNodePublications(ctx)
 		}
@@ -371,6 +424,19 @@ func (p *Plugin) addControllerServiceCapabilities(ctx context.Context, cl []csi.
 	p.csCap = csCap
 }
 
+func (p *Plugin) addGroupControllerServiceCapabilities(
+	ctx context.Context, cl []csi.GroupControllerServiceCapability_RPC_Type,
+) {
+	var gcsCap []*csi.GroupControllerServiceCapability
+
+	for _, c := range cl {
+		Logc(ctx).WithField("capability", c.String()).Info("Enabling group controller service capability.")
+		gcsCap = append(gcsCap, NewGroupControllerServiceCapability(c))
+	}
+
+	p.gcsCap = gcsCap
+}
+
 func (p *Plugin) addNodeServiceCapabilities(cl []csi.NodeServiceCapability_RPC_Type) {
 	var nsCap []*csi.NodeServiceCapability
 
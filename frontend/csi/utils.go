@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/container-storage-interface/spec/lib/go/csi"
+	"go.opentelemetry.io/otel/attribute"
 	"google.golang.org/grpc"
 
 	"github.com/netapp/trident/config"
@@ -18,6 +19,7 @@ import (
 	"github.com/netapp/trident/utils"
 	"github.com/netapp/trident/utils/crypto"
 	"github.com/netapp/trident/utils/errors"
+	"github.com/netapp/trident/utils/tracing"
 )
 
 func ParseEndpoint(ep string) (string, string, error) {
@@ -44,6 +46,18 @@ func NewControllerServiceCapability(cap csi.ControllerServiceCapability_RPC_Type
 	}
 }
 
+func NewGroupControllerServiceCapability(
+	cap csi.GroupControllerServiceCapability_RPC_Type,
+) *csi.GroupControllerServiceCapability {
+	return &csi.GroupControllerServiceCapability{
+		Type: &csi.GroupControllerServiceCapability_Rpc{
+			Rpc: &csi.GroupControllerServiceCapability_RPC{
+				Type: cap,
+			},
+		},
+	}
+}
+
 func NewNodeServiceCapability(cap csi.NodeServiceCapability_RPC_Type) *csi.NodeServiceCapability {
 	return &csi.NodeServiceCapability{
 		Type: &csi.NodeServiceCapability_Rpc{
@@ -54,7 +68,7 @@ func NewNodeServiceCapability(cap csi.NodeServiceCapability_RPC_Type) *csi.NodeS
 	}
 }
 
-// logGRPC is a unary interceptor that logs GRPC requests.
+// logGRPC is a unary interceptor that logs and traces GRPC requests.
 func logGRPC(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{},
 	error,
 ) {
@@ -66,8 +80,11 @@ func logGRPC(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, h
 
 	Logc(ctx).WithFields(logFields).Debugf("GRPC call: %s", info.FullMethod)
 
+	ctx, span := tracing.StartGRPCServerSpan(ctx, info.FullMethod, grpcSpanAttributes(req)...)
+
 	// Handle the actual request.
 	resp, err := handler(ctx, req)
+	tracing.EndGRPCSpan(span, err)
 	if err != nil {
 		Logc(ctx).Errorf("GRPC error: %v", err)
 	} else {
@@ -77,6 +94,25 @@ func logGRPC(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, h
 	return resp, err
 }
 
+// grpcSpanAttributes returns the names of the things a CSI request is about, for tracing.
+func grpcSpanAttributes(req interface{}) []attribute.KeyValue {
+	var attributes []attribute.KeyValue
+	switch r := req.(type) {
+	case *csi.CreateVolumeRequest:
+		attributes = append(attributes, tracing.Volume(r.GetName()))
+	case *csi.CreateSnapshotRequest:
+		attributes = append(attributes, tracing.Volume(r.GetSourceVolumeId()), tracing.Snapshot(r.GetName()))
+	case interface{ GetVolumeId() string }:
+		attributes = append(attributes, tracing.Volume(r.GetVolumeId()))
+	case interface{ GetSnapshotId() string }:
+		attributes = append(attributes, tracing.Snapshot(r.GetSnapshotId()))
+	}
+	if r, ok := req.(interface{ GetNodeId() string }); ok {
+		attributes = append(attributes, tracing.Node(r.GetNodeId()))
+	}
+	return attributes
+}
+
 // encryptCHAPPublishInfo will encrypt the CHAP credentials from volumePublish and add them to publishInfo
 func encryptCHAPPublishInfo(
 	ctx context.Context, publishInfo map[string]string, volumePublishInfo *utils.VolumePublishInfo, aesKey []byte,
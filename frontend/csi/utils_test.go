@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/container-storage-interface/spec/lib/go/csi"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/netapp/trident/config"
 	mockControllerAPI "github.com/netapp/trident/mocks/mock_frontend/mock_csi/mock_controller_api"
@@ -14,6 +16,7 @@ import (
 	"github.com/netapp/trident/mocks/mock_utils/mock_luks"
 	"github.com/netapp/trident/utils"
 	"github.com/netapp/trident/utils/errors"
+	"github.com/netapp/trident/utils/tracing"
 )
 
 func TestGetVolumeProtocolFromPublishInfo(t *testing.T) {
@@ -465,3 +468,26 @@ func TestEnsureLUKSVolumePassphrase_NoCorrectPassphraseProvided(t *testing.T) {
 	assert.Error(t, err)
 	mockCtrl.Finish()
 }
+
+func TestGRPCSpanAttributes(t *testing.T) {
+	tests := []struct {
+		req      interface{}
+		expected []attribute.KeyValue
+	}{
+		{&csi.CreateVolumeRequest{Name: "pvc-1"}, []attribute.KeyValue{tracing.Volume("pvc-1")}},
+		{
+			&csi.CreateSnapshotRequest{SourceVolumeId: "pvc-1", Name: "snap-1"},
+			[]attribute.KeyValue{tracing.Volume("pvc-1"), tracing.Snapshot("snap-1")},
+		},
+		{&csi.DeleteSnapshotRequest{SnapshotId: "pvc-1/snap-1"}, []attribute.KeyValue{tracing.Snapshot("pvc-1/snap-1")}},
+		{
+			&csi.ControllerPublishVolumeRequest{VolumeId: "pvc-1", NodeId: "node1"},
+			[]attribute.KeyValue{tracing.Volume("pvc-1"), tracing.Node("node1")},
+		},
+		{&csi.NodeGetInfoRequest{}, nil},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, grpcSpanAttributes(test.req))
+	}
+}
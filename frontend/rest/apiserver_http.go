@@ -19,7 +19,11 @@ type APIServerHTTP struct {
 	server *http.Server
 }
 
-func NewHTTPServer(p core.Orchestrator, address, port string, writeTimeout time.Duration) *APIServerHTTP {
+// NewHTTPServer returns a REST API server for localhost.  If an authenticator is specified, callers are
+// identified by it; otherwise all callers are trusted.
+func NewHTTPServer(
+	p core.Orchestrator, address, port string, writeTimeout time.Duration, authenticator *Authenticator,
+) *APIServerHTTP {
 	orchestrator = p
 
 	apiServer := &APIServerHTTP{
@@ -31,6 +35,10 @@ func NewHTTPServer(p core.Orchestrator, address, port string, writeTimeout time.
 		},
 	}
 
+	if authenticator != nil {
+		apiServer.server.Handler = authenticator.Handler(apiServer.server.Handler, true)
+	}
+
 	Log().WithField("address", apiServer.server.Addr).Info("Initializing HTTP REST frontend.")
 
 	return apiServer
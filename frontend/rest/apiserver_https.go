@@ -23,9 +23,11 @@ type APIServerHTTPS struct {
 	serverKeyFile  string
 }
 
+// NewHTTPSServer returns a REST API server.  With mutual TLS, callers must present the Trident client
+// certificate, or if an authenticator is specified, either that or a bearer token it accepts.
 func NewHTTPSServer(
 	p core.Orchestrator, address, port, caCertFile, serverCertFile, serverKeyFile string, enableMutualTLS bool,
-	handler http.Handler, writeTimeout time.Duration,
+	handler http.Handler, writeTimeout time.Duration, authenticator *Authenticator,
 ) (*APIServerHTTPS, error) {
 	orchestrator = p
 
@@ -48,6 +50,10 @@ func NewHTTPSServer(
 	if !enableMutualTLS {
 		apiServer.server.Handler = handler
 		apiServer.server.TLSConfig.ClientAuth = tls.NoClientCert
+	} else if authenticator != nil {
+		// Callers with tokens have no client certificate, so only verify one if presented
+		apiServer.server.Handler = authenticator.Handler(handler, false)
+		apiServer.server.TLSConfig.ClientAuth = tls.VerifyClientCertIfGiven
 	}
 
 	if caCertFile != "" {
@@ -0,0 +1,397 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package rest
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/gorilla/mux"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/netapp/trident/config"
+	. "github.com/netapp/trident/logging"
+	"github.com/netapp/trident/utils/errors"
+)
+
+// Role is a set of REST API permissions granted to a caller.
+type Role string
+
+const (
+	// RoleNone grants nothing.  As the local role, it requires callers on localhost to present a token.
+	RoleNone = Role("none")
+	// RoleView permits reading Trident's state, but not changing it or reading secrets such as CHAP credentials.
+	RoleView = Role("view")
+	// RoleAdmin permits everything.
+	RoleAdmin = Role("admin")
+)
+
+// roleRanks orders roles by the permissions they grant; each role grants all those of lower roles.
+var roleRanks = map[Role]int{RoleNone: 0, RoleView: 1, RoleAdmin: 2}
+
+// adminReadRoutes are the read-only routes that return secrets, so need the admin role.
+var adminReadRoutes = map[string]bool{
+	"GetCHAP": true,
+}
+
+// viewWriteRoutes are the routes that change nothing despite their method, such as dry runs, so need only
+// the view role.
+var viewWriteRoutes = map[string]bool{
+	"ExplainVolumePlacement": true,
+}
+
+const (
+	// tokenReviewCacheTTL is how long a successful token review is trusted before the token is reviewed again
+	tokenReviewCacheTTL = time.Minute
+
+	identitySourceCertificate = "certificate"
+	identitySourceToken       = "token"
+	identitySourceTokenReview = "tokenReview"
+	identitySourceLocal       = "local"
+
+	nodeIdentityName  = "trident-node"
+	localIdentityName = "local"
+)
+
+// Allows reports whether the role grants the permissions of the required role.
+func (r Role) Allows(required Role) bool {
+	return roleRanks[r] >= roleRanks[required]
+}
+
+func (r Role) validate() error {
+	if _, ok := roleRanks[r]; !ok {
+		return fmt.Errorf("unknown role %s", r)
+	}
+	return nil
+}
+
+// AuthConfig configures token authentication and the roles granted to REST API callers.
+type AuthConfig struct {
+	// Tokens are static bearer tokens, for deployments without Kubernetes
+	Tokens []StaticToken `json:"tokens,omitempty"`
+	// TokenReview authenticates other bearer tokens with the Kubernetes TokenReview API
+	TokenReview bool `json:"tokenReview,omitempty"`
+	// Audiences are the audiences a token must be intended for to pass review; if empty, the API server's
+	// own audiences apply
+	Audiences []string `json:"audiences,omitempty"`
+	// Bindings grant roles to callers by user name or group
+	Bindings []RoleBinding `json:"bindings,omitempty"`
+	// LocalRole is the role of callers on localhost presenting no token, such as tridentctl in the Trident
+	// pod.  It defaults to admin; set it to none to require a token on the HTTP server too.
+	LocalRole Role `json:"localRole,omitempty"`
+}
+
+// StaticToken is a bearer token for a user, granted a role directly or through bindings.
+type StaticToken struct {
+	User   string   `json:"user"`
+	Token  string   `json:"token"`
+	Role   Role     `json:"role,omitempty"`
+	Groups []string `json:"groups,omitempty"`
+}
+
+// RoleBinding grants a role to the named users and to the members of the named groups.
+type RoleBinding struct {
+	Role   Role     `json:"role"`
+	Users  []string `json:"users,omitempty"`
+	Groups []string `json:"groups,omitempty"`
+}
+
+// LoadAuthConfig reads an AuthConfig from a YAML or JSON file.
+func LoadAuthConfig(path string) (*AuthConfig, error) {
+	configBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read REST API auth config; %v", err)
+	}
+	authConfig := &AuthConfig{}
+	if err = yaml.Unmarshal(configBytes, authConfig); err != nil {
+		return nil, fmt.Errorf("could not parse REST API auth config; %v", err)
+	}
+	return authConfig, nil
+}
+
+// Identity is an authenticated REST API caller.
+type Identity struct {
+	Name   string
+	Groups []string
+	Role   Role
+	// Source is how the caller was authenticated
+	Source string
+}
+
+type identityContextKey struct{}
+
+// IdentityFromContext returns the caller of a REST API request, or nil if the server does not authenticate
+// callers.
+func IdentityFromContext(ctx context.Context) *Identity {
+	identity, _ := ctx.Value(identityContextKey{}).(*Identity)
+	return identity
+}
+
+// contextWithIdentity records the caller, both for authorization and as the actor of any audited changes.
+func contextWithIdentity(ctx context.Context, identity *Identity) context.Context {
+	ctx = ContextWithAuditActor(ctx, identity.Name)
+	return context.WithValue(ctx, identityContextKey{}, identity)
+}
+
+type staticTokenEntry struct {
+	user   string
+	role   Role
+	groups []string
+}
+
+type tokenReviewResult struct {
+	identity *Identity
+	expires  time.Time
+}
+
+// Authenticator identifies REST API callers by their client certificate or bearer token, and grants them
+// roles.
+type Authenticator struct {
+	// tokens maps the SHA-256 hash of each static token to its user
+	tokens      map[[sha256.Size]byte]*staticTokenEntry
+	kubeClient  kubernetes.Interface
+	audiences   []string
+	userRoles   map[string]Role
+	groupRoles  map[string]Role
+	localRole   Role
+	reviewMutex sync.Mutex
+	reviewCache map[[sha256.Size]byte]*tokenReviewResult
+}
+
+// NewAuthenticator validates an AuthConfig and returns an Authenticator for it.  A Kubernetes client is
+// needed only if the config enables token review.
+func NewAuthenticator(authConfig *AuthConfig, kubeClient kubernetes.Interface) (*Authenticator, error) {
+	a := &Authenticator{
+		tokens:      make(map[[sha256.Size]byte]*staticTokenEntry),
+		audiences:   authConfig.Audiences,
+		userRoles:   make(map[string]Role),
+		groupRoles:  make(map[string]Role),
+		localRole:   authConfig.LocalRole,
+		reviewCache: make(map[[sha256.Size]byte]*tokenReviewResult),
+	}
+
+	if a.localRole == "" {
+		a.localRole = RoleAdmin
+	} else if err := a.localRole.validate(); err != nil {
+		return nil, errors.InvalidInputError(fmt.Sprintf("invalid local role; %v", err))
+	}
+
+	if authConfig.TokenReview {
+		if kubeClient == nil {
+			return nil, errors.InvalidInputError("token review requires Kubernetes")
+		}
+		a.kubeClient = kubeClient
+	}
+
+	for _, token := range authConfig.Tokens {
+		if token.Token == "" || token.User == "" {
+			return nil, errors.InvalidInputError("each static token must have a token and a user")
+		}
+		if token.Role == "" {
+			token.Role = RoleNone
+		} else if err := token.Role.validate(); err != nil {
+			return nil, errors.InvalidInputError(fmt.Sprintf("invalid role for user %s; %v", token.User, err))
+		}
+		hash := sha256.Sum256([]byte(token.Token))
+		if _, ok := a.tokens[hash]; ok {
+			return nil, errors.InvalidInputError(fmt.Sprintf("the token for user %s is not unique", token.User))
+		}
+		a.tokens[hash] = &staticTokenEntry{user: token.User, role: token.Role, groups: token.Groups}
+	}
+
+	for _, binding := range authConfig.Bindings {
+		if err := binding.Role.validate(); err != nil {
+			return nil, errors.InvalidInputError(fmt.Sprintf("invalid role binding; %v", err))
+		}
+		for _, user := range binding.Users {
+			a.userRoles[user] = highestRole(a.userRoles[user], binding.Role)
+		}
+		for _, group := range binding.Groups {
+			a.groupRoles[group] = highestRole(a.groupRoles[group], binding.Role)
+		}
+	}
+
+	return a, nil
+}
+
+func highestRole(a, b Role) Role {
+	if a.Allows(b) {
+		return a
+	}
+	return b
+}
+
+// bindingRole returns the highest role bound to a user or any of its groups, starting from the specified role.
+func (a *Authenticator) bindingRole(role Role, user string, groups []string) Role {
+	if role == "" {
+		role = RoleNone
+	}
+	role = highestRole(role, a.userRoles[user])
+	for _, group := range groups {
+		role = highestRole(role, a.groupRoles[group])
+	}
+	return role
+}
+
+// authenticate identifies the caller of a request.  Trident nodes are identified by their client certificate,
+// and other callers by their bearer token.  Callers on a local server presenting neither get the local role.
+func (a *Authenticator) authenticate(r *http.Request, local bool) (*Identity, error) {
+	if r.TLS != nil && len(r.TLS.PeerCertificates) > 0 &&
+		r.TLS.PeerCertificates[0].Subject.CommonName == config.ClientCertName {
+		return &Identity{Name: nodeIdentityName, Role: RoleAdmin, Source: identitySourceCertificate}, nil
+	}
+
+	authorization := r.Header.Get("Authorization")
+	if authorization == "" {
+		if local && a.localRole != RoleNone {
+			return &Identity{Name: localIdentityName, Role: a.localRole, Source: identitySourceLocal}, nil
+		}
+		return nil, errors.AuthError("no credentials")
+	}
+
+	token, found := strings.CutPrefix(authorization, "Bearer ")
+	if !found || token == "" {
+		return nil, errors.AuthError("unsupported authorization scheme")
+	}
+
+	hash := sha256.Sum256([]byte(token))
+	if entry, ok := a.tokens[hash]; ok {
+		return &Identity{
+			Name:   entry.user,
+			Groups: entry.groups,
+			Role:   a.bindingRole(entry.role, entry.user, entry.groups),
+			Source: identitySourceToken,
+		}, nil
+	}
+
+	if a.kubeClient != nil {
+		return a.reviewToken(r.Context(), token, hash)
+	}
+	return nil, errors.AuthError("invalid token")
+}
+
+// reviewToken asks Kubernetes who a token belongs to, remembering the answer briefly.
+func (a *Authenticator) reviewToken(ctx context.Context, token string, hash [sha256.Size]byte) (*Identity, error) {
+	// Hold the lock only to use the cache, so one slow review does not hold up every other caller
+	a.reviewMutex.Lock()
+	result, ok := a.reviewCache[hash]
+	a.reviewMutex.Unlock()
+	if ok && time.Now().Before(result.expires) {
+		return result.identity, nil
+	}
+
+	review := &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{Token: token, Audiences: a.audiences},
+	}
+	review, err := a.kubeClient.AuthenticationV1().TokenReviews().Create(ctx, review, metav1.CreateOptions{})
+	if err != nil {
+		Logc(ctx).WithError(err).Error("Could not review REST API token.")
+		return nil, errors.AuthError("token could not be reviewed")
+	}
+	if !review.Status.Authenticated {
+		return nil, errors.AuthError(fmt.Sprintf("invalid token; %s", review.Status.Error))
+	}
+
+	user := review.Status.User
+	identity := &Identity{
+		Name:   user.Username,
+		Groups: user.Groups,
+		Role:   a.bindingRole(RoleNone, user.Username, user.Groups),
+		Source: identitySourceTokenReview,
+	}
+
+	a.reviewMutex.Lock()
+	defer a.reviewMutex.Unlock()
+	now := time.Now()
+	for cachedHash, result := range a.reviewCache {
+		if now.After(result.expires) {
+			delete(a.reviewCache, cachedHash)
+		}
+	}
+	a.reviewCache[hash] = &tokenReviewResult{identity: identity, expires: now.Add(tokenReviewCacheTTL)}
+
+	return identity, nil
+}
+
+// Handler authenticates each request before passing it to the specified handler with the caller's identity
+// in its context.  Local is true for the HTTP server, which listens only on localhost.
+func (a *Authenticator) Handler(handler http.Handler, local bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		identity, err := a.authenticate(r, local)
+		if err != nil {
+			Audit().Logf(r.Context(), AuditRESTAccess, LogFields{
+				"Method":     r.Method,
+				"RequestURL": r.URL,
+				"SourceIP":   r.RemoteAddr,
+				"error":      err,
+			}, "REST API caller not authenticated.")
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf("Bearer realm=\"%s\"", config.OrchestratorName))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(w, r.WithContext(contextWithIdentity(r.Context(), identity)))
+	})
+}
+
+// routeRole returns the role needed to call a route.
+func routeRole(route Route) Role {
+	if route.Method == http.MethodGet || route.Method == http.MethodHead {
+		if adminReadRoutes[route.Name] {
+			return RoleAdmin
+		}
+		return RoleView
+	}
+	if viewWriteRoutes[route.Name] {
+		return RoleView
+	}
+	return RoleAdmin
+}
+
+// authorizeMiddleware rejects requests to a route by callers without the role it needs, recording each
+// decision in the audit log.  Requests to servers that do not authenticate callers are not checked.
+func authorizeMiddleware(route Route) mux.MiddlewareFunc {
+	required := routeRole(route)
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			identity := IdentityFromContext(r.Context())
+			if identity == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			allowed := identity.Role.Allows(required)
+			fields := LogFields{
+				"Route":        route.Name,
+				"User":         identity.Name,
+				"Groups":       identity.Groups,
+				"AuthSource":   identity.Source,
+				"Role":         identity.Role,
+				"RequiredRole": required,
+				"Allowed":      allowed,
+			}
+			if !allowed {
+				Audit().Logf(r.Context(), AuditRESTAccess, fields, "REST API call denied.")
+				w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+				writeHTTPResponse(r.Context(), w, &httpErrorResponse{
+					Error: fmt.Sprintf("user %s may not call %s", identity.Name, route.Name),
+				}, http.StatusForbidden)
+				return
+			}
+			Audit().Logf(r.Context(), AuditRESTAccess, fields, "REST API call authorized.")
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+type httpErrorResponse struct {
+	Error string `json:"error"`
+}
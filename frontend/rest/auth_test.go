@@ -0,0 +1,234 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package rest
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/netapp/trident/config"
+	mockcore "github.com/netapp/trident/mocks/mock_core"
+	"github.com/netapp/trident/storage"
+)
+
+func TestNewAuthenticator_Invalid(t *testing.T) {
+	for name, authConfig := range map[string]*AuthConfig{
+		"unknown local role": {LocalRole: "root"},
+		"unknown token role": {Tokens: []StaticToken{{User: "user1", Token: "token1", Role: "root"}}},
+		"token without user": {Tokens: []StaticToken{{Token: "token1"}}},
+		"duplicate token":    {Tokens: []StaticToken{{User: "user1", Token: "token1"}, {User: "user2", Token: "token1"}}},
+		"unknown binding":    {Bindings: []RoleBinding{{Role: "root", Users: []string{"user1"}}}},
+		"review without k8s": {TokenReview: true},
+	} {
+		_, err := NewAuthenticator(authConfig, nil)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestLoadAuthConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "auth.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte(`
+localRole: none
+tokens:
+- user: monitoring
+  token: abc
+  role: view
+bindings:
+- role: admin
+  groups: [ops]
+`), 0o600))
+
+	authConfig, err := LoadAuthConfig(path)
+	assert.NoError(t, err)
+	assert.Equal(t, RoleNone, authConfig.LocalRole)
+	assert.Equal(t, []StaticToken{{User: "monitoring", Token: "abc", Role: RoleView}}, authConfig.Tokens)
+	assert.Equal(t, []RoleBinding{{Role: RoleAdmin, Groups: []string{"ops"}}}, authConfig.Bindings)
+
+	_, err = LoadAuthConfig(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.Error(t, err)
+}
+
+func TestAuthenticator_Roles(t *testing.T) {
+	// Set up mocks and tear down functions.
+	oldOrchestrator := orchestrator
+	defer func() {
+		orchestrator = oldOrchestrator
+	}()
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+	orchestrator = mockOrchestrator
+
+	authenticator, err := NewAuthenticator(&AuthConfig{
+		Tokens: []StaticToken{
+			{User: "monitoring", Token: "view-token", Role: RoleView},
+			{User: "ops", Token: "ops-token", Groups: []string{"operators"}},
+			{User: "nobody", Token: "no-role-token"},
+		},
+		Bindings: []RoleBinding{{Role: RoleAdmin, Groups: []string{"operators"}}},
+	}, nil)
+	assert.NoError(t, err)
+
+	localServer := httptest.NewServer(authenticator.Handler(NewRouter(false), true))
+	defer localServer.Close()
+	remoteServer := httptest.NewServer(authenticator.Handler(NewRouter(false), false))
+	defer remoteServer.Close()
+
+	doRequest := func(method, url, token string) int {
+		req, err := http.NewRequest(method, url, nil)
+		assert.NoError(t, err, "expected no error")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		res, err := http.DefaultClient.Do(req)
+		if !assert.NoError(t, err, "expected no error") {
+			t.FailNow()
+		}
+		_ = res.Body.Close()
+		return res.StatusCode
+	}
+
+	listVolumes := "/trident/v1/volume"
+	deleteBackend := "/trident/v1/backend/backend1"
+	getCHAP := "/trident/v1/chap/vol1/node1"
+	explainVolume := "/trident/v1/volume/explain"
+
+	// The view role may read
+	mockOrchestrator.EXPECT().ListVolumes(gomock.Any()).Return([]*storage.VolumeExternal{}, nil)
+	assert.Equal(t, http.StatusOK, doRequest(http.MethodGet, remoteServer.URL+listVolumes, "view-token"))
+
+	// but not change anything or read secrets
+	assert.Equal(t, http.StatusForbidden, doRequest(http.MethodDelete, remoteServer.URL+deleteBackend, "view-token"))
+	assert.Equal(t, http.StatusForbidden, doRequest(http.MethodGet, remoteServer.URL+getCHAP, "view-token"))
+
+	// Dry runs change nothing, so the view role may make them; this one gets as far as rejecting the empty request
+	assert.Equal(t, http.StatusBadRequest, doRequest(http.MethodPost, remoteServer.URL+explainVolume, "view-token"))
+	assert.Equal(t, http.StatusForbidden, doRequest(http.MethodPost, remoteServer.URL+explainVolume, "no-role-token"))
+
+	// The admin role, here bound to a group, may do anything
+	mockOrchestrator.EXPECT().DeleteBackend(gomock.Any(), "backend1").Return(nil)
+	assert.Equal(t, http.StatusOK, doRequest(http.MethodDelete, remoteServer.URL+deleteBackend, "ops-token"))
+
+	// Users without a role may do nothing
+	assert.Equal(t, http.StatusForbidden, doRequest(http.MethodGet, remoteServer.URL+listVolumes, "no-role-token"))
+
+	// Unknown tokens and missing tokens are rejected, except locally
+	assert.Equal(t, http.StatusUnauthorized, doRequest(http.MethodGet, remoteServer.URL+listVolumes, "bad-token"))
+	assert.Equal(t, http.StatusUnauthorized, doRequest(http.MethodGet, localServer.URL+listVolumes, "bad-token"))
+	assert.Equal(t, http.StatusUnauthorized, doRequest(http.MethodGet, remoteServer.URL+listVolumes, ""))
+	mockOrchestrator.EXPECT().DeleteBackend(gomock.Any(), "backend1").Return(nil)
+	assert.Equal(t, http.StatusOK, doRequest(http.MethodDelete, localServer.URL+deleteBackend, ""))
+
+	// Local callers may be required to present tokens too
+	authenticator.localRole = RoleNone
+	assert.Equal(t, http.StatusUnauthorized, doRequest(http.MethodGet, localServer.URL+listVolumes, ""))
+}
+
+func TestAuthenticator_NodeCertificate(t *testing.T) {
+	authenticator, err := NewAuthenticator(&AuthConfig{}, nil)
+	assert.NoError(t, err)
+
+	request := httptest.NewRequest(http.MethodGet, "/trident/v1/node", nil)
+	request.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{
+		{Subject: pkix.Name{CommonName: config.ClientCertName}},
+	}}
+	identity, err := authenticator.authenticate(request, false)
+	assert.NoError(t, err)
+	assert.Equal(t, RoleAdmin, identity.Role)
+	assert.Equal(t, identitySourceCertificate, identity.Source)
+
+	request.TLS.PeerCertificates[0].Subject.CommonName = "someone-else"
+	_, err = authenticator.authenticate(request, false)
+	assert.Error(t, err)
+}
+
+func TestAuthenticator_TokenReview(t *testing.T) {
+	kubeClient := fake.NewSimpleClientset()
+	reviews := 0
+	kubeClient.PrependReactor("create", "tokenreviews",
+		func(action k8stesting.Action) (bool, runtime.Object, error) {
+			reviews++
+			review := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+			assert.Equal(t, []string{"trident"}, review.Spec.Audiences)
+			if review.Spec.Token == "sa-token" {
+				review.Status = authenticationv1.TokenReviewStatus{
+					Authenticated: true,
+					User: authenticationv1.UserInfo{
+						Username: "system:serviceaccount:monitoring:prometheus",
+						Groups:   []string{"system:serviceaccounts", "system:serviceaccounts:monitoring"},
+					},
+				}
+			} else {
+				review.Status = authenticationv1.TokenReviewStatus{Error: "token expired"}
+			}
+			return true, review, nil
+		})
+
+	authenticator, err := NewAuthenticator(&AuthConfig{
+		TokenReview: true,
+		Audiences:   []string{"trident"},
+		Bindings:    []RoleBinding{{Role: RoleView, Groups: []string{"system:serviceaccounts:monitoring"}}},
+	}, kubeClient)
+	assert.NoError(t, err)
+
+	request := httptest.NewRequest(http.MethodGet, "/trident/v1/volume", nil)
+	request.Header.Set("Authorization", "Bearer sa-token")
+	identity, err := authenticator.authenticate(request, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "system:serviceaccount:monitoring:prometheus", identity.Name)
+	assert.Equal(t, RoleView, identity.Role)
+	assert.Equal(t, identitySourceTokenReview, identity.Source)
+
+	// Reviews are remembered
+	_, err = authenticator.authenticate(request, false)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, reviews)
+
+	request.Header.Set("Authorization", "Bearer expired-token")
+	_, err = authenticator.authenticate(request, false)
+	assert.ErrorContains(t, err, "token expired")
+
+	request.Header.Set("Authorization", "Basic dXNlcjpwYXNz")
+	_, err = authenticator.authenticate(request, false)
+	assert.Error(t, err)
+}
+
+func TestAuthenticator_TokenReviewUnlocked(t *testing.T) {
+	var authenticator *Authenticator
+	kubeClient := fake.NewSimpleClientset()
+	kubeClient.PrependReactor("create", "tokenreviews",
+		func(action k8stesting.Action) (bool, runtime.Object, error) {
+			// Other callers may use the cache while a token is under review
+			if assert.True(t, authenticator.reviewMutex.TryLock(), "review cache locked during review") {
+				authenticator.reviewMutex.Unlock()
+			}
+			review := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+			review.Status = authenticationv1.TokenReviewStatus{
+				Authenticated: true,
+				User:          authenticationv1.UserInfo{Username: "user1"},
+			}
+			return true, review, nil
+		})
+
+	authenticator, err := NewAuthenticator(&AuthConfig{TokenReview: true}, kubeClient)
+	assert.NoError(t, err)
+
+	request := httptest.NewRequest(http.MethodGet, "/trident/v1/volume", nil)
+	request.Header.Set("Authorization", "Bearer sa-token")
+	identity, err := authenticator.authenticate(request, false)
+	assert.NoError(t, err)
+	assert.Equal(t, "user1", identity.Name)
+	assert.Len(t, authenticator.reviewCache, 1)
+}
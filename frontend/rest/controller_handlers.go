@@ -9,14 +9,19 @@ import (
 	"io"
 	"net/http"
 	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
 
 	"github.com/netapp/trident/acp"
 	"github.com/netapp/trident/config"
-	"github.com/netapp/trident/frontend"
+	"github.com/netapp/trident/core"
 	"github.com/netapp/trident/frontend/common"
+	"github.com/netapp/trident/frontend/controlplane"
 	controllerhelpers "github.com/netapp/trident/frontend/csi/controller_helpers"
 	k8shelper "github.com/netapp/trident/frontend/csi/controller_helpers/kubernetes"
 	. "github.com/netapp/trident/logging"
@@ -391,6 +396,7 @@ func UpdateBackendState(w http.ResponseWriter, r *http.Request) {
 
 type ListBackendsResponse struct {
 	Backends []string `json:"backends"`
+	Continue string   `json:"continue,omitempty"`
 	Error    string   `json:"error,omitempty"`
 }
 
@@ -405,11 +411,37 @@ func ListBackends(w http.ResponseWriter, r *http.Request) {
 			backendNames := make([]string, 0)
 			ctx := GenerateRequestContext(r.Context(), "", "", WorkflowBackendList, LogLayerRESTFrontend)
 
-			backends, err := orchestrator.ListBackends(ctx)
+			options, err := parseListOptions(r, filterState, filterStorageClass)
+			if err != nil {
+				response.Error = err.Error()
+				response.setList(backendNames)
+				return httpStatusCodeForGetUpdateList(err)
+			}
+
+			var (
+				backends       []*storage.BackendExternal
+				storageClasses []*storageclass.External
+			)
+			backends, err = orchestrator.ListBackends(ctx)
+			if err == nil && options.uses(filterStorageClass) {
+				storageClasses, err = orchestrator.ListStorageClasses(ctx)
+			}
 			if err != nil {
 				Logc(r.Context()).Errorf("ListBackends: %v", err)
 				response.Error = err.Error()
 			} else if len(backends) > 0 {
+				backends, response.Continue = paginate(options, backends,
+					func(backend *storage.BackendExternal) *listItem {
+						item := &listItem{name: backend.Name, fields: map[string][]string{
+							filterState: {string(backend.State)},
+						}}
+						for _, sc := range storageClasses {
+							if len(sc.StoragePools[backend.Name]) > 0 {
+								item.fields[filterStorageClass] = append(item.fields[filterStorageClass], sc.GetName())
+							}
+						}
+						return item
+					})
 				backendNames = make([]string, 0, len(backends))
 				for _, backend := range backends {
 					backendNames = append(backendNames, backend.Name)
@@ -522,9 +554,49 @@ func AddVolume(w http.ResponseWriter, r *http.Request) {
 	)
 }
 
+// ExplainVolumePlacement reports where the volume in the request body would be created, and why every other
+// storage pool would not be used, without creating it.
+func ExplainVolumePlacement(w http.ResponseWriter, r *http.Request) {
+	response := &ExplainPlacementResponse{}
+	UpdateGeneric(w, r, response, volumePlacementExplainer)
+}
+
+func volumePlacementExplainer(
+	_ http.ResponseWriter, r *http.Request,
+	response httpResponse, _ map[string]string, body []byte,
+) int {
+	ctx := GenerateRequestContext(r.Context(), "", "", WorkflowVolumeCreate, LogLayerRESTFrontend)
+
+	explainResponse, ok := response.(*ExplainPlacementResponse)
+	if !ok {
+		response.setError(fmt.Errorf("response object must be of type ExplainPlacementResponse"))
+		return http.StatusInternalServerError
+	}
+
+	volumeConfig := new(storage.VolumeConfig)
+	if err := json.Unmarshal(body, volumeConfig); err != nil {
+		explainResponse.setError(fmt.Errorf("invalid JSON: %s", err.Error()))
+		return http.StatusBadRequest
+	}
+	if err := volumeConfig.Validate(); err != nil {
+		explainResponse.setError(err)
+		return http.StatusBadRequest
+	}
+
+	placement, err := orchestrator.ExplainVolumePlacement(ctx, volumeConfig)
+	if err != nil {
+		explainResponse.setError(err)
+		return httpStatusCodeForGetUpdateList(err)
+	}
+
+	explainResponse.Placement = placement
+	return http.StatusOK
+}
+
 type ListVolumesResponse struct {
-	Volumes []string `json:"volumes"`
-	Error   string   `json:"error,omitempty"`
+	Volumes  []string `json:"volumes"`
+	Continue string   `json:"continue,omitempty"`
+	Error    string   `json:"error,omitempty"`
 }
 
 func (l *ListVolumesResponse) setList(payload []string) {
@@ -544,6 +616,14 @@ func ListVolumes(w http.ResponseWriter, r *http.Request) {
 			volumeNames := make([]string, 0)
 			ctx := GenerateRequestContext(r.Context(), "", "", WorkflowVolumeList, LogLayerRESTFrontend)
 
+			options, err := parseListOptions(r, filterBackend, filterStorageClass, filterState, filterNamespace,
+				filterNode)
+			if err != nil {
+				response.Error = err.Error()
+				response.setList(volumeNames)
+				return httpStatusCodeForGetUpdateList(err)
+			}
+
 			if r.URL.Query().Has("subordinateOf") {
 				volumes, err = orchestrator.ListSubordinateVolumes(ctx, r.URL.Query().Get("subordinateOf"))
 			} else if r.URL.Query().Has("parentOfSubordinate") {
@@ -553,9 +633,15 @@ func ListVolumes(w http.ResponseWriter, r *http.Request) {
 				volumes, err = orchestrator.ListVolumes(ctx)
 			}
 
+			var describe func(*storage.VolumeExternal) *listItem
+			if err == nil {
+				describe, err = volumeListItems(ctx, options)
+			}
+
 			if err != nil {
 				response.Error = err.Error()
 			} else if len(volumes) > 0 {
+				volumes, response.Continue = paginate(options, volumes, describe)
 				volumeNames = make([]string, 0, len(volumes))
 				for _, volume := range volumes {
 					volumeNames = append(volumeNames, volume.Config.Name)
@@ -567,6 +653,49 @@ func ListVolumes(w http.ResponseWriter, r *http.Request) {
 	)
 }
 
+// volumeListItems returns a function describing volumes for the list options, looking up their backends'
+// names and the nodes they are published to only if needed.
+func volumeListItems(
+	ctx context.Context, options *listOptions,
+) (func(*storage.VolumeExternal) *listItem, error) {
+	backendNames := make(map[string]string)
+	if options.uses(filterBackend) {
+		backends, err := orchestrator.ListBackends(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, backend := range backends {
+			backendNames[backend.BackendUUID] = backend.Name
+		}
+	}
+
+	publishedNodes := make(map[string][]string)
+	if options.uses(filterNode) {
+		publications, err := orchestrator.ListVolumePublications(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, publication := range publications {
+			publishedNodes[publication.VolumeName] = append(publishedNodes[publication.VolumeName],
+				publication.NodeName)
+		}
+	}
+
+	return func(volume *storage.VolumeExternal) *listItem {
+		backends := []string{volume.BackendUUID}
+		if name, ok := backendNames[volume.BackendUUID]; ok {
+			backends = []string{name, volume.BackendUUID}
+		}
+		return &listItem{name: volume.Config.Name, fields: map[string][]string{
+			filterBackend:      backends,
+			filterStorageClass: {volume.Config.StorageClass},
+			filterState:        {string(volume.State)},
+			filterNamespace:    {volume.Config.Namespace},
+			filterNode:         publishedNodes[volume.Config.Name],
+		}}
+	}, nil
+}
+
 type GetVolumeResponse struct {
 	Volume *storage.VolumeExternal `json:"volume"`
 	Error  string                  `json:"error,omitempty"`
@@ -723,6 +852,187 @@ func volumeUpdater(
 	return http.StatusOK
 }
 
+type MoveVolumeResponse struct {
+	Volume *storage.VolumeExternal `json:"volume"`
+	Status string                  `json:"status,omitempty"`
+	Error  string                  `json:"error,omitempty"`
+}
+
+func (r *MoveVolumeResponse) setError(err error) {
+	r.Error = err.Error()
+}
+
+func (r *MoveVolumeResponse) isError() bool {
+	return r.Error != ""
+}
+
+func (r *MoveVolumeResponse) logSuccess(ctx context.Context) {
+	Logc(ctx).WithFields(LogFields{
+		"handler": "MoveVolume",
+		"status":  r.Status,
+	}).Info("Moved a volume.")
+}
+
+func (r *MoveVolumeResponse) logFailure(ctx context.Context) {
+	Logc(ctx).WithFields(LogFields{
+		"handler": "MoveVolume",
+	}).Error(r.Error)
+}
+
+// MoveVolume advances the move of a volume to another pool.  Moves may take a long time, so until the move is
+// complete the current volume is returned with http.StatusAccepted and a status describing its progress, and
+// the request should be repeated.
+func MoveVolume(w http.ResponseWriter, r *http.Request) {
+	response := &MoveVolumeResponse{}
+	UpdateGeneric(w, r, response, volumeMover)
+}
+
+func volumeMover(
+	_ http.ResponseWriter, r *http.Request,
+	response httpResponse, vars map[string]string, body []byte,
+) int {
+	ctx := GenerateRequestContext(r.Context(), "", "", WorkflowVolumeMove, LogLayerRESTFrontend)
+
+	moveResponse, ok := response.(*MoveVolumeResponse)
+	if !ok {
+		response.setError(fmt.Errorf("response object must be of type MoveVolumeResponse"))
+		return http.StatusInternalServerError
+	}
+
+	moveVolRequest := &storage.MoveVolumeRequest{}
+	if err := json.Unmarshal(body, moveVolRequest); err != nil {
+		moveResponse.setError(fmt.Errorf("invalid JSON: %s", err.Error()))
+		return http.StatusBadRequest
+	}
+	if err := moveVolRequest.Validate(); err != nil {
+		moveResponse.setError(err)
+		return http.StatusBadRequest
+	}
+
+	volName := vars["volume"]
+
+	volume, err := orchestrator.MoveVolume(ctx, volName, moveVolRequest.Backend, moveVolRequest.Pool)
+	if err != nil {
+		if errors.IsInProgressError(err) {
+			moveResponse.Status = err.Error()
+			if moveResponse.Volume, err = orchestrator.GetVolume(ctx, volName); err != nil {
+				moveResponse.setError(err)
+				return http.StatusInternalServerError
+			}
+			return http.StatusAccepted
+		}
+
+		moveResponse.setError(err)
+		if errors.IsInvalidInputError(err) {
+			return http.StatusBadRequest
+		} else if errors.IsNotFoundError(err) {
+			return http.StatusNotFound
+		} else if errors.IsUnsupportedError(err) || errors.IsNotManagedError(err) {
+			return http.StatusForbidden
+		} else if errors.IsVolumeStateError(err) {
+			return http.StatusConflict
+		}
+		return http.StatusInternalServerError
+	}
+
+	moveResponse.Volume = volume
+	return http.StatusOK
+}
+
+type GetOrphanReportResponse struct {
+	Report *storage.OrphanReport `json:"report"`
+	Error  string                `json:"error,omitempty"`
+}
+
+// GetOrphanReport compares the volumes and snapshots on the storage backends with those tracked by Trident.
+// The report may be limited to one backend with the backend query parameter.
+func GetOrphanReport(w http.ResponseWriter, r *http.Request) {
+	response := &GetOrphanReportResponse{}
+	GetGeneric(w, r, response,
+		func(map[string]string) int {
+			ctx := GenerateRequestContext(r.Context(), "", "", WorkflowBackendGetOrphans, LogLayerRESTFrontend)
+
+			report, err := orchestrator.GetOrphanReport(ctx, r.URL.Query().Get("backend"))
+			if err != nil {
+				response.Error = err.Error()
+			}
+			response.Report = report
+			return httpStatusCodeForGetUpdateList(err)
+		},
+	)
+}
+
+type ResolveOrphansResponse struct {
+	Report *storage.OrphanReport `json:"report"`
+	Error  string                `json:"error,omitempty"`
+}
+
+func (r *ResolveOrphansResponse) setError(err error) {
+	r.Error = err.Error()
+}
+
+func (r *ResolveOrphansResponse) isError() bool {
+	return r.Error != ""
+}
+
+func (r *ResolveOrphansResponse) logSuccess(ctx context.Context) {
+	Logc(ctx).WithFields(LogFields{
+		"handler": "ResolveOrphans",
+	}).Info("Resolved untracked volumes.")
+}
+
+func (r *ResolveOrphansResponse) logFailure(ctx context.Context) {
+	Logc(ctx).WithFields(LogFields{
+		"handler": "ResolveOrphans",
+	}).Error(r.Error)
+}
+
+// ResolveOrphans imports or deletes the untracked volumes found on the storage backends.  Unless the request
+// explicitly turns off its dry run, nothing is changed.  The outcome for each volume is recorded in the report.
+func ResolveOrphans(w http.ResponseWriter, r *http.Request) {
+	response := &ResolveOrphansResponse{}
+	UpdateGeneric(w, r, response, orphanResolver)
+}
+
+func orphanResolver(
+	_ http.ResponseWriter, r *http.Request,
+	response httpResponse, _ map[string]string, body []byte,
+) int {
+	ctx := GenerateRequestContext(r.Context(), "", "", WorkflowBackendResolveOrphans, LogLayerRESTFrontend)
+
+	resolveResponse, ok := response.(*ResolveOrphansResponse)
+	if !ok {
+		response.setError(fmt.Errorf("response object must be of type ResolveOrphansResponse"))
+		return http.StatusInternalServerError
+	}
+
+	resolveRequest := &storage.ResolveOrphansRequest{}
+	if err := json.Unmarshal(body, resolveRequest); err != nil {
+		resolveResponse.setError(fmt.Errorf("invalid JSON: %s", err.Error()))
+		return http.StatusBadRequest
+	}
+	if err := resolveRequest.Validate(); err != nil {
+		resolveResponse.setError(err)
+		return http.StatusBadRequest
+	}
+
+	report, err := orchestrator.ResolveOrphans(ctx, resolveRequest)
+	if err != nil {
+		resolveResponse.setError(err)
+		if errors.IsInvalidInputError(err) {
+			return http.StatusBadRequest
+		} else if errors.IsNotFoundError(err) {
+			return http.StatusNotFound
+		} else if errors.IsNotReadyError(err) {
+			return http.StatusServiceUnavailable
+		}
+		return http.StatusInternalServerError
+	}
+
+	resolveResponse.Report = report
+	return http.StatusOK
+}
+
 type ImportVolumeResponse struct {
 	Volume *storage.VolumeExternal `json:"volume"`
 	Error  string                  `json:"error,omitempty"`
@@ -851,6 +1161,7 @@ func AddStorageClass(w http.ResponseWriter, r *http.Request) {
 
 type ListStorageClassesResponse struct {
 	StorageClasses []string `json:"storageClasses"`
+	Continue       string   `json:"continue,omitempty"`
 	Error          string   `json:"error,omitempty"`
 }
 
@@ -865,10 +1176,28 @@ func ListStorageClasses(w http.ResponseWriter, r *http.Request) {
 			storageClassNames := make([]string, 0)
 			ctx := GenerateRequestContext(r.Context(), "", "", WorkflowStorageClassList, LogLayerRESTFrontend)
 
+			options, err := parseListOptions(r, filterBackend)
+			if err != nil {
+				response.Error = err.Error()
+				response.setList(storageClassNames)
+				return httpStatusCodeForGetUpdateList(err)
+			}
+
 			storageClasses, err := orchestrator.ListStorageClasses(ctx)
 			if err != nil {
 				response.Error = err.Error()
 			} else if len(storageClasses) > 0 {
+				storageClasses, response.Continue = paginate(options, storageClasses,
+					func(sc *storageclass.External) *listItem {
+						item := &listItem{name: sc.GetName(), fields: make(map[string][]string)}
+						for backendName, pools := range sc.StoragePools {
+							if len(pools) > 0 {
+								item.fields[filterBackend] = append(item.fields[filterBackend], backendName)
+							}
+						}
+						sort.Strings(item.fields[filterBackend])
+						return item
+					})
 				storageClassNames = make([]string, 0, len(storageClasses))
 				for _, sc := range storageClasses {
 					storageClassNames = append(storageClassNames, sc.GetName())
@@ -902,6 +1231,50 @@ func GetStorageClass(w http.ResponseWriter, r *http.Request) {
 	)
 }
 
+type ExplainPlacementResponse struct {
+	Placement *storageclass.PlacementExplanation `json:"placement"`
+	Error     string                             `json:"error,omitempty"`
+}
+
+func (e *ExplainPlacementResponse) setError(err error) {
+	e.Error = err.Error()
+}
+
+func (e *ExplainPlacementResponse) isError() bool {
+	return e.Error != ""
+}
+
+func (e *ExplainPlacementResponse) logSuccess(ctx context.Context) {
+	Logc(ctx).WithFields(LogFields{
+		"handler": "ExplainVolumePlacement",
+	}).Debug("Explained volume placement.")
+}
+
+func (e *ExplainPlacementResponse) logFailure(ctx context.Context) {
+	Logc(ctx).WithFields(LogFields{
+		"handler": "ExplainVolumePlacement",
+	}).Error(e.Error)
+}
+
+// ExplainStorageClass lists every storage pool with the checks that accepted it for, or rejected it from,
+// the storage class.
+func ExplainStorageClass(w http.ResponseWriter, r *http.Request) {
+	response := &ExplainPlacementResponse{}
+	GetGeneric(w, r, response,
+		func(vars map[string]string) int {
+			ctx := GenerateRequestContext(r.Context(), "", "", WorkflowStorageClassGet, LogLayerRESTFrontend)
+
+			placement, err := orchestrator.ExplainStorageClass(ctx, vars["storageClass"])
+			if err != nil {
+				response.Error = err.Error()
+			} else {
+				response.Placement = placement
+			}
+			return httpStatusCodeForGetUpdateList(err)
+		},
+	)
+}
+
 func DeleteStorageClass(w http.ResponseWriter, r *http.Request) {
 	DeleteGeneric(w, r, func(ctx context.Context, vars map[string]string) error {
 		ctx = GenerateRequestContext(r.Context(), "", "", WorkflowStorageClassDelete, LogLayerRESTFrontend)
@@ -910,6 +1283,192 @@ func DeleteStorageClass(w http.ResponseWriter, r *http.Request) {
 	})
 }
 
+type AddSnapshotPolicyResponse struct {
+	SnapshotPolicyID string `json:"snapshotPolicy"`
+	Error            string `json:"error,omitempty"`
+}
+
+func (a *AddSnapshotPolicyResponse) setError(err error) {
+	a.Error = err.Error()
+}
+
+func (a *AddSnapshotPolicyResponse) isError() bool {
+	return a.Error != ""
+}
+
+func (a *AddSnapshotPolicyResponse) logSuccess(ctx context.Context) {
+	Logc(ctx).WithFields(LogFields{
+		"handler":        "AddSnapshotPolicy",
+		"snapshotPolicy": a.SnapshotPolicyID,
+	}).Info("Added a new snapshot policy.")
+}
+
+func (a *AddSnapshotPolicyResponse) logFailure(ctx context.Context) {
+	Logc(ctx).WithFields(LogFields{
+		"handler":        "AddSnapshotPolicy",
+		"snapshotPolicy": a.SnapshotPolicyID,
+	}).Error(a.Error)
+}
+
+func AddSnapshotPolicy(w http.ResponseWriter, r *http.Request) {
+	response := &AddSnapshotPolicyResponse{}
+	AddGeneric(w, r, response,
+		func(body []byte) int {
+			policyConfig := new(storage.SnapshotPolicyConfig)
+			err := json.Unmarshal(body, policyConfig)
+			if err != nil {
+				response.setError(fmt.Errorf("invalid JSON: %s", err.Error()))
+				return httpStatusCodeForAdd(err)
+			}
+			ctx := GenerateRequestContext(r.Context(), "", "", WorkflowSnapshotPolicyCreate, LogLayerRESTFrontend)
+
+			policy, err := orchestrator.AddSnapshotPolicy(ctx, policyConfig)
+			if err != nil {
+				response.setError(err)
+			}
+			if policy != nil {
+				response.SnapshotPolicyID = policy.Config.Name
+			}
+			return httpStatusCodeForAdd(err)
+		},
+	)
+}
+
+type UpdateSnapshotPolicyResponse struct {
+	SnapshotPolicyID string `json:"snapshotPolicy"`
+	Error            string `json:"error,omitempty"`
+}
+
+func (u *UpdateSnapshotPolicyResponse) setError(err error) {
+	u.Error = err.Error()
+}
+
+func (u *UpdateSnapshotPolicyResponse) isError() bool {
+	return u.Error != ""
+}
+
+func (u *UpdateSnapshotPolicyResponse) logSuccess(ctx context.Context) {
+	Logc(ctx).WithFields(LogFields{
+		"handler":        "UpdateSnapshotPolicy",
+		"snapshotPolicy": u.SnapshotPolicyID,
+	}).Info("Updated a snapshot policy.")
+}
+
+func (u *UpdateSnapshotPolicyResponse) logFailure(ctx context.Context) {
+	Logc(ctx).WithFields(LogFields{
+		"handler":        "UpdateSnapshotPolicy",
+		"snapshotPolicy": u.SnapshotPolicyID,
+	}).Error(u.Error)
+}
+
+func UpdateSnapshotPolicy(w http.ResponseWriter, r *http.Request) {
+	response := &UpdateSnapshotPolicyResponse{}
+	UpdateGeneric(w, r, response,
+		func(w http.ResponseWriter, r *http.Request, response httpResponse, vars map[string]string, body []byte) int {
+			updateResponse, ok := response.(*UpdateSnapshotPolicyResponse)
+			if !ok {
+				response.setError(fmt.Errorf("response object must be of type UpdateSnapshotPolicyResponse"))
+				return http.StatusInternalServerError
+			}
+			policyConfig := new(storage.SnapshotPolicyConfig)
+			err := json.Unmarshal(body, policyConfig)
+			if err != nil {
+				updateResponse.setError(fmt.Errorf("invalid JSON: %s", err.Error()))
+				return httpStatusCodeForGetUpdateList(err)
+			}
+			if policyConfig.Name == "" {
+				policyConfig.Name = vars["snapshotPolicy"]
+			} else if policyConfig.Name != vars["snapshotPolicy"] {
+				updateResponse.setError(fmt.Errorf("snapshot policy name %s does not match URL", policyConfig.Name))
+				return http.StatusBadRequest
+			}
+			ctx := GenerateRequestContext(r.Context(), "", "", WorkflowSnapshotPolicyUpdate, LogLayerRESTFrontend)
+
+			policy, err := orchestrator.UpdateSnapshotPolicy(ctx, policyConfig)
+			if err != nil {
+				updateResponse.setError(err)
+			}
+			if policy != nil {
+				updateResponse.SnapshotPolicyID = policy.Config.Name
+			}
+			return httpStatusCodeForGetUpdateList(err)
+		},
+	)
+}
+
+type ListSnapshotPoliciesResponse struct {
+	SnapshotPolicies []string `json:"snapshotPolicies"`
+	Continue         string   `json:"continue,omitempty"`
+	Error            string   `json:"error,omitempty"`
+}
+
+func (l *ListSnapshotPoliciesResponse) setList(payload []string) {
+	l.SnapshotPolicies = payload
+}
+
+func ListSnapshotPolicies(w http.ResponseWriter, r *http.Request) {
+	response := &ListSnapshotPoliciesResponse{}
+	ListGeneric(w, r, response,
+		func(_ map[string]string) int {
+			policyNames := make([]string, 0)
+			ctx := GenerateRequestContext(r.Context(), "", "", WorkflowSnapshotPolicyList, LogLayerRESTFrontend)
+
+			options, err := parseListOptions(r)
+			if err != nil {
+				response.Error = err.Error()
+				response.setList(policyNames)
+				return httpStatusCodeForGetUpdateList(err)
+			}
+
+			policies, err := orchestrator.ListSnapshotPolicies(ctx)
+			if err != nil {
+				response.Error = err.Error()
+			} else if len(policies) > 0 {
+				policies, response.Continue = paginate(options, policies,
+					func(policy *storage.SnapshotPolicyExternal) *listItem {
+						return &listItem{name: policy.Config.Name}
+					})
+				policyNames = make([]string, 0, len(policies))
+				for _, policy := range policies {
+					policyNames = append(policyNames, policy.Config.Name)
+				}
+			}
+			response.setList(policyNames)
+			return httpStatusCodeForGetUpdateList(err)
+		},
+	)
+}
+
+type GetSnapshotPolicyResponse struct {
+	SnapshotPolicy *storage.SnapshotPolicyExternal `json:"snapshotPolicy"`
+	Error          string                          `json:"error,omitempty"`
+}
+
+func GetSnapshotPolicy(w http.ResponseWriter, r *http.Request) {
+	response := &GetSnapshotPolicyResponse{}
+	GetGeneric(w, r, response,
+		func(vars map[string]string) int {
+			ctx := GenerateRequestContext(r.Context(), "", "", WorkflowSnapshotPolicyGet, LogLayerRESTFrontend)
+
+			policy, err := orchestrator.GetSnapshotPolicy(ctx, vars["snapshotPolicy"])
+			if err != nil {
+				response.Error = err.Error()
+			} else {
+				response.SnapshotPolicy = policy
+			}
+			return httpStatusCodeForGetUpdateList(err)
+		},
+	)
+}
+
+func DeleteSnapshotPolicy(w http.ResponseWriter, r *http.Request) {
+	DeleteGeneric(w, r, func(ctx context.Context, vars map[string]string) error {
+		ctx = GenerateRequestContext(r.Context(), "", "", WorkflowSnapshotPolicyDelete, LogLayerRESTFrontend)
+
+		return orchestrator.DeleteSnapshotPolicy(ctx, vars["snapshotPolicy"])
+	})
+}
+
 type AddNodeResponse struct {
 	Name           string            `json:"name"`
 	TopologyLabels map[string]string `json:"topologyLabels,omitempty"`
@@ -971,43 +1530,13 @@ func AddNode(w http.ResponseWriter, r *http.Request) {
 				return httpStatusCodeForAdd(err)
 			}
 
-			var csiFrontend frontend.Plugin
 			ctx := GenerateRequestContext(r.Context(), "", "", WorkflowNodeCreate, LogLayerRESTFrontend)
 
-			csiFrontend, err = orchestrator.GetFrontend(ctx, controllerhelpers.KubernetesHelper)
-			if err != nil {
-				csiFrontend, err = orchestrator.GetFrontend(ctx, controllerhelpers.PlainCSIHelper)
-			}
-			if err != nil {
-				err = fmt.Errorf("could not get CSI helper frontend")
-				updateResponse.setError(err)
-				return httpStatusCodeForAdd(err)
-			}
-
-			helper, ok := csiFrontend.(controllerhelpers.ControllerHelper)
-			if !ok {
-				err = fmt.Errorf("could not get CSI hybrid frontend")
-				updateResponse.setError(err)
-				return httpStatusCodeForAdd(err)
-			}
-			topologyLabels, err := helper.GetNodeTopologyLabels(ctx, node.Name)
-			if err != nil {
-				updateResponse.setError(err)
-				return httpStatusCodeForAdd(err)
-			}
-			node.TopologyLabels = topologyLabels
-			updateResponse.setTopologyLabels(topologyLabels)
-			Logc(r.Context()).WithField("node", node.Name).Info("Determined topology labels for node: ",
-				topologyLabels)
-
-			nodeEventCallback := func(eventType, reason, message string) {
-				helper.RecordNodeEvent(ctx, node.Name, eventType, reason, message)
-			}
-
-			err = orchestrator.AddNode(ctx, node, nodeEventCallback)
+			err = controlplane.AddNode(ctx, orchestrator, node)
 			if err != nil {
 				response.setError(err)
 			}
+			updateResponse.setTopologyLabels(node.TopologyLabels)
 			updateResponse.Name = node.Name
 			updateResponse.LogLevel = node.LogLevel
 			updateResponse.LogWorkflows = node.LogWorkflows
@@ -1073,37 +1602,7 @@ func nodeUpdater(
 		ctx := context.Background()
 		logEntry := Logc(ctx).WithFields(LogFields{"handler": "UpdateNode", "nodeName": nodeName})
 
-		// Get the just-in-time node state from Kubernetes
-		k8sFrontend, err := orchestrator.GetFrontend(ctx, controllerhelpers.KubernetesHelper)
-		if err != nil {
-			logEntry.Error(err)
-			return
-		}
-		k8s, ok := k8sFrontend.(k8shelper.K8SControllerHelperPlugin)
-		if !ok {
-			logEntry.Error(fmt.Errorf("unable to obtain Kubernetes frontend"))
-			return
-		}
-
-		k8sNodePublicationState, err := k8s.GetNodePublicationState(ctx, nodeName)
-		if err != nil {
-			logEntry.Error(err)
-			return
-		}
-
-		// If the nodePublicationState came from another actor (like tridentctl, or trident node pod)
-		// Then we should use those values. If those values are set, these values will be used. Otherwise,
-		// we should use the k8sNodePublication state.
-		if k8sNodePublicationState != nil {
-			if nodePublicationState.OrchestratorReady == nil && k8sNodePublicationState.OrchestratorReady != nil {
-				nodePublicationState.OrchestratorReady = k8sNodePublicationState.OrchestratorReady
-			}
-			if nodePublicationState.AdministratorReady == nil && k8sNodePublicationState.AdministratorReady != nil {
-				nodePublicationState.AdministratorReady = k8sNodePublicationState.AdministratorReady
-			}
-		}
-
-		err = orchestrator.UpdateNode(ctx, nodeName, nodePublicationState)
+		err := controlplane.UpdateNodePublicationState(ctx, orchestrator, nodeName, nodePublicationState)
 		if err == nil {
 			logEntry.Infof("Updated a node: %s state", nodeName)
 		} else {
@@ -1139,8 +1638,9 @@ func GetNode(w http.ResponseWriter, r *http.Request) {
 }
 
 type ListNodesResponse struct {
-	Nodes []string `json:"nodes"`
-	Error string   `json:"error,omitempty"`
+	Nodes    []string `json:"nodes"`
+	Continue string   `json:"continue,omitempty"`
+	Error    string   `json:"error,omitempty"`
 }
 
 func (l *ListNodesResponse) setList(payload []string) {
@@ -1152,10 +1652,24 @@ func ListNodes(w http.ResponseWriter, r *http.Request) {
 	ListGeneric(w, r, response,
 		func(_ map[string]string) int {
 			nodeNames := make([]string, 0)
+			options, err := parseListOptions(r, filterState, filterLabel)
+			if err != nil {
+				response.Error = err.Error()
+				response.setList(nodeNames)
+				return httpStatusCodeForGetUpdateList(err)
+			}
+
 			nodes, err := orchestrator.ListNodes(r.Context())
 			if err != nil {
 				response.Error = err.Error()
 			} else if len(nodes) > 0 {
+				nodes, response.Continue = paginate(options, nodes, func(node *utils.NodeExternal) *listItem {
+					return &listItem{
+						name:   node.Name,
+						fields: map[string][]string{filterState: {string(node.PublicationState)}},
+						labels: node.TopologyLabels,
+					}
+				})
 				nodeNames = make([]string, 0, len(nodes))
 				for _, node := range nodes {
 					nodeNames = append(nodeNames, node.Name)
@@ -1195,6 +1709,7 @@ func GetVolumePublication(w http.ResponseWriter, r *http.Request) {
 
 type VolumePublicationsResponse struct {
 	VolumePublications []*utils.VolumePublicationExternal `json:"volumePublications"`
+	Continue           string                             `json:"continue,omitempty"`
 	Error              string                             `json:"error,omitempty"`
 }
 
@@ -1222,11 +1737,17 @@ func ListVolumePublications(w http.ResponseWriter, r *http.Request) {
 	response := &VolumePublicationsResponse{}
 	GetGeneric(w, r, response,
 		func(_ map[string]string) int {
+			options, err := parseListOptions(r, filterNode)
+			if err != nil {
+				response.Error = err.Error()
+				return httpStatusCodeForGetUpdateList(err)
+			}
+
 			pubs, err := orchestrator.ListVolumePublications(r.Context())
 			if err != nil {
 				response.Error = err.Error()
 			} else {
-				response.VolumePublications = pubs
+				response.VolumePublications, response.Continue = paginate(options, pubs, publicationListItem)
 			}
 			return httpStatusCodeForGetUpdateList(err)
 		},
@@ -1237,11 +1758,17 @@ func ListVolumePublicationsForVolume(w http.ResponseWriter, r *http.Request) {
 	response := &VolumePublicationsResponse{}
 	GetGeneric(w, r, response,
 		func(vars map[string]string) int {
+			options, err := parseListOptions(r, filterNode)
+			if err != nil {
+				response.Error = err.Error()
+				return httpStatusCodeForGetUpdateList(err)
+			}
+
 			pubs, err := orchestrator.ListVolumePublicationsForVolume(r.Context(), vars["volume"])
 			if err != nil {
 				response.Error = err.Error()
 			} else {
-				response.VolumePublications = pubs
+				response.VolumePublications, response.Continue = paginate(options, pubs, publicationListItem)
 			}
 			return httpStatusCodeForGetUpdateList(err)
 		},
@@ -1252,17 +1779,27 @@ func ListVolumePublicationsForNode(w http.ResponseWriter, r *http.Request) {
 	response := &VolumePublicationsResponse{}
 	GetGeneric(w, r, response,
 		func(vars map[string]string) int {
+			options, err := parseListOptions(r, filterNode)
+			if err != nil {
+				response.Error = err.Error()
+				return httpStatusCodeForGetUpdateList(err)
+			}
+
 			pubs, err := orchestrator.ListVolumePublicationsForNode(r.Context(), vars["node"])
 			if err != nil {
 				response.Error = err.Error()
 			} else {
-				response.VolumePublications = pubs
+				response.VolumePublications, response.Continue = paginate(options, pubs, publicationListItem)
 			}
 			return httpStatusCodeForGetUpdateList(err)
 		},
 	)
 }
 
+func publicationListItem(publication *utils.VolumePublicationExternal) *listItem {
+	return &listItem{name: publication.Name, fields: map[string][]string{filterNode: {publication.NodeName}}}
+}
+
 type GetSnapshotResponse struct {
 	Snapshot *storage.SnapshotExternal `json:"snapshot"`
 	Error    string                    `json:"error,omitempty"`
@@ -1285,6 +1822,7 @@ func GetSnapshot(w http.ResponseWriter, r *http.Request) {
 
 type ListSnapshotsResponse struct {
 	Snapshots []string `json:"snapshots"`
+	Continue  string   `json:"continue,omitempty"`
 	Error     string   `json:"error,omitempty"`
 }
 
@@ -1297,10 +1835,22 @@ func ListSnapshots(w http.ResponseWriter, r *http.Request) {
 	ListGeneric(w, r, response,
 		func(_ map[string]string) int {
 			snapshotIDs := make([]string, 0)
+			options, err := parseListOptions(r, filterBackend, filterStorageClass, filterState, filterNamespace)
+			if err != nil {
+				response.Error = err.Error()
+				response.setList(snapshotIDs)
+				return httpStatusCodeForGetUpdateList(err)
+			}
+
 			snapshots, err := orchestrator.ListSnapshots(r.Context())
+			var describe func(*storage.SnapshotExternal) *listItem
+			if err == nil {
+				describe, err = snapshotListItems(r.Context(), options)
+			}
 			if err != nil {
 				response.Error = err.Error()
 			} else if len(snapshots) > 0 {
+				snapshots, response.Continue = paginate(options, snapshots, describe)
 				snapshotIDs = make([]string, 0, len(snapshots))
 				for _, snapshot := range snapshots {
 					snapshotIDs = append(snapshotIDs, snapshot.ID())
@@ -1317,10 +1867,22 @@ func ListSnapshotsForVolume(w http.ResponseWriter, r *http.Request) {
 	ListGeneric(w, r, response,
 		func(vars map[string]string) int {
 			snapshotIDs := make([]string, 0)
+			options, err := parseListOptions(r, filterBackend, filterStorageClass, filterState, filterNamespace)
+			if err != nil {
+				response.Error = err.Error()
+				response.setList(snapshotIDs)
+				return httpStatusCodeForGetUpdateList(err)
+			}
+
 			snapshots, err := orchestrator.ListSnapshotsForVolume(r.Context(), vars["volume"])
+			var describe func(*storage.SnapshotExternal) *listItem
+			if err == nil {
+				describe, err = snapshotListItems(r.Context(), options)
+			}
 			if err != nil {
 				response.Error = err.Error()
 			} else if len(snapshots) > 0 {
+				snapshots, response.Continue = paginate(options, snapshots, describe)
 				snapshotIDs = make([]string, 0, len(snapshots))
 				for _, snapshot := range snapshots {
 					snapshotIDs = append(snapshotIDs, snapshot.ID())
@@ -1332,6 +1894,39 @@ func ListSnapshotsForVolume(w http.ResponseWriter, r *http.Request) {
 	)
 }
 
+// snapshotListItems returns a function describing snapshots for the list options.  Snapshots are filtered
+// by the backend, storage class and namespace of their volumes, which are looked up only if needed.
+func snapshotListItems(
+	ctx context.Context, options *listOptions,
+) (func(*storage.SnapshotExternal) *listItem, error) {
+	volumeItems := make(map[string]*listItem)
+	if options.uses(filterBackend) || options.uses(filterStorageClass) || options.uses(filterNamespace) {
+		describeVolume, err := volumeListItems(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+		volumes, err := orchestrator.ListVolumes(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, volume := range volumes {
+			volumeItems[volume.Config.Name] = describeVolume(volume)
+		}
+	}
+
+	return func(snapshot *storage.SnapshotExternal) *listItem {
+		item := &listItem{name: snapshot.ID(), fields: map[string][]string{
+			filterState: {string(snapshot.State)},
+		}}
+		if volumeItem, ok := volumeItems[snapshot.Config.VolumeName]; ok {
+			for _, field := range []string{filterBackend, filterStorageClass, filterNamespace} {
+				item.fields[field] = volumeItem.fields[field]
+			}
+		}
+		return item
+	}, nil
+}
+
 type AddSnapshotResponse struct {
 	SnapshotID string `json:"snapshotID"`
 	Error      string `json:"error,omitempty"`
@@ -1567,3 +2162,131 @@ func SetLoggingLayers(w http.ResponseWriter, r *http.Request) {
 		},
 	)
 }
+
+// eventKeepaliveInterval is how often WatchEvents writes a comment to an idle stream, so that proxies and
+// clients do not time it out.
+const eventKeepaliveInterval = 30 * time.Second
+
+// WatchEventResponse is the data of each server-sent event written by WatchEvents.
+type WatchEventResponse struct {
+	Sequence  uint64          `json:"sequence"`
+	Type      core.EventType  `json:"type"`
+	Kind      core.EventKind  `json:"kind"`
+	Name      string          `json:"name"`
+	Timestamp time.Time       `json:"timestamp"`
+	Object    json.RawMessage `json:"object,omitempty"`
+}
+
+type WatchEventsResponse struct {
+	Error string `json:"error,omitempty"`
+}
+
+// WatchEvents streams changes made by the orchestrator as server-sent events, each with its sequence number
+// as its ID and "<kind>.<type>" as its event name.  The kind query parameter limits the stream to some kinds
+// of object.  A client resumes after disconnecting by sending the last ID it received in the Last-Event-ID
+// header or the since query parameter; if those events are no longer held, the request fails with 410 Gone
+// and the client should list the objects again.
+func WatchEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := GenerateRequestContext(r.Context(), "", "", WorkflowCoreWatch, LogLayerRESTFrontend)
+
+	writeError := func(err error, httpStatusCode int) {
+		w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+		writeHTTPResponse(ctx, w, &WatchEventsResponse{Error: err.Error()}, httpStatusCode)
+	}
+
+	since := r.URL.Query().Get("since")
+	if lastEventID := r.Header.Get("Last-Event-ID"); lastEventID != "" {
+		since = lastEventID
+	}
+	var sinceSequence uint64
+	if since != "" {
+		var err error
+		if sinceSequence, err = strconv.ParseUint(since, 10, 64); err != nil {
+			writeError(fmt.Errorf("invalid event sequence number %s", since), http.StatusBadRequest)
+			return
+		}
+	}
+
+	kinds := make(map[core.EventKind]bool)
+	for _, kindList := range r.URL.Query()["kind"] {
+		for _, kind := range strings.Split(kindList, ",") {
+			kinds[core.EventKind(strings.TrimSpace(kind))] = true
+		}
+	}
+
+	subscription, err := orchestrator.SubscribeEvents(ctx, sinceSequence)
+	if err != nil {
+		httpStatusCode := httpStatusCodeForGetUpdateList(err)
+		if errors.IsNotFoundError(err) {
+			httpStatusCode = http.StatusGone
+		}
+		writeError(err, httpStatusCode)
+		return
+	}
+	defer subscription.Close()
+
+	// The stream outlives the server's write timeout, so lift it for this response
+	responseController := http.NewResponseController(w)
+	if err = responseController.SetWriteDeadline(time.Time{}); err != nil {
+		Logc(ctx).WithError(err).Debug("Could not clear write deadline for event stream.")
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	_ = responseController.Flush()
+
+	keepalive := time.NewTicker(eventKeepaliveInterval)
+	defer keepalive.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+
+		case event, ok := <-subscription.Events:
+			if !ok {
+				// The subscriber fell behind, so end the stream; the client may resume from its last ID
+				return
+			}
+			if len(kinds) > 0 && !kinds[event.Kind] {
+				continue
+			}
+			if err = writeEvent(w, event); err != nil {
+				Logc(ctx).WithError(err).Debug("Could not write event, ending stream.")
+				return
+			}
+
+		case <-keepalive.C:
+			if _, err = io.WriteString(w, ": keepalive\n\n"); err != nil {
+				return
+			}
+		}
+		if err = responseController.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+func writeEvent(w io.Writer, event *core.Event) error {
+	response := &WatchEventResponse{
+		Sequence:  event.Sequence,
+		Type:      event.Type,
+		Kind:      event.Kind,
+		Name:      event.Name,
+		Timestamp: event.Timestamp,
+	}
+	if event.Object != nil {
+		object, err := json.Marshal(event.Object)
+		if err != nil {
+			return err
+		}
+		response.Object = object
+	}
+	data, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s.%s\ndata: %s\n\n", event.Sequence, event.Kind, event.Type, data)
+	return err
+}
@@ -17,10 +17,12 @@ import (
 	"github.com/stretchr/testify/assert"
 	http_test "github.com/stretchr/testify/http"
 
+	"github.com/netapp/trident/core"
 	"github.com/netapp/trident/frontend"
 	mockcore "github.com/netapp/trident/mocks/mock_core"
 	mockk8scontrollerhelper "github.com/netapp/trident/mocks/mock_frontend/mock_csi/mock_controller_helpers/mock_kubernetes_helper"
 	"github.com/netapp/trident/storage"
+	storageclass "github.com/netapp/trident/storage_class"
 	"github.com/netapp/trident/utils"
 	"github.com/netapp/trident/utils/errors"
 )
@@ -221,6 +223,61 @@ func TestUpdateVolume(t *testing.T) {
 	assert.Equal(t, http.StatusOK, w.StatusCode)
 }
 
+func TestVolumeMover(t *testing.T) {
+	volName := "test"
+	body := `{"backend": "backend2", "pool": "aggr2"}`
+	request := generateHTTPRequest(http.MethodPost, body)
+	writer := &http_test.TestResponseWriter{}
+	vol := &storage.VolumeExternal{Config: &storage.VolumeConfig{Name: volName}, Pool: "aggr1"}
+	movedVol := &storage.VolumeExternal{Config: &storage.VolumeConfig{Name: volName}, Pool: "aggr2"}
+
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+	orchestrator = mockOrchestrator
+
+	// Move in progress
+	mockOrchestrator.EXPECT().MoveVolume(gomock.Any(), volName, "backend2", "aggr2").
+		Return(nil, errors.InProgressError("replicating"))
+	mockOrchestrator.EXPECT().GetVolume(gomock.Any(), volName).Return(vol, nil)
+	response := &MoveVolumeResponse{}
+	responseCode := volumeMover(writer, request, response, map[string]string{"volume": volName}, []byte(body))
+	assert.Equal(t, http.StatusAccepted, responseCode)
+	assert.Contains(t, response.Status, "replicating")
+	assert.Equal(t, "aggr1", response.Volume.Pool)
+	assert.False(t, response.isError())
+
+	// Move complete
+	mockOrchestrator.EXPECT().MoveVolume(gomock.Any(), volName, "backend2", "aggr2").Return(movedVol, nil)
+	response = &MoveVolumeResponse{}
+	responseCode = volumeMover(writer, request, response, map[string]string{"volume": volName}, []byte(body))
+	assert.Equal(t, http.StatusOK, responseCode)
+	assert.Equal(t, "aggr2", response.Volume.Pool)
+
+	// Missing pool
+	response = &MoveVolumeResponse{}
+	responseCode = volumeMover(writer, request, response, map[string]string{"volume": volName},
+		[]byte(`{"backend": "backend2"}`))
+	assert.Equal(t, http.StatusBadRequest, responseCode)
+
+	// Orchestrator errors
+	tests := []struct {
+		err      error
+		expected int
+	}{
+		{errors.NotFoundError("volume %s not found", volName), http.StatusNotFound},
+		{errors.UnsupportedError("unsupported"), http.StatusForbidden},
+		{errors.VolumeStateError("moving elsewhere"), http.StatusConflict},
+		{errors.New("failed"), http.StatusInternalServerError},
+	}
+	for _, test := range tests {
+		mockOrchestrator.EXPECT().MoveVolume(gomock.Any(), volName, "backend2", "aggr2").Return(nil, test.err)
+		response = &MoveVolumeResponse{}
+		responseCode = volumeMover(writer, request, response, map[string]string{"volume": volName}, []byte(body))
+		assert.Equal(t, test.expected, responseCode)
+		assert.Equal(t, test.err.Error(), response.Error)
+	}
+}
+
 func TestVolumeUpdater_Success(t *testing.T) {
 	// Create request
 	body := `
@@ -532,3 +589,448 @@ func TestGetNode_FailsWithCoreError(t *testing.T) {
 	assert.Nil(t, updateNodeResponse.Node, "expected nil Node value in response")
 	assert.NotEmpty(t, updateNodeResponse.Error, "expected non-empty Error string in response")
 }
+
+func TestSnapshotPolicyRoutes(t *testing.T) {
+	// Set up mocks and tear down functions.
+	oldOrchestrator := orchestrator
+	defer func() {
+		orchestrator = oldOrchestrator
+	}()
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+
+	orchestrator = mockOrchestrator
+	server := httptest.NewServer(NewRouter(false))
+	defer server.Close()
+	url := server.URL + "/trident/v1/snapshotpolicy"
+	policyConfig := &storage.SnapshotPolicyConfig{Name: "hourly", Schedule: "@hourly", RetentionCount: 24}
+	policy := &storage.SnapshotPolicyExternal{
+		Config:      policyConfig,
+		NextRunTime: "2024-10-16T13:00:00Z",
+		Volumes:     map[string]*storage.SnapshotPolicyVolumeStatus{"vol1": {RetainedSnapshots: 3}},
+	}
+
+	doRequest := func(method, url, body string) (int, []byte) {
+		req, err := http.NewRequest(method, url, strings.NewReader(body))
+		assert.NoError(t, err, "expected no error")
+		res, err := http.DefaultClient.Do(req)
+		if !assert.NoError(t, err, "expected no error") {
+			t.FailNow()
+		}
+		defer res.Body.Close()
+		responseBody, err := io.ReadAll(res.Body)
+		assert.NoError(t, err, "expected no error")
+		return res.StatusCode, responseBody
+	}
+
+	// Add
+	mockOrchestrator.EXPECT().AddSnapshotPolicy(gomock.Any(), policyConfig).Return(policy, nil)
+	status, body := doRequest(http.MethodPost, url, `{"name":"hourly","schedule":"@hourly","retentionCount":24}`)
+	assert.Equal(t, http.StatusCreated, status)
+	addResponse := AddSnapshotPolicyResponse{}
+	assert.NoError(t, json.Unmarshal(body, &addResponse))
+	assert.Equal(t, "hourly", addResponse.SnapshotPolicyID)
+
+	mockOrchestrator.EXPECT().AddSnapshotPolicy(gomock.Any(), gomock.Any()).
+		Return(nil, errors.InvalidInputError("invalid schedule"))
+	status, _ = doRequest(http.MethodPost, url, `{"name":"hourly","schedule":"hourly","retentionCount":24}`)
+	assert.Equal(t, http.StatusBadRequest, status)
+
+	// Update, with the name taken from the URL
+	mockOrchestrator.EXPECT().UpdateSnapshotPolicy(gomock.Any(), policyConfig).Return(policy, nil)
+	status, _ = doRequest(http.MethodPut, url+"/hourly", `{"schedule":"@hourly","retentionCount":24}`)
+	assert.Equal(t, http.StatusOK, status)
+
+	status, _ = doRequest(http.MethodPut, url+"/hourly", `{"name":"daily","schedule":"@daily","retentionCount":7}`)
+	assert.Equal(t, http.StatusBadRequest, status)
+
+	// Get
+	mockOrchestrator.EXPECT().GetSnapshotPolicy(gomock.Any(), "hourly").Return(policy, nil)
+	status, body = doRequest(http.MethodGet, url+"/hourly", "")
+	assert.Equal(t, http.StatusOK, status)
+	getResponse := GetSnapshotPolicyResponse{}
+	assert.NoError(t, json.Unmarshal(body, &getResponse))
+	assert.Equal(t, policy, getResponse.SnapshotPolicy)
+
+	mockOrchestrator.EXPECT().GetSnapshotPolicy(gomock.Any(), "daily").
+		Return(nil, errors.NotFoundError("snapshot policy daily not found"))
+	status, _ = doRequest(http.MethodGet, url+"/daily", "")
+	assert.Equal(t, http.StatusNotFound, status)
+
+	// List
+	mockOrchestrator.EXPECT().ListSnapshotPolicies(gomock.Any()).
+		Return([]*storage.SnapshotPolicyExternal{policy}, nil)
+	status, body = doRequest(http.MethodGet, url, "")
+	assert.Equal(t, http.StatusOK, status)
+	listResponse := ListSnapshotPoliciesResponse{}
+	assert.NoError(t, json.Unmarshal(body, &listResponse))
+	assert.Equal(t, []string{"hourly"}, listResponse.SnapshotPolicies)
+
+	// Delete
+	mockOrchestrator.EXPECT().DeleteSnapshotPolicy(gomock.Any(), "hourly").Return(nil)
+	status, _ = doRequest(http.MethodDelete, url+"/hourly", "")
+	assert.Equal(t, http.StatusOK, status)
+}
+
+func TestOrphanRoutes(t *testing.T) {
+	// Set up mocks and tear down functions.
+	oldOrchestrator := orchestrator
+	defer func() {
+		orchestrator = oldOrchestrator
+	}()
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+
+	orchestrator = mockOrchestrator
+	server := httptest.NewServer(NewRouter(false))
+	defer server.Close()
+	url := server.URL + "/trident/v1/orphan"
+	report := storage.NewOrphanReport()
+	report.UntrackedVolumes = append(report.UntrackedVolumes, &storage.UntrackedVolume{
+		Name:         "stray",
+		InternalName: "trident_stray",
+		Backend:      "backend1",
+		Resolution:   storage.OrphanResolutionDryRun,
+	})
+
+	doRequest := func(method, url, body string) (int, []byte) {
+		req, err := http.NewRequest(method, url, strings.NewReader(body))
+		assert.NoError(t, err, "expected no error")
+		res, err := http.DefaultClient.Do(req)
+		if !assert.NoError(t, err, "expected no error") {
+			t.FailNow()
+		}
+		defer res.Body.Close()
+		responseBody, err := io.ReadAll(res.Body)
+		assert.NoError(t, err, "expected no error")
+		return res.StatusCode, responseBody
+	}
+
+	// Report
+	mockOrchestrator.EXPECT().GetOrphanReport(gomock.Any(), "backend1").Return(report, nil)
+	status, body := doRequest(http.MethodGet, url+"?backend=backend1", "")
+	assert.Equal(t, http.StatusOK, status)
+	getResponse := GetOrphanReportResponse{}
+	assert.NoError(t, json.Unmarshal(body, &getResponse))
+	assert.Equal(t, report.UntrackedVolumes, getResponse.Report.UntrackedVolumes)
+
+	mockOrchestrator.EXPECT().GetOrphanReport(gomock.Any(), "").Return(nil, errors.NotFoundError("not found"))
+	status, _ = doRequest(http.MethodGet, url, "")
+	assert.Equal(t, http.StatusNotFound, status)
+
+	// Resolve, as a dry run unless told otherwise
+	mockOrchestrator.EXPECT().ResolveOrphans(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *storage.ResolveOrphansRequest) (*storage.OrphanReport, error) {
+			assert.True(t, request.IsDryRun())
+			assert.Equal(t, []string{"trident_stray"}, request.Volumes)
+			return report, nil
+		})
+	status, body = doRequest(http.MethodPost, url, `{"action":"delete","volumes":["trident_stray"]}`)
+	assert.Equal(t, http.StatusOK, status)
+	resolveResponse := ResolveOrphansResponse{}
+	assert.NoError(t, json.Unmarshal(body, &resolveResponse))
+	assert.Equal(t, report.UntrackedVolumes, resolveResponse.Report.UntrackedVolumes)
+
+	mockOrchestrator.EXPECT().ResolveOrphans(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *storage.ResolveOrphansRequest) (*storage.OrphanReport, error) {
+			assert.False(t, request.IsDryRun())
+			return nil, errors.NotFoundError("volume trident_other is not an untracked volume")
+		})
+	status, _ = doRequest(http.MethodPost, url, `{"action":"delete","volumes":["trident_other"],"dryRun":false}`)
+	assert.Equal(t, http.StatusNotFound, status)
+
+	// An import needs a storage class
+	status, _ = doRequest(http.MethodPost, url, `{"action":"import"}`)
+	assert.Equal(t, http.StatusBadRequest, status)
+
+	status, _ = doRequest(http.MethodPost, url, `{"action":"rename"}`)
+	assert.Equal(t, http.StatusBadRequest, status)
+}
+
+func TestExplainRoutes(t *testing.T) {
+	// Set up mocks and tear down functions.
+	oldOrchestrator := orchestrator
+	defer func() {
+		orchestrator = oldOrchestrator
+	}()
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+
+	orchestrator = mockOrchestrator
+	server := httptest.NewServer(NewRouter(false))
+	defer server.Close()
+	placement := &storageclass.PlacementExplanation{
+		StorageClass: "gold",
+		Pools: []*storageclass.PoolPlacement{{
+			Backend: "backend1",
+			Pool:    "pool1",
+			Checks: []storageclass.PoolCheck{{
+				Check:     storageclass.PoolCheckAttribute,
+				Attribute: "media",
+				Reason:    "pool does not offer media",
+			}},
+		}},
+	}
+
+	doRequest := func(method, url, body string) (int, []byte) {
+		req, err := http.NewRequest(method, url, strings.NewReader(body))
+		assert.NoError(t, err, "expected no error")
+		res, err := http.DefaultClient.Do(req)
+		if !assert.NoError(t, err, "expected no error") {
+			t.FailNow()
+		}
+		defer res.Body.Close()
+		responseBody, err := io.ReadAll(res.Body)
+		assert.NoError(t, err, "expected no error")
+		return res.StatusCode, responseBody
+	}
+
+	// Storage class
+	mockOrchestrator.EXPECT().ExplainStorageClass(gomock.Any(), "gold").Return(placement, nil)
+	status, body := doRequest(http.MethodGet, server.URL+"/trident/v1/storageclass/gold/explain", "")
+	assert.Equal(t, http.StatusOK, status)
+	response := ExplainPlacementResponse{}
+	assert.NoError(t, json.Unmarshal(body, &response))
+	assert.Equal(t, placement, response.Placement)
+
+	mockOrchestrator.EXPECT().ExplainStorageClass(gomock.Any(), "silver").Return(nil,
+		errors.NotFoundError("not found"))
+	status, _ = doRequest(http.MethodGet, server.URL+"/trident/v1/storageclass/silver/explain", "")
+	assert.Equal(t, http.StatusNotFound, status)
+
+	// Volume
+	url := server.URL + "/trident/v1/volume/explain"
+	mockOrchestrator.EXPECT().ExplainVolumePlacement(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, volumeConfig *storage.VolumeConfig) (*storageclass.PlacementExplanation, error) {
+			assert.Equal(t, "vol1", volumeConfig.Name)
+			assert.Equal(t, "gold", volumeConfig.StorageClass)
+			return placement, nil
+		})
+	status, body = doRequest(http.MethodPost, url, `{"name":"vol1","size":"1Gi","storageClass":"gold"}`)
+	assert.Equal(t, http.StatusOK, status)
+	response = ExplainPlacementResponse{}
+	assert.NoError(t, json.Unmarshal(body, &response))
+	assert.Equal(t, placement, response.Placement)
+
+	status, _ = doRequest(http.MethodPost, url, `{"name":"vol1"}`)
+	assert.Equal(t, http.StatusBadRequest, status)
+}
+
+func TestWatchEvents(t *testing.T) {
+	// Set up mocks and tear down functions.
+	oldOrchestrator := orchestrator
+	defer func() {
+		orchestrator = oldOrchestrator
+	}()
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+
+	orchestrator = mockOrchestrator
+	server := httptest.NewServer(NewRouter(false))
+	defer server.Close()
+	url := server.URL + "/trident/v1/event"
+
+	doRequest := func(url, lastEventID string) (*http.Response, string) {
+		req, err := http.NewRequest(http.MethodGet, url, nil)
+		assert.NoError(t, err, "expected no error")
+		if lastEventID != "" {
+			req.Header.Set("Last-Event-ID", lastEventID)
+		}
+		res, err := http.DefaultClient.Do(req)
+		if !assert.NoError(t, err, "expected no error") {
+			t.FailNow()
+		}
+		defer res.Body.Close()
+		responseBody, err := io.ReadAll(res.Body)
+		assert.NoError(t, err, "expected no error")
+		return res, string(responseBody)
+	}
+
+	// subscription returns a subscription that delivers the specified events, then ends
+	subscription := func(events ...*core.Event) *core.EventSubscription {
+		eventChannel := make(chan *core.Event, len(events))
+		for _, event := range events {
+			eventChannel <- event
+		}
+		close(eventChannel)
+		return core.NewEventSubscription(eventChannel, func() {})
+	}
+	volume := &storage.VolumeExternal{Config: &storage.VolumeConfig{Name: "vol1"}}
+	events := []*core.Event{
+		{Sequence: 5, Type: core.EventAdded, Kind: core.EventKindBackend, Name: "backend1"},
+		{Sequence: 6, Type: core.EventAdded, Kind: core.EventKindVolume, Name: "vol1", Object: volume},
+		{Sequence: 7, Type: core.EventDeleted, Kind: core.EventKindNode, Name: "node1"},
+	}
+
+	// All events
+	mockOrchestrator.EXPECT().SubscribeEvents(gomock.Any(), uint64(0)).Return(subscription(events...), nil)
+	res, body := doRequest(url, "")
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+	assert.Equal(t, "text/event-stream", res.Header.Get("Content-Type"))
+	assert.Contains(t, body, "id: 5\nevent: backend.added\ndata: ")
+	assert.Contains(t, body, "id: 6\nevent: volume.added\ndata: ")
+	assert.Contains(t, body, "id: 7\nevent: node.deleted\ndata: ")
+
+	// Filtered by kind, resuming from the Last-Event-ID header
+	mockOrchestrator.EXPECT().SubscribeEvents(gomock.Any(), uint64(4)).Return(subscription(events...), nil)
+	_, body = doRequest(url+"?kind=volume&since=1", "4")
+	lines := strings.Split(strings.TrimSpace(body), "\n")
+	if assert.Len(t, lines, 3) {
+		assert.Equal(t, "id: 6", lines[0])
+		response := WatchEventResponse{}
+		assert.NoError(t, json.Unmarshal([]byte(strings.TrimPrefix(lines[2], "data: ")), &response))
+		assert.Equal(t, core.EventKindVolume, response.Kind)
+		assert.Equal(t, "vol1", response.Name)
+		returnedVolume := &storage.VolumeExternal{}
+		assert.NoError(t, json.Unmarshal(response.Object, returnedVolume))
+		assert.Equal(t, "vol1", returnedVolume.Config.Name)
+	}
+
+	mockOrchestrator.EXPECT().SubscribeEvents(gomock.Any(), uint64(2)).Return(subscription(events...), nil)
+	_, body = doRequest(url+"?kind=backend,node&kind=snapshot&since=2", "")
+	assert.Contains(t, body, "event: backend.added")
+	assert.Contains(t, body, "event: node.deleted")
+	assert.NotContains(t, body, "event: volume.added")
+
+	// Expired events
+	mockOrchestrator.EXPECT().SubscribeEvents(gomock.Any(), uint64(1)).Return(nil,
+		errors.NotFoundError("events after 1 are no longer available"))
+	res, body = doRequest(url+"?since=1", "")
+	assert.Equal(t, http.StatusGone, res.StatusCode)
+	assert.Contains(t, body, "no longer available")
+
+	mockOrchestrator.EXPECT().SubscribeEvents(gomock.Any(), gomock.Any()).Return(nil, errors.NotReadyError())
+	res, _ = doRequest(url, "")
+	assert.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+
+	// Invalid sequence number
+	res, _ = doRequest(url+"?since=abc", "")
+	assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+}
+
+func TestListRoutes_Pagination(t *testing.T) {
+	// Set up mocks and tear down functions.
+	oldOrchestrator := orchestrator
+	defer func() {
+		orchestrator = oldOrchestrator
+	}()
+	mockCtrl := gomock.NewController(t)
+	mockOrchestrator := mockcore.NewMockOrchestrator(mockCtrl)
+
+	orchestrator = mockOrchestrator
+	server := httptest.NewServer(NewRouter(false))
+	defer server.Close()
+
+	doRequest := func(url string, response interface{}) int {
+		res, err := http.Get(url)
+		if !assert.NoError(t, err, "expected no error") {
+			t.FailNow()
+		}
+		defer res.Body.Close()
+		assert.NoError(t, json.NewDecoder(res.Body).Decode(response))
+		return res.StatusCode
+	}
+
+	volume := func(name, backendUUID, storageClass, namespace string) *storage.VolumeExternal {
+		return &storage.VolumeExternal{
+			Config:      &storage.VolumeConfig{Name: name, StorageClass: storageClass, Namespace: namespace},
+			BackendUUID: backendUUID,
+			State:       storage.VolumeStateOnline,
+		}
+	}
+	volumes := []*storage.VolumeExternal{
+		volume("vol3", "uuid1", "gold", "ns1"),
+		volume("vol1", "uuid2", "silver", "ns2"),
+		volume("vol4", "uuid1", "silver", "ns1"),
+		volume("vol2", "uuid1", "gold", "ns2"),
+	}
+	backends := []*storage.BackendExternal{
+		{Name: "backend1", BackendUUID: "uuid1"},
+		{Name: "backend2", BackendUUID: "uuid2"},
+	}
+	url := server.URL + "/trident/v1/volume"
+
+	// Pages follow one another in name order
+	var names []string
+	continueToken := ""
+	for page := 0; page < 3; page++ {
+		mockOrchestrator.EXPECT().ListVolumes(gomock.Any()).Return(volumes, nil)
+		response := &ListVolumesResponse{}
+		status := doRequest(url+"?limit=3&continue="+continueToken, response)
+		assert.Equal(t, http.StatusOK, status)
+		names = append(names, response.Volumes...)
+		if continueToken = response.Continue; continueToken == "" {
+			break
+		}
+	}
+	assert.Equal(t, []string{"vol1", "vol2", "vol3", "vol4"}, names)
+
+	// Filters, with backends matched by name or UUID, and descending sort
+	mockOrchestrator.EXPECT().ListVolumes(gomock.Any()).Return(volumes, nil)
+	mockOrchestrator.EXPECT().ListBackends(gomock.Any()).Return(backends, nil)
+	response := &ListVolumesResponse{}
+	doRequest(url+"?backend=backend1&storageClass=gold&sort=-name", response)
+	assert.Equal(t, []string{"vol3", "vol2"}, response.Volumes)
+	assert.Empty(t, response.Continue)
+
+	mockOrchestrator.EXPECT().ListVolumes(gomock.Any()).Return(volumes, nil)
+	mockOrchestrator.EXPECT().ListBackends(gomock.Any()).Return(backends, nil)
+	response = &ListVolumesResponse{}
+	doRequest(url+"?backend=uuid1&namespace=ns1", response)
+	assert.Equal(t, []string{"vol3", "vol4"}, response.Volumes)
+
+	// Sorted by another field, then by name
+	mockOrchestrator.EXPECT().ListVolumes(gomock.Any()).Return(volumes, nil)
+	response = &ListVolumesResponse{}
+	doRequest(url+"?sort=storageClass&limit=2", response)
+	assert.Equal(t, []string{"vol2", "vol3"}, response.Volumes)
+	mockOrchestrator.EXPECT().ListVolumes(gomock.Any()).Return(volumes, nil)
+	token := response.Continue
+	response = &ListVolumesResponse{}
+	doRequest(url+"?sort=storageClass&limit=2&continue="+token, response)
+	assert.Equal(t, []string{"vol1", "vol4"}, response.Volumes)
+
+	// Volumes published to a node
+	mockOrchestrator.EXPECT().ListVolumes(gomock.Any()).Return(volumes, nil)
+	mockOrchestrator.EXPECT().ListVolumePublications(gomock.Any()).Return([]*utils.VolumePublicationExternal{
+		{Name: "vol4.node1", VolumeName: "vol4", NodeName: "node1"},
+		{Name: "vol1.node2", VolumeName: "vol1", NodeName: "node2"},
+	}, nil)
+	response = &ListVolumesResponse{}
+	doRequest(url+"?node=node1", response)
+	assert.Equal(t, []string{"vol4"}, response.Volumes)
+
+	// Invalid parameters are rejected before listing
+	for _, query := range []string{
+		"limit=0", "limit=abc", "sort=size", "label=a=b", "continue=abc",
+		"sort=-name&continue=" + token,
+	} {
+		response = &ListVolumesResponse{}
+		status := doRequest(url+"?"+query, response)
+		assert.Equal(t, http.StatusBadRequest, status, query)
+		assert.NotEmpty(t, response.Error, query)
+	}
+
+	// Nodes by label
+	mockOrchestrator.EXPECT().ListNodes(gomock.Any()).Return([]*utils.NodeExternal{
+		{Name: "node1", TopologyLabels: map[string]string{"zone": "a", "rack": "1"}},
+		{Name: "node2", TopologyLabels: map[string]string{"zone": "b"}},
+		{Name: "node3", TopologyLabels: map[string]string{"zone": "a"}},
+	}, nil)
+	nodesResponse := &ListNodesResponse{}
+	doRequest(server.URL+"/trident/v1/node?label=zone=a,rack", nodesResponse)
+	assert.Equal(t, []string{"node1"}, nodesResponse.Nodes)
+
+	// Publications for a node, paginated
+	mockOrchestrator.EXPECT().ListVolumePublications(gomock.Any()).Return([]*utils.VolumePublicationExternal{
+		{Name: "vol2.node1", VolumeName: "vol2", NodeName: "node1"},
+		{Name: "vol1.node2", VolumeName: "vol1", NodeName: "node2"},
+		{Name: "vol1.node1", VolumeName: "vol1", NodeName: "node1"},
+	}, nil)
+	publicationsResponse := &VolumePublicationsResponse{}
+	doRequest(server.URL+"/trident/v1/publication?node=node1&limit=1", publicationsResponse)
+	if assert.Len(t, publicationsResponse.VolumePublications, 1) {
+		assert.Equal(t, "vol1.node1", publicationsResponse.VolumePublications[0].Name)
+	}
+	assert.NotEmpty(t, publicationsResponse.Continue)
+}
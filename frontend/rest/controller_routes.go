@@ -130,6 +130,27 @@ var controllerRoutes = Routes{
 		nil,
 		UpdateVolume,
 	},
+	Route{
+		"MoveVolume",
+		"POST",
+		config.VolumeURL + "/{volume}/move",
+		nil,
+		MoveVolume,
+	},
+	Route{
+		"GetOrphanReport",
+		"GET",
+		config.OrphanURL,
+		nil,
+		GetOrphanReport,
+	},
+	Route{
+		"ResolveOrphans",
+		"POST",
+		config.OrphanURL,
+		nil,
+		ResolveOrphans,
+	},
 	Route{
 		"ImportVolume",
 		"POST",
@@ -137,6 +158,13 @@ var controllerRoutes = Routes{
 		nil,
 		ImportVolume,
 	},
+	Route{
+		"ExplainVolumePlacement",
+		"POST",
+		config.VolumeURL + "/explain",
+		nil,
+		ExplainVolumePlacement,
+	},
 	Route{
 		"AddStorageClass",
 		"POST",
@@ -151,6 +179,13 @@ var controllerRoutes = Routes{
 		nil,
 		GetStorageClass,
 	},
+	Route{
+		"ExplainStorageClass",
+		"GET",
+		config.StorageClassURL + "/{storageClass}/explain",
+		nil,
+		ExplainStorageClass,
+	},
 	Route{
 		"ListStorageClasses",
 		"GET",
@@ -165,6 +200,41 @@ var controllerRoutes = Routes{
 		nil,
 		DeleteStorageClass,
 	},
+	Route{
+		"AddSnapshotPolicy",
+		"POST",
+		config.SnapshotPolicyURL,
+		nil,
+		AddSnapshotPolicy,
+	},
+	Route{
+		"UpdateSnapshotPolicy",
+		"PUT",
+		config.SnapshotPolicyURL + "/{snapshotPolicy}",
+		nil,
+		UpdateSnapshotPolicy,
+	},
+	Route{
+		"GetSnapshotPolicy",
+		"GET",
+		config.SnapshotPolicyURL + "/{snapshotPolicy}",
+		nil,
+		GetSnapshotPolicy,
+	},
+	Route{
+		"ListSnapshotPolicies",
+		"GET",
+		config.SnapshotPolicyURL,
+		nil,
+		ListSnapshotPolicies,
+	},
+	Route{
+		"DeleteSnapshotPolicy",
+		"DELETE",
+		config.SnapshotPolicyURL + "/{snapshotPolicy}",
+		nil,
+		DeleteSnapshotPolicy,
+	},
 	Route{
 		"AddOrUpdateNode",
 		"PUT",
@@ -269,6 +339,13 @@ var controllerRoutes = Routes{
 		nil,
 		DeleteSnapshot,
 	},
+	Route{
+		"WatchEvents",
+		"GET",
+		config.EventURL,
+		nil,
+		WatchEvents,
+	},
 	Route{
 		"GetCHAP",
 		"GET",
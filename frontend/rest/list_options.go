@@ -0,0 +1,227 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package rest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/netapp/trident/utils/errors"
+)
+
+// Query parameters accepted by list routes
+const (
+	listLimitParam    = "limit"
+	listContinueParam = "continue"
+	listSortParam     = "sort"
+
+	filterBackend      = "backend"
+	filterStorageClass = "storageClass"
+	filterState        = "state"
+	filterNamespace    = "namespace"
+	filterLabel        = "label"
+	filterNode         = "node"
+
+	sortByName = "name"
+)
+
+// listOptions holds the pagination, filtering and sorting parameters of a list request.  Objects are
+// filtered, then sorted by the sort field and by name, and a page of up to limit objects is returned
+// along with a continue token from which the next page follows.
+type listOptions struct {
+	limit      int
+	after      *continueToken
+	sortBy     string
+	descending bool
+	filters    map[string]string
+	// labels maps each label a listed object must have to its required value, or to "" for any value
+	labels map[string]string
+}
+
+// continueToken records the position of the last object on a page.  It is opaque to clients.
+type continueToken struct {
+	Sort string `json:"s"`
+	Key  string `json:"k"`
+	Name string `json:"n"`
+}
+
+// listItem describes a listed object by the fields it may be filtered and sorted by.  A field may have
+// several values, such as the nodes a volume is published to, in which case a filter matches any of them
+// and sorting uses the first.
+type listItem struct {
+	name   string
+	fields map[string][]string
+	labels map[string]string
+}
+
+// parseListOptions reads the list parameters of a request, accepting only the filters supported by its
+// route.
+func parseListOptions(r *http.Request, supportedFilters ...string) (*listOptions, error) {
+	query := r.URL.Query()
+	options := &listOptions{
+		sortBy:  sortByName,
+		filters: make(map[string]string),
+		labels:  make(map[string]string),
+	}
+
+	if limit := query.Get(listLimitParam); limit != "" {
+		var err error
+		if options.limit, err = strconv.Atoi(limit); err != nil || options.limit < 1 {
+			return nil, errors.InvalidInputError(fmt.Sprintf("invalid limit %s; must be a positive integer",
+				limit))
+		}
+	}
+
+	supported := map[string]bool{sortByName: true}
+	for _, filter := range supportedFilters {
+		supported[filter] = true
+	}
+
+	if sortBy := query.Get(listSortParam); sortBy != "" {
+		options.descending = strings.HasPrefix(sortBy, "-")
+		options.sortBy = strings.TrimPrefix(sortBy, "-")
+		if !supported[options.sortBy] || options.sortBy == filterLabel {
+			return nil, errors.InvalidInputError(fmt.Sprintf("cannot sort by %s", options.sortBy))
+		}
+	}
+
+	for _, filter := range []string{filterBackend, filterStorageClass, filterState, filterNamespace, filterNode} {
+		if !query.Has(filter) {
+			continue
+		} else if !supported[filter] {
+			return nil, errors.InvalidInputError(fmt.Sprintf("cannot filter by %s here", filter))
+		}
+		options.filters[filter] = query.Get(filter)
+	}
+
+	if query.Has(filterLabel) {
+		if !supported[filterLabel] {
+			return nil, errors.InvalidInputError(fmt.Sprintf("cannot filter by %s here", filterLabel))
+		}
+		for _, labelList := range query[filterLabel] {
+			for _, label := range strings.Split(labelList, ",") {
+				key, value, _ := strings.Cut(strings.TrimSpace(label), "=")
+				if key == "" {
+					return nil, errors.InvalidInputError(fmt.Sprintf("invalid label filter %s", labelList))
+				}
+				options.labels[key] = value
+			}
+		}
+	}
+
+	if token := query.Get(listContinueParam); token != "" {
+		tokenBytes, err := base64.RawURLEncoding.DecodeString(token)
+		options.after = &continueToken{}
+		if err != nil || json.Unmarshal(tokenBytes, options.after) != nil {
+			return nil, errors.InvalidInputError("invalid continue token")
+		}
+		if options.after.Sort != options.sortParam() {
+			return nil, errors.InvalidInputError(fmt.Sprintf("continue token does not match sort %s",
+				options.sortParam()))
+		}
+	}
+
+	return options, nil
+}
+
+// sortParam returns the sort query parameter equivalent to these options.
+func (o *listOptions) sortParam() string {
+	if o.descending {
+		return "-" + o.sortBy
+	}
+	return o.sortBy
+}
+
+// uses reports whether the specified field is needed to filter or sort, so that list routes may skip
+// looking up fields that are costly to find.
+func (o *listOptions) uses(field string) bool {
+	_, filtered := o.filters[field]
+	return filtered || o.sortBy == field || (field == filterLabel && len(o.labels) > 0)
+}
+
+func (o *listOptions) matches(item *listItem) bool {
+	for field, value := range o.filters {
+		found := false
+		for _, itemValue := range item.fields[field] {
+			if itemValue == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	for key, value := range o.labels {
+		if itemValue, ok := item.labels[key]; !ok || (value != "" && itemValue != value) {
+			return false
+		}
+	}
+	return true
+}
+
+func (o *listOptions) sortKey(item *listItem) string {
+	if o.sortBy == sortByName {
+		return item.name
+	} else if values := item.fields[o.sortBy]; len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// less orders objects by sort key, then by name, in the requested direction.
+func (o *listOptions) less(keyA, nameA, keyB, nameB string) bool {
+	if o.descending {
+		keyA, nameA, keyB, nameB = keyB, nameB, keyA, nameA
+	}
+	if keyA != keyB {
+		return keyA < keyB
+	}
+	return nameA < nameB
+}
+
+// paginate returns the page of objects selected by the list options, and the continue token for the next
+// page, if there is one.
+func paginate[T any](o *listOptions, objects []T, describe func(T) *listItem) ([]T, string) {
+	type sortable struct {
+		object T
+		key    string
+		name   string
+	}
+
+	selected := make([]sortable, 0, len(objects))
+	for _, object := range objects {
+		item := describe(object)
+		if !o.matches(item) {
+			continue
+		}
+		key := o.sortKey(item)
+		if o.after != nil && !o.less(o.after.Key, o.after.Name, key, item.name) {
+			continue
+		}
+		selected = append(selected, sortable{object: object, key: key, name: item.name})
+	}
+
+	sort.Slice(selected, func(i, j int) bool {
+		return o.less(selected[i].key, selected[i].name, selected[j].key, selected[j].name)
+	})
+
+	token := ""
+	if o.limit > 0 && len(selected) > o.limit {
+		selected = selected[:o.limit]
+		last := selected[o.limit-1]
+		tokenBytes, _ := json.Marshal(&continueToken{Sort: o.sortParam(), Key: last.key, Name: last.name})
+		token = base64.RawURLEncoding.EncodeToString(tokenBytes)
+	}
+
+	page := make([]T, 0, len(selected))
+	for _, s := range selected {
+		page = append(page, s.object)
+	}
+	return page, token
+}
@@ -7,7 +7,11 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/gorilla/mux"
+	"go.opentelemetry.io/otel/attribute"
+
 	. "github.com/netapp/trident/logging"
+	"github.com/netapp/trident/utils/tracing"
 )
 
 type loggingResponseWriter struct {
@@ -26,6 +30,11 @@ func (lrw *loggingResponseWriter) WriteHeader(code int) {
 	lrw.ResponseWriter.WriteHeader(code)
 }
 
+// Unwrap lets http.ResponseController reach the underlying writer, so streaming handlers may flush.
+func (lrw *loggingResponseWriter) Unwrap() http.ResponseWriter {
+	return lrw.ResponseWriter
+}
+
 func Logger(inner http.Handler, routeName string) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now()
@@ -38,8 +47,11 @@ func Logger(inner http.Handler, routeName string) http.Handler {
 		r = r.WithContext(ctx)
 		logRestCallInfo("REST API call received.", r, start, routeName, "")
 
+		r, span := tracing.StartHTTPServerSpan(r, routeName, restSpanAttributes(mux.Vars(r))...)
+
 		lrw := NewLoggingResponseWriter(w)
 		inner.ServeHTTP(lrw, r)
+		tracing.EndHTTPServerSpan(span, lrw.statusCode)
 
 		statusCode := strconv.Itoa(lrw.statusCode)
 		restOpsTotal.WithLabelValues(r.Method, routeName, statusCode).Inc()
@@ -50,6 +62,27 @@ func Logger(inner http.Handler, routeName string) http.Handler {
 	})
 }
 
+// restSpanAttributes returns the names of the things a REST call is about, for tracing.
+func restSpanAttributes(vars map[string]string) []attribute.KeyValue {
+	var attributes []attribute.KeyValue
+	if volume, ok := vars["volume"]; ok {
+		attributes = append(attributes, tracing.Volume(volume))
+	}
+	if snapshot, ok := vars["snapshot"]; ok {
+		attributes = append(attributes, tracing.Snapshot(snapshot))
+	}
+	if backend, ok := vars["backend"]; ok {
+		attributes = append(attributes, tracing.Backend(backend))
+	}
+	if node, ok := vars["node"]; ok {
+		attributes = append(attributes, tracing.Node(node))
+	}
+	if storageClass, ok := vars["storageClass"]; ok {
+		attributes = append(attributes, tracing.StorageClass(storageClass))
+	}
+	return attributes
+}
+
 func logRestCallInfo(msg string, r *http.Request, start time.Time, name, statusCode string) {
 	subjects := []string{}
 	if r.TLS != nil {
@@ -72,6 +105,9 @@ func logRestCallInfo(msg string, r *http.Request, start time.Time, name, statusC
 	Logc(r.Context()).WithFields(logFields).Debug(msg)
 
 	logFields["ClientCertSubjects"] = subjects
+	if identity := IdentityFromContext(r.Context()); identity != nil {
+		logFields["User"] = identity.Name
+	}
 	logFields["SourceIP"] = r.RemoteAddr
 	logFields["Referer"] = r.Referer()
 	logFields["UserAgent"] = r.UserAgent()
@@ -37,6 +37,9 @@ func newRouter(routes Routes, https bool) *mux.Router {
 			handler = secureheader.Handler(handler)
 		}
 
+		// Apply authorization middleware, which checks callers identified by the server
+		handler = authorizeMiddleware(route)(handler)
+
 		// Apply logging middleware
 		handler = Logger(handler, route.Name)
 
@@ -1,43 +1,138 @@
 package logging
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
 )
 
-const auditKey = "audit"
+const (
+	auditKey = "audit"
 
-var auditor AuditLogger
+	// AuditRedacted replaces the values of audited fields that the redactor matches
+	AuditRedacted = "<REDACTED>"
+	// AuditSystemActor is the actor of changes that no authenticated caller asked for
+	AuditSystemActor = "trident"
+)
+
+// auditor is disabled until InitAuditLogger is called
+var auditor = newAuditLogger(true)
 
 type auditLogger struct {
 	enabled bool
+	sinks   []AuditSink
+
+	mutex        sync.Mutex
+	sequence     uint64
+	previousHash string
 }
 
-func InitAuditLogger(disabled bool) {
-	auditor = newAuditLogger(disabled)
+// AuditOutcome says whether an audited operation succeeded.
+type AuditOutcome string
+
+const (
+	AuditSuccess = AuditOutcome("success")
+	AuditFailure = AuditOutcome("failure")
+)
+
+// AuditRecord is a structured audit record.  Records are chained together, each holding the hash of the record
+// before it, so that removing or altering a record written to a sink may be detected; see VerifyAuditTrail.
+// Before and After summarize an object changed by the orchestrator, holding only the fields that changed, and
+// Fields holds the details of an access event.
+type AuditRecord struct {
+	Sequence     uint64                 `json:"sequence"`
+	Time         time.Time              `json:"time"`
+	Event        AuditEvent             `json:"event"`
+	Actor        string                 `json:"actor"`
+	Source       string                 `json:"source,omitempty"`
+	RequestID    string                 `json:"requestID,omitempty"`
+	Workflow     string                 `json:"workflow,omitempty"`
+	Operation    string                 `json:"operation"`
+	ObjectKind   string                 `json:"objectKind,omitempty"`
+	ObjectName   string                 `json:"objectName,omitempty"`
+	Before       map[string]interface{} `json:"before,omitempty"`
+	After        map[string]interface{} `json:"after,omitempty"`
+	Fields       map[string]interface{} `json:"fields,omitempty"`
+	Outcome      AuditOutcome           `json:"outcome,omitempty"`
+	Error        string                 `json:"error,omitempty"`
+	PreviousHash string                 `json:"previousHash"`
+	Hash         string                 `json:"hash"`
+}
+
+// InitAuditLogger sets up the audit logger, which writes to the normal log stream unless disabled, and writes
+// structured records to any sinks specified.
+func InitAuditLogger(disabled bool, sinks ...AuditSink) {
+	auditor = newAuditLogger(disabled, sinks...)
+}
+
+// CloseAuditLogger flushes and closes the audit sinks.
+func CloseAuditLogger() {
+	if a, ok := auditor.(*auditLogger); ok {
+		a.close()
+	}
 }
 
 func Audit() AuditLogger {
 	return auditor
 }
 
-func newAuditLogger(disabled bool) AuditLogger {
+func newAuditLogger(disabled bool, sinks ...AuditSink) AuditLogger {
 	logr := &auditLogger{}
 	logr.enabled = !disabled
+	logr.sinks = sinks
+
+	// Continue the chain of records left by an earlier run, if a sink has kept it
+	for _, sink := range sinks {
+		if trail, ok := sink.(auditTrail); ok {
+			if last := trail.lastRecord(); last != nil {
+				logr.sequence = last.Sequence
+				logr.previousHash = last.Hash
+				break
+			}
+		}
+	}
 
 	return logr
 }
 
+// ContextWithAuditActor returns a context naming the authenticated caller on whose behalf work is done.
+func ContextWithAuditActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, ContextKeyAuditActor, actor)
+}
+
+// AuditActor returns the caller named by a context, or the system actor if there is none.
+func AuditActor(ctx context.Context) string {
+	if actor, ok := ctx.Value(ContextKeyAuditActor).(string); ok && actor != "" {
+		return actor
+	}
+	return AuditSystemActor
+}
+
+func (a *auditLogger) Enabled() bool {
+	return a.enabled || len(a.sinks) > 0
+}
+
 func (a *auditLogger) Log(ctx context.Context, event AuditEvent, fields LogFields, message string) {
 	if a.enabled {
 		ctx = context.WithValue(ctx, auditKey, event)
 		Logc(ctx).WithFields(fields).Info(message)
 	}
+	a.writeAccess(ctx, event, fields, message)
 }
 
 func (a *auditLogger) Logln(ctx context.Context, event AuditEvent, fields LogFields, message string) {
 	if a.enabled {
 		ctx = context.WithValue(ctx, auditKey, event)
 		a.Log(ctx, event, fields, message+"\n")
+	} else {
+		a.writeAccess(ctx, event, fields, message)
 	}
 }
 
@@ -46,4 +141,177 @@ func (a *auditLogger) Logf(ctx context.Context, event AuditEvent, fields LogFiel
 		ctx = context.WithValue(ctx, auditKey, event)
 		Logc(ctx).WithFields(fields).Infof(format, args...)
 	}
+	a.writeAccess(ctx, event, fields, fmt.Sprintf(format, args...))
+}
+
+// Record writes a structured audit record, filling in the caller and request details from the context.
+func (a *auditLogger) Record(ctx context.Context, record *AuditRecord) {
+	if !a.Enabled() {
+		return
+	}
+
+	if a.enabled {
+		fields := LogFields{
+			"actor":      AuditActor(ctx),
+			"operation":  record.Operation,
+			"objectKind": record.ObjectKind,
+			"objectName": record.ObjectName,
+			"outcome":    record.Outcome,
+		}
+		if record.Error != "" {
+			fields["error"] = record.Error
+		}
+		Logc(context.WithValue(ctx, auditKey, record.Event)).WithFields(fields).Info("Orchestrator state changed.")
+	}
+
+	a.write(ctx, record)
+}
+
+// writeAccess sends an access event to the sinks.
+func (a *auditLogger) writeAccess(ctx context.Context, event AuditEvent, fields LogFields, message string) {
+	if len(a.sinks) == 0 {
+		return
+	}
+	a.write(ctx, &AuditRecord{
+		Event:     event,
+		Operation: strings.TrimSpace(message),
+		Fields:    redactAuditFields(flattenAuditObject(map[string]interface{}(fields))),
+	})
+}
+
+// write completes a record, chains it to the one before, and writes it to every sink.
+func (a *auditLogger) write(ctx context.Context, record *AuditRecord) {
+	if len(a.sinks) == 0 {
+		return
+	}
+
+	record.Time = time.Now().UTC()
+	record.Actor = AuditActor(ctx)
+	if source, ok := ctx.Value(ContextKeyRequestSource).(string); ok {
+		record.Source = source
+	}
+	if requestID := ctx.Value(ContextKeyRequestID); requestID != nil {
+		record.RequestID = fmt.Sprint(requestID)
+	}
+	if workflow, ok := ctx.Value(ContextKeyWorkflow).(Workflow); ok && workflow != WorkflowNone {
+		record.Workflow = workflow.String()
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.sequence++
+	record.Sequence = a.sequence
+	record.PreviousHash = a.previousHash
+	record.Hash = ""
+
+	line, err := hashAuditRecord(record)
+	if err != nil {
+		Logc(ctx).WithError(err).Error("Could not write audit record.")
+		a.sequence--
+		return
+	}
+	a.previousHash = record.Hash
+
+	for _, sink := range a.sinks {
+		if err = sink.Write(line); err != nil {
+			Logc(ctx).WithError(err).WithField("sink", sink.Name()).Error("Could not write audit record.")
+		}
+	}
+}
+
+func (a *auditLogger) close() {
+	for _, sink := range a.sinks {
+		if err := sink.Close(); err != nil {
+			Log().WithError(err).WithField("sink", sink.Name()).Error("Could not close audit sink.")
+		}
+	}
+}
+
+// marshalAuditRecord returns the JSON form of a record, without escaping HTML characters such as those of
+// redacted values.
+func marshalAuditRecord(record interface{}) ([]byte, error) {
+	buffer := &bytes.Buffer{}
+	encoder := json.NewEncoder(buffer)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(record); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buffer.Bytes(), []byte("\n")), nil
+}
+
+// hashAuditRecord sets the hash of a record, which covers every other field including the hash of the record
+// before it, and returns the record's JSON form.
+func hashAuditRecord(record *AuditRecord) ([]byte, error) {
+	unhashed, err := marshalAuditRecord(record)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(unhashed)
+	record.Hash = hex.EncodeToString(sum[:])
+	return marshalAuditRecord(record)
+}
+
+// AuditChanges summarizes a change to an object for an audit record.  The objects, either of which may be nil
+// for additions and deletions, are flattened into fields named by their JSON paths, such as "config.size".
+// For updates, only the fields that differ are kept.  Field values matched by the redactor are replaced.
+func AuditChanges(before, after interface{}) (map[string]interface{}, map[string]interface{}) {
+	beforeFields := flattenAuditObject(before)
+	afterFields := flattenAuditObject(after)
+
+	if beforeFields != nil && afterFields != nil {
+		for key, value := range beforeFields {
+			if afterValue, ok := afterFields[key]; ok && reflect.DeepEqual(value, afterValue) {
+				delete(beforeFields, key)
+				delete(afterFields, key)
+			}
+		}
+	}
+
+	return redactAuditFields(beforeFields), redactAuditFields(afterFields)
+}
+
+// flattenAuditObject returns the leaf fields of an object's JSON form, named by their paths.
+func flattenAuditObject(object interface{}) map[string]interface{} {
+	if object == nil || (reflect.ValueOf(object).Kind() == reflect.Ptr && reflect.ValueOf(object).IsNil()) {
+		return nil
+	}
+
+	objectJSON, err := json.Marshal(object)
+	if err != nil {
+		return map[string]interface{}{"error": err.Error()}
+	}
+	var value interface{}
+	if err = json.Unmarshal(objectJSON, &value); err != nil {
+		return map[string]interface{}{"error": err.Error()}
+	}
+
+	fields := make(map[string]interface{})
+	var flatten func(prefix string, value interface{})
+	flatten = func(prefix string, value interface{}) {
+		if object, ok := value.(map[string]interface{}); ok && (len(object) > 0 || prefix == "") {
+			for key, field := range object {
+				if prefix != "" {
+					key = prefix + "." + key
+				}
+				flatten(key, field)
+			}
+			return
+		}
+		fields[prefix] = value
+	}
+	flatten("", value)
+	return fields
+}
+
+// redactAuditFields replaces the value of each field that the redactor would redact from a log line.
+func redactAuditFields(fields map[string]interface{}) map[string]interface{} {
+	for key, value := range fields {
+		name := key[strings.LastIndex(key, ".")+1:]
+		line, err := json.Marshal(map[string]interface{}{name: value})
+		if err != nil || !bytes.Equal(redactAllPatterns(line), line) {
+			fields[key] = AuditRedacted
+		}
+	}
+	return fields
 }
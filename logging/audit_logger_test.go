@@ -3,8 +3,15 @@ package logging
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 
 	log "github.com/sirupsen/logrus"
@@ -38,3 +45,234 @@ func captureOutput(t *testing.T, f func()) string {
 	f()
 	return buf.String()
 }
+
+// testAuditSink keeps the audit records written to it.
+type testAuditSink struct {
+	records []string
+	closed  bool
+}
+
+func (s *testAuditSink) Name() string { return "test" }
+
+func (s *testAuditSink) Write(record []byte) error {
+	s.records = append(s.records, string(record))
+	return nil
+}
+
+func (s *testAuditSink) Close() error {
+	s.closed = true
+	return nil
+}
+
+func TestAuditLoggerRecord(t *testing.T) {
+	sink := &testAuditSink{}
+	InitAuditLogger(true, sink)
+	defer InitAuditLogger(true)
+
+	ctx := GenerateRequestContext(context.Background(), "req1", ContextSourceREST, WorkflowVolumeDelete,
+		LogLayerCore)
+	ctx = ContextWithAuditActor(ctx, "alice")
+	before, after := AuditChanges(map[string]interface{}{"name": "vol1", "size": "1Gi"}, nil)
+	Audit().Record(ctx, &AuditRecord{
+		Event:      AuditStateChange,
+		Operation:  "deleted",
+		ObjectKind: "volume",
+		ObjectName: "vol1",
+		Before:     before,
+		After:      after,
+		Outcome:    AuditSuccess,
+	})
+	Audit().Logf(context.Background(), AuditRESTAccess, LogFields{"method": "GET"}, "REST API call %s.", "received")
+
+	assert.True(t, Audit().Enabled(), "Expected sinks to enable the audit logger.")
+	assert.Len(t, sink.records, 2)
+
+	record := &AuditRecord{}
+	assert.NoError(t, json.Unmarshal([]byte(sink.records[0]), record))
+	assert.Equal(t, uint64(1), record.Sequence)
+	assert.Equal(t, "alice", record.Actor)
+	assert.Equal(t, ContextSourceREST, record.Source)
+	assert.Equal(t, "req1", record.RequestID)
+	assert.Equal(t, WorkflowVolumeDelete.String(), record.Workflow)
+	assert.Equal(t, map[string]interface{}{"name": "vol1", "size": "1Gi"}, record.Before)
+	assert.Empty(t, record.PreviousHash)
+
+	access := &AuditRecord{}
+	assert.NoError(t, json.Unmarshal([]byte(sink.records[1]), access))
+	assert.Equal(t, AuditSystemActor, access.Actor)
+	assert.Equal(t, "REST API call received.", access.Operation)
+	assert.Equal(t, map[string]interface{}{"method": "GET"}, access.Fields)
+	assert.Equal(t, record.Hash, access.PreviousHash)
+
+	CloseAuditLogger()
+	assert.True(t, sink.closed, "Expected the sink to be closed.")
+}
+
+func TestAuditChanges(t *testing.T) {
+	type config struct {
+		Name                 string `json:"name"`
+		Size                 string `json:"size"`
+		IscsiInitiatorSecret string `json:"iscsiInitiatorSecret,omitempty"`
+	}
+	type volume struct {
+		Config config `json:"config"`
+		State  string `json:"state"`
+	}
+
+	before, after := AuditChanges(
+		&volume{Config: config{Name: "vol1", Size: "1Gi", IscsiInitiatorSecret: "secret1"}, State: "online"},
+		&volume{Config: config{Name: "vol1", Size: "2Gi", IscsiInitiatorSecret: "secret2"}, State: "online"},
+	)
+	assert.Equal(t, map[string]interface{}{"config.size": "1Gi", "config.iscsiInitiatorSecret": AuditRedacted}, before)
+	assert.Equal(t, map[string]interface{}{"config.size": "2Gi", "config.iscsiInitiatorSecret": AuditRedacted}, after)
+
+	var deleted *volume
+	before, after = AuditChanges(&volume{Config: config{Name: "vol1"}, State: "deleting"}, deleted)
+	assert.Equal(t, map[string]interface{}{"config.name": "vol1", "config.size": "", "state": "deleting"}, before)
+	assert.Nil(t, after)
+}
+
+func TestVerifyAuditTrail(t *testing.T) {
+	sink := &testAuditSink{}
+	InitAuditLogger(true, sink)
+	defer InitAuditLogger(true)
+
+	for _, name := range []string{"vol1", "vol2", "vol3"} {
+		before, _ := AuditChanges(map[string]interface{}{"size": 1073741824, "name": name}, nil)
+		Audit().Record(context.Background(), &AuditRecord{
+			Event: AuditStateChange, Operation: "deleted", ObjectName: name, Before: before,
+		})
+	}
+
+	count, err := VerifyAuditTrail(strings.NewReader(strings.Join(sink.records, "\n")))
+	assert.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	// A trail may be checked from any record onwards
+	count, err = VerifyAuditTrail(strings.NewReader(strings.Join(sink.records[1:], "\n")))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	altered := strings.Replace(sink.records[1], "vol2", "vol4", 1)
+	_, err = VerifyAuditTrail(strings.NewReader(strings.Join([]string{sink.records[0], altered}, "\n")))
+	assert.ErrorContains(t, err, "altered")
+
+	_, err = VerifyAuditTrail(strings.NewReader(strings.Join([]string{sink.records[0], sink.records[2]}, "\n")))
+	assert.ErrorContains(t, err, "missing")
+}
+
+func TestAuditFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	sink, err := newAuditFileSink(&AuditFileConfig{Path: path, MaxBackups: 2})
+	assert.NoError(t, err)
+	sink.maxSize = 1024
+
+	InitAuditLogger(true, sink)
+	defer InitAuditLogger(true)
+	for i := 0; i < 20; i++ {
+		Audit().Record(context.Background(), &AuditRecord{
+			Event: AuditStateChange, Operation: "added", ObjectName: fmt.Sprintf("vol%d", i),
+		})
+	}
+	CloseAuditLogger()
+
+	_, err = os.Stat(path + ".1")
+	assert.NoError(t, err, "Expected the audit file to have been rotated.")
+	_, err = os.Stat(path + ".3")
+	assert.True(t, os.IsNotExist(err), "Expected only two rotated files to be kept.")
+
+	// Records written after a restart continue the chain
+	sink, err = newAuditFileSink(&AuditFileConfig{Path: path, MaxBackups: 2})
+	assert.NoError(t, err)
+	InitAuditLogger(true, sink)
+	Audit().Record(context.Background(), &AuditRecord{Event: AuditStateChange, Operation: "added"})
+	CloseAuditLogger()
+
+	var trail bytes.Buffer
+	for _, name := range []string{path + ".2", path + ".1", path} {
+		contents, err := os.ReadFile(name)
+		assert.NoError(t, err)
+		trail.Write(contents)
+	}
+	lines := strings.Split(strings.TrimSpace(trail.String()), "\n")
+	count, err := VerifyAuditTrail(&trail)
+	assert.NoError(t, err)
+	assert.Equal(t, len(lines), count)
+	last := &AuditRecord{}
+	assert.NoError(t, json.Unmarshal([]byte(lines[len(lines)-1]), last))
+	assert.Equal(t, uint64(21), last.Sequence)
+	assert.Less(t, count, 21, "Expected the oldest records to have been removed.")
+}
+
+func TestAuditWebhookSink(t *testing.T) {
+	var mutex sync.Mutex
+	var batches [][]json.RawMessage
+	fail := true
+	failed := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		assert.Equal(t, "Bearer abc", r.Header.Get("Authorization"))
+		if fail {
+			fail = false
+			close(failed)
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		var batch []json.RawMessage
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&batch))
+		batches = append(batches, batch)
+	}))
+	defer server.Close()
+
+	sink, err := newAuditWebhookSink(&AuditWebhookConfig{
+		URL:           server.URL,
+		Headers:       map[string]string{"Authorization": "Bearer abc"},
+		BatchSize:     2,
+		FlushInterval: "1h",
+	})
+	assert.NoError(t, err)
+
+	// The first batch fails and is retried with the next
+	for i := 0; i < 5; i++ {
+		assert.NoError(t, sink.Write([]byte(fmt.Sprintf(`{"sequence":%d}`, i+1))))
+		if i == 1 {
+			<-failed
+		}
+	}
+	assert.NoError(t, sink.Close())
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	sent := 0
+	for _, batch := range batches {
+		assert.LessOrEqual(t, len(batch), 2)
+		sent += len(batch)
+	}
+	assert.Equal(t, 5, sent, "Expected every record to be sent.")
+
+	_, err = newAuditWebhookSink(&AuditWebhookConfig{URL: server.URL, FlushInterval: "soon"})
+	assert.Error(t, err)
+}
+
+func TestLoadAuditConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte(`
+file:
+  path: /var/log/trident/audit.log
+  maxSizeMB: 10
+webhook:
+  url: https://audit.example.com/records
+  flushInterval: 10s
+`), 0o600))
+
+	auditConfig, err := LoadAuditConfig(path)
+	assert.NoError(t, err)
+	assert.Equal(t, &AuditFileConfig{Path: "/var/log/trident/audit.log", MaxSizeMB: 10}, auditConfig.File)
+	assert.Nil(t, auditConfig.Syslog)
+	assert.Equal(t, "https://audit.example.com/records", auditConfig.Webhook.URL)
+	assert.Equal(t, "10s", auditConfig.Webhook.FlushInterval)
+
+	_, err = NewAuditSinks(&AuditConfig{File: &AuditFileConfig{}})
+	assert.Error(t, err, "Expected a file sink without a path to be rejected.")
+}
@@ -0,0 +1,490 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package logging
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ghodss/yaml"
+)
+
+const (
+	defaultAuditFileMaxSizeMB        = 100
+	defaultAuditFileMaxBackups       = 5
+	defaultAuditSyslogTag            = "trident-audit"
+	defaultAuditWebhookBatchSize     = 100
+	defaultAuditWebhookFlushInterval = 5 * time.Second
+	defaultAuditWebhookTimeout       = 10 * time.Second
+	defaultAuditWebhookMaxPending    = 10000
+
+	// auditTrailTailSize is how much of an audit file is read to find the last record written to it
+	auditTrailTailSize = 1024 * 1024
+)
+
+// AuditConfig configures the sinks that receive structured audit records.  It is read from a YAML file.
+type AuditConfig struct {
+	File    *AuditFileConfig    `json:"file,omitempty"`
+	Syslog  *AuditSyslogConfig  `json:"syslog,omitempty"`
+	Webhook *AuditWebhookConfig `json:"webhook,omitempty"`
+}
+
+// AuditFileConfig configures a file of audit records, one JSON record per line, rotated once it reaches
+// maxSizeMB.  The newest maxBackups rotated files are kept, with suffixes .1, .2 and so on.
+type AuditFileConfig struct {
+	Path       string `json:"path"`
+	MaxSizeMB  int    `json:"maxSizeMB,omitempty"`
+	MaxBackups int    `json:"maxBackups,omitempty"`
+}
+
+// AuditSyslogConfig configures a syslog destination.  Network and address are as for syslog.Dial, and may be
+// left empty to use the local syslog daemon.
+type AuditSyslogConfig struct {
+	Network string `json:"network,omitempty"`
+	Address string `json:"address,omitempty"`
+	Tag     string `json:"tag,omitempty"`
+}
+
+// AuditWebhookConfig configures an HTTP endpoint to which audit records are posted in batches, as JSON arrays.
+// A batch is sent once batchSize records are waiting or flushInterval has passed.  Records that cannot be sent
+// are retried with the next batch; once maxPending records are waiting, the oldest are dropped.
+type AuditWebhookConfig struct {
+	URL           string            `json:"url"`
+	Headers       map[string]string `json:"headers,omitempty"`
+	BatchSize     int               `json:"batchSize,omitempty"`
+	FlushInterval string            `json:"flushInterval,omitempty"`
+	Timeout       string            `json:"timeout,omitempty"`
+	MaxPending    int               `json:"maxPending,omitempty"`
+}
+
+// auditTrail is implemented by sinks that can report the last record written to them, so that the chain of
+// records continues across restarts.
+type auditTrail interface {
+	lastRecord() *AuditRecord
+}
+
+// LoadAuditConfig reads the audit sink configuration from a YAML file.
+func LoadAuditConfig(path string) (*AuditConfig, error) {
+	configBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read audit configuration; %v", err)
+	}
+	auditConfig := &AuditConfig{}
+	if err = yaml.Unmarshal(configBytes, auditConfig); err != nil {
+		return nil, fmt.Errorf("could not parse audit configuration; %v", err)
+	}
+	return auditConfig, nil
+}
+
+// NewAuditSinks creates the sinks described by an audit configuration.
+func NewAuditSinks(auditConfig *AuditConfig) ([]AuditSink, error) {
+	sinks := make([]AuditSink, 0)
+	closeAll := func() {
+		for _, sink := range sinks {
+			_ = sink.Close()
+		}
+	}
+
+	if auditConfig.File != nil {
+		sink, err := newAuditFileSink(auditConfig.File)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	if auditConfig.Syslog != nil {
+		sink, err := newAuditSyslogSink(auditConfig.Syslog)
+		if err != nil {
+			closeAll()
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	if auditConfig.Webhook != nil {
+		sink, err := newAuditWebhookSink(auditConfig.Webhook)
+		if err != nil {
+			closeAll()
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+
+	return sinks, nil
+}
+
+// VerifyAuditTrail checks that the audit records read, one JSON record per line, are unaltered and that none
+// are missing, returning the number of records checked.  The first record need not begin the chain, so that a
+// trail may be checked from any rotated file onwards.
+func VerifyAuditTrail(records io.Reader) (int, error) {
+	scanner := bufio.NewScanner(records)
+	scanner.Buffer(make([]byte, 0, 64*1024), MaxLogEntryLength*64)
+
+	count := 0
+	var previous *AuditRecord
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		record := &AuditRecord{}
+		decoder := json.NewDecoder(bytes.NewReader(line))
+		decoder.UseNumber()
+		if err := decoder.Decode(record); err != nil {
+			return count, fmt.Errorf("could not parse audit record after sequence %d; %v", count, err)
+		}
+
+		hash := record.Hash
+		record.Hash = ""
+		if _, err := hashAuditRecord(record); err != nil {
+			return count, err
+		} else if record.Hash != hash {
+			return count, fmt.Errorf("audit record %d has been altered", record.Sequence)
+		}
+
+		if previous != nil {
+			if record.Sequence != previous.Sequence+1 {
+				return count, fmt.Errorf("audit records %d to %d are missing", previous.Sequence+1,
+					record.Sequence-1)
+			} else if record.PreviousHash != previous.Hash {
+				return count, fmt.Errorf("audit record %d does not follow record %d", record.Sequence,
+					previous.Sequence)
+			}
+		}
+
+		previous = record
+		count++
+	}
+
+	return count, scanner.Err()
+}
+
+// auditFileSink appends audit records to a file, rotating it by size.
+type auditFileSink struct {
+	mutex      sync.Mutex
+	path       string
+	maxSize    int64
+	maxBackups int
+	file       *os.File
+	size       int64
+}
+
+func newAuditFileSink(fileConfig *AuditFileConfig) (*auditFileSink, error) {
+	if fileConfig.Path == "" {
+		return nil, fmt.Errorf("audit file path not specified")
+	}
+	sink := &auditFileSink{
+		path:       fileConfig.Path,
+		maxSize:    int64(fileConfig.MaxSizeMB) * 1024 * 1024,
+		maxBackups: fileConfig.MaxBackups,
+	}
+	if sink.maxSize <= 0 {
+		sink.maxSize = defaultAuditFileMaxSizeMB * 1024 * 1024
+	}
+	if sink.maxBackups <= 0 {
+		sink.maxBackups = defaultAuditFileMaxBackups
+	}
+	if err := sink.open(); err != nil {
+		return nil, err
+	}
+	return sink, nil
+}
+
+func (s *auditFileSink) Name() string {
+	return "file"
+}
+
+func (s *auditFileSink) open() error {
+	file, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("could not open audit file %s; %v", s.path, err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return fmt.Errorf("could not open audit file %s; %v", s.path, err)
+	}
+	s.file = file
+	s.size = info.Size()
+	return nil
+}
+
+func (s *auditFileSink) Write(record []byte) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.file == nil {
+		if err := s.open(); err != nil {
+			return err
+		}
+	}
+
+	line := make([]byte, 0, len(record)+1)
+	n, err := s.file.Write(append(append(line, record...), '\n'))
+	s.size += int64(n)
+	if err != nil {
+		return err
+	}
+
+	if s.size >= s.maxSize {
+		return s.rotate()
+	}
+	return nil
+}
+
+// rotate renames the file to the first backup, shifting older backups along and removing the oldest.
+func (s *auditFileSink) rotate() error {
+	if err := s.file.Close(); err != nil {
+		return err
+	}
+	s.file = nil
+
+	for i := s.maxBackups - 1; i > 0; i-- {
+		backup := fmt.Sprintf("%s.%d", s.path, i)
+		if _, err := os.Stat(backup); err == nil {
+			if err = os.Rename(backup, fmt.Sprintf("%s.%d", s.path, i+1)); err != nil {
+				return err
+			}
+		}
+	}
+	if err := os.Rename(s.path, s.path+".1"); err != nil {
+		return err
+	}
+	return s.open()
+}
+
+// lastRecord returns the last record in the file, or in its newest backup if it has just been rotated.
+func (s *auditFileSink) lastRecord() *AuditRecord {
+	for _, path := range []string{s.path, s.path + ".1"} {
+		if record := lastAuditRecordInFile(path); record != nil {
+			return record
+		}
+	}
+	return nil
+}
+
+func lastAuditRecordInFile(path string) *AuditRecord {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer func() { _ = file.Close() }()
+
+	info, err := file.Stat()
+	if err != nil || info.Size() == 0 {
+		return nil
+	}
+	offset := info.Size() - auditTrailTailSize
+	if offset < 0 {
+		offset = 0
+	}
+	tail := make([]byte, info.Size()-offset)
+	if _, err = file.ReadAt(tail, offset); err != nil && err != io.EOF {
+		return nil
+	}
+
+	lines := bytes.Split(bytes.TrimSpace(tail), []byte("\n"))
+	record := &AuditRecord{}
+	if err = json.Unmarshal(lines[len(lines)-1], record); err != nil || record.Hash == "" {
+		Log().WithField("path", path).Warning("Could not read the last audit record; starting a new chain.")
+		return nil
+	}
+	return record
+}
+
+func (s *auditFileSink) Close() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.file == nil {
+		return nil
+	}
+	err := s.file.Close()
+	s.file = nil
+	return err
+}
+
+// auditWebhookSink posts batches of audit records to an HTTP endpoint from a background goroutine.
+type auditWebhookSink struct {
+	url           string
+	headers       map[string]string
+	client        *http.Client
+	batchSize     int
+	flushInterval time.Duration
+	maxPending    int
+
+	mutex   sync.Mutex
+	pending []json.RawMessage
+	dropped int
+
+	flush   chan struct{}
+	stop    chan struct{}
+	stopped chan struct{}
+}
+
+func newAuditWebhookSink(webhookConfig *AuditWebhookConfig) (*auditWebhookSink, error) {
+	if webhookConfig.URL == "" {
+		return nil, fmt.Errorf("audit webhook URL not specified")
+	}
+
+	sink := &auditWebhookSink{
+		url:           webhookConfig.URL,
+		headers:       webhookConfig.Headers,
+		batchSize:     webhookConfig.BatchSize,
+		flushInterval: defaultAuditWebhookFlushInterval,
+		maxPending:    webhookConfig.MaxPending,
+		flush:         make(chan struct{}, 1),
+		stop:          make(chan struct{}),
+		stopped:       make(chan struct{}),
+	}
+	if sink.batchSize <= 0 {
+		sink.batchSize = defaultAuditWebhookBatchSize
+	}
+	if sink.maxPending <= 0 {
+		sink.maxPending = defaultAuditWebhookMaxPending
+	}
+
+	var err error
+	if webhookConfig.FlushInterval != "" {
+		if sink.flushInterval, err = time.ParseDuration(webhookConfig.FlushInterval); err != nil {
+			return nil, fmt.Errorf("invalid audit webhook flush interval; %v", err)
+		}
+	}
+	timeout := defaultAuditWebhookTimeout
+	if webhookConfig.Timeout != "" {
+		if timeout, err = time.ParseDuration(webhookConfig.Timeout); err != nil {
+			return nil, fmt.Errorf("invalid audit webhook timeout; %v", err)
+		}
+	}
+	sink.client = &http.Client{Timeout: timeout}
+
+	go sink.run()
+	return sink, nil
+}
+
+func (s *auditWebhookSink) Name() string {
+	return "webhook"
+}
+
+// Write queues a record, to be sent with the next batch.
+func (s *auditWebhookSink) Write(record []byte) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if len(s.pending) >= s.maxPending {
+		s.pending = s.pending[1:]
+		s.dropped++
+	}
+	s.pending = append(s.pending, append(json.RawMessage{}, record...))
+
+	if len(s.pending) >= s.batchSize {
+		select {
+		case s.flush <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+func (s *auditWebhookSink) run() {
+	defer close(s.stopped)
+
+	ticker := time.NewTicker(s.flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			s.sendAll()
+			return
+		case <-ticker.C:
+			s.sendAll()
+		case <-s.flush:
+			s.sendAll()
+		}
+	}
+}
+
+// sendAll sends batches until no records are waiting or a batch cannot be sent.
+func (s *auditWebhookSink) sendAll() {
+	for {
+		s.mutex.Lock()
+		if s.dropped > 0 {
+			Log().WithField("dropped", s.dropped).Warning("Audit webhook fell behind, dropped the oldest records.")
+			s.dropped = 0
+		}
+		size := len(s.pending)
+		if size == 0 {
+			s.mutex.Unlock()
+			return
+		} else if size > s.batchSize {
+			size = s.batchSize
+		}
+		batch := s.pending[:size:size]
+		s.pending = s.pending[size:]
+		s.mutex.Unlock()
+
+		if err := s.send(batch); err != nil {
+			Log().WithError(err).WithField("records", len(batch)).Warning("Could not send audit records.")
+
+			// Put the batch back to be retried, dropping the oldest records if too many are now waiting
+			s.mutex.Lock()
+			s.pending = append(batch, s.pending...)
+			if excess := len(s.pending) - s.maxPending; excess > 0 {
+				s.pending = s.pending[excess:]
+				s.dropped += excess
+			}
+			s.mutex.Unlock()
+			return
+		}
+	}
+}
+
+func (s *auditWebhookSink) send(batch []json.RawMessage) error {
+	body, err := json.Marshal(batch)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.client.Timeout)
+	defer cancel()
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	for name, value := range s.headers {
+		request.Header.Set(name, value)
+	}
+
+	response, err := s.client.Do(request)
+	if err != nil {
+		return err
+	}
+	_, _ = io.Copy(io.Discard, response.Body)
+	_ = response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("audit webhook returned %s", response.Status)
+	}
+	return nil
+}
+
+// Close sends any records still waiting and stops the sink.
+func (s *auditWebhookSink) Close() error {
+	select {
+	case <-s.stop:
+	default:
+		close(s.stop)
+	}
+	<-s.stopped
+	return nil
+}
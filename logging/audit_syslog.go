@@ -0,0 +1,39 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+//go:build !windows
+
+package logging
+
+import (
+	"fmt"
+	"log/syslog"
+)
+
+// auditSyslogSink writes audit records to syslog, with the auth facility.
+type auditSyslogSink struct {
+	writer *syslog.Writer
+}
+
+func newAuditSyslogSink(syslogConfig *AuditSyslogConfig) (AuditSink, error) {
+	tag := syslogConfig.Tag
+	if tag == "" {
+		tag = defaultAuditSyslogTag
+	}
+	writer, err := syslog.Dial(syslogConfig.Network, syslogConfig.Address, syslog.LOG_INFO|syslog.LOG_AUTH, tag)
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to syslog; %v", err)
+	}
+	return &auditSyslogSink{writer: writer}, nil
+}
+
+func (s *auditSyslogSink) Name() string {
+	return "syslog"
+}
+
+func (s *auditSyslogSink) Write(record []byte) error {
+	return s.writer.Info(string(record))
+}
+
+func (s *auditSyslogSink) Close() error {
+	return s.writer.Close()
+}
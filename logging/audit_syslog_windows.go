@@ -0,0 +1,9 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package logging
+
+import "fmt"
+
+func newAuditSyslogSink(_ *AuditSyslogConfig) (AuditSink, error) {
+	return nil, fmt.Errorf("audit records cannot be sent to syslog on Windows")
+}
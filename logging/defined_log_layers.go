@@ -17,6 +17,7 @@ const (
 	LogLayerCRDFrontend             = LogLayer("crd_frontend")
 	LogLayerDockerFrontend          = LogLayer("docker_frontend")
 	LogLayerMetricsFrontend         = LogLayer("metrics_frontend")
+	LogLayerControlPlaneFrontend    = LogLayer("controlplane_frontend")
 	LogLayerPersistentStore         = LogLayer("persistent_store")
 	LogLayerANFNASDriver            = LogLayer(AzureNASStorageDriverName)
 	LogLayerANFSubvolumeDriver      = LogLayer(AzureNASBlockStorageDriverName)
@@ -40,6 +41,7 @@ var layers = []LogLayer{
 	LogLayerRESTFrontend,
 	LogLayerCRDFrontend,
 	LogLayerDockerFrontend,
+	LogLayerControlPlaneFrontend,
 	LogLayerPersistentStore,
 	LogLayerANFNASDriver,
 	LogLayerANFSubvolumeDriver,
@@ -15,6 +15,8 @@ const (
 	CategoryNode           = WorkflowCategory("node")
 	CategoryBackend        = WorkflowCategory("backend")
 	CategorySnapshot       = WorkflowCategory("snapshot")
+	CategoryGroupSnapshot  = WorkflowCategory("group_snapshot")
+	CategorySnapshotPolicy = WorkflowCategory("snapshot_policy")
 	CategoryController     = WorkflowCategory("controller")
 	CategoryNodeServer     = WorkflowCategory("node_server")
 	CategoryIdentityServer = WorkflowCategory("identity_server")
@@ -27,6 +29,9 @@ const (
 	OpVersion          = WorkflowOperation("version")
 	OpNodeReconcile    = WorkflowOperation("node_reconcile")
 	OpBackendReconcile = WorkflowOperation("backend_reconcile")
+	OpVolumeHealth     = WorkflowOperation("volume_health")
+	OpSnapshotPolicy   = WorkflowOperation("snapshot_policy")
+	OpWatch            = WorkflowOperation("watch")
 	OpReconcile        = WorkflowOperation("reconcile")
 	OpTrace            = WorkflowOperation("trace")
 	OpLogger           = WorkflowOperation("logger")
@@ -45,9 +50,13 @@ const (
 	OpCloneFrom        = WorkflowOperation("clone_from")
 	OpImport           = WorkflowOperation("import")
 	OpResize           = WorkflowOperation("resize")
+	OpMove             = WorkflowOperation("move")
+	OpGetOrphans       = WorkflowOperation("get_orphans")
+	OpResolveOrphans   = WorkflowOperation("resolve_orphans")
 	OpMount            = WorkflowOperation("mount")
 	OpUnmount          = WorkflowOperation("unmount")
 	OpGetCapabilties   = WorkflowOperation("get_capabilities")
+	OpGetCapacity      = WorkflowOperation("get_capacity")
 	OpProbe            = WorkflowOperation("probe")
 	OpGetResponse      = WorkflowOperation("get_response")
 	OpPublish          = WorkflowOperation("publish")
@@ -71,6 +80,9 @@ var (
 	WorkflowCoreInit             = Workflow{CategoryCore, OpInit}
 	WorkflowCoreNodeReconcile    = Workflow{CategoryCore, OpNodeReconcile}
 	WorkflowCoreBackendReconcile = Workflow{CategoryCore, OpBackendReconcile}
+	WorkflowCoreVolumeHealth     = Workflow{CategoryCore, OpVolumeHealth}
+	WorkflowCoreSnapshotPolicy   = Workflow{CategoryCore, OpSnapshotPolicy}
+	WorkflowCoreWatch            = Workflow{CategoryCore, OpWatch}
 
 	WorkflowGRPCTrace = Workflow{CategoryGRPC, OpTrace}
 
@@ -101,6 +113,7 @@ var (
 	WorkflowVolumeClone           = Workflow{CategoryVolume, OpClone}
 	WorkflowVolumeImport          = Workflow{CategoryVolume, OpImport}
 	WorkflowVolumeResize          = Workflow{CategoryVolume, OpResize}
+	WorkflowVolumeMove            = Workflow{CategoryVolume, OpMove}
 	WorkflowVolumeMount           = Workflow{CategoryVolume, OpMount}
 	WorkflowVolumeUnmount         = Workflow{CategoryVolume, OpUnmount}
 	WorkflowVolumeGetCapabilities = Workflow{CategoryVolume, OpGetCapabilties}
@@ -124,11 +137,13 @@ var (
 	WorkflowIdentityGetInfo         = Workflow{CategoryIdentityServer, OpGetInfo}
 	WorkflowIdentityGetCapabilities = Workflow{CategoryIdentityServer, OpGetCapabilties}
 
-	WorkflowBackendCreate = Workflow{CategoryBackend, OpCreate}
-	WorkflowBackendDelete = Workflow{CategoryBackend, OpDelete}
-	WorkflowBackendGet    = Workflow{CategoryBackend, OpGet}
-	WorkflowBackendUpdate = Workflow{CategoryBackend, OpUpdate}
-	WorkflowBackendList   = Workflow{CategoryBackend, OpList}
+	WorkflowBackendCreate         = Workflow{CategoryBackend, OpCreate}
+	WorkflowBackendDelete         = Workflow{CategoryBackend, OpDelete}
+	WorkflowBackendGet            = Workflow{CategoryBackend, OpGet}
+	WorkflowBackendUpdate         = Workflow{CategoryBackend, OpUpdate}
+	WorkflowBackendList           = Workflow{CategoryBackend, OpList}
+	WorkflowBackendGetOrphans     = Workflow{CategoryBackend, OpGetOrphans}
+	WorkflowBackendResolveOrphans = Workflow{CategoryBackend, OpResolveOrphans}
 
 	WorkflowSnapshotCreate    = Workflow{CategorySnapshot, OpCreate}
 	WorkflowSnapshotDelete    = Workflow{CategorySnapshot, OpDelete}
@@ -137,9 +152,21 @@ var (
 	WorkflowSnapshotList      = Workflow{CategorySnapshot, OpList}
 	WorkflowSnapshotCloneFrom = Workflow{CategorySnapshot, OpCloneFrom}
 
+	WorkflowGroupSnapshotCreate          = Workflow{CategoryGroupSnapshot, OpCreate}
+	WorkflowGroupSnapshotDelete          = Workflow{CategoryGroupSnapshot, OpDelete}
+	WorkflowGroupSnapshotGet             = Workflow{CategoryGroupSnapshot, OpGet}
+	WorkflowGroupSnapshotGetCapabilities = Workflow{CategoryGroupSnapshot, OpGetCapabilties}
+
+	WorkflowSnapshotPolicyCreate = Workflow{CategorySnapshotPolicy, OpCreate}
+	WorkflowSnapshotPolicyGet    = Workflow{CategorySnapshotPolicy, OpGet}
+	WorkflowSnapshotPolicyUpdate = Workflow{CategorySnapshotPolicy, OpUpdate}
+	WorkflowSnapshotPolicyList   = Workflow{CategorySnapshotPolicy, OpList}
+	WorkflowSnapshotPolicyDelete = Workflow{CategorySnapshotPolicy, OpDelete}
+
 	WorkflowControllerPublish         = Workflow{CategoryController, OpPublish}
 	WorkflowControllerUnpublish       = Workflow{CategoryController, OpUnpublish}
 	WorkflowControllerGetCapabilities = Workflow{CategoryController, OpGetCapabilties}
+	WorkflowControllerGetCapacity     = Workflow{CategoryController, OpGetCapacity}
 
 	WorkflowNodeStage         = Workflow{CategoryNodeServer, OpStage}
 	WorkflowNodeUnstage       = Workflow{CategoryNodeServer, OpUnstage}
@@ -177,6 +204,7 @@ var (
 		WorkflowVolumeClone,
 		WorkflowVolumeImport,
 		WorkflowVolumeResize,
+		WorkflowVolumeMove,
 		WorkflowVolumeMount,
 		WorkflowVolumeUnmount,
 		WorkflowVolumeGetCapabilities,
@@ -198,15 +226,27 @@ var (
 		WorkflowBackendGet,
 		WorkflowBackendUpdate,
 		WorkflowBackendList,
+		WorkflowBackendGetOrphans,
+		WorkflowBackendResolveOrphans,
 		WorkflowSnapshotCreate,
 		WorkflowSnapshotDelete,
 		WorkflowSnapshotGet,
 		WorkflowSnapshotUpdate,
 		WorkflowSnapshotList,
 		WorkflowSnapshotCloneFrom,
+		WorkflowGroupSnapshotCreate,
+		WorkflowGroupSnapshotDelete,
+		WorkflowGroupSnapshotGet,
+		WorkflowGroupSnapshotGetCapabilities,
+		WorkflowSnapshotPolicyCreate,
+		WorkflowSnapshotPolicyGet,
+		WorkflowSnapshotPolicyUpdate,
+		WorkflowSnapshotPolicyList,
+		WorkflowSnapshotPolicyDelete,
 		WorkflowControllerPublish,
 		WorkflowControllerUnpublish,
 		WorkflowControllerGetCapabilities,
+		WorkflowControllerGetCapacity,
 		WorkflowNodeStage,
 		WorkflowNodeUnstage,
 		WorkflowNodePublish,
@@ -735,7 +735,7 @@ func TestSetContextLogLayer(t *testing.T) {
 
 func TestListLogLayers(t *testing.T) {
 	assert.Equal(t, []string{
-		"all", "azure-netapp-files", "azure-netapp-files-subvolume", "core",
+		"all", "azure-netapp-files", "azure-netapp-files-subvolume", "controlplane_frontend", "core",
 		"crd_frontend", "csi_frontend", "docker_frontend", "fake", "gcp-cvs", "ontap-nas",
 		"ontap-nas-economy", "ontap-nas-flexgroup", "ontap-san", "ontap-san-economy",
 		"persistent_store", "rest_frontend", "solidfire-san",
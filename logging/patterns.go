@@ -50,3 +50,11 @@ var backendAuthorization = redactedPattern{
 	re:  regexp.MustCompile(`\\*"username\\*":\\*"([^\\"])*\\*"`),
 	rep: []byte("\\\"username\\\":<REDACTED>"),
 }
+
+// iscsiCHAPCredentials pattern intended to redact the CHAP credentials held in volume access information
+// Example match: `"iscsi(Target)Username":"<string of characters other than \ and \""`
+// Example match: `"iscsi(Initiator|Target)Secret":"<string of characters other than \ and \""`
+var iscsiCHAPCredentials = redactedPattern{
+	re:  regexp.MustCompile(`\\*"iscsi(Target|Initiator)*(Username|Secret)\\*":\\*"([^\\"])*\\*"`),
+	rep: []byte("\\\"iscsi[Target|Initiator][Username|Secret]\\\":<REDACTED>"),
+}
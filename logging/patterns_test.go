@@ -226,3 +226,34 @@ func TestBackendAuthorizationRedactor(t *testing.T) {
 		})
 	}
 }
+
+func TestISCSICHAPCredentialsRedactor(t *testing.T) {
+	tests := []struct {
+		name     string
+		line     string
+		expected string
+	}{
+		{
+			name:     "initiator secret",
+			line:     "\"iscsiInitiatorSecret\":\"secret1234*&^%$#toberedacted\"",
+			expected: "\\\"iscsi[Target|Initiator][Username|Secret]\\\":<REDACTED>",
+		},
+		{
+			name:     "escaped target username",
+			line:     "\\\"iscsiTargetUsername\\\":\\\"user1234toberedacted\\\"",
+			expected: "\\\"iscsi[Target|Initiator][Username|Secret]\\\":<REDACTED>",
+		},
+		{
+			name:     "simple case with wrong key",
+			line:     "\"iscsiTargetPortal\":\"10.0.0.1\"",
+			expected: "\"iscsiTargetPortal\":\"10.0.0.1\"",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			input := []byte(test.line)
+			actual := iscsiCHAPCredentials.re.ReplaceAll(input, iscsiCHAPCredentials.rep)
+			assert.Equal(t, test.expected, string(actual))
+		})
+	}
+}
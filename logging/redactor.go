@@ -20,6 +20,7 @@ var redactedPatterns = []redactedPattern{
 	backendCreateCHAPSecrets,
 	backendCreateCHAPUsername,
 	backendAuthorization,
+	iscsiCHAPCredentials,
 }
 
 // Redactor is a formatter that redacts pre-defined regex patterns
@@ -14,6 +14,7 @@ const (
 	ContextKeyWorkflow      ContextKey = "workflow"
 	ContextKeyLogLayer      ContextKey = "logLayer"
 	CRDControllerEvent      ContextKey = "crdControllerEvent"
+	ContextKeyAuditActor    ContextKey = "auditActor"
 
 	ContextSourceCRD      = "CRD"
 	ContextSourceREST     = "REST"
@@ -28,6 +29,7 @@ const (
 	AuditRESTAccess   = AuditEvent("rest")
 	AuditGRPCAccess   = AuditEvent("csi")
 	AuditDockerAccess = AuditEvent("docker")
+	AuditStateChange  = AuditEvent("state")
 )
 
 // ContextKey is used for context.Context value. The value requires a key that is not primitive type.
@@ -89,4 +91,13 @@ type AuditLogger interface {
 	Log(ctx context.Context, event AuditEvent, fields LogFields, message string)
 	Logln(ctx context.Context, event AuditEvent, fields LogFields, message string)
 	Logf(ctx context.Context, event AuditEvent, fields LogFields, format string, args ...interface{})
+	Record(ctx context.Context, record *AuditRecord)
+	Enabled() bool
+}
+
+// AuditSink receives audit records, each as a line of JSON.
+type AuditSink interface {
+	Name() string
+	Write(record []byte) error
+	Close() error
 }
@@ -16,10 +16,14 @@ import (
 	"syscall"
 	"time"
 
+	"k8s.io/client-go/kubernetes"
+
 	"github.com/netapp/trident/acp"
+	clik8sclient "github.com/netapp/trident/cli/k8s_client"
 	"github.com/netapp/trident/config"
 	"github.com/netapp/trident/core"
 	"github.com/netapp/trident/frontend"
+	"github.com/netapp/trident/frontend/controlplane"
 	"github.com/netapp/trident/frontend/crd"
 	"github.com/netapp/trident/frontend/csi"
 	controllerhelpers "github.com/netapp/trident/frontend/csi/controller_helpers"
@@ -35,11 +39,14 @@ import (
 	persistentstore "github.com/netapp/trident/persistent_store"
 	"github.com/netapp/trident/utils"
 	"github.com/netapp/trident/utils/errors"
+	"github.com/netapp/trident/utils/tracing"
 )
 
 var (
 	// Logging
-	auditLog  = flag.Bool("disable_audit_log", true, "Disable the audit logger")
+	auditLog    = flag.Bool("disable_audit_log", true, "Disable the audit logger")
+	auditConfig = flag.String("audit_config", "", "Path to a file configuring the file, syslog and "+
+		"webhook sinks for audit records")
 	debug     = flag.Bool("debug", false, "Enable debugging output")
 	logFormat = flag.String("log_format", "text", "Logging format (text, json)")
 	logLevel  = flag.String("log_level", "info", "Logging level (trace, debug, info, warn, "+
@@ -86,7 +93,14 @@ var (
 		"any metadata.  WILL LOSE TRACK OF VOLUMES ON REBOOT/CRASH.")
 	usePassthrough = flag.Bool("passthrough", false, "Uses the storage backends "+
 		"as the source of truth.  No data is stored anywhere else.")
-	useCRD = flag.Bool("crd_persistence", false, "Uses CRDs for persisting orchestrator state.")
+	useCRD        = flag.Bool("crd_persistence", false, "Uses CRDs for persisting orchestrator state.")
+	boltStorePath = flag.String("bolt_persistence", "", "Uses the specified file for persisting "+
+		"orchestrator state.  Intended for deployments without Kubernetes.")
+	migrateStoreFrom = flag.String("migrate_store_from", "", "Before bootstrapping, copies any "+
+		"orchestrator state missing from the persistent store from a store of this type (passthrough, crdv1 "+
+		"or bolt).")
+	migrateStoreFromPath = flag.String("migrate_store_from_path", "", "Backend config file or directory "+
+		"of a passthrough store, or file of a bolt store, to migrate from.")
 
 	// HTTP REST interface
 	address            = flag.String("address", "127.0.0.1", "Storage orchestrator HTTP API address")
@@ -105,8 +119,23 @@ var (
 	httpsClientKey  = flag.String("https_client_key", config.ClientKeyPath, "HTTPS client private key")
 	httpsClientCert = flag.String("https_client_cert", config.ClientCertPath, "HTTPS client certificate")
 
+	// gRPC control-plane interface
+	grpcAddress = flag.String("grpc_address", "", "Storage orchestrator gRPC control-plane address")
+	grpcPort    = flag.String("grpc_port", "", "Storage orchestrator gRPC control-plane port; "+
+		"disabled if not specified")
+
+	// REST authentication
+	restAuthConfig = flag.String("rest_auth_config", "", "Path to a file configuring bearer token "+
+		"authentication and roles for the REST interfaces")
+
 	aesKey = flag.String("aes_key", config.AESKeyPath, "AES encryption key")
 
+	// Tracing
+	tracingEndpoint = flag.String("tracing_endpoint", "", "URL of an OTLP/gRPC collector to export "+
+		"traces to; tracing is disabled if not specified")
+	tracingSampleRatio = flag.Float64("tracing_sample_ratio", 1, "Fraction of the traces started by "+
+		"Trident that are exported")
+
 	// HTTP metrics interface
 	metricsAddress = flag.String("metrics_address", "", "Storage orchestrator metrics address")
 	metricsPort    = flag.String("metrics_port", "8001", "Storage orchestrator metrics port")
@@ -126,6 +155,10 @@ var (
 	// core
 	backendStoragePollInterval = flag.Duration("backend_storage_poll_interval", config.BackendStoragePollInterval,
 		"Interval at which core polls backend storage for its state")
+	volumeHealthPollInterval = flag.Duration("volume_health_poll_interval", config.VolumeHealthPollInterval,
+		"Interval at which core checks the condition of each volume")
+	snapshotPolicyPollInterval = flag.Duration("snapshot_policy_poll_interval", config.SnapshotPolicyPollInterval,
+		"Interval at which core checks whether any snapshot policy is due to run")
 
 	storeClient  persistentstore.Client
 	enableDocker bool
@@ -187,6 +220,9 @@ func processCmdLineArgs(ctx context.Context) {
 	if *useCRD {
 		storeCount++
 	}
+	if *boltStorePath != "" {
+		storeCount++
+	}
 	// Infer persistent store type if not explicitly specified
 	if storeCount == 0 && enableDocker {
 		Logc(ctx).Debug("Inferred passthrough persistent store.")
@@ -215,11 +251,78 @@ func processCmdLineArgs(ctx context.Context) {
 		if err != nil {
 			Logc(ctx).Fatalf("Unable to create the Kubernetes store client. %v", err)
 		}
+
+	case *boltStorePath != "":
+		Logc(ctx).WithField("path", *boltStorePath).Debug("Trident is configured with a bolt store client.")
+		storeClient, err = persistentstore.NewBoltClient(*boltStorePath)
+		if err != nil {
+			Logc(ctx).Fatalf("Unable to create the bolt store client. %v", err)
+		}
 	}
 
 	config.UsingPassthroughStore = storeClient.GetType() == persistentstore.PassthroughStore
 }
 
+// migratePersistentStore copies orchestrator state from another persistent store, so that an installation may
+// change stores, such as when moving from Docker to Kubernetes.  Objects already in the store are left alone,
+// so this is harmless once the migration is done.
+func migratePersistentStore(ctx context.Context) {
+	location := *migrateStoreFromPath
+	if persistentstore.StoreType(*migrateStoreFrom) == persistentstore.CRDV1Store {
+		location = *k8sConfigPath
+	}
+
+	source, err := persistentstore.NewMigrationClient(ctx, persistentstore.StoreType(*migrateStoreFrom), location)
+	if err != nil {
+		Logc(ctx).Fatalf("Unable to open the %s store to migrate from. %v", *migrateStoreFrom, err)
+	}
+	defer func() { _ = source.Stop() }()
+
+	report, err := persistentstore.NewDataMigrator(source, storeClient, false).Run(ctx)
+	if err != nil {
+		Logc(ctx).Fatalf("Unable to migrate from the %s store. %v", *migrateStoreFrom, err)
+	}
+
+	for _, object := range report.Objects {
+		fields := LogFields{
+			"kind":   object.Kind,
+			"name":   object.Name,
+			"result": object.Result,
+		}
+		switch object.Result {
+		case persistentstore.MigrationConflict, persistentstore.MigrationFailed:
+			fields["diff"] = object.Diff
+			fields["error"] = object.Error
+			Logc(ctx).WithFields(fields).Error("Object was not migrated.")
+		default:
+			Logc(ctx).WithFields(fields).Debug("Object was migrated.")
+		}
+	}
+	if !report.Succeeded() {
+		Logc(ctx).Fatalf("Some objects could not be migrated from the %s store.", *migrateStoreFrom)
+	}
+	Logc(ctx).WithField("objects", len(report.Objects)).Infof("Migrated from the %s store.", *migrateStoreFrom)
+}
+
+// newRESTAuthenticator loads the REST authentication config, connecting to Kubernetes if it reviews tokens.
+func newRESTAuthenticator(path string) (*rest.Authenticator, error) {
+	authConfig, err := rest.LoadAuthConfig(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var kubeClient kubernetes.Interface
+	if authConfig.TokenReview {
+		clients, err := clik8sclient.CreateK8SClients(*k8sAPIServer, *k8sConfigPath, "")
+		if err != nil {
+			return nil, fmt.Errorf("could not create Kubernetes client for token review; %v", err)
+		}
+		kubeClient = clients.KubeClient
+	}
+
+	return rest.NewAuthenticator(authConfig, kubeClient)
+}
+
 // getenvAsPointerToBool returns the key's value and defaults to false if not set
 func getenvAsPointerToBool(ctx context.Context, key string) *bool {
 	Logc(ctx).Trace(">>>> getenvAsPointerToBool")
@@ -343,7 +446,18 @@ func main() {
 	}
 
 	// Initialize the audit logger.
-	InitAuditLogger(*auditLog)
+	var auditSinks []AuditSink
+	if *auditConfig != "" {
+		sinkConfig, err := LoadAuditConfig(*auditConfig)
+		if err == nil {
+			auditSinks, err = NewAuditSinks(sinkConfig)
+		}
+		if err != nil {
+			_, _ = fmt.Fprintf(os.Stderr, "Could not set up audit sinks; %v", err)
+			os.Exit(1)
+		}
+	}
+	InitAuditLogger(*auditLog, auditSinks...)
 
 	// Print all env variables
 	for _, element := range os.Environ() {
@@ -357,6 +471,16 @@ func main() {
 		"binary":     os.Args[0],
 	}).Info("Running Trident storage orchestrator.")
 
+	shutdownTracing, err := tracing.Init(ctx, tracing.Config{
+		Endpoint:       *tracingEndpoint,
+		SampleRatio:    *tracingSampleRatio,
+		ServiceName:    config.OrchestratorName,
+		ServiceVersion: config.OrchestratorVersion.String(),
+	})
+	if err != nil {
+		Log().Fatalf("Unable to initialize tracing. %v", err)
+	}
+
 	processCmdLineArgs(ctx)
 
 	orchestrator := core.NewTridentOrchestrator(storeClient)
@@ -485,6 +609,16 @@ func main() {
 		}
 	}
 
+	// Set up REST authentication
+	var restAuthenticator *rest.Authenticator
+	if *restAuthConfig != "" {
+		restAuthenticator, err = newRESTAuthenticator(*restAuthConfig)
+		if err != nil {
+			Log().Fatalf("Unable to set up REST authentication. %v", err)
+		}
+		Log().WithField("config", *restAuthConfig).Info("Enabled REST authentication.")
+	}
+
 	// Create HTTP REST frontend
 	if *enableREST {
 
@@ -499,7 +633,7 @@ func main() {
 			if *address != "127.0.0.1" && *address != "[::1]" {
 				*address = "127.0.0.1"
 			}
-			httpServer := rest.NewHTTPServer(orchestrator, *address, *port, *httpRequestTimeout)
+			httpServer := rest.NewHTTPServer(orchestrator, *address, *port, *httpRequestTimeout, restAuthenticator)
 			preBootstrapFrontends = append(preBootstrapFrontends, httpServer)
 			Log().WithFields(LogFields{"name": httpServer.GetName()}).Info("Added frontend.")
 		}
@@ -516,7 +650,7 @@ func main() {
 
 			httpsServer, err := rest.NewHTTPSServer(
 				orchestrator, *httpsAddress, *httpsPort, *httpsCACert, *httpsServerCert, *httpsServerKey,
-				enableMutualTLS, handler, *httpRequestTimeout)
+				enableMutualTLS, handler, *httpRequestTimeout, restAuthenticator)
 			if err != nil {
 				Log().Fatalf("Unable to start the HTTPS REST frontend. %v", err)
 			}
@@ -525,6 +659,19 @@ func main() {
 		}
 	}
 
+	// Create gRPC control-plane frontend
+	if *grpcPort != "" {
+		grpcServer, err := controlplane.NewServer(
+			orchestrator, *grpcAddress, *grpcPort, *httpsCACert, *httpsServerCert, *httpsServerKey)
+		if err != nil {
+			Log().Fatalf("Unable to start the gRPC control-plane frontend. %v", err)
+		}
+		// The CSI frontend reaches node plugins through this frontend to freeze filesystems
+		orchestrator.AddFrontend(ctx, grpcServer)
+		preBootstrapFrontends = append(preBootstrapFrontends, grpcServer)
+		Log().WithFields(LogFields{"name": grpcServer.GetName()}).Info("Added frontend.")
+	}
+
 	// Set configuration values used for ACP for the lifecycle of Trident.
 	// This always needs to happen regardless of if ACP is enabled or not and must happen before bootstrapping.
 	acp.Initialize(*acpAddress, *enableACP, config.HTTPTimeout)
@@ -554,6 +701,10 @@ func main() {
 		}
 	}
 
+	if *migrateStoreFrom != "" {
+		migratePersistentStore(ctx)
+	}
+
 	if err = orchestrator.Bootstrap(txnMonitor); err != nil {
 		Log().Error(err.Error())
 	}
@@ -569,8 +720,10 @@ func main() {
 
 	if config.CurrentDriverContext == config.ContextCSI {
 		go orchestrator.PeriodicallyReconcileNodeAccessOnBackends()
+		go orchestrator.PeriodicallyCheckVolumeHealth(*volumeHealthPollInterval)
 	}
 	go orchestrator.PeriodicallyReconcileBackendState(*backendStoragePollInterval)
+	go orchestrator.PeriodicallyRunSnapshotPolicies(*snapshotPolicyPollInterval)
 
 	// Register and wait for a shutdown signal
 	c := make(chan os.Signal, 1)
@@ -591,4 +744,8 @@ func main() {
 	if err = storeClient.Stop(); err != nil {
 		Log().Error(err)
 	}
+	if err = shutdownTracing(ctx); err != nil {
+		Log().Error(err)
+	}
+	CloseAuditLogger()
 }
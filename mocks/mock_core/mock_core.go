@@ -10,6 +10,7 @@ import (
 	time "time"
 
 	gomock "github.com/golang/mock/gomock"
+	config "github.com/netapp/trident/config"
 	core "github.com/netapp/trident/core"
 	frontend "github.com/netapp/trident/frontend"
 	storage "github.com/netapp/trident/storage"
@@ -81,6 +82,21 @@ func (mr *MockOrchestratorMockRecorder) AddNode(arg0, arg1, arg2 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNode", reflect.TypeOf((*MockOrchestrator)(nil).AddNode), arg0, arg1, arg2)
 }
 
+// AddSnapshotPolicy mocks base method.
+func (m *MockOrchestrator) AddSnapshotPolicy(arg0 context.Context, arg1 *storage.SnapshotPolicyConfig) (*storage.SnapshotPolicyExternal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSnapshotPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*storage.SnapshotPolicyExternal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddSnapshotPolicy indicates an expected call of AddSnapshotPolicy.
+func (mr *MockOrchestratorMockRecorder) AddSnapshotPolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSnapshotPolicy", reflect.TypeOf((*MockOrchestrator)(nil).AddSnapshotPolicy), arg0, arg1)
+}
+
 // AddStorageClass mocks base method.
 func (m *MockOrchestrator) AddStorageClass(arg0 context.Context, arg1 *storageclass.Config) (*storageclass.External, error) {
 	m.ctrl.T.Helper()
@@ -198,6 +214,21 @@ func (mr *MockOrchestratorMockRecorder) CloneVolume(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloneVolume", reflect.TypeOf((*MockOrchestrator)(nil).CloneVolume), arg0, arg1)
 }
 
+// CreateGroupSnapshot mocks base method.
+func (m *MockOrchestrator) CreateGroupSnapshot(arg0 context.Context, arg1 *storage.GroupSnapshotConfig) (*storage.GroupSnapshotExternal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGroupSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(*storage.GroupSnapshotExternal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGroupSnapshot indicates an expected call of CreateGroupSnapshot.
+func (mr *MockOrchestratorMockRecorder) CreateGroupSnapshot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroupSnapshot", reflect.TypeOf((*MockOrchestrator)(nil).CreateGroupSnapshot), arg0, arg1)
+}
+
 // CreateSnapshot mocks base method.
 func (m *MockOrchestrator) CreateSnapshot(arg0 context.Context, arg1 *storage.SnapshotConfig) (*storage.SnapshotExternal, error) {
 	m.ctrl.T.Helper()
@@ -241,6 +272,20 @@ func (mr *MockOrchestratorMockRecorder) DeleteBackendByBackendUUID(arg0, arg1, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBackendByBackendUUID", reflect.TypeOf((*MockOrchestrator)(nil).DeleteBackendByBackendUUID), arg0, arg1, arg2)
 }
 
+// DeleteGroupSnapshot mocks base method.
+func (m *MockOrchestrator) DeleteGroupSnapshot(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGroupSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGroupSnapshot indicates an expected call of DeleteGroupSnapshot.
+func (mr *MockOrchestratorMockRecorder) DeleteGroupSnapshot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroupSnapshot", reflect.TypeOf((*MockOrchestrator)(nil).DeleteGroupSnapshot), arg0, arg1)
+}
+
 // DeleteNode mocks base method.
 func (m *MockOrchestrator) DeleteNode(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -269,6 +314,20 @@ func (mr *MockOrchestratorMockRecorder) DeleteSnapshot(arg0, arg1, arg2 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshot", reflect.TypeOf((*MockOrchestrator)(nil).DeleteSnapshot), arg0, arg1, arg2)
 }
 
+// DeleteSnapshotPolicy mocks base method.
+func (m *MockOrchestrator) DeleteSnapshotPolicy(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSnapshotPolicy", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSnapshotPolicy indicates an expected call of DeleteSnapshotPolicy.
+func (mr *MockOrchestratorMockRecorder) DeleteSnapshotPolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshotPolicy", reflect.TypeOf((*MockOrchestrator)(nil).DeleteSnapshotPolicy), arg0, arg1)
+}
+
 // DeleteStorageClass mocks base method.
 func (m *MockOrchestrator) DeleteStorageClass(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -353,6 +412,36 @@ func (mr *MockOrchestratorMockRecorder) EstablishMirror(arg0, arg1, arg2, arg3,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstablishMirror", reflect.TypeOf((*MockOrchestrator)(nil).EstablishMirror), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
+// ExplainStorageClass mocks base method.
+func (m *MockOrchestrator) ExplainStorageClass(arg0 context.Context, arg1 string) (*storageclass.PlacementExplanation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExplainStorageClass", arg0, arg1)
+	ret0, _ := ret[0].(*storageclass.PlacementExplanation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExplainStorageClass indicates an expected call of ExplainStorageClass.
+func (mr *MockOrchestratorMockRecorder) ExplainStorageClass(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExplainStorageClass", reflect.TypeOf((*MockOrchestrator)(nil).ExplainStorageClass), arg0, arg1)
+}
+
+// ExplainVolumePlacement mocks base method.
+func (m *MockOrchestrator) ExplainVolumePlacement(arg0 context.Context, arg1 *storage.VolumeConfig) (*storageclass.PlacementExplanation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExplainVolumePlacement", arg0, arg1)
+	ret0, _ := ret[0].(*storageclass.PlacementExplanation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExplainVolumePlacement indicates an expected call of ExplainVolumePlacement.
+func (mr *MockOrchestratorMockRecorder) ExplainVolumePlacement(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExplainVolumePlacement", reflect.TypeOf((*MockOrchestrator)(nil).ExplainVolumePlacement), arg0, arg1)
+}
+
 // GetBackend mocks base method.
 func (m *MockOrchestrator) GetBackend(arg0 context.Context, arg1 string) (*storage.BackendExternal, error) {
 	m.ctrl.T.Helper()
@@ -413,6 +502,21 @@ func (mr *MockOrchestratorMockRecorder) GetFrontend(arg0, arg1 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFrontend", reflect.TypeOf((*MockOrchestrator)(nil).GetFrontend), arg0, arg1)
 }
 
+// GetGroupSnapshot mocks base method.
+func (m *MockOrchestrator) GetGroupSnapshot(arg0 context.Context, arg1 string) (*storage.GroupSnapshotExternal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(*storage.GroupSnapshotExternal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupSnapshot indicates an expected call of GetGroupSnapshot.
+func (mr *MockOrchestratorMockRecorder) GetGroupSnapshot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupSnapshot", reflect.TypeOf((*MockOrchestrator)(nil).GetGroupSnapshot), arg0, arg1)
+}
+
 // GetLogLevel mocks base method.
 func (m *MockOrchestrator) GetLogLevel(arg0 context.Context) (string, error) {
 	m.ctrl.T.Helper()
@@ -473,6 +577,21 @@ func (mr *MockOrchestratorMockRecorder) GetNode(arg0, arg1 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNode", reflect.TypeOf((*MockOrchestrator)(nil).GetNode), arg0, arg1)
 }
 
+// GetOrphanReport mocks base method.
+func (m *MockOrchestrator) GetOrphanReport(arg0 context.Context, arg1 string) (*storage.OrphanReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrphanReport", arg0, arg1)
+	ret0, _ := ret[0].(*storage.OrphanReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrphanReport indicates an expected call of GetOrphanReport.
+func (mr *MockOrchestratorMockRecorder) GetOrphanReport(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrphanReport", reflect.TypeOf((*MockOrchestrator)(nil).GetOrphanReport), arg0, arg1)
+}
+
 // GetReplicationDetails mocks base method.
 func (m *MockOrchestrator) GetReplicationDetails(arg0 context.Context, arg1, arg2, arg3 string) (string, string, string, error) {
 	m.ctrl.T.Helper()
@@ -535,6 +654,36 @@ func (mr *MockOrchestratorMockRecorder) GetSnapshot(arg0, arg1, arg2 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshot", reflect.TypeOf((*MockOrchestrator)(nil).GetSnapshot), arg0, arg1, arg2)
 }
 
+// GetSnapshotPolicy mocks base method.
+func (m *MockOrchestrator) GetSnapshotPolicy(arg0 context.Context, arg1 string) (*storage.SnapshotPolicyExternal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnapshotPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*storage.SnapshotPolicyExternal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSnapshotPolicy indicates an expected call of GetSnapshotPolicy.
+func (mr *MockOrchestratorMockRecorder) GetSnapshotPolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshotPolicy", reflect.TypeOf((*MockOrchestrator)(nil).GetSnapshotPolicy), arg0, arg1)
+}
+
+// GetStorageCapacity mocks base method.
+func (m *MockOrchestrator) GetStorageCapacity(arg0 context.Context, arg1 *storageclass.Config, arg2 config.Protocol, arg3 map[string]string) (*storageclass.Capacity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageCapacity", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*storageclass.Capacity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageCapacity indicates an expected call of GetStorageCapacity.
+func (mr *MockOrchestratorMockRecorder) GetStorageCapacity(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageCapacity", reflect.TypeOf((*MockOrchestrator)(nil).GetStorageCapacity), arg0, arg1, arg2, arg3)
+}
+
 // GetStorageClass mocks base method.
 func (m *MockOrchestrator) GetStorageClass(arg0 context.Context, arg1 string) (*storageclass.External, error) {
 	m.ctrl.T.Helper()
@@ -625,6 +774,21 @@ func (mr *MockOrchestratorMockRecorder) GetVolumeForImport(arg0, arg1, arg2 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeForImport", reflect.TypeOf((*MockOrchestrator)(nil).GetVolumeForImport), arg0, arg1, arg2)
 }
 
+// GetVolumeHealth mocks base method.
+func (m *MockOrchestrator) GetVolumeHealth(arg0 context.Context, arg1 string) (*storage.VolumeHealth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeHealth", arg0, arg1)
+	ret0, _ := ret[0].(*storage.VolumeHealth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeHealth indicates an expected call of GetVolumeHealth.
+func (mr *MockOrchestratorMockRecorder) GetVolumeHealth(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeHealth", reflect.TypeOf((*MockOrchestrator)(nil).GetVolumeHealth), arg0, arg1)
+}
+
 // GetVolumePublication mocks base method.
 func (m *MockOrchestrator) GetVolumePublication(arg0 context.Context, arg1, arg2 string) (*utils.VolumePublication, error) {
 	m.ctrl.T.Helper()
@@ -700,6 +864,21 @@ func (mr *MockOrchestratorMockRecorder) ListBackends(arg0 interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackends", reflect.TypeOf((*MockOrchestrator)(nil).ListBackends), arg0)
 }
 
+// ListGroupSnapshots mocks base method.
+func (m *MockOrchestrator) ListGroupSnapshots(arg0 context.Context) ([]*storage.GroupSnapshotExternal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGroupSnapshots", arg0)
+	ret0, _ := ret[0].([]*storage.GroupSnapshotExternal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGroupSnapshots indicates an expected call of ListGroupSnapshots.
+func (mr *MockOrchestratorMockRecorder) ListGroupSnapshots(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGroupSnapshots", reflect.TypeOf((*MockOrchestrator)(nil).ListGroupSnapshots), arg0)
+}
+
 // ListLogLayers mocks base method.
 func (m *MockOrchestrator) ListLogLayers(arg0 context.Context) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -745,6 +924,21 @@ func (mr *MockOrchestratorMockRecorder) ListNodes(arg0 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNodes", reflect.TypeOf((*MockOrchestrator)(nil).ListNodes), arg0)
 }
 
+// ListSnapshotPolicies mocks base method.
+func (m *MockOrchestrator) ListSnapshotPolicies(arg0 context.Context) ([]*storage.SnapshotPolicyExternal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSnapshotPolicies", arg0)
+	ret0, _ := ret[0].([]*storage.SnapshotPolicyExternal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSnapshotPolicies indicates an expected call of ListSnapshotPolicies.
+func (mr *MockOrchestratorMockRecorder) ListSnapshotPolicies(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshotPolicies", reflect.TypeOf((*MockOrchestrator)(nil).ListSnapshotPolicies), arg0)
+}
+
 // ListSnapshots mocks base method.
 func (m *MockOrchestrator) ListSnapshots(arg0 context.Context) ([]*storage.SnapshotExternal, error) {
 	m.ctrl.T.Helper()
@@ -820,6 +1014,21 @@ func (mr *MockOrchestratorMockRecorder) ListSubordinateVolumes(arg0, arg1 interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubordinateVolumes", reflect.TypeOf((*MockOrchestrator)(nil).ListSubordinateVolumes), arg0, arg1)
 }
 
+// ListVolumeHealth mocks base method.
+func (m *MockOrchestrator) ListVolumeHealth(arg0 context.Context) (map[string]*storage.VolumeHealth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVolumeHealth", arg0)
+	ret0, _ := ret[0].(map[string]*storage.VolumeHealth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVolumeHealth indicates an expected call of ListVolumeHealth.
+func (mr *MockOrchestratorMockRecorder) ListVolumeHealth(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumeHealth", reflect.TypeOf((*MockOrchestrator)(nil).ListVolumeHealth), arg0)
+}
+
 // ListVolumePublications mocks base method.
 func (m *MockOrchestrator) ListVolumePublications(arg0 context.Context) ([]*utils.VolumePublicationExternal, error) {
 	m.ctrl.T.Helper()
@@ -880,6 +1089,33 @@ func (mr *MockOrchestratorMockRecorder) ListVolumes(arg0 interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumes", reflect.TypeOf((*MockOrchestrator)(nil).ListVolumes), arg0)
 }
 
+// MoveVolume mocks base method.
+func (m *MockOrchestrator) MoveVolume(arg0 context.Context, arg1, arg2, arg3 string) (*storage.VolumeExternal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveVolume", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*storage.VolumeExternal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveVolume indicates an expected call of MoveVolume.
+func (mr *MockOrchestratorMockRecorder) MoveVolume(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveVolume", reflect.TypeOf((*MockOrchestrator)(nil).MoveVolume), arg0, arg1, arg2, arg3)
+}
+
+// PeriodicallyCheckVolumeHealth mocks base method.
+func (m *MockOrchestrator) PeriodicallyCheckVolumeHealth(arg0 time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PeriodicallyCheckVolumeHealth", arg0)
+}
+
+// PeriodicallyCheckVolumeHealth indicates an expected call of PeriodicallyCheckVolumeHealth.
+func (mr *MockOrchestratorMockRecorder) PeriodicallyCheckVolumeHealth(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PeriodicallyCheckVolumeHealth", reflect.TypeOf((*MockOrchestrator)(nil).PeriodicallyCheckVolumeHealth), arg0)
+}
+
 // PeriodicallyReconcileBackendState mocks base method.
 func (m *MockOrchestrator) PeriodicallyReconcileBackendState(arg0 time.Duration) {
 	m.ctrl.T.Helper()
@@ -904,6 +1140,18 @@ func (mr *MockOrchestratorMockRecorder) PeriodicallyReconcileNodeAccessOnBackend
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PeriodicallyReconcileNodeAccessOnBackends", reflect.TypeOf((*MockOrchestrator)(nil).PeriodicallyReconcileNodeAccessOnBackends))
 }
 
+// PeriodicallyRunSnapshotPolicies mocks base method.
+func (m *MockOrchestrator) PeriodicallyRunSnapshotPolicies(arg0 time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PeriodicallyRunSnapshotPolicies", arg0)
+}
+
+// PeriodicallyRunSnapshotPolicies indicates an expected call of PeriodicallyRunSnapshotPolicies.
+func (mr *MockOrchestratorMockRecorder) PeriodicallyRunSnapshotPolicies(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PeriodicallyRunSnapshotPolicies", reflect.TypeOf((*MockOrchestrator)(nil).PeriodicallyRunSnapshotPolicies), arg0)
+}
+
 // PromoteMirror mocks base method.
 func (m *MockOrchestrator) PromoteMirror(arg0 context.Context, arg1, arg2, arg3, arg4 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -1032,6 +1280,21 @@ func (mr *MockOrchestratorMockRecorder) ResizeVolume(arg0, arg1, arg2 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeVolume", reflect.TypeOf((*MockOrchestrator)(nil).ResizeVolume), arg0, arg1, arg2)
 }
 
+// ResolveOrphans mocks base method.
+func (m *MockOrchestrator) ResolveOrphans(arg0 context.Context, arg1 *storage.ResolveOrphansRequest) (*storage.OrphanReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveOrphans", arg0, arg1)
+	ret0, _ := ret[0].(*storage.OrphanReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveOrphans indicates an expected call of ResolveOrphans.
+func (mr *MockOrchestratorMockRecorder) ResolveOrphans(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveOrphans", reflect.TypeOf((*MockOrchestrator)(nil).ResolveOrphans), arg0, arg1)
+}
+
 // RestoreSnapshot mocks base method.
 func (m *MockOrchestrator) RestoreSnapshot(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -1102,6 +1365,21 @@ func (mr *MockOrchestratorMockRecorder) SetVolumeState(arg0, arg1, arg2 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetVolumeState", reflect.TypeOf((*MockOrchestrator)(nil).SetVolumeState), arg0, arg1, arg2)
 }
 
+// SubscribeEvents mocks base method.
+func (m *MockOrchestrator) SubscribeEvents(arg0 context.Context, arg1 uint64) (*core.EventSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeEvents", arg0, arg1)
+	ret0, _ := ret[0].(*core.EventSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeEvents indicates an expected call of SubscribeEvents.
+func (mr *MockOrchestratorMockRecorder) SubscribeEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeEvents", reflect.TypeOf((*MockOrchestrator)(nil).SubscribeEvents), arg0, arg1)
+}
+
 // UnpublishVolume mocks base method.
 func (m *MockOrchestrator) UnpublishVolume(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -1189,6 +1467,21 @@ func (mr *MockOrchestratorMockRecorder) UpdateNode(arg0, arg1, arg2 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNode", reflect.TypeOf((*MockOrchestrator)(nil).UpdateNode), arg0, arg1, arg2)
 }
 
+// UpdateSnapshotPolicy mocks base method.
+func (m *MockOrchestrator) UpdateSnapshotPolicy(arg0 context.Context, arg1 *storage.SnapshotPolicyConfig) (*storage.SnapshotPolicyExternal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSnapshotPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*storage.SnapshotPolicyExternal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSnapshotPolicy indicates an expected call of UpdateSnapshotPolicy.
+func (mr *MockOrchestratorMockRecorder) UpdateSnapshotPolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSnapshotPolicy", reflect.TypeOf((*MockOrchestrator)(nil).UpdateSnapshotPolicy), arg0, arg1)
+}
+
 // UpdateVolume mocks base method.
 func (m *MockOrchestrator) UpdateVolume(arg0 context.Context, arg1 string, arg2 *utils.VolumeUpdateInfo) error {
 	m.ctrl.T.Helper()
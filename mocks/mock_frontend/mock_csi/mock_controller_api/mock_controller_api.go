@@ -11,6 +11,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	controllerAPI "github.com/netapp/trident/frontend/csi/controller_api"
+	v1 "github.com/netapp/trident/frontend/csi/controller_api/v1"
 	utils "github.com/netapp/trident/utils"
 )
 
@@ -81,6 +82,23 @@ func (mr *MockTridentControllerMockRecorder) GetChap(arg0, arg1, arg2 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChap", reflect.TypeOf((*MockTridentController)(nil).GetChap), arg0, arg1, arg2)
 }
 
+// GetLoggingConfig mocks base method.
+func (m *MockTridentController) GetLoggingConfig(arg0 context.Context) (string, string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoggingConfig", arg0)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(string)
+	ret3, _ := ret[3].(error)
+	return ret0, ret1, ret2, ret3
+}
+
+// GetLoggingConfig indicates an expected call of GetLoggingConfig.
+func (mr *MockTridentControllerMockRecorder) GetLoggingConfig(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoggingConfig", reflect.TypeOf((*MockTridentController)(nil).GetLoggingConfig), arg0)
+}
+
 // GetNode mocks base method.
 func (m *MockTridentController) GetNode(arg0 context.Context, arg1 string) (*utils.NodeExternal, error) {
 	m.ctrl.T.Helper()
@@ -142,6 +160,34 @@ func (mr *MockTridentControllerMockRecorder) ListVolumePublicationsForNode(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumePublicationsForNode", reflect.TypeOf((*MockTridentController)(nil).ListVolumePublicationsForNode), arg0, arg1)
 }
 
+// ReportFreeze mocks base method.
+func (m *MockTridentController) ReportFreeze(arg0 context.Context, arg1 *v1.ReportFreezeRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReportFreeze", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReportFreeze indicates an expected call of ReportFreeze.
+func (mr *MockTridentControllerMockRecorder) ReportFreeze(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportFreeze", reflect.TypeOf((*MockTridentController)(nil).ReportFreeze), arg0, arg1)
+}
+
+// ReportVolumeUsage mocks base method.
+func (m *MockTridentController) ReportVolumeUsage(arg0 context.Context, arg1 *v1.ReportVolumeUsageRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReportVolumeUsage", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReportVolumeUsage indicates an expected call of ReportVolumeUsage.
+func (mr *MockTridentControllerMockRecorder) ReportVolumeUsage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportVolumeUsage", reflect.TypeOf((*MockTridentController)(nil).ReportVolumeUsage), arg0, arg1)
+}
+
 // UpdateNode mocks base method.
 func (m *MockTridentController) UpdateNode(arg0 context.Context, arg1 string, arg2 *utils.NodePublicationStateFlags) error {
 	m.ctrl.T.Helper()
@@ -169,3 +215,18 @@ func (mr *MockTridentControllerMockRecorder) UpdateVolumeLUKSPassphraseNames(arg
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVolumeLUKSPassphraseNames", reflect.TypeOf((*MockTridentController)(nil).UpdateVolumeLUKSPassphraseNames), arg0, arg1, arg2)
 }
+
+// WatchNode mocks base method.
+func (m *MockTridentController) WatchNode(arg0 context.Context, arg1 string) (<-chan *v1.NodeEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchNode", arg0, arg1)
+	ret0, _ := ret[0].(<-chan *v1.NodeEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchNode indicates an expected call of WatchNode.
+func (mr *MockTridentControllerMockRecorder) WatchNode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchNode", reflect.TypeOf((*MockTridentController)(nil).WatchNode), arg0, arg1)
+}
@@ -12,6 +12,7 @@ import (
 	config "github.com/netapp/trident/config"
 	controllerhelpers "github.com/netapp/trident/frontend/csi/controller_helpers"
 	storage "github.com/netapp/trident/storage"
+	storageclass "github.com/netapp/trident/storage_class"
 	utils "github.com/netapp/trident/utils"
 )
 
@@ -98,6 +99,21 @@ func (mr *MockControllerHelperMockRecorder) GetSnapshotConfigForImport(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshotConfigForImport", reflect.TypeOf((*MockControllerHelper)(nil).GetSnapshotConfigForImport), arg0, arg1, arg2)
 }
 
+// GetStorageClassConfig mocks base method.
+func (m *MockControllerHelper) GetStorageClassConfig(arg0 context.Context, arg1 map[string]string) (*storageclass.Config, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageClassConfig", arg0, arg1)
+	ret0, _ := ret[0].(*storageclass.Config)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageClassConfig indicates an expected call of GetStorageClassConfig.
+func (mr *MockControllerHelperMockRecorder) GetStorageClassConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageClassConfig", reflect.TypeOf((*MockControllerHelper)(nil).GetStorageClassConfig), arg0, arg1)
+}
+
 // GetVolumeConfig mocks base method.
 func (m *MockControllerHelper) GetVolumeConfig(arg0 context.Context, arg1 string, arg2 int64, arg3 map[string]string, arg4 config.Protocol, arg5 []config.AccessMode, arg6 config.VolumeMode, arg7 string, arg8, arg9, arg10 []map[string]string) (*storage.VolumeConfig, error) {
 	m.ctrl.T.Helper()
@@ -53,6 +53,20 @@ func (mr *MockStoreClientMockRecorder) AddBackend(arg0, arg1 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBackend", reflect.TypeOf((*MockStoreClient)(nil).AddBackend), arg0, arg1)
 }
 
+// AddGroupSnapshot mocks base method.
+func (m *MockStoreClient) AddGroupSnapshot(arg0 context.Context, arg1 *storage.GroupSnapshot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGroupSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddGroupSnapshot indicates an expected call of AddGroupSnapshot.
+func (mr *MockStoreClientMockRecorder) AddGroupSnapshot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGroupSnapshot", reflect.TypeOf((*MockStoreClient)(nil).AddGroupSnapshot), arg0, arg1)
+}
+
 // AddOrUpdateNode mocks base method.
 func (m *MockStoreClient) AddOrUpdateNode(arg0 context.Context, arg1 *utils.Node) error {
 	m.ctrl.T.Helper()
@@ -81,6 +95,20 @@ func (mr *MockStoreClientMockRecorder) AddSnapshot(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSnapshot", reflect.TypeOf((*MockStoreClient)(nil).AddSnapshot), arg0, arg1)
 }
 
+// AddSnapshotPolicy mocks base method.
+func (m *MockStoreClient) AddSnapshotPolicy(arg0 context.Context, arg1 *storage.SnapshotPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSnapshotPolicy", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSnapshotPolicy indicates an expected call of AddSnapshotPolicy.
+func (mr *MockStoreClientMockRecorder) AddSnapshotPolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSnapshotPolicy", reflect.TypeOf((*MockStoreClient)(nil).AddSnapshotPolicy), arg0, arg1)
+}
+
 // AddStorageClass mocks base method.
 func (m *MockStoreClient) AddStorageClass(arg0 context.Context, arg1 *storageclass.StorageClass) error {
 	m.ctrl.T.Helper()
@@ -165,6 +193,20 @@ func (mr *MockStoreClientMockRecorder) DeleteBackends(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBackends", reflect.TypeOf((*MockStoreClient)(nil).DeleteBackends), arg0)
 }
 
+// DeleteGroupSnapshot mocks base method.
+func (m *MockStoreClient) DeleteGroupSnapshot(arg0 context.Context, arg1 *storage.GroupSnapshot) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGroupSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGroupSnapshot indicates an expected call of DeleteGroupSnapshot.
+func (mr *MockStoreClientMockRecorder) DeleteGroupSnapshot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroupSnapshot", reflect.TypeOf((*MockStoreClient)(nil).DeleteGroupSnapshot), arg0, arg1)
+}
+
 // DeleteNode mocks base method.
 func (m *MockStoreClient) DeleteNode(arg0 context.Context, arg1 *utils.Node) error {
 	m.ctrl.T.Helper()
@@ -193,6 +235,20 @@ func (mr *MockStoreClientMockRecorder) DeleteSnapshot(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshot", reflect.TypeOf((*MockStoreClient)(nil).DeleteSnapshot), arg0, arg1)
 }
 
+// DeleteSnapshotPolicy mocks base method.
+func (m *MockStoreClient) DeleteSnapshotPolicy(arg0 context.Context, arg1 *storage.SnapshotPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSnapshotPolicy", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSnapshotPolicy indicates an expected call of DeleteSnapshotPolicy.
+func (mr *MockStoreClientMockRecorder) DeleteSnapshotPolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshotPolicy", reflect.TypeOf((*MockStoreClient)(nil).DeleteSnapshotPolicy), arg0, arg1)
+}
+
 // DeleteSnapshots mocks base method.
 func (m *MockStoreClient) DeleteSnapshots(arg0 context.Context) error {
 	m.ctrl.T.Helper()
@@ -336,6 +392,36 @@ func (mr *MockStoreClientMockRecorder) GetConfig() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfig", reflect.TypeOf((*MockStoreClient)(nil).GetConfig))
 }
 
+// GetGroupSnapshot mocks base method.
+func (m *MockStoreClient) GetGroupSnapshot(arg0 context.Context, arg1 string) (*storage.GroupSnapshotPersistent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(*storage.GroupSnapshotPersistent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupSnapshot indicates an expected call of GetGroupSnapshot.
+func (mr *MockStoreClientMockRecorder) GetGroupSnapshot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupSnapshot", reflect.TypeOf((*MockStoreClient)(nil).GetGroupSnapshot), arg0, arg1)
+}
+
+// GetGroupSnapshots mocks base method.
+func (m *MockStoreClient) GetGroupSnapshots(arg0 context.Context) ([]*storage.GroupSnapshotPersistent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupSnapshots", arg0)
+	ret0, _ := ret[0].([]*storage.GroupSnapshotPersistent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroupSnapshots indicates an expected call of GetGroupSnapshots.
+func (mr *MockStoreClientMockRecorder) GetGroupSnapshots(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupSnapshots", reflect.TypeOf((*MockStoreClient)(nil).GetGroupSnapshots), arg0)
+}
+
 // GetNode mocks base method.
 func (m *MockStoreClient) GetNode(arg0 context.Context, arg1 string) (*utils.Node, error) {
 	m.ctrl.T.Helper()
@@ -381,6 +467,36 @@ func (mr *MockStoreClientMockRecorder) GetSnapshot(arg0, arg1, arg2 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshot", reflect.TypeOf((*MockStoreClient)(nil).GetSnapshot), arg0, arg1, arg2)
 }
 
+// GetSnapshotPolicies mocks base method.
+func (m *MockStoreClient) GetSnapshotPolicies(arg0 context.Context) ([]*storage.SnapshotPolicyPersistent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnapshotPolicies", arg0)
+	ret0, _ := ret[0].([]*storage.SnapshotPolicyPersistent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSnapshotPolicies indicates an expected call of GetSnapshotPolicies.
+func (mr *MockStoreClientMockRecorder) GetSnapshotPolicies(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshotPolicies", reflect.TypeOf((*MockStoreClient)(nil).GetSnapshotPolicies), arg0)
+}
+
+// GetSnapshotPolicy mocks base method.
+func (m *MockStoreClient) GetSnapshotPolicy(arg0 context.Context, arg1 string) (*storage.SnapshotPolicyPersistent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSnapshotPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*storage.SnapshotPolicyPersistent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSnapshotPolicy indicates an expected call of GetSnapshotPolicy.
+func (mr *MockStoreClientMockRecorder) GetSnapshotPolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshotPolicy", reflect.TypeOf((*MockStoreClient)(nil).GetSnapshotPolicy), arg0, arg1)
+}
+
 // GetSnapshots mocks base method.
 func (m *MockStoreClient) GetSnapshots(arg0 context.Context) ([]*storage.SnapshotPersistent, error) {
 	m.ctrl.T.Helper()
@@ -644,6 +760,20 @@ func (mr *MockStoreClientMockRecorder) UpdateSnapshot(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSnapshot", reflect.TypeOf((*MockStoreClient)(nil).UpdateSnapshot), arg0, arg1)
 }
 
+// UpdateSnapshotPolicy mocks base method.
+func (m *MockStoreClient) UpdateSnapshotPolicy(arg0 context.Context, arg1 *storage.SnapshotPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSnapshotPolicy", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSnapshotPolicy indicates an expected call of UpdateSnapshotPolicy.
+func (mr *MockStoreClientMockRecorder) UpdateSnapshotPolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSnapshotPolicy", reflect.TypeOf((*MockStoreClient)(nil).UpdateSnapshotPolicy), arg0, arg1)
+}
+
 // UpdateVolume mocks base method.
 func (m *MockStoreClient) UpdateVolume(arg0 context.Context, arg1 *storage.Volume) error {
 	m.ctrl.T.Helper()
@@ -40,6 +40,18 @@ func (m *MockBackend) EXPECT() *MockBackendMockRecorder {
 	return m.recorder
 }
 
+// AddCachedVolume mocks base method.
+func (m *MockBackend) AddCachedVolume(arg0 *storage.Volume) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddCachedVolume", arg0)
+}
+
+// AddCachedVolume indicates an expected call of AddCachedVolume.
+func (mr *MockBackendMockRecorder) AddCachedVolume(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCachedVolume", reflect.TypeOf((*MockBackend)(nil).AddCachedVolume), arg0)
+}
+
 // AddStoragePool mocks base method.
 func (m *MockBackend) AddStoragePool(arg0 storage.Pool) {
 	m.ctrl.T.Helper()
@@ -209,6 +221,21 @@ func (mr *MockBackendMockRecorder) ConstructPersistent(arg0 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConstructPersistent", reflect.TypeOf((*MockBackend)(nil).ConstructPersistent), arg0)
 }
 
+// CreateGroupSnapshot mocks base method.
+func (m *MockBackend) CreateGroupSnapshot(arg0 context.Context, arg1 *storage.GroupSnapshotConfig, arg2 []*storage.SnapshotConfig, arg3 []*storage.VolumeConfig) ([]*storage.Snapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGroupSnapshot", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*storage.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGroupSnapshot indicates an expected call of CreateGroupSnapshot.
+func (mr *MockBackendMockRecorder) CreateGroupSnapshot(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroupSnapshot", reflect.TypeOf((*MockBackend)(nil).CreateGroupSnapshot), arg0, arg1, arg2, arg3)
+}
+
 // CreateSnapshot mocks base method.
 func (m *MockBackend) CreateSnapshot(arg0 context.Context, arg1 *storage.SnapshotConfig, arg2 *storage.VolumeConfig) (*storage.Snapshot, error) {
 	m.ctrl.T.Helper()
@@ -353,6 +380,21 @@ func (mr *MockBackendMockRecorder) GetPhysicalPoolNames(arg0 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPhysicalPoolNames", reflect.TypeOf((*MockBackend)(nil).GetPhysicalPoolNames), arg0)
 }
 
+// GetPoolCapacity mocks base method.
+func (m *MockBackend) GetPoolCapacity(arg0 context.Context, arg1 storage.Pool) (*storage.PoolCapacity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPoolCapacity", arg0, arg1)
+	ret0, _ := ret[0].(*storage.PoolCapacity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPoolCapacity indicates an expected call of GetPoolCapacity.
+func (mr *MockBackendMockRecorder) GetPoolCapacity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPoolCapacity", reflect.TypeOf((*MockBackend)(nil).GetPoolCapacity), arg0, arg1)
+}
+
 // GetProtocol mocks base method.
 func (m *MockBackend) GetProtocol(arg0 context.Context) config.Protocol {
 	m.ctrl.T.Helper()
@@ -426,6 +468,21 @@ func (mr *MockBackendMockRecorder) GetVolumeForImport(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeForImport", reflect.TypeOf((*MockBackend)(nil).GetVolumeForImport), arg0, arg1)
 }
 
+// GetVolumeHealth mocks base method.
+func (m *MockBackend) GetVolumeHealth(arg0 context.Context, arg1 *storage.VolumeConfig) (*storage.VolumeHealth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeHealth", arg0, arg1)
+	ret0, _ := ret[0].(*storage.VolumeHealth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeHealth indicates an expected call of GetVolumeHealth.
+func (mr *MockBackendMockRecorder) GetVolumeHealth(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeHealth", reflect.TypeOf((*MockBackend)(nil).GetVolumeHealth), arg0, arg1)
+}
+
 // HasVolumes mocks base method.
 func (m *MockBackend) HasVolumes() bool {
 	m.ctrl.T.Helper()
@@ -481,6 +538,20 @@ func (mr *MockBackendMockRecorder) IsCredentialsFieldSet(arg0 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsCredentialsFieldSet", reflect.TypeOf((*MockBackend)(nil).IsCredentialsFieldSet), arg0)
 }
 
+// MoveVolume mocks base method.
+func (m *MockBackend) MoveVolume(arg0 context.Context, arg1 *storage.VolumeConfig, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveVolume", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveVolume indicates an expected call of MoveVolume.
+func (mr *MockBackendMockRecorder) MoveVolume(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveVolume", reflect.TypeOf((*MockBackend)(nil).MoveVolume), arg0, arg1, arg2)
+}
+
 // Name mocks base method.
 func (m *MockBackend) Name() string {
 	m.ctrl.T.Helper()
@@ -314,6 +314,20 @@ func (mr *MockAzureMockRecorder) ModifyVolume(arg0, arg1, arg2, arg3, arg4, arg5
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyVolume", reflect.TypeOf((*MockAzure)(nil).ModifyVolume), arg0, arg1, arg2, arg3, arg4, arg5)
 }
 
+// MoveVolume mocks base method.
+func (m *MockAzure) MoveVolume(arg0 context.Context, arg1 *api.FileSystem, arg2 *api.CapacityPool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveVolume", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveVolume indicates an expected call of MoveVolume.
+func (mr *MockAzureMockRecorder) MoveVolume(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveVolume", reflect.TypeOf((*MockAzure)(nil).MoveVolume), arg0, arg1, arg2)
+}
+
 // RandomSubnetForStoragePool mocks base method.
 func (m *MockAzure) RandomSubnetForStoragePool(arg0 context.Context, arg1 storage.Pool) *api.Subnet {
 	m.ctrl.T.Helper()
@@ -674,6 +688,21 @@ func (mr *MockAzureMockRecorder) Volumes(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Volumes", reflect.TypeOf((*MockAzure)(nil).Volumes), arg0)
 }
 
+// VolumesForCapacityPool mocks base method.
+func (m *MockAzure) VolumesForCapacityPool(arg0 context.Context, arg1 *api.CapacityPool) (*[]*api.FileSystem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumesForCapacityPool", arg0, arg1)
+	ret0, _ := ret[0].(*[]*api.FileSystem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VolumesForCapacityPool indicates an expected call of VolumesForCapacityPool.
+func (mr *MockAzureMockRecorder) VolumesForCapacityPool(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumesForCapacityPool", reflect.TypeOf((*MockAzure)(nil).VolumesForCapacityPool), arg0, arg1)
+}
+
 // WaitForSnapshotState mocks base method.
 func (m *MockAzure) WaitForSnapshotState(arg0 context.Context, arg1 *api.Snapshot, arg2 *api.FileSystem, arg3 string, arg4 []string, arg5 time.Duration) error {
 	m.ctrl.T.Helper()
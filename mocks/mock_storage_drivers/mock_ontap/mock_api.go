@@ -51,6 +51,20 @@ func (mr *MockOntapAPIMockRecorder) APIVersion(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APIVersion", reflect.TypeOf((*MockOntapAPI)(nil).APIVersion), arg0)
 }
 
+// ConsistencyGroupSnapshot mocks base method.
+func (m *MockOntapAPI) ConsistencyGroupSnapshot(arg0 context.Context, arg1 string, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsistencyGroupSnapshot", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConsistencyGroupSnapshot indicates an expected call of ConsistencyGroupSnapshot.
+func (mr *MockOntapAPIMockRecorder) ConsistencyGroupSnapshot(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsistencyGroupSnapshot", reflect.TypeOf((*MockOntapAPI)(nil).ConsistencyGroupSnapshot), arg0, arg1, arg2)
+}
+
 // EmsAutosupportLog mocks base method.
 func (m *MockOntapAPI) EmsAutosupportLog(arg0 context.Context, arg1, arg2 string, arg3 bool, arg4, arg5, arg6 string, arg7 int, arg8 string, arg9 int) {
 	m.ctrl.T.Helper()
@@ -1314,6 +1328,20 @@ func (mr *MockOntapAPIMockRecorder) QtreeModifyExportPolicy(arg0, arg1, arg2, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QtreeModifyExportPolicy", reflect.TypeOf((*MockOntapAPI)(nil).QtreeModifyExportPolicy), arg0, arg1, arg2, arg3)
 }
 
+// QtreeModifyQosPolicy mocks base method.
+func (m *MockOntapAPI) QtreeModifyQosPolicy(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QtreeModifyQosPolicy", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// QtreeModifyQosPolicy indicates an expected call of QtreeModifyQosPolicy.
+func (mr *MockOntapAPIMockRecorder) QtreeModifyQosPolicy(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QtreeModifyQosPolicy", reflect.TypeOf((*MockOntapAPI)(nil).QtreeModifyQosPolicy), arg0, arg1, arg2, arg3)
+}
+
 // QtreeRename mocks base method.
 func (m *MockOntapAPI) QtreeRename(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -1885,6 +1913,34 @@ func (mr *MockOntapAPIMockRecorder) VolumeModifySnapshotDirectoryAccess(arg0, ar
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeModifySnapshotDirectoryAccess", reflect.TypeOf((*MockOntapAPI)(nil).VolumeModifySnapshotDirectoryAccess), arg0, arg1, arg2)
 }
 
+// VolumeModifySnapshotPolicy mocks base method.
+func (m *MockOntapAPI) VolumeModifySnapshotPolicy(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeModifySnapshotPolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VolumeModifySnapshotPolicy indicates an expected call of VolumeModifySnapshotPolicy.
+func (mr *MockOntapAPIMockRecorder) VolumeModifySnapshotPolicy(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeModifySnapshotPolicy", reflect.TypeOf((*MockOntapAPI)(nil).VolumeModifySnapshotPolicy), arg0, arg1, arg2)
+}
+
+// VolumeModifyTieringPolicy mocks base method.
+func (m *MockOntapAPI) VolumeModifyTieringPolicy(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeModifyTieringPolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VolumeModifyTieringPolicy indicates an expected call of VolumeModifyTieringPolicy.
+func (mr *MockOntapAPIMockRecorder) VolumeModifyTieringPolicy(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeModifyTieringPolicy", reflect.TypeOf((*MockOntapAPI)(nil).VolumeModifyTieringPolicy), arg0, arg1, arg2)
+}
+
 // VolumeModifyUnixPermissions mocks base method.
 func (m *MockOntapAPI) VolumeModifyUnixPermissions(arg0 context.Context, arg1, arg2, arg3 string) error {
 	m.ctrl.T.Helper()
@@ -1913,6 +1969,20 @@ func (mr *MockOntapAPIMockRecorder) VolumeMount(arg0, arg1, arg2 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeMount", reflect.TypeOf((*MockOntapAPI)(nil).VolumeMount), arg0, arg1, arg2)
 }
 
+// VolumeMove mocks base method.
+func (m *MockOntapAPI) VolumeMove(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeMove", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VolumeMove indicates an expected call of VolumeMove.
+func (mr *MockOntapAPIMockRecorder) VolumeMove(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeMove", reflect.TypeOf((*MockOntapAPI)(nil).VolumeMove), arg0, arg1, arg2)
+}
+
 // VolumeRename mocks base method.
 func (m *MockOntapAPI) VolumeRename(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -88,6 +88,63 @@ func (mr *MockRestClientInterfaceMockRecorder) ClusterInfo(arg0 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterInfo", reflect.TypeOf((*MockRestClientInterface)(nil).ClusterInfo), arg0)
 }
 
+// ConsistencyGroupCreateAndWait mocks base method.
+func (m *MockRestClientInterface) ConsistencyGroupCreateAndWait(arg0 context.Context, arg1 string, arg2 []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsistencyGroupCreateAndWait", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConsistencyGroupCreateAndWait indicates an expected call of ConsistencyGroupCreateAndWait.
+func (mr *MockRestClientInterfaceMockRecorder) ConsistencyGroupCreateAndWait(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsistencyGroupCreateAndWait", reflect.TypeOf((*MockRestClientInterface)(nil).ConsistencyGroupCreateAndWait), arg0, arg1, arg2)
+}
+
+// ConsistencyGroupDeleteAndWait mocks base method.
+func (m *MockRestClientInterface) ConsistencyGroupDeleteAndWait(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsistencyGroupDeleteAndWait", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConsistencyGroupDeleteAndWait indicates an expected call of ConsistencyGroupDeleteAndWait.
+func (mr *MockRestClientInterfaceMockRecorder) ConsistencyGroupDeleteAndWait(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsistencyGroupDeleteAndWait", reflect.TypeOf((*MockRestClientInterface)(nil).ConsistencyGroupDeleteAndWait), arg0, arg1)
+}
+
+// ConsistencyGroupGetByName mocks base method.
+func (m *MockRestClientInterface) ConsistencyGroupGetByName(arg0 context.Context, arg1 string) (*models.ConsistencyGroupResponseInlineRecordsInlineArrayItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsistencyGroupGetByName", arg0, arg1)
+	ret0, _ := ret[0].(*models.ConsistencyGroupResponseInlineRecordsInlineArrayItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsistencyGroupGetByName indicates an expected call of ConsistencyGroupGetByName.
+func (mr *MockRestClientInterfaceMockRecorder) ConsistencyGroupGetByName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsistencyGroupGetByName", reflect.TypeOf((*MockRestClientInterface)(nil).ConsistencyGroupGetByName), arg0, arg1)
+}
+
+// ConsistencyGroupSnapshotCreateAndWait mocks base method.
+func (m *MockRestClientInterface) ConsistencyGroupSnapshotCreateAndWait(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsistencyGroupSnapshotCreateAndWait", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConsistencyGroupSnapshotCreateAndWait indicates an expected call of ConsistencyGroupSnapshotCreateAndWait.
+func (mr *MockRestClientInterfaceMockRecorder) ConsistencyGroupSnapshotCreateAndWait(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsistencyGroupSnapshotCreateAndWait", reflect.TypeOf((*MockRestClientInterface)(nil).ConsistencyGroupSnapshotCreateAndWait), arg0, arg1, arg2)
+}
+
 // EmsAutosupportLog mocks base method.
 func (m *MockRestClientInterface) EmsAutosupportLog(arg0 context.Context, arg1 string, arg2 bool, arg3, arg4, arg5 string, arg6 int, arg7 string, arg8 int) error {
 	m.ctrl.T.Helper()
@@ -1493,6 +1550,20 @@ func (mr *MockRestClientInterfaceMockRecorder) QtreeModifyExportPolicy(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QtreeModifyExportPolicy", reflect.TypeOf((*MockRestClientInterface)(nil).QtreeModifyExportPolicy), arg0, arg1, arg2, arg3)
 }
 
+// QtreeModifyQosPolicy mocks base method.
+func (m *MockRestClientInterface) QtreeModifyQosPolicy(arg0 context.Context, arg1, arg2, arg3 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QtreeModifyQosPolicy", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// QtreeModifyQosPolicy indicates an expected call of QtreeModifyQosPolicy.
+func (mr *MockRestClientInterfaceMockRecorder) QtreeModifyQosPolicy(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QtreeModifyQosPolicy", reflect.TypeOf((*MockRestClientInterface)(nil).QtreeModifyQosPolicy), arg0, arg1, arg2, arg3)
+}
+
 // QtreeRename mocks base method.
 func (m *MockRestClientInterface) QtreeRename(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -2312,6 +2383,34 @@ func (mr *MockRestClientInterfaceMockRecorder) VolumeModifySnapshotDirectoryAcce
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeModifySnapshotDirectoryAccess", reflect.TypeOf((*MockRestClientInterface)(nil).VolumeModifySnapshotDirectoryAccess), arg0, arg1, arg2)
 }
 
+// VolumeModifySnapshotPolicy mocks base method.
+func (m *MockRestClientInterface) VolumeModifySnapshotPolicy(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeModifySnapshotPolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VolumeModifySnapshotPolicy indicates an expected call of VolumeModifySnapshotPolicy.
+func (mr *MockRestClientInterfaceMockRecorder) VolumeModifySnapshotPolicy(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeModifySnapshotPolicy", reflect.TypeOf((*MockRestClientInterface)(nil).VolumeModifySnapshotPolicy), arg0, arg1, arg2)
+}
+
+// VolumeModifyTieringPolicy mocks base method.
+func (m *MockRestClientInterface) VolumeModifyTieringPolicy(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeModifyTieringPolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VolumeModifyTieringPolicy indicates an expected call of VolumeModifyTieringPolicy.
+func (mr *MockRestClientInterfaceMockRecorder) VolumeModifyTieringPolicy(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeModifyTieringPolicy", reflect.TypeOf((*MockRestClientInterface)(nil).VolumeModifyTieringPolicy), arg0, arg1, arg2)
+}
+
 // VolumeModifyUnixPermissions mocks base method.
 func (m *MockRestClientInterface) VolumeModifyUnixPermissions(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
@@ -2340,6 +2439,20 @@ func (mr *MockRestClientInterfaceMockRecorder) VolumeMount(arg0, arg1, arg2 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeMount", reflect.TypeOf((*MockRestClientInterface)(nil).VolumeMount), arg0, arg1, arg2)
 }
 
+// VolumeMoveStart mocks base method.
+func (m *MockRestClientInterface) VolumeMoveStart(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeMoveStart", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VolumeMoveStart indicates an expected call of VolumeMoveStart.
+func (mr *MockRestClientInterfaceMockRecorder) VolumeMoveStart(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeMoveStart", reflect.TypeOf((*MockRestClientInterface)(nil).VolumeMoveStart), arg0, arg1, arg2)
+}
+
 // VolumeRename mocks base method.
 func (m *MockRestClientInterface) VolumeRename(arg0 context.Context, arg1, arg2 string) error {
 	m.ctrl.T.Helper()
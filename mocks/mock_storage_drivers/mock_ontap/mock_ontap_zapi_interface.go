@@ -81,6 +81,36 @@ func (mr *MockZapiClientInterfaceMockRecorder) ClientConfig() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClientConfig", reflect.TypeOf((*MockZapiClientInterface)(nil).ClientConfig))
 }
 
+// ConsistencyGroupCommit mocks base method.
+func (m *MockZapiClientInterface) ConsistencyGroupCommit(arg0 int) (*azgo.CgCommitResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsistencyGroupCommit", arg0)
+	ret0, _ := ret[0].(*azgo.CgCommitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsistencyGroupCommit indicates an expected call of ConsistencyGroupCommit.
+func (mr *MockZapiClientInterfaceMockRecorder) ConsistencyGroupCommit(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsistencyGroupCommit", reflect.TypeOf((*MockZapiClientInterface)(nil).ConsistencyGroupCommit), arg0)
+}
+
+// ConsistencyGroupStart mocks base method.
+func (m *MockZapiClientInterface) ConsistencyGroupStart(arg0 string, arg1 []string) (*azgo.CgStartResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsistencyGroupStart", arg0, arg1)
+	ret0, _ := ret[0].(*azgo.CgStartResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsistencyGroupStart indicates an expected call of ConsistencyGroupStart.
+func (mr *MockZapiClientInterfaceMockRecorder) ConsistencyGroupStart(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsistencyGroupStart", reflect.TypeOf((*MockZapiClientInterface)(nil).ConsistencyGroupStart), arg0, arg1)
+}
+
 // EmsAutosupportLog mocks base method.
 func (m *MockZapiClientInterface) EmsAutosupportLog(arg0 string, arg1 bool, arg2, arg3, arg4 string, arg5 int, arg6 string, arg7 int) (*azgo.EmsAutosupportLogResponse, error) {
 	m.ctrl.T.Helper()
@@ -1310,6 +1340,21 @@ func (mr *MockZapiClientInterfaceMockRecorder) QtreeModifyExportPolicy(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QtreeModifyExportPolicy", reflect.TypeOf((*MockZapiClientInterface)(nil).QtreeModifyExportPolicy), arg0, arg1, arg2)
 }
 
+// QtreeModifyQosPolicy mocks base method.
+func (m *MockZapiClientInterface) QtreeModifyQosPolicy(arg0, arg1, arg2 string) (*azgo.QtreeModifyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QtreeModifyQosPolicy", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*azgo.QtreeModifyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QtreeModifyQosPolicy indicates an expected call of QtreeModifyQosPolicy.
+func (mr *MockZapiClientInterfaceMockRecorder) QtreeModifyQosPolicy(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QtreeModifyQosPolicy", reflect.TypeOf((*MockZapiClientInterface)(nil).QtreeModifyQosPolicy), arg0, arg1, arg2)
+}
+
 // QtreeRename mocks base method.
 func (m *MockZapiClientInterface) QtreeRename(arg0, arg1 string) (*azgo.QtreeRenameResponse, error) {
 	m.ctrl.T.Helper()
@@ -2138,6 +2183,36 @@ func (mr *MockZapiClientInterfaceMockRecorder) VolumeModifySnapshotDirectoryAcce
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeModifySnapshotDirectoryAccess", reflect.TypeOf((*MockZapiClientInterface)(nil).VolumeModifySnapshotDirectoryAccess), arg0, arg1)
 }
 
+// VolumeModifySnapshotPolicy mocks base method.
+func (m *MockZapiClientInterface) VolumeModifySnapshotPolicy(arg0, arg1 string) (*azgo.VolumeModifyIterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeModifySnapshotPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*azgo.VolumeModifyIterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VolumeModifySnapshotPolicy indicates an expected call of VolumeModifySnapshotPolicy.
+func (mr *MockZapiClientInterfaceMockRecorder) VolumeModifySnapshotPolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeModifySnapshotPolicy", reflect.TypeOf((*MockZapiClientInterface)(nil).VolumeModifySnapshotPolicy), arg0, arg1)
+}
+
+// VolumeModifyTieringPolicy mocks base method.
+func (m *MockZapiClientInterface) VolumeModifyTieringPolicy(arg0, arg1 string) (*azgo.VolumeModifyIterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeModifyTieringPolicy", arg0, arg1)
+	ret0, _ := ret[0].(*azgo.VolumeModifyIterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VolumeModifyTieringPolicy indicates an expected call of VolumeModifyTieringPolicy.
+func (mr *MockZapiClientInterfaceMockRecorder) VolumeModifyTieringPolicy(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeModifyTieringPolicy", reflect.TypeOf((*MockZapiClientInterface)(nil).VolumeModifyTieringPolicy), arg0, arg1)
+}
+
 // VolumeModifyUnixPermissions mocks base method.
 func (m *MockZapiClientInterface) VolumeModifyUnixPermissions(arg0, arg1 string) (*azgo.VolumeModifyIterResponse, error) {
 	m.ctrl.T.Helper()
@@ -2168,6 +2243,21 @@ func (mr *MockZapiClientInterfaceMockRecorder) VolumeMount(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeMount", reflect.TypeOf((*MockZapiClientInterface)(nil).VolumeMount), arg0, arg1)
 }
 
+// VolumeMoveStart mocks base method.
+func (m *MockZapiClientInterface) VolumeMoveStart(arg0, arg1 string) (*azgo.VolumeMoveStartResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeMoveStart", arg0, arg1)
+	ret0, _ := ret[0].(*azgo.VolumeMoveStartResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VolumeMoveStart indicates an expected call of VolumeMoveStart.
+func (mr *MockZapiClientInterfaceMockRecorder) VolumeMoveStart(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeMoveStart", reflect.TypeOf((*MockZapiClientInterface)(nil).VolumeMoveStart), arg0, arg1)
+}
+
 // VolumeOffline mocks base method.
 func (m *MockZapiClientInterface) VolumeOffline(arg0 string) (*azgo.VolumeOfflineResponse, error) {
 	m.ctrl.T.Helper()
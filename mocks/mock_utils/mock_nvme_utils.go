@@ -135,9 +135,11 @@ func (mr *MockNVMeInterfaceMockRecorder) PopulateCurrentNVMeSessions(arg0, arg1
 }
 
 // RectifyNVMeSession mocks base method.
-func (m *MockNVMeInterface) RectifyNVMeSession(arg0 context.Context, arg1 utils.NVMeSubsystem, arg2 *utils.NVMeSessions) {
+func (m *MockNVMeInterface) RectifyNVMeSession(arg0 context.Context, arg1 utils.NVMeSubsystem, arg2 *utils.NVMeSessions) error {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "RectifyNVMeSession", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "RectifyNVMeSession", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
 // RectifyNVMeSession indicates an expected call of RectifyNVMeSession.
@@ -32,10 +32,14 @@ const (
 	// CRD names
 	ActionMirrorUpdateCRDName    = "tridentactionmirrorupdates.trident.netapp.io"
 	ActionSnapshotRestoreCRDName = "tridentactionsnapshotrestores.trident.netapp.io"
+	ActionVolumeMoveCRDName      = "tridentactionvolumemoves.trident.netapp.io"
 	BackendCRDName               = "tridentbackends.trident.netapp.io"
+	GroupSnapshotCRDName         = "tridentgroupsnapshots.trident.netapp.io"
 	BackendConfigCRDName         = "tridentbackendconfigs.trident.netapp.io"
 	MirrorRelationshipCRDName    = "tridentmirrorrelationships.trident.netapp.io"
 	SnapshotInfoCRDName          = "tridentsnapshotinfos.trident.netapp.io"
+	SnapshotPolicyCRDName        = "tridentsnapshotpolicies.trident.netapp.io"
+	SnapshotPolicyStateCRDName   = "tridentsnapshotpolicystates.trident.netapp.io"
 	NodeCRDName                  = "tridentnodes.trident.netapp.io"
 	StorageClassCRDName          = "tridentstorageclasses.trident.netapp.io"
 	TransactionCRDName           = "tridenttransactions.trident.netapp.io"
@@ -100,15 +104,22 @@ var (
 
 	k8sAPIQPS int
 
+	tracingEndpoint    string
+	tracingSampleRatio float64
+
 	CRDnames = []string{
 		ActionMirrorUpdateCRDName,
 		ActionSnapshotRestoreCRDName,
+		ActionVolumeMoveCRDName,
 		BackendCRDName,
 		BackendConfigCRDName,
+		GroupSnapshotCRDName,
 		MirrorRelationshipCRDName,
 		NodeCRDName,
 		SnapshotCRDName,
 		SnapshotInfoCRDName,
+		SnapshotPolicyCRDName,
+		SnapshotPolicyStateCRDName,
 		StorageClassCRDName,
 		TransactionCRDName,
 		VersionCRDName,
@@ -340,6 +351,7 @@ func (i *Installer) setInstallationParams(
 	iscsiSelfHealingWaitTime = commonconfig.ISCSISelfHealingWaitTimeString
 	imagePullPolicy = DefaultImagePullPolicy
 	acpImage = commonconfig.DefaultACPImage
+	tracingSampleRatio = 1
 
 	imagePullSecrets = []string{}
 
@@ -481,6 +493,18 @@ func (i *Installer) setInstallationParams(
 
 	k8sAPIQPS = cr.Spec.K8sAPIQPS
 
+	tracingEndpoint = cr.Spec.TracingEndpoint
+	if cr.Spec.TracingSampleRatio != "" {
+		var err error
+		tracingSampleRatio, err = strconv.ParseFloat(cr.Spec.TracingSampleRatio, 64)
+		if err != nil {
+			return nil, nil, false, fmt.Errorf("could not parse the tracing sample ratio as a number: %v", err)
+		}
+		if tracingSampleRatio < 0 || tracingSampleRatio > 1 {
+			return nil, nil, false, fmt.Errorf("the tracing sample ratio must be between 0 and 1")
+		}
+	}
+
 	// Owner Reference details set on each of the Trident object created by the operator
 	controllingCRDetails := make(map[string]string)
 	managedByCR := "true"
@@ -763,6 +787,9 @@ func (i *Installer) createCRDs(performOperationOnce bool) error {
 	if err = i.CreateOrPatchCRD(SnapshotCRDName, k8sclient.GetSnapshotCRDYAML(), false); err != nil {
 		return err
 	}
+	if err = i.CreateOrPatchCRD(GroupSnapshotCRDName, k8sclient.GetGroupSnapshotCRDYAML(), false); err != nil {
+		return err
+	}
 	if err = i.CreateOrPatchCRD(VolumeReferenceCRDName, k8sclient.GetVolumeReferenceCRDYAML(), false); err != nil {
 		return err
 	}
@@ -778,6 +805,16 @@ func (i *Installer) createCRDs(performOperationOnce bool) error {
 		false); err != nil {
 		return err
 	}
+	if err = i.CreateOrPatchCRD(ActionVolumeMoveCRDName, k8sclient.GetActionVolumeMoveCRDYAML(), false); err != nil {
+		return err
+	}
+	if err = i.CreateOrPatchCRD(SnapshotPolicyCRDName, k8sclient.GetSnapshotPolicyCRDYAML(), false); err != nil {
+		return err
+	}
+	if err = i.CreateOrPatchCRD(SnapshotPolicyStateCRDName, k8sclient.GetSnapshotPolicyStateCRDYAML(),
+		false); err != nil {
+		return err
+	}
 	if err = i.CreateOrPatchCRD(ConfiguratorCRDName, k8sclient.GetConfiguratorCRDYAML(), false); err != nil {
 		return err
 	}
@@ -1521,6 +1558,8 @@ func (i *Installer) createOrPatchTridentDeployment(
 		EnableACP:               enableACP,
 		IdentityLabel:           identityLabel,
 		K8sAPIQPS:               k8sAPIQPS,
+		TracingEndpoint:         tracingEndpoint,
+		TracingSampleRatio:      tracingSampleRatio,
 	}
 
 	newDeploymentYAML := k8sclient.GetCSIDeploymentYAML(deploymentArgs)
@@ -1600,6 +1639,8 @@ func (i *Installer) createOrPatchTridentDaemonSet(
 		ImagePullPolicy:          imagePullPolicy,
 		ISCSISelfHealingInterval: iscsiSelfHealingInterval,
 		ISCSISelfHealingWaitTime: iscsiSelfHealingWaitTime,
+		TracingEndpoint:          tracingEndpoint,
+		TracingSampleRatio:       tracingSampleRatio,
 	}
 
 	var newDaemonSetYAML string
@@ -75,6 +75,8 @@ type TridentOrchestratorSpec struct {
 	ISCSISelfHealingInterval     string            `json:"iscsiSelfHealingInterval,omitempty"`
 	ISCSISelfHealingWaitTime     string            `json:"iscsiSelfHealingWaitTime,omitempty"`
 	K8sAPIQPS                    int               `json:"k8sAPIQPS,omitempty"`
+	TracingEndpoint              string            `json:"tracingEndpoint,omitempty"`
+	TracingSampleRatio           string            `json:"tracingSampleRatio,omitempty"`
 }
 
 // Toleration
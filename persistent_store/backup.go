@@ -0,0 +1,602 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package persistentstore
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/netapp/trident/config"
+	. "github.com/netapp/trident/logging"
+	"github.com/netapp/trident/storage"
+	"github.com/netapp/trident/storage/factory"
+	sc "github.com/netapp/trident/storage_class"
+	"github.com/netapp/trident/utils"
+	"github.com/netapp/trident/utils/errors"
+)
+
+// BackupFormatVersion is the version of the backup archive layout written by WriteBackup.  ReadBackup rejects
+// archives written in a later format.
+const BackupFormatVersion = 2
+
+const (
+	backupManifestFile           = "manifest.json"
+	backupStorageClassesFile     = "storageclasses.json"
+	backupBackendsFile           = "backends.json"
+	backupBackendSecretsFile     = "backendsecrets.json"
+	backupVolumesFile            = "volumes.json"
+	backupSnapshotsFile          = "snapshots.json"
+	backupGroupSnapshotsFile     = "groupsnapshots.json"
+	backupSnapshotPoliciesFile   = "snapshotpolicies.json"
+	backupNodesFile              = "nodes.json"
+	backupVolumePublicationsFile = "volumepublications.json"
+)
+
+// BackupManifest describes a backup archive.  Every other file in the archive is listed in Files with its
+// object count and SHA-256 checksum.
+type BackupManifest struct {
+	FormatVersion  int                            `json:"formatVersion"`
+	TridentVersion string                         `json:"tridentVersion"`
+	Created        string                         `json:"created"`
+	Store          StoreType                      `json:"store"`
+	TridentUUID    string                         `json:"tridentUUID,omitempty"`
+	Version        *config.PersistentStateVersion `json:"version,omitempty"`
+	Files          map[string]*BackupFile         `json:"files"`
+}
+
+type BackupFile struct {
+	Objects int    `json:"objects"`
+	SHA256  string `json:"sha256"`
+}
+
+// BackendSecretReference names the secret holding the credentials of a backend.  Only the names of the secret's
+// keys are backed up, never their values, so the secret must exist again before the backend can be restored.
+type BackendSecretReference struct {
+	Backend string   `json:"backend"`
+	Name    string   `json:"name"`
+	Type    string   `json:"type"`
+	Keys    []string `json:"keys,omitempty"`
+	// UserProvided is set if the backend names its own secret in its credentials field
+	UserProvided bool `json:"userProvided,omitempty"`
+}
+
+// Required reports whether the secret must be found to restore the backend.
+func (r *BackendSecretReference) Required() bool {
+	return r.Type == "secret" && (r.UserProvided || len(r.Keys) > 0)
+}
+
+// Backup holds all orchestrator state read from a persistent store, with backend credentials replaced by
+// references to their secrets.
+type Backup struct {
+	Manifest           *BackupManifest
+	StorageClasses     []*sc.Persistent
+	Backends           []*storage.BackendPersistent
+	BackendSecrets     []*BackendSecretReference
+	Volumes            []*storage.VolumeExternal
+	Snapshots          []*storage.SnapshotPersistent
+	GroupSnapshots     []*storage.GroupSnapshotPersistent
+	SnapshotPolicies   []*storage.SnapshotPolicyPersistent
+	Nodes              []*utils.Node
+	VolumePublications []*utils.VolumePublication
+}
+
+// backendSecretName is the name of the secret Trident creates for a backend that has no credentials field.
+func backendSecretName(backendUUID string) string {
+	return fmt.Sprintf("tbe-%s", backendUUID)
+}
+
+// NewBackup reads all orchestrator state from a persistent store.  Like a migration, a backup cannot include
+// volume transactions, so the store must have none.
+func NewBackup(ctx context.Context, client Client) (*Backup, error) {
+	txns, err := client.GetVolumeTransactions(ctx)
+	if err != nil && !MatchKeyNotFoundErr(err) {
+		return nil, fmt.Errorf("could not read volume transactions; %v", err)
+	} else if len(txns) > 0 {
+		return nil, fmt.Errorf("the %s store has %d pending volume transactions; start Trident to resolve them "+
+			"before taking a backup", client.GetType(), len(txns))
+	}
+
+	backup := &Backup{
+		Manifest: &BackupManifest{
+			FormatVersion:  BackupFormatVersion,
+			TridentVersion: config.OrchestratorVersion.String(),
+			Created:        time.Now().UTC().Format(time.RFC3339),
+			Store:          client.GetType(),
+		},
+	}
+	if backup.Manifest.TridentUUID, err = client.GetTridentUUID(ctx); err != nil {
+		Logc(ctx).WithField("error", err).Debug("Could not read Trident UUID.")
+	}
+	if backup.Manifest.Version, err = client.GetVersion(ctx); err != nil {
+		Logc(ctx).WithField("error", err).Debug("Could not read persistent state version.")
+	}
+
+	if backup.StorageClasses, err = client.GetStorageClasses(ctx); err != nil && !MatchKeyNotFoundErr(err) {
+		return nil, fmt.Errorf("could not read storage classes; %v", err)
+	}
+	backends, err := client.GetBackends(ctx)
+	if err != nil && !MatchKeyNotFoundErr(err) {
+		return nil, fmt.Errorf("could not read backends; %v", err)
+	}
+	for _, backend := range backends {
+		redacted, secretRef, err := redactBackend(backend)
+		if err != nil {
+			return nil, fmt.Errorf("could not remove the credentials of backend %s; %v", backend.Name, err)
+		}
+		backup.Backends = append(backup.Backends, redacted)
+		backup.BackendSecrets = append(backup.BackendSecrets, secretRef)
+	}
+	if backup.Volumes, err = client.GetVolumes(ctx); err != nil && !MatchKeyNotFoundErr(err) {
+		return nil, fmt.Errorf("could not read volumes; %v", err)
+	}
+	if backup.Snapshots, err = client.GetSnapshots(ctx); err != nil && !MatchKeyNotFoundErr(err) {
+		return nil, fmt.Errorf("could not read snapshots; %v", err)
+	}
+	if backup.GroupSnapshots, err = client.GetGroupSnapshots(ctx); err != nil && !MatchKeyNotFoundErr(err) {
+		return nil, fmt.Errorf("could not read group snapshots; %v", err)
+	}
+	if backup.SnapshotPolicies, err = client.GetSnapshotPolicies(ctx); err != nil && !MatchKeyNotFoundErr(err) {
+		return nil, fmt.Errorf("could not read snapshot policies; %v", err)
+	}
+	if backup.Nodes, err = client.GetNodes(ctx); err != nil && !MatchKeyNotFoundErr(err) {
+		return nil, fmt.Errorf("could not read nodes; %v", err)
+	}
+	if backup.VolumePublications, err = client.GetVolumePublications(ctx); err != nil && !MatchKeyNotFoundErr(err) {
+		return nil, fmt.Errorf("could not read volume publications; %v", err)
+	}
+
+	return backup, nil
+}
+
+// redactBackend returns a copy of a backend with its credentials replaced by the name of their secret, in the
+// same way the CRD store persists backends, along with a reference to that secret.
+func redactBackend(backend *storage.BackendPersistent) (*storage.BackendPersistent, *BackendSecretReference,
+	error,
+) {
+	secretRef := &BackendSecretReference{
+		Backend: backend.Name,
+		Name:    backendSecretName(backend.BackendUUID),
+		Type:    "secret",
+	}
+
+	credentialsName, credentialsType, err := backend.GetBackendCredentials()
+	if err != nil {
+		return nil, nil, err
+	}
+	if credentialsName != "" {
+		secretRef.Name = credentialsName
+		secretRef.UserProvided = true
+	}
+	if credentialsType != "" {
+		secretRef.Type = credentialsType
+	}
+
+	redacted, secretMap, _, err := backend.ExtractBackendSecrets(secretRef.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+	for key := range secretMap {
+		secretRef.Keys = append(secretRef.Keys, strings.ToLower(key))
+	}
+	sort.Strings(secretRef.Keys)
+
+	return redacted, secretRef, nil
+}
+
+// WriteBackup writes a backup as a gzipped tar archive of JSON files, starting with the manifest.
+func WriteBackup(w io.Writer, backup *Backup) error {
+	files := []struct {
+		name    string
+		objects interface{}
+		count   int
+	}{
+		{backupStorageClassesFile, backup.StorageClasses, len(backup.StorageClasses)},
+		{backupBackendsFile, backup.Backends, len(backup.Backends)},
+		{backupBackendSecretsFile, backup.BackendSecrets, len(backup.BackendSecrets)},
+		{backupVolumesFile, backup.Volumes, len(backup.Volumes)},
+		{backupSnapshotsFile, backup.Snapshots, len(backup.Snapshots)},
+		{backupGroupSnapshotsFile, backup.GroupSnapshots, len(backup.GroupSnapshots)},
+		{backupSnapshotPoliciesFile, backup.SnapshotPolicies, len(backup.SnapshotPolicies)},
+		{backupNodesFile, backup.Nodes, len(backup.Nodes)},
+		{backupVolumePublicationsFile, backup.VolumePublications, len(backup.VolumePublications)},
+	}
+
+	contents := make(map[string][]byte, len(files))
+	backup.Manifest.Files = make(map[string]*BackupFile, len(files))
+	for _, file := range files {
+		data, err := json.MarshalIndent(file.objects, "", "  ")
+		if err != nil {
+			return fmt.Errorf("could not marshal %s; %v", file.name, err)
+		}
+		checksum := sha256.Sum256(data)
+		contents[file.name] = data
+		backup.Manifest.Files[file.name] = &BackupFile{Objects: file.count, SHA256: hex.EncodeToString(checksum[:])}
+	}
+	manifest, err := json.MarshalIndent(backup.Manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not marshal %s; %v", backupManifestFile, err)
+	}
+
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+	writeFile := func(name string, data []byte) error {
+		header := &tar.Header{Name: name, Mode: 0o600, Size: int64(len(data)), ModTime: time.Now()}
+		if err := tarWriter.WriteHeader(header); err != nil {
+			return err
+		}
+		_, err := tarWriter.Write(data)
+		return err
+	}
+
+	if err = writeFile(backupManifestFile, manifest); err != nil {
+		return err
+	}
+	for _, file := range files {
+		if err = writeFile(file.name, contents[file.name]); err != nil {
+			return err
+		}
+	}
+	if err = tarWriter.Close(); err != nil {
+		return err
+	}
+	return gzipWriter.Close()
+}
+
+// ReadBackup reads a backup archive written by WriteBackup, verifying its format version and the checksum and
+// object count of every file listed in its manifest.
+func ReadBackup(r io.Reader) (*Backup, error) {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("not a backup archive; %v", err)
+	}
+	defer func() { _ = gzipReader.Close() }()
+
+	contents := make(map[string][]byte)
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("could not read backup archive; %v", err)
+		}
+		var data bytes.Buffer
+		if _, err = io.Copy(&data, tarReader); err != nil {
+			return nil, fmt.Errorf("could not read %s from backup archive; %v", header.Name, err)
+		}
+		contents[header.Name] = data.Bytes()
+	}
+
+	manifestData, ok := contents[backupManifestFile]
+	if !ok {
+		return nil, fmt.Errorf("backup archive has no %s", backupManifestFile)
+	}
+	backup := &Backup{Manifest: &BackupManifest{}}
+	if err = json.Unmarshal(manifestData, backup.Manifest); err != nil {
+		return nil, fmt.Errorf("could not parse %s; %v", backupManifestFile, err)
+	}
+	if backup.Manifest.FormatVersion < 1 || backup.Manifest.FormatVersion > BackupFormatVersion {
+		return nil, fmt.Errorf("unsupported backup format version %d; this version of Trident reads versions "+
+			"up to %d", backup.Manifest.FormatVersion, BackupFormatVersion)
+	}
+
+	// Each file is required from the format version in which it was introduced
+	files := []struct {
+		name    string
+		objects interface{}
+		count   func() int
+		since   int
+	}{
+		{backupStorageClassesFile, &backup.StorageClasses, func() int { return len(backup.StorageClasses) }, 1},
+		{backupBackendsFile, &backup.Backends, func() int { return len(backup.Backends) }, 1},
+		{backupBackendSecretsFile, &backup.BackendSecrets, func() int { return len(backup.BackendSecrets) }, 1},
+		{backupVolumesFile, &backup.Volumes, func() int { return len(backup.Volumes) }, 1},
+		{backupSnapshotsFile, &backup.Snapshots, func() int { return len(backup.Snapshots) }, 1},
+		{backupGroupSnapshotsFile, &backup.GroupSnapshots, func() int { return len(backup.GroupSnapshots) }, 1},
+		{backupSnapshotPoliciesFile, &backup.SnapshotPolicies, func() int { return len(backup.SnapshotPolicies) }, 2},
+		{backupNodesFile, &backup.Nodes, func() int { return len(backup.Nodes) }, 1},
+		{backupVolumePublicationsFile, &backup.VolumePublications,
+			func() int { return len(backup.VolumePublications) }, 1},
+	}
+	for _, file := range files {
+		if backup.Manifest.FormatVersion < file.since {
+			continue
+		}
+		expected, ok := backup.Manifest.Files[file.name]
+		if !ok {
+			return nil, fmt.Errorf("backup manifest does not list %s", file.name)
+		}
+		data, ok := contents[file.name]
+		if !ok {
+			return nil, fmt.Errorf("backup archive has no %s", file.name)
+		}
+		checksum := sha256.Sum256(data)
+		if hex.EncodeToString(checksum[:]) != expected.SHA256 {
+			return nil, fmt.Errorf("checksum of %s does not match the backup manifest", file.name)
+		}
+		if err = json.Unmarshal(data, file.objects); err != nil {
+			return nil, fmt.Errorf("could not parse %s; %v", file.name, err)
+		}
+		if file.count() != expected.Objects {
+			return nil, fmt.Errorf("%s has %d objects, but the backup manifest lists %d", file.name,
+				file.count(), expected.Objects)
+		}
+	}
+
+	return backup, nil
+}
+
+// VolumeValidationResult says whether a backed up volume was found on its backend.
+type VolumeValidationResult string
+
+const (
+	VolumeFound      = VolumeValidationResult("found")      // The backend reports the volume exists
+	VolumeMissing    = VolumeValidationResult("missing")    // The backend could not find the volume
+	VolumeUnverified = VolumeValidationResult("unverified") // The backend is unknown, or could not be initialized or asked
+)
+
+type ValidatedVolume struct {
+	Name         string                 `json:"name"`
+	InternalName string                 `json:"internalName"`
+	Backend      string                 `json:"backend,omitempty"`
+	Result       VolumeValidationResult `json:"result"`
+	Error        string                 `json:"error,omitempty"`
+}
+
+// BackupRestorer replays a backup into a persistent store.
+type BackupRestorer struct {
+	backup     *Backup
+	destClient Client
+	secrets    map[string]map[string]string
+	dryRun     bool
+}
+
+// NewBackupRestorer returns a restorer for a backup.  Backend credentials are looked up by secret name, first
+// in secrets, then in the destination store.
+func NewBackupRestorer(
+	backup *Backup, destClient Client, secrets map[string]map[string]string, dryRun bool,
+) *BackupRestorer {
+	return &BackupRestorer{
+		backup:     backup,
+		destClient: destClient,
+		secrets:    secrets,
+		dryRun:     dryRun,
+	}
+}
+
+// Run copies the backed up objects that are missing from the destination store, reporting any that differ
+// from the backup as conflicts, in the same way as a migration between stores.  Every backend whose
+// credentials are required must have its secret available, or nothing is restored.
+func (r *BackupRestorer) Run(ctx context.Context) (*MigrationReport, error) {
+	backends, err := r.resolveBackends(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	source := NewInMemoryClient()
+	if r.backup.Manifest.Version != nil {
+		source.version = r.backup.Manifest.Version
+	}
+	for _, add := range []func() error{
+		func() error {
+			for _, storageClass := range r.backup.StorageClasses {
+				if err := source.AddStorageClass(ctx, sc.NewFromPersistent(storageClass)); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		func() error {
+			for _, backend := range backends {
+				if err := source.AddBackendPersistent(ctx, backend); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		func() error {
+			for _, v := range r.backup.Volumes {
+				volume := storage.NewVolume(v.Config, v.BackendUUID, v.Pool, v.Orphaned, v.State)
+				if err := source.AddVolume(ctx, volume); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		func() error {
+			for _, snapshot := range r.backup.Snapshots {
+				if err := source.AddSnapshot(ctx, &snapshot.Snapshot); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		func() error {
+			for _, groupSnapshot := range r.backup.GroupSnapshots {
+				if err := source.AddGroupSnapshot(ctx, &groupSnapshot.GroupSnapshot); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		func() error {
+			for _, p := range r.backup.SnapshotPolicies {
+				policy, err := p.ConstructSnapshotPolicy(time.Now())
+				if err != nil {
+					return err
+				}
+				if err = source.AddSnapshotPolicy(ctx, policy); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		func() error {
+			for _, node := range r.backup.Nodes {
+				if err := source.AddOrUpdateNode(ctx, node); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		func() error {
+			for _, publication := range r.backup.VolumePublications {
+				if err := source.AddVolumePublication(ctx, publication); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	} {
+		if err = add(); err != nil {
+			return nil, fmt.Errorf("could not load backup; %v", err)
+		}
+	}
+
+	report, err := NewDataMigrator(source, r.destClient, r.dryRun).Run(ctx)
+	if report != nil {
+		report.Source = r.backup.Manifest.Store
+	}
+	return report, err
+}
+
+// resolveBackends returns the backed up backends with their credentials restored from their secrets.
+func (r *BackupRestorer) resolveBackends(ctx context.Context) ([]*storage.BackendPersistent, error) {
+	secretRefs := make(map[string]*BackendSecretReference, len(r.backup.BackendSecrets))
+	for _, secretRef := range r.backup.BackendSecrets {
+		secretRefs[secretRef.Backend] = secretRef
+	}
+
+	backends := make([]*storage.BackendPersistent, 0, len(r.backup.Backends))
+	missing := make([]string, 0)
+
+	for _, backend := range r.backup.Backends {
+		secretRef, ok := secretRefs[backend.Name]
+		if !ok || !secretRef.Required() {
+			backends = append(backends, backend)
+			continue
+		}
+
+		secretMap, err := r.backendSecret(ctx, secretRef.Name)
+		if err != nil {
+			return nil, fmt.Errorf("could not read secret %s of backend %s; %v", secretRef.Name, backend.Name, err)
+		} else if secretMap == nil {
+			missing = append(missing, fmt.Sprintf("%s (backend %s)", secretRef.Name, backend.Name))
+			continue
+		}
+
+		// Inject into a copy, so the backup itself keeps no credentials
+		resolved, _, _, err := backend.ExtractBackendSecrets(secretRef.Name)
+		if err != nil {
+			return nil, err
+		}
+		if err = resolved.InjectBackendSecrets(secretMap); err != nil {
+			return nil, fmt.Errorf("could not restore the credentials of backend %s; %v", backend.Name, err)
+		}
+		backends = append(backends, resolved)
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("the credentials of some backends were not found; recreate these secrets or "+
+			"supply their values to restore them: %s", strings.Join(missing, ", "))
+	}
+	return backends, nil
+}
+
+func (r *BackupRestorer) backendSecret(ctx context.Context, secretName string) (map[string]string, error) {
+	if secretMap, ok := r.secrets[secretName]; ok {
+		lowerSecretMap := make(map[string]string, len(secretMap))
+		for key, value := range secretMap {
+			lowerSecretMap[strings.ToLower(key)] = value
+		}
+		return lowerSecretMap, nil
+	}
+	return r.destClient.GetBackendSecret(ctx, secretName)
+}
+
+// ValidateVolumes initializes each backed up backend and asks its driver whether each of its volumes still
+// exists.  Volumes of backends that are unknown or cannot be initialized, and volumes that a backend could not
+// look up, are reported as unverified.
+func (r *BackupRestorer) ValidateVolumes(ctx context.Context) ([]*ValidatedVolume, error) {
+	backends, err := r.resolveBackends(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	liveBackends := make(map[string]storage.Backend, len(backends))
+	backendErrors := make(map[string]error)
+	backendNames := make(map[string]string, len(backends))
+	for _, backend := range backends {
+		backendNames[backend.BackendUUID] = backend.Name
+		if liveBackends[backend.BackendUUID], err = newBackendFromPersistent(ctx, backend); err != nil {
+			backendErrors[backend.BackendUUID] = err
+		}
+	}
+	defer func() {
+		for _, backend := range liveBackends {
+			if backend != nil {
+				backend.Terminate(ctx)
+			}
+		}
+	}()
+
+	results := make([]*ValidatedVolume, 0, len(r.backup.Volumes))
+	for _, volume := range r.backup.Volumes {
+		result := &ValidatedVolume{
+			Name:         volume.Config.Name,
+			InternalName: volume.Config.InternalName,
+			Backend:      backendNames[volume.BackendUUID],
+		}
+		results = append(results, result)
+
+		backend := liveBackends[volume.BackendUUID]
+		if backendErr, ok := backendErrors[volume.BackendUUID]; ok {
+			result.Result = VolumeUnverified
+			result.Error = fmt.Sprintf("could not initialize backend; %v", backendErr)
+		} else if backend == nil {
+			result.Result = VolumeUnverified
+			result.Error = fmt.Sprintf("backend %s is not in the backup", volume.BackendUUID)
+		} else {
+			result.Result, result.Error = validateVolume(ctx, backend.Driver(), volume.Config.InternalName)
+		}
+	}
+
+	return results, nil
+}
+
+// validateVolume asks a driver whether a volume exists.  Only a volume that the driver reports as not found is
+// missing; if the driver could not tell, such as when its storage cannot be reached, the volume is unverified.
+func validateVolume(
+	ctx context.Context, driver storage.Driver, internalName string,
+) (VolumeValidationResult, string) {
+	if err := driver.Get(ctx, internalName); errors.IsNotFoundError(err) {
+		return VolumeMissing, err.Error()
+	} else if err != nil {
+		return VolumeUnverified, err.Error()
+	}
+	return VolumeFound, ""
+}
+
+// newBackendFromPersistent initializes a backend from its persistent form, as Trident does when bootstrapping.
+func newBackendFromPersistent(ctx context.Context, backend *storage.BackendPersistent) (storage.Backend, error) {
+	configJSON, err := backend.MarshalConfig()
+	if err != nil {
+		return nil, err
+	}
+	commonConfig, configJSON, err := factory.ValidateCommonSettings(ctx, configJSON)
+	if err != nil {
+		return nil, err
+	}
+	return factory.NewStorageBackendForConfig(ctx, configJSON, backend.ConfigRef, backend.BackendUUID,
+		commonConfig, nil)
+}
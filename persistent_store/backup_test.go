@@ -0,0 +1,324 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package persistentstore
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+
+	"github.com/netapp/trident/config"
+	mockstorage "github.com/netapp/trident/mocks/mock_storage"
+	"github.com/netapp/trident/storage"
+	"github.com/netapp/trident/storage/fake"
+	drivers "github.com/netapp/trident/storage_drivers"
+	fakedriver "github.com/netapp/trident/storage_drivers/fake"
+	testutils "github.com/netapp/trident/storage_drivers/fake/test_utils"
+	"github.com/netapp/trident/utils/errors"
+)
+
+func writeTestBackup(t *testing.T, source Client) []byte {
+	backup, err := NewBackup(ctx(), source)
+	if err != nil {
+		t.Fatalf("Backup failed: %v", err)
+	}
+	var archive bytes.Buffer
+	if err = WriteBackup(&archive, backup); err != nil {
+		t.Fatalf("Could not write backup: %v", err)
+	}
+	return archive.Bytes()
+}
+
+// rewriteTestBackup repacks a backup archive after passing each of its files through edit.
+func rewriteTestBackup(t *testing.T, archive []byte, edit func(name string, data []byte) []byte) []byte {
+	gzipReader, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tarReader := tar.NewReader(gzipReader)
+
+	var rewritten bytes.Buffer
+	gzipWriter := gzip.NewWriter(&rewritten)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		data, err := io.ReadAll(tarReader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		data = edit(header.Name, data)
+		header.Size = int64(len(data))
+		if err = tarWriter.WriteHeader(header); err != nil {
+			t.Fatal(err)
+		}
+		if _, err = tarWriter.Write(data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	_ = tarWriter.Close()
+	_ = gzipWriter.Close()
+	return rewritten.Bytes()
+}
+
+func TestBackup_RoundTrip(t *testing.T) {
+	source := getTestMigrationSource(t)
+	archive := writeTestBackup(t, source)
+
+	backup, err := ReadBackup(bytes.NewReader(archive))
+	if err != nil {
+		t.Fatalf("Could not read backup: %v", err)
+	}
+	if backup.Manifest.FormatVersion != BackupFormatVersion || backup.Manifest.Store != MemoryStore {
+		t.Errorf("Unexpected manifest: %+v", backup.Manifest)
+	}
+	files := backup.Manifest.Files
+	if files[backupVolumesFile].Objects != 1 || files[backupSnapshotsFile].Objects != 1 ||
+		files[backupSnapshotPoliciesFile].Objects != 1 {
+		t.Errorf("Unexpected object counts in manifest: %+v", files)
+	}
+
+	dest, _ := getTestBoltClient(t)
+	report, err := NewBackupRestorer(backup, dest, nil, false).Run(ctx())
+	if err != nil {
+		t.Fatalf("Restore failed: %v", err)
+	}
+	expected := map[string]MigrationResult{
+		"storage class/gold":            MigrationCopied,
+		"backend/fake1":                 MigrationCopied,
+		"volume/vol1":                   MigrationCopied,
+		"snapshot/vol1/snap1":           MigrationCopied,
+		"snapshot policy/hourly":        MigrationCopied,
+		"node/node1":                    MigrationCopied,
+		"volume publication/vol1.node1": MigrationCopied,
+	}
+	if results := migrationResults(report); !reflect.DeepEqual(expected, results) {
+		t.Errorf("Unexpected restore results; expected %v, got %v", expected, results)
+	}
+	if report.Source != MemoryStore {
+		t.Errorf("Expected the report to name the backed up store; got %s", report.Source)
+	}
+
+	// Restoring again finds everything in place
+	if report, err = NewBackupRestorer(backup, dest, nil, false).Run(ctx()); err != nil {
+		t.Fatalf("Restore failed: %v", err)
+	}
+	for name, result := range migrationResults(report) {
+		if result != MigrationUnchanged {
+			t.Errorf("Expected %s to be unchanged; got %s", name, result)
+		}
+	}
+
+	// Archives written before snapshot policies were backed up are still read
+	v1Archive := rewriteTestBackup(t, archive, func(name string, data []byte) []byte {
+		if name == backupManifestFile {
+			manifest := &BackupManifest{}
+			_ = json.Unmarshal(data, manifest)
+			manifest.FormatVersion = 1
+			delete(manifest.Files, backupSnapshotPoliciesFile)
+			data, _ = json.Marshal(manifest)
+		}
+		return data
+	})
+	if backup, err = ReadBackup(bytes.NewReader(v1Archive)); err != nil {
+		t.Fatalf("Could not read version 1 backup: %v", err)
+	}
+	if len(backup.SnapshotPolicies) != 0 || len(backup.Volumes) != 1 {
+		t.Errorf("Unexpected version 1 backup contents: %+v", backup)
+	}
+}
+
+func TestReadBackup_Rejected(t *testing.T) {
+	archive := writeTestBackup(t, getTestMigrationSource(t))
+
+	tampered := rewriteTestBackup(t, archive, func(name string, data []byte) []byte {
+		if name == backupVolumesFile {
+			return bytes.Replace(data, []byte("1GB"), []byte("9GB"), 1)
+		}
+		return data
+	})
+	if _, err := ReadBackup(bytes.NewReader(tampered)); err == nil || !strings.Contains(err.Error(), "checksum") {
+		t.Errorf("Expected a checksum error; got %v", err)
+	}
+
+	future := rewriteTestBackup(t, archive, func(name string, data []byte) []byte {
+		if name == backupManifestFile {
+			manifest := &BackupManifest{}
+			_ = json.Unmarshal(data, manifest)
+			manifest.FormatVersion = BackupFormatVersion + 1
+			data, _ = json.Marshal(manifest)
+		}
+		return data
+	})
+	if _, err := ReadBackup(bytes.NewReader(future)); err == nil {
+		t.Error("Expected a later format version to be rejected")
+	}
+
+	if _, err := ReadBackup(strings.NewReader("not an archive")); err == nil {
+		t.Error("Expected an invalid archive to be rejected")
+	}
+}
+
+func TestBackup_BackendSecrets(t *testing.T) {
+	source := NewInMemoryClient()
+	backendUUID := uuid.NewString()
+	backend := &storage.BackendPersistent{
+		Version: config.OrchestratorAPIVersion,
+		Config: storage.PersistentStorageBackendConfig{
+			OntapConfig: &drivers.OntapStorageDriverConfig{
+				CommonStorageDriverConfig: &drivers.CommonStorageDriverConfig{
+					StorageDriverName: config.OntapNASStorageDriverName,
+				},
+				Username: "admin",
+				Password: "password",
+			},
+		},
+		Name:        "ontap1",
+		BackendUUID: backendUUID,
+		Online:      true,
+		State:       storage.Online,
+	}
+	if err := source.AddBackendPersistent(ctx(), backend); err != nil {
+		t.Fatal(err)
+	}
+
+	archive := writeTestBackup(t, source)
+	if bytes.Contains(archive, []byte("password")) {
+		t.Error("Backup should not contain secret values")
+	}
+	backup, err := ReadBackup(bytes.NewReader(archive))
+	if err != nil {
+		t.Fatal(err)
+	}
+	secretName := "tbe-" + backendUUID
+	expectedRef := &BackendSecretReference{
+		Backend: "ontap1", Name: secretName, Type: "secret", Keys: []string{"clientprivatekey", "password", "username"},
+	}
+	if len(backup.BackendSecrets) != 1 || !reflect.DeepEqual(expectedRef, backup.BackendSecrets[0]) {
+		t.Errorf("Unexpected backend secret references: %v", backup.BackendSecrets)
+	}
+	if username := backup.Backends[0].Config.OntapConfig.Username; username != "secret:"+secretName {
+		t.Errorf("Expected username to reference the backend secret; got %s", username)
+	}
+
+	// Without the secret, nothing is restored
+	dest, _ := getTestBoltClient(t)
+	if _, err = NewBackupRestorer(backup, dest, nil, false).Run(ctx()); err == nil ||
+		!strings.Contains(err.Error(), secretName) {
+		t.Errorf("Expected restore to fail for want of %s; got %v", secretName, err)
+	}
+	if backends, _ := dest.GetBackends(ctx()); len(backends) != 0 {
+		t.Errorf("Failed restore copied %d backends", len(backends))
+	}
+
+	secrets := map[string]map[string]string{secretName: {"Username": "admin", "Password": "password"}}
+	report, err := NewBackupRestorer(backup, dest, secrets, false).Run(ctx())
+	if err != nil || !report.Succeeded() {
+		t.Fatalf("Restore failed: %v, %v", migrationResults(report), err)
+	}
+	restored, err := dest.GetBackend(ctx(), "ontap1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ontapConfig := restored.Config.OntapConfig; ontapConfig.Username != "admin" || ontapConfig.Password != "password" {
+		t.Errorf("Backend credentials were not restored; got %s/%s", ontapConfig.Username, ontapConfig.Password)
+	}
+	if backup.Backends[0].Config.OntapConfig.Password == "password" {
+		t.Error("Restore should not have modified the backup")
+	}
+}
+
+func TestBackupRestorer_ValidateVolumes(t *testing.T) {
+	fakeConfig := drivers.FakeStorageDriverConfig{
+		CommonStorageDriverConfig: &drivers.CommonStorageDriverConfig{
+			Version:           drivers.ConfigVersion,
+			StorageDriverName: config.FakeStorageDriverName,
+		},
+		Protocol:     config.File,
+		Pools:        testutils.GenerateFakePools(1),
+		InstanceName: "fake1",
+	}
+	fakeBackend, err := storage.NewStorageBackend(ctx(), fakedriver.NewFakeStorageDriver(ctx(), fakeConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fakeBackend.SetBackendUUID(uuid.NewString())
+
+	// The fake driver does not persist its volumes, so add one to the config it will be initialized with
+	backend := fakeBackend.ConstructPersistent(ctx())
+	backend.Config.FakeStorageDriverConfig.Volumes = []fake.Volume{
+		{Name: "vol1_internal", RequestedPool: "pool-0", SizeBytes: 1073741824},
+	}
+
+	source := NewInMemoryClient()
+	if err = source.AddBackendPersistent(ctx(), backend); err != nil {
+		t.Fatal(err)
+	}
+	for _, volume := range []*storage.Volume{
+		getFakeVolumeWithName("vol1", fakeBackend),
+		getFakeVolumeWithName("vol2", fakeBackend),
+		storage.NewVolume(&storage.VolumeConfig{Name: "vol3", InternalName: "vol3_internal"}, "unknown-uuid",
+			storagePool, false, storage.VolumeStateOnline),
+	} {
+		if err = source.AddVolume(ctx(), volume); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	backup, err := ReadBackup(bytes.NewReader(writeTestBackup(t, source)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	results, err := NewBackupRestorer(backup, NewInMemoryClient(), nil, true).ValidateVolumes(ctx())
+	if err != nil {
+		t.Fatalf("Validation failed: %v", err)
+	}
+
+	expected := map[string]VolumeValidationResult{
+		"vol1": VolumeFound,
+		"vol2": VolumeMissing,
+		"vol3": VolumeUnverified,
+	}
+	actual := make(map[string]VolumeValidationResult)
+	for _, result := range results {
+		actual[result.Name] = result.Result
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Unexpected validation results; expected %v, got %v", expected, actual)
+	}
+}
+
+func TestValidateVolume(t *testing.T) {
+	mockDriver := mockstorage.NewMockDriver(gomock.NewController(t))
+	mockDriver.EXPECT().Get(gomock.Any(), "found").Return(nil)
+	mockDriver.EXPECT().Get(gomock.Any(), "gone").Return(errors.NotFoundError("volume gone not found"))
+	mockDriver.EXPECT().Get(gomock.Any(), "unreachable").Return(errors.New("connection timed out"))
+
+	// Only a volume the driver knows to be gone is missing
+	expected := map[string]VolumeValidationResult{
+		"found":       VolumeFound,
+		"gone":        VolumeMissing,
+		"unreachable": VolumeUnverified,
+	}
+	for internalName, expectedResult := range expected {
+		result, message := validateVolume(ctx(), mockDriver, internalName)
+		if result != expectedResult {
+			t.Errorf("Unexpected validation result for %s; expected %s, got %s", internalName, expectedResult, result)
+		}
+		if (result == VolumeFound) != (message == "") {
+			t.Errorf("Unexpected validation message for %s: %q", internalName, message)
+		}
+	}
+}
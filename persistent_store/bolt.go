@@ -0,0 +1,583 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package persistentstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/netapp/trident/config"
+	. "github.com/netapp/trident/logging"
+	"github.com/netapp/trident/storage"
+	sc "github.com/netapp/trident/storage_class"
+	"github.com/netapp/trident/utils"
+	"github.com/netapp/trident/utils/errors"
+)
+
+const (
+	boltOpenTimeout = 10 * time.Second
+
+	boltMetaBucket               = "meta"
+	boltBackendsBucket           = "backends"
+	boltVolumesBucket            = "volumes"
+	boltVolumeTxnsBucket         = "volumeTransactions"
+	boltStorageClassesBucket     = "storageClasses"
+	boltNodesBucket              = "nodes"
+	boltVolumePublicationsBucket = "volumePublications"
+	boltSnapshotsBucket          = "snapshots"
+	boltGroupSnapshotsBucket     = "groupSnapshots"
+	boltSnapshotPoliciesBucket   = "snapshotPolicies"
+
+	boltVersionKey = "version"
+	boltUUIDKey    = "uuid"
+)
+
+var boltBuckets = []string{
+	boltMetaBucket,
+	boltBackendsBucket,
+	boltVolumesBucket,
+	boltVolumeTxnsBucket,
+	boltStorageClassesBucket,
+	boltNodesBucket,
+	boltVolumePublicationsBucket,
+	boltSnapshotsBucket,
+	boltGroupSnapshotsBucket,
+	boltSnapshotPoliciesBucket,
+}
+
+// BoltClient persists orchestrator state in an embedded, transactional key-value file, for deployments
+// that have neither Kubernetes to hold CRDs nor backends that may serve as the source of truth.  Each
+// object kind is kept in its own bucket, keyed by name, as JSON.  Backend credentials are stored with
+// the backends, so the file is only readable by its owner.
+type BoltClient struct {
+	db   *bolt.DB
+	path string
+}
+
+// NewBoltClient opens the store file at the specified path, creating it if needed.  Only one process may
+// open the file at a time.
+func NewBoltClient(path string) (*BoltClient, error) {
+	ctx := GenerateRequestContext(nil, "", ContextSourceInternal, WorkflowStorageClientCreate,
+		LogLayerPersistentStore)
+
+	if path == "" {
+		return nil, errors.New("bolt store initialization failed, store path must be specified")
+	}
+
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: boltOpenTimeout})
+	if err != nil {
+		return nil, fmt.Errorf("bolt store initialization failed, could not open %s; %v", path, err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range boltBuckets {
+			if _, err := tx.CreateBucketIfNotExists([]byte(bucket)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("bolt store initialization failed, could not create buckets; %v", err)
+	}
+
+	Logc(ctx).WithField("path", path).Debug("Opened bolt store.")
+
+	return &BoltClient{db: db, path: path}, nil
+}
+
+func (c *BoltClient) GetType() StoreType {
+	return BoltStore
+}
+
+func (c *BoltClient) Stop() error {
+	return c.db.Close()
+}
+
+func (c *BoltClient) GetConfig() *ClientConfig {
+	return &ClientConfig{}
+}
+
+// GetTridentUUID returns the UUID assigned when the persistent state version was first stored.
+func (c *BoltClient) GetTridentUUID(context.Context) (string, error) {
+	var tridentUUID string
+	err := c.db.View(func(tx *bolt.Tx) error {
+		value := tx.Bucket([]byte(boltMetaBucket)).Get([]byte(boltUUIDKey))
+		if value == nil {
+			return NewPersistentStoreError(KeyNotFoundErr, boltUUIDKey)
+		}
+		tridentUUID = string(value)
+		return nil
+	})
+	return tridentUUID, err
+}
+
+func (c *BoltClient) GetVersion(context.Context) (*config.PersistentStateVersion, error) {
+	version := &config.PersistentStateVersion{}
+	if err := c.get(boltMetaBucket, boltVersionKey, version); err != nil {
+		return nil, err
+	}
+	return version, nil
+}
+
+// SetVersion stores the persistent state version, and assigns the Trident UUID the first time it is called.
+func (c *BoltClient) SetVersion(ctx context.Context, version *config.PersistentStateVersion) error {
+	value, err := json.Marshal(version)
+	if err != nil {
+		return err
+	}
+
+	err = c.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltMetaBucket))
+		if bucket.Get([]byte(boltUUIDKey)) == nil {
+			if err := bucket.Put([]byte(boltUUIDKey), []byte(uuid.NewString())); err != nil {
+				return err
+			}
+		}
+		return bucket.Put([]byte(boltVersionKey), value)
+	})
+	if err != nil {
+		return err
+	}
+
+	Logc(ctx).WithFields(LogFields{
+		"PersistentStoreVersion": version.PersistentStoreVersion,
+		"OrchestratorAPIVersion": version.OrchestratorAPIVersion,
+	}).Debug("Set persistent state version.")
+
+	return nil
+}
+
+// get reads the value stored under key into value, returning a key-not-found error if there is none.
+func (c *BoltClient) get(bucket, key string, value interface{}) error {
+	return c.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket([]byte(bucket)).Get([]byte(key))
+		if data == nil {
+			return NewPersistentStoreError(KeyNotFoundErr, key)
+		}
+		return json.Unmarshal(data, value)
+	})
+}
+
+// put stores value under key, replacing any existing value.
+func (c *BoltClient) put(bucket, key string, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return c.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(bucket)).Put([]byte(key), data)
+	})
+}
+
+// create stores value under key, returning an already-exists error if the key is in use.
+func (c *BoltClient) create(bucket, key, kind string, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return c.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(bucket))
+		if b.Get([]byte(key)) != nil {
+			return NewAlreadyExistsError(kind, key)
+		}
+		return b.Put([]byte(key), data)
+	})
+}
+
+// replace stores value under key, returning a key-not-found error if the key is not in use.
+func (c *BoltClient) replace(bucket, key string, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return c.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(bucket))
+		if b.Get([]byte(key)) == nil {
+			return NewPersistentStoreError(KeyNotFoundErr, key)
+		}
+		return b.Put([]byte(key), data)
+	})
+}
+
+// delete removes key, reporting whether it was present.
+func (c *BoltClient) delete(bucket, key string) (bool, error) {
+	found := false
+	err := c.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(bucket))
+		if b.Get([]byte(key)) == nil {
+			return nil
+		}
+		found = true
+		return b.Delete([]byte(key))
+	})
+	return found, err
+}
+
+// deleteAll removes every key in a bucket.
+func (c *BoltClient) deleteAll(bucket string) error {
+	return c.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.DeleteBucket([]byte(bucket)); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucket([]byte(bucket))
+		return err
+	})
+}
+
+// boltList reads every value in a bucket, in key order.
+func boltList[T any](c *BoltClient, bucket string) ([]*T, error) {
+	results := make([]*T, 0)
+	err := c.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket([]byte(bucket)).ForEach(func(key, data []byte) error {
+			value := new(T)
+			if err := json.Unmarshal(data, value); err != nil {
+				return fmt.Errorf("could not parse %s %s; %v", bucket, key, err)
+			}
+			results = append(results, value)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (c *BoltClient) AddBackend(ctx context.Context, b storage.Backend) error {
+	return c.AddBackendPersistent(ctx, b.ConstructPersistent(ctx))
+}
+
+// AddBackendPersistent accepts a backend in its persistent form, such as one read from another store
+// during a migration.
+func (c *BoltClient) AddBackendPersistent(_ context.Context, backend *storage.BackendPersistent) error {
+	if backend.BackendUUID == "" {
+		return fmt.Errorf("backend %s does not have a UUID set", backend.Name)
+	}
+	if err := c.create(boltBackendsBucket, backend.Name, "backend", backend); err != nil {
+		if IsAlreadyExistsError(err) {
+			return fmt.Errorf("backend %s already exists", backend.Name)
+		}
+		return err
+	}
+	return nil
+}
+
+func (c *BoltClient) GetBackend(_ context.Context, backendName string) (*storage.BackendPersistent, error) {
+	backend := &storage.BackendPersistent{}
+	if err := c.get(boltBackendsBucket, backendName, backend); err != nil {
+		return nil, err
+	}
+	return backend, nil
+}
+
+// GetBackendSecret returns nothing, since backend credentials are stored with the backends.
+func (c *BoltClient) GetBackendSecret(context.Context, string) (map[string]string, error) {
+	return nil, nil
+}
+
+func (c *BoltClient) UpdateBackend(ctx context.Context, b storage.Backend) error {
+	return c.replace(boltBackendsBucket, b.Name(), b.ConstructPersistent(ctx))
+}
+
+func (c *BoltClient) DeleteBackend(ctx context.Context, b storage.Backend) error {
+	found, err := c.delete(boltBackendsBucket, b.Name())
+	if err != nil {
+		return err
+	}
+	if !found {
+		Logc(ctx).WithField("backendName", b.Name()).Debug("No backend to remove.")
+	}
+	return nil
+}
+
+// IsBackendDeleting always returns false, since backends are deleted from the store immediately.
+func (c *BoltClient) IsBackendDeleting(context.Context, storage.Backend) bool {
+	return false
+}
+
+func (c *BoltClient) GetBackends(context.Context) ([]*storage.BackendPersistent, error) {
+	return boltList[storage.BackendPersistent](c, boltBackendsBucket)
+}
+
+func (c *BoltClient) DeleteBackends(context.Context) error {
+	return c.deleteAll(boltBackendsBucket)
+}
+
+// ReplaceBackendAndUpdateVolumes replaces the original backend with the new one, which may have a different
+// name, in a single transaction.  Volumes refer to their backend by UUID, so they need no update.
+func (c *BoltClient) ReplaceBackendAndUpdateVolumes(
+	ctx context.Context, origBackend, newBackend storage.Backend,
+) error {
+	Logc(ctx).WithFields(LogFields{
+		"origBackend.Name":        origBackend.Name(),
+		"origBackend.BackendUUID": origBackend.BackendUUID(),
+		"newBackend.Name":         newBackend.Name(),
+	}).Debug("ReplaceBackendAndUpdateVolumes.")
+
+	backend := newBackend.ConstructPersistent(ctx)
+	if backend.BackendUUID == "" {
+		return fmt.Errorf("backend %s does not have a UUID set", backend.Name)
+	}
+	data, err := json.Marshal(backend)
+	if err != nil {
+		return err
+	}
+
+	return c.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(boltBackendsBucket))
+		if bucket.Get([]byte(origBackend.Name())) == nil {
+			return NewPersistentStoreError(KeyNotFoundErr, origBackend.Name())
+		}
+		if backend.Name != origBackend.Name() {
+			if bucket.Get([]byte(backend.Name)) != nil {
+				return fmt.Errorf("backend %s already exists", backend.Name)
+			}
+			if err := bucket.Delete([]byte(origBackend.Name())); err != nil {
+				return err
+			}
+		}
+		return bucket.Put([]byte(backend.Name), data)
+	})
+}
+
+// AddVolume writes the volume to the store, replacing any record left over from an earlier, failed attempt
+// to create it.
+func (c *BoltClient) AddVolume(_ context.Context, volume *storage.Volume) error {
+	return c.put(boltVolumesBucket, volume.Config.Name, volume.ConstructExternal())
+}
+
+func (c *BoltClient) GetVolume(_ context.Context, volumeName string) (*storage.VolumeExternal, error) {
+	volume := &storage.VolumeExternal{}
+	if err := c.get(boltVolumesBucket, volumeName, volume); err != nil {
+		return nil, err
+	}
+	return volume, nil
+}
+
+func (c *BoltClient) UpdateVolume(_ context.Context, volume *storage.Volume) error {
+	return c.replace(boltVolumesBucket, volume.Config.Name, volume.ConstructExternal())
+}
+
+func (c *BoltClient) DeleteVolume(_ context.Context, volume *storage.Volume) error {
+	_, err := c.delete(boltVolumesBucket, volume.Config.Name)
+	return err
+}
+
+func (c *BoltClient) GetVolumes(context.Context) ([]*storage.VolumeExternal, error) {
+	return boltList[storage.VolumeExternal](c, boltVolumesBucket)
+}
+
+func (c *BoltClient) DeleteVolumes(context.Context) error {
+	return c.deleteAll(boltVolumesBucket)
+}
+
+// AddVolumeTransaction fails if a transaction already exists for the volume, since only one may be outstanding.
+func (c *BoltClient) AddVolumeTransaction(ctx context.Context, volTxn *storage.VolumeTransaction) error {
+	Logc(ctx).WithFields(LogFields{
+		"op":   volTxn.Op,
+		"name": volTxn.Name(),
+	}).Debug("AddVolumeTransaction")
+
+	return c.create(boltVolumeTxnsBucket, volTxn.Name(), "volume transaction", volTxn)
+}
+
+func (c *BoltClient) GetVolumeTransactions(context.Context) ([]*storage.VolumeTransaction, error) {
+	return boltList[storage.VolumeTransaction](c, boltVolumeTxnsBucket)
+}
+
+func (c *BoltClient) UpdateVolumeTransaction(_ context.Context, volTxn *storage.VolumeTransaction) error {
+	return c.replace(boltVolumeTxnsBucket, volTxn.Name(), volTxn)
+}
+
+// GetVolumeTransaction returns nil if no transaction exists for the volume.
+func (c *BoltClient) GetVolumeTransaction(
+	_ context.Context, volTxn *storage.VolumeTransaction,
+) (*storage.VolumeTransaction, error) {
+	txn := &storage.VolumeTransaction{}
+	if err := c.get(boltVolumeTxnsBucket, volTxn.Name(), txn); err != nil {
+		if MatchKeyNotFoundErr(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting volume transaction; %v", err)
+	}
+	return txn, nil
+}
+
+func (c *BoltClient) DeleteVolumeTransaction(_ context.Context, volTxn *storage.VolumeTransaction) error {
+	_, err := c.delete(boltVolumeTxnsBucket, volTxn.Name())
+	return err
+}
+
+func (c *BoltClient) AddStorageClass(_ context.Context, s *sc.StorageClass) error {
+	return c.create(boltStorageClassesBucket, s.GetName(), "storage class", s.ConstructPersistent())
+}
+
+func (c *BoltClient) GetStorageClass(_ context.Context, scName string) (*sc.Persistent, error) {
+	storageClass := &sc.Persistent{}
+	if err := c.get(boltStorageClassesBucket, scName, storageClass); err != nil {
+		return nil, err
+	}
+	return storageClass, nil
+}
+
+func (c *BoltClient) GetStorageClasses(context.Context) ([]*sc.Persistent, error) {
+	return boltList[sc.Persistent](c, boltStorageClassesBucket)
+}
+
+func (c *BoltClient) DeleteStorageClass(_ context.Context, s *sc.StorageClass) error {
+	_, err := c.delete(boltStorageClassesBucket, s.GetName())
+	return err
+}
+
+func (c *BoltClient) AddOrUpdateNode(_ context.Context, node *utils.Node) error {
+	return c.put(boltNodesBucket, node.Name, node)
+}
+
+func (c *BoltClient) GetNode(_ context.Context, nodeName string) (*utils.Node, error) {
+	node := &utils.Node{}
+	if err := c.get(boltNodesBucket, nodeName, node); err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+func (c *BoltClient) GetNodes(context.Context) ([]*utils.Node, error) {
+	return boltList[utils.Node](c, boltNodesBucket)
+}
+
+func (c *BoltClient) DeleteNode(_ context.Context, node *utils.Node) error {
+	_, err := c.delete(boltNodesBucket, node.Name)
+	return err
+}
+
+func (c *BoltClient) AddVolumePublication(_ context.Context, vp *utils.VolumePublication) error {
+	return c.create(boltVolumePublicationsBucket, vp.Name, "volume publication", vp)
+}
+
+func (c *BoltClient) UpdateVolumePublication(_ context.Context, vp *utils.VolumePublication) error {
+	if err := c.replace(boltVolumePublicationsBucket, vp.Name, vp); err != nil {
+		if MatchKeyNotFoundErr(err) {
+			return errors.NotFoundError("volume publication %s not found", vp.Name)
+		}
+		return err
+	}
+	return nil
+}
+
+func (c *BoltClient) GetVolumePublication(_ context.Context, vpName string) (*utils.VolumePublication, error) {
+	publication := &utils.VolumePublication{}
+	if err := c.get(boltVolumePublicationsBucket, vpName, publication); err != nil {
+		if MatchKeyNotFoundErr(err) {
+			return nil, errors.NotFoundError("volume publication %s not found", vpName)
+		}
+		return nil, err
+	}
+	return publication, nil
+}
+
+func (c *BoltClient) GetVolumePublications(context.Context) ([]*utils.VolumePublication, error) {
+	return boltList[utils.VolumePublication](c, boltVolumePublicationsBucket)
+}
+
+func (c *BoltClient) DeleteVolumePublication(_ context.Context, vp *utils.VolumePublication) error {
+	found, err := c.delete(boltVolumePublicationsBucket, vp.Name)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return errors.NotFoundError("volume publication %s not found", vp.Name)
+	}
+	return nil
+}
+
+// AddSnapshot writes the snapshot to the store, replacing any record left over from an earlier, failed attempt
+// to create it.
+func (c *BoltClient) AddSnapshot(_ context.Context, snapshot *storage.Snapshot) error {
+	return c.put(boltSnapshotsBucket, snapshot.ID(), snapshot.ConstructPersistent())
+}
+
+func (c *BoltClient) GetSnapshot(_ context.Context, volumeName, snapshotName string) (
+	*storage.SnapshotPersistent, error,
+) {
+	snapshot := &storage.SnapshotPersistent{}
+	if err := c.get(boltSnapshotsBucket, storage.MakeSnapshotID(volumeName, snapshotName), snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+func (c *BoltClient) GetSnapshots(context.Context) ([]*storage.SnapshotPersistent, error) {
+	return boltList[storage.SnapshotPersistent](c, boltSnapshotsBucket)
+}
+
+func (c *BoltClient) UpdateSnapshot(_ context.Context, snapshot *storage.Snapshot) error {
+	return c.replace(boltSnapshotsBucket, snapshot.ID(), snapshot.ConstructPersistent())
+}
+
+func (c *BoltClient) DeleteSnapshot(_ context.Context, snapshot *storage.Snapshot) error {
+	_, err := c.delete(boltSnapshotsBucket, snapshot.ID())
+	return err
+}
+
+func (c *BoltClient) DeleteSnapshots(context.Context) error {
+	return c.deleteAll(boltSnapshotsBucket)
+}
+
+// AddGroupSnapshot writes the group snapshot to the store, replacing any record left over from an earlier,
+// failed attempt to create it.
+func (c *BoltClient) AddGroupSnapshot(_ context.Context, groupSnapshot *storage.GroupSnapshot) error {
+	return c.put(boltGroupSnapshotsBucket, groupSnapshot.ID(), groupSnapshot.ConstructPersistent())
+}
+
+func (c *BoltClient) GetGroupSnapshot(_ context.Context, groupSnapshotName string) (
+	*storage.GroupSnapshotPersistent, error,
+) {
+	groupSnapshot := &storage.GroupSnapshotPersistent{}
+	if err := c.get(boltGroupSnapshotsBucket, groupSnapshotName, groupSnapshot); err != nil {
+		return nil, err
+	}
+	return groupSnapshot, nil
+}
+
+func (c *BoltClient) GetGroupSnapshots(context.Context) ([]*storage.GroupSnapshotPersistent, error) {
+	return boltList[storage.GroupSnapshotPersistent](c, boltGroupSnapshotsBucket)
+}
+
+func (c *BoltClient) DeleteGroupSnapshot(_ context.Context, groupSnapshot *storage.GroupSnapshot) error {
+	_, err := c.delete(boltGroupSnapshotsBucket, groupSnapshot.ID())
+	return err
+}
+
+func (c *BoltClient) AddSnapshotPolicy(_ context.Context, policy *storage.SnapshotPolicy) error {
+	return c.create(boltSnapshotPoliciesBucket, policy.ID(), "snapshot policy", policy.ConstructPersistent())
+}
+
+func (c *BoltClient) GetSnapshotPolicy(_ context.Context, policyName string) (
+	*storage.SnapshotPolicyPersistent, error,
+) {
+	policy := &storage.SnapshotPolicyPersistent{}
+	if err := c.get(boltSnapshotPoliciesBucket, policyName, policy); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+func (c *BoltClient) GetSnapshotPolicies(context.Context) ([]*storage.SnapshotPolicyPersistent, error) {
+	return boltList[storage.SnapshotPolicyPersistent](c, boltSnapshotPoliciesBucket)
+}
+
+func (c *BoltClient) UpdateSnapshotPolicy(_ context.Context, policy *storage.SnapshotPolicy) error {
+	return c.replace(boltSnapshotPoliciesBucket, policy.ID(), policy.ConstructPersistent())
+}
+
+func (c *BoltClient) DeleteSnapshotPolicy(_ context.Context, policy *storage.SnapshotPolicy) error {
+	_, err := c.delete(boltSnapshotPoliciesBucket, policy.ID())
+	return err
+}
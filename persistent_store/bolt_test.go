@@ -0,0 +1,369 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package persistentstore
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/netapp/trident/config"
+	"github.com/netapp/trident/storage"
+	sc "github.com/netapp/trident/storage_class"
+	"github.com/netapp/trident/utils"
+	"github.com/netapp/trident/utils/errors"
+)
+
+func getTestBoltClient(t *testing.T) (*BoltClient, string) {
+	path := filepath.Join(t.TempDir(), "trident.db")
+	p, err := NewBoltClient(path)
+	if err != nil {
+		t.Fatalf("Unable to open bolt store: %v", err)
+	}
+	t.Cleanup(func() { _ = p.Stop() })
+	return p, path
+}
+
+func TestBoltVersion(t *testing.T) {
+	p, path := getTestBoltClient(t)
+
+	if _, err := p.GetVersion(ctx()); !MatchKeyNotFoundErr(err) {
+		t.Fatalf("Expected key not found error for a new store; got %v", err)
+	}
+	if _, err := p.GetTridentUUID(ctx()); !MatchKeyNotFoundErr(err) {
+		t.Fatalf("Expected key not found error for a new store; got %v", err)
+	}
+
+	version := &config.PersistentStateVersion{
+		PersistentStoreVersion: string(BoltStore),
+		OrchestratorAPIVersion: config.OrchestratorAPIVersion,
+		PublicationsSynced:     true,
+	}
+	if err := p.SetVersion(ctx(), version); err != nil {
+		t.Fatalf("Unable to set version: %v", err)
+	}
+	tridentUUID, err := p.GetTridentUUID(ctx())
+	if err != nil || tridentUUID == "" {
+		t.Fatalf("Trident UUID was not assigned; %v", err)
+	}
+
+	// The version and UUID survive reopening the store
+	if err = p.Stop(); err != nil {
+		t.Fatalf("Unable to close bolt store: %v", err)
+	}
+	p, err = NewBoltClient(path)
+	if err != nil {
+		t.Fatalf("Unable to reopen bolt store: %v", err)
+	}
+	defer p.Stop()
+
+	if err = p.SetVersion(ctx(), version); err != nil {
+		t.Fatalf("Unable to set version: %v", err)
+	}
+	recoveredVersion, err := p.GetVersion(ctx())
+	if err != nil {
+		t.Fatalf("Unable to get version: %v", err)
+	}
+	if !reflect.DeepEqual(version, recoveredVersion) {
+		t.Errorf("Version doesn't match; expected %v, got %v", version, recoveredVersion)
+	}
+	if recoveredUUID, _ := p.GetTridentUUID(ctx()); recoveredUUID != tridentUUID {
+		t.Errorf("Trident UUID changed from %s to %s", tridentUUID, recoveredUUID)
+	}
+}
+
+func TestBoltBackends(t *testing.T) {
+	p, _ := getTestBoltClient(t)
+
+	backend := getFakeBackendWithName("fake1")
+	backend.SetBackendUUID(uuid.NewString())
+	if err := p.AddBackend(ctx(), backend); err != nil {
+		t.Fatalf("Unable to add backend: %v", err)
+	}
+	if err := p.AddBackend(ctx(), backend); err == nil {
+		t.Error("Should not have been able to add a duplicate backend")
+	}
+
+	recoveredBackend, err := p.GetBackend(ctx(), backend.Name())
+	if err != nil {
+		t.Fatalf("Unable to get backend: %v", err)
+	}
+	if !reflect.DeepEqual(backend.ConstructPersistent(ctx()), recoveredBackend) {
+		t.Error("Backend state doesn't match!")
+	}
+
+	if err = p.UpdateBackend(ctx(), getFakeBackendWithName("missing")); !MatchKeyNotFoundErr(err) {
+		t.Errorf("Expected key not found error updating a missing backend; got %v", err)
+	}
+
+	// Renaming a backend keeps its UUID, so its volumes need no change
+	volume := storage.NewVolume(&storage.VolumeConfig{
+		Version: config.OrchestratorAPIVersion, Name: "vol1", Size: "1GB", Protocol: config.File,
+	}, backend.BackendUUID(), storagePool, false, storage.VolumeStateOnline)
+	if err = p.AddVolume(ctx(), volume); err != nil {
+		t.Fatalf("Unable to add volume: %v", err)
+	}
+	renamedBackend := getFakeBackendWithName("fake2")
+	renamedBackend.SetBackendUUID(backend.BackendUUID())
+	if err = p.ReplaceBackendAndUpdateVolumes(ctx(), backend, renamedBackend); err != nil {
+		t.Fatalf("Unable to replace backend: %v", err)
+	}
+	if _, err = p.GetBackend(ctx(), backend.Name()); !MatchKeyNotFoundErr(err) {
+		t.Errorf("Original backend should have been replaced; %v", err)
+	}
+	backends, err := p.GetBackends(ctx())
+	if err != nil || len(backends) != 1 || backends[0].Name != "fake2" {
+		t.Fatalf("Backend was not renamed; backends: %v, err: %v", backends, err)
+	}
+	recoveredVolume, err := p.GetVolume(ctx(), "vol1")
+	if err != nil || recoveredVolume.BackendUUID != backends[0].BackendUUID {
+		t.Errorf("Volume no longer refers to its backend; volume: %v, err: %v", recoveredVolume, err)
+	}
+
+	if err = p.DeleteBackend(ctx(), renamedBackend); err != nil {
+		t.Errorf("Unable to delete backend: %v", err)
+	}
+	if err = p.DeleteBackend(ctx(), renamedBackend); err != nil {
+		t.Errorf("Deleting a missing backend should have succeeded: %v", err)
+	}
+	if backends, _ = p.GetBackends(ctx()); len(backends) != 0 {
+		t.Errorf("Expected no backends; got %d", len(backends))
+	}
+}
+
+func TestBoltVolumeTransactions(t *testing.T) {
+	p, _ := getTestBoltClient(t)
+
+	firstTxn := &storage.VolumeTransaction{
+		Config: &storage.VolumeConfig{
+			Version: config.OrchestratorAPIVersion, Name: "testVol", Size: "1GB", StorageClass: "gold",
+		},
+		Op: storage.AddVolume,
+	}
+	secondTxn := &storage.VolumeTransaction{
+		Config: &storage.VolumeConfig{
+			Version: config.OrchestratorAPIVersion, Name: "testVol", Size: "1GB", StorageClass: "silver",
+		},
+		Op: storage.AddVolume,
+	}
+
+	if txn, err := p.GetVolumeTransaction(ctx(), firstTxn); txn != nil || err != nil {
+		t.Fatalf("Expected no transaction; got %v, %v", txn, err)
+	}
+	if err := p.AddVolumeTransaction(ctx(), firstTxn); err != nil {
+		t.Fatalf("Unable to add volume transaction: %v", err)
+	}
+	if err := p.AddVolumeTransaction(ctx(), secondTxn); !IsAlreadyExistsError(err) {
+		t.Errorf("Expected already exists error adding a second transaction; got %v", err)
+	}
+
+	txn, err := p.GetVolumeTransaction(ctx(), secondTxn)
+	if err != nil || !reflect.DeepEqual(firstTxn, txn) {
+		t.Fatalf("Transaction doesn't match; got %v, %v", txn, err)
+	}
+
+	if err = p.UpdateVolumeTransaction(ctx(), secondTxn); err != nil {
+		t.Errorf("Unable to update volume transaction: %v", err)
+	}
+	txns, err := p.GetVolumeTransactions(ctx())
+	if err != nil || len(txns) != 1 || txns[0].Config.StorageClass != "silver" {
+		t.Fatalf("Transaction was not updated; got %v, %v", txns, err)
+	}
+
+	if err = p.DeleteVolumeTransaction(ctx(), firstTxn); err != nil {
+		t.Errorf("Unable to delete volume transaction: %v", err)
+	}
+	if err = p.DeleteVolumeTransaction(ctx(), firstTxn); err != nil {
+		t.Errorf("Deleting a missing transaction should have succeeded: %v", err)
+	}
+	if err = p.UpdateVolumeTransaction(ctx(), firstTxn); !MatchKeyNotFoundErr(err) {
+		t.Errorf("Expected key not found error updating a missing transaction; got %v", err)
+	}
+}
+
+func TestBoltVolumesAndSnapshots(t *testing.T) {
+	p, _ := getTestBoltClient(t)
+
+	volConfig := &storage.VolumeConfig{
+		Version: config.OrchestratorAPIVersion, Name: "vol1", Size: "1GB", Protocol: config.File,
+	}
+	volume := storage.NewVolume(volConfig, "uuid1", storagePool, false, storage.VolumeStateOnline)
+	if err := p.UpdateVolume(ctx(), volume); !MatchKeyNotFoundErr(err) {
+		t.Errorf("Expected key not found error updating a missing volume; got %v", err)
+	}
+	if err := p.AddVolume(ctx(), volume); err != nil {
+		t.Fatalf("Unable to add volume: %v", err)
+	}
+
+	// Adding a volume again replaces it
+	volume.Config.Size = "2GB"
+	if err := p.AddVolume(ctx(), volume); err != nil {
+		t.Fatalf("Unable to replace volume: %v", err)
+	}
+	recoveredVolume, err := p.GetVolume(ctx(), "vol1")
+	if err != nil || !reflect.DeepEqual(volume.ConstructExternal(), recoveredVolume) {
+		t.Fatalf("Volume doesn't match; got %v, %v", recoveredVolume, err)
+	}
+
+	snapshot := storage.NewSnapshot(&storage.SnapshotConfig{
+		Version: config.OrchestratorAPIVersion, Name: "snap1", VolumeName: "vol1",
+	}, "2024-01-01T00:00:00Z", 1024, storage.SnapshotStateOnline)
+	if err = p.AddSnapshot(ctx(), snapshot); err != nil {
+		t.Fatalf("Unable to add snapshot: %v", err)
+	}
+	snapshot.SizeBytes = 2048
+	if err = p.UpdateSnapshot(ctx(), snapshot); err != nil {
+		t.Fatalf("Unable to update snapshot: %v", err)
+	}
+	recoveredSnapshot, err := p.GetSnapshot(ctx(), "vol1", "snap1")
+	if err != nil || !reflect.DeepEqual(snapshot.ConstructPersistent(), recoveredSnapshot) {
+		t.Fatalf("Snapshot doesn't match; got %v, %v", recoveredSnapshot, err)
+	}
+
+	groupSnapshot := storage.NewGroupSnapshot(&storage.GroupSnapshotConfig{
+		Version: config.OrchestratorAPIVersion, Name: "groupsnapshot-1", VolumeNames: []string{"vol1"},
+	}, []string{snapshot.ID()}, "2024-01-01T00:00:00Z")
+	if err = p.AddGroupSnapshot(ctx(), groupSnapshot); err != nil {
+		t.Fatalf("Unable to add group snapshot: %v", err)
+	}
+	recoveredGroupSnapshot, err := p.GetGroupSnapshot(ctx(), groupSnapshot.ID())
+	if err != nil || !reflect.DeepEqual(groupSnapshot.ConstructPersistent(), recoveredGroupSnapshot) {
+		t.Fatalf("Group snapshot doesn't match; got %v, %v", recoveredGroupSnapshot, err)
+	}
+	if err = p.DeleteGroupSnapshot(ctx(), groupSnapshot); err != nil {
+		t.Errorf("Unable to delete group snapshot: %v", err)
+	}
+
+	if err = p.DeleteSnapshots(ctx()); err != nil {
+		t.Errorf("Unable to delete snapshots: %v", err)
+	}
+	if _, err = p.GetSnapshot(ctx(), "vol1", "snap1"); !MatchKeyNotFoundErr(err) {
+		t.Errorf("Expected key not found error for a deleted snapshot; got %v", err)
+	}
+	if err = p.DeleteVolume(ctx(), volume); err != nil {
+		t.Errorf("Unable to delete volume: %v", err)
+	}
+	if volumes, _ := p.GetVolumes(ctx()); len(volumes) != 0 {
+		t.Errorf("Expected no volumes; got %d", len(volumes))
+	}
+}
+
+func TestBoltNodesAndPublications(t *testing.T) {
+	p, _ := getTestBoltClient(t)
+
+	node := &utils.Node{Name: "node1", IQN: "iqn.2016-04.com.example:node1"}
+	if err := p.AddOrUpdateNode(ctx(), node); err != nil {
+		t.Fatalf("Unable to add node: %v", err)
+	}
+	node.IQN = "iqn.2016-04.com.example:node1-new"
+	if err := p.AddOrUpdateNode(ctx(), node); err != nil {
+		t.Fatalf("Unable to update node: %v", err)
+	}
+	nodes, err := p.GetNodes(ctx())
+	if err != nil || len(nodes) != 1 || !reflect.DeepEqual(node, nodes[0]) {
+		t.Fatalf("Nodes don't match; got %v, %v", nodes, err)
+	}
+
+	publication := &utils.VolumePublication{Name: "vol1.node1", NodeName: "node1", VolumeName: "vol1"}
+	if err = p.UpdateVolumePublication(ctx(), publication); !errors.IsNotFoundError(err) {
+		t.Errorf("Expected not found error updating a missing publication; got %v", err)
+	}
+	if err = p.AddVolumePublication(ctx(), publication); err != nil {
+		t.Fatalf("Unable to add publication: %v", err)
+	}
+	if err = p.AddVolumePublication(ctx(), publication); !IsAlreadyExistsError(err) {
+		t.Errorf("Expected already exists error adding a duplicate publication; got %v", err)
+	}
+	publication.ReadOnly = true
+	if err = p.UpdateVolumePublication(ctx(), publication); err != nil {
+		t.Fatalf("Unable to update publication: %v", err)
+	}
+	recoveredPublication, err := p.GetVolumePublication(ctx(), publication.Name)
+	if err != nil || !reflect.DeepEqual(publication, recoveredPublication) {
+		t.Fatalf("Publication doesn't match; got %v, %v", recoveredPublication, err)
+	}
+	if err = p.DeleteVolumePublication(ctx(), publication); err != nil {
+		t.Errorf("Unable to delete publication: %v", err)
+	}
+	if _, err = p.GetVolumePublication(ctx(), publication.Name); !errors.IsNotFoundError(err) {
+		t.Errorf("Expected not found error for a deleted publication; got %v", err)
+	}
+	if err = p.DeleteVolumePublication(ctx(), publication); !errors.IsNotFoundError(err) {
+		t.Errorf("Expected not found error deleting a missing publication; got %v", err)
+	}
+
+	storageClass := sc.New(&sc.Config{Name: "gold"})
+	if err = p.AddStorageClass(ctx(), storageClass); err != nil {
+		t.Fatalf("Unable to add storage class: %v", err)
+	}
+	if err = p.AddStorageClass(ctx(), storageClass); !IsAlreadyExistsError(err) {
+		t.Errorf("Expected already exists error adding a duplicate storage class; got %v", err)
+	}
+	if recoveredSC, err := p.GetStorageClass(ctx(), "gold"); err != nil || recoveredSC.GetName() != "gold" {
+		t.Errorf("Storage class doesn't match; got %v, %v", recoveredSC, err)
+	}
+
+	if err = p.DeleteNode(ctx(), node); err != nil {
+		t.Errorf("Unable to delete node: %v", err)
+	}
+	if _, err = p.GetNode(ctx(), node.Name); !MatchKeyNotFoundErr(err) {
+		t.Errorf("Expected key not found error for a deleted node; got %v", err)
+	}
+}
+
+func TestBoltSnapshotPolicies(t *testing.T) {
+	p, path := getTestBoltClient(t)
+
+	policy, err := storage.NewSnapshotPolicy(&storage.SnapshotPolicyConfig{
+		Version: config.OrchestratorAPIVersion, Name: "hourly", Schedule: "@hourly", RetentionCount: 24,
+		VolumeNames: []string{"vol1"},
+	}, time.Date(2024, 10, 16, 12, 30, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Unable to create snapshot policy: %v", err)
+	}
+	if err = p.UpdateSnapshotPolicy(ctx(), policy); !MatchKeyNotFoundErr(err) {
+		t.Errorf("Expected key not found error updating a missing snapshot policy; got %v", err)
+	}
+	if err = p.AddSnapshotPolicy(ctx(), policy); err != nil {
+		t.Fatalf("Unable to add snapshot policy: %v", err)
+	}
+	if err = p.AddSnapshotPolicy(ctx(), policy); !IsAlreadyExistsError(err) {
+		t.Errorf("Expected already exists error adding a duplicate snapshot policy; got %v", err)
+	}
+
+	policy.Volumes["vol1"] = &storage.SnapshotPolicyVolumeStatus{
+		LastRunTime: "2024-10-16T13:00:00Z", LastSnapshotName: "hourly-20241016T130000Z", RetainedSnapshots: 1,
+	}
+	policy.ScheduleNextRun(time.Date(2024, 10, 16, 13, 0, 0, 0, time.UTC))
+	if err = p.UpdateSnapshotPolicy(ctx(), policy); err != nil {
+		t.Fatalf("Unable to update snapshot policy: %v", err)
+	}
+
+	// Policies and their status must survive a restart
+	if err = p.Stop(); err != nil {
+		t.Fatalf("Unable to close bolt store: %v", err)
+	}
+	if p, err = NewBoltClient(path); err != nil {
+		t.Fatalf("Unable to reopen bolt store: %v", err)
+	}
+	t.Cleanup(func() { _ = p.Stop() })
+
+	recoveredPolicy, err := p.GetSnapshotPolicy(ctx(), "hourly")
+	if err != nil || !reflect.DeepEqual(policy.ConstructPersistent(), recoveredPolicy) {
+		t.Fatalf("Snapshot policy doesn't match; got %v, %v", recoveredPolicy, err)
+	}
+	policies, err := p.GetSnapshotPolicies(ctx())
+	if err != nil || len(policies) != 1 || !reflect.DeepEqual(policy.ConstructPersistent(), policies[0]) {
+		t.Fatalf("Snapshot policies don't match; got %v, %v", policies, err)
+	}
+
+	if err = p.DeleteSnapshotPolicy(ctx(), policy); err != nil {
+		t.Errorf("Unable to delete snapshot policy: %v", err)
+	}
+	if _, err = p.GetSnapshotPolicy(ctx(), "hourly"); !MatchKeyNotFoundErr(err) {
+		t.Errorf("Expected key not found error for a deleted snapshot policy; got %v", err)
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/netapp/trident/utils"
+)
+
+func (in *TridentActionVolumeMove) GetObjectMeta() metav1.ObjectMeta {
+	return in.ObjectMeta
+}
+
+func (in *TridentActionVolumeMove) GetKind() string {
+	return "TridentActionVolumeMove"
+}
+
+func (in *TridentActionVolumeMove) GetFinalizers() []string {
+	if in.ObjectMeta.Finalizers != nil {
+		return in.ObjectMeta.Finalizers
+	}
+	return []string{}
+}
+
+func (in *TridentActionVolumeMove) HasTridentFinalizers() bool {
+	for _, finalizerName := range GetTridentFinalizers() {
+		if utils.SliceContainsString(in.ObjectMeta.Finalizers, finalizerName) {
+			return true
+		}
+	}
+	return false
+}
+
+func (in *TridentActionVolumeMove) AddTridentFinalizers() {
+	for _, finalizerName := range GetTridentFinalizers() {
+		if !utils.SliceContainsString(in.ObjectMeta.Finalizers, finalizerName) {
+			in.ObjectMeta.Finalizers = append(in.ObjectMeta.Finalizers, finalizerName)
+		}
+	}
+}
+
+func (in *TridentActionVolumeMove) RemoveTridentFinalizers() {
+	for _, finalizerName := range GetTridentFinalizers() {
+		in.ObjectMeta.Finalizers = utils.RemoveStringFromSlice(in.ObjectMeta.Finalizers, finalizerName)
+	}
+}
+
+// IsNew indicates whether the volume move action has not been started.
+func (in *TridentActionVolumeMove) IsNew() bool {
+	return in.Status.State == "" && in.Status.CompletionTime == nil && in.DeletionTimestamp == nil
+}
+
+// IsComplete indicates whether the volume move action has been completed.
+func (in *TridentActionVolumeMove) IsComplete() bool {
+	return in.Status.CompletionTime != nil && !in.Status.CompletionTime.IsZero()
+}
+
+// Succeeded indicates whether the volume move action succeeded.
+func (in *TridentActionVolumeMove) Succeeded() bool {
+	return in.Status.State == TridentActionStateSucceeded
+}
+
+func (in *TridentActionVolumeMove) InProgress() bool {
+	return in.Status.State == TridentActionStateInProgress
+}
+
+// Failed indicates whether the volume move action failed.
+func (in *TridentActionVolumeMove) Failed() bool {
+	return in.Status.State == TridentActionStateFailed
+}
@@ -0,0 +1,91 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package v1
+
+import (
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/netapp/trident/storage"
+	"github.com/netapp/trident/utils"
+)
+
+// NewTridentGroupSnapshot creates a new group snapshot CRD object from an internal GroupSnapshotPersistent object
+func NewTridentGroupSnapshot(persistent *storage.GroupSnapshotPersistent) (*TridentGroupSnapshot, error) {
+	tgs := &TridentGroupSnapshot{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "trident.netapp.io/v1",
+			Kind:       "TridentGroupSnapshot",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       NameFix(persistent.ID()),
+			Finalizers: GetTridentFinalizers(),
+		},
+	}
+
+	if err := tgs.Apply(persistent); err != nil {
+		return nil, err
+	}
+
+	return tgs, nil
+}
+
+// Apply applies changes from an internal GroupSnapshotPersistent object to its Kubernetes CRD equivalent
+func (in *TridentGroupSnapshot) Apply(persistent *storage.GroupSnapshotPersistent) error {
+	if NameFix(persistent.ID()) != in.ObjectMeta.Name {
+		return ErrNamesDontMatch
+	}
+
+	config, err := json.Marshal(persistent.Config)
+	if err != nil {
+		return err
+	}
+
+	in.Spec.Raw = config
+	in.SnapshotIDs = persistent.SnapshotIDs
+	in.Created = persistent.Created
+
+	return nil
+}
+
+// Persistent converts a Kubernetes CRD object into its internal GroupSnapshotPersistent equivalent
+func (in *TridentGroupSnapshot) Persistent() (*storage.GroupSnapshotPersistent, error) {
+	persistent := &storage.GroupSnapshotPersistent{}
+
+	persistent.Config = &storage.GroupSnapshotConfig{}
+	persistent.SnapshotIDs = in.SnapshotIDs
+	persistent.Created = in.Created
+
+	return persistent, json.Unmarshal(in.Spec.Raw, persistent.Config)
+}
+
+func (in *TridentGroupSnapshot) GetObjectMeta() metav1.ObjectMeta {
+	return in.ObjectMeta
+}
+
+func (in *TridentGroupSnapshot) GetKind() string {
+	return "TridentGroupSnapshot"
+}
+
+func (in *TridentGroupSnapshot) GetFinalizers() []string {
+	if in.ObjectMeta.Finalizers != nil {
+		return in.ObjectMeta.Finalizers
+	}
+	return []string{}
+}
+
+func (in *TridentGroupSnapshot) HasTridentFinalizers() bool {
+	for _, finalizerName := range GetTridentFinalizers() {
+		if utils.SliceContainsString(in.ObjectMeta.Finalizers, finalizerName) {
+			return true
+		}
+	}
+	return false
+}
+
+func (in *TridentGroupSnapshot) RemoveTridentFinalizers() {
+	for _, finalizerName := range GetTridentFinalizers() {
+		in.ObjectMeta.Finalizers = utils.RemoveStringFromSlice(in.ObjectMeta.Finalizers, finalizerName)
+	}
+}
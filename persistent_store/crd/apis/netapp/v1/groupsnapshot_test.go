@@ -0,0 +1,102 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package v1
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/netapp/trident/storage"
+)
+
+func TestNewGroupSnapshot(t *testing.T) {
+	// Build group snapshot
+	testGroupSnapshot := getFakeGroupSnapshot()
+
+	// Convert to Kubernetes Object using NewTridentGroupSnapshot
+	groupSnapshotCRD, err := NewTridentGroupSnapshot(testGroupSnapshot.ConstructPersistent())
+	if err != nil {
+		t.Fatal("Unable to construct TridentGroupSnapshot CRD: ", err)
+	}
+
+	// Build expected Kubernetes Object
+	expectedCRD := getFakeGroupSnapshotCRD(testGroupSnapshot)
+
+	// Compare
+	if !reflect.DeepEqual(groupSnapshotCRD, expectedCRD) {
+		t.Fatalf("TridentGroupSnapshot does not match expected result, got %v expected %v",
+			groupSnapshotCRD, expectedCRD)
+	}
+}
+
+func TestGroupSnapshot_Persistent(t *testing.T) {
+	// Build group snapshot
+	testGroupSnapshot := getFakeGroupSnapshot()
+
+	// Build expected Kubernetes Object
+	groupSnapshotCRD := getFakeGroupSnapshotCRD(testGroupSnapshot)
+
+	// Build persistent object by calling TridentGroupSnapshot.Persistent
+	persistent, err := groupSnapshotCRD.Persistent()
+	if err != nil {
+		t.Fatal("Unable to construct TridentGroupSnapshot persistent object: ", err)
+	}
+
+	// Build expected persistent object
+	expected := testGroupSnapshot.ConstructPersistent()
+
+	// Compare
+	if !reflect.DeepEqual(persistent, expected) {
+		t.Fatalf("TridentGroupSnapshot does not match expected result, got %v expected %v", persistent, expected)
+	}
+}
+
+func TestGroupSnapshot_ApplyNameMismatch(t *testing.T) {
+	groupSnapshotCRD := getFakeGroupSnapshotCRD(getFakeGroupSnapshot())
+
+	other := getFakeGroupSnapshot()
+	other.Config.Name = "othergroupsnap"
+
+	if err := groupSnapshotCRD.Apply(other.ConstructPersistent()); err != ErrNamesDontMatch {
+		t.Fatalf("Expected %v, got %v", ErrNamesDontMatch, err)
+	}
+}
+
+func getFakeGroupSnapshot() *storage.GroupSnapshot {
+	testGroupSnapshotConfig := &storage.GroupSnapshotConfig{
+		Version:      "1",
+		Name:         "groupsnap1",
+		InternalName: "groupsnap1",
+		VolumeNames:  []string{"vol1", "vol2"},
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	snapshotIDs := []string{"vol1/groupsnap1", "vol2/groupsnap1"}
+
+	return storage.NewGroupSnapshot(testGroupSnapshotConfig, snapshotIDs, now)
+}
+
+func getFakeGroupSnapshotCRD(groupSnapshot *storage.GroupSnapshot) *TridentGroupSnapshot {
+	crd := &TridentGroupSnapshot{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "trident.netapp.io/v1",
+			Kind:       "TridentGroupSnapshot",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       NameFix(groupSnapshot.ID()),
+			Finalizers: GetTridentFinalizers(),
+		},
+		Spec: runtime.RawExtension{
+			Raw: MustEncode(json.Marshal(groupSnapshot.ConstructPersistent().Config)),
+		},
+		SnapshotIDs: groupSnapshot.SnapshotIDs,
+		Created:     groupSnapshot.Created,
+	}
+
+	return crd
+}
@@ -58,10 +58,18 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&TridentVersionList{},
 		&TridentSnapshot{},
 		&TridentSnapshotList{},
+		&TridentGroupSnapshot{},
+		&TridentGroupSnapshotList{},
 		&TridentVolumeReference{},
 		&TridentVolumeReferenceList{},
 		&TridentActionSnapshotRestore{},
 		&TridentActionSnapshotRestoreList{},
+		&TridentActionVolumeMove{},
+		&TridentActionVolumeMoveList{},
+		&TridentSnapshotPolicy{},
+		&TridentSnapshotPolicyList{},
+		&TridentSnapshotPolicyState{},
+		&TridentSnapshotPolicyStateList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
@@ -0,0 +1,58 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/netapp/trident/utils"
+)
+
+const (
+	// SnapshotPolicyInvalid implies the user supplied a non-feasible TridentSnapshotPolicy spec
+	SnapshotPolicyInvalid      = "invalid"
+	SnapshotPolicyUpdateFailed = "updateFailed"
+)
+
+func (in *TridentSnapshotPolicy) GetObjectMeta() metav1.ObjectMeta {
+	return in.ObjectMeta
+}
+
+func (in *TridentSnapshotPolicy) GetKind() string {
+	return "TridentSnapshotPolicy"
+}
+
+func (in *TridentSnapshotPolicy) GetFinalizers() []string {
+	if in.ObjectMeta.Finalizers != nil {
+		return in.ObjectMeta.Finalizers
+	}
+	return []string{}
+}
+
+func (in *TridentSnapshotPolicy) HasTridentFinalizers() bool {
+	for _, finalizerName := range GetTridentFinalizers() {
+		if utils.SliceContainsString(in.ObjectMeta.Finalizers, finalizerName) {
+			return true
+		}
+	}
+	return false
+}
+
+func (in *TridentSnapshotPolicy) AddTridentFinalizers() {
+	for _, finalizerName := range GetTridentFinalizers() {
+		if !utils.SliceContainsString(in.ObjectMeta.Finalizers, finalizerName) {
+			in.ObjectMeta.Finalizers = append(in.ObjectMeta.Finalizers, finalizerName)
+		}
+	}
+}
+
+func (in *TridentSnapshotPolicy) RemoveTridentFinalizers() {
+	for _, finalizerName := range GetTridentFinalizers() {
+		in.ObjectMeta.Finalizers = utils.RemoveStringFromSlice(in.ObjectMeta.Finalizers, finalizerName)
+	}
+}
+
+// CoreName returns the name by which the policy is known to the Trident core, which is unique across namespaces.
+func (in *TridentSnapshotPolicy) CoreName() string {
+	return in.Namespace + "_" + in.Name
+}
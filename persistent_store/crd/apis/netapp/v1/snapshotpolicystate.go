@@ -0,0 +1,105 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package v1
+
+import (
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/netapp/trident/storage"
+	"github.com/netapp/trident/utils"
+)
+
+// NewTridentSnapshotPolicyState creates a new snapshot policy CRD object from an internal SnapshotPolicyPersistent
+// object
+func NewTridentSnapshotPolicyState(persistent *storage.SnapshotPolicyPersistent) (*TridentSnapshotPolicyState, error) {
+	tsps := &TridentSnapshotPolicyState{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "trident.netapp.io/v1",
+			Kind:       "TridentSnapshotPolicyState",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       NameFix(persistent.ID()),
+			Finalizers: GetTridentFinalizers(),
+		},
+	}
+
+	if err := tsps.Apply(persistent); err != nil {
+		return nil, err
+	}
+
+	return tsps, nil
+}
+
+// Apply applies changes from an internal SnapshotPolicyPersistent object to its Kubernetes CRD equivalent
+func (in *TridentSnapshotPolicyState) Apply(persistent *storage.SnapshotPolicyPersistent) error {
+	if NameFix(persistent.ID()) != in.ObjectMeta.Name {
+		return ErrNamesDontMatch
+	}
+
+	config, err := json.Marshal(persistent.Config)
+	if err != nil {
+		return err
+	}
+	volumes, err := json.Marshal(persistent.Volumes)
+	if err != nil {
+		return err
+	}
+
+	in.Spec.Raw = config
+	in.NextRunTime = persistent.NextRunTime
+	in.Volumes.Raw = volumes
+
+	return nil
+}
+
+// Persistent converts a Kubernetes CRD object into its internal SnapshotPolicyPersistent equivalent
+func (in *TridentSnapshotPolicyState) Persistent() (*storage.SnapshotPolicyPersistent, error) {
+	persistent := &storage.SnapshotPolicyPersistent{}
+
+	persistent.Config = &storage.SnapshotPolicyConfig{}
+	persistent.NextRunTime = in.NextRunTime
+	persistent.Volumes = make(map[string]*storage.SnapshotPolicyVolumeStatus)
+
+	if err := json.Unmarshal(in.Spec.Raw, persistent.Config); err != nil {
+		return nil, err
+	}
+	if len(in.Volumes.Raw) > 0 {
+		if err := json.Unmarshal(in.Volumes.Raw, &persistent.Volumes); err != nil {
+			return nil, err
+		}
+	}
+
+	return persistent, nil
+}
+
+func (in *TridentSnapshotPolicyState) GetObjectMeta() metav1.ObjectMeta {
+	return in.ObjectMeta
+}
+
+func (in *TridentSnapshotPolicyState) GetKind() string {
+	return "TridentSnapshotPolicyState"
+}
+
+func (in *TridentSnapshotPolicyState) GetFinalizers() []string {
+	if in.ObjectMeta.Finalizers != nil {
+		return in.ObjectMeta.Finalizers
+	}
+	return []string{}
+}
+
+func (in *TridentSnapshotPolicyState) HasTridentFinalizers() bool {
+	for _, finalizerName := range GetTridentFinalizers() {
+		if utils.SliceContainsString(in.ObjectMeta.Finalizers, finalizerName) {
+			return true
+		}
+	}
+	return false
+}
+
+func (in *TridentSnapshotPolicyState) RemoveTridentFinalizers() {
+	for _, finalizerName := range GetTridentFinalizers() {
+		in.ObjectMeta.Finalizers = utils.RemoveStringFromSlice(in.ObjectMeta.Finalizers, finalizerName)
+	}
+}
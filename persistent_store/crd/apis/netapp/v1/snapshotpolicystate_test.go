@@ -0,0 +1,115 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package v1
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/netapp/trident/storage"
+)
+
+func TestNewSnapshotPolicyState(t *testing.T) {
+	// Build snapshot policy
+	testPolicy := getFakeSnapshotPolicy(t)
+
+	// Convert to Kubernetes Object using NewTridentSnapshotPolicyState
+	policyCRD, err := NewTridentSnapshotPolicyState(testPolicy.ConstructPersistent())
+	if err != nil {
+		t.Fatal("Unable to construct TridentSnapshotPolicyState CRD: ", err)
+	}
+
+	// Build expected Kubernetes Object
+	expectedCRD := getFakeSnapshotPolicyStateCRD(testPolicy)
+
+	// Compare
+	if !reflect.DeepEqual(policyCRD, expectedCRD) {
+		t.Fatalf("TridentSnapshotPolicyState does not match expected result, got %v expected %v",
+			policyCRD, expectedCRD)
+	}
+}
+
+func TestSnapshotPolicyState_Persistent(t *testing.T) {
+	// Build snapshot policy
+	testPolicy := getFakeSnapshotPolicy(t)
+
+	// Build expected Kubernetes Object
+	policyCRD := getFakeSnapshotPolicyStateCRD(testPolicy)
+
+	// Build persistent object by calling TridentSnapshotPolicyState.Persistent
+	persistent, err := policyCRD.Persistent()
+	if err != nil {
+		t.Fatal("Unable to construct TridentSnapshotPolicyState persistent object: ", err)
+	}
+
+	// Build expected persistent object
+	expected := testPolicy.ConstructPersistent()
+
+	// Compare
+	if !reflect.DeepEqual(persistent, expected) {
+		t.Fatalf("TridentSnapshotPolicyState does not match expected result, got %v expected %v",
+			persistent, expected)
+	}
+}
+
+func TestSnapshotPolicyState_ApplyNameMismatch(t *testing.T) {
+	policyCRD := getFakeSnapshotPolicyStateCRD(getFakeSnapshotPolicy(t))
+
+	other := getFakeSnapshotPolicy(t)
+	other.Config.Name = "otherpolicy"
+
+	if err := policyCRD.Apply(other.ConstructPersistent()); err != ErrNamesDontMatch {
+		t.Fatalf("Expected %v, got %v", ErrNamesDontMatch, err)
+	}
+}
+
+func getFakeSnapshotPolicy(t *testing.T) *storage.SnapshotPolicy {
+	testPolicyConfig := &storage.SnapshotPolicyConfig{
+		Version:        "1",
+		Name:           "hourly",
+		Schedule:       "@hourly",
+		RetentionCount: 24,
+		VolumeNames:    []string{"vol1", "vol2"},
+	}
+
+	policy, err := storage.NewSnapshotPolicy(testPolicyConfig, time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal("Unable to construct snapshot policy: ", err)
+	}
+	policy.Volumes["vol1"] = &storage.SnapshotPolicyVolumeStatus{
+		LastRunTime:       "2024-05-01T10:00:00Z",
+		LastSnapshotName:  "hourly-20240501T100000Z",
+		RetainedSnapshots: 3,
+	}
+
+	return policy
+}
+
+func getFakeSnapshotPolicyStateCRD(policy *storage.SnapshotPolicy) *TridentSnapshotPolicyState {
+	persistent := policy.ConstructPersistent()
+
+	crd := &TridentSnapshotPolicyState{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "trident.netapp.io/v1",
+			Kind:       "TridentSnapshotPolicyState",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       NameFix(policy.ID()),
+			Finalizers: GetTridentFinalizers(),
+		},
+		Spec: runtime.RawExtension{
+			Raw: MustEncode(json.Marshal(persistent.Config)),
+		},
+		NextRunTime: persistent.NextRunTime,
+		Volumes: runtime.RawExtension{
+			Raw: MustEncode(json.Marshal(persistent.Volumes)),
+		},
+	}
+
+	return crd
+}
@@ -451,6 +451,32 @@ type TridentSnapshotList struct {
 	Items []*TridentSnapshot `json:"items"`
 }
 
+// TridentGroupSnapshot defines a Trident group snapshot, a set of snapshots of several volumes taken together.
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TridentGroupSnapshot struct {
+	metav1.TypeMeta `json:",inline"`
+	// +k8s:openapi-gen=false
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Specification of the group snapshot
+	Spec runtime.RawExtension `json:"spec"`
+	// The IDs of the member snapshots, one per volume
+	SnapshotIDs []string `json:"snapshotIDs"`
+	// The UTC time that the group snapshot was created, in RFC3339 format
+	Created string `json:"dateCreated"`
+}
+
+// TridentGroupSnapshotList is a list of TridentGroupSnapshot objects.
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TridentGroupSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// List of TridentGroupSnapshot objects
+	Items []*TridentGroupSnapshot `json:"items"`
+}
+
 // TridentVolumeReference defines a PVC whose backing volume Trident may share to other namespaces.
 // +genclient
 // +k8s:openapi-gen=true
@@ -525,3 +551,134 @@ type TridentActionSnapshotRestoreList struct {
 	// List of TridentActionSnapshotRestore objects
 	Items []*TridentActionSnapshotRestore `json:"items"`
 }
+
+// TridentActionVolumeMove defines an imperative action to move a volume to another storage pool or backend.
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TridentActionVolumeMove struct {
+	metav1.TypeMeta `json:",inline"`
+	// +k8s:openapi-gen=false
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Input spec for TridentActionVolumeMove
+	Spec TridentActionVolumeMoveSpec `json:"spec"`
+
+	// Completion status for TridentActionVolumeMove
+	Status TridentActionVolumeMoveStatus `json:"status"`
+}
+
+// TridentActionVolumeMoveSpec defines the arguments of TridentActionVolumeMove
+type TridentActionVolumeMoveSpec struct {
+	// PVCName is the name of the PVC (not the PV) whose bound volume is to be moved
+	PVCName string `json:"pvcName"`
+	// DestinationBackend is the name of the backend to move the volume to; defaults to the volume's backend
+	DestinationBackend string `json:"destinationBackend,omitempty"`
+	// DestinationPool is the name of the storage pool to move the volume to
+	DestinationPool string `json:"destinationPool"`
+}
+
+// TridentActionVolumeMoveStatus defines the result of TridentActionVolumeMove
+type TridentActionVolumeMoveStatus struct {
+	State          string       `json:"state,omitempty"`
+	Message        string       `json:"message,omitempty"`
+	StartTime      *metav1.Time `json:"startTime,omitempty"`
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// TridentActionVolumeMoveList is a list of TridentActionVolumeMove objects.
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TridentActionVolumeMoveList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// List of TridentActionVolumeMove objects
+	Items []*TridentActionVolumeMove `json:"items"`
+}
+
+// TridentSnapshotPolicy defines a schedule on which Trident takes snapshots of a set of PVCs, and the rules by
+// which Trident deletes those snapshots.
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TridentSnapshotPolicy struct {
+	metav1.TypeMeta `json:",inline"`
+	// +k8s:openapi-gen=false
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Input spec for TridentSnapshotPolicy
+	Spec TridentSnapshotPolicySpec `json:"spec"`
+
+	// Last-run status for TridentSnapshotPolicy
+	Status TridentSnapshotPolicyStatus `json:"status"`
+}
+
+// TridentSnapshotPolicySpec defines the desired state of TridentSnapshotPolicy
+type TridentSnapshotPolicySpec struct {
+	// Schedule is a five-field cron expression, a macro such as @daily, or "@every <duration>", evaluated in UTC
+	Schedule string `json:"schedule"`
+	// RetentionCount is the number of the policy's snapshots to keep per volume; zero means no limit
+	RetentionCount int `json:"retentionCount,omitempty"`
+	// RetentionMaxAge is the age, as a duration, beyond which the policy's snapshots are deleted
+	RetentionMaxAge string `json:"retentionMaxAge,omitempty"`
+	// PVCSelector selects PVCs in the policy's namespace by label
+	PVCSelector *metav1.LabelSelector `json:"pvcSelector,omitempty"`
+	// StorageClasses selects PVCs in the policy's namespace by storage class
+	StorageClasses []string `json:"storageClasses,omitempty"`
+}
+
+// TridentSnapshotPolicyStatus defines the observed state of TridentSnapshotPolicy
+type TridentSnapshotPolicyStatus struct {
+	ObservedGeneration int                                 `json:"observedGeneration"`
+	NextRunTime        string                              `json:"nextRunTime,omitempty"`
+	Message            string                              `json:"message,omitempty"`
+	Volumes            []TridentSnapshotPolicyVolumeStatus `json:"volumes,omitempty"`
+}
+
+// TridentSnapshotPolicyVolumeStatus records the most recent run of a TridentSnapshotPolicy against one PVC
+type TridentSnapshotPolicyVolumeStatus struct {
+	PVCName           string `json:"pvcName"`
+	VolumeName        string `json:"volumeName"`
+	LastRunTime       string `json:"lastRunTime,omitempty"`
+	LastSnapshotName  string `json:"lastSnapshotName,omitempty"`
+	RetainedSnapshots int    `json:"retainedSnapshots"`
+	LastError         string `json:"lastError,omitempty"`
+}
+
+// TridentSnapshotPolicyList is a list of TridentSnapshotPolicy objects.
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TridentSnapshotPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// List of TridentSnapshotPolicy objects
+	Items []*TridentSnapshotPolicy `json:"items"`
+}
+
+// TridentSnapshotPolicyState records a snapshot policy registered with Trident, along with its last-run status.
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TridentSnapshotPolicyState struct {
+	metav1.TypeMeta `json:",inline"`
+	// +k8s:openapi-gen=false
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Specification of the snapshot policy
+	Spec runtime.RawExtension `json:"spec"`
+	// The UTC time at which the policy next runs, in RFC3339 format
+	NextRunTime string `json:"nextRunTime,omitempty"`
+	// The last-run status of each volume governed by the policy, keyed by volume name
+	Volumes runtime.RawExtension `json:"volumes"`
+}
+
+// TridentSnapshotPolicyStateList is a list of TridentSnapshotPolicyState objects.
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TridentSnapshotPolicyStateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// List of TridentSnapshotPolicyState objects
+	Items []*TridentSnapshotPolicyState `json:"items"`
+}
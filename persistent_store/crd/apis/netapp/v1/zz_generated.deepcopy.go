@@ -8,6 +8,7 @@
 package v1
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -221,6 +222,111 @@ func (in *TridentActionSnapshotRestoreStatus) DeepCopy() *TridentActionSnapshotR
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TridentActionVolumeMove) DeepCopyInto(out *TridentActionVolumeMove) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TridentActionVolumeMove.
+func (in *TridentActionVolumeMove) DeepCopy() *TridentActionVolumeMove {
+	if in == nil {
+		return nil
+	}
+	out := new(TridentActionVolumeMove)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TridentActionVolumeMove) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TridentActionVolumeMoveList) DeepCopyInto(out *TridentActionVolumeMoveList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]*TridentActionVolumeMove, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(TridentActionVolumeMove)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TridentActionVolumeMoveList.
+func (in *TridentActionVolumeMoveList) DeepCopy() *TridentActionVolumeMoveList {
+	if in == nil {
+		return nil
+	}
+	out := new(TridentActionVolumeMoveList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TridentActionVolumeMoveList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TridentActionVolumeMoveSpec) DeepCopyInto(out *TridentActionVolumeMoveSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TridentActionVolumeMoveSpec.
+func (in *TridentActionVolumeMoveSpec) DeepCopy() *TridentActionVolumeMoveSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TridentActionVolumeMoveSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TridentActionVolumeMoveStatus) DeepCopyInto(out *TridentActionVolumeMoveStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TridentActionVolumeMoveStatus.
+func (in *TridentActionVolumeMoveStatus) DeepCopy() *TridentActionVolumeMoveStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TridentActionVolumeMoveStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TridentBackend) DeepCopyInto(out *TridentBackend) {
 	*out = *in
@@ -400,6 +506,75 @@ func (in *TridentBackendList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TridentGroupSnapshot) DeepCopyInto(out *TridentGroupSnapshot) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	if in.SnapshotIDs != nil {
+		in, out := &in.SnapshotIDs, &out.SnapshotIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TridentGroupSnapshot.
+func (in *TridentGroupSnapshot) DeepCopy() *TridentGroupSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(TridentGroupSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TridentGroupSnapshot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TridentGroupSnapshotList) DeepCopyInto(out *TridentGroupSnapshotList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]*TridentGroupSnapshot, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(TridentGroupSnapshot)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TridentGroupSnapshotList.
+func (in *TridentGroupSnapshotList) DeepCopy() *TridentGroupSnapshotList {
+	if in == nil {
+		return nil
+	}
+	out := new(TridentGroupSnapshotList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TridentGroupSnapshotList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TridentMirrorRelationship) DeepCopyInto(out *TridentMirrorRelationship) {
 	*out = *in
@@ -782,6 +957,199 @@ func (in *TridentSnapshotList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TridentSnapshotPolicy) DeepCopyInto(out *TridentSnapshotPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TridentSnapshotPolicy.
+func (in *TridentSnapshotPolicy) DeepCopy() *TridentSnapshotPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(TridentSnapshotPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TridentSnapshotPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TridentSnapshotPolicyList) DeepCopyInto(out *TridentSnapshotPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]*TridentSnapshotPolicy, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(TridentSnapshotPolicy)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TridentSnapshotPolicyList.
+func (in *TridentSnapshotPolicyList) DeepCopy() *TridentSnapshotPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(TridentSnapshotPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TridentSnapshotPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TridentSnapshotPolicySpec) DeepCopyInto(out *TridentSnapshotPolicySpec) {
+	*out = *in
+	if in.PVCSelector != nil {
+		in, out := &in.PVCSelector, &out.PVCSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StorageClasses != nil {
+		in, out := &in.StorageClasses, &out.StorageClasses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TridentSnapshotPolicySpec.
+func (in *TridentSnapshotPolicySpec) DeepCopy() *TridentSnapshotPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TridentSnapshotPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TridentSnapshotPolicyState) DeepCopyInto(out *TridentSnapshotPolicyState) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Volumes.DeepCopyInto(&out.Volumes)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TridentSnapshotPolicyState.
+func (in *TridentSnapshotPolicyState) DeepCopy() *TridentSnapshotPolicyState {
+	if in == nil {
+		return nil
+	}
+	out := new(TridentSnapshotPolicyState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TridentSnapshotPolicyState) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TridentSnapshotPolicyStateList) DeepCopyInto(out *TridentSnapshotPolicyStateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]*TridentSnapshotPolicyState, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(TridentSnapshotPolicyState)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TridentSnapshotPolicyStateList.
+func (in *TridentSnapshotPolicyStateList) DeepCopy() *TridentSnapshotPolicyStateList {
+	if in == nil {
+		return nil
+	}
+	out := new(TridentSnapshotPolicyStateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TridentSnapshotPolicyStateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TridentSnapshotPolicyStatus) DeepCopyInto(out *TridentSnapshotPolicyStatus) {
+	*out = *in
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]TridentSnapshotPolicyVolumeStatus, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TridentSnapshotPolicyStatus.
+func (in *TridentSnapshotPolicyStatus) DeepCopy() *TridentSnapshotPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TridentSnapshotPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TridentSnapshotPolicyVolumeStatus) DeepCopyInto(out *TridentSnapshotPolicyVolumeStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TridentSnapshotPolicyVolumeStatus.
+func (in *TridentSnapshotPolicyVolumeStatus) DeepCopy() *TridentSnapshotPolicyVolumeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TridentSnapshotPolicyVolumeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TridentStorageClass) DeepCopyInto(out *TridentStorageClass) {
 	*out = *in
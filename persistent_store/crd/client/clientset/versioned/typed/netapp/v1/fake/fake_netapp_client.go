@@ -22,6 +22,10 @@ func (c *FakeTridentV1) TridentActionSnapshotRestores(namespace string) v1.Tride
 	return &FakeTridentActionSnapshotRestores{c, namespace}
 }
 
+func (c *FakeTridentV1) TridentActionVolumeMoves(namespace string) v1.TridentActionVolumeMoveInterface {
+	return &FakeTridentActionVolumeMoves{c, namespace}
+}
+
 func (c *FakeTridentV1) TridentBackends(namespace string) v1.TridentBackendInterface {
 	return &FakeTridentBackends{c, namespace}
 }
@@ -30,6 +34,10 @@ func (c *FakeTridentV1) TridentBackendConfigs(namespace string) v1.TridentBacken
 	return &FakeTridentBackendConfigs{c, namespace}
 }
 
+func (c *FakeTridentV1) TridentGroupSnapshots(namespace string) v1.TridentGroupSnapshotInterface {
+	return &FakeTridentGroupSnapshots{c, namespace}
+}
+
 func (c *FakeTridentV1) TridentMirrorRelationships(namespace string) v1.TridentMirrorRelationshipInterface {
 	return &FakeTridentMirrorRelationships{c, namespace}
 }
@@ -46,6 +54,14 @@ func (c *FakeTridentV1) TridentSnapshotInfos(namespace string) v1.TridentSnapsho
 	return &FakeTridentSnapshotInfos{c, namespace}
 }
 
+func (c *FakeTridentV1) TridentSnapshotPolicies(namespace string) v1.TridentSnapshotPolicyInterface {
+	return &FakeTridentSnapshotPolicies{c, namespace}
+}
+
+func (c *FakeTridentV1) TridentSnapshotPolicyStates(namespace string) v1.TridentSnapshotPolicyStateInterface {
+	return &FakeTridentSnapshotPolicyStates{c, namespace}
+}
+
 func (c *FakeTridentV1) TridentStorageClasses(namespace string) v1.TridentStorageClassInterface {
 	return &FakeTridentStorageClasses{c, namespace}
 }
@@ -8,10 +8,14 @@ type TridentActionMirrorUpdateExpansion interface{}
 
 type TridentActionSnapshotRestoreExpansion interface{}
 
+type TridentActionVolumeMoveExpansion interface{}
+
 type TridentBackendExpansion interface{}
 
 type TridentBackendConfigExpansion interface{}
 
+type TridentGroupSnapshotExpansion interface{}
+
 type TridentMirrorRelationshipExpansion interface{}
 
 type TridentNodeExpansion interface{}
@@ -20,6 +24,10 @@ type TridentSnapshotExpansion interface{}
 
 type TridentSnapshotInfoExpansion interface{}
 
+type TridentSnapshotPolicyExpansion interface{}
+
+type TridentSnapshotPolicyStateExpansion interface{}
+
 type TridentStorageClassExpansion interface{}
 
 type TridentTransactionExpansion interface{}
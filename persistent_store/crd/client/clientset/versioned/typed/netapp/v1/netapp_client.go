@@ -14,12 +14,16 @@ type TridentV1Interface interface {
 	RESTClient() rest.Interface
 	TridentActionMirrorUpdatesGetter
 	TridentActionSnapshotRestoresGetter
+	TridentActionVolumeMovesGetter
 	TridentBackendsGetter
 	TridentBackendConfigsGetter
+	TridentGroupSnapshotsGetter
 	TridentMirrorRelationshipsGetter
 	TridentNodesGetter
 	TridentSnapshotsGetter
 	TridentSnapshotInfosGetter
+	TridentSnapshotPoliciesGetter
+	TridentSnapshotPolicyStatesGetter
 	TridentStorageClassesGetter
 	TridentTransactionsGetter
 	TridentVersionsGetter
@@ -41,6 +45,10 @@ func (c *TridentV1Client) TridentActionSnapshotRestores(namespace string) Triden
 	return newTridentActionSnapshotRestores(c, namespace)
 }
 
+func (c *TridentV1Client) TridentActionVolumeMoves(namespace string) TridentActionVolumeMoveInterface {
+	return newTridentActionVolumeMoves(c, namespace)
+}
+
 func (c *TridentV1Client) TridentBackends(namespace string) TridentBackendInterface {
 	return newTridentBackends(c, namespace)
 }
@@ -49,6 +57,10 @@ func (c *TridentV1Client) TridentBackendConfigs(namespace string) TridentBackend
 	return newTridentBackendConfigs(c, namespace)
 }
 
+func (c *TridentV1Client) TridentGroupSnapshots(namespace string) TridentGroupSnapshotInterface {
+	return newTridentGroupSnapshots(c, namespace)
+}
+
 func (c *TridentV1Client) TridentMirrorRelationships(namespace string) TridentMirrorRelationshipInterface {
 	return newTridentMirrorRelationships(c, namespace)
 }
@@ -65,6 +77,14 @@ func (c *TridentV1Client) TridentSnapshotInfos(namespace string) TridentSnapshot
 	return newTridentSnapshotInfos(c, namespace)
 }
 
+func (c *TridentV1Client) TridentSnapshotPolicies(namespace string) TridentSnapshotPolicyInterface {
+	return newTridentSnapshotPolicies(c, namespace)
+}
+
+func (c *TridentV1Client) TridentSnapshotPolicyStates(namespace string) TridentSnapshotPolicyStateInterface {
+	return newTridentSnapshotPolicyStates(c, namespace)
+}
+
 func (c *TridentV1Client) TridentStorageClasses(namespace string) TridentStorageClassInterface {
 	return newTridentStorageClasses(c, namespace)
 }
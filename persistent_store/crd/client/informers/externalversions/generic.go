@@ -43,10 +43,14 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Trident().V1().TridentActionMirrorUpdates().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("tridentactionsnapshotrestores"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Trident().V1().TridentActionSnapshotRestores().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("tridentactionvolumemoves"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Trident().V1().TridentActionVolumeMoves().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("tridentbackends"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Trident().V1().TridentBackends().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("tridentbackendconfigs"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Trident().V1().TridentBackendConfigs().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("tridentgroupsnapshots"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Trident().V1().TridentGroupSnapshots().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("tridentmirrorrelationships"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Trident().V1().TridentMirrorRelationships().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("tridentnodes"):
@@ -55,6 +59,10 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Trident().V1().TridentSnapshots().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("tridentsnapshotinfos"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Trident().V1().TridentSnapshotInfos().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("tridentsnapshotpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Trident().V1().TridentSnapshotPolicies().Informer()}, nil
+	case v1.SchemeGroupVersion.WithResource("tridentsnapshotpolicystates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Trident().V1().TridentSnapshotPolicyStates().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("tridentstorageclasses"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Trident().V1().TridentStorageClasses().Informer()}, nil
 	case v1.SchemeGroupVersion.WithResource("tridenttransactions"):
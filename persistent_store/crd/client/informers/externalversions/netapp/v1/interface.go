@@ -14,10 +14,14 @@ type Interface interface {
 	TridentActionMirrorUpdates() TridentActionMirrorUpdateInformer
 	// TridentActionSnapshotRestores returns a TridentActionSnapshotRestoreInformer.
 	TridentActionSnapshotRestores() TridentActionSnapshotRestoreInformer
+	// TridentActionVolumeMoves returns a TridentActionVolumeMoveInformer.
+	TridentActionVolumeMoves() TridentActionVolumeMoveInformer
 	// TridentBackends returns a TridentBackendInformer.
 	TridentBackends() TridentBackendInformer
 	// TridentBackendConfigs returns a TridentBackendConfigInformer.
 	TridentBackendConfigs() TridentBackendConfigInformer
+	// TridentGroupSnapshots returns a TridentGroupSnapshotInformer.
+	TridentGroupSnapshots() TridentGroupSnapshotInformer
 	// TridentMirrorRelationships returns a TridentMirrorRelationshipInformer.
 	TridentMirrorRelationships() TridentMirrorRelationshipInformer
 	// TridentNodes returns a TridentNodeInformer.
@@ -26,6 +30,10 @@ type Interface interface {
 	TridentSnapshots() TridentSnapshotInformer
 	// TridentSnapshotInfos returns a TridentSnapshotInfoInformer.
 	TridentSnapshotInfos() TridentSnapshotInfoInformer
+	// TridentSnapshotPolicies returns a TridentSnapshotPolicyInformer.
+	TridentSnapshotPolicies() TridentSnapshotPolicyInformer
+	// TridentSnapshotPolicyStates returns a TridentSnapshotPolicyStateInformer.
+	TridentSnapshotPolicyStates() TridentSnapshotPolicyStateInformer
 	// TridentStorageClasses returns a TridentStorageClassInformer.
 	TridentStorageClasses() TridentStorageClassInformer
 	// TridentTransactions returns a TridentTransactionInformer.
@@ -61,6 +69,11 @@ func (v *version) TridentActionSnapshotRestores() TridentActionSnapshotRestoreIn
 	return &tridentActionSnapshotRestoreInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// TridentActionVolumeMoves returns a TridentActionVolumeMoveInformer.
+func (v *version) TridentActionVolumeMoves() TridentActionVolumeMoveInformer {
+	return &tridentActionVolumeMoveInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // TridentBackends returns a TridentBackendInformer.
 func (v *version) TridentBackends() TridentBackendInformer {
 	return &tridentBackendInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -71,6 +84,11 @@ func (v *version) TridentBackendConfigs() TridentBackendConfigInformer {
 	return &tridentBackendConfigInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// TridentGroupSnapshots returns a TridentGroupSnapshotInformer.
+func (v *version) TridentGroupSnapshots() TridentGroupSnapshotInformer {
+	return &tridentGroupSnapshotInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // TridentMirrorRelationships returns a TridentMirrorRelationshipInformer.
 func (v *version) TridentMirrorRelationships() TridentMirrorRelationshipInformer {
 	return &tridentMirrorRelationshipInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -91,6 +109,16 @@ func (v *version) TridentSnapshotInfos() TridentSnapshotInfoInformer {
 	return &tridentSnapshotInfoInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// TridentSnapshotPolicies returns a TridentSnapshotPolicyInformer.
+func (v *version) TridentSnapshotPolicies() TridentSnapshotPolicyInformer {
+	return &tridentSnapshotPolicyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// TridentSnapshotPolicyStates returns a TridentSnapshotPolicyStateInformer.
+func (v *version) TridentSnapshotPolicyStates() TridentSnapshotPolicyStateInformer {
+	return &tridentSnapshotPolicyStateInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // TridentStorageClasses returns a TridentStorageClassInformer.
 func (v *version) TridentStorageClasses() TridentStorageClassInformer {
 	return &tridentStorageClassInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
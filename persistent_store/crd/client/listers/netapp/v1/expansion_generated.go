@@ -20,6 +20,14 @@ type TridentActionSnapshotRestoreListerExpansion interface{}
 // TridentActionSnapshotRestoreNamespaceLister.
 type TridentActionSnapshotRestoreNamespaceListerExpansion interface{}
 
+// TridentActionVolumeMoveListerExpansion allows custom methods to be added to
+// TridentActionVolumeMoveLister.
+type TridentActionVolumeMoveListerExpansion interface{}
+
+// TridentActionVolumeMoveNamespaceListerExpansion allows custom methods to be added to
+// TridentActionVolumeMoveNamespaceLister.
+type TridentActionVolumeMoveNamespaceListerExpansion interface{}
+
 // TridentBackendListerExpansion allows custom methods to be added to
 // TridentBackendLister.
 type TridentBackendListerExpansion interface{}
@@ -36,6 +44,14 @@ type TridentBackendConfigListerExpansion interface{}
 // TridentBackendConfigNamespaceLister.
 type TridentBackendConfigNamespaceListerExpansion interface{}
 
+// TridentGroupSnapshotListerExpansion allows custom methods to be added to
+// TridentGroupSnapshotLister.
+type TridentGroupSnapshotListerExpansion interface{}
+
+// TridentGroupSnapshotNamespaceListerExpansion allows custom methods to be added to
+// TridentGroupSnapshotNamespaceLister.
+type TridentGroupSnapshotNamespaceListerExpansion interface{}
+
 // TridentMirrorRelationshipListerExpansion allows custom methods to be added to
 // TridentMirrorRelationshipLister.
 type TridentMirrorRelationshipListerExpansion interface{}
@@ -68,6 +84,22 @@ type TridentSnapshotInfoListerExpansion interface{}
 // TridentSnapshotInfoNamespaceLister.
 type TridentSnapshotInfoNamespaceListerExpansion interface{}
 
+// TridentSnapshotPolicyListerExpansion allows custom methods to be added to
+// TridentSnapshotPolicyLister.
+type TridentSnapshotPolicyListerExpansion interface{}
+
+// TridentSnapshotPolicyNamespaceListerExpansion allows custom methods to be added to
+// TridentSnapshotPolicyNamespaceLister.
+type TridentSnapshotPolicyNamespaceListerExpansion interface{}
+
+// TridentSnapshotPolicyStateListerExpansion allows custom methods to be added to
+// TridentSnapshotPolicyStateLister.
+type TridentSnapshotPolicyStateListerExpansion interface{}
+
+// TridentSnapshotPolicyStateNamespaceListerExpansion allows custom methods to be added to
+// TridentSnapshotPolicyStateNamespaceLister.
+type TridentSnapshotPolicyStateNamespaceListerExpansion interface{}
+
 // TridentStorageClassListerExpansion allows custom methods to be added to
 // TridentStorageClassLister.
 type TridentStorageClassListerExpansion interface{}
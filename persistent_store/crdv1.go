@@ -5,11 +5,14 @@ package persistentstore
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	k8sapierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
 
 	clik8sclient "github.com/netapp/trident/cli/k8s_client"
 	"github.com/netapp/trident/config"
@@ -45,6 +48,7 @@ type CRDClientV1 struct {
 	k8sClient clik8sclient.KubernetesClient
 	version   *config.PersistentStateVersion
 	namespace string
+	cache     *crdCache // nil if all reads go to the API server
 }
 
 func NewCRDClientV1(masterURL, kubeConfigPath string) (*CRDClientV1, error) {
@@ -58,47 +62,92 @@ func NewCRDClientV1(masterURL, kubeConfigPath string) (*CRDClientV1, error) {
 		return nil, err
 	}
 
+	// Count the API calls made for Trident's custom resources, including those made by the informers
+	restConfig := rest.CopyConfig(clients.RestConfig)
+	restConfig.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return &apiCallCounter{next: rt}
+	})
+	crdClient, err := tridentv1clientset.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	Logc(ctx).WithFields(LogFields{
 		"tridentNamespace": clients.Namespace,
 	}).Trace("Created CRDv1 persistence client.")
 
 	return &CRDClientV1{
-		crdClient: clients.TridentClient,
+		crdClient: crdClient,
 		k8sClient: clients.K8SClient,
 		version: &config.PersistentStateVersion{
 			PersistentStoreVersion: string(CRDV1Store),
 			OrchestratorAPIVersion: config.OrchestratorAPIVersion,
 		},
 		namespace: clients.Namespace,
-	}, err
+		cache:     startCRDCache(ctx, crdClient, clients.K8SClient, clients.Namespace),
+	}, nil
+}
+
+// readCache returns the cache to read a resource from, or nil if it must be read from the API server.
+func (k *CRDClientV1) readCache(resource string, live bool) *crdResourceCache {
+	if live || k.cache == nil {
+		return nil
+	}
+	return k.cache.resources[resource]
+}
+
+// cacheWrite records an object returned by the API server, so that later reads from the cache include it.
+func (k *CRDClientV1) cacheWrite(resource string, obj runtime.Object) {
+	if k.cache != nil {
+		k.cache.resources[resource].write(obj)
+	}
+}
+
+// cacheDelete records that an object was deleted, so that later reads from the cache reflect it.
+func (k *CRDClientV1) cacheDelete(resource, name string) {
+	if k.cache != nil {
+		k.cache.resources[resource].delete(name)
+	}
+}
+
+// retryOnStaleCache calls update with objects read from the cache, and if the API server rejects the update
+// because a cached object was stale or missing, calls it once more with objects read from the API server.
+func (k *CRDClientV1) retryOnStaleCache(ctx context.Context, resource string, update func(live bool) error) error {
+	err := update(k.cache == nil)
+	if err != nil && k.cache != nil && (k8sapierrors.IsConflict(err) || k8sapierrors.IsAlreadyExists(err)) {
+		persistentStoreCacheConflictsTotal.WithLabelValues(resource).Inc()
+		Logc(ctx).WithField("resource", resource).Debug("Cached object was stale, retrying update.")
+		err = update(true)
+	}
+	return err
 }
 
 func (k *CRDClientV1) GetTridentUUID(ctx context.Context) (string, error) {
-	versionList, err := k.crdClient.TridentV1().TridentVersions(k.namespace).List(ctx, listOpts)
+	versions, err := k.listVersionsCRs(ctx, false)
 	if err != nil {
 		if strings.Contains(err.Error(), "the server could not find the requested resource") {
 			return "", NewPersistentStoreError(KeyNotFoundErr, v1.PersistentStateVersionName)
 		}
 		return "", err
-	} else if versionList == nil || versionList.Items == nil || len(versionList.Items) == 0 {
+	} else if len(versions) == 0 {
 		return "", NewPersistentStoreError(KeyNotFoundErr, v1.PersistentStateVersionName)
 	}
 
-	return string(versionList.Items[0].ObjectMeta.GetUID()), nil
+	return string(versions[0].ObjectMeta.GetUID()), nil
 }
 
 func (k *CRDClientV1) GetVersion(ctx context.Context) (*config.PersistentStateVersion, error) {
-	versionList, err := k.crdClient.TridentV1().TridentVersions(k.namespace).List(ctx, listOpts)
+	versions, err := k.listVersionsCRs(ctx, false)
 	if err != nil {
 		if strings.Contains(err.Error(), "the server could not find the requested resource") {
 			return nil, NewPersistentStoreError(KeyNotFoundErr, v1.PersistentStateVersionName)
 		}
 		return nil, err
-	} else if versionList == nil || versionList.Items == nil || len(versionList.Items) == 0 {
+	} else if len(versions) == 0 {
 		return nil, NewPersistentStoreError(KeyNotFoundErr, v1.PersistentStateVersionName)
 	}
 
-	persistentVersion, err := versionList.Items[0].Persistent()
+	persistentVersion, err := versions[0].Persistent()
 	if err != nil {
 		return nil, err
 	}
@@ -107,23 +156,30 @@ func (k *CRDClientV1) GetVersion(ctx context.Context) (*config.PersistentStateVe
 }
 
 func (k *CRDClientV1) SetVersion(ctx context.Context, version *config.PersistentStateVersion) error {
-	versionList, err := k.crdClient.TridentV1().TridentVersions(k.namespace).List(ctx, listOpts)
+	return k.retryOnStaleCache(ctx, tridentVersions, func(live bool) error {
+		return k.setVersion(ctx, version, live)
+	})
+}
+
+func (k *CRDClientV1) setVersion(ctx context.Context, version *config.PersistentStateVersion, live bool) error {
+	versions, err := k.listVersionsCRs(ctx, live)
 	if err != nil {
 		return err
 	}
 
 	// If version doesn't exist, create it
-	if versionList == nil || versionList.Items == nil || len(versionList.Items) == 0 {
+	if len(versions) == 0 {
 
 		newVersion, err := v1.NewTridentVersion(version)
 		if err != nil {
 			return err
 		}
 
-		_, err = k.crdClient.TridentV1().TridentVersions(k.namespace).Create(ctx, newVersion, createOpts)
+		createdVersion, err := k.crdClient.TridentV1().TridentVersions(k.namespace).Create(ctx, newVersion, createOpts)
 		if err != nil {
 			return err
 		}
+		k.cacheWrite(tridentVersions, createdVersion)
 
 		Logc(ctx).WithFields(LogFields{
 			"PersistentStoreVersion": newVersion.PersistentStoreVersion,
@@ -134,16 +190,17 @@ func (k *CRDClientV1) SetVersion(ctx context.Context, version *config.Persistent
 	}
 
 	// Version exists, so update it
-	existingVersion := versionList.Items[0]
+	existingVersion := versions[0]
 
 	if err = existingVersion.Apply(version); err != nil {
 		return err
 	}
 
-	_, err = k.crdClient.TridentV1().TridentVersions(k.namespace).Update(ctx, existingVersion, updateOpts)
+	updatedVersion, err := k.crdClient.TridentV1().TridentVersions(k.namespace).Update(ctx, existingVersion, updateOpts)
 	if err != nil {
 		return err
 	}
+	k.cacheWrite(tridentVersions, updatedVersion)
 
 	Logc(ctx).WithFields(LogFields{
 		"PersistentStoreVersion": existingVersion.PersistentStoreVersion,
@@ -162,6 +219,9 @@ func (k *CRDClientV1) GetType() StoreType {
 }
 
 func (k *CRDClientV1) Stop() error {
+	if k.cache != nil {
+		k.cache.stop()
+	}
 	return nil
 }
 
@@ -176,10 +236,18 @@ func (k *CRDClientV1) AddBackend(ctx context.Context, backend storage.Backend) e
 	return k.addBackendPersistent(ctx, backend.ConstructPersistent(ctx))
 }
 
+// AddBackendPersistent accepts a backend in its persistent form, such as one read from another store
+// during a migration, and persists it in the same way as AddBackend.
+func (k *CRDClientV1) AddBackendPersistent(ctx context.Context, backendPersistent *storage.BackendPersistent) error {
+	Logc(ctx).WithField("backend.Name", backendPersistent.Name).Debug("AddBackendPersistent.")
+
+	return k.addBackendPersistent(ctx, backendPersistent)
+}
+
 // addBackendPersistent is the internal method shared by AddBackend and AddBackendPersistent.
 func (k *CRDClientV1) addBackendPersistent(ctx context.Context, backendPersistent *storage.BackendPersistent) error {
 	// Ensure the backend doesn't already exist
-	if crd, err := k.getBackendCRD(ctx, backendPersistent.Name); crd != nil {
+	if crd, err := k.getBackendCRD(ctx, backendPersistent.Name, false); crd != nil {
 		return fmt.Errorf("backend %s already exists", backendPersistent.Name)
 	} else if err != nil && !MatchKeyNotFoundErr(err) {
 		return err
@@ -239,6 +307,9 @@ func (k *CRDClientV1) addBackendPersistent(ctx context.Context, backendPersisten
 
 			// If secret creation failed, clean up by deleting the backend we just created
 			deleteErr := k.crdClient.TridentV1().TridentBackends(k.namespace).Delete(ctx, crd.Name, k.deleteOpts())
+			if deleteErr == nil {
+				k.cacheDelete(tridentBackends, crd.Name)
+			}
 			if deleteErr != nil {
 				Logc(ctx).WithField("backend", crd.Name).Error(
 					"Could not delete backend resource after secret create failure.")
@@ -257,7 +328,7 @@ func (k *CRDClientV1) addBackendPersistent(ctx context.Context, backendPersisten
 
 // backendSecretName is the only method that creates the name of a backend's corresponding secret.
 func (k *CRDClientV1) backendSecretName(backendUUID string) string {
-	return fmt.Sprintf("tbe-%s", backendUUID)
+	return backendSecretName(backendUUID)
 }
 
 func (k *CRDClientV1) makeBackendSecret(
@@ -285,12 +356,22 @@ func (k *CRDClientV1) makeBackendSecret(
 func (k *CRDClientV1) addBackendCRD(ctx context.Context, backend *v1.TridentBackend) (*v1.TridentBackend, error) {
 	Logc(ctx).WithField("backendName", backend.Name).Debug("addBackendCRD")
 
-	return k.crdClient.TridentV1().TridentBackends(k.namespace).Create(ctx, backend, createOpts)
+	crd, err := k.crdClient.TridentV1().TridentBackends(k.namespace).Create(ctx, backend, createOpts)
+	if err != nil {
+		return nil, err
+	}
+	k.cacheWrite(tridentBackends, crd)
+
+	return crd, nil
 }
 
 // HasBackends returns true if any backend objects have been persisted as custom
 // resources in the current namespace.
 func (k *CRDClientV1) HasBackends(ctx context.Context) (bool, error) {
+	if c := k.readCache(tridentBackends, false); c != nil {
+		return len(c.list()) > 0, nil
+	}
+
 	listOneOpts := metav1.ListOptions{Limit: 1}
 	backendList, err := k.crdClient.TridentV1().TridentBackends(k.namespace).List(ctx, listOneOpts)
 	if err != nil {
@@ -307,17 +388,17 @@ func (k *CRDClientV1) GetBackend(ctx context.Context, backendName string) (*stor
 	Logc(ctx).WithField("backendName", backendName).Debug("GetBackend")
 
 	// Get all backend resources
-	list, err := k.crdClient.TridentV1().TridentBackends(k.namespace).List(ctx, listOpts)
+	backends, err := k.listBackendsCRs(ctx, false)
 	if err != nil {
 		return nil, err
-	} else if list == nil || list.Items == nil || len(list.Items) == 0 {
+	} else if len(backends) == 0 {
 		return nil, NewPersistentStoreError(KeyNotFoundErr, backendName)
 	}
 
 	var backendPersistent *storage.BackendPersistent
 
 	// Find the backend with the name we want
-	for _, backend := range list.Items {
+	for _, backend := range backends {
 
 		Logc(ctx).WithFields(LogFields{
 			"name":        backend.Name,
@@ -343,18 +424,19 @@ func (k *CRDClientV1) GetBackend(ctx context.Context, backendName string) (*stor
 
 // getBackendCRD retrieves the list of backends persisted as custom resources, finds the
 // one with the specified backend name, and returns the CRD form of the object.  This is
-// an internal method that does not fetch any Secret data.
-func (k *CRDClientV1) getBackendCRD(ctx context.Context, backendName string) (*v1.TridentBackend, error) {
+// an internal method that does not fetch any Secret data.  If live is true, the list is
+// read from the API server rather than the cache.
+func (k *CRDClientV1) getBackendCRD(ctx context.Context, backendName string, live bool) (*v1.TridentBackend, error) {
 	Logc(ctx).WithField("backendName", backendName).Debug("getBackendCRD")
 
-	list, err := k.crdClient.TridentV1().TridentBackends(k.namespace).List(ctx, listOpts)
+	backends, err := k.listBackendsCRs(ctx, live)
 	if err != nil {
 		return nil, err
-	} else if list == nil || list.Items == nil || len(list.Items) == 0 {
+	} else if len(backends) == 0 {
 		return nil, NewPersistentStoreError(KeyNotFoundErr, backendName)
 	}
 
-	for _, backend := range list.Items {
+	for _, backend := range backends {
 		Logc(ctx).WithFields(LogFields{
 			"name":        backend.Name,
 			"backendName": backend.BackendName,
@@ -474,6 +556,14 @@ func (k *CRDClientV1) UpdateBackend(ctx context.Context, update storage.Backend)
 
 // updateBackendPersistent is the internal method shared by UpdateBackend and UpdateBackendPersistent.
 func (k *CRDClientV1) updateBackendPersistent(ctx context.Context, backendPersistent *storage.BackendPersistent) error {
+	return k.retryOnStaleCache(ctx, tridentBackends, func(live bool) error {
+		return k.updateBackendPersistentCRD(ctx, backendPersistent, live)
+	})
+}
+
+func (k *CRDClientV1) updateBackendPersistentCRD(
+	ctx context.Context, backendPersistent *storage.BackendPersistent, live bool,
+) error {
 	// Ensure the backend has a valid UUID and create the secret name
 	if backendPersistent.BackendUUID == "" {
 		return fmt.Errorf("backend %s does not have a UUID set", backendPersistent.Name)
@@ -481,7 +571,7 @@ func (k *CRDClientV1) updateBackendPersistent(ctx context.Context, backendPersis
 	secretName := k.backendSecretName(backendPersistent.BackendUUID)
 
 	// Get the CRD that we will update
-	crd, err := k.getBackendCRD(ctx, backendPersistent.Name)
+	crd, err := k.getBackendCRD(ctx, backendPersistent.Name, live)
 	if err != nil {
 		return err
 	}
@@ -502,9 +592,11 @@ func (k *CRDClientV1) updateBackendPersistent(ctx context.Context, backendPersis
 	}
 
 	// Update the backend resource in Kubernetes
-	if _, err = k.crdClient.TridentV1().TridentBackends(k.namespace).Update(ctx, crd, updateOpts); err != nil {
+	updatedCRD, err := k.crdClient.TridentV1().TridentBackends(k.namespace).Update(ctx, crd, updateOpts)
+	if err != nil {
 		return err
 	}
+	k.cacheWrite(tridentBackends, updatedCRD)
 
 	Logc(ctx).WithFields(LogFields{
 		"backendName": crd.BackendName,
@@ -567,10 +659,13 @@ func (k *CRDClientV1) updateBackendPersistent(ctx context.Context, backendPersis
 		if secretError != nil {
 
 			// If the secret update failed, unroll the backend update
-			_, updateErr := k.crdClient.TridentV1().TridentBackends(k.namespace).Update(ctx, origCRDCopy, updateOpts)
+			origCRDCopy.ResourceVersion = updatedCRD.ResourceVersion
+			restoredCRD, updateErr := k.crdClient.TridentV1().TridentBackends(k.namespace).Update(
+				ctx, origCRDCopy, updateOpts)
 			if updateErr != nil {
 				Logc(ctx).WithField("backend", crd.Name).Error("Could not restore backend after secret update failure.")
 			} else {
+				k.cacheWrite(tridentBackends, restoredCRD)
 				Logc(ctx).WithField("backend", crd.Name).Warning("Restored backend after secret update failure.")
 			}
 
@@ -606,7 +701,7 @@ func (k *CRDClientV1) DeleteBackend(ctx context.Context, b storage.Backend) (err
 
 	// Get the CR that needs to be deleted
 	var backend *v1.TridentBackend
-	backend, err = k.getBackendCRD(ctx, b.Name())
+	backend, err = k.getBackendCRD(ctx, b.Name(), false)
 	if err != nil {
 		if MatchKeyNotFoundErr(err) {
 			keyError, ok := err.(*Error)
@@ -627,6 +722,7 @@ func (k *CRDClientV1) DeleteBackend(ctx context.Context, b storage.Backend) (err
 		); err != nil {
 			return err
 		}
+		k.cacheDelete(tridentBackends, backend.Name)
 
 		Logc(ctx).WithFields(logFields).Debug("Deleted backend resource.")
 	}
@@ -659,13 +755,19 @@ func (k *CRDClientV1) DeleteBackend(ctx context.Context, b storage.Backend) (err
 
 // removeBackendFinalizer accepts a Backend object and removes the finalizer from the corresponding TridentBackend CR
 func (k *CRDClientV1) removeBackendFinalizer(ctx context.Context, b storage.Backend) error {
+	return k.retryOnStaleCache(ctx, tridentBackends, func(live bool) error {
+		return k.removeBackendCRDFinalizer(ctx, b, live)
+	})
+}
+
+func (k *CRDClientV1) removeBackendCRDFinalizer(ctx context.Context, b storage.Backend, live bool) error {
 	logFields := LogFields{
 		"backendName": b.Name(),
 		"backendUUID": b.BackendUUID(),
 	}
 
 	// Get the CRD that we will delete
-	backend, err := k.getBackendCRD(ctx, b.Name())
+	backend, err := k.getBackendCRD(ctx, b.Name(), live)
 	if err != nil {
 		if MatchKeyNotFoundErr(err) {
 			keyError, ok := err.(*Error)
@@ -686,12 +788,14 @@ func (k *CRDClientV1) removeBackendFinalizer(ctx context.Context, b storage.Back
 
 		backendCopy := backend.DeepCopy()
 		backendCopy.RemoveTridentFinalizers()
-		if _, err := k.crdClient.TridentV1().TridentBackends(backend.Namespace).Update(
+		updatedBackend, err := k.crdClient.TridentV1().TridentBackends(backend.Namespace).Update(
 			ctx, backendCopy, updateOpts,
-		); err != nil {
+		)
+		if err != nil {
 			Logc(ctx).WithFields(logFields).Errorf("Problem removing finalizers: %v", err)
 			return err
 		}
+		k.cacheWrite(tridentBackends, updatedBackend)
 	} else {
 		Logc(ctx).WithFields(logFields).Debug("No finalizers to remove.")
 	}
@@ -707,7 +811,7 @@ func (k *CRDClientV1) IsBackendDeleting(ctx context.Context, b storage.Backend)
 	}
 
 	// Get the CR that needs to be verified
-	backend, err := k.getBackendCRD(ctx, b.Name())
+	backend, err := k.getBackendCRD(ctx, b.Name(), false)
 	if err != nil {
 		if MatchKeyNotFoundErr(err) {
 			keyError := err.(*Error)
@@ -734,14 +838,14 @@ func (k *CRDClientV1) IsBackendDeleting(ctx context.Context, b storage.Backend)
 // corresponding K8S secrets.
 func (k *CRDClientV1) GetBackends(ctx context.Context) ([]*storage.BackendPersistent, error) {
 	// Get the backend resources
-	backendList, err := k.crdClient.TridentV1().TridentBackends(k.namespace).List(ctx, listOpts)
+	backends, err := k.listBackendsCRs(ctx, false)
 	if err != nil {
 		return nil, err
 	}
 
 	results := make([]*storage.BackendPersistent, 0)
 
-	for _, backend := range backendList.Items {
+	for _, backend := range backends {
 
 		// Convert backend resource into BackendPersistent object
 		backendPersistent, err := backend.Persistent()
@@ -766,18 +870,19 @@ func (k *CRDClientV1) GetBackends(ctx context.Context) ([]*storage.BackendPersis
 func (k *CRDClientV1) DeleteBackends(ctx context.Context) error {
 	Logc(ctx).Debug("DeleteBackends.")
 
-	backendList, err := k.crdClient.TridentV1().TridentBackends(k.namespace).List(ctx, listOpts)
+	backends, err := k.listBackendsCRs(ctx, false)
 	if err != nil {
 		return err
 	}
 
-	for _, backend := range backendList.Items {
+	for _, backend := range backends {
 		// Delete the backend resource
 		err = k.crdClient.TridentV1().TridentBackends(k.namespace).Delete(ctx, backend.Name, k.deleteOpts())
 		if err != nil {
 			Logc(ctx).WithField("error", err).Error("Could not delete backend.")
 			return err
 		}
+		k.cacheDelete(tridentBackends, backend.Name)
 		Logc(ctx).WithFields(LogFields{
 			"backend":  backend.BackendName,
 			"resource": backend.Name,
@@ -811,8 +916,14 @@ func (k *CRDClientV1) ReplaceBackendAndUpdateVolumes(
 		"newBackend.Name":         newBackend.Name(),
 	}).Debug("ReplaceBackendAndUpdateVolumes.")
 
+	return k.retryOnStaleCache(ctx, tridentBackends, func(live bool) error {
+		return k.replaceBackendCRD(ctx, origBackend, newBackend, live)
+	})
+}
+
+func (k *CRDClientV1) replaceBackendCRD(ctx context.Context, origBackend, newBackend storage.Backend, live bool) error {
 	// Get the custom resource for the original backend
-	origCRD, err := k.getBackendCRD(ctx, origBackend.Name())
+	origCRD, err := k.getBackendCRD(ctx, origBackend.Name(), live)
 	if err != nil {
 		return err
 	}
@@ -868,6 +979,7 @@ func (k *CRDClientV1) ReplaceBackendAndUpdateVolumes(
 		Logc(ctx).WithField("error", err).Error("Could not update backend.")
 		return err
 	}
+	k.cacheWrite(tridentBackends, newCRD)
 
 	Logc(ctx).WithFields(LogFields{
 		"backendName": newCRD.BackendName,
@@ -883,11 +995,14 @@ func (k *CRDClientV1) ReplaceBackendAndUpdateVolumes(
 				"Could not update backend secret, will unroll backend update.")
 
 			// If the secret update failed, unroll the backend update
-			_, updateErr := k.crdClient.TridentV1().TridentBackends(k.namespace).Update(ctx, origCRDCopy, updateOpts)
+			origCRDCopy.ResourceVersion = newCRD.ResourceVersion
+			restoredCRD, updateErr := k.crdClient.TridentV1().TridentBackends(k.namespace).Update(
+				ctx, origCRDCopy, updateOpts)
 			if updateErr != nil {
 				Logc(ctx).WithField("backend", origCRD.Name).Error(
 					"Could not restore backend after secret update failure.")
 			} else {
+				k.cacheWrite(tridentBackends, restoredCRD)
 				Logc(ctx).WithField("backend", origCRD.Name).Warning("Restored backend after secret update failure.")
 			}
 
@@ -928,8 +1043,11 @@ func (k *CRDClientV1) AddVolume(ctx context.Context, volume *storage.Volume) err
 		"persistentVolume.BackendUUID": persistentVolume.BackendUUID,
 	}).Debug("AddVolume")
 
-	_, err = k.crdClient.TridentV1().TridentVolumes(k.namespace).Create(ctx, persistentVolume, createOpts)
-	if err == nil || !k8sapierrors.IsAlreadyExists(err) {
+	tvol, err := k.crdClient.TridentV1().TridentVolumes(k.namespace).Create(ctx, persistentVolume, createOpts)
+	if err == nil {
+		k.cacheWrite(tridentVolumes, tvol)
+		return nil
+	} else if !k8sapierrors.IsAlreadyExists(err) {
 		return err
 	}
 
@@ -944,24 +1062,35 @@ func (k *CRDClientV1) AddVolume(ctx context.Context, volume *storage.Volume) err
 	} else {
 		tvol = tvol.DeepCopy()
 		tvol.RemoveTridentFinalizers()
-		_, updateErr := k.crdClient.TridentV1().TridentVolumes(k.namespace).Update(ctx, tvol, updateOpts)
+		tvol, updateErr := k.crdClient.TridentV1().TridentVolumes(k.namespace).Update(ctx, tvol, updateOpts)
 		if updateErr != nil {
 			Logc(ctx).Errorf("Could not remove volume finalizers; %v", updateErr)
 			return updateErr
 		}
+		k.cacheWrite(tridentVolumes, tvol)
 
 		deleteErr := k.crdClient.TridentV1().TridentVolumes(k.namespace).Delete(ctx, tvol.Name, k.deleteOpts())
 		if deleteErr != nil {
 			Logc(ctx).Errorf("Could not delete volume; %v", deleteErr)
 			return deleteErr
 		}
+		k.cacheDelete(tridentVolumes, tvol.Name)
 	}
 
-	_, err = k.crdClient.TridentV1().TridentVolumes(k.namespace).Create(ctx, persistentVolume, createOpts)
-	return err
+	tvol, err = k.crdClient.TridentV1().TridentVolumes(k.namespace).Create(ctx, persistentVolume, createOpts)
+	if err != nil {
+		return err
+	}
+	k.cacheWrite(tridentVolumes, tvol)
+
+	return nil
 }
 
 func (k *CRDClientV1) HasVolumes(ctx context.Context) (bool, error) {
+	if c := k.readCache(tridentVolumes, false); c != nil {
+		return len(c.list()) > 0, nil
+	}
+
 	listOneOpts := metav1.ListOptions{Limit: 1}
 	volumeList, err := k.crdClient.TridentV1().TridentVolumes(k.namespace).List(ctx, listOneOpts)
 	if err != nil {
@@ -972,7 +1101,7 @@ func (k *CRDClientV1) HasVolumes(ctx context.Context) (bool, error) {
 }
 
 func (k *CRDClientV1) GetVolume(ctx context.Context, volName string) (*storage.VolumeExternal, error) {
-	volume, err := k.crdClient.TridentV1().TridentVolumes(k.namespace).Get(ctx, v1.NameFix(volName), getOpts)
+	volume, err := k.getVolumeCR(ctx, v1.NameFix(volName), false)
 	if err != nil {
 		return nil, err
 	}
@@ -986,26 +1115,32 @@ func (k *CRDClientV1) GetVolume(ctx context.Context, volName string) (*storage.V
 }
 
 func (k *CRDClientV1) UpdateVolume(ctx context.Context, update *storage.Volume) error {
-	volume, err := k.crdClient.TridentV1().TridentVolumes(k.namespace).Get(ctx, v1.NameFix(update.Config.Name), getOpts)
-	if err != nil {
-		return err
-	}
+	return k.retryOnStaleCache(ctx, tridentVolumes, func(live bool) error {
+		volume, err := k.getVolumeCR(ctx, v1.NameFix(update.Config.Name), live)
+		if err != nil {
+			return err
+		}
 
-	if err = volume.Apply(ctx, update.ConstructExternal()); err != nil {
-		return err
-	}
+		if err = volume.Apply(ctx, update.ConstructExternal()); err != nil {
+			return err
+		}
 
-	_, err = k.crdClient.TridentV1().TridentVolumes(k.namespace).Update(ctx, volume, updateOpts)
-	if err != nil {
-		return err
-	}
+		volume, err = k.crdClient.TridentV1().TridentVolumes(k.namespace).Update(ctx, volume, updateOpts)
+		if err != nil {
+			return err
+		}
+		k.cacheWrite(tridentVolumes, volume)
 
-	return nil
+		return nil
+	})
 }
 
 func (k *CRDClientV1) DeleteVolume(ctx context.Context, volume *storage.Volume) error {
 	err := k.crdClient.TridentV1().TridentVolumes(k.namespace).Delete(ctx, v1.NameFix(volume.Config.Name),
 		k.deleteOpts())
+	if err == nil {
+		k.cacheDelete(tridentVolumes, v1.NameFix(volume.Config.Name))
+	}
 
 	if k8sapierrors.IsNotFound(err) {
 		Logc(ctx).WithField("volume", volume).Debug("Volume already deleted.")
@@ -1016,14 +1151,14 @@ func (k *CRDClientV1) DeleteVolume(ctx context.Context, volume *storage.Volume)
 }
 
 func (k *CRDClientV1) GetVolumes(ctx context.Context) ([]*storage.VolumeExternal, error) {
-	volumeList, err := k.crdClient.TridentV1().TridentVolumes(k.namespace).List(ctx, listOpts)
+	volumes, err := k.listVolumesCRs(ctx, false)
 	if err != nil {
 		return nil, err
 	}
 
 	results := make([]*storage.VolumeExternal, 0)
 
-	for _, item := range volumeList.Items {
+	for _, item := range volumes {
 		if !item.ObjectMeta.DeletionTimestamp.IsZero() {
 			Logc(ctx).WithFields(LogFields{
 				"Name":              item.Name,
@@ -1045,16 +1180,17 @@ func (k *CRDClientV1) GetVolumes(ctx context.Context) ([]*storage.VolumeExternal
 }
 
 func (k *CRDClientV1) DeleteVolumes(ctx context.Context) error {
-	volumeList, err := k.crdClient.TridentV1().TridentVolumes(k.namespace).List(ctx, listOpts)
+	volumes, err := k.listVolumesCRs(ctx, false)
 	if err != nil {
 		return err
 	}
 
-	for _, item := range volumeList.Items {
+	for _, item := range volumes {
 		err := k.crdClient.TridentV1().TridentVolumes(k.namespace).Delete(ctx, item.ObjectMeta.Name, k.deleteOpts())
 		if err != nil {
 			return err
 		}
+		k.cacheDelete(tridentVolumes, item.ObjectMeta.Name)
 	}
 
 	return nil
@@ -1071,15 +1207,20 @@ func (k *CRDClientV1) AddVolumeTransaction(ctx context.Context, txn *storage.Vol
 		"name": v1.NameFix(txn.Name()),
 	}).Debug("AddVolumeTransaction")
 
-	_, err = k.crdClient.TridentV1().TridentTransactions(k.namespace).Create(ctx, newTxn, createOpts)
+	newTxn, err = k.crdClient.TridentV1().TridentTransactions(k.namespace).Create(ctx, newTxn, createOpts)
 	if err != nil {
 		return err
 	}
+	k.cacheWrite(tridentTransactions, newTxn)
 
 	return nil
 }
 
 func (k *CRDClientV1) HasVolumeTransactions(ctx context.Context) (bool, error) {
+	if c := k.readCache(tridentTransactions, false); c != nil {
+		return len(c.list()) > 0, nil
+	}
+
 	listOneOpts := metav1.ListOptions{Limit: 1}
 	txnList, err := k.crdClient.TridentV1().TridentTransactions(k.namespace).List(ctx, listOneOpts)
 	if err != nil {
@@ -1090,14 +1231,14 @@ func (k *CRDClientV1) HasVolumeTransactions(ctx context.Context) (bool, error) {
 }
 
 func (k *CRDClientV1) GetVolumeTransactions(ctx context.Context) ([]*storage.VolumeTransaction, error) {
-	txnList, err := k.crdClient.TridentV1().TridentTransactions(k.namespace).List(ctx, listOpts)
+	txns, err := k.listTransactionsCRs(ctx, false)
 	if err != nil {
 		return nil, err
 	}
 
 	results := make([]*storage.VolumeTransaction, 0)
 
-	for _, item := range txnList.Items {
+	for _, item := range txns {
 		if !item.ObjectMeta.DeletionTimestamp.IsZero() {
 			Logc(ctx).WithFields(LogFields{
 				"Name":              item.Name,
@@ -1117,23 +1258,30 @@ func (k *CRDClientV1) GetVolumeTransactions(ctx context.Context) ([]*storage.Vol
 }
 
 func (k *CRDClientV1) UpdateVolumeTransaction(ctx context.Context, update *storage.VolumeTransaction) error {
-	ttxn, err := k.crdClient.TridentV1().TridentTransactions(k.namespace).Get(ctx, v1.NameFix(update.Name()), getOpts)
-	if err != nil {
-		return err
-	}
+	return k.retryOnStaleCache(ctx, tridentTransactions, func(live bool) error {
+		ttxn, err := k.getTransactionCR(ctx, v1.NameFix(update.Name()), live)
+		if err != nil {
+			return err
+		}
 
-	if err = ttxn.Apply(update); err != nil {
-		return err
-	}
+		if err = ttxn.Apply(update); err != nil {
+			return err
+		}
 
-	_, err = k.crdClient.TridentV1().TridentTransactions(k.namespace).Update(ctx, ttxn, updateOpts)
-	return err
+		ttxn, err = k.crdClient.TridentV1().TridentTransactions(k.namespace).Update(ctx, ttxn, updateOpts)
+		if err != nil {
+			return err
+		}
+		k.cacheWrite(tridentTransactions, ttxn)
+
+		return nil
+	})
 }
 
 func (k *CRDClientV1) GetVolumeTransaction(
 	ctx context.Context, volTxn *storage.VolumeTransaction,
 ) (*storage.VolumeTransaction, error) {
-	ttxn, err := k.crdClient.TridentV1().TridentTransactions(k.namespace).Get(ctx, v1.NameFix(volTxn.Name()), getOpts)
+	ttxn, err := k.getTransactionCR(ctx, v1.NameFix(volTxn.Name()), false)
 
 	if k8sapierrors.IsNotFound(err) {
 		return nil, nil
@@ -1159,6 +1307,9 @@ func (k *CRDClientV1) GetVolumeTransaction(
 func (k *CRDClientV1) DeleteVolumeTransaction(ctx context.Context, volTxn *storage.VolumeTransaction) error {
 	err := k.crdClient.TridentV1().TridentTransactions(k.namespace).Delete(ctx, v1.NameFix(volTxn.Name()),
 		k.deleteOpts())
+	if err == nil {
+		k.cacheDelete(tridentTransactions, v1.NameFix(volTxn.Name()))
+	}
 
 	if k8sapierrors.IsNotFound(err) {
 		return nil
@@ -1173,15 +1324,20 @@ func (k *CRDClientV1) AddStorageClass(ctx context.Context, sc *storageclass.Stor
 		return err
 	}
 
-	_, err = k.crdClient.TridentV1().TridentStorageClasses(k.namespace).Create(ctx, persistentSC, createOpts)
+	persistentSC, err = k.crdClient.TridentV1().TridentStorageClasses(k.namespace).Create(ctx, persistentSC, createOpts)
 	if err != nil {
 		return err
 	}
+	k.cacheWrite(tridentStorageClasses, persistentSC)
 
 	return nil
 }
 
 func (k *CRDClientV1) HasStorageClasses(ctx context.Context) (bool, error) {
+	if c := k.readCache(tridentStorageClasses, false); c != nil {
+		return len(c.list()) > 0, nil
+	}
+
 	listOneOpts := metav1.ListOptions{Limit: 1}
 	scList, err := k.crdClient.TridentV1().TridentStorageClasses(k.namespace).List(ctx, listOneOpts)
 	if err != nil {
@@ -1192,7 +1348,7 @@ func (k *CRDClientV1) HasStorageClasses(ctx context.Context) (bool, error) {
 }
 
 func (k *CRDClientV1) GetStorageClass(ctx context.Context, scName string) (*storageclass.Persistent, error) {
-	sc, err := k.crdClient.TridentV1().TridentStorageClasses(k.namespace).Get(ctx, v1.NameFix(scName), getOpts)
+	sc, err := k.getStorageClassCR(ctx, v1.NameFix(scName), false)
 	if err != nil {
 		return nil, err
 	}
@@ -1206,14 +1362,14 @@ func (k *CRDClientV1) GetStorageClass(ctx context.Context, scName string) (*stor
 }
 
 func (k *CRDClientV1) GetStorageClasses(ctx context.Context) ([]*storageclass.Persistent, error) {
-	scList, err := k.crdClient.TridentV1().TridentStorageClasses(k.namespace).List(ctx, listOpts)
+	scs, err := k.listStorageClassesCRs(ctx, false)
 	if err != nil {
 		return nil, err
 	}
 
 	results := make([]*storageclass.Persistent, 0)
 
-	for _, item := range scList.Items {
+	for _, item := range scs {
 		if !item.ObjectMeta.DeletionTimestamp.IsZero() {
 			Logc(ctx).WithFields(LogFields{
 				"Name":              item.Name,
@@ -1236,6 +1392,9 @@ func (k *CRDClientV1) GetStorageClasses(ctx context.Context) ([]*storageclass.Pe
 func (k *CRDClientV1) DeleteStorageClass(ctx context.Context, sc *storageclass.StorageClass) error {
 	err := k.crdClient.TridentV1().TridentStorageClasses(k.namespace).Delete(ctx, v1.NameFix(sc.GetName()),
 		k.deleteOpts())
+	if err == nil {
+		k.cacheDelete(tridentStorageClasses, v1.NameFix(sc.GetName()))
+	}
 
 	if k8sapierrors.IsNotFound(err) {
 		return nil
@@ -1245,8 +1404,14 @@ func (k *CRDClientV1) DeleteStorageClass(ctx context.Context, sc *storageclass.S
 }
 
 func (k *CRDClientV1) AddOrUpdateNode(ctx context.Context, node *utils.Node) error {
+	return k.retryOnStaleCache(ctx, tridentNodes, func(live bool) error {
+		return k.addOrUpdateNode(ctx, node, live)
+	})
+}
+
+func (k *CRDClientV1) addOrUpdateNode(ctx context.Context, node *utils.Node, live bool) error {
 	// look to see if it's an existing one we need to update
-	existingNode, err := k.crdClient.TridentV1().TridentNodes(k.namespace).Get(ctx, v1.NameFix(node.Name), getOpts)
+	existingNode, err := k.getNodeCR(ctx, v1.NameFix(node.Name), live)
 	if err != nil {
 		if !IsStatusNotFoundError(err) {
 			return err
@@ -1259,10 +1424,11 @@ func (k *CRDClientV1) AddOrUpdateNode(ctx context.Context, node *utils.Node) err
 		if err = existingNode.Apply(node); err != nil {
 			return err
 		}
-		_, err = k.crdClient.TridentV1().TridentNodes(k.namespace).Update(ctx, existingNode, updateOpts)
+		existingNode, err = k.crdClient.TridentV1().TridentNodes(k.namespace).Update(ctx, existingNode, updateOpts)
 		if err != nil {
 			return err
 		}
+		k.cacheWrite(tridentNodes, existingNode)
 		return nil
 	}
 
@@ -1272,16 +1438,17 @@ func (k *CRDClientV1) AddOrUpdateNode(ctx context.Context, node *utils.Node) err
 		return err
 	}
 
-	_, err = k.crdClient.TridentV1().TridentNodes(k.namespace).Create(ctx, newNode, createOpts)
+	newNode, err = k.crdClient.TridentV1().TridentNodes(k.namespace).Create(ctx, newNode, createOpts)
 	if err != nil {
 		return err
 	}
+	k.cacheWrite(tridentNodes, newNode)
 
 	return nil
 }
 
 func (k *CRDClientV1) GetNode(ctx context.Context, nName string) (*utils.Node, error) {
-	node, err := k.crdClient.TridentV1().TridentNodes(k.namespace).Get(ctx, v1.NameFix(nName), getOpts)
+	node, err := k.getNodeCR(ctx, v1.NameFix(nName), false)
 	if err != nil {
 		return nil, err
 	}
@@ -1295,14 +1462,14 @@ func (k *CRDClientV1) GetNode(ctx context.Context, nName string) (*utils.Node, e
 }
 
 func (k *CRDClientV1) GetNodes(ctx context.Context) ([]*utils.Node, error) {
-	nodeList, err := k.crdClient.TridentV1().TridentNodes(k.namespace).List(ctx, listOpts)
+	nodes, err := k.listNodesCRs(ctx, false)
 	if err != nil {
 		return nil, err
 	}
 
 	results := make([]*utils.Node, 0)
 
-	for _, item := range nodeList.Items {
+	for _, item := range nodes {
 		if !item.ObjectMeta.DeletionTimestamp.IsZero() {
 			Logc(ctx).WithFields(LogFields{
 				"Name":              item.Name,
@@ -1324,6 +1491,9 @@ func (k *CRDClientV1) GetNodes(ctx context.Context) ([]*utils.Node, error) {
 
 func (k *CRDClientV1) DeleteNode(ctx context.Context, n *utils.Node) error {
 	err := k.crdClient.TridentV1().TridentNodes(k.namespace).Delete(ctx, v1.NameFix(n.Name), k.deleteOpts())
+	if err == nil {
+		k.cacheDelete(tridentNodes, v1.NameFix(n.Name))
+	}
 
 	if k8sapierrors.IsNotFound(err) {
 		return nil
@@ -1338,38 +1508,42 @@ func (k *CRDClientV1) AddVolumePublication(ctx context.Context, publication *uti
 		return err
 	}
 
-	_, err = k.crdClient.TridentV1().TridentVolumePublications(k.namespace).Create(ctx, newPublication, createOpts)
+	createdPublication, err := k.crdClient.TridentV1().TridentVolumePublications(k.namespace).Create(ctx,
+		newPublication, createOpts)
 	if err != nil {
 		if k8sapierrors.IsAlreadyExists(err) {
 			return NewAlreadyExistsError(newPublication.Kind, newPublication.Name)
 		}
 		return err
 	}
+	k.cacheWrite(tridentVolumePublications, createdPublication)
 
 	return nil
 }
 
 func (k *CRDClientV1) UpdateVolumePublication(ctx context.Context, publication *utils.VolumePublication) error {
-	existingPublication, err := k.crdClient.TridentV1().TridentVolumePublications(k.namespace).Get(ctx,
-		v1.NameFix(publication.Name), getOpts)
-	if err != nil {
-		return err
-	}
+	return k.retryOnStaleCache(ctx, tridentVolumePublications, func(live bool) error {
+		existingPublication, err := k.getVolumePublicationCR(ctx, v1.NameFix(publication.Name), live)
+		if err != nil {
+			return err
+		}
 
-	if err = existingPublication.Apply(publication); err != nil {
-		return err
-	}
-	_, err = k.crdClient.TridentV1().TridentVolumePublications(k.namespace).Update(ctx, existingPublication, updateOpts)
-	if err != nil {
-		return err
-	}
+		if err = existingPublication.Apply(publication); err != nil {
+			return err
+		}
+		existingPublication, err = k.crdClient.TridentV1().TridentVolumePublications(k.namespace).Update(ctx,
+			existingPublication, updateOpts)
+		if err != nil {
+			return err
+		}
+		k.cacheWrite(tridentVolumePublications, existingPublication)
 
-	return nil
+		return nil
+	})
 }
 
 func (k *CRDClientV1) GetVolumePublication(ctx context.Context, nName string) (*utils.VolumePublication, error) {
-	publication, err := k.crdClient.TridentV1().TridentVolumePublications(k.namespace).Get(ctx, v1.NameFix(nName),
-		getOpts)
+	publication, err := k.getVolumePublicationCR(ctx, v1.NameFix(nName), false)
 	if err != nil {
 		if k8sapierrors.IsNotFound(err) {
 			return nil, errors.NotFoundError(err.Error())
@@ -1386,14 +1560,14 @@ func (k *CRDClientV1) GetVolumePublication(ctx context.Context, nName string) (*
 }
 
 func (k *CRDClientV1) GetVolumePublications(ctx context.Context) ([]*utils.VolumePublication, error) {
-	publicationList, err := k.crdClient.TridentV1().TridentVolumePublications(k.namespace).List(ctx, listOpts)
+	publications, err := k.listVolumePublicationsCRs(ctx, false)
 	if err != nil {
 		return nil, err
 	}
 
 	results := make([]*utils.VolumePublication, 0)
 
-	for _, item := range publicationList.Items {
+	for _, item := range publications {
 		if !item.ObjectMeta.DeletionTimestamp.IsZero() {
 			Logc(ctx).WithFields(LogFields{
 				"Name":              item.Name,
@@ -1416,6 +1590,9 @@ func (k *CRDClientV1) GetVolumePublications(ctx context.Context) ([]*utils.Volum
 func (k *CRDClientV1) DeleteVolumePublication(ctx context.Context, vp *utils.VolumePublication) error {
 	err := k.crdClient.TridentV1().TridentVolumePublications(k.namespace).Delete(ctx, v1.NameFix(vp.Name),
 		k.deleteOpts())
+	if err == nil {
+		k.cacheDelete(tridentVolumePublications, v1.NameFix(vp.Name))
+	}
 
 	if k8sapierrors.IsNotFound(err) {
 		return errors.NotFoundError(err.Error())
@@ -1443,8 +1620,11 @@ func (k *CRDClientV1) AddSnapshot(ctx context.Context, snapshot *storage.Snapsho
 		return err
 	}
 
-	_, err = k.crdClient.TridentV1().TridentSnapshots(k.namespace).Create(ctx, persistentSnapshot, createOpts)
-	if err == nil || !k8sapierrors.IsAlreadyExists(err) {
+	tsnap, err := k.crdClient.TridentV1().TridentSnapshots(k.namespace).Create(ctx, persistentSnapshot, createOpts)
+	if err == nil {
+		k.cacheWrite(tridentSnapshots, tsnap)
+		return nil
+	} else if !k8sapierrors.IsAlreadyExists(err) {
 		return err
 	}
 
@@ -1459,28 +1639,35 @@ func (k *CRDClientV1) AddSnapshot(ctx context.Context, snapshot *storage.Snapsho
 	} else {
 		tsnap = tsnap.DeepCopy()
 		tsnap.RemoveTridentFinalizers()
-		_, updateErr := k.crdClient.TridentV1().TridentSnapshots(k.namespace).Update(ctx, tsnap, updateOpts)
+		tsnap, updateErr := k.crdClient.TridentV1().TridentSnapshots(k.namespace).Update(ctx, tsnap, updateOpts)
 		if updateErr != nil {
 			Logc(ctx).Errorf("Could not remove snapshot finalizers; %v", updateErr)
 			return updateErr
 		}
+		k.cacheWrite(tridentSnapshots, tsnap)
 
 		deleteErr := k.crdClient.TridentV1().TridentSnapshots(k.namespace).Delete(ctx, tsnap.Name, k.deleteOpts())
 		if deleteErr != nil {
 			Logc(ctx).Errorf("Could not delete snapshot; %v", deleteErr)
 			return deleteErr
 		}
+		k.cacheDelete(tridentSnapshots, tsnap.Name)
 	}
 
-	_, err = k.crdClient.TridentV1().TridentSnapshots(k.namespace).Create(ctx, persistentSnapshot, createOpts)
-	return err
+	tsnap, err = k.crdClient.TridentV1().TridentSnapshots(k.namespace).Create(ctx, persistentSnapshot, createOpts)
+	if err != nil {
+		return err
+	}
+	k.cacheWrite(tridentSnapshots, tsnap)
+
+	return nil
 }
 
 func (k *CRDClientV1) GetSnapshot(ctx context.Context, volumeName, snapshotName string) (
 	*storage.SnapshotPersistent, error,
 ) {
 	snapshotID := storage.MakeSnapshotID(volumeName, snapshotName)
-	snapshot, err := k.crdClient.TridentV1().TridentSnapshots(k.namespace).Get(ctx, v1.NameFix(snapshotID), getOpts)
+	snapshot, err := k.getSnapshotCR(ctx, v1.NameFix(snapshotID), false)
 	if err != nil {
 		return nil, err
 	}
@@ -1494,14 +1681,14 @@ func (k *CRDClientV1) GetSnapshot(ctx context.Context, volumeName, snapshotName
 }
 
 func (k *CRDClientV1) GetSnapshots(ctx context.Context) ([]*storage.SnapshotPersistent, error) {
-	snapshotList, err := k.crdClient.TridentV1().TridentSnapshots(k.namespace).List(ctx, listOpts)
+	snapshots, err := k.listSnapshotsCRs(ctx, false)
 	if err != nil {
 		return nil, err
 	}
 
 	results := make([]*storage.SnapshotPersistent, 0)
 
-	for _, item := range snapshotList.Items {
+	for _, item := range snapshots {
 		if !item.ObjectMeta.DeletionTimestamp.IsZero() {
 			Logc(ctx).WithFields(LogFields{
 				"Name":              item.Name,
@@ -1522,25 +1709,154 @@ func (k *CRDClientV1) GetSnapshots(ctx context.Context) ([]*storage.SnapshotPers
 }
 
 func (k *CRDClientV1) UpdateSnapshot(ctx context.Context, update *storage.Snapshot) error {
-	snapshot, err := k.crdClient.TridentV1().TridentSnapshots(k.namespace).Get(ctx, v1.NameFix(update.ID()), getOpts)
+	return k.retryOnStaleCache(ctx, tridentSnapshots, func(live bool) error {
+		snapshot, err := k.getSnapshotCR(ctx, v1.NameFix(update.ID()), live)
+		if err != nil {
+			return err
+		}
+
+		if err = snapshot.Apply(update.ConstructPersistent()); err != nil {
+			return err
+		}
+
+		snapshot, err = k.crdClient.TridentV1().TridentSnapshots(k.namespace).Update(ctx, snapshot, updateOpts)
+		if err != nil {
+			return err
+		}
+		k.cacheWrite(tridentSnapshots, snapshot)
+
+		return nil
+	})
+}
+
+func (k *CRDClientV1) DeleteSnapshot(ctx context.Context, snapshot *storage.Snapshot) error {
+	err := k.crdClient.TridentV1().TridentSnapshots(k.namespace).Delete(ctx, v1.NameFix(snapshot.ID()), k.deleteOpts())
+	if err == nil {
+		k.cacheDelete(tridentSnapshots, v1.NameFix(snapshot.ID()))
+	}
+
+	if k8sapierrors.IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
+
+func (k *CRDClientV1) DeleteSnapshots(ctx context.Context) error {
+	snapshots, err := k.listSnapshotsCRs(ctx, false)
 	if err != nil {
 		return err
 	}
 
-	if err = snapshot.Apply(update.ConstructPersistent()); err != nil {
+	for _, item := range snapshots {
+		err := k.crdClient.TridentV1().TridentSnapshots(k.namespace).Delete(ctx, item.ObjectMeta.Name, k.deleteOpts())
+		if err != nil {
+			return err
+		}
+		k.cacheDelete(tridentSnapshots, item.ObjectMeta.Name)
+	}
+
+	return nil
+}
+
+// AddGroupSnapshot accepts a group snapshot, converts it to its persistent form, and writes it to the database.
+// As with snapshots, any existing record left over from an earlier, failed attempt is replaced.
+func (k *CRDClientV1) AddGroupSnapshot(ctx context.Context, groupSnapshot *storage.GroupSnapshot) error {
+	persistentGroupSnapshot, err := v1.NewTridentGroupSnapshot(groupSnapshot.ConstructPersistent())
+	if err != nil {
 		return err
 	}
 
-	_, err = k.crdClient.TridentV1().TridentSnapshots(k.namespace).Update(ctx, snapshot, updateOpts)
+	tgsnap, err := k.crdClient.TridentV1().TridentGroupSnapshots(k.namespace).Create(ctx, persistentGroupSnapshot,
+		createOpts)
+	if err == nil {
+		k.cacheWrite(tridentGroupSnapshots, tgsnap)
+		return nil
+	} else if !k8sapierrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	tgsnap, getErr := k.crdClient.TridentV1().TridentGroupSnapshots(k.namespace).Get(ctx,
+		persistentGroupSnapshot.Name, getOpts)
+	if getErr != nil {
+		if !k8sapierrors.IsNotFound(getErr) {
+			return getErr
+		}
+	} else {
+		tgsnap = tgsnap.DeepCopy()
+		tgsnap.RemoveTridentFinalizers()
+		tgsnap, updateErr := k.crdClient.TridentV1().TridentGroupSnapshots(k.namespace).Update(ctx, tgsnap,
+			updateOpts)
+		if updateErr != nil {
+			Logc(ctx).Errorf("Could not remove group snapshot finalizers; %v", updateErr)
+			return updateErr
+		}
+		k.cacheWrite(tridentGroupSnapshots, tgsnap)
+
+		deleteErr := k.crdClient.TridentV1().TridentGroupSnapshots(k.namespace).Delete(ctx, tgsnap.Name,
+			k.deleteOpts())
+		if deleteErr != nil {
+			Logc(ctx).Errorf("Could not delete group snapshot; %v", deleteErr)
+			return deleteErr
+		}
+		k.cacheDelete(tridentGroupSnapshots, tgsnap.Name)
+	}
+
+	tgsnap, err = k.crdClient.TridentV1().TridentGroupSnapshots(k.namespace).Create(ctx, persistentGroupSnapshot,
+		createOpts)
 	if err != nil {
 		return err
 	}
+	k.cacheWrite(tridentGroupSnapshots, tgsnap)
 
 	return nil
 }
 
-func (k *CRDClientV1) DeleteSnapshot(ctx context.Context, snapshot *storage.Snapshot) error {
-	err := k.crdClient.TridentV1().TridentSnapshots(k.namespace).Delete(ctx, v1.NameFix(snapshot.ID()), k.deleteOpts())
+func (k *CRDClientV1) GetGroupSnapshot(ctx context.Context, groupSnapshotName string) (
+	*storage.GroupSnapshotPersistent, error,
+) {
+	groupSnapshot, err := k.getGroupSnapshotCR(ctx, v1.NameFix(groupSnapshotName), false)
+	if err != nil {
+		return nil, err
+	}
+
+	return groupSnapshot.Persistent()
+}
+
+func (k *CRDClientV1) GetGroupSnapshots(ctx context.Context) ([]*storage.GroupSnapshotPersistent, error) {
+	groupSnapshots, err := k.listGroupSnapshotsCRs(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*storage.GroupSnapshotPersistent, 0)
+
+	for _, item := range groupSnapshots {
+		if !item.ObjectMeta.DeletionTimestamp.IsZero() {
+			Logc(ctx).WithFields(LogFields{
+				"Name":              item.Name,
+				"DeletionTimestamp": item.DeletionTimestamp,
+			}).Debug("GetGroupSnapshots skipping deleted GroupSnapshot")
+			continue
+		}
+
+		persistentGroupSnapshot, err := item.Persistent()
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, persistentGroupSnapshot)
+	}
+
+	return results, nil
+}
+
+func (k *CRDClientV1) DeleteGroupSnapshot(ctx context.Context, groupSnapshot *storage.GroupSnapshot) error {
+	err := k.crdClient.TridentV1().TridentGroupSnapshots(k.namespace).Delete(ctx, v1.NameFix(groupSnapshot.ID()),
+		k.deleteOpts())
+	if err == nil {
+		k.cacheDelete(tridentGroupSnapshots, v1.NameFix(groupSnapshot.ID()))
+	}
 
 	if k8sapierrors.IsNotFound(err) {
 		return nil
@@ -1549,18 +1865,93 @@ func (k *CRDClientV1) DeleteSnapshot(ctx context.Context, snapshot *storage.Snap
 	return err
 }
 
-func (k *CRDClientV1) DeleteSnapshots(ctx context.Context) error {
-	snapshotList, err := k.crdClient.TridentV1().TridentSnapshots(k.namespace).List(ctx, listOpts)
+func (k *CRDClientV1) AddSnapshotPolicy(ctx context.Context, policy *storage.SnapshotPolicy) error {
+	persistentPolicy, err := v1.NewTridentSnapshotPolicyState(policy.ConstructPersistent())
 	if err != nil {
 		return err
 	}
 
-	for _, item := range snapshotList.Items {
-		err := k.crdClient.TridentV1().TridentSnapshots(k.namespace).Delete(ctx, item.ObjectMeta.Name, k.deleteOpts())
+	persistentPolicy, err = k.crdClient.TridentV1().TridentSnapshotPolicyStates(k.namespace).Create(ctx,
+		persistentPolicy, createOpts)
+	if err != nil {
+		return err
+	}
+	k.cacheWrite(tridentSnapshotPolicies, persistentPolicy)
+
+	return nil
+}
+
+func (k *CRDClientV1) GetSnapshotPolicy(ctx context.Context, policyName string) (
+	*storage.SnapshotPolicyPersistent, error,
+) {
+	policy, err := k.getSnapshotPolicyCR(ctx, v1.NameFix(policyName), false)
+	if err != nil {
+		return nil, err
+	}
+
+	return policy.Persistent()
+}
+
+func (k *CRDClientV1) GetSnapshotPolicies(ctx context.Context) ([]*storage.SnapshotPolicyPersistent, error) {
+	policies, err := k.listSnapshotPoliciesCRs(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*storage.SnapshotPolicyPersistent, 0)
+
+	for _, item := range policies {
+		if !item.ObjectMeta.DeletionTimestamp.IsZero() {
+			Logc(ctx).WithFields(LogFields{
+				"Name":              item.Name,
+				"DeletionTimestamp": item.DeletionTimestamp,
+			}).Debug("GetSnapshotPolicies skipping deleted SnapshotPolicy")
+			continue
+		}
+
+		persistentPolicy, err := item.Persistent()
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, persistentPolicy)
+	}
+
+	return results, nil
+}
+
+func (k *CRDClientV1) UpdateSnapshotPolicy(ctx context.Context, update *storage.SnapshotPolicy) error {
+	return k.retryOnStaleCache(ctx, tridentSnapshotPolicies, func(live bool) error {
+		policy, err := k.getSnapshotPolicyCR(ctx, v1.NameFix(update.ID()), live)
+		if err != nil {
+			return err
+		}
+
+		if err = policy.Apply(update.ConstructPersistent()); err != nil {
+			return err
+		}
+
+		policy, err = k.crdClient.TridentV1().TridentSnapshotPolicyStates(k.namespace).Update(ctx, policy,
+			updateOpts)
 		if err != nil {
 			return err
 		}
+		k.cacheWrite(tridentSnapshotPolicies, policy)
+
+		return nil
+	})
+}
+
+func (k *CRDClientV1) DeleteSnapshotPolicy(ctx context.Context, policy *storage.SnapshotPolicy) error {
+	err := k.crdClient.TridentV1().TridentSnapshotPolicyStates(k.namespace).Delete(ctx, v1.NameFix(policy.ID()),
+		k.deleteOpts())
+	if err == nil {
+		k.cacheDelete(tridentSnapshotPolicies, v1.NameFix(policy.ID()))
 	}
 
-	return nil
+	if k8sapierrors.IsNotFound(err) {
+		return nil
+	}
+
+	return err
 }
@@ -0,0 +1,599 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package persistentstore
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	k8sapierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+
+	clik8sclient "github.com/netapp/trident/cli/k8s_client"
+	. "github.com/netapp/trident/logging"
+	v1 "github.com/netapp/trident/persistent_store/crd/apis/netapp/v1"
+	tridentv1clientset "github.com/netapp/trident/persistent_store/crd/client/clientset/versioned"
+	tridentinformers "github.com/netapp/trident/persistent_store/crd/client/informers/externalversions"
+)
+
+const (
+	crdCacheSyncTimeout = 5 * time.Minute
+
+	tridentVersions           = "tridentversions"
+	tridentBackends           = "tridentbackends"
+	tridentVolumes            = "tridentvolumes"
+	tridentTransactions       = "tridenttransactions"
+	tridentStorageClasses     = "tridentstorageclasses"
+	tridentNodes              = "tridentnodes"
+	tridentVolumePublications = "tridentvolumepublications"
+	tridentSnapshots          = "tridentsnapshots"
+	tridentGroupSnapshots     = "tridentgroupsnapshots"
+	tridentSnapshotPolicies   = "tridentsnapshotpolicystates"
+)
+
+// crdCache serves the reads of a CRDClientV1 from shared informers, so that after the initial list of each
+// resource only writes and watches reach the API server.
+type crdCache struct {
+	factory   tridentinformers.SharedInformerFactory
+	stopChan  chan struct{}
+	stopOnce  sync.Once
+	resources map[string]*crdResourceCache
+}
+
+func newCRDCache(client tridentv1clientset.Interface, namespace string) *crdCache {
+	factory := tridentinformers.NewSharedInformerFactoryWithOptions(client, 0,
+		tridentinformers.WithNamespace(namespace))
+	informers := factory.Trident().V1()
+
+	c := &crdCache{
+		factory:   factory,
+		stopChan:  make(chan struct{}),
+		resources: make(map[string]*crdResourceCache),
+	}
+	for resource, informer := range map[string]cache.SharedIndexInformer{
+		tridentVersions:           informers.TridentVersions().Informer(),
+		tridentBackends:           informers.TridentBackends().Informer(),
+		tridentVolumes:            informers.TridentVolumes().Informer(),
+		tridentTransactions:       informers.TridentTransactions().Informer(),
+		tridentStorageClasses:     informers.TridentStorageClasses().Informer(),
+		tridentNodes:              informers.TridentNodes().Informer(),
+		tridentVolumePublications: informers.TridentVolumePublications().Informer(),
+		tridentSnapshots:          informers.TridentSnapshots().Informer(),
+		tridentGroupSnapshots:     informers.TridentGroupSnapshots().Informer(),
+		tridentSnapshotPolicies:   informers.TridentSnapshotPolicyStates().Informer(),
+	} {
+		c.resources[resource] = newCRDResourceCache(resource, namespace, informer)
+	}
+
+	return c
+}
+
+// startCRDCache returns a synced cache of Trident's custom resources, or nil if reads must go to the API server
+// because a resource's CRD is not installed or its informer could not list it.
+func startCRDCache(
+	ctx context.Context, client tridentv1clientset.Interface, k8sClient clik8sclient.KubernetesClient,
+	namespace string,
+) *crdCache {
+	c := newCRDCache(client, namespace)
+
+	for resource := range c.resources {
+		crdName := resource + "." + v1.GroupName
+		if exists, err := k8sClient.CheckCRDExists(crdName); err != nil || !exists {
+			Logc(ctx).WithField("CRD", crdName).WithError(err).Warning(
+				"CRD not found, persistent store will read from the API server.")
+			return nil
+		}
+	}
+
+	if err := c.start(ctx, crdCacheSyncTimeout); err != nil {
+		Logc(ctx).WithError(err).Warning("Could not sync persistent store cache, reading from the API server.")
+		c.stop()
+		return nil
+	}
+
+	return c
+}
+
+// start runs the informers and waits until each has listed its resource once.
+func (c *crdCache) start(ctx context.Context, timeout time.Duration) error {
+	c.factory.Start(c.stopChan)
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for informerType, synced := range c.factory.WaitForCacheSync(waitCtx.Done()) {
+		if !synced {
+			return fmt.Errorf("timed out waiting for %v cache to sync", informerType)
+		}
+	}
+
+	Logc(ctx).Debug("Persistent store caches synced.")
+
+	return nil
+}
+
+func (c *crdCache) stop() {
+	c.stopOnce.Do(func() { close(c.stopChan) })
+}
+
+// crdCacheWrite records an object written by this client that its informer may not have observed yet.
+type crdCacheWrite struct {
+	// object is the latest state of the object, or nil if the object no longer exists
+	object runtime.Object
+	// minVersion is the resource version at which the informer has caught up with this write
+	minVersion uint64
+}
+
+// crdResourceCache serves the objects of one Trident custom resource from an informer, overlaid with the
+// results of this client's own writes until the informer has caught up with them.  Resource versions are
+// compared as integers, as the API server's etcd storage defines them.
+type crdResourceCache struct {
+	resource  string
+	namespace string
+	indexer   cache.Indexer
+
+	mutex  sync.Mutex
+	writes map[string]*crdCacheWrite
+}
+
+func newCRDResourceCache(resource, namespace string, informer cache.SharedIndexInformer) *crdResourceCache {
+	c := &crdResourceCache{
+		resource:  resource,
+		namespace: namespace,
+		indexer:   informer.GetIndexer(),
+		writes:    make(map[string]*crdCacheWrite),
+	}
+
+	_, _ = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.observe,
+		UpdateFunc: func(_, newObj interface{}) { c.observe(newObj) },
+		DeleteFunc: c.observeDelete,
+	})
+
+	return c
+}
+
+// observe forgets a write once the informer has seen that version of the object or a later one.
+func (c *crdResourceCache) observe(obj interface{}) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if write, ok := c.writes[accessor.GetName()]; ok && resourceVersion(accessor) >= write.minVersion {
+		delete(c.writes, accessor.GetName())
+	}
+}
+
+// observeDelete forgets a write once the informer has seen the object go away.
+func (c *crdResourceCache) observeDelete(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	write, ok := c.writes[accessor.GetName()]
+	if ok && (write.object == nil || resourceVersion(accessor) >= write.minVersion) {
+		delete(c.writes, accessor.GetName())
+	}
+}
+
+// key returns the informer's key for the named object.
+func (c *crdResourceCache) key(name string) string {
+	if c.namespace == "" {
+		return name
+	}
+	return c.namespace + "/" + name
+}
+
+// merge returns the newer of an informer's copy of an object and this client's last write of it.  The informer's
+// copy must be read while the lock is held, so that a write forgotten by observe is never older than it.
+func (c *crdResourceCache) merge(name string, cached interface{}, exists bool) (runtime.Object, bool) {
+	write, ok := c.writes[name]
+	if !ok {
+		if !exists {
+			return nil, false
+		}
+		object, isObject := cached.(runtime.Object)
+		return object, isObject
+	}
+
+	if exists {
+		if accessor, err := meta.Accessor(cached); err == nil && resourceVersion(accessor) >= write.minVersion {
+			delete(c.writes, name)
+			object, isObject := cached.(runtime.Object)
+			return object, isObject
+		}
+	} else if write.object == nil {
+		delete(c.writes, name)
+	}
+
+	return write.object, write.object != nil
+}
+
+// get returns a copy of the named object, or a NotFound error like the API server would.
+func (c *crdResourceCache) get(name string) (runtime.Object, error) {
+	persistentStoreCacheReadsTotal.WithLabelValues(c.resource, "get").Inc()
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	cached, exists, err := c.indexer.GetByKey(c.key(name))
+	if err != nil {
+		return nil, err
+	}
+
+	object, exists := c.merge(name, cached, exists)
+	if !exists {
+		return nil, k8sapierrors.NewNotFound(v1.Resource(c.resource), name)
+	}
+
+	return object.DeepCopyObject(), nil
+}
+
+// list returns copies of all objects, sorted by name like an API server list.
+func (c *crdResourceCache) list() []runtime.Object {
+	persistentStoreCacheReadsTotal.WithLabelValues(c.resource, "list").Inc()
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	cached := c.indexer.List()
+
+	objects := make([]runtime.Object, 0, len(cached)+len(c.writes))
+	seen := make(map[string]bool, len(cached))
+
+	for _, item := range cached {
+		accessor, err := meta.Accessor(item)
+		if err != nil {
+			continue
+		}
+		seen[accessor.GetName()] = true
+		if object, exists := c.merge(accessor.GetName(), item, true); exists {
+			objects = append(objects, object.DeepCopyObject())
+		}
+	}
+	for name, write := range c.writes {
+		if !seen[name] && write.object != nil {
+			objects = append(objects, write.object.DeepCopyObject())
+		}
+	}
+
+	sort.Slice(objects, func(i, j int) bool {
+		return objectName(objects[i]) < objectName(objects[j])
+	})
+
+	return objects
+}
+
+// write records an object returned by a create or update call.  An object whose last finalizer was removed
+// after it was deleted is gone from the API server, so it is recorded as such.
+func (c *crdResourceCache) write(obj runtime.Object) {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if accessor.GetDeletionTimestamp() != nil && len(accessor.GetFinalizers()) == 0 {
+		c.writes[accessor.GetName()] = &crdCacheWrite{minVersion: resourceVersion(accessor) + 1}
+		return
+	}
+
+	c.writes[accessor.GetName()] = &crdCacheWrite{
+		object:     obj.DeepCopyObject(),
+		minVersion: resourceVersion(accessor),
+	}
+}
+
+// delete records that the named object was deleted.  Objects with finalizers remain on the API server with a
+// deletion timestamp until the finalizers are removed, so they are recorded that way.
+func (c *crdResourceCache) delete(name string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	cached, exists, err := c.indexer.GetByKey(c.key(name))
+	if err != nil {
+		return
+	}
+
+	object, exists := c.merge(name, cached, exists)
+	if !exists {
+		return
+	}
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		return
+	}
+
+	write := &crdCacheWrite{minVersion: resourceVersion(accessor) + 1}
+	if len(accessor.GetFinalizers()) > 0 {
+		write.object = object.DeepCopyObject()
+		deletionTimestamp := metav1.Now()
+		if deletingAccessor, err := meta.Accessor(write.object); err == nil {
+			deletingAccessor.SetDeletionTimestamp(&deletionTimestamp)
+		}
+	}
+	c.writes[name] = write
+}
+
+// resourceVersion returns an object's resource version as an integer, or zero if it has none.
+func resourceVersion(accessor metav1.Object) uint64 {
+	version, err := strconv.ParseUint(accessor.GetResourceVersion(), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return version
+}
+
+func objectName(obj runtime.Object) string {
+	accessor, err := meta.Accessor(obj)
+	if err != nil {
+		return ""
+	}
+	return accessor.GetName()
+}
+
+// cachedGet reads an object from the cache if there is one, or else from the API server.
+func cachedGet[T runtime.Object](c *crdResourceCache, name string, live func() (T, error)) (T, error) {
+	if c == nil {
+		return live()
+	}
+
+	object, err := c.get(name)
+	if err != nil {
+		var empty T
+		return empty, err
+	}
+	return object.(T), nil
+}
+
+// cachedList lists objects from the cache if there is one, or else from the API server.
+func cachedList[T runtime.Object](c *crdResourceCache, live func() ([]T, error)) ([]T, error) {
+	if c == nil {
+		return live()
+	}
+
+	objects := c.list()
+	results := make([]T, 0, len(objects))
+	for _, object := range objects {
+		results = append(results, object.(T))
+	}
+	return results, nil
+}
+
+// The following methods read Trident's custom resources from the cache if there is one and live is false, or
+// else from the API server.
+
+func (k *CRDClientV1) listVersionsCRs(ctx context.Context, live bool) ([]*v1.TridentVersion, error) {
+	return cachedList(k.readCache(tridentVersions, live), func() ([]*v1.TridentVersion, error) {
+		list, err := k.crdClient.TridentV1().TridentVersions(k.namespace).List(ctx, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	})
+}
+
+func (k *CRDClientV1) listBackendsCRs(ctx context.Context, live bool) ([]*v1.TridentBackend, error) {
+	return cachedList(k.readCache(tridentBackends, live), func() ([]*v1.TridentBackend, error) {
+		list, err := k.crdClient.TridentV1().TridentBackends(k.namespace).List(ctx, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	})
+}
+
+func (k *CRDClientV1) getVolumeCR(ctx context.Context, name string, live bool) (*v1.TridentVolume, error) {
+	return cachedGet(k.readCache(tridentVolumes, live), name, func() (*v1.TridentVolume, error) {
+		return k.crdClient.TridentV1().TridentVolumes(k.namespace).Get(ctx, name, getOpts)
+	})
+}
+
+func (k *CRDClientV1) listVolumesCRs(ctx context.Context, live bool) ([]*v1.TridentVolume, error) {
+	return cachedList(k.readCache(tridentVolumes, live), func() ([]*v1.TridentVolume, error) {
+		list, err := k.crdClient.TridentV1().TridentVolumes(k.namespace).List(ctx, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	})
+}
+
+func (k *CRDClientV1) getTransactionCR(ctx context.Context, name string, live bool) (*v1.TridentTransaction, error) {
+	return cachedGet(k.readCache(tridentTransactions, live), name, func() (*v1.TridentTransaction, error) {
+		return k.crdClient.TridentV1().TridentTransactions(k.namespace).Get(ctx, name, getOpts)
+	})
+}
+
+func (k *CRDClientV1) listTransactionsCRs(ctx context.Context, live bool) ([]*v1.TridentTransaction, error) {
+	return cachedList(k.readCache(tridentTransactions, live), func() ([]*v1.TridentTransaction, error) {
+		list, err := k.crdClient.TridentV1().TridentTransactions(k.namespace).List(ctx, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	})
+}
+
+func (k *CRDClientV1) getStorageClassCR(ctx context.Context, name string, live bool) (*v1.TridentStorageClass, error) {
+	return cachedGet(k.readCache(tridentStorageClasses, live), name, func() (*v1.TridentStorageClass, error) {
+		return k.crdClient.TridentV1().TridentStorageClasses(k.namespace).Get(ctx, name, getOpts)
+	})
+}
+
+func (k *CRDClientV1) listStorageClassesCRs(ctx context.Context, live bool) ([]*v1.TridentStorageClass, error) {
+	return cachedList(k.readCache(tridentStorageClasses, live), func() ([]*v1.TridentStorageClass, error) {
+		list, err := k.crdClient.TridentV1().TridentStorageClasses(k.namespace).List(ctx, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	})
+}
+
+func (k *CRDClientV1) getNodeCR(ctx context.Context, name string, live bool) (*v1.TridentNode, error) {
+	return cachedGet(k.readCache(tridentNodes, live), name, func() (*v1.TridentNode, error) {
+		return k.crdClient.TridentV1().TridentNodes(k.namespace).Get(ctx, name, getOpts)
+	})
+}
+
+func (k *CRDClientV1) listNodesCRs(ctx context.Context, live bool) ([]*v1.TridentNode, error) {
+	return cachedList(k.readCache(tridentNodes, live), func() ([]*v1.TridentNode, error) {
+		list, err := k.crdClient.TridentV1().TridentNodes(k.namespace).List(ctx, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	})
+}
+
+func (k *CRDClientV1) getVolumePublicationCR(
+	ctx context.Context, name string, live bool,
+) (*v1.TridentVolumePublication, error) {
+	return cachedGet(k.readCache(tridentVolumePublications, live), name, func() (*v1.TridentVolumePublication, error) {
+		return k.crdClient.TridentV1().TridentVolumePublications(k.namespace).Get(ctx, name, getOpts)
+	})
+}
+
+func (k *CRDClientV1) listVolumePublicationsCRs(
+	ctx context.Context, live bool,
+) ([]*v1.TridentVolumePublication, error) {
+	return cachedList(k.readCache(tridentVolumePublications, live), func() ([]*v1.TridentVolumePublication, error) {
+		list, err := k.crdClient.TridentV1().TridentVolumePublications(k.namespace).List(ctx, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	})
+}
+
+func (k *CRDClientV1) getSnapshotCR(ctx context.Context, name string, live bool) (*v1.TridentSnapshot, error) {
+	return cachedGet(k.readCache(tridentSnapshots, live), name, func() (*v1.TridentSnapshot, error) {
+		return k.crdClient.TridentV1().TridentSnapshots(k.namespace).Get(ctx, name, getOpts)
+	})
+}
+
+func (k *CRDClientV1) listSnapshotsCRs(ctx context.Context, live bool) ([]*v1.TridentSnapshot, error) {
+	return cachedList(k.readCache(tridentSnapshots, live), func() ([]*v1.TridentSnapshot, error) {
+		list, err := k.crdClient.TridentV1().TridentSnapshots(k.namespace).List(ctx, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	})
+}
+
+func (k *CRDClientV1) getGroupSnapshotCR(
+	ctx context.Context, name string, live bool,
+) (*v1.TridentGroupSnapshot, error) {
+	return cachedGet(k.readCache(tridentGroupSnapshots, live), name, func() (*v1.TridentGroupSnapshot, error) {
+		return k.crdClient.TridentV1().TridentGroupSnapshots(k.namespace).Get(ctx, name, getOpts)
+	})
+}
+
+func (k *CRDClientV1) listGroupSnapshotsCRs(ctx context.Context, live bool) ([]*v1.TridentGroupSnapshot, error) {
+	return cachedList(k.readCache(tridentGroupSnapshots, live), func() ([]*v1.TridentGroupSnapshot, error) {
+		list, err := k.crdClient.TridentV1().TridentGroupSnapshots(k.namespace).List(ctx, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	})
+}
+
+func (k *CRDClientV1) getSnapshotPolicyCR(
+	ctx context.Context, name string, live bool,
+) (*v1.TridentSnapshotPolicyState, error) {
+	c := k.readCache(tridentSnapshotPolicies, live)
+	return cachedGet(c, name, func() (*v1.TridentSnapshotPolicyState, error) {
+		return k.crdClient.TridentV1().TridentSnapshotPolicyStates(k.namespace).Get(ctx, name, getOpts)
+	})
+}
+
+func (k *CRDClientV1) listSnapshotPoliciesCRs(
+	ctx context.Context, live bool,
+) ([]*v1.TridentSnapshotPolicyState, error) {
+	return cachedList(k.readCache(tridentSnapshotPolicies, live), func() ([]*v1.TridentSnapshotPolicyState, error) {
+		list, err := k.crdClient.TridentV1().TridentSnapshotPolicyStates(k.namespace).List(ctx, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		return list.Items, nil
+	})
+}
+
+// apiCallCounter counts the requests a clientset makes for Trident custom resources.
+type apiCallCounter struct {
+	next http.RoundTripper
+}
+
+func (c *apiCallCounter) RoundTrip(req *http.Request) (*http.Response, error) {
+	resource, verb := crdRequestInfo(req)
+	persistentStoreAPICallsTotal.WithLabelValues(resource, verb).Inc()
+	return c.next.RoundTrip(req)
+}
+
+// crdRequestInfo determines the resource and verb of a request against a path such as
+// /apis/trident.netapp.io/v1/namespaces/trident/tridentvolumes/pvc-1234.
+func crdRequestInfo(req *http.Request) (resource, verb string) {
+	parts := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	if len(parts) >= 5 && parts[3] == "namespaces" {
+		parts = append(parts[:3:3], parts[5:]...)
+	}
+
+	resource = "unknown"
+	named := false
+	if len(parts) >= 4 {
+		resource = parts[3]
+		named = len(parts) >= 5
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		if watch := req.URL.Query().Get("watch"); watch == "true" || watch == "1" {
+			verb = "watch"
+		} else if named {
+			verb = "get"
+		} else {
+			verb = "list"
+		}
+	case http.MethodPost:
+		verb = "create"
+	case http.MethodPut:
+		verb = "update"
+	case http.MethodPatch:
+		verb = "patch"
+	case http.MethodDelete:
+		if named {
+			verb = "delete"
+		} else {
+			verb = "deletecollection"
+		}
+	default:
+		verb = strings.ToLower(req.Method)
+	}
+
+	return resource, verb
+}
@@ -0,0 +1,252 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package persistentstore
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgotesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/netapp/trident/config"
+	v1 "github.com/netapp/trident/persistent_store/crd/apis/netapp/v1"
+	"github.com/netapp/trident/storage"
+)
+
+func getTestCachedKubernetesClient(t *testing.T) (*CRDClientV1, *Clientset) {
+	p, _ := GetTestKubernetesClient()
+	client := p.crdClient.(*Clientset)
+
+	p.cache = newCRDCache(client, p.namespace)
+	if err := p.cache.start(ctx(), 10*time.Second); err != nil {
+		t.Fatalf("Cache did not sync; %v", err)
+	}
+	t.Cleanup(func() { _ = p.Stop() })
+
+	return p, client
+}
+
+// countReads returns the number of get and list calls made against a resource.
+func countReads(client *Clientset, resource string) int {
+	reads := 0
+	for _, action := range client.Actions() {
+		if action.GetResource().Resource == resource && (action.GetVerb() == "get" || action.GetVerb() == "list") {
+			reads++
+		}
+	}
+	return reads
+}
+
+func waitFor(t *testing.T, description string, condition func() bool) {
+	deadline := time.Now().Add(10 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatalf("Timed out waiting for %s", description)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestCRDClientV1_CachedVolume(t *testing.T) {
+	p, client := getTestCachedKubernetesClient(t)
+	readsAfterSync := countReads(client, tridentVolumes)
+
+	volConfig := storage.VolumeConfig{
+		Version:  config.OrchestratorAPIVersion,
+		Name:     "vol1",
+		Size:     "1GB",
+		Protocol: config.File,
+	}
+	vol := &storage.Volume{Config: &volConfig, BackendUUID: uuid.NewString(), Pool: storagePool}
+
+	// Written objects are read back at once, without asking the API server
+	if err := p.AddVolume(ctx(), vol); err != nil {
+		t.Fatal(err)
+	}
+	if recovered, err := p.GetVolume(ctx(), "vol1"); err != nil || recovered.Config.Size != "1GB" {
+		t.Fatalf("Volume was not read back from the cache; %v", err)
+	}
+
+	volConfig.Size = "2GB"
+	if err := p.UpdateVolume(ctx(), vol); err != nil {
+		t.Fatal(err)
+	}
+	if recovered, err := p.GetVolume(ctx(), "vol1"); err != nil || recovered.Config.Size != "2GB" {
+		t.Fatalf("Volume update was not read back from the cache; %v", err)
+	}
+	if volumes, err := p.GetVolumes(ctx()); err != nil || len(volumes) != 1 {
+		t.Fatalf("Expected one volume; got %v, %v", volumes, err)
+	}
+	if reads := countReads(client, tridentVolumes); reads != readsAfterSync {
+		t.Errorf("Expected reads to be served from the cache; %d API reads were made", reads-readsAfterSync)
+	}
+
+	// A deleted volume is held by its finalizers, so it is still found but no longer listed
+	if err := p.DeleteVolume(ctx(), vol); err != nil {
+		t.Fatal(err)
+	}
+	if volumes, err := p.GetVolumes(ctx()); err != nil || len(volumes) != 0 {
+		t.Fatalf("Expected no volumes; got %v, %v", volumes, err)
+	}
+	tvol, err := p.getVolumeCR(ctx(), "vol1", false)
+	if err != nil || tvol.DeletionTimestamp.IsZero() {
+		t.Fatalf("Volume should be deleting; %v", err)
+	}
+
+	// Once another client removes the finalizers, the informer drops the volume
+	tvol.RemoveTridentFinalizers()
+	if _, err = client.TridentV1().TridentVolumes(p.namespace).Update(ctx(), tvol, updateOpts); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, "volume deletion", func() bool {
+		_, err := p.GetVolume(ctx(), "vol1")
+		return errors.IsNotFound(err)
+	})
+}
+
+func TestCRDClientV1_CachedUpdateConflict(t *testing.T) {
+	p, client := getTestCachedKubernetesClient(t)
+
+	node := &v1.TridentNode{ObjectMeta: metav1.ObjectMeta{Name: "node1"}, IQN: "iqn.1"}
+	if _, err := client.TridentV1().TridentNodes(p.namespace).Create(ctx(), node, createOpts); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, "node to be cached", func() bool {
+		_, err := p.GetNode(ctx(), "node1")
+		return err == nil
+	})
+
+	// The first update is rejected as though the cached node were stale
+	conflicts := 0
+	client.PrependReactor("update", tridentNodes,
+		func(action clientgotesting.Action) (bool, runtime.Object, error) {
+			if conflicts > 0 {
+				return false, nil, nil
+			}
+			conflicts++
+			return true, nil, errors.NewConflict(v1.Resource(tridentNodes), "node1", nil)
+		})
+	readsBefore := countReads(client, tridentNodes)
+
+	persistentNode, err := p.GetNode(ctx(), "node1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	persistentNode.IQN = "iqn.2"
+	if err = p.AddOrUpdateNode(ctx(), persistentNode); err != nil {
+		t.Fatalf("Update should have been retried; %v", err)
+	}
+
+	if reads := countReads(client, tridentNodes) - readsBefore; reads != 1 {
+		t.Errorf("Expected the retry to read the node from the API server once; got %d reads", reads)
+	}
+	if recovered, err := p.GetNode(ctx(), "node1"); err != nil || recovered.IQN != "iqn.2" {
+		t.Errorf("Node update was not read back from the cache; %v", err)
+	}
+}
+
+func TestCRDResourceCache_Writes(t *testing.T) {
+	c := &crdResourceCache{
+		resource:  tridentVolumes,
+		namespace: "trident",
+		indexer:   cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{}),
+		writes:    make(map[string]*crdCacheWrite),
+	}
+	tvol := func(version string, finalizers ...string) *v1.TridentVolume {
+		return &v1.TridentVolume{ObjectMeta: metav1.ObjectMeta{
+			Name: "vol1", Namespace: "trident", ResourceVersion: version, Finalizers: finalizers,
+		}}
+	}
+	version := func() string {
+		obj, err := c.get("vol1")
+		if err != nil {
+			return err.Error()
+		}
+		return obj.(*v1.TridentVolume).ResourceVersion
+	}
+
+	// A write wins until the informer sees the same or a later version
+	_ = c.indexer.Add(tvol("5"))
+	c.write(tvol("7", v1.TridentFinalizerName))
+	if v := version(); v != "7" {
+		t.Errorf("Expected the written version; got %s", v)
+	}
+	_ = c.indexer.Update(tvol("6"))
+	c.observe(tvol("6"))
+	if v := version(); v != "7" || len(c.list()) != 1 {
+		t.Errorf("An older informer version should not replace a write; got %s", v)
+	}
+	_ = c.indexer.Update(tvol("8", v1.TridentFinalizerName))
+	if v := version(); v != "8" || len(c.writes) != 0 {
+		t.Errorf("A newer informer version should replace a write; got %s", v)
+	}
+
+	// A deleted object is kept with a deletion timestamp while it has finalizers
+	c.delete("vol1")
+	obj, err := c.get("vol1")
+	if err != nil || obj.(*v1.TridentVolume).DeletionTimestamp.IsZero() {
+		t.Fatalf("Deleted volume should still exist with a deletion timestamp; %v", err)
+	}
+	_ = c.indexer.Update(tvol("8", v1.TridentFinalizerName))
+	if obj, err = c.get("vol1"); err != nil || obj.(*v1.TridentVolume).DeletionTimestamp.IsZero() {
+		t.Errorf("Informer has not seen the deletion yet; %v", err)
+	}
+
+	// Removing the last finalizer of a deleting object removes the object
+	deleting := tvol("9")
+	deleting.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+	c.write(deleting)
+	if _, err = c.get("vol1"); !errors.IsNotFound(err) {
+		t.Errorf("Expected volume to be gone; got %v", err)
+	}
+	if objects := c.list(); len(objects) != 0 {
+		t.Errorf("Expected no volumes; got %d", len(objects))
+	}
+	_ = c.indexer.Delete(tvol("8"))
+	c.observeDelete(cache.DeletedFinalStateUnknown{Key: "trident/vol1", Obj: tvol("8")})
+	if len(c.writes) != 0 {
+		t.Error("Write should have been forgotten once the informer saw the deletion")
+	}
+
+	// A new object is visible before the informer sees it
+	c.write(tvol("10"))
+	if v := version(); v != "10" {
+		t.Errorf("Expected new volume; got %s", v)
+	}
+}
+
+func TestCRDRequestInfo(t *testing.T) {
+	tests := []struct {
+		method, url, resource, verb string
+	}{
+		{http.MethodGet, "/apis/trident.netapp.io/v1/namespaces/trident/tridentvolumes/pvc-1", "tridentvolumes", "get"},
+		{http.MethodGet, "/apis/trident.netapp.io/v1/namespaces/trident/tridentvolumes", "tridentvolumes", "list"},
+		{http.MethodGet, "/apis/trident.netapp.io/v1/namespaces/trident/tridentnodes?watch=true", "tridentnodes", "watch"},
+		{http.MethodGet, "/apis/trident.netapp.io/v1/tridentbackends", "tridentbackends", "list"},
+		{http.MethodPost, "/apis/trident.netapp.io/v1/namespaces/trident/tridentsnapshots", "tridentsnapshots", "create"},
+		{http.MethodPut, "/apis/trident.netapp.io/v1/namespaces/trident/tridentversions/trident", "tridentversions",
+			"update"},
+		{http.MethodDelete, "/apis/trident.netapp.io/v1/namespaces/trident/tridentvolumes/pvc-1", "tridentvolumes",
+			"delete"},
+		{http.MethodDelete, "/apis/trident.netapp.io/v1/namespaces/trident/tridentvolumes", "tridentvolumes",
+			"deletecollection"},
+		{http.MethodGet, "/version", "unknown", "list"},
+	}
+
+	for _, test := range tests {
+		req, err := http.NewRequest(test.method, "https://kubernetes"+test.url, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resource, verb := crdRequestInfo(req); resource != test.resource || verb != test.verb {
+			t.Errorf("%s %s: expected %s %s; got %s %s", test.method, test.url, test.resource, test.verb,
+				resource, verb)
+		}
+	}
+}
@@ -1216,3 +1216,156 @@ func TestBackend_RemoveFinalizers(t *testing.T) {
 	}
 }
 */
+
+func TestKubernetesGroupSnapshot(t *testing.T) {
+	p, _ := GetTestKubernetesClient()
+
+	// Adding a group snapshot
+	groupSnap1Config := &storage.GroupSnapshotConfig{
+		Version:      "1",
+		Name:         "groupsnapshot-1234",
+		InternalName: "groupsnapshot-1234",
+		VolumeNames:  []string{"vol1", "vol2"},
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+	snapshotIDs := []string{
+		storage.MakeSnapshotID("vol1", "groupsnapshot-1234"),
+		storage.MakeSnapshotID("vol2", "groupsnapshot-1234"),
+	}
+	groupSnap1 := storage.NewGroupSnapshot(groupSnap1Config, snapshotIDs, now)
+	if err := p.AddGroupSnapshot(ctx(), groupSnap1); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// Test idempotent replacement
+	if err := p.AddGroupSnapshot(ctx(), groupSnap1); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// Getting a group snapshot
+	recovered, err := p.GetGroupSnapshot(ctx(), groupSnap1.ID())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !reflect.DeepEqual(recovered.ConstructExternal(), groupSnap1.ConstructExternal()) {
+		t.Error("Recovered group snapshot does not match!")
+	}
+
+	// Listing group snapshots
+	groupSnapshots, err := p.GetGroupSnapshots(ctx())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(groupSnapshots) != 1 || groupSnapshots[0].ID() != groupSnap1.ID() {
+		t.Errorf("Expected one group snapshot, got %v", groupSnapshots)
+	}
+
+	// Deleting a group snapshot
+	if err = p.DeleteGroupSnapshot(ctx(), groupSnap1); err != nil {
+		t.Error(err.Error())
+	}
+
+	tgsnap, err := p.crdClient.TridentV1().TridentGroupSnapshots(p.namespace).Get(ctx(), groupSnap1.ID(), getOpts)
+	if err != nil || tgsnap == nil || !tgsnap.HasTridentFinalizers() || tgsnap.DeletionTimestamp.IsZero() {
+		t.Fatalf("Group snapshot should have been updated; %v", err)
+	}
+
+	// Deleted group snapshots are not listed
+	groupSnapshots, err = p.GetGroupSnapshots(ctx())
+	if err != nil || len(groupSnapshots) != 0 {
+		t.Errorf("Expected no group snapshots, got %v; %v", groupSnapshots, err)
+	}
+
+	// Remove finalizers to ensure group snapshot is deleted
+	tgsnap.RemoveTridentFinalizers()
+	_, _ = p.crdClient.TridentV1().TridentGroupSnapshots(p.namespace).Update(ctx(), tgsnap, updateOpts)
+
+	_, err = p.GetGroupSnapshot(ctx(), groupSnap1.ID())
+	if !errors.IsNotFound(err) {
+		t.Fatalf("Group snapshot should have been deleted; %v", err)
+	}
+
+	// Deleting a non-existent group snapshot
+	if err = p.DeleteGroupSnapshot(ctx(), groupSnap1); err != nil {
+		t.Error("DeleteGroupSnapshot should have succeeded.")
+	}
+}
+
+func TestKubernetesSnapshotPolicy(t *testing.T) {
+	p, _ := GetTestKubernetesClient()
+
+	// Adding a snapshot policy
+	policy, err := storage.NewSnapshotPolicy(&storage.SnapshotPolicyConfig{
+		Version:        "1",
+		Name:           "ns1_hourly",
+		Schedule:       "@hourly",
+		RetentionCount: 24,
+		StorageClasses: []string{"gold"},
+	}, time.Now())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if err = p.AddSnapshotPolicy(ctx(), policy); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// Updating a snapshot policy's status
+	policy.Volumes["vol1"] = &storage.SnapshotPolicyVolumeStatus{
+		LastRunTime:       time.Now().UTC().Format(time.RFC3339),
+		LastSnapshotName:  "ns1_hourly-20241016T130000Z",
+		RetainedSnapshots: 1,
+	}
+	policy.ScheduleNextRun(time.Now().Add(time.Hour))
+	if err = p.UpdateSnapshotPolicy(ctx(), policy); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	// Getting a snapshot policy
+	recovered, err := p.GetSnapshotPolicy(ctx(), policy.ID())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if !reflect.DeepEqual(recovered, policy.ConstructPersistent()) {
+		t.Error("Recovered snapshot policy does not match!")
+	}
+
+	// Listing snapshot policies
+	policies, err := p.GetSnapshotPolicies(ctx())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(policies) != 1 || policies[0].ID() != policy.ID() {
+		t.Errorf("Expected one snapshot policy, got %v", policies)
+	}
+
+	// Deleting a snapshot policy
+	if err = p.DeleteSnapshotPolicy(ctx(), policy); err != nil {
+		t.Error(err.Error())
+	}
+
+	tspstate, err := p.crdClient.TridentV1().TridentSnapshotPolicyStates(p.namespace).Get(ctx(), "ns1-hourly",
+		getOpts)
+	if err != nil || tspstate == nil || !tspstate.HasTridentFinalizers() || tspstate.DeletionTimestamp.IsZero() {
+		t.Fatalf("Snapshot policy should have been updated; %v", err)
+	}
+
+	// Deleted snapshot policies are not listed
+	policies, err = p.GetSnapshotPolicies(ctx())
+	if err != nil || len(policies) != 0 {
+		t.Errorf("Expected no snapshot policies, got %v; %v", policies, err)
+	}
+
+	// Remove finalizers to ensure snapshot policy is deleted
+	tspstate.RemoveTridentFinalizers()
+	_, _ = p.crdClient.TridentV1().TridentSnapshotPolicyStates(p.namespace).Update(ctx(), tspstate, updateOpts)
+
+	_, err = p.GetSnapshotPolicy(ctx(), policy.ID())
+	if !errors.IsNotFound(err) {
+		t.Fatalf("Snapshot policy should have been deleted; %v", err)
+	}
+
+	// Deleting a non-existent snapshot policy
+	if err = p.DeleteSnapshotPolicy(ctx(), policy); err != nil {
+		t.Error("DeleteSnapshotPolicy should have succeeded.")
+	}
+}
@@ -1,11 +1,67 @@
-// Copyright 2021 NetApp, Inc. All Rights Reserved.
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
 
 package persistentstore
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/netapp/trident/config"
 	. "github.com/netapp/trident/logging"
+	"github.com/netapp/trident/storage"
+	sc "github.com/netapp/trident/storage_class"
+	"github.com/netapp/trident/utils"
 )
 
+// MigrationResult says what happened to one object during a migration between persistent stores.
+type MigrationResult string
+
+const (
+	MigrationCopied    = MigrationResult("copied")    // Copied, and read back unchanged from the destination
+	MigrationPlanned   = MigrationResult("planned")   // Would be copied, but this is a dry run
+	MigrationUnchanged = MigrationResult("unchanged") // Already in the destination, identical to the source
+	MigrationConflict  = MigrationResult("conflict")  // Already in the destination, but different, so not copied
+	MigrationFailed    = MigrationResult("failed")    // Could not be copied, or read back differently
+)
+
+// MigratedObject reports the migration of one object.  Diff lists the top-level fields that differ between
+// the source and destination stores.
+type MigratedObject struct {
+	Kind   string          `json:"kind"`
+	Name   string          `json:"name"`
+	Result MigrationResult `json:"result"`
+	Diff   []string        `json:"diff,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// MigrationReport lists every object found in the source store, in migration order, with its result.
+type MigrationReport struct {
+	Source      StoreType         `json:"source"`
+	Destination StoreType         `json:"destination"`
+	DryRun      bool              `json:"dryRun"`
+	Objects     []*MigratedObject `json:"objects"`
+}
+
+// Succeeded reports whether every object is now identical in both stores, or would be after a dry run.
+func (r *MigrationReport) Succeeded() bool {
+	for _, object := range r.Objects {
+		if object.Result == MigrationConflict || object.Result == MigrationFailed {
+			return false
+		}
+	}
+	return true
+}
+
+// BackendPersistentWriter is implemented by stores that can accept a backend in its persistent form, without
+// a live driver, which is all a migration source can offer.
+type BackendPersistentWriter interface {
+	AddBackendPersistent(ctx context.Context, backend *storage.BackendPersistent) error
+}
+
 type DataMigrator struct {
 	SourceClient Client
 	DestClient   Client
@@ -20,9 +76,298 @@ func NewDataMigrator(SourceClient, DestClient Client, dryRun bool) *DataMigrator
 	}
 }
 
-func (m *DataMigrator) Run() error {
-	// Determine if this is a supported data migration.
-	Log().Debug("DataMigrator does not currently support any migrations.")
+// NewMigrationClient opens a store of the specified type as a migration source or destination.  The location
+// is the backend config file or directory of a passthrough store, the file of a bolt store, or the kubeconfig
+// path of a CRD store, where empty means the default or in-cluster config.  The backends of a passthrough
+// store are initialized, since its volumes are read from them.
+func NewMigrationClient(ctx context.Context, storeType StoreType, location string) (Client, error) {
+	switch storeType {
+	case PassthroughStore:
+		client, err := NewPassthroughClient(location)
+		if err != nil {
+			return nil, err
+		}
+		if err = client.InitializeBackends(ctx); err != nil {
+			return nil, fmt.Errorf("could not initialize the backends of the passthrough store; %v", err)
+		}
+		return client, nil
+	case CRDV1Store:
+		return NewCRDClientV1("", location)
+	case BoltStore:
+		return NewBoltClient(location)
+	default:
+		return nil, fmt.Errorf("unsupported store type %s; must be one of %s, %s or %s", storeType,
+			PassthroughStore, CRDV1Store, BoltStore)
+	}
+}
+
+// Run copies storage classes, backends, volumes, snapshots, group snapshots, nodes and volume publications
+// from the source store to the destination store, then reads each copied object back to verify it.  Objects
+// already in the destination are never overwritten.  Volume transactions are not migrated, so the source
+// store must have none; starting Trident on the source store resolves them.
+func (m *DataMigrator) Run(ctx context.Context) (*MigrationReport, error) {
+	report := &MigrationReport{
+		Source:      m.SourceClient.GetType(),
+		Destination: m.DestClient.GetType(),
+		DryRun:      m.dryRun,
+		Objects:     make([]*MigratedObject, 0),
+	}
+
+	if _, ok := m.DestClient.(BackendPersistentWriter); !ok {
+		return nil, fmt.Errorf("the %s store cannot be a migration destination", report.Destination)
+	}
+
+	txns, err := m.SourceClient.GetVolumeTransactions(ctx)
+	if err != nil && !MatchKeyNotFoundErr(err) {
+		return nil, fmt.Errorf("could not read volume transactions from the source store; %v", err)
+	} else if len(txns) > 0 {
+		return nil, fmt.Errorf("the source store has %d pending volume transactions; start Trident on the "+
+			"source store to resolve them before migrating", len(txns))
+	}
+
+	Logc(ctx).WithFields(LogFields{
+		"source":      report.Source,
+		"destination": report.Destination,
+		"dryRun":      m.dryRun,
+	}).Info("Migrating persistent store.")
+
+	for _, migrate := range []func(context.Context, *MigrationReport) error{
+		m.migrateStorageClasses,
+		m.migrateBackends,
+		m.migrateVolumes,
+		m.migrateSnapshots,
+		m.migrateGroupSnapshots,
+		m.migrateSnapshotPolicies,
+		m.migrateNodes,
+		m.migrateVolumePublications,
+	} {
+		if err = migrate(ctx, report); err != nil {
+			return report, err
+		}
+	}
+
+	if !m.dryRun {
+		version := &config.PersistentStateVersion{
+			PersistentStoreVersion: string(report.Destination),
+			OrchestratorAPIVersion: config.OrchestratorAPIVersion,
+		}
+		if sourceVersion, err := m.SourceClient.GetVersion(ctx); err == nil {
+			version.PublicationsSynced = sourceVersion.PublicationsSynced
+		}
+		if err = m.DestClient.SetVersion(ctx, version); err != nil {
+			return report, fmt.Errorf("could not set the persistent state version of the destination store; %v",
+				err)
+		}
+	}
+
+	Logc(ctx).WithFields(LogFields{
+		"objects":   len(report.Objects),
+		"succeeded": report.Succeeded(),
+	}).Info("Migrated persistent store.")
+
+	return report, nil
+}
+
+func (m *DataMigrator) migrateStorageClasses(ctx context.Context, report *MigrationReport) error {
+	return migrateObjects(ctx, m, report, "storage class",
+		func(s *sc.Persistent) string { return s.GetName() },
+		func(c Client) ([]*sc.Persistent, error) { return c.GetStorageClasses(ctx) },
+		func(s *sc.Persistent) error { return m.DestClient.AddStorageClass(ctx, sc.NewFromPersistent(s)) },
+	)
+}
+
+func (m *DataMigrator) migrateBackends(ctx context.Context, report *MigrationReport) error {
+	writer := m.DestClient.(BackendPersistentWriter)
+	return migrateObjects(ctx, m, report, "backend",
+		func(b *storage.BackendPersistent) string { return b.Name },
+		func(c Client) ([]*storage.BackendPersistent, error) { return c.GetBackends(ctx) },
+		func(b *storage.BackendPersistent) error { return writer.AddBackendPersistent(ctx, b) },
+	)
+}
+
+func (m *DataMigrator) migrateVolumes(ctx context.Context, report *MigrationReport) error {
+	return migrateObjects(ctx, m, report, "volume",
+		func(v *storage.VolumeExternal) string { return v.Config.Name },
+		func(c Client) ([]*storage.VolumeExternal, error) { return c.GetVolumes(ctx) },
+		func(v *storage.VolumeExternal) error {
+			return m.DestClient.AddVolume(ctx, storage.NewVolume(v.Config, v.BackendUUID, v.Pool, v.Orphaned,
+				v.State))
+		},
+	)
+}
+
+func (m *DataMigrator) migrateSnapshots(ctx context.Context, report *MigrationReport) error {
+	return migrateObjects(ctx, m, report, "snapshot",
+		func(s *storage.SnapshotPersistent) string { return s.ID() },
+		func(c Client) ([]*storage.SnapshotPersistent, error) { return c.GetSnapshots(ctx) },
+		func(s *storage.SnapshotPersistent) error { return m.DestClient.AddSnapshot(ctx, &s.Snapshot) },
+	)
+}
 
-	return nil // crdDataMigrator.Run()
+func (m *DataMigrator) migrateGroupSnapshots(ctx context.Context, report *MigrationReport) error {
+	return migrateObjects(ctx, m, report, "group snapshot",
+		func(s *storage.GroupSnapshotPersistent) string { return s.ID() },
+		func(c Client) ([]*storage.GroupSnapshotPersistent, error) { return c.GetGroupSnapshots(ctx) },
+		func(s *storage.GroupSnapshotPersistent) error {
+			return m.DestClient.AddGroupSnapshot(ctx, &s.GroupSnapshot)
+		},
+	)
+}
+
+func (m *DataMigrator) migrateSnapshotPolicies(ctx context.Context, report *MigrationReport) error {
+	return migrateObjects(ctx, m, report, "snapshot policy",
+		func(p *storage.SnapshotPolicyPersistent) string { return p.ID() },
+		func(c Client) ([]*storage.SnapshotPolicyPersistent, error) { return c.GetSnapshotPolicies(ctx) },
+		func(p *storage.SnapshotPolicyPersistent) error {
+			policy, err := p.ConstructSnapshotPolicy(time.Now())
+			if err != nil {
+				return err
+			}
+			return m.DestClient.AddSnapshotPolicy(ctx, policy)
+		},
+	)
+}
+
+func (m *DataMigrator) migrateNodes(ctx context.Context, report *MigrationReport) error {
+	return migrateObjects(ctx, m, report, "node",
+		func(n *utils.Node) string { return n.Name },
+		func(c Client) ([]*utils.Node, error) { return c.GetNodes(ctx) },
+		func(n *utils.Node) error { return m.DestClient.AddOrUpdateNode(ctx, n) },
+	)
+}
+
+func (m *DataMigrator) migrateVolumePublications(ctx context.Context, report *MigrationReport) error {
+	return migrateObjects(ctx, m, report, "volume publication",
+		func(p *utils.VolumePublication) string { return p.Name },
+		func(c Client) ([]*utils.VolumePublication, error) { return c.GetVolumePublications(ctx) },
+		func(p *utils.VolumePublication) error { return m.DestClient.AddVolumePublication(ctx, p) },
+	)
+}
+
+// migrateObjects copies the objects of one kind that are missing from the destination store, then lists the
+// destination again to verify them.  Errors reading either store end the migration; errors writing an object
+// are recorded in the report.
+func migrateObjects[T any](
+	ctx context.Context, m *DataMigrator, report *MigrationReport, kind string, name func(*T) string,
+	list func(Client) ([]*T, error), add func(*T) error,
+) error {
+	sourceObjects, err := list(m.SourceClient)
+	if err != nil && !MatchKeyNotFoundErr(err) {
+		return fmt.Errorf("could not read %ss from the source store; %v", kind, err)
+	}
+	destObjects, err := listObjectsByName(m.DestClient, name, list)
+	if err != nil {
+		return fmt.Errorf("could not read %ss from the destination store; %v", kind, err)
+	}
+
+	copied := make(map[string]*MigratedObject)
+	sourceByName := make(map[string]*T)
+
+	for _, object := range sourceObjects {
+		objectName := name(object)
+		migrated := &MigratedObject{Kind: kind, Name: objectName}
+		report.Objects = append(report.Objects, migrated)
+
+		if existing, ok := destObjects[objectName]; ok {
+			if migrated.Diff = diffObjects(object, existing); len(migrated.Diff) == 0 {
+				migrated.Result = MigrationUnchanged
+			} else {
+				migrated.Result = MigrationConflict
+			}
+			continue
+		}
+
+		if m.dryRun {
+			migrated.Result = MigrationPlanned
+			continue
+		}
+
+		if err = add(object); err != nil {
+			migrated.Result = MigrationFailed
+			migrated.Error = err.Error()
+			Logc(ctx).WithFields(LogFields{
+				"kind":  kind,
+				"name":  objectName,
+				"error": err,
+			}).Error("Could not migrate object.")
+			continue
+		}
+		copied[objectName] = migrated
+		sourceByName[objectName] = object
+	}
+
+	if len(copied) == 0 {
+		return nil
+	}
+
+	destObjects, err = listObjectsByName(m.DestClient, name, list)
+	if err != nil {
+		return fmt.Errorf("could not read %ss from the destination store; %v", kind, err)
+	}
+	for objectName, migrated := range copied {
+		existing, ok := destObjects[objectName]
+		if !ok {
+			migrated.Result = MigrationFailed
+			migrated.Error = "not found in the destination store after copying"
+		} else if migrated.Diff = diffObjects(sourceByName[objectName], existing); len(migrated.Diff) > 0 {
+			migrated.Result = MigrationFailed
+			migrated.Error = "read back from the destination store with different values"
+		} else {
+			migrated.Result = MigrationCopied
+		}
+	}
+
+	return nil
+}
+
+func listObjectsByName[T any](c Client, name func(*T) string, list func(Client) ([]*T, error)) (
+	map[string]*T, error,
+) {
+	objects, err := list(c)
+	if err != nil && !MatchKeyNotFoundErr(err) {
+		return nil, err
+	}
+	objectsByName := make(map[string]*T, len(objects))
+	for _, object := range objects {
+		objectsByName[name(object)] = object
+	}
+	return objectsByName, nil
+}
+
+// diffObjects returns the sorted names of the top-level JSON fields that differ between two objects.
+func diffObjects(a, b interface{}) []string {
+	aFields, aErr := jsonFields(a)
+	bFields, bErr := jsonFields(b)
+	if aErr != nil || bErr != nil {
+		if reflect.DeepEqual(a, b) {
+			return nil
+		}
+		return []string{"*"}
+	}
+
+	diff := make([]string, 0)
+	for field, aValue := range aFields {
+		if bValue, ok := bFields[field]; !ok || !reflect.DeepEqual(aValue, bValue) {
+			diff = append(diff, field)
+		}
+	}
+	for field := range bFields {
+		if _, ok := aFields[field]; !ok {
+			diff = append(diff, field)
+		}
+	}
+	sort.Strings(diff)
+	return diff
+}
+
+func jsonFields(object interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+	fields := make(map[string]interface{})
+	if err = json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
 }
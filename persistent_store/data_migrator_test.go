@@ -0,0 +1,151 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package persistentstore
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/netapp/trident/config"
+	"github.com/netapp/trident/storage"
+	sc "github.com/netapp/trident/storage_class"
+	"github.com/netapp/trident/utils"
+)
+
+func getTestMigrationSource(t *testing.T) *InMemoryClient {
+	source := NewInMemoryClient()
+
+	backend := getFakeBackendWithName("fake1")
+	backend.SetBackendUUID(uuid.NewString())
+	volume := storage.NewVolume(&storage.VolumeConfig{
+		Version: config.OrchestratorAPIVersion, Name: "vol1", Size: "1GB", Protocol: config.File,
+	}, backend.BackendUUID(), storagePool, false, storage.VolumeStateOnline)
+	snapshot := storage.NewSnapshot(&storage.SnapshotConfig{
+		Version: config.OrchestratorAPIVersion, Name: "snap1", VolumeName: "vol1",
+	}, "2024-01-01T00:00:00Z", 1024, storage.SnapshotStateOnline)
+	policy, err := storage.NewSnapshotPolicy(&storage.SnapshotPolicyConfig{
+		Version: config.OrchestratorAPIVersion, Name: "hourly", Schedule: "@hourly", RetentionCount: 24,
+		VolumeNames: []string{"vol1"},
+	}, time.Date(2024, 1, 1, 0, 30, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Unable to create snapshot policy: %v", err)
+	}
+	policy.Volumes["vol1"] = &storage.SnapshotPolicyVolumeStatus{LastSnapshotName: "hourly-20240101T000000Z"}
+
+	for _, err := range []error{
+		source.AddStorageClass(ctx(), sc.New(&sc.Config{Name: "gold"})),
+		source.AddBackend(ctx(), backend),
+		source.AddVolume(ctx(), volume),
+		source.AddSnapshot(ctx(), snapshot),
+		source.AddSnapshotPolicy(ctx(), policy),
+		source.AddOrUpdateNode(ctx(), &utils.Node{Name: "node1"}),
+		source.AddVolumePublication(ctx(), &utils.VolumePublication{
+			Name: "vol1.node1", NodeName: "node1", VolumeName: "vol1",
+		}),
+	} {
+		if err != nil {
+			t.Fatalf("Unable to populate source store: %v", err)
+		}
+	}
+	return source
+}
+
+func migrationResults(report *MigrationReport) map[string]MigrationResult {
+	results := make(map[string]MigrationResult)
+	for _, object := range report.Objects {
+		results[object.Kind+"/"+object.Name] = object.Result
+	}
+	return results
+}
+
+func TestDataMigrator_Run(t *testing.T) {
+	source := getTestMigrationSource(t)
+	dest, _ := getTestBoltClient(t)
+
+	// A dry run changes nothing
+	report, err := NewDataMigrator(source, dest, true).Run(ctx())
+	if err != nil {
+		t.Fatalf("Dry run failed: %v", err)
+	}
+	if len(report.Objects) != 7 || !report.Succeeded() {
+		t.Fatalf("Unexpected dry run report: %v", migrationResults(report))
+	}
+	for name, result := range migrationResults(report) {
+		if result != MigrationPlanned {
+			t.Errorf("Expected %s to be planned; got %s", name, result)
+		}
+	}
+	if volumes, _ := dest.GetVolumes(ctx()); len(volumes) != 0 {
+		t.Errorf("Dry run copied %d volumes", len(volumes))
+	}
+
+	report, err = NewDataMigrator(source, dest, false).Run(ctx())
+	if err != nil {
+		t.Fatalf("Migration failed: %v", err)
+	}
+	expected := map[string]MigrationResult{
+		"storage class/gold":            MigrationCopied,
+		"backend/fake1":                 MigrationCopied,
+		"volume/vol1":                   MigrationCopied,
+		"snapshot/vol1/snap1":           MigrationCopied,
+		"snapshot policy/hourly":        MigrationCopied,
+		"node/node1":                    MigrationCopied,
+		"volume publication/vol1.node1": MigrationCopied,
+	}
+	if results := migrationResults(report); !reflect.DeepEqual(expected, results) {
+		t.Errorf("Unexpected migration results; expected %v, got %v", expected, results)
+	}
+	if version, err := dest.GetVersion(ctx()); err != nil || version.PersistentStoreVersion != string(BoltStore) {
+		t.Errorf("Destination version was not set; got %v, %v", version, err)
+	}
+
+	// Migrating again finds everything in place, except a volume changed since
+	volume := storage.NewVolume(&storage.VolumeConfig{
+		Version: config.OrchestratorAPIVersion, Name: "vol1", Size: "2GB", Protocol: config.File,
+	}, "other-uuid", storagePool, false, storage.VolumeStateOnline)
+	if err = source.UpdateVolume(ctx(), volume); err != nil {
+		t.Fatalf("Unable to update source volume: %v", err)
+	}
+	report, err = NewDataMigrator(source, dest, false).Run(ctx())
+	if err != nil {
+		t.Fatalf("Migration failed: %v", err)
+	}
+	if report.Succeeded() {
+		t.Error("Migration with a conflict should not have succeeded")
+	}
+	for _, object := range report.Objects {
+		if object.Kind == "volume" {
+			if object.Result != MigrationConflict || !reflect.DeepEqual([]string{"Config", "backendUUID"}, object.Diff) {
+				t.Errorf("Unexpected volume result %s with diff %v", object.Result, object.Diff)
+			}
+		} else if object.Result != MigrationUnchanged {
+			t.Errorf("Expected %s %s to be unchanged; got %s", object.Kind, object.Name, object.Result)
+		}
+	}
+}
+
+func TestDataMigrator_RunRejected(t *testing.T) {
+	source := getTestMigrationSource(t)
+
+	if _, err := NewDataMigrator(source, newPassthroughClient(), false).Run(ctx()); err == nil {
+		t.Error("Migration to a passthrough store should have failed")
+	}
+
+	dest, _ := getTestBoltClient(t)
+	txn := &storage.VolumeTransaction{
+		Config: &storage.VolumeConfig{Version: config.OrchestratorAPIVersion, Name: "vol2"},
+		Op:     storage.AddVolume,
+	}
+	if err := source.AddVolumeTransaction(ctx(), txn); err != nil {
+		t.Fatalf("Unable to add volume transaction: %v", err)
+	}
+	if _, err := NewDataMigrator(source, dest, false).Run(ctx()); err == nil {
+		t.Error("Migration with pending volume transactions should have failed")
+	}
+	if backends, _ := dest.GetBackends(ctx()); len(backends) != 0 {
+		t.Errorf("Rejected migration copied %d backends", len(backends))
+	}
+}
@@ -30,6 +30,10 @@ type InMemoryClient struct {
 	nodesAdded              int
 	snapshots               map[string]*storage.SnapshotPersistent
 	snapshotsAdded          int
+	groupSnapshots          map[string]*storage.GroupSnapshotPersistent
+	groupSnapshotsAdded     int
+	snapshotPolicies        map[string]*storage.SnapshotPolicyPersistent
+	snapshotPoliciesAdded   int
 	uuid                    string
 }
 
@@ -42,6 +46,8 @@ func NewInMemoryClient() *InMemoryClient {
 		volumePublications: make(map[string]*utils.VolumePublication),
 		nodes:              make(map[string]*utils.Node),
 		snapshots:          make(map[string]*storage.SnapshotPersistent),
+		groupSnapshots:     make(map[string]*storage.GroupSnapshotPersistent),
+		snapshotPolicies:   make(map[string]*storage.SnapshotPolicyPersistent),
 		version: &config.PersistentStateVersion{
 			PersistentStoreVersion: "memory", OrchestratorAPIVersion: config.OrchestratorAPIVersion,
 		},
@@ -60,6 +66,8 @@ func (c *InMemoryClient) Stop() error {
 	c.volumeTxnsAdded = 0
 	c.nodesAdded = 0
 	c.snapshotsAdded = 0
+	c.groupSnapshotsAdded = 0
+	c.snapshotPoliciesAdded = 0
 	return nil
 }
 
@@ -80,7 +88,10 @@ func (c *InMemoryClient) SetVersion(context.Context, *config.PersistentStateVers
 }
 
 func (c *InMemoryClient) AddBackend(ctx context.Context, b storage.Backend) error {
-	backend := b.ConstructPersistent(ctx)
+	return c.AddBackendPersistent(ctx, b.ConstructPersistent(ctx))
+}
+
+func (c *InMemoryClient) AddBackendPersistent(_ context.Context, backend *storage.BackendPersistent) error {
 	if _, ok := c.backends[backend.Name]; ok {
 		return fmt.Errorf("backend %s already exists", backend.Name)
 	}
@@ -405,3 +416,87 @@ func (c *InMemoryClient) DeleteSnapshots(context.Context) error {
 	c.snapshots = make(map[string]*storage.SnapshotPersistent)
 	return nil
 }
+
+func (c *InMemoryClient) AddGroupSnapshot(_ context.Context, groupSnapshot *storage.GroupSnapshot) error {
+	c.groupSnapshots[groupSnapshot.ID()] = groupSnapshot.ConstructPersistent()
+	c.groupSnapshotsAdded++
+	return nil
+}
+
+// GetGroupSnapshot retrieves a group snapshot state from the persistent store
+func (c *InMemoryClient) GetGroupSnapshot(_ context.Context, groupSnapshotName string) (
+	*storage.GroupSnapshotPersistent, error,
+) {
+	ret, ok := c.groupSnapshots[groupSnapshotName]
+	if !ok {
+		return nil, NewPersistentStoreError(KeyNotFoundErr, groupSnapshotName)
+	}
+	return ret, nil
+}
+
+// GetGroupSnapshots retrieves all group snapshots
+func (c *InMemoryClient) GetGroupSnapshots(context.Context) ([]*storage.GroupSnapshotPersistent, error) {
+	ret := make([]*storage.GroupSnapshotPersistent, 0, len(c.groupSnapshots))
+	if c.groupSnapshotsAdded == 0 {
+		// Try to match etcd semantics as closely as possible.
+		return ret, nil
+	}
+	for _, s := range c.groupSnapshots {
+		ret = append(ret, s)
+	}
+	return ret, nil
+}
+
+// DeleteGroupSnapshot deletes a group snapshot from the persistent store
+func (c *InMemoryClient) DeleteGroupSnapshot(_ context.Context, groupSnapshot *storage.GroupSnapshot) error {
+	delete(c.groupSnapshots, groupSnapshot.ID())
+	return nil
+}
+
+func (c *InMemoryClient) AddSnapshotPolicy(_ context.Context, policy *storage.SnapshotPolicy) error {
+	if _, ok := c.snapshotPolicies[policy.ID()]; ok {
+		return NewAlreadyExistsError("snapshot policy", policy.ID())
+	}
+	c.snapshotPolicies[policy.ID()] = policy.ConstructPersistent()
+	c.snapshotPoliciesAdded++
+	return nil
+}
+
+// GetSnapshotPolicy retrieves a snapshot policy from the persistent store
+func (c *InMemoryClient) GetSnapshotPolicy(_ context.Context, policyName string) (
+	*storage.SnapshotPolicyPersistent, error,
+) {
+	ret, ok := c.snapshotPolicies[policyName]
+	if !ok {
+		return nil, NewPersistentStoreError(KeyNotFoundErr, policyName)
+	}
+	return ret, nil
+}
+
+// GetSnapshotPolicies retrieves all snapshot policies
+func (c *InMemoryClient) GetSnapshotPolicies(context.Context) ([]*storage.SnapshotPolicyPersistent, error) {
+	ret := make([]*storage.SnapshotPolicyPersistent, 0, len(c.snapshotPolicies))
+	if c.snapshotPoliciesAdded == 0 {
+		// Try to match etcd semantics as closely as possible.
+		return ret, nil
+	}
+	for _, p := range c.snapshotPolicies {
+		ret = append(ret, p)
+	}
+	return ret, nil
+}
+
+func (c *InMemoryClient) UpdateSnapshotPolicy(_ context.Context, policy *storage.SnapshotPolicy) error {
+	// UpdateSnapshotPolicy requires the policy to already exist.
+	if _, ok := c.snapshotPolicies[policy.ID()]; !ok {
+		return NewPersistentStoreError(KeyNotFoundErr, policy.ID())
+	}
+	c.snapshotPolicies[policy.ID()] = policy.ConstructPersistent()
+	return nil
+}
+
+// DeleteSnapshotPolicy deletes a snapshot policy from the persistent store
+func (c *InMemoryClient) DeleteSnapshotPolicy(_ context.Context, policy *storage.SnapshotPolicy) error {
+	delete(c.snapshotPolicies, policy.ID())
+	return nil
+}
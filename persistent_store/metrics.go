@@ -0,0 +1,37 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package persistentstore
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/netapp/trident/config"
+)
+
+var (
+	persistentStoreAPICallsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: config.OrchestratorName,
+			Name:      "persistent_store_api_calls_total",
+			Help:      "The total number of Kubernetes API calls made by the persistent store",
+		},
+		[]string{"resource", "verb"},
+	)
+	persistentStoreCacheReadsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: config.OrchestratorName,
+			Name:      "persistent_store_cache_reads_total",
+			Help:      "The total number of persistent store reads served from the informer cache",
+		},
+		[]string{"resource", "verb"},
+	)
+	persistentStoreCacheConflictsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: config.OrchestratorName,
+			Name:      "persistent_store_cache_conflicts_total",
+			Help:      "The total number of persistent store updates retried because the cached object was stale",
+		},
+		[]string{"resource"},
+	)
+)
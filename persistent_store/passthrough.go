@@ -17,6 +17,7 @@ import (
 	"github.com/netapp/trident/config"
 	. "github.com/netapp/trident/logging"
 	"github.com/netapp/trident/storage"
+	"github.com/netapp/trident/storage/factory"
 	sc "github.com/netapp/trident/storage_class"
 	drivers "github.com/netapp/trident/storage_drivers"
 	"github.com/netapp/trident/utils"
@@ -278,6 +279,36 @@ func (c *PassthroughClient) GetBackends(context.Context) ([]*storage.BackendPers
 	return backendList, nil
 }
 
+// InitializeBackends creates a live backend from each config file, as the
+// orchestrator does while bootstrapping, so that the store may be read without
+// an orchestrator, as when migrating to another store.  The boot backends are
+// replaced by the persistent form of the live ones, which have the names and
+// UUIDs that their volumes refer to.
+func (c *PassthroughClient) InitializeBackends(ctx context.Context) error {
+	for i, bootBackend := range c.bootBackends {
+		configJSON, err := bootBackend.MarshalConfig()
+		if err != nil {
+			return err
+		}
+
+		commonConfig, configJSON, err := factory.ValidateCommonSettings(ctx, configJSON)
+		if err != nil {
+			return err
+		}
+
+		backend, err := factory.NewStorageBackendForConfig(ctx, configJSON, "", uuid.NewString(), commonConfig, nil)
+		if err != nil {
+			return err
+		}
+
+		if err = c.AddBackend(ctx, backend); err != nil {
+			return err
+		}
+		c.bootBackends[i] = backend.ConstructPersistent(ctx)
+	}
+	return nil
+}
+
 func (c *PassthroughClient) DeleteBackends(context.Context) error {
 	c.liveBackends = make(map[string]storage.Backend)
 	return nil
@@ -461,3 +492,46 @@ func (c *PassthroughClient) DeleteSnapshot(context.Context, *storage.Snapshot) e
 func (c *PassthroughClient) DeleteSnapshots(context.Context) error {
 	return nil
 }
+
+func (c *PassthroughClient) AddGroupSnapshot(context.Context, *storage.GroupSnapshot) error {
+	return nil
+}
+
+func (c *PassthroughClient) GetGroupSnapshot(
+	_ context.Context, groupSnapshotName string,
+) (*storage.GroupSnapshotPersistent, error) {
+	return nil, NewPersistentStoreError(KeyNotFoundErr, groupSnapshotName)
+}
+
+// GetGroupSnapshots retrieves all group snapshots
+func (c *PassthroughClient) GetGroupSnapshots(context.Context) ([]*storage.GroupSnapshotPersistent, error) {
+	return make([]*storage.GroupSnapshotPersistent, 0), nil
+}
+
+func (c *PassthroughClient) DeleteGroupSnapshot(context.Context, *storage.GroupSnapshot) error {
+	return nil
+}
+
+// AddSnapshotPolicy stores nothing, so snapshot policies last only as long as the orchestrator.
+func (c *PassthroughClient) AddSnapshotPolicy(context.Context, *storage.SnapshotPolicy) error {
+	return nil
+}
+
+func (c *PassthroughClient) GetSnapshotPolicy(
+	_ context.Context, policyName string,
+) (*storage.SnapshotPolicyPersistent, error) {
+	return nil, NewPersistentStoreError(KeyNotFoundErr, policyName)
+}
+
+// GetSnapshotPolicies retrieves all snapshot policies
+func (c *PassthroughClient) GetSnapshotPolicies(context.Context) ([]*storage.SnapshotPolicyPersistent, error) {
+	return make([]*storage.SnapshotPolicyPersistent, 0), nil
+}
+
+func (c *PassthroughClient) UpdateSnapshotPolicy(context.Context, *storage.SnapshotPolicy) error {
+	return nil
+}
+
+func (c *PassthroughClient) DeleteSnapshotPolicy(context.Context, *storage.SnapshotPolicy) error {
+	return nil
+}
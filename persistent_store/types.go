@@ -20,6 +20,7 @@ const (
 	MemoryStore      StoreType = "memory"
 	PassthroughStore StoreType = "passthrough"
 	CRDV1Store       StoreType = "crdv1"
+	BoltStore        StoreType = "bolt"
 )
 
 type ClientConfig struct {
@@ -80,6 +81,17 @@ type Client interface {
 	UpdateSnapshot(ctx context.Context, snapshot *storage.Snapshot) error
 	DeleteSnapshot(ctx context.Context, snapshot *storage.Snapshot) error
 	DeleteSnapshots(ctx context.Context) error
+
+	AddGroupSnapshot(ctx context.Context, groupSnapshot *storage.GroupSnapshot) error
+	GetGroupSnapshot(ctx context.Context, groupSnapshotName string) (*storage.GroupSnapshotPersistent, error)
+	GetGroupSnapshots(ctx context.Context) ([]*storage.GroupSnapshotPersistent, error)
+	DeleteGroupSnapshot(ctx context.Context, groupSnapshot *storage.GroupSnapshot) error
+
+	AddSnapshotPolicy(ctx context.Context, policy *storage.SnapshotPolicy) error
+	GetSnapshotPolicy(ctx context.Context, policyName string) (*storage.SnapshotPolicyPersistent, error)
+	GetSnapshotPolicies(ctx context.Context) ([]*storage.SnapshotPolicyPersistent, error)
+	UpdateSnapshotPolicy(ctx context.Context, policy *storage.SnapshotPolicy) error
+	DeleteSnapshotPolicy(ctx context.Context, policy *storage.SnapshotPolicy) error
 }
 
 type CRDClient interface {
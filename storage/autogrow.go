@@ -0,0 +1,109 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package storage
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/netapp/trident/utils"
+)
+
+// defaultAutogrowIncrement is how much a volume grows by if its policy does not say
+const defaultAutogrowIncrement = "10%"
+
+// AutogrowPolicy describes when a volume is grown, and by how much, as the space used on it is reported by the
+// nodes to which it is published.  A volume grows by its increment, either a size or a percentage of its current
+// size, whenever the percentage of its space used reaches the threshold, until it reaches the maximum size.
+type AutogrowPolicy struct {
+	ThresholdPercent int    `json:"thresholdPercent"`
+	Increment        string `json:"increment"`
+	MaxSize          string `json:"maxSize,omitempty"`
+}
+
+// NewAutogrowPolicy returns the autogrow policy described by a storage class's parameters, or nil if the
+// storage class sets no threshold, leaving autogrow disabled.
+func NewAutogrowPolicy(threshold, increment, maxSize string) (*AutogrowPolicy, error) {
+	if threshold == "" {
+		if increment != "" || maxSize != "" {
+			return nil, fmt.Errorf("an autogrow increment or maximum size requires an autogrow threshold")
+		}
+		return nil, nil
+	}
+
+	thresholdPercent, err := strconv.Atoi(strings.TrimSuffix(threshold, "%"))
+	if err != nil || thresholdPercent < 1 || thresholdPercent > 99 {
+		return nil, fmt.Errorf("autogrow threshold %s is not a percentage between 1 and 99", threshold)
+	}
+
+	if increment == "" {
+		increment = defaultAutogrowIncrement
+	}
+	policy := &AutogrowPolicy{ThresholdPercent: thresholdPercent, Increment: increment, MaxSize: maxSize}
+
+	if _, err = policy.NextSize(1); err != nil {
+		return nil, err
+	}
+	if maxSize != "" {
+		if _, err = parseAutogrowSize(maxSize); err != nil {
+			return nil, fmt.Errorf("invalid autogrow maximum size %s; %v", maxSize, err)
+		}
+	}
+	return policy, nil
+}
+
+// ThresholdReached reports whether enough of a volume's space is used that it should grow.
+func (p *AutogrowPolicy) ThresholdReached(usedBytes, totalBytes int64) bool {
+	if totalBytes <= 0 || usedBytes < 0 {
+		return false
+	}
+	return float64(usedBytes)*100 >= float64(totalBytes)*float64(p.ThresholdPercent)
+}
+
+// NextSize returns the size to which a volume of the specified size grows, which is no larger than the policy's
+// maximum size.  If the volume may grow no further, its current size is returned.
+func (p *AutogrowPolicy) NextSize(sizeBytes uint64) (uint64, error) {
+	var newSize uint64
+
+	if percent, found := strings.CutSuffix(p.Increment, "%"); found {
+		incrementPercent, err := strconv.ParseUint(percent, 10, 64)
+		if err != nil || incrementPercent < 1 || incrementPercent > 1000 {
+			return 0, fmt.Errorf("autogrow increment %s is not a percentage between 1 and 1000", p.Increment)
+		}
+		newSize = sizeBytes + (sizeBytes*incrementPercent+99)/100
+	} else {
+		increment, err := parseAutogrowSize(p.Increment)
+		if err != nil {
+			return 0, fmt.Errorf("invalid autogrow increment %s; %v", p.Increment, err)
+		}
+		newSize = sizeBytes + increment
+	}
+
+	if p.MaxSize != "" {
+		maxSize, err := parseAutogrowSize(p.MaxSize)
+		if err != nil {
+			return 0, fmt.Errorf("invalid autogrow maximum size %s; %v", p.MaxSize, err)
+		}
+		if newSize > maxSize {
+			newSize = maxSize
+		}
+		if newSize < sizeBytes {
+			newSize = sizeBytes
+		}
+	}
+	return newSize, nil
+}
+
+// parseAutogrowSize returns the number of bytes in a positive size such as "5Gi".
+func parseAutogrowSize(size string) (uint64, error) {
+	sizeString, err := utils.ConvertSizeToBytes(size)
+	if err != nil {
+		return 0, err
+	}
+	sizeBytes, err := strconv.ParseUint(sizeString, 10, 64)
+	if err != nil || sizeBytes == 0 {
+		return 0, fmt.Errorf("%s is not a positive size", size)
+	}
+	return sizeBytes, nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAutogrowPolicy(t *testing.T) {
+	tests := []struct {
+		name                          string
+		threshold, increment, maxSize string
+		want                          *AutogrowPolicy
+		wantErr                       bool
+	}{
+		{"disabled", "", "", "", nil, false},
+		{"default increment", "80", "", "", &AutogrowPolicy{ThresholdPercent: 80, Increment: "10%"}, false},
+		{
+			"percent sign", "90%", "5Gi", "100Gi",
+			&AutogrowPolicy{ThresholdPercent: 90, Increment: "5Gi", MaxSize: "100Gi"}, false,
+		},
+		{"increment without threshold", "", "5Gi", "", nil, true},
+		{"threshold too high", "100", "", "", nil, true},
+		{"threshold not a number", "most", "", "", nil, true},
+		{"bad increment", "80", "lots", "", nil, true},
+		{"zero increment", "80", "0%", "", nil, true},
+		{"negative increment", "80", "-5Gi", "", nil, true},
+		{"bad max size", "80", "", "huge", nil, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			policy, err := NewAutogrowPolicy(test.threshold, test.increment, test.maxSize)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.want, policy)
+		})
+	}
+}
+
+func TestAutogrowPolicy_ThresholdReached(t *testing.T) {
+	policy := &AutogrowPolicy{ThresholdPercent: 80, Increment: "10%"}
+
+	assert.False(t, policy.ThresholdReached(79, 100))
+	assert.True(t, policy.ThresholdReached(80, 100))
+	assert.True(t, policy.ThresholdReached(100, 100))
+	assert.False(t, policy.ThresholdReached(0, 0))
+}
+
+func TestAutogrowPolicy_NextSize(t *testing.T) {
+	const gi = uint64(1024 * 1024 * 1024)
+
+	tests := []struct {
+		name    string
+		policy  *AutogrowPolicy
+		size    uint64
+		want    uint64
+		wantErr bool
+	}{
+		{"percent", &AutogrowPolicy{Increment: "10%"}, 10 * gi, 11 * gi, false},
+		{"percent rounds up", &AutogrowPolicy{Increment: "10%"}, 5, 6, false},
+		{"size", &AutogrowPolicy{Increment: "5Gi"}, 10 * gi, 15 * gi, false},
+		{"capped", &AutogrowPolicy{Increment: "5Gi", MaxSize: "12Gi"}, 10 * gi, 12 * gi, false},
+		{"at max size", &AutogrowPolicy{Increment: "5Gi", MaxSize: "12Gi"}, 12 * gi, 12 * gi, false},
+		{"beyond max size", &AutogrowPolicy{Increment: "5Gi", MaxSize: "12Gi"}, 20 * gi, 20 * gi, false},
+		{"invalid increment", &AutogrowPolicy{Increment: "lots"}, 10 * gi, 0, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			size, err := test.policy.NextSize(test.size)
+			if test.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.want, size)
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"reflect"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/RoaringBitmap/roaring"
@@ -23,6 +24,7 @@ import (
 	drivers "github.com/netapp/trident/storage_drivers"
 	"github.com/netapp/trident/utils"
 	"github.com/netapp/trident/utils/errors"
+	"github.com/netapp/trident/utils/tracing"
 )
 
 // Driver provides a common interface for storage related operations
@@ -102,7 +104,8 @@ type StateGetter interface {
 	GetBackendState(ctx context.Context) (string, *roaring.Bitmap)
 }
 
-// VolumeUpdater provides a common interface for backends that support updating the volume
+// VolumeUpdater provides a common interface for backends that support updating the volume.  The volume config
+// and volumes passed to Update are copies, which the driver may change and return as the updated volumes.
 type VolumeUpdater interface {
 	Update(
 		ctx context.Context, volConfig *VolumeConfig,
@@ -110,6 +113,31 @@ type VolumeUpdater interface {
 	) (map[string]*Volume, error)
 }
 
+// PoolCapacityGetter provides a common interface for backends that can report the space available in a storage pool
+type PoolCapacityGetter interface {
+	GetPoolCapacity(ctx context.Context, pool Pool) (*PoolCapacity, error)
+}
+
+// VolumeHealthChecker provides a common interface for backends that can report the health of their volumes
+type VolumeHealthChecker interface {
+	GetVolumeHealth(ctx context.Context, volConfig *VolumeConfig) (*VolumeHealth, error)
+}
+
+// GroupSnapshotter provides a common interface for backends that can snapshot several volumes at the same
+// point in time.  The snapshot and volume configs are parallel slices, one entry per volume.
+type GroupSnapshotter interface {
+	CreateGroupSnapshot(
+		ctx context.Context, config *GroupSnapshotConfig, snapConfigs []*SnapshotConfig, volConfigs []*VolumeConfig,
+	) ([]*Snapshot, error)
+}
+
+// VolumeMover provides a common interface for backends that can relocate a volume to another of their storage
+// pools without disrupting access to it.  Moves may take a long time, so drivers should start the move and return
+// an InProgressError until it finishes, and they must tolerate being called again for a move already under way.
+type VolumeMover interface {
+	MoveVolume(ctx context.Context, volConfig *VolumeConfig, poolName string) error
+}
+
 type StorageBackend struct {
 	driver             Driver
 	name               string
@@ -120,6 +148,7 @@ type StorageBackend struct {
 	stateReason        string
 	storage            map[string]Pool
 	volumes            map[string]*Volume
+	volumesMutex       sync.RWMutex // guards volumes, since volumes on one backend may be created in parallel
 	configRef          string
 	nodeAccessUpToDate bool
 }
@@ -146,7 +175,20 @@ func (b *StorageBackend) UpdateVolume(
 		return nil, errors.NotManagedError("source volume %s is not managed by Trident", volConfig.InternalName)
 	}
 
-	return volUpdateDriver.Update(ctx, volConfig, updateInfo, b.volumes)
+	// The driver changes copies of the cached volumes, so that the caller may replace the cached volumes only
+	// once the changes are persisted.  The copy of the volume being updated shares its config with the driver.
+	volumes := b.Volumes()
+	for name, volume := range volumes {
+		volumes[name] = NewVolume(volume.Config.ConstructClone(), volume.BackendUUID, volume.Pool, volume.Orphaned,
+			volume.State)
+	}
+	if volume, ok := volumes[volConfig.Name]; ok {
+		volConfig = volume.Config
+	} else {
+		volConfig = volConfig.ConstructClone()
+	}
+
+	return volUpdateDriver.Update(ctx, volConfig, updateInfo, volumes)
 }
 
 func (b *StorageBackend) Driver() Driver {
@@ -215,11 +257,22 @@ func (b *StorageBackend) SetStorage(Storage map[string]Pool) {
 	b.storage = Storage
 }
 
+// Volumes returns a copy of the backend's volume cache, which is safe to read while volumes are added
+// to or removed from the backend.
 func (b *StorageBackend) Volumes() map[string]*Volume {
-	return b.volumes
+	b.volumesMutex.RLock()
+	defer b.volumesMutex.RUnlock()
+
+	volumes := make(map[string]*Volume, len(b.volumes))
+	for name, volume := range b.volumes {
+		volumes[name] = volume
+	}
+	return volumes
 }
 
 func (b *StorageBackend) SetVolumes(Volumes map[string]*Volume) {
+	b.volumesMutex.Lock()
+	defer b.volumesMutex.Unlock()
 	b.volumes = Volumes
 }
 
@@ -378,8 +431,10 @@ func (b *StorageBackend) IsCredentialsFieldSet(ctx context.Context) bool {
 
 func (b *StorageBackend) AddVolume(
 	ctx context.Context, volConfig *VolumeConfig, storagePool Pool, volAttributes map[string]sa.Request, retry bool,
-) (*Volume, error) {
-	var err error
+) (volume *Volume, err error) {
+	ctx, span := tracing.StartSpan(ctx, "storage.AddVolume",
+		tracing.Volume(volConfig.Name), tracing.Backend(b.name), tracing.Pool(storagePool.Name()))
+	defer tracing.EndSpan(span, &err)
 
 	Logc(ctx).WithFields(LogFields{
 		"backend":        b.name,
@@ -458,7 +513,7 @@ func (b *StorageBackend) AddVolume(
 	}
 
 	vol := NewVolume(volConfig, b.backendUUID, storagePool.Name(), false, VolumeStateOnline)
-	b.volumes[vol.Config.Name] = vol
+	b.AddCachedVolume(vol)
 	return vol, nil
 }
 
@@ -489,7 +544,14 @@ func (b *StorageBackend) GetDebugTraceFlags(ctx context.Context) map[string]bool
 
 func (b *StorageBackend) CloneVolume(
 	ctx context.Context, sourceVolConfig, cloneVolConfig *VolumeConfig, storagePool Pool, retry bool,
-) (*Volume, error) {
+) (volume *Volume, err error) {
+	ctx, span := tracing.StartSpan(ctx, "storage.CloneVolume",
+		tracing.Volume(cloneVolConfig.Name), tracing.Backend(b.name))
+	defer tracing.EndSpan(span, &err)
+	if storagePool != nil {
+		span.SetAttributes(tracing.Pool(storagePool.Name()))
+	}
+
 	fields := LogFields{
 		"backend":                cloneVolConfig.Name,
 		"backendUUID":            b.backendUUID,
@@ -600,13 +662,16 @@ func (b *StorageBackend) CloneVolume(
 	}
 
 	vol := NewVolume(cloneVolConfig, b.backendUUID, poolName, false, VolumeStateOnline)
-	b.volumes[vol.Config.Name] = vol
+	b.AddCachedVolume(vol)
 	return vol, nil
 }
 
 func (b *StorageBackend) PublishVolume(
 	ctx context.Context, volConfig *VolumeConfig, publishInfo *utils.VolumePublishInfo,
-) error {
+) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "storage.PublishVolume", tracing.Volume(volConfig.Name), tracing.Backend(b.name))
+	defer tracing.EndSpan(span, &err)
+
 	Logc(ctx).WithFields(LogFields{
 		"backend":        b.name,
 		"backendUUID":    b.backendUUID,
@@ -630,7 +695,10 @@ func (b *StorageBackend) PublishVolume(
 
 func (b *StorageBackend) UnpublishVolume(
 	ctx context.Context, volConfig *VolumeConfig, publishInfo *utils.VolumePublishInfo,
-) error {
+) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "storage.UnpublishVolume", tracing.Volume(volConfig.Name), tracing.Backend(b.name))
+	defer tracing.EndSpan(span, &err)
+
 	Logc(ctx).WithFields(LogFields{
 		"backend":        b.name,
 		"backendUUID":    b.backendUUID,
@@ -668,7 +736,10 @@ func (b *StorageBackend) GetVolumeForImport(ctx context.Context, volumeID string
 	return volExternal, nil
 }
 
-func (b *StorageBackend) ImportVolume(ctx context.Context, volConfig *VolumeConfig) (*Volume, error) {
+func (b *StorageBackend) ImportVolume(ctx context.Context, volConfig *VolumeConfig) (volume *Volume, err error) {
+	ctx, span := tracing.StartSpan(ctx, "storage.ImportVolume", tracing.Volume(volConfig.Name), tracing.Backend(b.name))
+	defer tracing.EndSpan(span, &err)
+
 	Logc(ctx).WithFields(LogFields{
 		"backend":    b.name,
 		"volume":     volConfig.ImportOriginalName,
@@ -693,7 +764,7 @@ func (b *StorageBackend) ImportVolume(ctx context.Context, volConfig *VolumeConf
 		b.driver.CreatePrepare(ctx, volConfig, nil)
 	}
 
-	err := b.driver.Import(ctx, volConfig, volConfig.ImportOriginalName)
+	err = b.driver.Import(ctx, volConfig, volConfig.ImportOriginalName)
 	if err != nil {
 		return nil, fmt.Errorf("driver import volume failed: %v", err)
 	}
@@ -706,12 +777,15 @@ func (b *StorageBackend) ImportVolume(ctx context.Context, volConfig *VolumeConf
 		return nil, fmt.Errorf("failed post import volume operations : %v", err)
 	}
 
-	volume := NewVolume(volConfig, b.backendUUID, drivers.UnsetPool, false, VolumeStateOnline)
-	b.volumes[volume.Config.Name] = volume
+	volume = NewVolume(volConfig, b.backendUUID, drivers.UnsetPool, false, VolumeStateOnline)
+	b.AddCachedVolume(volume)
 	return volume, nil
 }
 
-func (b *StorageBackend) ResizeVolume(ctx context.Context, volConfig *VolumeConfig, newSize string) error {
+func (b *StorageBackend) ResizeVolume(ctx context.Context, volConfig *VolumeConfig, newSize string) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "storage.ResizeVolume", tracing.Volume(volConfig.Name), tracing.Backend(b.name))
+	defer tracing.EndSpan(span, &err)
+
 	// Ensure volume is managed
 	if volConfig.ImportNotManaged {
 		return errors.NotManagedError("volume %s is not managed by Trident", volConfig.InternalName)
@@ -765,7 +839,10 @@ func (b *StorageBackend) RenameVolume(ctx context.Context, volConfig *VolumeConf
 	return nil
 }
 
-func (b *StorageBackend) RemoveVolume(ctx context.Context, volConfig *VolumeConfig) error {
+func (b *StorageBackend) RemoveVolume(ctx context.Context, volConfig *VolumeConfig) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "storage.RemoveVolume", tracing.Volume(volConfig.Name), tracing.Backend(b.name))
+	defer tracing.EndSpan(span, &err)
+
 	Logc(ctx).WithFields(LogFields{
 		"backend":        b.name,
 		"volume":         volConfig.Name,
@@ -796,7 +873,16 @@ func (b *StorageBackend) RemoveVolume(ctx context.Context, volConfig *VolumeConf
 	return nil
 }
 
+// AddCachedVolume records a volume in the backend's volume cache.
+func (b *StorageBackend) AddCachedVolume(volume *Volume) {
+	b.volumesMutex.Lock()
+	defer b.volumesMutex.Unlock()
+	b.volumes[volume.Config.Name] = volume
+}
+
 func (b *StorageBackend) RemoveCachedVolume(volumeName string) {
+	b.volumesMutex.Lock()
+	defer b.volumesMutex.Unlock()
 	delete(b.volumes, volumeName)
 }
 
@@ -853,7 +939,11 @@ func (b *StorageBackend) GetSnapshots(ctx context.Context, volConfig *VolumeConf
 
 func (b *StorageBackend) CreateSnapshot(
 	ctx context.Context, snapConfig *SnapshotConfig, volConfig *VolumeConfig,
-) (*Snapshot, error) {
+) (snapshot *Snapshot, err error) {
+	ctx, span := tracing.StartSpan(ctx, "storage.CreateSnapshot",
+		tracing.Volume(volConfig.Name), tracing.Snapshot(snapConfig.Name), tracing.Backend(b.name))
+	defer tracing.EndSpan(span, &err)
+
 	Logc(ctx).WithFields(LogFields{
 		"backend":        b.name,
 		"volume":         snapConfig.Name,
@@ -895,9 +985,85 @@ func (b *StorageBackend) CreateSnapshot(
 	return b.driver.CreateSnapshot(ctx, snapConfig, volConfig)
 }
 
+// CreateGroupSnapshot creates a crash-consistent set of snapshots of several volumes on this backend.
+// The snapshot and volume configs are parallel slices, one entry per volume.
+func (b *StorageBackend) CreateGroupSnapshot(
+	ctx context.Context, config *GroupSnapshotConfig, snapConfigs []*SnapshotConfig, volConfigs []*VolumeConfig,
+) ([]*Snapshot, error) {
+	Logc(ctx).WithFields(LogFields{
+		"backend":       b.name,
+		"groupSnapshot": config.Name,
+		"volumes":       config.VolumeNames,
+	}).Debug("Attempting group snapshot create.")
+
+	groupSnapshotter, ok := b.driver.(GroupSnapshotter)
+	if !ok {
+		return nil, errors.UnsupportedError(fmt.Sprintf(
+			"group snapshots are not implemented by backends of type %v", b.driver.Name()))
+	}
+
+	if len(snapConfigs) != len(volConfigs) {
+		return nil, fmt.Errorf("group snapshot %s has %d snapshots for %d volumes",
+			config.Name, len(snapConfigs), len(volConfigs))
+	}
+
+	// Ensure volumes are managed
+	for _, volConfig := range volConfigs {
+		if volConfig.ImportNotManaged {
+			return nil, errors.NotManagedError("source volume %s is not managed by Trident", volConfig.InternalName)
+		}
+	}
+
+	// Ensure backend is ready
+	if err := b.ensureOnline(ctx); err != nil {
+		return nil, err
+	}
+
+	// Set the default internal snapshot names to match the group snapshot name.  Drivers
+	// may override these values in the config structures if necessary.
+	config.InternalName = config.Name
+	for _, snapConfig := range snapConfigs {
+		snapConfig.InternalName = config.InternalName
+	}
+
+	// Implement idempotency by checking for the snapshots first
+	existingSnapshots := make([]*Snapshot, 0, len(snapConfigs))
+	for i, snapConfig := range snapConfigs {
+		existingSnapshot, err := b.driver.GetSnapshot(ctx, snapConfig, volConfigs[i])
+		if err != nil {
+			// An error here means we couldn't check for the snapshot.  It does not mean the snapshot doesn't exist.
+			return nil, err
+		} else if existingSnapshot != nil {
+			existingSnapshots = append(existingSnapshots, existingSnapshot)
+		}
+	}
+
+	if len(existingSnapshots) == len(snapConfigs) {
+
+		Logc(ctx).WithFields(LogFields{
+			"backend":       b.name,
+			"groupSnapshot": config.Name,
+		}).Warning("Group snapshot already exists.")
+
+		// Group snapshot already exists, so just return its snapshots
+		return existingSnapshots, nil
+	} else if len(existingSnapshots) > 0 {
+		// Snapshots taken separately aren't consistent with each other, so they can't be completed into a group
+		return nil, errors.FoundError("%d of %d snapshots named %s already exist on backend %s",
+			len(existingSnapshots), len(snapConfigs), config.Name, b.name)
+	}
+
+	// Create group snapshot
+	return groupSnapshotter.CreateGroupSnapshot(ctx, config, snapConfigs, volConfigs)
+}
+
 func (b *StorageBackend) RestoreSnapshot(
 	ctx context.Context, snapConfig *SnapshotConfig, volConfig *VolumeConfig,
-) error {
+) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "storage.RestoreSnapshot",
+		tracing.Volume(volConfig.Name), tracing.Snapshot(snapConfig.Name), tracing.Backend(b.name))
+	defer tracing.EndSpan(span, &err)
+
 	Logc(ctx).WithFields(LogFields{
 		"backend":        b.name,
 		"volume":         snapConfig.Name,
@@ -921,7 +1087,11 @@ func (b *StorageBackend) RestoreSnapshot(
 
 func (b *StorageBackend) DeleteSnapshot(
 	ctx context.Context, snapConfig *SnapshotConfig, volConfig *VolumeConfig,
-) error {
+) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "storage.DeleteSnapshot",
+		tracing.Volume(volConfig.Name), tracing.Snapshot(snapConfig.Name), tracing.Backend(b.name))
+	defer tracing.EndSpan(span, &err)
+
 	Logc(ctx).WithFields(LogFields{
 		"backend":          b.name,
 		"volumeName":       snapConfig.VolumeName,
@@ -990,6 +1160,8 @@ func (b *StorageBackend) GetUpdateType(ctx context.Context, origBackend Backend)
 // HasVolumes returns true if the Backend has one or more volumes
 // provisioned on it.
 func (b *StorageBackend) HasVolumes() bool {
+	b.volumesMutex.RLock()
+	defer b.volumesMutex.RUnlock()
 	return len(b.volumes) > 0
 }
 
@@ -1136,9 +1308,11 @@ func (b *StorageBackend) ConstructExternal(ctx context.Context) *BackendExternal
 	for name, pool := range b.storage {
 		backendExternal.Storage[name] = pool.ConstructExternal()
 	}
+	b.volumesMutex.RLock()
 	for volName := range b.volumes {
 		backendExternal.Volumes = append(backendExternal.Volumes, volName)
 	}
+	b.volumesMutex.RUnlock()
 
 	return &backendExternal
 }
@@ -1367,6 +1541,77 @@ func (b *StorageBackend) GetMirrorStatus(
 	return mirrorDriver.GetMirrorStatus(ctx, localInternalVolumeName, remoteVolumeHandle)
 }
 
+// GetVolumeHealth returns the condition of a volume on this backend.  Drivers that do not implement
+// VolumeHealthChecker are limited to reporting whether the volume exists.
+func (b *StorageBackend) GetVolumeHealth(ctx context.Context, volConfig *VolumeConfig) (*VolumeHealth, error) {
+	Logc(ctx).WithFields(LogFields{
+		"backend":        b.name,
+		"volume":         volConfig.Name,
+		"volumeInternal": volConfig.InternalName,
+	}).Trace("Checking volume health.")
+
+	if !b.state.IsOnline() {
+		return NewVolumeHealth(VolumeHealthOffline, fmt.Sprintf("backend %s is %s", b.name, b.state)), nil
+	}
+
+	if healthChecker, ok := b.driver.(VolumeHealthChecker); ok {
+		return healthChecker.GetVolumeHealth(ctx, volConfig)
+	}
+
+	if err := b.driver.Get(ctx, volConfig.InternalName); err != nil {
+		if errors.IsNotFoundError(err) {
+			return NewVolumeHealth(VolumeHealthMissing, err.Error()), nil
+		}
+		return nil, err
+	}
+
+	return NewVolumeHealth(VolumeHealthNormal, ""), nil
+}
+
+// MoveVolume relocates a volume to another storage pool on this backend.  The move is complete when this
+// method returns nil; an InProgressError means the caller should check back later.
+func (b *StorageBackend) MoveVolume(ctx context.Context, volConfig *VolumeConfig, poolName string) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "storage.MoveVolume",
+		tracing.Volume(volConfig.Name), tracing.Backend(b.name), tracing.Pool(poolName))
+	defer tracing.EndSpan(span, &err)
+
+	Logc(ctx).WithFields(LogFields{
+		"backend":        b.name,
+		"volume":         volConfig.Name,
+		"volumeInternal": volConfig.InternalName,
+		"pool":           poolName,
+	}).Debug("Attempting volume move.")
+
+	// Ensure volume is managed
+	if volConfig.ImportNotManaged {
+		return errors.NotManagedError("volume %s is not managed by Trident", volConfig.InternalName)
+	}
+
+	// Ensure backend is ready
+	if err := b.ensureOnline(ctx); err != nil {
+		return err
+	}
+
+	moveDriver, ok := b.driver.(VolumeMover)
+	if !ok {
+		return errors.UnsupportedError(
+			fmt.Sprintf("volume move is not implemented by backends of type %v", b.driver.Name()))
+	}
+
+	return moveDriver.MoveVolume(ctx, volConfig, poolName)
+}
+
+// GetPoolCapacity returns the total and free space of the specified storage pool, if the driver can report it.
+func (b *StorageBackend) GetPoolCapacity(ctx context.Context, pool Pool) (*PoolCapacity, error) {
+	capacityDriver, ok := b.driver.(PoolCapacityGetter)
+	if !ok {
+		return nil, errors.UnsupportedError(
+			fmt.Sprintf("pool capacity is not reported by backends of type %v", b.driver.Name()))
+	}
+
+	return capacityDriver.GetPoolCapacity(ctx, pool)
+}
+
 func (b *StorageBackend) CanMirror() bool {
 	_, ok := b.driver.(Mirrorer)
 	return ok
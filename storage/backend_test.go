@@ -11,6 +11,7 @@ import (
 
 	"github.com/netapp/trident/acp"
 	mockacp "github.com/netapp/trident/mocks/mock_acp"
+	"github.com/netapp/trident/utils"
 	"github.com/netapp/trident/utils/errors"
 )
 
@@ -101,6 +102,19 @@ func TestDeleteSnapshot_BackendOffline(t *testing.T) {
 	assert.Errorf(t, err, "expected err")
 }
 
+func TestGetVolumeHealth_BackendOffline(t *testing.T) {
+	backend := &StorageBackend{
+		name:  "backend",
+		state: Offline,
+	}
+
+	health, err := backend.GetVolumeHealth(context.Background(), &VolumeConfig{Name: "vol", InternalName: "vol"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, VolumeHealthOffline, health.Condition)
+	assert.True(t, health.IsAbnormal())
+}
+
 func TestDeleteSnapshot_NotManaged(t *testing.T) {
 	backendUUID := "test-backend"
 	volumeName := "pvc-e9748b6b-8240-4fd8-97bc-868bf064ecd4"
@@ -386,3 +400,49 @@ func TestIsProvisioningAllowed(t *testing.T) {
 		)
 	}
 }
+
+// updatingDriver changes the QoS policy of the volume it updates, then fails if told to.
+type updatingDriver struct {
+	Driver
+	err error
+}
+
+func (d *updatingDriver) Update(
+	_ context.Context, volConfig *VolumeConfig, _ *utils.VolumeUpdateInfo, allVolumes map[string]*Volume,
+) (map[string]*Volume, error) {
+	volConfig.QosPolicy = "gold"
+	allVolumes[volConfig.Name].Config.TieringPolicy = "none"
+	if d.err != nil {
+		return nil, d.err
+	}
+	return map[string]*Volume{volConfig.Name: allVolumes[volConfig.Name]}, nil
+}
+
+func TestUpdateVolume_ChangesCopies(t *testing.T) {
+	volume := NewVolume(&VolumeConfig{Name: "vol", InternalName: "vol", QosPolicy: "silver"}, "uuid", "pool",
+		false, VolumeStateOnline)
+	backend := &StorageBackend{
+		name:    "backend",
+		state:   Online,
+		driver:  &updatingDriver{err: errors.New("failed")},
+		volumes: map[string]*Volume{"vol": volume},
+	}
+
+	// A failed update leaves the cached volume alone
+	_, err := backend.UpdateVolume(context.Background(), volume.Config, &utils.VolumeUpdateInfo{QosPolicy: "gold"})
+
+	assert.Error(t, err)
+	assert.Equal(t, "silver", volume.Config.QosPolicy)
+	assert.Empty(t, volume.Config.TieringPolicy)
+
+	// as does a successful one, which returns the changed copy for the caller to persist and cache
+	backend.driver = &updatingDriver{}
+	updatedVols, err := backend.UpdateVolume(context.Background(), volume.Config,
+		&utils.VolumeUpdateInfo{QosPolicy: "gold"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "silver", volume.Config.QosPolicy)
+	assert.Equal(t, "gold", updatedVols["vol"].Config.QosPolicy)
+	assert.Equal(t, "none", updatedVols["vol"].Config.TieringPolicy)
+	assert.Same(t, backend.volumes["vol"], volume)
+}
@@ -0,0 +1,98 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package storage
+
+import (
+	"fmt"
+)
+
+// GroupSnapshotConfig describes a set of snapshots of several volumes that are taken at the same point in time,
+// so that together they are crash-consistent.  Each member snapshot is named after the group snapshot.
+type GroupSnapshotConfig struct {
+	Version      string   `json:"version,omitempty"`
+	Name         string   `json:"name,omitempty"`
+	InternalName string   `json:"internalName,omitempty"`
+	VolumeNames  []string `json:"volumeNames,omitempty"`
+}
+
+func (c *GroupSnapshotConfig) ID() string {
+	return c.Name
+}
+
+func (c *GroupSnapshotConfig) Validate() error {
+	if c.Name == "" || len(c.VolumeNames) == 0 {
+		return fmt.Errorf("the following fields for \"GroupSnapshot\" are mandatory: name and volumeNames")
+	}
+
+	volumeNames := make(map[string]bool, len(c.VolumeNames))
+	for _, volumeName := range c.VolumeNames {
+		if volumeName == "" {
+			return fmt.Errorf("group snapshot %s lists an empty volume name", c.Name)
+		}
+		if volumeNames[volumeName] {
+			return fmt.Errorf("group snapshot %s lists volume %s more than once", c.Name, volumeName)
+		}
+		volumeNames[volumeName] = true
+	}
+	return nil
+}
+
+type GroupSnapshot struct {
+	Config      *GroupSnapshotConfig
+	SnapshotIDs []string `json:"snapshotIDs"` // The IDs of the member snapshots, one per volume
+	Created     string   `json:"dateCreated"` // The UTC time that the snapshots were created, in RFC3339 format
+}
+
+type GroupSnapshotExternal struct {
+	GroupSnapshot
+}
+
+type GroupSnapshotPersistent struct {
+	GroupSnapshot
+}
+
+func NewGroupSnapshot(config *GroupSnapshotConfig, snapshotIDs []string, created string) *GroupSnapshot {
+	return &GroupSnapshot{
+		Config:      config,
+		SnapshotIDs: snapshotIDs,
+		Created:     created,
+	}
+}
+
+func (s *GroupSnapshot) ID() string {
+	return s.Config.ID()
+}
+
+func (s *GroupSnapshot) ConstructExternal() *GroupSnapshotExternal {
+	clone := s.ConstructClone()
+	return &GroupSnapshotExternal{GroupSnapshot: *clone}
+}
+
+func (s *GroupSnapshot) ConstructPersistent() *GroupSnapshotPersistent {
+	clone := s.ConstructClone()
+	return &GroupSnapshotPersistent{GroupSnapshot: *clone}
+}
+
+func (s *GroupSnapshot) ConstructClone() *GroupSnapshot {
+	return &GroupSnapshot{
+		Config: &GroupSnapshotConfig{
+			Version:      s.Config.Version,
+			Name:         s.Config.Name,
+			InternalName: s.Config.InternalName,
+			VolumeNames:  append([]string(nil), s.Config.VolumeNames...),
+		},
+		SnapshotIDs: append([]string(nil), s.SnapshotIDs...),
+		Created:     s.Created,
+	}
+}
+
+func (s *GroupSnapshotPersistent) ConstructExternal() *GroupSnapshotExternal {
+	clone := s.ConstructClone()
+	return &GroupSnapshotExternal{GroupSnapshot: *clone}
+}
+
+type ByGroupSnapshotExternalID []*GroupSnapshotExternal
+
+func (a ByGroupSnapshotExternalID) Len() int           { return len(a) }
+func (a ByGroupSnapshotExternalID) Less(i, j int) bool { return a[i].ID() < a[j].ID() }
+func (a ByGroupSnapshotExternalID) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
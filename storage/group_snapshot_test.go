@@ -0,0 +1,51 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupSnapshotConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *GroupSnapshotConfig
+		wantErr bool
+	}{
+		{"valid", &GroupSnapshotConfig{Name: "group", VolumeNames: []string{"vol1", "vol2"}}, false},
+		{"missing name", &GroupSnapshotConfig{VolumeNames: []string{"vol1"}}, true},
+		{"missing volumes", &GroupSnapshotConfig{Name: "group"}, true},
+		{"empty volume name", &GroupSnapshotConfig{Name: "group", VolumeNames: []string{"vol1", ""}}, true},
+		{"duplicate volume", &GroupSnapshotConfig{Name: "group", VolumeNames: []string{"vol1", "vol1"}}, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.config.Validate()
+			if test.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestGroupSnapshot_ConstructClone(t *testing.T) {
+	config := &GroupSnapshotConfig{Version: "1", Name: "group", InternalName: "group", VolumeNames: []string{"vol1"}}
+	groupSnapshot := NewGroupSnapshot(config, []string{"vol1/group"}, "2024-01-01T00:00:00Z")
+
+	clone := groupSnapshot.ConstructClone()
+	assert.Equal(t, groupSnapshot, clone)
+
+	// The clone must not share its slices with the original
+	clone.Config.VolumeNames[0] = "vol2"
+	clone.SnapshotIDs[0] = "vol2/group"
+	assert.Equal(t, "vol1", groupSnapshot.Config.VolumeNames[0])
+	assert.Equal(t, "vol1/group", groupSnapshot.SnapshotIDs[0])
+
+	external := groupSnapshot.ConstructPersistent().ConstructExternal()
+	assert.Equal(t, groupSnapshot.ID(), external.ID())
+	assert.Equal(t, groupSnapshot.SnapshotIDs, external.SnapshotIDs)
+}
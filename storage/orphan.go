@@ -0,0 +1,99 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package storage
+
+import (
+	"fmt"
+)
+
+const (
+	OrphanActionImport = "import"
+	OrphanActionDelete = "delete"
+
+	OrphanReasonNotFound = "not found on backend"
+	OrphanReasonOrphaned = "marked orphaned"
+
+	OrphanResolutionImported = "imported"
+	OrphanResolutionDeleted  = "deleted"
+	OrphanResolutionDryRun   = "dry run"
+)
+
+// UntrackedVolume is a volume found on a storage backend that Trident does not track, such as one left behind
+// by a failed install, an obliviate run, or a volume deleted from Trident's store but not from the backend.
+type UntrackedVolume struct {
+	Name         string `json:"name"`
+	InternalName string `json:"internalName"`
+	Size         string `json:"size"`
+	Backend      string `json:"backend"`
+	BackendUUID  string `json:"backendUUID"`
+	Resolution   string `json:"resolution,omitempty"` // Outcome of the requested action, if any
+	Error        string `json:"error,omitempty"`
+}
+
+// MissingVolume is a volume tracked by Trident that its storage backend no longer reports, or that Trident has
+// marked as orphaned.
+type MissingVolume struct {
+	Name         string `json:"name"`
+	InternalName string `json:"internalName"`
+	Backend      string `json:"backend"`
+	BackendUUID  string `json:"backendUUID"`
+	Orphaned     bool   `json:"orphaned"`
+	Reason       string `json:"reason"`
+}
+
+// MissingSnapshot is a snapshot tracked by Trident that its storage backend no longer reports.
+type MissingSnapshot struct {
+	Name         string `json:"name"`
+	InternalName string `json:"internalName"`
+	VolumeName   string `json:"volumeName"`
+	Backend      string `json:"backend"`
+	BackendUUID  string `json:"backendUUID"`
+}
+
+// OrphanReport describes the differences between the volumes and snapshots on the storage backends and those
+// tracked by Trident.  A backend whose inventory could not be read is listed with the error, and contributes
+// nothing else to the report.
+type OrphanReport struct {
+	UntrackedVolumes []*UntrackedVolume `json:"untrackedVolumes"`
+	MissingVolumes   []*MissingVolume   `json:"missingVolumes"`
+	MissingSnapshots []*MissingSnapshot `json:"missingSnapshots"`
+	BackendErrors    map[string]string  `json:"backendErrors,omitempty"` // key is backend name
+}
+
+func NewOrphanReport() *OrphanReport {
+	return &OrphanReport{
+		UntrackedVolumes: make([]*UntrackedVolume, 0),
+		MissingVolumes:   make([]*MissingVolume, 0),
+		MissingSnapshots: make([]*MissingSnapshot, 0),
+		BackendErrors:    make(map[string]string),
+	}
+}
+
+// ResolveOrphansRequest asks that untracked volumes be imported into Trident or deleted from their backends.
+// Unless DryRun is explicitly false, nothing is changed, and the report only says what would be done.
+type ResolveOrphansRequest struct {
+	Backend      string   `json:"backend,omitempty"` // Limits the request to one backend
+	Action       string   `json:"action"`
+	Volumes      []string `json:"volumes,omitempty"`      // Internal names of the volumes to act on; if empty, all
+	StorageClass string   `json:"storageClass,omitempty"` // Storage class of imported volumes
+	DryRun       *bool    `json:"dryRun,omitempty"`
+}
+
+func (r *ResolveOrphansRequest) Validate() error {
+	switch r.Action {
+	case OrphanActionImport:
+		if r.StorageClass == "" {
+			return fmt.Errorf("a storage class is required to import volumes")
+		}
+	case OrphanActionDelete:
+	default:
+		return fmt.Errorf("invalid action %s; must be one of %s or %s", r.Action, OrphanActionImport,
+			OrphanActionDelete)
+	}
+	return nil
+}
+
+// IsDryRun reports whether the request only asks what would be done, which is the default.
+func (r *ResolveOrphansRequest) IsDryRun() bool {
+	return r.DryRun == nil || *r.DryRun
+}
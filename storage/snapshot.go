@@ -21,6 +21,7 @@ type SnapshotConfig struct {
 	VolumeInternalName  string   `json:"volumeInternalName,omitempty"`
 	LUKSPassphraseNames []string `json:"luksPassphraseNames,omitempty"`
 	ImportNotManaged    bool     `json:"importNotManaged"`
+	GroupSnapshotName   string   `json:"groupSnapshotName,omitempty"`
 }
 
 func (c *SnapshotConfig) ID() string {
@@ -112,6 +113,7 @@ func (s *Snapshot) ConstructClone() *Snapshot {
 			VolumeInternalName:  s.Config.VolumeInternalName,
 			LUKSPassphraseNames: s.Config.LUKSPassphraseNames,
 			ImportNotManaged:    s.Config.ImportNotManaged,
+			GroupSnapshotName:   s.Config.GroupSnapshotName,
 		},
 		Created:   s.Created,
 		SizeBytes: s.SizeBytes,
@@ -0,0 +1,224 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package storage
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/netapp/trident/utils"
+	"github.com/netapp/trident/utils/cron"
+)
+
+var snapshotPolicyNameRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]{0,126}$`)
+
+// SnapshotPolicyConfig describes a schedule on which snapshots are created of a set of volumes, and the rules
+// by which those snapshots are later deleted.  A volume is governed by the policy if its name is listed or if it
+// belongs to one of the listed storage classes.  Each snapshot is named after the policy and the time it was taken.
+type SnapshotPolicyConfig struct {
+	Version         string   `json:"version,omitempty"`
+	Name            string   `json:"name"`
+	Schedule        string   `json:"schedule"`
+	RetentionCount  int      `json:"retentionCount,omitempty"`
+	RetentionMaxAge string   `json:"retentionMaxAge,omitempty"`
+	StorageClasses  []string `json:"storageClasses,omitempty"`
+	VolumeNames     []string `json:"volumeNames,omitempty"`
+}
+
+func (c *SnapshotPolicyConfig) Validate() error {
+	if c.Name == "" || c.Schedule == "" {
+		return fmt.Errorf("the following fields for \"SnapshotPolicy\" are mandatory: name and schedule")
+	}
+	if !snapshotPolicyNameRegex.MatchString(c.Name) {
+		return fmt.Errorf("snapshot policy name %s must be 1-127 letters, digits, hyphens or underscores", c.Name)
+	}
+	if _, err := cron.Parse(c.Schedule); err != nil {
+		return err
+	}
+	if c.RetentionCount < 0 {
+		return fmt.Errorf("snapshot policy %s has a negative retention count", c.Name)
+	}
+	if _, err := c.MaxAge(); err != nil {
+		return err
+	}
+	if c.RetentionCount == 0 && c.RetentionMaxAge == "" {
+		return fmt.Errorf("snapshot policy %s must specify a retention count, a retention age, or both", c.Name)
+	}
+	return nil
+}
+
+// MaxAge returns the age beyond which the policy's snapshots are deleted, or zero if there is no age limit.
+func (c *SnapshotPolicyConfig) MaxAge() (time.Duration, error) {
+	if c.RetentionMaxAge == "" {
+		return 0, nil
+	}
+	maxAge, err := time.ParseDuration(c.RetentionMaxAge)
+	if err != nil || maxAge <= 0 {
+		return 0, fmt.Errorf("snapshot policy %s has an invalid retention age %s", c.Name, c.RetentionMaxAge)
+	}
+	return maxAge, nil
+}
+
+// Matches reports whether a volume is governed by the policy.
+func (c *SnapshotPolicyConfig) Matches(volConfig *VolumeConfig) bool {
+	return utils.SliceContainsString(c.VolumeNames, volConfig.Name) ||
+		(volConfig.StorageClass != "" && utils.SliceContainsString(c.StorageClasses, volConfig.StorageClass))
+}
+
+// SnapshotName returns the name of a snapshot taken by the policy at the specified time.
+func (c *SnapshotPolicyConfig) SnapshotName(t time.Time) string {
+	return c.Name + "-" + t.UTC().Format(SnapshotNameFormat)
+}
+
+// IsPolicySnapshot reports whether a snapshot name was generated by the policy.
+func (c *SnapshotPolicyConfig) IsPolicySnapshot(snapshotName string) bool {
+	suffix, found := strings.CutPrefix(snapshotName, c.Name+"-")
+	if !found {
+		return false
+	}
+	_, err := time.Parse(SnapshotNameFormat, suffix)
+	return err == nil
+}
+
+// SnapshotsToDelete returns those of the supplied snapshots, which need not all belong to the policy,
+// that fall outside the policy's retention rules as of the specified time.
+func (c *SnapshotPolicyConfig) SnapshotsToDelete(snapshots []*SnapshotExternal, now time.Time) []*SnapshotExternal {
+	maxAge, _ := c.MaxAge()
+
+	policySnapshots := make([]*SnapshotExternal, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		if c.IsPolicySnapshot(snapshot.Config.Name) {
+			policySnapshots = append(policySnapshots, snapshot)
+		}
+	}
+
+	// Policy snapshot names sort chronologically, so order them newest first
+	sort.Slice(policySnapshots, func(i, j int) bool {
+		return policySnapshots[i].Config.Name > policySnapshots[j].Config.Name
+	})
+
+	toDelete := make([]*SnapshotExternal, 0)
+	for i, snapshot := range policySnapshots {
+		if c.RetentionCount > 0 && i >= c.RetentionCount {
+			toDelete = append(toDelete, snapshot)
+			continue
+		}
+		if maxAge > 0 {
+			created, err := time.Parse(SnapshotNameFormat, strings.TrimPrefix(snapshot.Config.Name, c.Name+"-"))
+			if err == nil && now.Sub(created) > maxAge {
+				toDelete = append(toDelete, snapshot)
+			}
+		}
+	}
+	return toDelete
+}
+
+// SnapshotPolicyVolumeStatus records the most recent run of a snapshot policy against one volume.
+type SnapshotPolicyVolumeStatus struct {
+	LastRunTime       string `json:"lastRunTime,omitempty"`
+	LastSnapshotName  string `json:"lastSnapshotName,omitempty"`
+	RetainedSnapshots int    `json:"retainedSnapshots"`
+	LastError         string `json:"lastError,omitempty"`
+}
+
+type SnapshotPolicy struct {
+	Config      *SnapshotPolicyConfig
+	NextRunTime time.Time
+	Volumes     map[string]*SnapshotPolicyVolumeStatus // key is volume name
+}
+
+type SnapshotPolicyExternal struct {
+	Config      *SnapshotPolicyConfig                  `json:"config"`
+	NextRunTime string                                 `json:"nextRunTime,omitempty"`
+	Volumes     map[string]*SnapshotPolicyVolumeStatus `json:"volumes"`
+}
+
+type SnapshotPolicyPersistent struct {
+	SnapshotPolicyExternal
+}
+
+// NewSnapshotPolicy returns a policy whose first run is the first scheduled time after the specified time.
+func NewSnapshotPolicy(config *SnapshotPolicyConfig, now time.Time) (*SnapshotPolicy, error) {
+	schedule, err := cron.Parse(config.Schedule)
+	if err != nil {
+		return nil, err
+	}
+	return &SnapshotPolicy{
+		Config:      config,
+		NextRunTime: schedule.Next(now),
+		Volumes:     make(map[string]*SnapshotPolicyVolumeStatus),
+	}, nil
+}
+
+func (p *SnapshotPolicy) ID() string {
+	return p.Config.Name
+}
+
+// ScheduleNextRun advances the policy's next run to the first scheduled time after the specified time.
+func (p *SnapshotPolicy) ScheduleNextRun(now time.Time) {
+	if schedule, err := cron.Parse(p.Config.Schedule); err == nil {
+		p.NextRunTime = schedule.Next(now)
+	}
+}
+
+// IsDue reports whether the policy should run at the specified time.
+func (p *SnapshotPolicy) IsDue(now time.Time) bool {
+	return !p.NextRunTime.IsZero() && !now.Before(p.NextRunTime)
+}
+
+func (p *SnapshotPolicy) ConstructExternal() *SnapshotPolicyExternal {
+	configCopy := *p.Config
+	configCopy.StorageClasses = append([]string(nil), p.Config.StorageClasses...)
+	configCopy.VolumeNames = append([]string(nil), p.Config.VolumeNames...)
+
+	external := &SnapshotPolicyExternal{
+		Config:  &configCopy,
+		Volumes: make(map[string]*SnapshotPolicyVolumeStatus, len(p.Volumes)),
+	}
+	if !p.NextRunTime.IsZero() {
+		external.NextRunTime = p.NextRunTime.UTC().Format(time.RFC3339)
+	}
+	for volumeName, status := range p.Volumes {
+		statusCopy := *status
+		external.Volumes[volumeName] = &statusCopy
+	}
+	return external
+}
+
+func (p *SnapshotPolicy) ConstructPersistent() *SnapshotPolicyPersistent {
+	return &SnapshotPolicyPersistent{SnapshotPolicyExternal: *p.ConstructExternal()}
+}
+
+func (p *SnapshotPolicyPersistent) ID() string {
+	return p.Config.Name
+}
+
+// ConstructSnapshotPolicy restores a policy read from the persistent store.  A policy whose next run time was
+// not recorded is scheduled afresh from the specified time.
+func (p *SnapshotPolicyPersistent) ConstructSnapshotPolicy(now time.Time) (*SnapshotPolicy, error) {
+	if p.Config == nil {
+		return nil, fmt.Errorf("snapshot policy has no config")
+	}
+	configCopy := *p.Config
+	configCopy.StorageClasses = append([]string(nil), p.Config.StorageClasses...)
+	configCopy.VolumeNames = append([]string(nil), p.Config.VolumeNames...)
+
+	policy, err := NewSnapshotPolicy(&configCopy, now)
+	if err != nil {
+		return nil, err
+	}
+	if p.NextRunTime != "" {
+		if policy.NextRunTime, err = time.Parse(time.RFC3339, p.NextRunTime); err != nil {
+			return nil, fmt.Errorf("snapshot policy %s has an invalid next run time %s", p.Config.Name,
+				p.NextRunTime)
+		}
+	}
+	for volumeName, status := range p.Volumes {
+		statusCopy := *status
+		policy.Volumes[volumeName] = &statusCopy
+	}
+	return policy, nil
+}
@@ -0,0 +1,145 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshotPolicyConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *SnapshotPolicyConfig
+		wantErr bool
+	}{
+		{"valid count", &SnapshotPolicyConfig{Name: "hourly", Schedule: "@hourly", RetentionCount: 24}, false},
+		{"valid age", &SnapshotPolicyConfig{Name: "ns_daily", Schedule: "0 2 * * *", RetentionMaxAge: "168h"}, false},
+		{"missing name", &SnapshotPolicyConfig{Schedule: "@hourly", RetentionCount: 1}, true},
+		{"invalid name", &SnapshotPolicyConfig{Name: "a.b", Schedule: "@hourly", RetentionCount: 1}, true},
+		{"missing schedule", &SnapshotPolicyConfig{Name: "hourly", RetentionCount: 1}, true},
+		{"invalid schedule", &SnapshotPolicyConfig{Name: "hourly", Schedule: "hourly", RetentionCount: 1}, true},
+		{"negative count", &SnapshotPolicyConfig{Name: "hourly", Schedule: "@hourly", RetentionCount: -1}, true},
+		{"invalid age", &SnapshotPolicyConfig{Name: "hourly", Schedule: "@hourly", RetentionMaxAge: "1w"}, true},
+		{"no retention", &SnapshotPolicyConfig{Name: "hourly", Schedule: "@hourly"}, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.config.Validate()
+			if test.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSnapshotPolicyConfig_Matches(t *testing.T) {
+	config := &SnapshotPolicyConfig{StorageClasses: []string{"gold"}, VolumeNames: []string{"vol1"}}
+
+	assert.True(t, config.Matches(&VolumeConfig{Name: "vol1", StorageClass: "silver"}))
+	assert.True(t, config.Matches(&VolumeConfig{Name: "vol2", StorageClass: "gold"}))
+	assert.False(t, config.Matches(&VolumeConfig{Name: "vol3", StorageClass: "silver"}))
+	assert.False(t, config.Matches(&VolumeConfig{Name: "vol4"}))
+}
+
+func TestSnapshotPolicyConfig_SnapshotsToDelete(t *testing.T) {
+	now := time.Date(2024, 10, 16, 12, 0, 0, 0, time.UTC)
+	config := &SnapshotPolicyConfig{Name: "hourly", Schedule: "@hourly", RetentionCount: 3, RetentionMaxAge: "150m"}
+
+	assert.Equal(t, "hourly-20241016T120000Z", config.SnapshotName(now))
+	assert.True(t, config.IsPolicySnapshot("hourly-20241016T120000Z"))
+	assert.False(t, config.IsPolicySnapshot("hourly-manual"))
+	assert.False(t, config.IsPolicySnapshot("daily-20241016T120000Z"))
+
+	snapshots := make([]*SnapshotExternal, 0)
+	for _, name := range []string{
+		config.SnapshotName(now.Add(-4 * time.Hour)),
+		config.SnapshotName(now),
+		"hourly-manual",
+		config.SnapshotName(now.Add(-3 * time.Hour)),
+		config.SnapshotName(now.Add(-1 * time.Hour)),
+		"snapshot-12345",
+	} {
+		snapshots = append(snapshots, &SnapshotExternal{Snapshot{Config: &SnapshotConfig{Name: name}}})
+	}
+
+	// The two oldest exceed the count, and the third oldest exceeds the age
+	toDelete := config.SnapshotsToDelete(snapshots, now)
+	if assert.Len(t, toDelete, 2) {
+		assert.Equal(t, "hourly-20241016T090000Z", toDelete[0].Config.Name)
+		assert.Equal(t, "hourly-20241016T080000Z", toDelete[1].Config.Name)
+	}
+
+	config.RetentionMaxAge = "90m"
+	toDelete = config.SnapshotsToDelete(snapshots, now)
+	assert.Len(t, toDelete, 2)
+
+	config.RetentionMaxAge = "30m"
+	toDelete = config.SnapshotsToDelete(snapshots, now)
+	assert.Len(t, toDelete, 3)
+}
+
+func TestSnapshotPolicy_Schedule(t *testing.T) {
+	now := time.Date(2024, 10, 16, 12, 30, 0, 0, time.UTC)
+	config := &SnapshotPolicyConfig{Name: "hourly", Schedule: "@hourly", RetentionCount: 1, VolumeNames: []string{"v"}}
+
+	policy, err := NewSnapshotPolicy(config, now)
+	assert.NoError(t, err)
+	assert.False(t, policy.IsDue(now))
+	assert.True(t, policy.IsDue(now.Add(30*time.Minute)))
+
+	policy.ScheduleNextRun(now.Add(30 * time.Minute))
+	assert.Equal(t, time.Date(2024, 10, 16, 14, 0, 0, 0, time.UTC), policy.NextRunTime)
+
+	policy.Volumes["v"] = &SnapshotPolicyVolumeStatus{LastSnapshotName: "hourly-20241016T130000Z"}
+	external := policy.ConstructExternal()
+	assert.Equal(t, "2024-10-16T14:00:00Z", external.NextRunTime)
+	assert.Equal(t, "hourly-20241016T130000Z", external.Volumes["v"].LastSnapshotName)
+
+	// The external copy must not share state with the policy
+	external.Config.VolumeNames[0] = "w"
+	external.Volumes["v"].LastError = "failed"
+	assert.Equal(t, "v", policy.Config.VolumeNames[0])
+	assert.Empty(t, policy.Volumes["v"].LastError)
+
+	_, err = NewSnapshotPolicy(&SnapshotPolicyConfig{Name: "bad", Schedule: "bad"}, now)
+	assert.Error(t, err)
+}
+
+func TestSnapshotPolicyPersistent_ConstructSnapshotPolicy(t *testing.T) {
+	now := time.Date(2024, 10, 16, 12, 30, 0, 0, time.UTC)
+	config := &SnapshotPolicyConfig{Name: "hourly", Schedule: "@hourly", RetentionCount: 1, VolumeNames: []string{"v"}}
+
+	policy, err := NewSnapshotPolicy(config, now)
+	assert.NoError(t, err)
+	policy.Volumes["v"] = &SnapshotPolicyVolumeStatus{LastSnapshotName: "hourly-20241016T120000Z"}
+
+	// A restored policy keeps its next run and volume statuses, whenever it is restored
+	restored, err := policy.ConstructPersistent().ConstructSnapshotPolicy(now.Add(24 * time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, policy.NextRunTime, restored.NextRunTime)
+	assert.Equal(t, policy.ConstructExternal(), restored.ConstructExternal())
+
+	restored.Config.VolumeNames[0] = "w"
+	restored.Volumes["v"].LastError = "failed"
+	assert.Equal(t, "v", policy.Config.VolumeNames[0])
+	assert.Empty(t, policy.Volumes["v"].LastError)
+
+	// A policy without a next run is scheduled from the specified time
+	persistent := policy.ConstructPersistent()
+	persistent.NextRunTime = ""
+	restored, err = persistent.ConstructSnapshotPolicy(now.Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2024, 10, 16, 14, 0, 0, 0, time.UTC), restored.NextRunTime)
+
+	persistent.NextRunTime = "soon"
+	_, err = persistent.ConstructSnapshotPolicy(now)
+	assert.Error(t, err)
+
+	_, err = (&SnapshotPolicyPersistent{}).ConstructSnapshotPolicy(now)
+	assert.Error(t, err)
+}
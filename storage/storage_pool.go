@@ -104,6 +104,20 @@ func (p *StoragePool) RemoveStorageClass(class string) bool {
 	return found
 }
 
+// PoolCapacity describes the space backing a storage pool, as reported by its storage driver.
+type PoolCapacity struct {
+	TotalBytes uint64 `json:"totalBytes"`
+	FreeBytes  uint64 `json:"freeBytes"`
+}
+
+// UsedFraction returns the fraction of the pool's total space that is in use, in the range [0, 1].
+func (c *PoolCapacity) UsedFraction() float64 {
+	if c.TotalBytes == 0 || c.FreeBytes >= c.TotalBytes {
+		return 0
+	}
+	return float64(c.TotalBytes-c.FreeBytes) / float64(c.TotalBytes)
+}
+
 type PoolExternal struct {
 	Name           string   `json:"name"`
 	StorageClasses []string `json:"storageClasses"`
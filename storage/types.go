@@ -58,11 +58,15 @@ type Backend interface {
 	ResizeVolume(ctx context.Context, volConfig *VolumeConfig, newSize string) error
 	RenameVolume(ctx context.Context, volConfig *VolumeConfig, newName string) error
 	RemoveVolume(ctx context.Context, volConfig *VolumeConfig) error
+	AddCachedVolume(volume *Volume)
 	RemoveCachedVolume(volumeName string)
 	CanSnapshot(ctx context.Context, snapConfig *SnapshotConfig, volConfig *VolumeConfig) error
 	GetSnapshot(ctx context.Context, snapConfig *SnapshotConfig, volConfig *VolumeConfig) (*Snapshot, error)
 	GetSnapshots(ctx context.Context, volConfig *VolumeConfig) ([]*Snapshot, error)
 	CreateSnapshot(ctx context.Context, snapConfig *SnapshotConfig, volConfig *VolumeConfig) (*Snapshot, error)
+	CreateGroupSnapshot(
+		ctx context.Context, config *GroupSnapshotConfig, snapConfigs []*SnapshotConfig, volConfigs []*VolumeConfig,
+	) ([]*Snapshot, error)
 	RestoreSnapshot(ctx context.Context, snapConfig *SnapshotConfig, volConfig *VolumeConfig) error
 	DeleteSnapshot(ctx context.Context, snapConfig *SnapshotConfig, volConfig *VolumeConfig) error
 	GetUpdateType(ctx context.Context, origBackend Backend) *roaring.Bitmap
@@ -78,6 +82,9 @@ type Backend interface {
 	UpdateMirror(ctx context.Context, localInternalVolumeName, snapshotName string) error
 	CheckMirrorTransferState(ctx context.Context, pvcVolumeName string) (*time.Time, error)
 	GetMirrorTransferTime(ctx context.Context, pvcVolumeName string) (*time.Time, error)
+	GetPoolCapacity(ctx context.Context, pool Pool) (*PoolCapacity, error)
+	GetVolumeHealth(ctx context.Context, volConfig *VolumeConfig) (*VolumeHealth, error)
+	MoveVolume(ctx context.Context, volConfig *VolumeConfig, poolName string) error
 	ChapEnabled
 	PublishEnforceable
 }
@@ -23,6 +23,7 @@ type VolumeConfig struct {
 	SpaceReserve                string                 `json:"spaceReserve"`
 	SecurityStyle               string                 `json:"securityStyle"`
 	SnapshotPolicy              string                 `json:"snapshotPolicy,omitempty"`
+	TieringPolicy               string                 `json:"tieringPolicy,omitempty"`
 	SnapshotReserve             string                 `json:"snapshotReserve,omitempty"`
 	SnapshotDir                 string                 `json:"snapshotDirectory,omitempty"`
 	ExportPolicy                string                 `json:"exportPolicy,omitempty"`
@@ -67,6 +68,8 @@ type VolumeConfig struct {
 	SubordinateVolumes map[string]interface{} `json:"-"`
 	Namespace          string                 `json:"namespace"`
 	RequestName        string                 `json:"requestName"`
+	// Autogrow is the policy by which the volume is grown as it fills, if any
+	Autogrow *AutogrowPolicy `json:"autogrow,omitempty"`
 }
 
 type VolumeCreatingConfig struct {
@@ -76,6 +79,32 @@ type VolumeCreatingConfig struct {
 	VolumeConfig
 }
 
+// VolumeMoveState records how far a move between backends has progressed, so that it may be resumed
+type VolumeMoveState string
+
+const (
+	VolumeMoveStateStarting    = VolumeMoveState("")
+	VolumeMoveStateReplicating = VolumeMoveState("replicating")
+	VolumeMoveStateCuttingOver = VolumeMoveState("cuttingOver")
+	VolumeMoveStateCommitted   = VolumeMoveState("committed")
+)
+
+type VolumeMoveConfig struct {
+	StartTime              time.Time       `json:"startTime"`              // Time this move operation began
+	SourceBackendUUID      string          `json:"sourceBackendUUID"`      // UUID of the backend moved from
+	SourcePool             string          `json:"sourcePool"`             // Name of the pool moved from
+	DestinationBackendUUID string          `json:"destinationBackendUUID"` // UUID of the backend moved to
+	DestinationPool        string          `json:"destinationPool"`        // Name of the pool moved to
+	DestinationConfig      *VolumeConfig   `json:"destinationConfig,omitempty"`
+	State                  VolumeMoveState `json:"state,omitempty"`
+	VolumeConfig                           // Config of the volume being moved, as it was on the source
+}
+
+// IsCrossBackend reports whether the volume is being moved to a different backend
+func (c *VolumeMoveConfig) IsCrossBackend() bool {
+	return c.SourceBackendUUID != c.DestinationBackendUUID
+}
+
 func (c *VolumeConfig) Validate() error {
 	if c.Name == "" || c.Size == "" {
 		return fmt.Errorf("the following fields for \"Volume\" are mandatory: name and size")
@@ -161,6 +190,39 @@ func (s VolumeState) IsSubordinate() bool {
 	return s == VolumeStateSubordinate
 }
 
+// VolumeHealthCondition identifies the condition of a volume on its storage backend.
+type VolumeHealthCondition string
+
+const (
+	VolumeHealthNormal         = VolumeHealthCondition("normal")
+	VolumeHealthMissing        = VolumeHealthCondition("missing")
+	VolumeHealthOffline        = VolumeHealthCondition("offline")
+	VolumeHealthReadOnly       = VolumeHealthCondition("read_only")
+	VolumeHealthMirrorBroken   = VolumeHealthCondition("mirror_broken")
+	VolumeHealthSpaceExhausted = VolumeHealthCondition("space_exhausted")
+)
+
+// VolumeHealth describes the most recently observed condition of a volume on its storage backend.
+type VolumeHealth struct {
+	Condition   VolumeHealthCondition `json:"condition"`
+	Message     string                `json:"message,omitempty"`
+	LastChecked time.Time             `json:"lastChecked"`
+}
+
+// NewVolumeHealth returns a VolumeHealth with the specified condition, checked now.
+func NewVolumeHealth(condition VolumeHealthCondition, message string) *VolumeHealth {
+	return &VolumeHealth{
+		Condition:   condition,
+		Message:     message,
+		LastChecked: time.Now(),
+	}
+}
+
+// IsAbnormal returns true if the volume needs attention.
+func (h *VolumeHealth) IsAbnormal() bool {
+	return h != nil && h.Condition != VolumeHealthNormal
+}
+
 func NewVolume(conf *VolumeConfig, backendUUID, pool string, orphaned bool, state VolumeState) *Volume {
 	return &Volume{
 		Config:      conf,
@@ -229,6 +291,18 @@ func (r *ImportVolumeRequest) Validate() error {
 	return nil
 }
 
+type MoveVolumeRequest struct {
+	Backend string `json:"backend,omitempty"` // The backend to move to; if empty, the volume's current backend
+	Pool    string `json:"pool"`
+}
+
+func (r *MoveVolumeRequest) Validate() error {
+	if r.Pool == "" {
+		return fmt.Errorf("the following field is mandatory: pool")
+	}
+	return nil
+}
+
 type UpgradeVolumeRequest struct {
 	Type   string `json:"type"`
 	Volume string `json:"volume"`
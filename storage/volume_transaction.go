@@ -15,11 +15,13 @@ const (
 
 	// Transactions for long-running operations
 	VolumeCreating VolumeOperation = "volumeCreating"
+	MoveVolume     VolumeOperation = "moveVolume"
 )
 
 type VolumeTransaction struct {
 	Config               *VolumeConfig
 	VolumeCreatingConfig *VolumeCreatingConfig
+	VolumeMoveConfig     *VolumeMoveConfig
 	SnapshotConfig       *SnapshotConfig
 	Op                   VolumeOperation
 }
@@ -34,6 +36,8 @@ func (t *VolumeTransaction) Name() string {
 		return t.SnapshotConfig.ID()
 	case VolumeCreating:
 		return t.VolumeCreatingConfig.Name
+	case MoveVolume:
+		return t.VolumeMoveConfig.Name
 	default:
 		return t.Config.Name
 	}
@@ -38,15 +38,15 @@ func NewBoolOfferFromOffers(offers ...Offer) Offer {
 // Matches is a boolean offer of true matches any request; a boolean offer of false
 // only matches a false request.  This assumes that the requested parameter
 // will be passed into the driver.
-func (o *boolOffer) Matches(r Request) bool {
+func (o *boolOffer) Matches(r Request) MatchResult {
 	br, ok := r.(*boolRequest)
 	if !ok {
-		return false
+		return typeMismatch(boolType, r)
 	}
-	if o.Offer {
-		return true
+	if o.Offer || br.Request == o.Offer {
+		return matched()
 	}
-	return br.Request == o.Offer
+	return notMatched("true was requested but only false is offered")
 }
 
 func (o *boolOffer) String() string {
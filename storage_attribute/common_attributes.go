@@ -55,6 +55,13 @@ const (
 	StoragePools           = "storagePools"
 	AdditionalStoragePools = "additionalStoragePools"
 	ExcludeStoragePools    = "excludeStoragePools"
+	PlacementStrategy      = "placementStrategy"
+	FreezeFilesystem       = "freezeFilesystem"
+
+	// Autogrow policy, set on a storage class and stored with each of its volumes
+	AutogrowThreshold = "autogrowThreshold"
+	AutogrowIncrement = "autogrowIncrement"
+	AutogrowMaxSize   = "autogrowMaxSize"
 )
 
 var attrTypes = map[string]Type{
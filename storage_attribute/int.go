@@ -13,12 +13,15 @@ func NewIntOffer(min, max int) Offer {
 	}
 }
 
-func (o *intOffer) Matches(r Request) bool {
+func (o *intOffer) Matches(r Request) MatchResult {
 	ir, ok := r.(*intRequest)
 	if !ok {
-		return false
+		return typeMismatch(intType, r)
 	}
-	return ir.Request >= o.Min && ir.Request <= o.Max
+	if ir.Request < o.Min || ir.Request > o.Max {
+		return notMatched("%d was requested but only %d to %d is offered", ir.Request, o.Min, o.Max)
+	}
+	return matched()
 }
 
 func (o *intOffer) String() string {
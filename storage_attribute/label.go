@@ -41,7 +41,7 @@ func NewLabelOffer(labelMaps ...map[string]string) Offer {
 	}
 }
 
-func (o *labelOffer) Matches(r Request) bool {
+func (o *labelOffer) Matches(r Request) MatchResult {
 	Log().WithFields(LogFields{
 		"request": r,
 		"offers":  o.Offers,
@@ -50,17 +50,17 @@ func (o *labelOffer) Matches(r Request) bool {
 	// Check that this is a label request
 	request, ok := r.(*labelRequest)
 	if !ok {
-		return false
+		return typeMismatch(labelType, r)
 	}
 
 	// Check that each selector finds a match among the offered labels
 	for _, selector := range request.selectors {
 		if !selector.Matches(*o) {
-			return false
+			return notMatched("offered labels %v do not satisfy selector %s", o.Offers, selector)
 		}
 	}
 
-	return true
+	return matched()
 }
 
 func (o *labelOffer) String() string {
@@ -138,6 +138,7 @@ func (r *labelRequest) String() string {
 // Common interface for the various types of label requests (==, !=, in, notin, exists)
 type labelSelector interface {
 	Matches(offer labelOffer) bool
+	String() string
 }
 
 /////////////////////////////////////////////////////////////////////////////
@@ -173,6 +174,10 @@ func (r *labelEqualRequest) Matches(offer labelOffer) bool {
 	return false
 }
 
+func (r *labelEqualRequest) String() string {
+	return r.labelName + "=" + r.labelValue
+}
+
 /////////////////////////////////////////////////////////////////////////////
 // labelSelector for equality (not equals)
 /////////////////////////////////////////////////////////////////////////////
@@ -206,6 +211,10 @@ func (r *labelNotEqualRequest) Matches(offer labelOffer) bool {
 	return false
 }
 
+func (r *labelNotEqualRequest) String() string {
+	return r.labelName + "!=" + r.labelValue
+}
+
 /////////////////////////////////////////////////////////////////////////////
 // labelSelector for sets (in)
 /////////////////////////////////////////////////////////////////////////////
@@ -253,6 +262,10 @@ func (r *labelInSetRequest) Matches(offer labelOffer) bool {
 	return false
 }
 
+func (r *labelInSetRequest) String() string {
+	return r.labelName + " in (" + strings.Join(r.labelSet, ",") + ")"
+}
+
 /////////////////////////////////////////////////////////////////////////////
 // labelSelector for sets (notin)
 /////////////////////////////////////////////////////////////////////////////
@@ -303,6 +316,10 @@ func (r *labelNotInSetRequest) Matches(offer labelOffer) bool {
 	return true
 }
 
+func (r *labelNotInSetRequest) String() string {
+	return r.labelName + " notin (" + strings.Join(r.labelSet, ",") + ")"
+}
+
 /////////////////////////////////////////////////////////////////////////////
 // labelSelector for sets (exists)
 /////////////////////////////////////////////////////////////////////////////
@@ -336,6 +353,10 @@ func (r *labelExistsRequest) Matches(offer labelOffer) bool {
 	return false
 }
 
+func (r *labelExistsRequest) String() string {
+	return r.labelName
+}
+
 /////////////////////////////////////////////////////////////////////////////
 // labelSelector for sets (not exists)
 /////////////////////////////////////////////////////////////////////////////
@@ -368,3 +389,7 @@ func (r *labelNotExistsRequest) Matches(offer labelOffer) bool {
 	// Found no match in key --> match
 	return true
 }
+
+func (r *labelNotExistsRequest) String() string {
+	return "!" + r.labelName
+}
@@ -45,3 +45,18 @@ func UnmarshalOfferMap(mapJSON json.RawMessage) (map[string]Offer, error) {
 
 	return ret, nil
 }
+
+func matched() MatchResult {
+	return MatchResult{Matched: true}
+}
+
+func notMatched(format string, a ...interface{}) MatchResult {
+	return MatchResult{Matched: false, Reason: fmt.Sprintf(format, a...)}
+}
+
+func typeMismatch(offerType Type, r Request) MatchResult {
+	if r == nil {
+		return notMatched("no value was requested of the %s offer", offerType)
+	}
+	return notMatched("a %s request cannot be satisfied by a %s offer", r.GetType(), offerType)
+}
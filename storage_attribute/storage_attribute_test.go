@@ -141,9 +141,43 @@ func TestMatches(t *testing.T) {
 			true,
 		},
 	} {
-		if test.o.Matches(test.r) != test.expected {
+		result := test.o.Matches(test.r)
+		if result.Matched != test.expected {
 			t.Errorf("Test case %d failed", i)
 		}
+		if result.Matched == (result.Reason != "") {
+			t.Errorf("Test case %d has unexpected reason %q", i, result.Reason)
+		}
+	}
+}
+
+func TestMatchReasons(t *testing.T) {
+	tests := []struct {
+		name     string
+		r        Request
+		o        Offer
+		expected string
+	}{
+		{"bool", NewBoolRequest(true), NewBoolOffer(false), "true was requested but only false is offered"},
+		{"int", NewIntRequest(20), NewIntOffer(5, 10), "20 was requested but only 5 to 10 is offered"},
+		{
+			"string", NewStringRequest("hdd"), NewStringOffer("ssd", "hybrid"),
+			"hdd was requested but only [ssd,hybrid] is offered",
+		},
+		{
+			"label", NewLabelRequestMustCompile("performance in (gold, silver)"),
+			NewLabelOffer(map[string]string{"performance": "bronze"}),
+			"offered labels map[performance:bronze] do not satisfy selector performance in (gold,silver)",
+		},
+		{"type", NewStringRequest("5"), NewIntOffer(5, 10), "a string request cannot be satisfied by a int offer"},
+		{"nil", nil, NewBoolOffer(true), "no value was requested of the bool offer"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := test.o.Matches(test.r)
+			assert.False(t, result.Matched)
+			assert.Equal(t, test.expected, result.Reason)
+		})
 	}
 }
 
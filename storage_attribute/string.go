@@ -36,17 +36,17 @@ func NewStringOfferFromOffers(offers ...Offer) Offer {
 	}
 }
 
-func (o *stringOffer) Matches(r Request) bool {
+func (o *stringOffer) Matches(r Request) MatchResult {
 	sr, ok := r.(*stringRequest)
 	if !ok {
-		return false
+		return typeMismatch(stringType, r)
 	}
 	for _, s := range o.Offers {
 		if s == sr.Request {
-			return true
+			return matched()
 		}
 	}
-	return false
+	return notMatched("%s was requested but only [%s] is offered", sr.Request, strings.Join(o.Offers, ","))
 }
 
 func (o *stringOffer) String() string {
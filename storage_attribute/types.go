@@ -3,10 +3,17 @@
 package storageattribute
 
 type Offer interface {
-	Matches(requested Request) bool
+	Matches(requested Request) MatchResult
 	ToString() string
 }
 
+// MatchResult is the outcome of comparing an offered attribute with a requested one.  If the offer does not
+// satisfy the request, Reason explains why.
+type MatchResult struct {
+	Matched bool   `json:"matched"`
+	Reason  string `json:"reason,omitempty"`
+}
+
 // At the moment, there aren't any terribly useful methods to put here, but
 // there might be.  This is more here for symmetry at the moment.
 type Request interface {
@@ -0,0 +1,102 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package storageclass
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/netapp/trident/logging"
+	"github.com/netapp/trident/storage"
+	"github.com/netapp/trident/utils/errors"
+)
+
+// Capacity describes the space available to new volumes in a set of storage pools.
+type Capacity struct {
+	// AvailableBytes is the total free space across the pools.
+	AvailableBytes uint64 `json:"availableBytes"`
+	// MaximumVolumeBytes is the largest volume that could be created in any single pool.
+	MaximumVolumeBytes uint64 `json:"maximumVolumeBytes"`
+	// Pools is the number of pools that reported their capacity.
+	Pools int `json:"pools"`
+}
+
+// GetPoolsCapacity aggregates the free space of the supplied pools.  Physical pools of a backend are
+// disjoint, so their free space is summed.  Virtual pools share the backend's physical storage, so only
+// the largest value reported by any of a backend's virtual pools is counted.  Pools whose capacity cannot
+// be determined are ignored; if none of the pools report their capacity, an unsupported error is returned.
+func GetPoolsCapacity(ctx context.Context, pools []storage.Pool) (*Capacity, error) {
+	capacity := &Capacity{}
+	if len(pools) == 0 {
+		return capacity, nil
+	}
+
+	type backendCapacity struct {
+		physicalPools  map[string]bool
+		physicalBytes  uint64
+		virtualBytes   uint64
+		hasVirtualPool bool
+	}
+	backendCapacities := make(map[string]*backendCapacity)
+
+	for _, pool := range pools {
+		backend := pool.Backend()
+		if backend == nil {
+			continue
+		}
+
+		poolCapacity := getPoolCapacity(ctx, pool)
+		if poolCapacity == nil {
+			continue
+		}
+		capacity.Pools++
+
+		bc, ok := backendCapacities[backend.BackendUUID()]
+		if !ok {
+			bc = &backendCapacity{physicalPools: make(map[string]bool)}
+			for _, name := range backend.GetPhysicalPoolNames(ctx) {
+				bc.physicalPools[name] = true
+			}
+			backendCapacities[backend.BackendUUID()] = bc
+		}
+
+		if bc.physicalPools[pool.Name()] {
+			bc.physicalBytes += poolCapacity.FreeBytes
+		} else {
+			bc.hasVirtualPool = true
+			if poolCapacity.FreeBytes > bc.virtualBytes {
+				bc.virtualBytes = poolCapacity.FreeBytes
+			}
+		}
+
+		if poolCapacity.FreeBytes > capacity.MaximumVolumeBytes {
+			capacity.MaximumVolumeBytes = poolCapacity.FreeBytes
+		}
+	}
+
+	if capacity.Pools == 0 {
+		return nil, errors.UnsupportedError(fmt.Sprintf(
+			"none of the %d matching storage pools report their capacity", len(pools)))
+	}
+
+	for _, bc := range backendCapacities {
+		// A virtual pool already accounts for the free space of the physical pools beneath it
+		if bc.hasVirtualPool {
+			if bc.virtualBytes > bc.physicalBytes {
+				capacity.AvailableBytes += bc.virtualBytes
+			} else {
+				capacity.AvailableBytes += bc.physicalBytes
+			}
+		} else {
+			capacity.AvailableBytes += bc.physicalBytes
+		}
+	}
+
+	Logc(ctx).WithFields(LogFields{
+		"pools":              capacity.Pools,
+		"availableBytes":     capacity.AvailableBytes,
+		"maximumVolumeBytes": capacity.MaximumVolumeBytes,
+	}).Debug("Aggregated storage pool capacity.")
+
+	return capacity, nil
+}
@@ -0,0 +1,79 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package storageclass
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	mockstorage "github.com/netapp/trident/mocks/mock_storage"
+	"github.com/netapp/trident/storage"
+	"github.com/netapp/trident/utils/errors"
+)
+
+func TestGetPoolsCapacity(t *testing.T) {
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+
+	newBackend := func(uuid string, physicalPools []string, capacities map[string]*storage.PoolCapacity) []storage.Pool {
+		backend := mockstorage.NewMockBackend(mockCtrl)
+		backend.EXPECT().Name().Return(uuid).AnyTimes()
+		backend.EXPECT().BackendUUID().Return(uuid).AnyTimes()
+		backend.EXPECT().GetPhysicalPoolNames(gomock.Any()).Return(physicalPools).AnyTimes()
+
+		backend.EXPECT().GetPoolCapacity(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, pool storage.Pool) (*storage.PoolCapacity, error) {
+				if capacity := capacities[pool.Name()]; capacity != nil {
+					return capacity, nil
+				}
+				return nil, fmt.Errorf("unsupported")
+			}).AnyTimes()
+
+		pools := make([]storage.Pool, 0)
+		for name := range capacities {
+			pool := mockstorage.NewMockPool(mockCtrl)
+			pool.EXPECT().Name().Return(name).AnyTimes()
+			pool.EXPECT().Backend().Return(backend).AnyTimes()
+			pools = append(pools, pool)
+		}
+		return pools
+	}
+
+	// Physical pools are summed
+	physical := newBackend("physical", []string{"aggr1", "aggr2"}, map[string]*storage.PoolCapacity{
+		"aggr1": {TotalBytes: 1000, FreeBytes: 400},
+		"aggr2": {TotalBytes: 1000, FreeBytes: 600},
+	})
+	// Virtual pools overlap, so only the largest is counted
+	virtual := newBackend("virtual", []string{"aggr1"}, map[string]*storage.PoolCapacity{
+		"virtual-pool-0": {TotalBytes: 5000, FreeBytes: 2500},
+		"virtual-pool-1": {TotalBytes: 5000, FreeBytes: 2500},
+	})
+	// Pools of unknown capacity are ignored
+	unknown := newBackend("unknown", []string{"pool"}, map[string]*storage.PoolCapacity{"pool": nil})
+
+	capacity, err := GetPoolsCapacity(ctx, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, &Capacity{}, capacity)
+
+	capacity, err = GetPoolsCapacity(ctx, physical)
+	assert.NoError(t, err)
+	assert.Equal(t, &Capacity{AvailableBytes: 1000, MaximumVolumeBytes: 600, Pools: 2}, capacity)
+
+	capacity, err = GetPoolsCapacity(ctx, virtual)
+	assert.NoError(t, err)
+	assert.Equal(t, &Capacity{AvailableBytes: 2500, MaximumVolumeBytes: 2500, Pools: 2}, capacity)
+
+	pools := append(append(append([]storage.Pool{}, physical...), virtual...), unknown...)
+	capacity, err = GetPoolsCapacity(ctx, pools)
+	assert.NoError(t, err)
+	assert.Equal(t, &Capacity{AvailableBytes: 3500, MaximumVolumeBytes: 2500, Pools: 4}, capacity)
+
+	capacity, err = GetPoolsCapacity(ctx, unknown)
+	assert.Nil(t, capacity)
+	assert.True(t, errors.IsUnsupportedError(err))
+}
@@ -18,6 +18,8 @@ func (c *Config) UnmarshalJSON(data []byte) error {
 		RequiredStorage map[string][]string `json:"requiredStorage,omitempty"`
 		AdditionalPools map[string][]string `json:"additionalStoragePools,omitempty"`
 		ExcludePools    map[string][]string `json:"excludeStoragePools,omitempty"`
+		Placement       string              `json:"placementStrategy,omitempty"`
+		FreezeFS        bool                `json:"freezeFilesystem,omitempty"`
 	}
 	err := json.Unmarshal(data, &tmp)
 	if err != nil {
@@ -36,6 +38,8 @@ func (c *Config) UnmarshalJSON(data []byte) error {
 	}
 
 	c.ExcludePools = tmp.ExcludePools
+	c.Placement = tmp.Placement
+	c.FreezeFS = tmp.FreezeFS
 
 	return err
 }
@@ -49,12 +53,16 @@ func (c *Config) MarshalJSON() ([]byte, error) {
 		Pools           map[string][]string `json:"storagePools,omitempty"`
 		AdditionalPools map[string][]string `json:"additionalStoragePools,omitempty"`
 		ExcludePools    map[string][]string `json:"excludeStoragePools,omitempty"`
+		Placement       string              `json:"placementStrategy,omitempty"`
+		FreezeFS        bool                `json:"freezeFilesystem,omitempty"`
 	}
 	tmp.Version = c.Version
 	tmp.Name = c.Name
 	tmp.Pools = c.Pools
 	tmp.AdditionalPools = c.AdditionalPools
 	tmp.ExcludePools = c.ExcludePools
+	tmp.Placement = c.Placement
+	tmp.FreezeFS = c.FreezeFS
 	// TODO (agagan): The below function MarshalRequestMap always return a positive response.
 	//  The negative use case is not covered in the unit test.
 	attrs, err := storageattribute.MarshalRequestMap(c.Attributes)
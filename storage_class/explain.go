@@ -0,0 +1,223 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package storageclass
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/netapp/trident/config"
+	. "github.com/netapp/trident/logging"
+	"github.com/netapp/trident/storage"
+)
+
+// PoolCheckType identifies one of the checks that decide whether a storage pool may hold a new volume.
+type PoolCheckType string
+
+const (
+	PoolCheckBackendState    = PoolCheckType("backendState")
+	PoolCheckExcludePools    = PoolCheckType("excludeStoragePools")
+	PoolCheckAdditionalPools = PoolCheckType("additionalStoragePools")
+	PoolCheckAttribute       = PoolCheckType("attribute")
+	PoolCheckPools           = PoolCheckType("storagePools")
+	PoolCheckProtocol        = PoolCheckType("protocol")
+	PoolCheckMirroring       = PoolCheckType("mirroring")
+	PoolCheckTopology        = PoolCheckType("topology")
+	PoolCheckNASType         = PoolCheckType("nasType")
+	PoolCheckCapacity        = PoolCheckType("capacity")
+)
+
+// PoolCheck is the outcome of one placement check of a storage pool.  Attribute names the storage class
+// attribute for attribute checks.
+type PoolCheck struct {
+	Check     PoolCheckType `json:"check"`
+	Attribute string        `json:"attribute,omitempty"`
+	Passed    bool          `json:"passed"`
+	Reason    string        `json:"reason,omitempty"`
+}
+
+// PoolMatch is the outcome of comparing a storage pool with a storage class.
+type PoolMatch struct {
+	Matched bool
+	Checks  []PoolCheck
+}
+
+func (m *PoolMatch) addCheck(check PoolCheckType, attribute string, passed bool, reason string) {
+	m.Checks = append(m.Checks, PoolCheck{Check: check, Attribute: attribute, Passed: passed, Reason: reason})
+}
+
+// PlacementRequest describes the volume whose placement is explained.  The zero value stands for any volume
+// of the storage class, so only the storage class and backend state are checked.
+type PlacementRequest struct {
+	Volume              string
+	Protocol            config.Protocol
+	AccessMode          config.AccessMode
+	RequisiteTopologies []map[string]string
+	PreferredTopologies []map[string]string
+	RequestedBytes      uint64
+	MirrorDestination   bool
+}
+
+// PoolPlacement explains whether a storage pool would be tried for a new volume, and if so, in what order.
+type PoolPlacement struct {
+	Backend     string      `json:"backend"`
+	BackendUUID string      `json:"backendUUID"`
+	Pool        string      `json:"pool"`
+	Eligible    bool        `json:"eligible"`
+	Order       int         `json:"order,omitempty"` // Position among the eligible pools, starting at 1
+	Checks      []PoolCheck `json:"checks"`
+}
+
+// FailedChecks returns the checks that rejected the pool.
+func (p *PoolPlacement) FailedChecks() []PoolCheck {
+	failed := make([]PoolCheck, 0)
+	for _, check := range p.Checks {
+		if !check.Passed {
+			failed = append(failed, check)
+		}
+	}
+	return failed
+}
+
+// PlacementExplanation lists every candidate pool for a storage class, or for a volume request, with the checks
+// that accepted or rejected it.  Eligible pools come first, in the order they would be tried, followed by the
+// rejected pools sorted by backend and pool name.  The order of eligible pools may vary between calls for
+// placement strategies with a random element.
+type PlacementExplanation struct {
+	StorageClass      string            `json:"storageClass"`
+	Volume            string            `json:"volume,omitempty"`
+	Protocol          config.Protocol   `json:"protocol"`
+	PlacementStrategy PlacementStrategy `json:"placementStrategy"`
+	Pools             []*PoolPlacement  `json:"pools"`
+}
+
+// ExplainPlacement applies the storage class matcher and the placement filters used when creating a volume to
+// every pool of the supplied backends, without creating anything.
+func (s *StorageClass) ExplainPlacement(
+	ctx context.Context, backends []storage.Backend, request *PlacementRequest,
+) *PlacementExplanation {
+	if request == nil {
+		request = &PlacementRequest{}
+	}
+	protocol := request.Protocol
+	if protocol == "" {
+		protocol = config.ProtocolAny
+	}
+	nasType := requestedNASType(s.GetAttributes())
+
+	explanation := &PlacementExplanation{
+		StorageClass:      s.GetName(),
+		Volume:            request.Volume,
+		Protocol:          protocol,
+		PlacementStrategy: s.GetPlacementStrategy(),
+		Pools:             make([]*PoolPlacement, 0),
+	}
+
+	eligiblePools := make([]storage.Pool, 0)
+	placements := make(map[storage.Pool]*PoolPlacement)
+	rejected := make([]*PoolPlacement, 0)
+
+	for _, backend := range backends {
+		for _, pool := range backend.Storage() {
+			placement := &PoolPlacement{
+				Backend:     backend.Name(),
+				BackendUUID: backend.BackendUUID(),
+				Pool:        pool.Name(),
+				Checks:      make([]PoolCheck, 0),
+			}
+			match := &PoolMatch{Checks: placement.Checks}
+
+			if backend.State().IsOnline() {
+				match.addCheck(PoolCheckBackendState, "", true, "")
+			} else {
+				match.addCheck(PoolCheckBackendState, "", false,
+					fmt.Sprintf("backend is %s", backend.State()))
+			}
+
+			classMatch := s.MatchPool(ctx, pool)
+			match.Checks = append(match.Checks, classMatch.Checks...)
+
+			supported, reason := checkPoolProtocol(ctx, pool, protocol, request.AccessMode)
+			match.addCheck(PoolCheckProtocol, "", supported, reason)
+
+			if request.MirrorDestination {
+				if backend.CanMirror() {
+					match.addCheck(PoolCheckMirroring, "", true, "")
+				} else {
+					match.addCheck(PoolCheckMirroring, "", false,
+						"mirror destinations can only be placed on mirroring enabled backends")
+				}
+			}
+
+			if len(request.RequisiteTopologies) > 0 {
+				if isPoolInRequisiteTopologies(pool, request.RequisiteTopologies) {
+					match.addCheck(PoolCheckTopology, "", true, "")
+				} else {
+					match.addCheck(PoolCheckTopology, "", false, fmt.Sprintf(
+						"pool topologies %v do not support any requisite topology", pool.SupportedTopologies()))
+				}
+			}
+
+			if nasType != "" {
+				matches, nasReason := checkPoolNASType(pool, nasType)
+				match.addCheck(PoolCheckNASType, "", matches, nasReason)
+			}
+
+			if request.RequestedBytes > 0 {
+				match.Checks = append(match.Checks, checkPoolCapacity(ctx, pool, request.RequestedBytes))
+			}
+
+			placement.Checks = match.Checks
+			placement.Eligible = classMatch.Matched && len(placement.FailedChecks()) == 0
+			if placement.Eligible {
+				eligiblePools = append(eligiblePools, pool)
+				placements[pool] = placement
+			} else {
+				rejected = append(rejected, placement)
+			}
+		}
+	}
+
+	orderedPools := SortPoolsByPreferredTopologiesWithStrategy(ctx, eligiblePools, request.PreferredTopologies,
+		explanation.PlacementStrategy, request.RequestedBytes,
+		GetPoolCapacities(ctx, eligiblePools, explanation.PlacementStrategy))
+	for i, pool := range orderedPools {
+		placement := placements[pool]
+		placement.Order = i + 1
+		explanation.Pools = append(explanation.Pools, placement)
+	}
+
+	sort.Slice(rejected, func(i, j int) bool {
+		if rejected[i].Backend != rejected[j].Backend {
+			return rejected[i].Backend < rejected[j].Backend
+		}
+		return rejected[i].Pool < rejected[j].Pool
+	})
+	explanation.Pools = append(explanation.Pools, rejected...)
+
+	Logc(ctx).WithFields(LogFields{
+		"storageClass": s.GetName(),
+		"volume":       request.Volume,
+		"eligible":     len(orderedPools),
+		"rejected":     len(rejected),
+	}).Debug("Explained storage pool placement.")
+
+	return explanation
+}
+
+// checkPoolCapacity checks whether the pool has room for the requested size.  Pools whose capacity cannot be
+// determined pass, since volume creation will still be attempted on them.
+func checkPoolCapacity(ctx context.Context, pool storage.Pool, requestedBytes uint64) PoolCheck {
+	check := PoolCheck{Check: PoolCheckCapacity, Passed: true}
+
+	capacity := getPoolCapacity(ctx, pool)
+	if capacity == nil {
+		check.Reason = "pool capacity is not reported"
+	} else if capacity.FreeBytes < requestedBytes {
+		check.Passed = false
+		check.Reason = fmt.Sprintf("%d bytes were requested but only %d bytes are free", requestedBytes,
+			capacity.FreeBytes)
+	}
+	return check
+}
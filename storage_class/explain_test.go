@@ -0,0 +1,185 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package storageclass
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/netapp/trident/config"
+	mockstorage "github.com/netapp/trident/mocks/mock_storage"
+	"github.com/netapp/trident/storage"
+	sa "github.com/netapp/trident/storage_attribute"
+)
+
+const gib = 1024 * 1024 * 1024
+
+func newExplainTestBackend(
+	mockCtrl *gomock.Controller, name string, state storage.BackendState, protocol config.Protocol,
+	backendType string, freeBytes map[string]uint64,
+) *mockstorage.MockBackend {
+	backend := mockstorage.NewMockBackend(mockCtrl)
+	backend.EXPECT().Name().Return(name).AnyTimes()
+	backend.EXPECT().BackendUUID().Return(name + "-uuid").AnyTimes()
+	backend.EXPECT().State().Return(state).AnyTimes()
+	backend.EXPECT().GetProtocol(gomock.Any()).Return(protocol).AnyTimes()
+
+	pools := make(map[string]storage.Pool)
+	for poolName := range freeBytes {
+		pool := mockstorage.NewMockPool(mockCtrl)
+		pool.EXPECT().Name().Return(poolName).AnyTimes()
+		pool.EXPECT().Backend().Return(backend).AnyTimes()
+		pool.EXPECT().Attributes().Return(map[string]sa.Offer{
+			sa.BackendType: sa.NewStringOffer(backendType),
+		}).AnyTimes()
+		pool.EXPECT().SupportedTopologies().Return(nil).AnyTimes()
+		pools[poolName] = pool
+	}
+	backend.EXPECT().GetPoolCapacity(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, pool storage.Pool) (*storage.PoolCapacity, error) {
+			return &storage.PoolCapacity{TotalBytes: 100 * gib, FreeBytes: freeBytes[pool.Name()]}, nil
+		}).AnyTimes()
+	backend.EXPECT().Storage().Return(pools).AnyTimes()
+	backend.EXPECT().Volumes().Return(map[string]*storage.Volume{}).AnyTimes()
+	return backend
+}
+
+func failedCheckTypes(placement *PoolPlacement) []PoolCheckType {
+	checks := make([]PoolCheckType, 0)
+	for _, check := range placement.FailedChecks() {
+		checks = append(checks, check.Check)
+	}
+	return checks
+}
+
+func TestExplainPlacement(t *testing.T) {
+	ctx := context.TODO()
+	mockCtrl := gomock.NewController(t)
+
+	nas := newExplainTestBackend(mockCtrl, "nas", storage.Online, config.File, "ontap-nas",
+		map[string]uint64{"roomy": 50 * gib, "full": 1 * gib})
+	offline := newExplainTestBackend(mockCtrl, "offline", storage.Offline, config.File, "ontap-nas",
+		map[string]uint64{"idle": 50 * gib})
+	san := newExplainTestBackend(mockCtrl, "san", storage.Online, config.Block, "ontap-san",
+		map[string]uint64{"lun": 50 * gib})
+
+	sc := New(&Config{
+		Name: "gold",
+		Attributes: map[string]sa.Request{
+			sa.BackendType: sa.NewStringRequest("ontap-nas"),
+		},
+	})
+
+	explanation := sc.ExplainPlacement(ctx, []storage.Backend{nas, offline, san}, &PlacementRequest{
+		Volume:         "vol1",
+		Protocol:       config.File,
+		AccessMode:     config.ReadWriteMany,
+		RequestedBytes: 10 * gib,
+	})
+
+	assert.Equal(t, "gold", explanation.StorageClass)
+	assert.Equal(t, "vol1", explanation.Volume)
+	assert.Equal(t, config.File, explanation.Protocol)
+	assert.Equal(t, PlacementRandom, explanation.PlacementStrategy)
+
+	if assert.Len(t, explanation.Pools, 4) {
+		roomy := explanation.Pools[0]
+		assert.Equal(t, "roomy", roomy.Pool)
+		assert.True(t, roomy.Eligible)
+		assert.Equal(t, 1, roomy.Order)
+		assert.Empty(t, roomy.FailedChecks())
+
+		// Rejected pools follow, sorted by backend and pool name
+		full := explanation.Pools[1]
+		assert.Equal(t, "full", full.Pool)
+		assert.False(t, full.Eligible)
+		assert.Zero(t, full.Order)
+		assert.Equal(t, []PoolCheckType{PoolCheckCapacity}, failedCheckTypes(full))
+		assert.Equal(t, fmt.Sprintf("%d bytes were requested but only %d bytes are free", 10*gib, 1*gib),
+			full.FailedChecks()[0].Reason)
+
+		idle := explanation.Pools[2]
+		assert.Equal(t, "offline", idle.Backend)
+		assert.Equal(t, []PoolCheckType{PoolCheckBackendState}, failedCheckTypes(idle))
+		assert.Equal(t, "backend is offline", idle.FailedChecks()[0].Reason)
+
+		lun := explanation.Pools[3]
+		assert.Equal(t, "san-uuid", lun.BackendUUID)
+		assert.Equal(t, []PoolCheckType{PoolCheckAttribute, PoolCheckProtocol}, failedCheckTypes(lun))
+		assert.Equal(t, sa.BackendType, lun.FailedChecks()[0].Attribute)
+		assert.Equal(t, "ontap-nas was requested but only [ontap-san] is offered", lun.FailedChecks()[0].Reason)
+		assert.Equal(t, "file was requested but the backend offers block", lun.FailedChecks()[1].Reason)
+	}
+
+	// Without a volume request, only the storage class and backend state are checked
+	explanation = sc.ExplainPlacement(ctx, []storage.Backend{nas, san}, nil)
+	assert.Equal(t, config.ProtocolAny, explanation.Protocol)
+	if assert.Len(t, explanation.Pools, 3) {
+		assert.True(t, explanation.Pools[0].Eligible)
+		assert.True(t, explanation.Pools[1].Eligible)
+		assert.ElementsMatch(t, []string{"roomy", "full"},
+			[]string{explanation.Pools[0].Pool, explanation.Pools[1].Pool})
+		assert.Equal(t, []PoolCheckType{PoolCheckAttribute}, failedCheckTypes(explanation.Pools[2]))
+	}
+}
+
+func TestMatchPool(t *testing.T) {
+	ctx := context.TODO()
+	mockCtrl := gomock.NewController(t)
+
+	pool, _ := getFakeStoragePool(mockCtrl, "pool1", "backend1", "ontap-nas", "sc1", nil)
+
+	tests := []struct {
+		name     string
+		config   *Config
+		matched  bool
+		expected []PoolCheck
+	}{
+		{
+			"excluded",
+			&Config{Name: "sc1", ExcludePools: map[string][]string{"backend1": {"pool1"}}},
+			false,
+			[]PoolCheck{{Check: PoolCheckExcludePools, Reason: "pool is listed in excludeStoragePools"}},
+		},
+		{
+			"additional",
+			&Config{
+				Name:            "sc1",
+				Attributes:      map[string]sa.Request{sa.BackendType: sa.NewStringRequest("ontap-san")},
+				AdditionalPools: map[string][]string{"backend1": {"pool1"}},
+			},
+			true,
+			[]PoolCheck{
+				{Check: PoolCheckAdditionalPools, Passed: true, Reason: "pool is listed in additionalStoragePools"},
+			},
+		},
+		{
+			"attributes and pools",
+			&Config{
+				Name: "sc1",
+				Attributes: map[string]sa.Request{
+					sa.BackendType: sa.NewStringRequest("ontap-nas"),
+					sa.Media:       sa.NewStringRequest("ssd"),
+				},
+				Pools: map[string][]string{"backend2": {"pool1"}},
+			},
+			false,
+			[]PoolCheck{
+				{Check: PoolCheckAttribute, Attribute: sa.BackendType, Passed: true},
+				{Check: PoolCheckAttribute, Attribute: sa.Media, Reason: "pool does not offer media"},
+				{Check: PoolCheckPools, Reason: "pool is not listed in storagePools"},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			match := New(test.config).MatchPool(ctx, pool)
+			assert.Equal(t, test.matched, match.Matched)
+			assert.Equal(t, test.expected, match.Checks)
+		})
+	}
+}
@@ -0,0 +1,240 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package storageclass
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+
+	. "github.com/netapp/trident/logging"
+	"github.com/netapp/trident/storage"
+)
+
+// PlacementStrategy determines the order in which the matching pools of a storage class are tried
+// when a new volume is created.
+type PlacementStrategy string
+
+const (
+	// PlacementRandom shuffles the pools, which was the only available behavior before strategies were added.
+	PlacementRandom = PlacementStrategy("random")
+	// PlacementLeastUsed prefers the pools with the lowest fraction of used space.
+	PlacementLeastUsed = PlacementStrategy("leastUsed")
+	// PlacementSpread prefers the pools holding the fewest Trident volumes.
+	PlacementSpread = PlacementStrategy("spread")
+	// PlacementBinPack prefers the fullest pools that can still fit the requested volume.
+	PlacementBinPack = PlacementStrategy("binPack")
+	// PlacementWeighted shuffles the pools with a probability proportional to their free space.
+	PlacementWeighted = PlacementStrategy("weighted")
+
+	DefaultPlacementStrategy = PlacementRandom
+)
+
+var placementStrategies = []PlacementStrategy{
+	PlacementRandom, PlacementLeastUsed, PlacementSpread, PlacementBinPack, PlacementWeighted,
+}
+
+// ParsePlacementStrategy returns the placement strategy matching the supplied value, ignoring case.  An empty
+// value yields the default strategy.
+func ParsePlacementStrategy(value string) (PlacementStrategy, error) {
+	if value == "" {
+		return DefaultPlacementStrategy, nil
+	}
+	for _, strategy := range placementStrategies {
+		if strings.EqualFold(value, string(strategy)) {
+			return strategy, nil
+		}
+	}
+
+	validStrategies := make([]string, 0, len(placementStrategies))
+	for _, strategy := range placementStrategies {
+		validStrategies = append(validStrategies, string(strategy))
+	}
+	return "", fmt.Errorf("invalid pool placement strategy '%s'; valid values are: %s", value,
+		strings.Join(validStrategies, ", "))
+}
+
+// needsCapacity returns true if the strategy orders pools by their reported capacity.
+func (p PlacementStrategy) needsCapacity() bool {
+	switch p {
+	case PlacementLeastUsed, PlacementBinPack, PlacementWeighted:
+		return true
+	default:
+		return false
+	}
+}
+
+// poolPlacementInfo caches the data needed to rank a single pool.
+type poolPlacementInfo struct {
+	pool        storage.Pool
+	capacity    *storage.PoolCapacity // nil if the driver cannot report it
+	volumeCount int
+}
+
+// getPoolCapacity queries the pool's backend for the pool's capacity, returning nil if it is unavailable.
+func getPoolCapacity(ctx context.Context, pool storage.Pool) *storage.PoolCapacity {
+	backend := pool.Backend()
+	if backend == nil {
+		return nil
+	}
+
+	capacity, err := backend.GetPoolCapacity(ctx, pool)
+	if err != nil {
+		Logc(ctx).WithFields(LogFields{
+			"pool":    pool.Name(),
+			"backend": backend.Name(),
+		}).WithError(err).Debug("Could not get storage pool capacity.")
+		return nil
+	}
+	return capacity
+}
+
+// PoolCapacities holds the capacity reported by each of a set of pools.  Pools that are absent, or whose capacity
+// is nil, could not report it.
+type PoolCapacities map[storage.Pool]*storage.PoolCapacity
+
+// GetPoolCapacities queries the backends of the pools for their capacity, if the placement strategy orders pools
+// by it.  Backends may be slow to answer, so this is done ahead of ordering the pools, without holding any locks.
+func GetPoolCapacities(ctx context.Context, pools []storage.Pool, strategy PlacementStrategy) PoolCapacities {
+	capacities := make(PoolCapacities, len(pools))
+	if !strategy.needsCapacity() {
+		return capacities
+	}
+	for _, pool := range pools {
+		capacities[pool] = getPoolCapacity(ctx, pool)
+	}
+	return capacities
+}
+
+// countPoolVolumes returns the number of volumes the pool's backend has placed on the pool.
+func countPoolVolumes(pool storage.Pool) int {
+	backend := pool.Backend()
+	if backend == nil {
+		return 0
+	}
+
+	count := 0
+	for _, volume := range backend.Volumes() {
+		if volume.Pool == pool.Name() {
+			count++
+		}
+	}
+	return count
+}
+
+// OrderPoolsByPlacementStrategy returns a copy of the supplied pools ordered according to the placement strategy.
+// Pools whose capacity is not among those supplied are placed after all pools with a known capacity, in random
+// order, when the strategy relies on capacity data.  The requested size is only used by the bin-pack strategy.
+func OrderPoolsByPlacementStrategy(
+	ctx context.Context, pools []storage.Pool, strategy PlacementStrategy, requestedBytes uint64,
+	capacities PoolCapacities,
+) []storage.Pool {
+	if len(pools) == 0 {
+		return make([]storage.Pool, 0)
+	}
+
+	infos := make([]*poolPlacementInfo, 0, len(pools))
+	for _, pool := range pools {
+		info := &poolPlacementInfo{pool: pool}
+		if strategy.needsCapacity() {
+			info.capacity = capacities[pool]
+		}
+		if strategy == PlacementSpread {
+			info.volumeCount = countPoolVolumes(pool)
+		}
+		infos = append(infos, info)
+	}
+
+	// Shuffle first so that ties are broken randomly by the stable sorts below
+	rand.Shuffle(len(infos), func(i, j int) {
+		infos[i], infos[j] = infos[j], infos[i]
+	})
+
+	switch strategy {
+	case PlacementLeastUsed:
+		sort.SliceStable(infos, func(i, j int) bool {
+			if infos[i].capacity == nil || infos[j].capacity == nil {
+				return infos[j].capacity == nil && infos[i].capacity != nil
+			}
+			return infos[i].capacity.UsedFraction() < infos[j].capacity.UsedFraction()
+		})
+	case PlacementSpread:
+		sort.SliceStable(infos, func(i, j int) bool {
+			return infos[i].volumeCount < infos[j].volumeCount
+		})
+	case PlacementBinPack:
+		sort.SliceStable(infos, func(i, j int) bool {
+			return binPackLess(infos[i], infos[j], requestedBytes)
+		})
+	case PlacementWeighted:
+		infos = weightedShuffle(infos)
+	}
+
+	orderedPools := make([]storage.Pool, 0, len(infos))
+	for _, info := range infos {
+		orderedPools = append(orderedPools, info.pool)
+	}
+
+	Logc(ctx).WithFields(LogFields{
+		"strategy": strategy,
+		"pools":    len(orderedPools),
+	}).Trace("Ordered storage pools by placement strategy.")
+
+	return orderedPools
+}
+
+// binPackLess orders pools that can fit the request by ascending free space, followed by pools that cannot fit
+// the request by descending free space, followed by pools of unknown capacity.
+func binPackLess(a, b *poolPlacementInfo, requestedBytes uint64) bool {
+	if a.capacity == nil || b.capacity == nil {
+		return b.capacity == nil && a.capacity != nil
+	}
+
+	aFits := a.capacity.FreeBytes >= requestedBytes
+	bFits := b.capacity.FreeBytes >= requestedBytes
+	if aFits != bFits {
+		return aFits
+	}
+	if aFits {
+		return a.capacity.FreeBytes < b.capacity.FreeBytes
+	}
+	return a.capacity.FreeBytes > b.capacity.FreeBytes
+}
+
+// weightedShuffle orders pools of known capacity randomly, with each pool's chance of appearing earlier proportional
+// to its free space (Efraimidis-Spirakis sampling).  Pools of unknown capacity follow in their existing order.
+func weightedShuffle(infos []*poolPlacementInfo) []*poolPlacementInfo {
+	type weightedInfo struct {
+		info *poolPlacementInfo
+		key  float64
+	}
+
+	weighted := make([]weightedInfo, 0, len(infos))
+	unknown := make([]*poolPlacementInfo, 0)
+
+	for _, info := range infos {
+		if info.capacity == nil {
+			unknown = append(unknown, info)
+			continue
+		}
+		// Use the logarithm of u^(1/w) to avoid losing precision with weights measured in bytes
+		key := math.Inf(-1)
+		if info.capacity.FreeBytes > 0 {
+			key = math.Log(rand.Float64()) / float64(info.capacity.FreeBytes)
+		}
+		weighted = append(weighted, weightedInfo{info: info, key: key})
+	}
+
+	sort.SliceStable(weighted, func(i, j int) bool {
+		return weighted[i].key > weighted[j].key
+	})
+
+	ordered := make([]*poolPlacementInfo, 0, len(infos))
+	for _, w := range weighted {
+		ordered = append(ordered, w.info)
+	}
+	return append(ordered, unknown...)
+}
@@ -0,0 +1,174 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package storageclass
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	mockstorage "github.com/netapp/trident/mocks/mock_storage"
+	"github.com/netapp/trident/storage"
+)
+
+func newPlacementTestPool(
+	mockCtrl *gomock.Controller, name string, capacity *storage.PoolCapacity, volumeCount int,
+) storage.Pool {
+	backend := mockstorage.NewMockBackend(mockCtrl)
+	backend.EXPECT().Name().Return("backend-" + name).AnyTimes()
+	if capacity != nil {
+		backend.EXPECT().GetPoolCapacity(gomock.Any(), gomock.Any()).Return(capacity, nil).AnyTimes()
+	} else {
+		backend.EXPECT().GetPoolCapacity(gomock.Any(), gomock.Any()).Return(nil,
+			fmt.Errorf("unsupported")).AnyTimes()
+	}
+
+	volumes := make(map[string]*storage.Volume)
+	for i := 0; i < volumeCount; i++ {
+		volumes[fmt.Sprintf("%s-vol%d", name, i)] = &storage.Volume{Pool: name}
+	}
+	volumes["other"] = &storage.Volume{Pool: "other"}
+	backend.EXPECT().Volumes().Return(volumes).AnyTimes()
+
+	pool := mockstorage.NewMockPool(mockCtrl)
+	pool.EXPECT().Name().Return(name).AnyTimes()
+	pool.EXPECT().Backend().Return(backend).AnyTimes()
+	return pool
+}
+
+func poolNames(pools []storage.Pool) []string {
+	names := make([]string, 0, len(pools))
+	for _, pool := range pools {
+		names = append(names, pool.Name())
+	}
+	return names
+}
+
+func TestParsePlacementStrategy(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected PlacementStrategy
+		isError  bool
+	}{
+		{"", PlacementRandom, false},
+		{"random", PlacementRandom, false},
+		{"leastUsed", PlacementLeastUsed, false},
+		{"leastused", PlacementLeastUsed, false},
+		{"spread", PlacementSpread, false},
+		{"BinPack", PlacementBinPack, false},
+		{"weighted", PlacementWeighted, false},
+		{"roundRobin", "", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			strategy, err := ParsePlacementStrategy(test.value)
+			if test.isError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, strategy)
+			}
+		})
+	}
+}
+
+func TestGetPlacementStrategy(t *testing.T) {
+	assert.Equal(t, PlacementRandom, New(&Config{Name: "sc"}).GetPlacementStrategy())
+	assert.Equal(t, PlacementSpread, New(&Config{Name: "sc", Placement: "spread"}).GetPlacementStrategy())
+	assert.Equal(t, PlacementRandom, New(&Config{Name: "sc", Placement: "invalid"}).GetPlacementStrategy())
+}
+
+func TestOrderPoolsByPlacementStrategy(t *testing.T) {
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+
+	pools := []storage.Pool{
+		newPlacementTestPool(mockCtrl, "full", &storage.PoolCapacity{TotalBytes: 1000, FreeBytes: 50}, 1),
+		newPlacementTestPool(mockCtrl, "half", &storage.PoolCapacity{TotalBytes: 1000, FreeBytes: 500}, 5),
+		newPlacementTestPool(mockCtrl, "unknown", nil, 0),
+		newPlacementTestPool(mockCtrl, "empty", &storage.PoolCapacity{TotalBytes: 2000, FreeBytes: 1900}, 3),
+	}
+
+	assert.Empty(t, OrderPoolsByPlacementStrategy(ctx, nil, PlacementLeastUsed, 0, nil))
+
+	capacities := GetPoolCapacities(ctx, pools, PlacementLeastUsed)
+	assert.Len(t, capacities, 4)
+	assert.Nil(t, capacities[pools[2]])
+	assert.Empty(t, GetPoolCapacities(ctx, pools, PlacementSpread))
+
+	ordered := OrderPoolsByPlacementStrategy(ctx, pools, PlacementRandom, 0, capacities)
+	assert.ElementsMatch(t, poolNames(pools), poolNames(ordered))
+
+	ordered = OrderPoolsByPlacementStrategy(ctx, pools, PlacementLeastUsed, 0, capacities)
+	assert.Equal(t, []string{"empty", "half", "full", "unknown"}, poolNames(ordered))
+
+	ordered = OrderPoolsByPlacementStrategy(ctx, pools, PlacementSpread, 0, capacities)
+	assert.Equal(t, []string{"unknown", "full", "empty", "half"}, poolNames(ordered))
+
+	ordered = OrderPoolsByPlacementStrategy(ctx, pools, PlacementBinPack, 100, capacities)
+	assert.Equal(t, []string{"half", "empty", "full", "unknown"}, poolNames(ordered))
+
+	ordered = OrderPoolsByPlacementStrategy(ctx, pools, PlacementBinPack, 0, capacities)
+	assert.Equal(t, []string{"full", "half", "empty", "unknown"}, poolNames(ordered))
+
+	ordered = OrderPoolsByPlacementStrategy(ctx, pools, PlacementWeighted, 0, capacities)
+	assert.ElementsMatch(t, poolNames(pools), poolNames(ordered))
+	assert.Equal(t, "unknown", ordered[len(ordered)-1].Name())
+}
+
+func TestOrderPoolsByPlacementStrategy_WeightedFavorsFreeSpace(t *testing.T) {
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+
+	pools := []storage.Pool{
+		newPlacementTestPool(mockCtrl, "small", &storage.PoolCapacity{TotalBytes: 1 << 40, FreeBytes: 1 << 30}, 0),
+		newPlacementTestPool(mockCtrl, "large", &storage.PoolCapacity{TotalBytes: 1 << 40, FreeBytes: 99 << 30}, 0),
+		newPlacementTestPool(mockCtrl, "none", &storage.PoolCapacity{TotalBytes: 1 << 40, FreeBytes: 0}, 0),
+	}
+
+	capacities := GetPoolCapacities(ctx, pools, PlacementWeighted)
+	largeFirst := 0
+	for i := 0; i < 200; i++ {
+		ordered := OrderPoolsByPlacementStrategy(ctx, pools, PlacementWeighted, 0, capacities)
+		assert.Equal(t, "none", ordered[2].Name(), "pool without free space should be last")
+		if ordered[0].Name() == "large" {
+			largeFirst++
+		}
+	}
+	assert.Greater(t, largeFirst, 150, "pool with most free space should usually be first")
+}
+
+func TestSortPoolsByPreferredTopologiesWithStrategy(t *testing.T) {
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+
+	preferred := map[string]string{"topology.kubernetes.io/zone": "Z1"}
+	other := map[string]string{"topology.kubernetes.io/zone": "Z2"}
+
+	newPool := func(name string, freeBytes uint64, topology map[string]string) storage.Pool {
+		backend := mockstorage.NewMockBackend(mockCtrl)
+		backend.EXPECT().Name().Return("backend").AnyTimes()
+		backend.EXPECT().GetPoolCapacity(gomock.Any(), gomock.Any()).Return(
+			&storage.PoolCapacity{TotalBytes: 1000, FreeBytes: freeBytes}, nil).AnyTimes()
+		pool := mockstorage.NewMockPool(mockCtrl)
+		pool.EXPECT().Name().Return(name).AnyTimes()
+		pool.EXPECT().Backend().Return(backend).AnyTimes()
+		pool.EXPECT().SupportedTopologies().Return([]map[string]string{topology}).AnyTimes()
+		return pool
+	}
+
+	pools := []storage.Pool{
+		newPool("z2-empty", 900, other),
+		newPool("z1-full", 100, preferred),
+		newPool("z1-empty", 800, preferred),
+		newPool("z2-full", 200, other),
+	}
+
+	ordered := SortPoolsByPreferredTopologiesWithStrategy(ctx, pools, []map[string]string{preferred},
+		PlacementLeastUsed, 0, GetPoolCapacities(ctx, pools, PlacementLeastUsed))
+	assert.Equal(t, []string{"z1-empty", "z1-full", "z2-empty", "z2-full"}, poolNames(ordered))
+}
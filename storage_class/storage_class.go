@@ -6,7 +6,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"math/rand"
 	"regexp"
 	"sort"
 	"strings"
@@ -140,6 +139,13 @@ func (s *StorageClass) regexMatcher(ctx context.Context, storagePool storage.Poo
 }
 
 func (s *StorageClass) Matches(ctx context.Context, storagePool storage.Pool) bool {
+	return s.MatchPool(ctx, storagePool).Matched
+}
+
+// MatchPool compares a storage pool with the storage class, returning whether the pool satisfies the class
+// along with each check that accepted or rejected it.  A pool excluded by excludeStoragePools, or included by
+// additionalStoragePools, is not checked further.
+func (s *StorageClass) MatchPool(ctx context.Context, storagePool storage.Pool) *PoolMatch {
 	Logc(ctx).WithFields(LogFields{
 		"storageClass": s.GetName(),
 		"config":       s.config,
@@ -147,17 +153,23 @@ func (s *StorageClass) Matches(ctx context.Context, storagePool storage.Pool) bo
 		"poolBackend":  storagePool.Backend().Name(),
 	}).Debug("Checking if storage pool matches.")
 
+	match := &PoolMatch{Checks: make([]PoolCheck, 0)}
+
 	// Check excludeStoragePools first, since it can reject a match
 	if len(s.config.ExcludePools) > 0 {
 		if matches := s.regexMatcher(ctx, storagePool, s.config.ExcludePools); matches {
-			return false
+			match.addCheck(PoolCheckExcludePools, "", false, "pool is listed in excludeStoragePools")
+			return match
 		}
+		match.addCheck(PoolCheckExcludePools, "", true, "")
 	}
 
 	// Check additionalStoragePools next, since it can yield a match result by itself
 	if len(s.config.AdditionalPools) > 0 {
 		if matches := s.regexMatcher(ctx, storagePool, s.config.AdditionalPools); matches {
-			return true
+			match.addCheck(PoolCheckAdditionalPools, "", true, "pool is listed in additionalStoragePools")
+			match.Matched = true
+			return match
 		}
 
 		// Handle the sub-case where additionalStoragePools is specified (but didn't match) and
@@ -168,22 +180,37 @@ func (s *StorageClass) Matches(ctx context.Context, storagePool storage.Pool) bo
 				"storageClass": s.GetName(),
 				"pool":         storagePool.Name(),
 			}).Debug("Pool failed to match storage class additionalStoragePools attribute.")
-			return false
+			match.addCheck(PoolCheckAdditionalPools, "", false, "pool is not listed in additionalStoragePools")
+			return match
 		}
 	}
 
 	// Attributes are used to narrow the pool selection.  Therefore if no attributes are
 	// specified, then all pools can match.  If one or more attributes are specified in the
-	// storage class, then all must match.
+	// storage class, then all must match.  Every attribute is checked so that all reasons
+	// for a mismatch are reported.
+	attributeNames := make([]string, 0, len(s.config.Attributes))
+	for name := range s.config.Attributes {
+		attributeNames = append(attributeNames, name)
+	}
+	sort.Strings(attributeNames)
+
 	attributesMatch := true
-	for name, request := range s.config.Attributes {
+	for _, name := range attributeNames {
+		request := s.config.Attributes[name]
 
 		// Remap the "selector" storage class attribute to the "labels" pool attribute
+		offerName := name
 		if name == "selector" {
-			name = "labels"
+			offerName = "labels"
 		}
 
-		if offer, ok := storagePool.Attributes()[name]; !ok || !offer.Matches(request) {
+		offer, ok := storagePool.Attributes()[offerName]
+		result := storageattribute.MatchResult{Reason: fmt.Sprintf("pool does not offer %s", offerName)}
+		if ok {
+			result = offer.Matches(request)
+		}
+		if !result.Matched {
 			Logc(ctx).WithFields(LogFields{
 				"offer":        offer,
 				"request":      request,
@@ -191,10 +218,11 @@ func (s *StorageClass) Matches(ctx context.Context, storagePool storage.Pool) bo
 				"pool":         storagePool.Name(),
 				"attribute":    name,
 				"found":        ok,
+				"reason":       result.Reason,
 			}).Debug("Attribute for storage pool failed to match storage class.")
 			attributesMatch = false
-			break
 		}
+		match.addCheck(PoolCheckAttribute, name, result.Matched, result.Reason)
 	}
 
 	// The storagePools list is used to narrow the pool selection.  Therefore, if no pools are
@@ -203,19 +231,24 @@ func (s *StorageClass) Matches(ctx context.Context, storagePool storage.Pool) bo
 	poolsMatch := true
 	if len(s.config.Pools) > 0 {
 		poolsMatch = s.regexMatcher(ctx, storagePool, s.config.Pools)
+		reason := ""
+		if !poolsMatch {
+			reason = "pool is not listed in storagePools"
+		}
+		match.addCheck(PoolCheckPools, "", poolsMatch, reason)
 	}
 
-	result := attributesMatch && poolsMatch
+	match.Matched = attributesMatch && poolsMatch
 
 	Logc(ctx).WithFields(LogFields{
 		"attributesMatch": attributesMatch,
 		"poolsMatch":      poolsMatch,
-		"match":           result,
+		"match":           match.Matched,
 		"pool":            storagePool.Name(),
 		"storageClass":    s.GetName(),
 	}).Debug("Result of pool match for storage class.")
 
-	return result
+	return match
 }
 
 // CheckAndAddBackend iterates through each of the storage pools
@@ -277,6 +310,16 @@ func (s *StorageClass) GetName() string {
 	return s.config.Name
 }
 
+// GetPlacementStrategy returns the pool placement strategy of the storage class, falling back to the
+// default strategy if none or an invalid one was specified.
+func (s *StorageClass) GetPlacementStrategy() PlacementStrategy {
+	strategy, err := ParsePlacementStrategy(s.config.Placement)
+	if err != nil {
+		return DefaultPlacementStrategy
+	}
+	return strategy
+}
+
 func (s *StorageClass) GetStoragePools() map[string][]string {
 	return s.config.Pools
 }
@@ -291,26 +334,38 @@ func (s *StorageClass) GetStoragePoolsForProtocol(
 	ret := make([]storage.Pool, 0, len(s.pools))
 	// TODO:  Change this to work with indices of backends?
 	for _, storagePool := range s.pools {
-		storagePoolProtocol := storagePool.Backend().GetProtocol(ctx)
-
-		if p == config.ProtocolAny || storagePoolProtocol == p {
-			// TODO (arorar): Remove this check after ROX is disabled for iSCSI (non-raw block) volumes.
-			if storagePoolProtocol == config.BlockOnFile && (accessMode == config.
-				ReadOnlyMany || accessMode == config.ReadWriteMany) {
-				continue
-			}
-
+		if supported, _ := checkPoolProtocol(ctx, storagePool, p, accessMode); supported {
 			ret = append(ret, storagePool)
 		}
+	}
+	return ret
+}
 
-		// AddRawBlockSupportOnBoF: Add below else-if code block to allow raw block volumes on BlockOnFile
-		// Allow only RWO raw-block on Block-On-File
+// checkPoolProtocol returns whether the pool's backend serves volumes of the supplied protocol and access mode,
+// and if not, why.
+func checkPoolProtocol(
+	ctx context.Context, storagePool storage.Pool, p config.Protocol, accessMode config.AccessMode,
+) (bool, string) {
+	storagePoolProtocol := storagePool.Backend().GetProtocol(ctx)
+
+	if p == config.ProtocolAny || storagePoolProtocol == p {
+		// TODO (arorar): Remove this check after ROX is disabled for iSCSI (non-raw block) volumes.
+		if storagePoolProtocol == config.BlockOnFile && (accessMode == config.
+			ReadOnlyMany || accessMode == config.ReadWriteMany) {
+			return false, fmt.Sprintf("%s access is not supported by %s backends", accessMode, storagePoolProtocol)
+		}
 
-		// else if p == config.Block && accessMode == config.ReadWriteOnce && storagePoolProtocol == config.BlockOnFile {
-		//     ret = append(ret, storagePool)
-		// }
+		return true, ""
 	}
-	return ret
+
+	// AddRawBlockSupportOnBoF: Add below else-if code block to allow raw block volumes on BlockOnFile
+	// Allow only RWO raw-block on Block-On-File
+
+	// else if p == config.Block && accessMode == config.ReadWriteOnce && storagePoolProtocol == config.BlockOnFile {
+	//     return true, ""
+	// }
+
+	return false, fmt.Sprintf("%s was requested but the backend offers %s", p, storagePoolProtocol)
 }
 
 // FilterPoolsOnNasType returns pools filtered over nasType SMB. If not found returns the provided pool list as it is.
@@ -340,13 +395,10 @@ func FilterPoolsOnNasType(
 
 	// Filter out pools with non-matching NAS types
 	for _, pool := range pools {
-		nasTypeOffer := pool.Attributes()[storageattribute.NASType]
-		if nasTypeOffer == nil {
-			Logc(ctx).Debug("nasType offer is not present")
-			continue
-		}
-		if strings.ToLower(nasTypeOffer.ToString()) == nasType {
+		if matches, reason := checkPoolNASType(pool, nasType); matches {
 			filteredPools = append(filteredPools, pool)
+		} else {
+			Logc(ctx).WithField("pool", pool.Name()).Debug(reason)
 		}
 	}
 
@@ -354,20 +406,59 @@ func FilterPoolsOnNasType(
 	return filteredPools
 }
 
+// requestedNASType returns the lower-case NAS type requested by the storage class attributes, if any.
+func requestedNASType(scAttributes map[string]storageattribute.Request) string {
+	req := scAttributes[storageattribute.NASType]
+	if req == nil {
+		return ""
+	}
+	nasType, _ := req.Value().(string)
+	return strings.ToLower(nasType)
+}
+
+// checkPoolNASType returns whether the pool offers the supplied lower-case NAS type, and if not, why.
+func checkPoolNASType(pool storage.Pool, nasType string) (bool, string) {
+	if nasType != storageattribute.SMB && nasType != storageattribute.NFS {
+		return false, fmt.Sprintf("nasType %s is not one of %s or %s", nasType, storageattribute.NFS,
+			storageattribute.SMB)
+	}
+	nasTypeOffer := pool.Attributes()[storageattribute.NASType]
+	if nasTypeOffer == nil {
+		return false, "nasType offer is not present"
+	}
+	if offered := strings.ToLower(nasTypeOffer.ToString()); offered != nasType {
+		return false, fmt.Sprintf("%s was requested but the pool offers %s", nasType, offered)
+	}
+	return true, ""
+}
+
 // GetStoragePoolsForProtocolByBackend returns an ordered list of pools, where
-// each pool matches the supplied protocol.
+// each pool matches the supplied protocol.  Pools are ordered first by preferred topology
+// and then by the storage class's placement strategy, using the capacities supplied for
+// those strategies that rely on them.
 func (s *StorageClass) GetStoragePoolsForProtocolByBackend(
 	ctx context.Context, p config.Protocol, requisiteTopologies, preferredTopologies []map[string]string,
-	accessMode config.AccessMode,
+	accessMode config.AccessMode, requestedBytes uint64, capacities PoolCapacities,
 ) []storage.Pool {
-	// Get all matching pools
-	var pools []storage.Pool
+	pools := s.GetCandidateStoragePools(ctx, p, requisiteTopologies, accessMode)
+	pools = SortPoolsByPreferredTopologiesWithStrategy(ctx, pools, preferredTopologies,
+		s.GetPlacementStrategy(), requestedBytes, capacities)
+
+	Logc(ctx).Debugf("Finally got %d storage pools", len(pools))
+
+	return pools
+}
 
+// GetCandidateStoragePools returns the unordered list of pools that match the supplied protocol,
+// requisite topologies and the storage class's NAS type.
+func (s *StorageClass) GetCandidateStoragePools(
+	ctx context.Context, p config.Protocol, requisiteTopologies []map[string]string, accessMode config.AccessMode,
+) []storage.Pool {
 	poolsForProtocol := s.GetStoragePoolsForProtocol(ctx, p, accessMode)
 	if len(poolsForProtocol) == 0 {
 		Logc(ctx).Info("no backend pools support the requisite protocol")
 	}
-	pools = FilterPoolsOnTopology(ctx, poolsForProtocol, requisiteTopologies)
+	pools := FilterPoolsOnTopology(ctx, poolsForProtocol, requisiteTopologies)
 	if len(pools) == 0 {
 		Logc(ctx).Info("no backend pools support any requisite topologies")
 	}
@@ -375,10 +466,6 @@ func (s *StorageClass) GetStoragePoolsForProtocolByBackend(
 	if len(pools) == 0 {
 		Logc(ctx).Info("no backend pools found for given NASType")
 	}
-	pools = SortPoolsByPreferredTopologies(ctx, pools, preferredTopologies)
-
-	Logc(ctx).Debugf("Finally got %d storage pools", len(pools))
-
 	return pools
 }
 
@@ -479,14 +566,7 @@ func FilterPoolsOnTopology(
 	}
 
 	for _, pool := range pools {
-		if len(pool.SupportedTopologies()) > 0 {
-			for _, topology := range requisiteTopologies {
-				if isTopologySupportedByPool(pool, topology) {
-					filteredPools = append(filteredPools, pool)
-					break
-				}
-			}
-		} else {
+		if isPoolInRequisiteTopologies(pool, requisiteTopologies) {
 			filteredPools = append(filteredPools, pool)
 		}
 	}
@@ -494,11 +574,35 @@ func FilterPoolsOnTopology(
 	return filteredPools
 }
 
+// isPoolInRequisiteTopologies returns whether the pool can support any of the requisiteTopologies.  A pool
+// without supported topologies is accessible from anywhere.
+func isPoolInRequisiteTopologies(pool storage.Pool, requisiteTopologies []map[string]string) bool {
+	if len(requisiteTopologies) == 0 || len(pool.SupportedTopologies()) == 0 {
+		return true
+	}
+	for _, topology := range requisiteTopologies {
+		if isTopologySupportedByPool(pool, topology) {
+			return true
+		}
+	}
+	return false
+}
+
 // SortPoolsByPreferredTopologies returns a list of pools ordered by the pools supportedTopologies field against
 // the provided list of preferredTopologies. If 2 or more pools can support a given preferredTopology, they are shuffled
 // randomly within that segment of the list, in order to prevent hotspots.
 func SortPoolsByPreferredTopologies(
 	ctx context.Context, pools []storage.Pool, preferredTopologies []map[string]string,
+) []storage.Pool {
+	return SortPoolsByPreferredTopologiesWithStrategy(ctx, pools, preferredTopologies, PlacementRandom, 0, nil)
+}
+
+// SortPoolsByPreferredTopologiesWithStrategy returns a list of pools ordered by the pools supportedTopologies field
+// against the provided list of preferredTopologies. If 2 or more pools can support a given preferredTopology, they
+// are ordered within that segment of the list according to the placement strategy.
+func SortPoolsByPreferredTopologiesWithStrategy(
+	ctx context.Context, pools []storage.Pool, preferredTopologies []map[string]string,
+	strategy PlacementStrategy, requestedBytes uint64, capacities PoolCapacities,
 ) []storage.Pool {
 	remainingPools := make([]storage.Pool, len(pools))
 	copy(remainingPools, pools)
@@ -523,20 +627,14 @@ func SortPoolsByPreferredTopologies(
 		remainingPools = make([]storage.Pool, len(newRemainingPools))
 		copy(remainingPools, newRemainingPools)
 
-		// shuffle bucket
-		rand.Shuffle(len(poolBucket), func(i, j int) {
-			poolBucket[i], poolBucket[j] = poolBucket[j], poolBucket[i]
-		})
-
-		// add all in bucket to final list
-		orderedPools = append(orderedPools, poolBucket...)
+		// order bucket and add all in bucket to final list
+		orderedPools = append(orderedPools,
+			OrderPoolsByPlacementStrategy(ctx, poolBucket, strategy, requestedBytes, capacities)...)
 	}
 
-	// shuffle and add leftover pools the did not match any preference
-	rand.Shuffle(len(remainingPools), func(i, j int) {
-		remainingPools[i], remainingPools[j] = remainingPools[j], remainingPools[i]
-	})
-	return append(orderedPools, remainingPools...)
+	// order and add leftover pools the did not match any preference
+	return append(orderedPools,
+		OrderPoolsByPlacementStrategy(ctx, remainingPools, strategy, requestedBytes, capacities)...)
 }
 
 // GetTopologyForVolume correlates the topology requirements of a new volume (if any) with the known topologies
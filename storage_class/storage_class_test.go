@@ -186,7 +186,7 @@ func TestGetStoragePoolsForProtocolByBackend(t *testing.T) {
 
 	// Check for valid case
 	pools := sc.GetStoragePoolsForProtocolByBackend(ctx, config.File, requisiteTopologies, preferredTopologies,
-		config.ReadWriteMany)
+		config.ReadWriteMany, 0, nil)
 
 	assert.Equal(t, 1, len(pools))
 	assert.Equal(t, "fake pool 1", pools[0].Name())
@@ -201,7 +201,7 @@ func TestGetStoragePoolsForProtocolByBackend(t *testing.T) {
 
 	// Check for invalid case
 	pools2 := sc2.GetStoragePoolsForProtocolByBackend(ctx, config.File, requisiteTopologies, preferredTopologies,
-		config.ReadWriteMany)
+		config.ReadWriteMany, 0, nil)
 
 	assert.Equal(t, 0, len(pools2))
 }
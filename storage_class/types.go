@@ -21,6 +21,8 @@ type Config struct {
 	Pools           map[string][]string                 `json:"storagePools,omitempty"`
 	AdditionalPools map[string][]string                 `json:"additionalStoragePools,omitempty"`
 	ExcludePools    map[string][]string                 `json:"excludeStoragePools,omitempty"`
+	Placement       string                              `json:"placementStrategy,omitempty"`
+	FreezeFS        bool                                `json:"freezeFilesystem,omitempty"`
 }
 
 type External struct {
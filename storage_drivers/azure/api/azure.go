@@ -10,6 +10,7 @@ import (
 	"io"
 	"net/http"
 	"regexp"
+	"sync"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
@@ -27,6 +28,7 @@ import (
 	"github.com/netapp/trident/storage"
 	"github.com/netapp/trident/utils"
 	"github.com/netapp/trident/utils/errors"
+	"github.com/netapp/trident/utils/tracing"
 )
 
 const (
@@ -79,6 +81,12 @@ type AzureClient struct {
 	SubvolumesClient *netapp.SubvolumesClient
 	ResourceClient   *netapp.ResourceClient
 	AzureResources
+
+	// resourcesMutex guards AzureResources, which discovery replaces while volumes on the backend may be
+	// operated upon in parallel
+	resourcesMutex sync.RWMutex
+	// refreshMutex serializes refreshes of AzureResources
+	refreshMutex sync.Mutex
 }
 
 type AzureError struct {
@@ -259,6 +267,16 @@ type Client struct {
 	sdkClient *AzureClient
 }
 
+// tracingPolicy traces each attempt at each call made to Azure.
+type tracingPolicy struct{}
+
+func (tracingPolicy) Do(req *policy.Request) (*http.Response, error) {
+	span := tracing.StartHTTPClientSpan(req.Raw(), "azure-netapp-files")
+	resp, err := req.Next()
+	tracing.EndHTTPClientSpan(span, resp, err)
+	return resp, err
+}
+
 // NewDriver is a factory method for creating a new SDK interface.
 func NewDriver(config ClientConfig) (Azure, error) {
 	var err error
@@ -280,6 +298,7 @@ func NewDriver(config ClientConfig) (Azure, error) {
 				RetryDelay:    SDKRetryDelay,
 				MaxRetryDelay: SDKMaxRetryDelay,
 			},
+			PerRetryPolicies: []policy.Policy{tracingPolicy{}},
 		},
 	}
 
@@ -291,6 +310,7 @@ func NewDriver(config ClientConfig) (Azure, error) {
 				RetryDelay:    SDKRetryDelay,
 				MaxRetryDelay: SDKMaxRetryDelay,
 			},
+			PerRetryPolicies: []policy.Policy{tracingPolicy{}},
 		},
 	}
 
@@ -359,11 +379,15 @@ func (c Client) Init(ctx context.Context, pools map[string]storage.Pool) error {
 
 // RegisterStoragePool makes a note of pools defined by the driver for later mapping.
 func (c Client) registerStoragePools(sPools map[string]storage.Pool) {
-	c.sdkClient.AzureResources.StoragePoolMap = make(map[string]storage.Pool)
+	storagePoolMap := make(map[string]storage.Pool)
 
 	for _, sPool := range sPools {
-		c.sdkClient.AzureResources.StoragePoolMap[sPool.Name()] = sPool
+		storagePoolMap[sPool.Name()] = sPool
 	}
+
+	c.sdkClient.resourcesMutex.Lock()
+	defer c.sdkClient.resourcesMutex.Unlock()
+	c.sdkClient.AzureResources.StoragePoolMap = storagePoolMap
 }
 
 // ///////////////////////////////////////////////////////////////////////////////
@@ -823,6 +847,11 @@ func (c Client) getLabelsFromVolume(vol *netapp.Volume) map[string]string {
 // Functions to retrieve and manage volumes
 // ///////////////////////////////////////////////////////////////////////////////
 
+// VolumesForCapacityPool returns all volumes in a discovered capacity pool.
+func (c Client) VolumesForCapacityPool(ctx context.Context, cPool *CapacityPool) (*[]*FileSystem, error) {
+	return c.getVolumesFromPool(ctx, cPool)
+}
+
 // getVolumesFromPool gets a set of volumes belonging to a single capacity pool.  As pools can come and go
 // in between cache updates, we ignore any 404 errors here.
 func (c Client) getVolumesFromPool(ctx context.Context, cPool *CapacityPool) (*[]*FileSystem, error) {
@@ -1105,8 +1134,8 @@ func (c Client) CreateVolume(ctx context.Context, request *FilesystemCreateReque
 
 	// Get the capacity pool so we can determine location and service level
 	cPoolFullName := CreateCapacityPoolFullName(resourceGroup, netappAccount, cPoolName)
-	cPool, ok := c.sdkClient.AzureResources.CapacityPoolMap[cPoolFullName]
-	if !ok {
+	cPool := c.capacityPool(cPoolFullName)
+	if cPool == nil {
 		return nil, fmt.Errorf("unknown capacity pool %s", cPoolFullName)
 	}
 
@@ -1357,6 +1386,44 @@ func (c Client) ResizeVolume(ctx context.Context, filesystem *FileSystem, newSiz
 	return nil
 }
 
+// MoveVolume moves a volume to another capacity pool in the same NetApp account, which changes its service level.
+func (c Client) MoveVolume(ctx context.Context, filesystem *FileSystem, cPool *CapacityPool) error {
+	logFields := LogFields{
+		"API":          "VolumesClient.BeginPoolChange",
+		"volume":       filesystem.FullName,
+		"capacityPool": cPool.FullName,
+	}
+
+	body := netapp.PoolChangeRequest{
+		NewPoolResourceID: &cPool.ID,
+	}
+
+	var rawResponse *http.Response
+	responseCtx := runtime.WithCaptureResponse(ctx, &rawResponse)
+
+	poller, err := c.sdkClient.VolumesClient.BeginPoolChange(responseCtx,
+		filesystem.ResourceGroup, filesystem.NetAppAccount, filesystem.CapacityPool, filesystem.Name, body, nil)
+
+	logFields["correlationID"] = GetCorrelationID(rawResponse)
+
+	if err != nil {
+		Logc(ctx).WithFields(logFields).WithError(err).Error("Error moving volume.")
+		return err
+	}
+
+	Logc(ctx).WithFields(logFields).Debug("Volume move request issued.")
+
+	_, err = poller.PollUntilDone(responseCtx, &runtime.PollUntilDoneOptions{Frequency: 2 * time.Second})
+	if err != nil {
+		Logc(ctx).WithFields(logFields).WithError(err).Error("Error polling for volume move result.")
+		return err
+	}
+
+	Logc(ctx).WithFields(logFields).Debug("Volume move complete.")
+
+	return nil
+}
+
 // DeleteVolume deletes a volume.
 func (c Client) DeleteVolume(ctx context.Context, filesystem *FileSystem) error {
 	logFields := LogFields{
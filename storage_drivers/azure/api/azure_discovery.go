@@ -41,8 +41,15 @@ const (
 // RefreshAzureResources refreshes the cache of discovered Azure resources and validates
 // them against our known storage pools.
 func (c Client) RefreshAzureResources(ctx context.Context) error {
+	// Only one refresh runs at a time, and those waiting on it use what it discovered
+	c.sdkClient.refreshMutex.Lock()
+	defer c.sdkClient.refreshMutex.Unlock()
+
 	// Check if it is time to update the cache
-	if time.Now().Before(c.sdkClient.AzureResources.lastUpdateTime.Add(c.config.MaxCacheAge)) {
+	c.sdkClient.resourcesMutex.RLock()
+	lastUpdateTime := c.sdkClient.AzureResources.lastUpdateTime
+	c.sdkClient.resourcesMutex.RUnlock()
+	if time.Now().Before(lastUpdateTime.Add(c.config.MaxCacheAge)) {
 		Logc(ctx).Debugf("Cached resources not yet %v old, skipping refresh.", c.config.MaxCacheAge)
 		return nil
 	}
@@ -51,6 +58,8 @@ func (c Client) RefreshAzureResources(ctx context.Context) error {
 	Logc(ctx).Debugf("Discovering Azure resources.")
 	discoveryErr := multierr.Combine(c.DiscoverAzureResources(ctx))
 
+	c.sdkClient.resourcesMutex.RLock()
+
 	// This is noisy, hide it behind api tracing.
 	c.dumpAzureResources(ctx, c.config.StorageDriverName, c.config.DebugTraceFlags["api"])
 
@@ -65,6 +74,8 @@ func (c Client) RefreshAzureResources(ctx context.Context) error {
 	poolErrors := multierr.Combine(c.checkForUnsatisfiedPools(ctx)...)
 	discoveryErr = multierr.Combine(discoveryErr, poolErrors)
 
+	c.sdkClient.resourcesMutex.RUnlock()
+
 	Logc(ctx).Debugf("Discovering Azure preview features.")
 	discoveryErr = multierr.Combine(discoveryErr, c.EnableAzureFeatures(ctx, FeatureUnixPermissions))
 
@@ -88,6 +99,8 @@ func (c Client) DiscoverAzureResources(ctx context.Context) (returnError error)
 		}
 
 		// Swap the newly discovered resources into the cache only if discovery succeeded.
+		c.sdkClient.resourcesMutex.Lock()
+		defer c.sdkClient.resourcesMutex.Unlock()
 		c.sdkClient.AzureResources.ResourceGroups = newResourceGroups
 		c.sdkClient.AzureResources.ResourceGroupMap = newResourceGroupMap
 		c.sdkClient.AzureResources.NetAppAccountMap = newNetAppAccountMap
@@ -244,7 +257,7 @@ func (c Client) checkForUnsatisfiedPools(ctx context.Context) (discoveryErrors [
 	for sPoolName, sPool := range c.sdkClient.AzureResources.StoragePoolMap {
 
 		// Find all capacity pools that work for this storage pool
-		cPools := c.CapacityPoolsForStoragePool(ctx, sPool, sPool.InternalAttributes()[serviceLevel])
+		cPools := c.capacityPoolsForStoragePool(ctx, sPool, sPool.InternalAttributes()[serviceLevel])
 
 		if len(cPools) == 0 {
 
@@ -416,7 +429,9 @@ func (c Client) EnableAzureFeatures(ctx context.Context, featureNames ...string)
 		}
 
 		// Swap the newly discovered features into the cache only if discovery succeeded.
+		c.sdkClient.resourcesMutex.Lock()
 		c.sdkClient.AzureResources.Features = featureMap
+		c.sdkClient.resourcesMutex.Unlock()
 
 		Logc(ctx).Debug("Switched to newly discovered features.")
 	}()
@@ -490,6 +505,9 @@ func (c Client) enableAzureFeature(
 
 // Features returns the map of preview features believed to be available in the current subscription.
 func (c Client) Features() map[string]bool {
+	c.sdkClient.resourcesMutex.RLock()
+	defer c.sdkClient.resourcesMutex.RUnlock()
+
 	featureMap := make(map[string]bool)
 	for k, v := range c.sdkClient.Features {
 		featureMap[k] = v
@@ -499,6 +517,9 @@ func (c Client) Features() map[string]bool {
 
 // HasFeature returns true if the named preview feature is believed to be available in the current subscription.
 func (c Client) HasFeature(feature string) bool {
+	c.sdkClient.resourcesMutex.RLock()
+	defer c.sdkClient.resourcesMutex.RUnlock()
+
 	value, ok := c.sdkClient.Features[feature]
 	return ok && value
 }
@@ -636,6 +657,12 @@ func (c Client) discoverCapacityPools(ctx context.Context) (*[]*CapacityPool, er
 			continue
 		}
 
+		// The size is only used to report capacity, so a pool is not ignored if it cannot be read
+		var sizeBytes int64
+		if size, ok := rawProperties["size"].(float64); ok {
+			sizeBytes = int64(size)
+		}
+
 		cpools = append(cpools,
 			&CapacityPool{
 				ID:                id,
@@ -649,6 +676,7 @@ func (c Client) discoverCapacityPools(ctx context.Context) (*[]*CapacityPool, er
 				ServiceLevel:      serviceLevel,
 				ProvisioningState: provisioningState,
 				QosType:           qosType,
+				SizeBytes:         sizeBytes,
 			})
 	}
 
@@ -778,6 +806,9 @@ func (c Client) discoverSubnets(ctx context.Context) (*[]*Subnet, error) {
 
 // CapacityPools returns a list of all discovered ANF capacity pools.
 func (c Client) CapacityPools() *[]*CapacityPool {
+	c.sdkClient.resourcesMutex.RLock()
+	defer c.sdkClient.resourcesMutex.RUnlock()
+
 	var cPools []*CapacityPool
 
 	for _, cPool := range c.sdkClient.AzureResources.CapacityPoolMap {
@@ -789,18 +820,24 @@ func (c Client) CapacityPools() *[]*CapacityPool {
 
 // capacityPool returns a single discovered capacity pool by its full name.
 func (c Client) capacityPool(cPoolFullName string) *CapacityPool {
+	c.sdkClient.resourcesMutex.RLock()
+	defer c.sdkClient.resourcesMutex.RUnlock()
+
 	return c.sdkClient.AzureResources.CapacityPoolMap[cPoolFullName]
 }
 
 // CapacityPoolsForStoragePools returns all discovered capacity pools matching all known storage pools,
 // regardless of service levels.
 func (c Client) CapacityPoolsForStoragePools(ctx context.Context) []*CapacityPool {
+	c.sdkClient.resourcesMutex.RLock()
+	defer c.sdkClient.resourcesMutex.RUnlock()
+
 	// This map deduplicates cPools from multiple storage pools
 	cPoolMap := make(map[*CapacityPool]bool)
 
 	// Build deduplicated map of cPools
 	for _, sPool := range c.sdkClient.StoragePoolMap {
-		for _, cPool := range c.CapacityPoolsForStoragePool(ctx, sPool, "") {
+		for _, cPool := range c.capacityPoolsForStoragePool(ctx, sPool, "") {
 			cPoolMap[cPool] = true
 		}
 	}
@@ -819,6 +856,16 @@ func (c Client) CapacityPoolsForStoragePools(ctx context.Context) []*CapacityPoo
 // storage pool and service level.  The pools are shuffled to enable easier random selection.
 func (c Client) CapacityPoolsForStoragePool(
 	ctx context.Context, sPool storage.Pool, serviceLevel string,
+) []*CapacityPool {
+	c.sdkClient.resourcesMutex.RLock()
+	defer c.sdkClient.resourcesMutex.RUnlock()
+
+	return c.capacityPoolsForStoragePool(ctx, sPool, serviceLevel)
+}
+
+// capacityPoolsForStoragePool implements CapacityPoolsForStoragePool.  The caller must hold the resources lock.
+func (c Client) capacityPoolsForStoragePool(
+	ctx context.Context, sPool storage.Pool, serviceLevel string,
 ) []*CapacityPool {
 	Logd(ctx, c.config.StorageDriverName, c.config.DebugTraceFlags["discovery"]).WithField("storagePool", sPool.Name()).
 		Tracef("Determining capacity pools for storage pool.")
@@ -924,11 +971,17 @@ func (c Client) EnsureVolumeInValidCapacityPool(ctx context.Context, volume *Fil
 
 // subnet returns a single subnet by its full name
 func (c Client) subnet(subnetFullName string) *Subnet {
+	c.sdkClient.resourcesMutex.RLock()
+	defer c.sdkClient.resourcesMutex.RUnlock()
+
 	return c.sdkClient.AzureResources.SubnetMap[subnetFullName]
 }
 
 // SubnetsForStoragePool returns all discovered subnets matching the specified storage pool.
 func (c Client) SubnetsForStoragePool(ctx context.Context, sPool storage.Pool) []*Subnet {
+	c.sdkClient.resourcesMutex.RLock()
+	defer c.sdkClient.resourcesMutex.RUnlock()
+
 	Logd(ctx, c.config.StorageDriverName, c.config.DebugTraceFlags["discovery"]).WithField("storagePool", sPool.Name()).Tracef("Determining subnets for storage pool.")
 
 	// This map tracks which subnets have passed the filters
@@ -1005,6 +1058,9 @@ func (c Client) RandomSubnetForStoragePool(ctx context.Context, sPool storage.Po
 func (c Client) FilteredCapacityPoolMap(
 	ctx context.Context, rgFilter, naFilter, cpFilter []string,
 ) map[string]*CapacityPool {
+	c.sdkClient.resourcesMutex.RLock()
+	defer c.sdkClient.resourcesMutex.RUnlock()
+
 	// This map tracks which capacity pools have passed the filters
 	filteredCapacityPoolMap := make(map[string]bool)
 
@@ -1058,6 +1114,9 @@ func (c Client) FilteredCapacityPoolMap(
 func (c Client) FilteredSubnetMap(
 	ctx context.Context, rgFilter []string, vnFilter, snFilter string,
 ) map[string]*Subnet {
+	c.sdkClient.resourcesMutex.RLock()
+	defer c.sdkClient.resourcesMutex.RUnlock()
+
 	filteredSubnetMap := make(map[string]bool)
 
 	for subnetFullName := range c.sdkClient.SubnetMap {
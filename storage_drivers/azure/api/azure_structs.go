@@ -106,6 +106,7 @@ type CapacityPool struct {
 	ServiceLevel      string
 	ProvisioningState string
 	QosType           string
+	SizeBytes         int64
 }
 
 // FileSystem records details of a discovered Azure Subnet.
@@ -29,6 +29,7 @@ type Azure interface {
 	FilteredSubnetMap(ctx context.Context, rgFilter []string, vnFilter, snFilter string) map[string]*Subnet
 
 	Volumes(context.Context) (*[]*FileSystem, error)
+	VolumesForCapacityPool(context.Context, *CapacityPool) (*[]*FileSystem, error)
 	Volume(context.Context, *storage.VolumeConfig) (*FileSystem, error)
 	VolumeExists(context.Context, *storage.VolumeConfig) (bool, *FileSystem, error)
 	VolumeByCreationToken(context.Context, string) (*FileSystem, error)
@@ -39,6 +40,7 @@ type Azure interface {
 	CreateVolume(context.Context, *FilesystemCreateRequest) (*FileSystem, error)
 	ModifyVolume(context.Context, *FileSystem, map[string]string, *string, *bool, *ExportRule) error
 	ResizeVolume(context.Context, *FileSystem, int64) error
+	MoveVolume(context.Context, *FileSystem, *CapacityPool) error
 	DeleteVolume(context.Context, *FileSystem) error
 
 	Subvolumes(context.Context, []string) (*[]*Subvolume, error)
@@ -1970,6 +1970,9 @@ func (d *NASStorageDriver) Get(ctx context.Context, name string) error {
 	}
 
 	if _, err := d.SDK.VolumeByCreationToken(ctx, name); err != nil {
+		if errors.IsNotFoundError(err) {
+			return errors.WrapWithNotFoundError(err, "could not get volume %s; %v", name, err)
+		}
 		return fmt.Errorf("could not get volume %s; %v", name, err)
 	}
 
@@ -2191,6 +2194,37 @@ func (d *NASStorageDriver) GetExternalConfig(ctx context.Context) interface{} {
 	return cloneConfig
 }
 
+// GetPoolCapacity returns the space available to the specified storage pool, which is the combined size of the
+// capacity pools it may use less the quotas of the volumes already in them.  Each volume must still fit within a
+// single capacity pool.
+func (d *NASStorageDriver) GetPoolCapacity(ctx context.Context, pool storage.Pool) (*storage.PoolCapacity, error) {
+	cPools := d.SDK.CapacityPoolsForStoragePool(ctx, pool, pool.InternalAttributes()[ServiceLevel])
+	if len(cPools) == 0 {
+		return nil, fmt.Errorf("no capacity pools found for storage pool %s", pool.Name())
+	}
+
+	capacity := &storage.PoolCapacity{}
+	var usedBytes uint64
+	for _, cPool := range cPools {
+		if cPool.SizeBytes <= 0 {
+			return nil, fmt.Errorf("size of capacity pool %s is unknown", cPool.FullName)
+		}
+		volumes, err := d.SDK.VolumesForCapacityPool(ctx, cPool)
+		if err != nil {
+			return nil, fmt.Errorf("could not list volumes in capacity pool %s; %v", cPool.FullName, err)
+		}
+		capacity.TotalBytes += uint64(cPool.SizeBytes)
+		for _, volume := range *volumes {
+			usedBytes += uint64(volume.QuotaInBytes)
+		}
+	}
+	if usedBytes < capacity.TotalBytes {
+		capacity.FreeBytes = capacity.TotalBytes - usedBytes
+	}
+
+	return capacity, nil
+}
+
 // GetVolumeForImport queries the storage backend for all relevant info about
 // a single container volume managed by this driver and returns a VolumeExternal
 // representation of the volume.  For this driver, volumeID is the unique creation
@@ -2417,17 +2451,90 @@ func (d *NASStorageDriver) Update(
 		return nil, updateErr
 	}
 
+	// Only the service level may be changed among the volume's performance attributes
+	if updateInfo.QosPolicy != "" || updateInfo.AdaptiveQosPolicy != "" ||
+		updateInfo.TieringPolicy != "" || updateInfo.SnapshotPolicy != "" {
+		updateErr := errors.UnsupportedError(fmt.Sprintf(
+			"QoS, tiering and snapshot policies may not be changed on %s volumes", d.Name()))
+		Logc(ctx).WithError(updateErr).Error(updateErrorMsg)
+		return nil, updateErr
+	}
+
 	var updatedVols map[string]*storage.Volume
 	var updateError error
 
 	// Update snapshotDirectory for volume
 	if updateInfo.SnapshotDirectory != "" {
 		updatedVols, updateError = d.updateSnapshotDirectory(ctx, name, volume, updateInfo.SnapshotDirectory, allVolumes)
+		if updateError != nil {
+			return nil, updateError
+		}
+	}
+
+	// Move the volume to a capacity pool with the requested service level
+	if updateInfo.ServiceLevel != "" {
+		updatedVols, updateError = d.updateServiceLevel(ctx, name, volume, updateInfo.ServiceLevel, allVolumes)
 	}
 
 	return updatedVols, updateError
 }
 
+func (d *NASStorageDriver) updateServiceLevel(
+	ctx context.Context, name string, volume *api.FileSystem,
+	serviceLevel string, allVolumes map[string]*storage.Volume,
+) (map[string]*storage.Volume, error) {
+	fields := LogFields{
+		"Method":       "updateServiceLevel",
+		"Type":         "AzureNASStorageDriver",
+		"name":         name,
+		"serviceLevel": serviceLevel,
+	}
+	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> updateServiceLevel")
+	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< updateServiceLevel")
+
+	genericLogError := fmt.Sprintf("Failed to update service level for volume %v.", name)
+
+	var vol *storage.Volume
+	if vol = allVolumes[name]; vol == nil {
+		failureErr := fmt.Errorf("volume %v not found", name)
+		Logc(ctx).WithError(failureErr).Error(genericLogError)
+		return nil, failureErr
+	}
+
+	// Move the volume only if it isn't already in a capacity pool with the requested service level
+	if !strings.EqualFold(volume.ServiceLevel, serviceLevel) {
+
+		// A volume may only be moved between capacity pools in the same NetApp account
+		var targetPool *api.CapacityPool
+		for _, cPool := range d.SDK.CapacityPoolsForStoragePools(ctx) {
+			if cPool.ResourceGroup == volume.ResourceGroup && cPool.NetAppAccount == volume.NetAppAccount &&
+				cPool.Name != volume.CapacityPool && strings.EqualFold(cPool.ServiceLevel, serviceLevel) {
+				targetPool = cPool
+				break
+			}
+		}
+		if targetPool == nil {
+			failureErr := errors.InvalidInputError(fmt.Sprintf(
+				"no capacity pool with service level %s found in NetApp account %s", serviceLevel, volume.NetAppAccount))
+			Logc(ctx).WithError(failureErr).Error(genericLogError)
+			return nil, failureErr
+		}
+
+		if err := d.SDK.MoveVolume(ctx, volume, targetPool); err != nil {
+			Logc(ctx).WithError(err).Error(genericLogError)
+			return nil, err
+		}
+		serviceLevel = targetPool.ServiceLevel
+	} else {
+		serviceLevel = volume.ServiceLevel
+	}
+
+	// Update volConfig for the volume to ensure cache is appropriately updated
+	vol.Config.ServiceLevel = serviceLevel
+
+	return map[string]*storage.Volume{name: vol}, nil
+}
+
 func (d *NASStorageDriver) updateSnapshotDirectory(
 	ctx context.Context, name string, volume *api.FileSystem,
 	snapshotDir string, allVolumes map[string]*storage.Volume,
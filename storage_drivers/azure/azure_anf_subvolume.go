@@ -1672,6 +1672,9 @@ func (d *NASBlockStorageDriver) Get(ctx context.Context, name string) error {
 	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< Get")
 
 	if _, err := d.SDK.SubvolumeByCreationToken(ctx, name, d.getAllFilePoolVolumes(), false); err != nil {
+		if errors.IsNotFoundError(err) {
+			return errors.WrapWithNotFoundError(err, "could not get volume %s; %v", name, err)
+		}
 		return fmt.Errorf("could not get volume %s; %v", name, err)
 	}
 
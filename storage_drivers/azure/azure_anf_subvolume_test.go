@@ -20,6 +20,7 @@ import (
 	"github.com/netapp/trident/storage_drivers/azure/api"
 	"github.com/netapp/trident/storage_drivers/fake"
 	"github.com/netapp/trident/utils"
+	"github.com/netapp/trident/utils/errors"
 )
 
 func newTestANFSubvolumeDriver(mockAPI api.Azure) *NASBlockStorageDriver {
@@ -3660,6 +3661,19 @@ func TestSubvolumeGet_Error(t *testing.T) {
 	result := driver.Get(ctx, name)
 
 	assert.Error(t, result, "got subvolume")
+	assert.False(t, errors.IsNotFoundError(result), "expected error other than not found")
+}
+
+func TestSubvolumeGet_NotFound(t *testing.T) {
+	mockAPI, driver := newMockANFSubvolumeDriver(t)
+	name := "subvol1"
+
+	mockAPI.EXPECT().SubvolumeByCreationToken(ctx, name, driver.getAllFilePoolVolumes(), false).Return(nil,
+		errors.NotFoundError("subvolume with creation token '%s' not found", name)).Times(1)
+	result := driver.Get(ctx, name)
+
+	assert.Error(t, result, "got subvolume")
+	assert.True(t, errors.IsNotFoundError(result), "expected not found error")
 }
 
 func TestSubvolumeResize_SubvolumeNotFound(t *testing.T) {
@@ -7118,9 +7118,10 @@ func TestGet_DiscoveryFailed(t *testing.T) {
 	result := driver.Get(ctx, "volume1")
 
 	assert.Error(t, result, "expected error")
+	assert.False(t, errors.IsNotFoundError(result), "expected error other than not found")
 }
 
-func TestGet_NotFound(t *testing.T) {
+func TestGet_Error(t *testing.T) {
 	mockAPI, driver := newMockANFDriver(t)
 
 	mockAPI.EXPECT().RefreshAzureResources(ctx).Return(nil).Times(1)
@@ -7129,6 +7130,20 @@ func TestGet_NotFound(t *testing.T) {
 	result := driver.Get(ctx, "volume1")
 
 	assert.Error(t, result, "expected error")
+	assert.False(t, errors.IsNotFoundError(result), "expected error other than not found")
+}
+
+func TestGet_NotFound(t *testing.T) {
+	mockAPI, driver := newMockANFDriver(t)
+
+	mockAPI.EXPECT().RefreshAzureResources(ctx).Return(nil).Times(1)
+	mockAPI.EXPECT().VolumeByCreationToken(ctx, "volume1").Return(nil,
+		errors.NotFoundError("volume with creation token 'volume1' not found")).Times(1)
+
+	result := driver.Get(ctx, "volume1")
+
+	assert.Error(t, result, "expected error")
+	assert.True(t, errors.IsNotFoundError(result), "expected not found error")
 }
 
 func TestResize(t *testing.T) {
@@ -8153,6 +8168,80 @@ func TestUpdate_Success(t *testing.T) {
 	}
 }
 
+func TestUpdate_ServiceLevel(t *testing.T) {
+	mockAPI, driver := newMockANFDriver(t)
+
+	driver.Config.BackendName = "anf"
+	driver.Config.ServiceLevel = api.ServiceLevelUltra
+	driver.Config.NetworkFeatures = api.NetworkFeaturesStandard
+	driver.Config.NASType = "nfs"
+
+	driver.populateConfigurationDefaults(ctx, &driver.Config)
+	driver.initializeStoragePools(ctx)
+	driver.initializeTelemetry(ctx, BackendUUID)
+
+	storagePool := driver.pools["anf_pool"]
+
+	volConfig, _, _, _, filesystem := getStructsForCreateNFSVolume(ctx, driver, storagePool)
+	filesystem.ServiceLevel = api.ServiceLevelUltra
+	volConfig.ServiceLevel = api.ServiceLevelUltra
+
+	capacityPools := getMultipleCapacityPoolsForCreateVolume()
+	capacityPools[1].ServiceLevel = api.ServiceLevelPremium
+	capacityPools[2].ServiceLevel = api.ServiceLevelPremium
+	capacityPools[1].NetAppAccount = "NA2"
+
+	allVolumes := map[string]*storage.Volume{
+		volConfig.Name: {
+			Config:      volConfig,
+			BackendUUID: BackendUUID,
+			Pool:        "anf_pool",
+			State:       "Online",
+		},
+	}
+
+	mockAPI.EXPECT().RefreshAzureResources(ctx).Return(nil).AnyTimes()
+	mockAPI.EXPECT().Volume(ctx, volConfig).Return(filesystem, nil).AnyTimes()
+	mockAPI.EXPECT().CapacityPoolsForStoragePools(ctx).Return(capacityPools).AnyTimes()
+
+	// The volume moves to a capacity pool with the new service level in the same NetApp account
+	mockAPI.EXPECT().MoveVolume(ctx, filesystem, capacityPools[2]).Return(nil)
+
+	result, err := driver.Update(ctx, volConfig,
+		&utils.VolumeUpdateInfo{ServiceLevel: strings.ToLower(api.ServiceLevelPremium)}, allVolumes)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, api.ServiceLevelPremium, volConfig.ServiceLevel)
+
+	// The volume already has the requested service level
+	volConfig.ServiceLevel = ""
+
+	result, err = driver.Update(ctx, volConfig, &utils.VolumeUpdateInfo{ServiceLevel: api.ServiceLevelUltra}, allVolumes)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, api.ServiceLevelUltra, volConfig.ServiceLevel)
+
+	// No capacity pool has the requested service level
+	_, err = driver.Update(ctx, volConfig, &utils.VolumeUpdateInfo{ServiceLevel: api.ServiceLevelStandard}, allVolumes)
+
+	assert.True(t, errors.IsInvalidInputError(err))
+
+	// The move fails
+	mockAPI.EXPECT().MoveVolume(ctx, filesystem, capacityPools[2]).Return(errors.New("failed"))
+
+	_, err = driver.Update(ctx, volConfig, &utils.VolumeUpdateInfo{ServiceLevel: api.ServiceLevelPremium}, allVolumes)
+
+	assert.Error(t, err)
+	assert.Equal(t, api.ServiceLevelUltra, volConfig.ServiceLevel)
+
+	// ONTAP policies may not be changed
+	_, err = driver.Update(ctx, volConfig, &utils.VolumeUpdateInfo{QosPolicy: "gold"}, allVolumes)
+
+	assert.True(t, errors.IsUnsupportedError(err))
+}
+
 func TestUpdate_NilVolumeUpdateInfo(t *testing.T) {
 	_, driver := newMockANFDriver(t)
 
@@ -8431,3 +8520,54 @@ func TestUpdateSnapshotDirectory_Failure(t *testing.T) {
 	assert.Nil(t, result)
 	assert.NotNil(t, err)
 }
+
+func TestGetPoolCapacity(t *testing.T) {
+	mockAPI, driver := newMockANFDriver(t)
+	driver.Config.BackendName = "anf"
+	driver.Config.ServiceLevel = api.ServiceLevelUltra
+	driver.populateConfigurationDefaults(ctx, &driver.Config)
+	driver.initializeStoragePools(ctx)
+	storagePool := driver.pools["anf_pool"]
+
+	cPool1 := &api.CapacityPool{FullName: "RG1/NA1/CP1", ServiceLevel: api.ServiceLevelUltra, SizeBytes: 4 << 40}
+	cPool2 := &api.CapacityPool{FullName: "RG1/NA1/CP2", ServiceLevel: api.ServiceLevelUltra, SizeBytes: 2 << 40}
+
+	mockAPI.EXPECT().CapacityPoolsForStoragePool(ctx, storagePool, api.ServiceLevelUltra).Return(
+		[]*api.CapacityPool{cPool1, cPool2})
+	mockAPI.EXPECT().VolumesForCapacityPool(ctx, cPool1).Return(
+		&[]*api.FileSystem{{QuotaInBytes: 1 << 40}, {QuotaInBytes: 1 << 40}}, nil)
+	mockAPI.EXPECT().VolumesForCapacityPool(ctx, cPool2).Return(&[]*api.FileSystem{{QuotaInBytes: 1 << 40}}, nil)
+
+	capacity, err := driver.GetPoolCapacity(ctx, storagePool)
+	assert.NoError(t, err)
+	assert.Equal(t, &storage.PoolCapacity{TotalBytes: 6 << 40, FreeBytes: 3 << 40}, capacity)
+}
+
+func TestGetPoolCapacity_Errors(t *testing.T) {
+	mockAPI, driver := newMockANFDriver(t)
+	driver.Config.BackendName = "anf"
+	driver.Config.ServiceLevel = api.ServiceLevelUltra
+	driver.populateConfigurationDefaults(ctx, &driver.Config)
+	driver.initializeStoragePools(ctx)
+	storagePool := driver.pools["anf_pool"]
+
+	// No capacity pools
+	mockAPI.EXPECT().CapacityPoolsForStoragePool(ctx, storagePool, api.ServiceLevelUltra).Return(nil)
+	_, err := driver.GetPoolCapacity(ctx, storagePool)
+	assert.Error(t, err)
+
+	// Capacity pool size not discovered
+	cPool := &api.CapacityPool{FullName: "RG1/NA1/CP1", ServiceLevel: api.ServiceLevelUltra}
+	mockAPI.EXPECT().CapacityPoolsForStoragePool(ctx, storagePool, api.ServiceLevelUltra).Return(
+		[]*api.CapacityPool{cPool})
+	_, err = driver.GetPoolCapacity(ctx, storagePool)
+	assert.Error(t, err)
+
+	// Volumes cannot be listed
+	cPool.SizeBytes = 4 << 40
+	mockAPI.EXPECT().CapacityPoolsForStoragePool(ctx, storagePool, api.ServiceLevelUltra).Return(
+		[]*api.CapacityPool{cPool})
+	mockAPI.EXPECT().VolumesForCapacityPool(ctx, cPool).Return(nil, errFailed)
+	_, err = driver.GetPoolCapacity(ctx, storagePool)
+	assert.Error(t, err)
+}
@@ -12,6 +12,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/RoaringBitmap/roaring"
@@ -48,6 +49,9 @@ type StorageDriver struct {
 	initialized bool
 	Config      drivers.FakeStorageDriverConfig
 
+	// mutex guards the volumes, snapshots and pools, which may be used by concurrent operations
+	mutex sync.Mutex
+
 	// Volumes saves info about Volumes created on this driver
 	Volumes map[string]fake.Volume
 
@@ -77,12 +81,12 @@ type StorageDriver struct {
 }
 
 // String implements Stringer interface for the FakeStorageDriver driver
-func (d StorageDriver) String() string {
-	return utils.ToStringRedacted(&d, []string{"Secret"}, nil)
+func (d *StorageDriver) String() string {
+	return utils.ToStringRedacted(d, []string{"Secret"}, nil)
 }
 
 // GoString implements GoStringer interface for the FakeStorageDriver driver
-func (d StorageDriver) GoString() string {
+func (d *StorageDriver) GoString() string {
 	return d.String()
 }
 
@@ -558,6 +562,9 @@ func (d *StorageDriver) validate(ctx context.Context) error {
 func (d *StorageDriver) Create(
 	ctx context.Context, volConfig *storage.VolumeConfig, storagePool storage.Pool, volAttributes map[string]sa.Request,
 ) error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
 	name := volConfig.InternalName
 	if _, ok := d.Volumes[name]; ok {
 		return drivers.NewVolumeExistsError(name)
@@ -730,6 +737,9 @@ func (d *StorageDriver) BootstrapVolume(ctx context.Context, volume *storage.Vol
 func (d *StorageDriver) CreateClone(
 	ctx context.Context, _, cloneVolConfig *storage.VolumeConfig, _ storage.Pool,
 ) error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
 	name := cloneVolConfig.InternalName
 	source := cloneVolConfig.CloneSourceVolumeInternal
 	snapshot := cloneVolConfig.CloneSourceSnapshotInternal
@@ -787,6 +797,9 @@ func (d *StorageDriver) CreateClone(
 }
 
 func (d *StorageDriver) Import(ctx context.Context, volConfig *storage.VolumeConfig, originalName string) error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
 	Logc(ctx).WithFields(LogFields{
 		"volumeConfig": volConfig,
 		"originalName": originalName,
@@ -809,6 +822,9 @@ func (d *StorageDriver) Import(ctx context.Context, volConfig *storage.VolumeCon
 }
 
 func (d *StorageDriver) Rename(ctx context.Context, name, newName string) error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
 	Logc(ctx).WithFields(LogFields{
 		"name":    name,
 		"newName": newName,
@@ -826,6 +842,9 @@ func (d *StorageDriver) Rename(ctx context.Context, name, newName string) error
 }
 
 func (d *StorageDriver) Destroy(ctx context.Context, volConfig *storage.VolumeConfig) error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
 	name := volConfig.InternalName
 
 	d.DestroyedVolumes[name] = true
@@ -870,6 +889,9 @@ func (d *StorageDriver) CanSnapshot(_ context.Context, _ *storage.SnapshotConfig
 func (d *StorageDriver) GetSnapshot(
 	_ context.Context, snapConfig *storage.SnapshotConfig, _ *storage.VolumeConfig,
 ) (*storage.Snapshot, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
 	internalSnapName := snapConfig.InternalName
 	internalVolName := snapConfig.VolumeInternalName
 
@@ -893,6 +915,9 @@ func (d *StorageDriver) GetSnapshot(
 func (d *StorageDriver) GetSnapshots(_ context.Context, volConfig *storage.VolumeConfig) (
 	[]*storage.Snapshot, error,
 ) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
 	internalVolName := volConfig.InternalName
 
 	snapshots := make([]*storage.Snapshot, 0)
@@ -914,6 +939,9 @@ func (d *StorageDriver) GetSnapshots(_ context.Context, volConfig *storage.Volum
 func (d *StorageDriver) CreateSnapshot(
 	ctx context.Context, snapConfig *storage.SnapshotConfig, _ *storage.VolumeConfig,
 ) (*storage.Snapshot, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
 	internalSnapName := snapConfig.InternalName
 	internalVolName := snapConfig.VolumeInternalName
 
@@ -967,6 +995,67 @@ func (d *StorageDriver) CreateSnapshot(
 	return snapshot, nil
 }
 
+// CreateGroupSnapshot creates snapshots of several volumes at once.  Like a consistency group on real storage,
+// either all of the snapshots are created or none of them are.
+func (d *StorageDriver) CreateGroupSnapshot(
+	ctx context.Context, config *storage.GroupSnapshotConfig, snapConfigs []*storage.SnapshotConfig,
+	_ []*storage.VolumeConfig,
+) ([]*storage.Snapshot, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	fields := LogFields{
+		"Method":            "CreateGroupSnapshot",
+		"Type":              "StorageDriver",
+		"groupSnapshotName": config.InternalName,
+	}
+	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> CreateGroupSnapshot")
+	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< CreateGroupSnapshot")
+
+	// Ensure every snapshot may be created before creating any of them
+	for _, snapConfig := range snapConfigs {
+		internalVolName := snapConfig.VolumeInternalName
+		if _, ok := d.Volumes[internalVolName]; !ok {
+			return nil, fmt.Errorf("source volume %s not found", internalVolName)
+		}
+		if _, ok := d.Snapshots[internalVolName][snapConfig.InternalName]; ok {
+			return nil, fmt.Errorf("snapshot %s already exists", snapConfig.InternalName)
+		}
+		if len(d.Snapshots[internalVolName]) >= maxSnapshots {
+			return nil, errors.MaxLimitReachedError(fmt.Sprintf("could not create snapshot: too many snapshots " +
+				"created for a volume"))
+		}
+	}
+
+	created := time.Now().UTC().Format(utils.TimestampFormat)
+	snapshots := make([]*storage.Snapshot, 0, len(snapConfigs))
+
+	for _, snapConfig := range snapConfigs {
+		internalVolName := snapConfig.VolumeInternalName
+		if _, ok := d.Snapshots[internalVolName]; !ok {
+			d.Snapshots[internalVolName] = make(map[string]*storage.Snapshot)
+		}
+
+		snapshot := &storage.Snapshot{
+			Config:    snapConfig,
+			Created:   created,
+			SizeBytes: int64(d.Volumes[internalVolName].SizeBytes),
+			State:     storage.SnapshotStateOnline,
+		}
+		d.Snapshots[internalVolName][snapConfig.InternalName] = snapshot
+		d.DestroyedSnapshots[snapConfig.ID()] = false
+		snapshots = append(snapshots, snapshot)
+	}
+
+	Logc(ctx).WithFields(LogFields{
+		"backend":           d.Config.InstanceName,
+		"groupSnapshotName": config.InternalName,
+		"volumes":           config.VolumeNames,
+	}).Info("Created fake group snapshot.")
+
+	return snapshots, nil
+}
+
 func (d *StorageDriver) BootstrapSnapshot(
 	ctx context.Context, snapshot *storage.Snapshot, volConfig *storage.VolumeConfig,
 ) {
@@ -988,6 +1077,9 @@ func (d *StorageDriver) BootstrapSnapshot(
 func (d *StorageDriver) RestoreSnapshot(
 	_ context.Context, snapConfig *storage.SnapshotConfig, _ *storage.VolumeConfig,
 ) error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
 	internalSnapName := snapConfig.InternalName
 	internalVolName := snapConfig.VolumeInternalName
 
@@ -1011,6 +1103,9 @@ func (d *StorageDriver) RestoreSnapshot(
 func (d *StorageDriver) DeleteSnapshot(
 	_ context.Context, snapConfig *storage.SnapshotConfig, _ *storage.VolumeConfig,
 ) error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
 	internalSnapName := snapConfig.InternalName
 	internalVolName := snapConfig.VolumeInternalName
 
@@ -1027,16 +1122,72 @@ func (d *StorageDriver) DeleteSnapshot(
 }
 
 func (d *StorageDriver) Get(_ context.Context, name string) error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
 	_, ok := d.Volumes[name]
 	if !ok {
-		return fmt.Errorf("could not find volume %s", name)
+		return errors.NotFoundError("could not find volume %s", name)
 	}
 
 	return nil
 }
 
+// GetVolumeHealth reports whether the volume exists on this fake backend
+func (d *StorageDriver) GetVolumeHealth(
+	_ context.Context, volConfig *storage.VolumeConfig,
+) (*storage.VolumeHealth, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if _, ok := d.Volumes[volConfig.InternalName]; !ok {
+		return storage.NewVolumeHealth(storage.VolumeHealthMissing,
+			fmt.Sprintf("could not find volume %s", volConfig.InternalName)), nil
+	}
+
+	return storage.NewVolumeHealth(storage.VolumeHealthNormal, ""), nil
+}
+
+// GetPoolCapacity returns the space of the physical pool, or the combined space of all physical
+// pools if a virtual pool is specified.
+func (d *StorageDriver) GetPoolCapacity(_ context.Context, pool storage.Pool) (*storage.PoolCapacity, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	physicalPoolNames := make([]string, 0)
+	if _, ok := d.physicalPools[pool.Name()]; ok {
+		physicalPoolNames = append(physicalPoolNames, pool.Name())
+	} else if _, ok = d.virtualPools[pool.Name()]; ok {
+		for name := range d.physicalPools {
+			physicalPoolNames = append(physicalPoolNames, name)
+		}
+	} else {
+		return nil, errors.NotFoundError("pool %s not found", pool.Name())
+	}
+
+	capacity := &storage.PoolCapacity{}
+	for _, name := range physicalPoolNames {
+		fakePool, ok := d.fakePools[name]
+		if !ok {
+			continue
+		}
+		capacity.FreeBytes += fakePool.Bytes
+		capacity.TotalBytes += fakePool.Bytes
+		for _, volume := range d.Volumes {
+			if volume.PhysicalPool == name {
+				capacity.TotalBytes += volume.SizeBytes
+			}
+		}
+	}
+
+	return capacity, nil
+}
+
 // Resize expands the volume size.
 func (d *StorageDriver) Resize(_ context.Context, volConfig *storage.VolumeConfig, sizeBytes uint64) error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
 	name := volConfig.InternalName
 	vol := d.Volumes[name]
 
@@ -1167,6 +1318,9 @@ func (d *StorageDriver) GetExternalConfig(context.Context) interface{} {
 }
 
 func (d *StorageDriver) GetVolumeForImport(_ context.Context, volumeID string) (*storage.VolumeExternal, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
 	volume, ok := d.Volumes[volumeID]
 	if !ok {
 		return nil, fmt.Errorf("fake volume %s not found", volumeID)
@@ -1180,8 +1334,15 @@ func (d *StorageDriver) GetVolumeExternalWrappers(_ context.Context, channel cha
 	defer close(channel)
 
 	// Convert all volumes to VolumeExternal and write them to the channel
+	d.mutex.Lock()
+	volumes := make([]*storage.VolumeExternal, 0, len(d.Volumes))
 	for _, volume := range d.Volumes {
-		channel <- &storage.VolumeExternalWrapper{Volume: d.getVolumeExternal(volume), Error: nil}
+		volumes = append(volumes, d.getVolumeExternal(volume))
+	}
+	d.mutex.Unlock()
+
+	for _, volume := range volumes {
+		channel <- &storage.VolumeExternalWrapper{Volume: volume, Error: nil}
 	}
 }
 
@@ -1237,7 +1398,10 @@ func (d *StorageDriver) GetUpdateType(_ context.Context, driverOrig storage.Driv
 }
 
 // CopyVolumes copies Volumes into this instance; there is no "storage system of truth" to use
-func (d StorageDriver) CopyVolumes(volumes map[string]fake.Volume) {
+func (d *StorageDriver) CopyVolumes(volumes map[string]fake.Volume) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
 	for name, vol := range volumes {
 		d.Volumes[name] = vol
 	}
@@ -1268,7 +1432,7 @@ func (d *StorageDriver) handleVolumeCreatingTransaction(ctx context.Context, vol
 	return nil
 }
 
-func (d StorageDriver) generateCreatingVolumes() map[string]fake.CreatingVolume {
+func (d *StorageDriver) generateCreatingVolumes() map[string]fake.CreatingVolume {
 	creatingVolumes := make(map[string]fake.CreatingVolume)
 	transaction01 := fake.CreatingVolume{
 		Name:           PVC_creating_01,
@@ -1315,15 +1479,15 @@ func (d *StorageDriver) ReconcileNodeAccess(ctx context.Context, nodes []*utils.
 }
 
 // GetCommonConfig returns driver's CommonConfig
-func (d StorageDriver) GetCommonConfig(context.Context) *drivers.CommonStorageDriverConfig {
+func (d *StorageDriver) GetCommonConfig(context.Context) *drivers.CommonStorageDriverConfig {
 	return d.Config.CommonStorageDriverConfig
 }
 
-func (d StorageDriver) EnablePublishEnforcement(ctx context.Context, volume *storage.Volume) error {
+func (d *StorageDriver) EnablePublishEnforcement(ctx context.Context, volume *storage.Volume) error {
 	volume.Config.AccessInfo.PublishEnforcement = true
 	return nil
 }
 
-func (d StorageDriver) CanEnablePublishEnforcement() bool {
+func (d *StorageDriver) CanEnablePublishEnforcement() bool {
 	return true
 }
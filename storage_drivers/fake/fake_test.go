@@ -36,9 +36,9 @@ func TestNewConfig(t *testing.T) {
 }
 
 func TestStorageDriverString(t *testing.T) {
-	fakeStorageDrivers := []StorageDriver{
-		*NewFakeStorageDriverWithDebugTraceFlags(map[string]bool{"method": true}),
-		*NewFakeStorageDriverWithDebugTraceFlags(nil),
+	fakeStorageDrivers := []*StorageDriver{
+		NewFakeStorageDriverWithDebugTraceFlags(map[string]bool{"method": true}),
+		NewFakeStorageDriverWithDebugTraceFlags(nil),
 	}
 
 	// key: string to include in debug logs when the sensitive flag is set to true
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"reflect"
 	"regexp"
+	"sync"
 	"time"
 
 	netapp "cloud.google.com/go/netapp/apiv1"
@@ -17,6 +18,7 @@ import (
 	"golang.org/x/oauth2/google"
 	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
@@ -26,6 +28,7 @@ import (
 	drivers "github.com/netapp/trident/storage_drivers"
 	"github.com/netapp/trident/utils"
 	"github.com/netapp/trident/utils/errors"
+	"github.com/netapp/trident/utils/tracing"
 )
 
 const (
@@ -70,6 +73,12 @@ type ClientConfig struct {
 type GCNVClient struct {
 	gcnv *netapp.Client
 	GCNVResources
+
+	// resourcesMutex guards GCNVResources, which discovery replaces while volumes on the backend may be
+	// operated upon in parallel
+	resourcesMutex sync.RWMutex
+	// refreshMutex serializes refreshes of GCNVResources
+	refreshMutex sync.Mutex
 }
 
 // Client encapsulates connection details.
@@ -78,6 +87,10 @@ type Client struct {
 	sdkClient *GCNVClient
 }
 
+// tracingOption traces each call made to GCNV.
+var tracingOption = option.WithGRPCDialOption(
+	grpc.WithChainUnaryInterceptor(tracing.UnaryClientInterceptor("google-cloud-netapp-volumes")))
+
 func createGCNVClient(ctx context.Context, config *ClientConfig) (*netapp.Client, error) {
 	// Check if the config is empty
 	if reflect.ValueOf(*config.APIKey).IsZero() {
@@ -85,7 +98,7 @@ func createGCNVClient(ctx context.Context, config *ClientConfig) (*netapp.Client
 		if err != nil {
 			return nil, err
 		}
-		return netapp.NewClient(ctx, option.WithCredentials(credentials))
+		return netapp.NewClient(ctx, option.WithCredentials(credentials), tracingOption)
 	} else if config.APIKey != nil {
 		keyBytes, jsonErr := json.Marshal(config.APIKey)
 		if jsonErr != nil {
@@ -95,7 +108,7 @@ func createGCNVClient(ctx context.Context, config *ClientConfig) (*netapp.Client
 		if credsErr != nil {
 			return nil, credsErr
 		}
-		return netapp.NewClient(ctx, option.WithCredentials(creds))
+		return netapp.NewClient(ctx, option.WithCredentials(creds), tracingOption)
 	} else {
 		return nil, errors.New("apiKey in config must be specified")
 	}
@@ -127,11 +140,15 @@ func (c Client) Init(ctx context.Context, pools map[string]storage.Pool) error {
 
 // RegisterStoragePool makes a note of pools defined by the driver for later mapping.
 func (c Client) registerStoragePools(sPools map[string]storage.Pool) {
-	c.sdkClient.GCNVResources.StoragePoolMap = make(map[string]storage.Pool)
+	storagePoolMap := make(map[string]storage.Pool)
 
 	for _, sPool := range sPools {
-		c.sdkClient.GCNVResources.StoragePoolMap[sPool.Name()] = sPool
+		storagePoolMap[sPool.Name()] = sPool
 	}
+
+	c.sdkClient.resourcesMutex.Lock()
+	defer c.sdkClient.resourcesMutex.Unlock()
+	c.sdkClient.GCNVResources.StoragePoolMap = storagePoolMap
 }
 
 // ///////////////////////////////////////////////////////////////////////////////
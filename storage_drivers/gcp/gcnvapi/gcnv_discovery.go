@@ -35,8 +35,15 @@ const (
 // RefreshGCNVResources refreshes the cache of discovered GCNV resources and validates
 // them against our known storage pools.
 func (c Client) RefreshGCNVResources(ctx context.Context) error {
+	// Only one refresh runs at a time, and those waiting on it use what it discovered
+	c.sdkClient.refreshMutex.Lock()
+	defer c.sdkClient.refreshMutex.Unlock()
+
 	// Check if it is time to update the cache
-	if time.Now().Before(c.sdkClient.GCNVResources.lastUpdateTime.Add(c.config.MaxCacheAge)) {
+	c.sdkClient.resourcesMutex.RLock()
+	lastUpdateTime := c.sdkClient.GCNVResources.lastUpdateTime
+	c.sdkClient.resourcesMutex.RUnlock()
+	if time.Now().Before(lastUpdateTime.Add(c.config.MaxCacheAge)) {
 		Logc(ctx).Debugf("Cached resources not yet %v old, skipping refresh.", c.config.MaxCacheAge)
 		return nil
 	}
@@ -45,6 +52,9 @@ func (c Client) RefreshGCNVResources(ctx context.Context) error {
 	Logc(ctx).Debugf("Discovering GCNV resources.")
 	discoveryErr := multierr.Combine(c.DiscoverGCNVResources(ctx))
 
+	c.sdkClient.resourcesMutex.RLock()
+	defer c.sdkClient.resourcesMutex.RUnlock()
+
 	// This is noisy, hide it behind api tracing.
 	c.dumpGCNVResources(ctx, c.config.StorageDriverName, c.config.DebugTraceFlags["api"])
 
@@ -71,6 +81,8 @@ func (c Client) DiscoverGCNVResources(ctx context.Context) (returnError error) {
 		}
 
 		// Swap the newly discovered resources into the cache only if discovery succeeded.
+		c.sdkClient.resourcesMutex.Lock()
+		defer c.sdkClient.resourcesMutex.Unlock()
 		c.sdkClient.GCNVResources.CapacityPoolMap = newCapacityPoolMap
 		c.sdkClient.GCNVResources.lastUpdateTime = time.Now()
 
@@ -121,7 +133,7 @@ func (c Client) checkForUnsatisfiedPools(ctx context.Context) (discoveryErrors [
 	for sPoolName, sPool := range c.sdkClient.GCNVResources.StoragePoolMap {
 
 		// Find all capacity pools that work for this storage pool
-		cPools := c.CapacityPoolsForStoragePool(ctx, sPool, sPool.InternalAttributes()[serviceLevel])
+		cPools := c.capacityPoolsForStoragePool(ctx, sPool, sPool.InternalAttributes()[serviceLevel])
 
 		if len(cPools) == 0 {
 
@@ -287,6 +299,9 @@ func (c Client) discoverCapacityPools(ctx context.Context) (*[]*CapacityPool, er
 
 // CapacityPools returns a list of all discovered GCNV capacity pools.
 func (c Client) CapacityPools() *[]*CapacityPool {
+	c.sdkClient.resourcesMutex.RLock()
+	defer c.sdkClient.resourcesMutex.RUnlock()
+
 	var cPools []*CapacityPool
 
 	for _, cPool := range c.sdkClient.GCNVResources.CapacityPoolMap {
@@ -298,6 +313,9 @@ func (c Client) CapacityPools() *[]*CapacityPool {
 
 // capacityPool returns a single discovered capacity pool by its short name.
 func (c Client) capacityPool(cPoolName string) *CapacityPool {
+	c.sdkClient.resourcesMutex.RLock()
+	defer c.sdkClient.resourcesMutex.RUnlock()
+
 	for _, cPool := range c.sdkClient.GCNVResources.CapacityPoolMap {
 		if cPool.Name == cPoolName {
 			return cPool
@@ -309,12 +327,15 @@ func (c Client) capacityPool(cPoolName string) *CapacityPool {
 // CapacityPoolsForStoragePools returns all discovered capacity pools matching all known storage pools,
 // regardless of service levels.
 func (c Client) CapacityPoolsForStoragePools(ctx context.Context) []*CapacityPool {
+	c.sdkClient.resourcesMutex.RLock()
+	defer c.sdkClient.resourcesMutex.RUnlock()
+
 	// This map deduplicates cPools from multiple storage pools
 	cPoolMap := make(map[*CapacityPool]bool)
 
 	// Build deduplicated map of cPools
 	for _, sPool := range c.sdkClient.StoragePoolMap {
-		for _, cPool := range c.CapacityPoolsForStoragePool(ctx, sPool, "") {
+		for _, cPool := range c.capacityPoolsForStoragePool(ctx, sPool, "") {
 			cPoolMap[cPool] = true
 		}
 	}
@@ -333,6 +354,16 @@ func (c Client) CapacityPoolsForStoragePools(ctx context.Context) []*CapacityPoo
 // storage pool and service level.  The pools are shuffled to enable easier random selection.
 func (c Client) CapacityPoolsForStoragePool(
 	ctx context.Context, sPool storage.Pool, serviceLevel string,
+) []*CapacityPool {
+	c.sdkClient.resourcesMutex.RLock()
+	defer c.sdkClient.resourcesMutex.RUnlock()
+
+	return c.capacityPoolsForStoragePool(ctx, sPool, serviceLevel)
+}
+
+// capacityPoolsForStoragePool implements CapacityPoolsForStoragePool.  The caller must hold the resources lock.
+func (c Client) capacityPoolsForStoragePool(
+	ctx context.Context, sPool storage.Pool, serviceLevel string,
 ) []*CapacityPool {
 	Logd(ctx, c.config.StorageDriverName, c.config.DebugTraceFlags["discovery"]).WithField("storagePool", sPool.Name()).
 		Tracef("Determining capacity pools for storage pool.")
@@ -1774,6 +1774,9 @@ func (d *NASStorageDriver) Get(ctx context.Context, name string) error {
 	}
 
 	if _, err := d.API.VolumeByName(ctx, name); err != nil {
+		if errors.IsNotFoundError(err) {
+			return errors.WrapWithNotFoundError(err, "could not get volume %s; %v", name, err)
+		}
 		return fmt.Errorf("could not get volume %s; %v", name, err)
 	}
 
@@ -5650,9 +5650,10 @@ func TestGet_DiscoveryFailed(t *testing.T) {
 	result := driver.Get(ctx, "volume1")
 
 	assert.Error(t, result, "expected error")
+	assert.False(t, errors.IsNotFoundError(result), "expected error other than not found")
 }
 
-func TestGet_NotFound(t *testing.T) {
+func TestGet_Error(t *testing.T) {
 	mockAPI, driver := newMockGCNVDriver(t)
 
 	storagePrefix := "myPrefix-"
@@ -5664,6 +5665,23 @@ func TestGet_NotFound(t *testing.T) {
 	result := driver.Get(ctx, "volume1")
 
 	assert.Error(t, result, "expected error")
+	assert.False(t, errors.IsNotFoundError(result), "expected error other than not found")
+}
+
+func TestGet_NotFound(t *testing.T) {
+	mockAPI, driver := newMockGCNVDriver(t)
+
+	storagePrefix := "myPrefix-"
+	driver.Config.StoragePrefix = &storagePrefix
+
+	mockAPI.EXPECT().RefreshGCNVResources(ctx).Return(nil).Times(1)
+	mockAPI.EXPECT().VolumeByName(ctx, "volume1").Return(nil,
+		errors.WrapWithNotFoundError(errFailed, "volume 'volume1' not found")).Times(1)
+
+	result := driver.Get(ctx, "volume1")
+
+	assert.Error(t, result, "expected error")
+	assert.True(t, errors.IsNotFoundError(result), "expected not found error")
 }
 
 func TestResize(t *testing.T) {
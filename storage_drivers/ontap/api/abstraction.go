@@ -129,6 +129,7 @@ type OntapAPI interface {
 	QtreeDestroyAsync(ctx context.Context, path string, force bool) error
 	QtreeRename(ctx context.Context, path, newPath string) error
 	QtreeModifyExportPolicy(ctx context.Context, name, volumeName, newExportPolicyName string) error
+	QtreeModifyQosPolicy(ctx context.Context, name, volumeName, qosPolicy string) error
 	QtreeCount(ctx context.Context, volumeName string) (int, error)
 	QtreeListByPrefix(ctx context.Context, prefix, volumePrefix string) (Qtrees, error)
 	QtreeGetByName(ctx context.Context, name, volumePrefix string) (*Qtree, error)
@@ -192,6 +193,9 @@ type OntapAPI interface {
 	VolumeCreate(ctx context.Context, volume Volume) error
 	VolumeDestroy(ctx context.Context, volumeName string, force bool) error
 	VolumeModifySnapshotDirectoryAccess(ctx context.Context, name string, enable bool) error
+	VolumeModifySnapshotPolicy(ctx context.Context, name, snapshotPolicy string) error
+	VolumeModifyTieringPolicy(ctx context.Context, name, tieringPolicy string) error
+	VolumeMove(ctx context.Context, name, aggregate string) error
 	VolumeExists(ctx context.Context, volumeName string) (bool, error)
 	VolumeInfo(ctx context.Context, volumeName string) (*Volume, error)
 	VolumeListByPrefix(ctx context.Context, prefix string) (Volumes, error)
@@ -209,6 +213,7 @@ type OntapAPI interface {
 	VolumeSize(ctx context.Context, volumeName string) (uint64, error)
 	VolumeUsedSize(ctx context.Context, volumeName string) (int, error)
 	VolumeSnapshotCreate(ctx context.Context, snapshotName, sourceVolume string) error
+	ConsistencyGroupSnapshot(ctx context.Context, snapshotName string, volumes []string) error
 	VolumeSnapshotInfo(ctx context.Context, snapshotName, sourceVolume string) (Snapshot, error)
 	VolumeSnapshotList(ctx context.Context, sourceVolume string) (Snapshots, error)
 	VolumeSnapshotDelete(ctx context.Context, snapshotName, sourceVolume string) error
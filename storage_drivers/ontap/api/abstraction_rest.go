@@ -190,7 +190,7 @@ func (d OntapAPIREST) VolumeInfo(ctx context.Context, name string) (*Volume, err
 	fields := []string{
 		"type", "size", "comment", "aggregates", "nas", "guarantee",
 		"snapshot_policy", "snapshot_directory_access_enabled",
-		"space.snapshot.used", "space.snapshot.reserve_percent",
+		"space.snapshot.used", "space.snapshot.reserve_percent", "state", "movement.state",
 	}
 	volumeGetResponse, err := d.api.VolumeGetByName(ctx, name, fields)
 	if err != nil {
@@ -265,7 +265,10 @@ func VolumeInfoFromRestAttrsHelper(volumeGetResponse *models.Volume) (*Volume, e
 	var responseSnapshotReserveInt int
 	var responseSnapshotSpaceUsed int
 	var responseSpaceReserve string
+	var responseState string
 	var responseUnixPermissions string
+	var responseMoveInProgress bool
+	var responseMoveState string
 
 	if volumeGetResponse == nil {
 		return nil, fmt.Errorf("volumeGetResponse was nil")
@@ -333,6 +336,20 @@ func VolumeInfoFromRestAttrsHelper(volumeGetResponse *models.Volume) (*Volume, e
 		responseSnapdirAccessEnabled = volumeGetResponse.SnapshotDirectoryAccessEnabled
 	}
 
+	if volumeGetResponse.State != nil {
+		responseState = *volumeGetResponse.State
+	}
+
+	if volumeGetResponse.Movement != nil && volumeGetResponse.Movement.State != nil {
+		responseMoveState = *volumeGetResponse.Movement.State
+		switch *volumeGetResponse.Movement.State {
+		case models.VolumeInlineMovementStateSuccess, models.VolumeInlineMovementStateFailed,
+			models.VolumeInlineMovementStateAborted:
+		default:
+			responseMoveInProgress = true
+		}
+	}
+
 	volumeInfo := &Volume{
 		AccessType:        responseAccessType,
 		Aggregates:        responseAggregates,
@@ -345,8 +362,11 @@ func VolumeInfoFromRestAttrsHelper(volumeGetResponse *models.Volume) (*Volume, e
 		SnapshotReserve:   responseSnapshotReserveInt,
 		SnapshotSpaceUsed: responseSnapshotSpaceUsed,
 		SpaceReserve:      responseSpaceReserve,
+		State:             responseState,
 		UnixPermissions:   responseUnixPermissions,
 		DPVolume:          responseAccessType == "dp",
+		MoveInProgress:    responseMoveInProgress,
+		MoveState:         responseMoveState,
 	}
 
 	if volumeGetResponse.Name != nil {
@@ -936,6 +956,31 @@ func (d OntapAPIREST) VolumeModifySnapshotDirectoryAccess(ctx context.Context, n
 	return nil
 }
 
+func (d OntapAPIREST) VolumeModifySnapshotPolicy(ctx context.Context, name, snapshotPolicy string) error {
+	if err := d.api.VolumeModifySnapshotPolicy(ctx, name, snapshotPolicy); err != nil {
+		return fmt.Errorf("error modifying snapshot policy; %v", err)
+	}
+
+	return nil
+}
+
+func (d OntapAPIREST) VolumeModifyTieringPolicy(ctx context.Context, name, tieringPolicy string) error {
+	if err := d.api.VolumeModifyTieringPolicy(ctx, name, tieringPolicy); err != nil {
+		return fmt.Errorf("error modifying tiering policy; %v", err)
+	}
+
+	return nil
+}
+
+// VolumeMove starts moving a flexvol to another aggregate.  The move completes in the background.
+func (d OntapAPIREST) VolumeMove(ctx context.Context, name, aggregate string) error {
+	if err := d.api.VolumeMoveStart(ctx, name, aggregate); err != nil {
+		return fmt.Errorf("error starting volume move; %v", err)
+	}
+
+	return nil
+}
+
 func (d OntapAPIREST) VolumeMount(ctx context.Context, name, junctionPath string) error {
 	// Mount the volume at the specified junction
 	if err := d.api.VolumeMount(ctx, name, junctionPath); err != nil {
@@ -1224,6 +1269,10 @@ func (d OntapAPIREST) QtreeModifyExportPolicy(ctx context.Context, name, volumeN
 	return d.api.QtreeModifyExportPolicy(ctx, name, volumeName, newExportPolicyName)
 }
 
+func (d OntapAPIREST) QtreeModifyQosPolicy(ctx context.Context, name, volumeName, qosPolicy string) error {
+	return d.api.QtreeModifyQosPolicy(ctx, name, volumeName, qosPolicy)
+}
+
 func (d OntapAPIREST) QtreeCount(ctx context.Context, volumeName string) (int, error) {
 	return d.api.QtreeCount(ctx, volumeName)
 }
@@ -1394,6 +1443,42 @@ func (d OntapAPIREST) VolumeSnapshotCreate(ctx context.Context, snapshotName, so
 	return nil
 }
 
+// ConsistencyGroupSnapshot creates a snapshot with the same name on each of the volumes, all at the same
+// point in time.  ONTAP only snapshots consistency groups, so the volumes are placed in a temporary consistency
+// group, named after the snapshot, for the duration of the operation.
+func (d OntapAPIREST) ConsistencyGroupSnapshot(ctx context.Context, snapshotName string, volumes []string) error {
+	consistencyGroupName := snapshotName
+
+	if err := d.api.ConsistencyGroupCreateAndWait(ctx, consistencyGroupName, volumes); err != nil {
+		return fmt.Errorf("error creating consistency group %s: %v", consistencyGroupName, err)
+	}
+
+	consistencyGroup, err := d.api.ConsistencyGroupGetByName(ctx, consistencyGroupName)
+	if err != nil {
+		return fmt.Errorf("error looking up consistency group %s: %v", consistencyGroupName, err)
+	}
+	if consistencyGroup == nil || consistencyGroup.UUID == nil {
+		return fmt.Errorf("could not find consistency group %s", consistencyGroupName)
+	}
+	consistencyGroupUUID := *consistencyGroup.UUID
+
+	defer func() {
+		// Deleting the consistency group leaves its volumes and their snapshots in place
+		if err := d.api.ConsistencyGroupDeleteAndWait(ctx, consistencyGroupUUID); err != nil {
+			Logc(ctx).WithFields(LogFields{
+				"consistencyGroup": consistencyGroupName,
+				"error":            err,
+			}).Warning("Could not delete temporary consistency group.")
+		}
+	}()
+
+	if err = d.api.ConsistencyGroupSnapshotCreateAndWait(ctx, consistencyGroupUUID, snapshotName); err != nil {
+		return fmt.Errorf("error creating consistency group snapshot: %v", err)
+	}
+
+	return nil
+}
+
 // pollVolumeExistence polls for the volume, with backoff retry logic
 func (d OntapAPIREST) pollVolumeExistence(ctx context.Context, volumeName string) error {
 	checkVolumeStatus := func() error {
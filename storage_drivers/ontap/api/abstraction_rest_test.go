@@ -2824,6 +2824,42 @@ func TestVolumeSnapshotCreate(t *testing.T) {
 	assert.Error(t, err, "no error returned while creating a snapshot")
 }
 
+func TestConsistencyGroupSnapshot(t *testing.T) {
+	oapi, rsi := newMockOntapAPIREST(t)
+	volumes := []string{"vol1", "vol2"}
+	consistencyGroup := &models.ConsistencyGroupResponseInlineRecordsInlineArrayItem{
+		Name: utils.Ptr("snap1"),
+		UUID: utils.Ptr("cg-uuid"),
+	}
+
+	// case 1: Snapshot the volumes through a temporary consistency group, which is then deleted
+	rsi.EXPECT().ConsistencyGroupCreateAndWait(ctx, "snap1", volumes).Return(nil)
+	rsi.EXPECT().ConsistencyGroupGetByName(ctx, "snap1").Return(consistencyGroup, nil)
+	rsi.EXPECT().ConsistencyGroupSnapshotCreateAndWait(ctx, "cg-uuid", "snap1").Return(nil)
+	rsi.EXPECT().ConsistencyGroupDeleteAndWait(ctx, "cg-uuid").Return(nil)
+	err := oapi.ConsistencyGroupSnapshot(ctx, "snap1", volumes)
+	assert.NoError(t, err, "error returned while creating a consistency group snapshot")
+
+	// case 2: The consistency group cannot be created
+	rsi.EXPECT().ConsistencyGroupCreateAndWait(ctx, "snap1", volumes).Return(fmt.Errorf("volume in use"))
+	err = oapi.ConsistencyGroupSnapshot(ctx, "snap1", volumes)
+	assert.Error(t, err, "no error returned while creating a consistency group snapshot")
+
+	// case 3: The consistency group is not found after creation
+	rsi.EXPECT().ConsistencyGroupCreateAndWait(ctx, "snap1", volumes).Return(nil)
+	rsi.EXPECT().ConsistencyGroupGetByName(ctx, "snap1").Return(nil, nil)
+	err = oapi.ConsistencyGroupSnapshot(ctx, "snap1", volumes)
+	assert.Error(t, err, "no error returned while creating a consistency group snapshot")
+
+	// case 4: The snapshot fails, but the consistency group is still deleted
+	rsi.EXPECT().ConsistencyGroupCreateAndWait(ctx, "snap1", volumes).Return(nil)
+	rsi.EXPECT().ConsistencyGroupGetByName(ctx, "snap1").Return(consistencyGroup, nil)
+	rsi.EXPECT().ConsistencyGroupSnapshotCreateAndWait(ctx, "cg-uuid", "snap1").Return(fmt.Errorf("failed"))
+	rsi.EXPECT().ConsistencyGroupDeleteAndWait(ctx, "cg-uuid").Return(nil)
+	err = oapi.ConsistencyGroupSnapshot(ctx, "snap1", volumes)
+	assert.Error(t, err, "no error returned while creating a consistency group snapshot")
+}
+
 func TestVolumeCloneCreate(t *testing.T) {
 	oapi, rsi := newMockOntapAPIREST(t)
 
@@ -143,7 +143,9 @@ func VolumeInfoFromZapiAttrsHelper(volumeGetResponse *azgo.VolumeAttributesType)
 		responseSnapshotReserveInt   int
 		responseSnapshotSpaceUsed    int
 		responseSpaceReserve         string
+		responseState                string
 		responseUnixPermissions      string
+		responseMoveInProgress       bool
 	)
 
 	if volumeGetResponse.VolumeIdAttributesPtr != nil {
@@ -210,6 +212,16 @@ func VolumeInfoFromZapiAttrsHelper(volumeGetResponse *azgo.VolumeAttributesType)
 		}
 	}
 
+	if volumeGetResponse.VolumeStateAttributesPtr != nil &&
+		volumeGetResponse.VolumeStateAttributesPtr.StatePtr != nil {
+		responseState = volumeGetResponse.VolumeStateAttributesPtr.State()
+	}
+
+	if volumeGetResponse.VolumeStateAttributesPtr != nil &&
+		volumeGetResponse.VolumeStateAttributesPtr.IsMovingPtr != nil {
+		responseMoveInProgress = volumeGetResponse.VolumeStateAttributesPtr.IsMoving()
+	}
+
 	if volumeGetResponse.VolumeSnapshotAttributesPtr != nil {
 		if volumeGetResponse.VolumeSnapshotAttributesPtr.SnapshotPolicyPtr != nil {
 			responseSnapshotPolicy = volumeGetResponse.VolumeSnapshotAttributesPtr.SnapshotPolicy()
@@ -231,8 +243,10 @@ func VolumeInfoFromZapiAttrsHelper(volumeGetResponse *azgo.VolumeAttributesType)
 		SnapshotReserve:   responseSnapshotReserveInt,
 		SnapshotSpaceUsed: responseSnapshotSpaceUsed,
 		SpaceReserve:      responseSpaceReserve,
+		State:             responseState,
 		UnixPermissions:   responseUnixPermissions,
 		DPVolume:          responseAccessType == "dp",
+		MoveInProgress:    responseMoveInProgress,
 	}
 	return volumeInfo, nil
 }
@@ -1438,6 +1452,34 @@ func (d OntapAPIZAPI) VolumeModifySnapshotDirectoryAccess(ctx context.Context, n
 	return nil
 }
 
+func (d OntapAPIZAPI) VolumeModifySnapshotPolicy(ctx context.Context, name, snapshotPolicy string) error {
+	response, err := d.api.VolumeModifySnapshotPolicy(name, snapshotPolicy)
+	if err = azgo.GetError(ctx, response, err); err != nil {
+		return fmt.Errorf("error modifying snapshot policy: %v", err)
+	}
+
+	return nil
+}
+
+func (d OntapAPIZAPI) VolumeModifyTieringPolicy(ctx context.Context, name, tieringPolicy string) error {
+	response, err := d.api.VolumeModifyTieringPolicy(name, tieringPolicy)
+	if err = azgo.GetError(ctx, response, err); err != nil {
+		return fmt.Errorf("error modifying tiering policy: %v", err)
+	}
+
+	return nil
+}
+
+// VolumeMove starts moving a flexvol to another aggregate.  The move completes in the background.
+func (d OntapAPIZAPI) VolumeMove(ctx context.Context, name, aggregate string) error {
+	response, err := d.api.VolumeMoveStart(name, aggregate)
+	if err = azgo.GetError(ctx, response, err); err != nil {
+		return fmt.Errorf("error starting volume move: %v", err)
+	}
+
+	return nil
+}
+
 func (d OntapAPIZAPI) VolumeMount(ctx context.Context, name, junctionPath string) error {
 	mountResponse, err := d.api.VolumeMount(name, junctionPath)
 	if err = azgo.GetError(ctx, mountResponse, err); err != nil {
@@ -1697,6 +1739,11 @@ func (d OntapAPIZAPI) QtreeModifyExportPolicy(ctx context.Context, name, volumeN
 	return azgo.GetError(ctx, response, err)
 }
 
+func (d OntapAPIZAPI) QtreeModifyQosPolicy(ctx context.Context, name, volumeName, qosPolicy string) error {
+	response, err := d.api.QtreeModifyQosPolicy(name, volumeName, qosPolicy)
+	return azgo.GetError(ctx, response, err)
+}
+
 func (d OntapAPIZAPI) QtreeCount(ctx context.Context, volumeName string) (int, error) {
 	return d.api.QtreeCount(ctx, volumeName)
 }
@@ -1875,6 +1922,22 @@ func (d OntapAPIZAPI) VolumeSnapshotCreate(ctx context.Context, snapshotName, so
 	return nil
 }
 
+// ConsistencyGroupSnapshot creates a snapshot with the same name on each of the volumes, all at the same
+// point in time, by fencing I/O to the volumes until the snapshots are committed.
+func (d OntapAPIZAPI) ConsistencyGroupSnapshot(ctx context.Context, snapshotName string, volumes []string) error {
+	startResponse, err := d.api.ConsistencyGroupStart(snapshotName, volumes)
+	if err = azgo.GetError(ctx, startResponse, err); err != nil {
+		return fmt.Errorf("error starting consistency group snapshot: %v", err)
+	}
+
+	commitResponse, err := d.api.ConsistencyGroupCommit(startResponse.Result.CgId())
+	if err = azgo.GetError(ctx, commitResponse, err); err != nil {
+		return fmt.Errorf("error committing consistency group snapshot: %v", err)
+	}
+
+	return nil
+}
+
 // probeForVolume polls for the ONTAP volume to appear, with backoff retry logic
 func (d OntapAPIZAPI) probeForVolume(ctx context.Context, name string) error {
 	checkVolumeExists := func() error {
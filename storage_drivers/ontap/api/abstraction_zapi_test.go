@@ -11,6 +11,7 @@ import (
 
 	mockapi "github.com/netapp/trident/mocks/mock_storage_drivers/mock_ontap"
 	"github.com/netapp/trident/storage_drivers/ontap/api"
+	"github.com/netapp/trident/storage_drivers/ontap/api/azgo"
 )
 
 func TestOntapAPIZAPI_LunGetFSType(t *testing.T) {
@@ -104,3 +105,36 @@ func TestOntapAPIZAPI_LunGetFSType_Failure(t *testing.T) {
 	assert.Empty(t, fstype)
 	assert.Error(t, err)
 }
+
+func TestOntapAPIZAPI_ConsistencyGroupSnapshot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mock := mockapi.NewMockZapiClientInterface(ctrl)
+	oapi, err := api.NewOntapAPIZAPIFromZapiClientInterface(mock)
+	assert.NoError(t, err)
+
+	volumes := []string{"vol1", "vol2"}
+	startResponse := azgo.NewCgStartResponse()
+	startResponse.Result.ResultStatusAttr = "passed"
+	startResponse.Result.SetCgId(42)
+	commitResponse := azgo.NewCgCommitResponse()
+	commitResponse.Result.ResultStatusAttr = "passed"
+
+	// Case 1: The snapshot is started and committed
+	mock.EXPECT().ConsistencyGroupStart("snap1", volumes).Return(startResponse, nil)
+	mock.EXPECT().ConsistencyGroupCommit(42).Return(commitResponse, nil)
+	err = oapi.ConsistencyGroupSnapshot(ctx, "snap1", volumes)
+	assert.NoError(t, err)
+
+	// Case 2: The snapshot cannot be started
+	mock.EXPECT().ConsistencyGroupStart("snap1", volumes).Return(nil, fmt.Errorf("cg-start failed"))
+	err = oapi.ConsistencyGroupSnapshot(ctx, "snap1", volumes)
+	assert.Error(t, err)
+
+	// Case 3: The snapshot cannot be committed
+	mock.EXPECT().ConsistencyGroupStart("snap1", volumes).Return(startResponse, nil)
+	mock.EXPECT().ConsistencyGroupCommit(42).Return(nil, fmt.Errorf("cg-commit failed"))
+	err = oapi.ConsistencyGroupSnapshot(ctx, "snap1", volumes)
+	assert.Error(t, err)
+}
@@ -24,6 +24,7 @@ import (
 	. "github.com/netapp/trident/logging"
 	"github.com/netapp/trident/utils"
 	"github.com/netapp/trident/utils/errors"
+	"github.com/netapp/trident/utils/tracing"
 )
 
 type ZAPIRequest interface {
@@ -173,7 +174,7 @@ func (o *ZapiRunner) SendZapi(r ZAPIRequest) (*http.Response, error) {
 	}
 
 	client := &http.Client{
-		Transport: tr,
+		Transport: tracing.NewTransport("ontap-zapi", tr),
 		Timeout:   time.Duration(tridentconfig.StorageAPITimeoutSeconds * time.Second),
 	}
 	response, err := client.Do(req)
@@ -29,6 +29,7 @@ import (
 	. "github.com/netapp/trident/logging"
 	drivers "github.com/netapp/trident/storage_drivers"
 	"github.com/netapp/trident/storage_drivers/ontap/api/rest/client"
+	"github.com/netapp/trident/storage_drivers/ontap/api/rest/client/application"
 	"github.com/netapp/trident/storage_drivers/ontap/api/rest/client/cluster"
 	nas "github.com/netapp/trident/storage_drivers/ontap/api/rest/client/n_a_s"
 	nvme "github.com/netapp/trident/storage_drivers/ontap/api/rest/client/n_v_me"
@@ -41,6 +42,7 @@ import (
 	"github.com/netapp/trident/storage_drivers/ontap/api/rest/models"
 	"github.com/netapp/trident/utils"
 	"github.com/netapp/trident/utils/errors"
+	"github.com/netapp/trident/utils/tracing"
 	versionutils "github.com/netapp/trident/utils/version"
 )
 
@@ -134,7 +136,7 @@ func NewRestClient(ctx context.Context, config ClientConfig, SVM, driverName str
 	}
 
 	result.httpClient = &http.Client{
-		Transport: result.tr,
+		Transport: tracing.NewTransport("ontap-rest", result.tr),
 		Timeout:   time.Duration(60 * time.Second),
 	}
 
@@ -953,6 +955,40 @@ func (c RestClient) setVolumeCommentByNameAndStyle(
 	return c.PollJobStatus(ctx, volumeModifyAccepted.Payload)
 }
 
+// modifyVolumeByNameAndStyle applies the supplied volume attributes to the volume with the specified name and style
+func (c RestClient) modifyVolumeByNameAndStyle(
+	ctx context.Context, volumeName, style string, volumeInfo *models.Volume,
+) error {
+	fields := []string{""}
+	volume, err := c.getVolumeByNameAndStyle(ctx, volumeName, style, fields)
+	if err != nil {
+		return err
+	}
+	if volume == nil {
+		return fmt.Errorf("could not find volume with name %v", volumeName)
+	}
+	if volume.UUID == nil {
+		return fmt.Errorf("could not find volume uuid with name %v", volumeName)
+	}
+
+	params := storage.NewVolumeModifyParamsWithTimeout(c.httpClient.Timeout)
+	params.Context = ctx
+	params.HTTPClient = c.httpClient
+	params.UUID = *volume.UUID
+
+	params.SetInfo(volumeInfo)
+
+	volumeModifyAccepted, err := c.api.Storage.VolumeModify(params, c.authInfo)
+	if err != nil {
+		return err
+	}
+	if volumeModifyAccepted == nil {
+		return fmt.Errorf("unexpected response from volume modify")
+	}
+
+	return c.PollJobStatus(ctx, volumeModifyAccepted.Payload)
+}
+
 // convertUnixPermissions turns "rwx" into "7" and so on, if possible, otherwise returns the string
 func convertUnixPermissions(s string) string {
 	s = strings.TrimPrefix(s, "---")
@@ -1523,6 +1559,64 @@ func (c RestClient) VolumeSetQosPolicyGroupName(
 	return c.setVolumeQosPolicyGroupNameByNameAndStyle(ctx, volumeName, qosPolicyGroup, models.VolumeStyleFlexvol)
 }
 
+// VolumeModifySnapshotPolicy sets the snapshot policy of the specified flexvol
+// equivalent to filer::> volume modify -vserver iscsi_vs -volume v -snapshot-policy newSnapshotPolicy
+func (c RestClient) VolumeModifySnapshotPolicy(ctx context.Context, volumeName, snapshotPolicy string) error {
+	volumeInfo := &models.Volume{
+		SnapshotPolicy: &models.VolumeInlineSnapshotPolicy{Name: utils.Ptr(snapshotPolicy)},
+	}
+	return c.modifyVolumeByNameAndStyle(ctx, volumeName, models.VolumeStyleFlexvol, volumeInfo)
+}
+
+// VolumeModifyTieringPolicy sets the tiering policy of the specified flexvol
+// equivalent to filer::> volume modify -vserver iscsi_vs -volume v -tiering-policy newTieringPolicy
+func (c RestClient) VolumeModifyTieringPolicy(ctx context.Context, volumeName, tieringPolicy string) error {
+	volumeInfo := &models.Volume{
+		Tiering: &models.VolumeInlineTiering{Policy: utils.Ptr(tieringPolicy)},
+	}
+	return c.modifyVolumeByNameAndStyle(ctx, volumeName, models.VolumeStyleFlexvol, volumeInfo)
+}
+
+// VolumeMoveStart starts a nondisruptive move of the specified flexvol to another aggregate in the same SVM.
+// The move continues in the background, and its progress is reported in the volume's movement state.
+// equivalent to filer::> volume move start -vserver iscsi_vs -volume v -destination-aggregate newAggregate
+func (c RestClient) VolumeMoveStart(ctx context.Context, volumeName, aggregate string) error {
+	fields := []string{""}
+	volume, err := c.getVolumeByNameAndStyle(ctx, volumeName, models.VolumeStyleFlexvol, fields)
+	if err != nil {
+		return err
+	}
+	if volume == nil {
+		return fmt.Errorf("could not find volume with name %v", volumeName)
+	}
+	if volume.UUID == nil {
+		return fmt.Errorf("could not find volume uuid with name %v", volumeName)
+	}
+
+	params := storage.NewVolumeModifyParamsWithTimeout(c.httpClient.Timeout)
+	params.Context = ctx
+	params.HTTPClient = c.httpClient
+	params.UUID = *volume.UUID
+
+	params.SetInfo(&models.Volume{
+		Movement: &models.VolumeInlineMovement{
+			DestinationAggregate: &models.VolumeInlineMovementInlineDestinationAggregate{
+				Name: utils.Ptr(aggregate),
+			},
+		},
+	})
+
+	volumeModifyAccepted, err := c.api.Storage.VolumeModify(params, c.authInfo)
+	if err != nil {
+		return err
+	}
+	if volumeModifyAccepted == nil {
+		return fmt.Errorf("unexpected response from volume modify")
+	}
+
+	return nil
+}
+
 // VolumeCloneSplitStart starts splitting theflexvol clone
 func (c RestClient) VolumeCloneSplitStart(ctx context.Context, volumeName string) error {
 	return c.startCloneSplitByNameAndStyle(ctx, volumeName, models.VolumeStyleFlexvol)
@@ -1571,6 +1665,113 @@ func (c RestClient) SnapshotCreateAndWait(ctx context.Context, volumeUUID, snaps
 	return c.PollJobStatus(ctx, snapshotCreateResult.Payload)
 }
 
+// ConsistencyGroupCreateAndWait creates a consistency group from existing volumes and waits on the job to complete
+func (c RestClient) ConsistencyGroupCreateAndWait(
+	ctx context.Context, consistencyGroupName string, volumeNames []string,
+) error {
+	params := application.NewConsistencyGroupCreateParamsWithTimeout(c.httpClient.Timeout)
+	params.Context = ctx
+	params.HTTPClient = c.httpClient
+
+	volumes := make([]*models.ConsistencyGroupInlineVolumesInlineArrayItem, 0, len(volumeNames))
+	for _, volumeName := range volumeNames {
+		volumes = append(volumes, &models.ConsistencyGroupInlineVolumesInlineArrayItem{
+			Name: utils.Ptr(volumeName),
+			ProvisioningOptions: &models.ConsistencyGroupInlineVolumesInlineArrayItemInlineProvisioningOptions{
+				Action: utils.Ptr(models.ConsistencyGroupInlineVolumesInlineArrayItemInlineProvisioningOptionsActionAdd),
+			},
+		})
+	}
+
+	consistencyGroupInfo := &models.ConsistencyGroup{
+		Name:                          utils.Ptr(consistencyGroupName),
+		Svm:                           &models.ConsistencyGroupInlineSvm{UUID: utils.Ptr(c.svmUUID)},
+		ConsistencyGroupInlineVolumes: volumes,
+	}
+
+	params.SetInfo(consistencyGroupInfo)
+
+	_, createAccepted, err := c.api.Application.ConsistencyGroupCreate(params, c.authInfo)
+	if err != nil {
+		return fmt.Errorf("could not create consistency group; %v", err)
+	}
+	if createAccepted == nil {
+		// The consistency group was created synchronously
+		return nil
+	}
+
+	return c.PollJobStatus(ctx, createAccepted.Payload)
+}
+
+// ConsistencyGroupGetByName gets the consistency group with the specified name
+func (c RestClient) ConsistencyGroupGetByName(
+	ctx context.Context, consistencyGroupName string,
+) (*models.ConsistencyGroupResponseInlineRecordsInlineArrayItem, error) {
+	params := application.NewConsistencyGroupCollectionGetParamsWithTimeout(c.httpClient.Timeout)
+	params.Context = ctx
+	params.HTTPClient = c.httpClient
+
+	params.SvmUUID = utils.Ptr(c.svmUUID)
+	params.SetName(utils.Ptr(consistencyGroupName))
+	params.SetFields([]string{"name", "uuid"})
+
+	result, err := c.api.Application.ConsistencyGroupCollectionGet(params, c.authInfo)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil || result.Payload == nil || result.Payload.NumRecords == nil ||
+		*result.Payload.NumRecords != 1 || result.Payload.ConsistencyGroupResponseInlineRecords == nil {
+		return nil, nil
+	}
+
+	return result.Payload.ConsistencyGroupResponseInlineRecords[0], nil
+}
+
+// ConsistencyGroupSnapshotCreateAndWait snapshots every volume in a consistency group at the same point in time,
+// and waits on the job to complete
+func (c RestClient) ConsistencyGroupSnapshotCreateAndWait(
+	ctx context.Context, consistencyGroupUUID, snapshotName string,
+) error {
+	params := application.NewConsistencyGroupSnapshotCreateParamsWithTimeout(c.httpClient.Timeout)
+	params.Context = ctx
+	params.HTTPClient = c.httpClient
+
+	params.ConsistencyGroupUUID = consistencyGroupUUID
+	params.SetInfo(&models.ConsistencyGroupSnapshot{
+		Name: utils.Ptr(snapshotName),
+	})
+
+	_, snapshotAccepted, err := c.api.Application.ConsistencyGroupSnapshotCreate(params, c.authInfo)
+	if err != nil {
+		return fmt.Errorf("could not create consistency group snapshot; %v", err)
+	}
+	if snapshotAccepted == nil {
+		return nil
+	}
+
+	return c.PollJobStatus(ctx, snapshotAccepted.Payload)
+}
+
+// ConsistencyGroupDeleteAndWait deletes a consistency group, leaving its volumes and their snapshots in place,
+// and waits on the job to complete
+func (c RestClient) ConsistencyGroupDeleteAndWait(ctx context.Context, consistencyGroupUUID string) error {
+	params := application.NewConsistencyGroupDeleteParamsWithTimeout(c.httpClient.Timeout)
+	params.Context = ctx
+	params.HTTPClient = c.httpClient
+
+	params.UUID = consistencyGroupUUID
+
+	_, deleteAccepted, err := c.api.Application.ConsistencyGroupDelete(params, c.authInfo)
+	if err != nil {
+		return fmt.Errorf("could not delete consistency group; %v", err)
+	}
+	if deleteAccepted == nil {
+		return nil
+	}
+
+	return c.PollJobStatus(ctx, deleteAccepted.Payload)
+}
+
 // SnapshotList lists snapshots
 func (c RestClient) SnapshotList(ctx context.Context, volumeUUID string) (*storage.SnapshotCollectionGetOK, error) {
 	params := storage.NewSnapshotCollectionGetParamsWithTimeout(c.httpClient.Timeout)
@@ -4681,6 +4882,48 @@ func (c RestClient) QtreeModifyExportPolicy(ctx context.Context, name, volumeNam
 	return c.PollJobStatus(ctx, modifyAccepted.Payload)
 }
 
+// QtreeModifyQosPolicy sets the QoS policy group of the specified qtree
+func (c RestClient) QtreeModifyQosPolicy(ctx context.Context, name, volumeName, qosPolicy string) error {
+	qtree, err := c.QtreeGetByName(ctx, name, volumeName)
+	if err != nil {
+		return err
+	}
+	if qtree == nil {
+		return fmt.Errorf("could not find qtree %v", name)
+	}
+	if qtree.ID == nil {
+		return fmt.Errorf("could not find id for qtree with name %v", name)
+	}
+	if qtree.Volume == nil || qtree.Volume.UUID == nil || qtree.Volume.Name == nil {
+		return fmt.Errorf("unexpected response from qtree lookup by name, missing volume information for qtree with name %v",
+			name)
+	}
+
+	params := storage.NewQtreeModifyParamsWithTimeout(c.httpClient.Timeout)
+	params.SetContext(ctx)
+	params.SetHTTPClient(c.httpClient)
+	params.SetID(strconv.FormatInt(*qtree.ID, 10))
+	params.SetVolumeUUID(*qtree.Volume.UUID)
+
+	qtreeInfo := &models.Qtree{
+		QosPolicy: &models.QtreeInlineQosPolicy{
+			Name: utils.Ptr(qosPolicy),
+		},
+	}
+
+	params.SetInfo(qtreeInfo)
+
+	modifyAccepted, err := c.api.Storage.QtreeModify(params, c.authInfo)
+	if err != nil {
+		return err
+	}
+	if modifyAccepted == nil {
+		return fmt.Errorf("unexpected response from qtree modify")
+	}
+
+	return c.PollJobStatus(ctx, modifyAccepted.Payload)
+}
+
 // QuotaOn enables quotas on a Flexvol
 // equivalent to filer::> volume quota on
 func (c RestClient) QuotaOn(ctx context.Context, volumeName string) error {
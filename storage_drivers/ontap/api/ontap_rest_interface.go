@@ -67,6 +67,18 @@ type RestClientInterface interface {
 	SnapshotCreate(ctx context.Context, volumeUUID, snapshotName string) (*storage.SnapshotCreateAccepted, error)
 	// SnapshotCreateAndWait creates a snapshot and waits on the job to complete
 	SnapshotCreateAndWait(ctx context.Context, volumeUUID, snapshotName string) error
+	// ConsistencyGroupCreateAndWait creates a consistency group from existing volumes and waits on the job to complete
+	ConsistencyGroupCreateAndWait(ctx context.Context, consistencyGroupName string, volumeNames []string) error
+	// ConsistencyGroupGetByName gets the consistency group with the specified name
+	ConsistencyGroupGetByName(
+		ctx context.Context, consistencyGroupName string,
+	) (*models.ConsistencyGroupResponseInlineRecordsInlineArrayItem, error)
+	// ConsistencyGroupSnapshotCreateAndWait snapshots every volume in a consistency group at the same point in
+	// time, and waits on the job to complete
+	ConsistencyGroupSnapshotCreateAndWait(ctx context.Context, consistencyGroupUUID, snapshotName string) error
+	// ConsistencyGroupDeleteAndWait deletes a consistency group, leaving its volumes and their snapshots in place,
+	// and waits on the job to complete
+	ConsistencyGroupDeleteAndWait(ctx context.Context, consistencyGroupUUID string) error
 	// SnapshotList lists snapshots
 	SnapshotList(ctx context.Context, volumeUUID string) (*storage.SnapshotCollectionGetOK, error)
 	// SnapshotListByName lists snapshots by name
@@ -83,6 +95,12 @@ type RestClientInterface interface {
 	SnapshotRestoreFlexgroup(ctx context.Context, snapshotName, volumeName string) error
 	// VolumeModifySnapshotDirectoryAccess modifies access to the ".snapshot" directory
 	VolumeModifySnapshotDirectoryAccess(ctx context.Context, volumeName string, enable bool) error
+	// VolumeModifySnapshotPolicy sets the snapshot policy of the specified flexvol
+	VolumeModifySnapshotPolicy(ctx context.Context, volumeName, snapshotPolicy string) error
+	// VolumeModifyTieringPolicy sets the tiering policy of the specified flexvol
+	VolumeModifyTieringPolicy(ctx context.Context, volumeName, tieringPolicy string) error
+	// VolumeMoveStart starts a nondisruptive move of the specified flexvol to another aggregate in the same SVM
+	VolumeMoveStart(ctx context.Context, volumeName, aggregate string) error
 	// VolumeListAllBackedBySnapshot returns the names of all FlexVols backed by the specified snapshot
 	VolumeListAllBackedBySnapshot(ctx context.Context, volumeName, snapshotName string) ([]string, error)
 	// VolumeCloneCreate creates a clone
@@ -272,6 +290,8 @@ type RestClientInterface interface {
 	QtreeGetAll(ctx context.Context, volumePrefix string) (*storage.QtreeCollectionGetOK, error)
 	// QtreeModifyExportPolicy modifies the export policy for the qtree
 	QtreeModifyExportPolicy(ctx context.Context, name, volumeName, newExportPolicyName string) error
+	// QtreeModifyQosPolicy sets the QoS policy group of the specified qtree
+	QtreeModifyQosPolicy(ctx context.Context, name, volumeName, qosPolicy string) error
 	// QuotaOn enables quotas on a Flexvol
 	// equivalent to filer::> volume quota on
 	QuotaOn(ctx context.Context, volumeName string) error
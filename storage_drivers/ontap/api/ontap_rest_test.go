@@ -4989,6 +4989,43 @@ func TestOntapREST_VolumeDisableSnapshotDirectoryAccess(t *testing.T) {
 	}
 }
 
+func TestOntapREST_VolumeModifyPolicies(t *testing.T) {
+	tests := []struct {
+		name            string
+		mockFunction    func(w http.ResponseWriter, r *http.Request)
+		isErrorExpected bool
+	}{
+		{"PositiveTestCase", mockGetVolumeResponseAccepted, false},
+		{"NumRecordsMoreThanTwo", mockGetVolumeResponseNumRecordsMoreThanTwo, true},
+		{"NumRecordsFieldsNil", mockGetVolumeResponseNumRecordsNil, true},
+		{"UUIDNil", mockGetVolumeResponseUUIDNil, true},
+		{"VolumeModify_Fail", mockModifyFailed, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(test.mockFunction))
+			rs := newRestClient(server.Listener.Addr().String(), server.Client())
+			assert.NotNil(t, rs)
+
+			err := rs.VolumeModifySnapshotPolicy(ctx, "fakeVolume", "hourly")
+			if !test.isErrorExpected {
+				assert.NoError(t, err, "could not modify the snapshot policy")
+			} else {
+				assert.Error(t, err, "modified the snapshot policy")
+			}
+
+			err = rs.VolumeModifyTieringPolicy(ctx, "fakeVolume", "auto")
+			if !test.isErrorExpected {
+				assert.NoError(t, err, "could not modify the tiering policy")
+			} else {
+				assert.Error(t, err, "modified the tiering policy")
+			}
+			server.Close()
+		})
+	}
+}
+
 func TestOntapRestListAllVolumeNamesBackedBySnapshot(t *testing.T) {
 	tests := []struct {
 		name         string
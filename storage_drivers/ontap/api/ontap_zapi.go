@@ -1365,6 +1365,49 @@ func (c Client) VolumeModifySnapshotDirectoryAccess(name string, enable bool) (*
 	return response, err
 }
 
+// VolumeModifySnapshotPolicy sets the snapshot policy of the specified flexvol
+func (c Client) VolumeModifySnapshotPolicy(name, snapshotPolicy string) (*azgo.VolumeModifyIterResponse, error) {
+	ssattr := azgo.NewVolumeSnapshotAttributesType().SetSnapshotPolicy(snapshotPolicy)
+	volSnapshotAttrs := azgo.NewVolumeAttributesType().SetVolumeSnapshotAttributes(*ssattr)
+	return c.volumeModifyAttributes(name, volSnapshotAttrs)
+}
+
+// VolumeModifyTieringPolicy sets the tiering policy of the specified flexvol
+func (c Client) VolumeModifyTieringPolicy(name, tieringPolicy string) (*azgo.VolumeModifyIterResponse, error) {
+	compAggrAttr := azgo.NewVolumeCompAggrAttributesType().SetTieringPolicy(tieringPolicy)
+	volCompAggrAttrs := azgo.NewVolumeAttributesType().SetVolumeCompAggrAttributes(*compAggrAttr)
+	return c.volumeModifyAttributes(name, volCompAggrAttrs)
+}
+
+// VolumeMoveStart starts a nondisruptive move of the specified flexvol to another aggregate in the same SVM
+func (c Client) VolumeMoveStart(name, aggregate string) (*azgo.VolumeMoveStartResponse, error) {
+	response, err := azgo.NewVolumeMoveStartRequest().
+		SetVserver(c.SVMName()).
+		SetSourceVolume(name).
+		SetDestAggr(aggregate).
+		ExecuteUsing(c.zr)
+	return response, err
+}
+
+// volumeModifyAttributes applies the supplied attributes to the flexvol with the specified name
+func (c Client) volumeModifyAttributes(
+	name string, volAttrs *azgo.VolumeAttributesType,
+) (*azgo.VolumeModifyIterResponse, error) {
+	volattr := &azgo.VolumeModifyIterRequestAttributes{}
+	volattr.SetVolumeAttributes(*volAttrs)
+
+	queryattr := &azgo.VolumeModifyIterRequestQuery{}
+	volidattr := azgo.NewVolumeIdAttributesType().SetName(azgo.VolumeNameType(name))
+	volIdAttrs := azgo.NewVolumeAttributesType().SetVolumeIdAttributes(*volidattr)
+	queryattr.SetVolumeAttributes(*volIdAttrs)
+
+	response, err := azgo.NewVolumeModifyIterRequest().
+		SetQuery(*queryattr).
+		SetAttributes(*volattr).
+		ExecuteUsing(c.zr)
+	return response, err
+}
+
 // Use this to set the QoS Policy Group for volume clones since
 // we can't set adaptive policy groups directly during volume clone creation.
 func (c Client) VolumeSetQosPolicyGroupName(
@@ -1950,6 +1993,15 @@ func (c Client) QtreeModifyExportPolicy(name, volumeName, exportPolicy string) (
 		ExecuteUsing(c.zr)
 }
 
+// QtreeModifyQosPolicy sets the QoS policy group of the specified qtree
+func (c Client) QtreeModifyQosPolicy(name, volumeName, qosPolicy string) (*azgo.QtreeModifyResponse, error) {
+	return azgo.NewQtreeModifyRequest().
+		SetQtree(name).
+		SetVolume(volumeName).
+		SetQosPolicyGroup(qosPolicy).
+		ExecuteUsing(c.zr)
+}
+
 // QuotaOn enables quotas on a Flexvol
 // equivalent to filer::> volume quota on
 func (c Client) QuotaOn(volume string) (*azgo.QuotaOnResponse, error) {
@@ -2169,6 +2221,28 @@ func (c Client) SnapshotCreate(snapshotName, volumeName string) (*azgo.SnapshotC
 	return response, err
 }
 
+// ConsistencyGroupStart starts a consistency group snapshot of a set of volumes, fencing I/O to them
+// until the snapshot is committed or the timeout expires.
+func (c Client) ConsistencyGroupStart(snapshotName string, volumes []string) (*azgo.CgStartResponse, error) {
+	volumeNames := &azgo.CgStartRequestVolumes{}
+	volumeNames.SetVolumeName(volumes)
+
+	response, err := azgo.NewCgStartRequest().
+		SetSnapshot(snapshotName).
+		SetTimeout("urgent").
+		SetVolumes(*volumeNames).
+		ExecuteUsing(c.zr)
+	return response, err
+}
+
+// ConsistencyGroupCommit commits a consistency group snapshot started by ConsistencyGroupStart
+func (c Client) ConsistencyGroupCommit(cgID int) (*azgo.CgCommitResponse, error) {
+	response, err := azgo.NewCgCommitRequest().
+		SetCgId(cgID).
+		ExecuteUsing(c.zr)
+	return response, err
+}
+
 // SnapshotList returns the list of snapshots associated with a volume
 func (c Client) SnapshotList(volumeName string) (*azgo.SnapshotGetIterResponse, error) {
 	query := &azgo.SnapshotGetIterRequestQuery{}
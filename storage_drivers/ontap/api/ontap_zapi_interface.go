@@ -168,6 +168,12 @@ type ZapiClientInterface interface {
 	VolumeCloneSplitStart(name string) (*azgo.VolumeCloneSplitStartResponse, error)
 	// VolumeModifySnapshotDirectoryAccess modifies access to the ".snapshot" directory
 	VolumeModifySnapshotDirectoryAccess(name string, enable bool) (*azgo.VolumeModifyIterResponse, error)
+	// VolumeModifySnapshotPolicy sets the snapshot policy of the specified flexvol
+	VolumeModifySnapshotPolicy(name, snapshotPolicy string) (*azgo.VolumeModifyIterResponse, error)
+	// VolumeModifyTieringPolicy sets the tiering policy of the specified flexvol
+	VolumeModifyTieringPolicy(name, tieringPolicy string) (*azgo.VolumeModifyIterResponse, error)
+	// VolumeMoveStart starts a nondisruptive move of the specified flexvol to another aggregate in the same SVM
+	VolumeMoveStart(name, aggregate string) (*azgo.VolumeMoveStartResponse, error)
 	// Use this to set the QoS Policy Group for volume clones since
 	// we can't set adaptive policy groups directly during volume clone creation.
 	VolumeSetQosPolicyGroupName(name string, qosPolicyGroup QosPolicyGroup) (*azgo.VolumeModifyIterResponse, error)
@@ -234,6 +240,8 @@ type ZapiClientInterface interface {
 	// equivalent to filer::> volume qtree show
 	QtreeGetAll(volumePrefix string) (*azgo.QtreeListIterResponse, error)
 	QtreeModifyExportPolicy(name, volumeName, exportPolicy string) (*azgo.QtreeModifyResponse, error)
+	// QtreeModifyQosPolicy sets the QoS policy group of the specified qtree
+	QtreeModifyQosPolicy(name, volumeName, qosPolicy string) (*azgo.QtreeModifyResponse, error)
 	// QuotaOn enables quotas on a Flexvol
 	// equivalent to filer::> volume quota on
 	QuotaOn(volume string) (*azgo.QuotaOnResponse, error)
@@ -272,6 +280,11 @@ type ZapiClientInterface interface {
 	ExportRuleDestroy(policy string, ruleIndex int) (*azgo.ExportRuleDestroyResponse, error)
 	// SnapshotCreate creates a snapshot of a volume
 	SnapshotCreate(snapshotName, volumeName string) (*azgo.SnapshotCreateResponse, error)
+	// ConsistencyGroupStart starts a consistency group snapshot of a set of volumes, fencing I/O to them
+	// until the snapshot is committed or the timeout expires.
+	ConsistencyGroupStart(snapshotName string, volumes []string) (*azgo.CgStartResponse, error)
+	// ConsistencyGroupCommit commits a consistency group snapshot started by ConsistencyGroupStart
+	ConsistencyGroupCommit(cgID int) (*azgo.CgCommitResponse, error)
 	// SnapshotList returns the list of snapshots associated with a volume
 	SnapshotList(volumeName string) (*azgo.SnapshotGetIterResponse, error)
 	// SnapshotInfo returns a snapshot by name for a volume
@@ -22,10 +22,13 @@ type Volume struct {
 	SnapshotReserve   int
 	SnapshotSpaceUsed int
 	SpaceReserve      string
+	State             string
 	TieringPolicy     string
 	UnixPermissions   string
 	UUID              string
 	DPVolume          bool
+	MoveInProgress    bool
+	MoveState         string
 }
 
 type (
@@ -17,6 +17,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/RoaringBitmap/roaring"
@@ -2673,6 +2674,120 @@ func getStorageBackendPhysicalPoolNamesCommon(physicalPools map[string]storage.P
 	return physicalPoolNames
 }
 
+// getPoolCapacityCommon returns the space of the aggregate backing a physical pool.  Volumes in a virtual
+// pool may be placed on any of the backend's aggregates, so a virtual pool reports the combined space of all
+// physical pools.
+func getPoolCapacityCommon(
+	ctx context.Context, client api.OntapAPI, pool storage.Pool, physicalPools, virtualPools map[string]storage.Pool,
+) (*storage.PoolCapacity, error) {
+	if _, ok := physicalPools[pool.Name()]; ok {
+		return getAggregatesCapacity(ctx, client, []string{pool.Name()})
+	}
+	if _, ok := virtualPools[pool.Name()]; ok {
+		return getAggregatesCapacity(ctx, client, getStorageBackendPhysicalPoolNamesCommon(physicalPools))
+	}
+	return nil, errors.NotFoundError("pool %s not found", pool.Name())
+}
+
+// getAggregatesCapacity returns the combined size and free space of the specified aggregates.
+func getAggregatesCapacity(
+	ctx context.Context, client api.OntapAPI, aggregates []string,
+) (*storage.PoolCapacity, error) {
+	capacity := &storage.PoolCapacity{}
+
+	for _, aggregate := range aggregates {
+		aggrSpaceList, err := client.GetSVMAggregateSpace(ctx, aggregate)
+		if err != nil {
+			return nil, err
+		}
+		if len(aggrSpaceList) == 0 {
+			return nil, fmt.Errorf("could not find space information for aggregate %s", aggregate)
+		}
+
+		aggrSpace := aggrSpaceList[0]
+		if aggrSpace.Size() <= 0 {
+			continue
+		}
+		capacity.TotalBytes += uint64(aggrSpace.Size())
+		if aggrSpace.Used() < aggrSpace.Size() {
+			capacity.FreeBytes += uint64(aggrSpace.Size() - aggrSpace.Used())
+		}
+	}
+
+	Logc(ctx).WithFields(LogFields{
+		"aggregates": aggregates,
+		"totalBytes": capacity.TotalBytes,
+		"freeBytes":  capacity.FreeBytes,
+	}).Trace("Determined aggregate capacity.")
+
+	return capacity, nil
+}
+
+// getVolumeHealthCommon inspects the Flexvol backing a volume and reports the first abnormal condition
+// found, in order of severity.  The space check may be skipped by drivers whose Flexvols are expected to
+// be full, such as those holding space-reserved LUNs.
+func getVolumeHealthCommon(
+	ctx context.Context, client api.OntapAPI, volConfig *storage.VolumeConfig, flexvol string, checkSpace bool,
+) (*storage.VolumeHealth, error) {
+	volume, err := client.VolumeInfo(ctx, flexvol)
+	if err != nil {
+		exists, existsErr := client.VolumeExists(ctx, flexvol)
+		if existsErr != nil {
+			return nil, fmt.Errorf("could not check volume %s; %v", flexvol, err)
+		}
+		if !exists {
+			return storage.NewVolumeHealth(storage.VolumeHealthMissing,
+				fmt.Sprintf("volume %s was not found online on the backend", flexvol)), nil
+		}
+		return storage.NewVolumeHealth(storage.VolumeHealthOffline,
+			fmt.Sprintf("volume %s could not be read from the backend; %v", flexvol, err)), nil
+	}
+
+	if volume.State != "" && volume.State != "online" {
+		return storage.NewVolumeHealth(storage.VolumeHealthOffline,
+			fmt.Sprintf("volume %s is %s", flexvol, volume.State)), nil
+	}
+
+	if volConfig.IsMirrorDestination && volConfig.PeerVolumeHandle != "" {
+		remoteSVM, remoteFlexvol, err := parseVolumeHandle(volConfig.PeerVolumeHandle)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse peer volume handle %s; %v", volConfig.PeerVolumeHandle, err)
+		}
+		mirror, err := client.SnapmirrorGet(ctx, flexvol, client.SVMName(), remoteFlexvol, remoteSVM)
+		if err != nil {
+			if !api.IsNotFoundError(err) {
+				return nil, err
+			}
+			return storage.NewVolumeHealth(storage.VolumeHealthMirrorBroken,
+				fmt.Sprintf("no mirror relationship found for volume %s", flexvol)), nil
+		}
+		if !mirror.IsHealthy {
+			return storage.NewVolumeHealth(storage.VolumeHealthMirrorBroken,
+				fmt.Sprintf("mirror relationship for volume %s is unhealthy; %s", flexvol, mirror.UnhealthyReason)), nil
+		}
+	} else if volume.DPVolume {
+		return storage.NewVolumeHealth(storage.VolumeHealthReadOnly,
+			fmt.Sprintf("volume %s is a data protection volume and is not writable", flexvol)), nil
+	}
+
+	if checkSpace {
+		size, err := strconv.ParseUint(volume.Size, 10, 64)
+		if err == nil && size > 0 {
+			usable := size * uint64(100-volume.SnapshotReserve) / 100
+			used, err := client.VolumeUsedSize(ctx, flexvol)
+			if err != nil {
+				return nil, fmt.Errorf("could not get used size of volume %s; %v", flexvol, err)
+			}
+			if uint64(used) >= usable {
+				return storage.NewVolumeHealth(storage.VolumeHealthSpaceExhausted,
+					fmt.Sprintf("volume %s has no free space", flexvol)), nil
+			}
+		}
+	}
+
+	return storage.NewVolumeHealth(storage.VolumeHealthNormal, ""), nil
+}
+
 func getPoolsForCreate(
 	ctx context.Context, volConfig *storage.VolumeConfig, storagePool storage.Pool,
 	volAttributes map[string]sa.Request, physicalPools, virtualPools map[string]storage.Pool,
@@ -3053,6 +3168,79 @@ func createFlexvolSnapshot(
 	}, nil
 }
 
+// createFlexvolGroupSnapshot creates a snapshot of each of the given volumes, all at the same point in time.
+func createFlexvolGroupSnapshot(
+	ctx context.Context, groupSnapshotConfig *storage.GroupSnapshotConfig, snapConfigs []*storage.SnapshotConfig,
+	config *drivers.OntapStorageDriverConfig, client api.OntapAPI, sizeGetter func(context.Context, string) (int, error),
+) ([]*storage.Snapshot, error) {
+	internalSnapName := groupSnapshotConfig.InternalName
+
+	fields := LogFields{
+		"Method":       "CreateGroupSnapshot",
+		"Type":         "ontap_common",
+		"snapshotName": internalSnapName,
+		"volumeCount":  len(snapConfigs),
+	}
+	Logd(ctx, config.StorageDriverName,
+		config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> CreateGroupSnapshot")
+	defer Logd(ctx, config.StorageDriverName,
+		config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< CreateGroupSnapshot")
+
+	internalVolNames := make([]string, 0, len(snapConfigs))
+	sizes := make(map[string]int, len(snapConfigs))
+
+	for _, snapConfig := range snapConfigs {
+		internalVolName := snapConfig.VolumeInternalName
+
+		// If any of the specified volumes doesn't exist, return error
+		volExists, err := client.VolumeExists(ctx, internalVolName)
+		if err != nil {
+			return nil, fmt.Errorf("error checking for existing volume: %v", err)
+		}
+		if !volExists {
+			return nil, fmt.Errorf("volume %s does not exist", internalVolName)
+		}
+
+		size, err := sizeGetter(ctx, internalVolName)
+		if err != nil {
+			return nil, fmt.Errorf("error reading volume size: %v", err)
+		}
+
+		internalVolNames = append(internalVolNames, internalVolName)
+		sizes[internalVolName] = size
+	}
+
+	if err := client.ConsistencyGroupSnapshot(ctx, internalSnapName, internalVolNames); err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]*storage.Snapshot, 0, len(snapConfigs))
+
+	for _, snapConfig := range snapConfigs {
+		internalVolName := snapConfig.VolumeInternalName
+
+		snap, err := client.VolumeSnapshotInfo(ctx, internalSnapName, internalVolName)
+		if err != nil {
+			return nil, err
+		}
+
+		Logc(ctx).WithFields(LogFields{
+			"snapshotName": internalSnapName,
+			"volumeName":   internalVolName,
+			"created":      snap.CreateTime,
+		}).Debug("Found snapshot.")
+
+		snapshots = append(snapshots, &storage.Snapshot{
+			Config:    snapConfig,
+			Created:   snap.CreateTime,
+			SizeBytes: int64(sizes[internalVolName]),
+			State:     storage.SnapshotStateOnline,
+		})
+	}
+
+	return snapshots, nil
+}
+
 // cloneFlexvol creates a volume clone
 func cloneFlexvol(
 	ctx context.Context, name, source, snapshot, labels string, split bool, config *drivers.OntapStorageDriverConfig,
@@ -3467,3 +3655,103 @@ func ConstructPoolForLabels(nameTemplate string, labels map[string]string) *stor
 
 	return pool
 }
+
+// updateFlexvolPolicies applies any QoS, tiering and snapshot policy changes requested for a Flexvol-backed volume.
+// QoS is set on the LUN if a LUN path is given, otherwise on the Flexvol.  The volume config is updated to
+// reflect each change, so that the caller may persist it.
+func updateFlexvolPolicies(
+	ctx context.Context, flexvol, lunPath string, volConfig *storage.VolumeConfig,
+	updateInfo *utils.VolumeUpdateInfo, config *drivers.OntapStorageDriverConfig, client api.OntapAPI,
+) error {
+	if updateInfo.ServiceLevel != "" {
+		return errors.UnsupportedError(fmt.Sprintf("service level may not be changed on %s volumes",
+			config.StorageDriverName))
+	}
+
+	if updateInfo.QosPolicy != "" || updateInfo.AdaptiveQosPolicy != "" {
+		if !client.SupportsFeature(ctx, api.QosPolicies) {
+			return errors.UnsupportedError("trident does not support QoS policies for ONTAP version")
+		}
+		qosPolicyGroup, err := api.NewQosPolicyGroup(updateInfo.QosPolicy, updateInfo.AdaptiveQosPolicy)
+		if err != nil {
+			return errors.InvalidInputError(err.Error())
+		}
+		if lunPath != "" {
+			err = client.LunSetQosPolicyGroup(ctx, lunPath, qosPolicyGroup)
+		} else {
+			err = client.VolumeSetQosPolicyGroupName(ctx, flexvol, qosPolicyGroup)
+		}
+		if err != nil {
+			return fmt.Errorf("could not set QoS policy group of volume %s; %v", volConfig.Name, err)
+		}
+		volConfig.QosPolicy = updateInfo.QosPolicy
+		volConfig.AdaptiveQosPolicy = updateInfo.AdaptiveQosPolicy
+	}
+
+	if updateInfo.TieringPolicy != "" {
+		switch updateInfo.TieringPolicy {
+		case "snapshot-only", "auto", "none", "backup", "all":
+			break
+		default:
+			return errors.InvalidInputError(fmt.Sprintf("invalid tiering policy %s", updateInfo.TieringPolicy))
+		}
+		if err := client.VolumeModifyTieringPolicy(ctx, flexvol, updateInfo.TieringPolicy); err != nil {
+			return fmt.Errorf("could not set tiering policy of volume %s; %v", volConfig.Name, err)
+		}
+		volConfig.TieringPolicy = updateInfo.TieringPolicy
+	}
+
+	if updateInfo.SnapshotPolicy != "" {
+		if err := client.VolumeModifySnapshotPolicy(ctx, flexvol, updateInfo.SnapshotPolicy); err != nil {
+			return fmt.Errorf("could not set snapshot policy of volume %s; %v", volConfig.Name, err)
+		}
+		volConfig.SnapshotPolicy = updateInfo.SnapshotPolicy
+	}
+
+	return nil
+}
+
+// moveFlexvol relocates a Flexvol to the aggregate backing the named physical pool using ONTAP volume move.
+// ONTAP moves volumes in the background, so this returns an InProgressError until the Flexvol is found on the
+// destination aggregate with no move under way.  Calling it again while a move is running does not start another.
+// The moves started are recorded in startedMoves, so that a move that ends without reaching its destination is
+// reported as failed rather than started again.
+func moveFlexvol(
+	ctx context.Context, flexvol, poolName string, physicalPools map[string]storage.Pool, client api.OntapAPI,
+	startedMoves *sync.Map,
+) error {
+	if _, ok := physicalPools[poolName]; !ok {
+		return errors.InvalidInputError(fmt.Sprintf("pool %s is not an aggregate of this backend", poolName))
+	}
+
+	volume, err := client.VolumeInfo(ctx, flexvol)
+	if err != nil {
+		return err
+	}
+
+	if volume.MoveInProgress {
+		return errors.InProgressError(fmt.Sprintf("volume %s is moving to aggregate %s", flexvol, poolName))
+	}
+
+	if utils.SliceContainsString(volume.Aggregates, poolName) {
+		startedMoves.Delete(flexvol)
+		return nil
+	}
+
+	// A move that was started here but is no longer running has failed or been aborted
+	if destination, ok := startedMoves.LoadAndDelete(flexvol); ok && destination == poolName {
+		state := volume.MoveState
+		if state == "" {
+			state = "unknown"
+		}
+		return fmt.Errorf("move of volume %s to aggregate %s did not complete; last move state: %s",
+			flexvol, poolName, state)
+	}
+
+	if err = client.VolumeMove(ctx, flexvol, poolName); err != nil {
+		return fmt.Errorf("could not move volume %s to aggregate %s; %v", flexvol, poolName, err)
+	}
+	startedMoves.Store(flexvol, poolName)
+
+	return errors.InProgressError(fmt.Sprintf("volume %s is moving to aggregate %s", flexvol, poolName))
+}
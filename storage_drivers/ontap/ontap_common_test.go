@@ -733,6 +733,127 @@ func TestCheckAggregateLimits(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestGetPoolCapacityCommon(t *testing.T) {
+	ctx := context.Background()
+
+	physicalPools := map[string]storage.Pool{
+		"aggr1": storage.NewStoragePool(nil, "aggr1"),
+		"aggr2": storage.NewStoragePool(nil, "aggr2"),
+	}
+	virtualPools := map[string]storage.Pool{
+		"pool_0": storage.NewStoragePool(nil, "pool_0"),
+	}
+
+	mockOntapAPI := newMockOntapAPI(t)
+	mockOntapAPI.EXPECT().GetSVMAggregateSpace(gomock.Any(), "aggr1").Return(
+		[]api.SVMAggregateSpace{api.NewSVMAggregateSpace(1000, 400, 400)}, nil).AnyTimes()
+	mockOntapAPI.EXPECT().GetSVMAggregateSpace(gomock.Any(), "aggr2").Return(
+		[]api.SVMAggregateSpace{api.NewSVMAggregateSpace(2000, 2100, 2100)}, nil).AnyTimes()
+
+	// Physical pool reports its own aggregate
+	capacity, err := getPoolCapacityCommon(ctx, mockOntapAPI, physicalPools["aggr1"], physicalPools, virtualPools)
+	assert.NoError(t, err)
+	assert.Equal(t, &storage.PoolCapacity{TotalBytes: 1000, FreeBytes: 600}, capacity)
+
+	// Overcommitted aggregate reports no free space
+	capacity, err = getPoolCapacityCommon(ctx, mockOntapAPI, physicalPools["aggr2"], physicalPools, virtualPools)
+	assert.NoError(t, err)
+	assert.Equal(t, &storage.PoolCapacity{TotalBytes: 2000, FreeBytes: 0}, capacity)
+
+	// Virtual pool reports all aggregates
+	capacity, err = getPoolCapacityCommon(ctx, mockOntapAPI, virtualPools["pool_0"], physicalPools, virtualPools)
+	assert.NoError(t, err)
+	assert.Equal(t, &storage.PoolCapacity{TotalBytes: 3000, FreeBytes: 600}, capacity)
+
+	// Unknown pool
+	_, err = getPoolCapacityCommon(ctx, mockOntapAPI, storage.NewStoragePool(nil, "unknown"), physicalPools,
+		virtualPools)
+	assert.True(t, errors.IsNotFoundError(err))
+
+	// API error
+	mockOntapAPI = newMockOntapAPI(t)
+	mockOntapAPI.EXPECT().GetSVMAggregateSpace(gomock.Any(), "aggr1").Return(nil, fmt.Errorf("failed"))
+	_, err = getPoolCapacityCommon(ctx, mockOntapAPI, physicalPools["aggr1"], physicalPools, virtualPools)
+	assert.Error(t, err)
+
+	// Empty result
+	mockOntapAPI = newMockOntapAPI(t)
+	mockOntapAPI.EXPECT().GetSVMAggregateSpace(gomock.Any(), "aggr1").Return([]api.SVMAggregateSpace{}, nil)
+	_, err = getPoolCapacityCommon(ctx, mockOntapAPI, physicalPools["aggr1"], physicalPools, virtualPools)
+	assert.Error(t, err)
+}
+
+func TestGetVolumeHealthCommon(t *testing.T) {
+	ctx := context.Background()
+	volConfig := &storage.VolumeConfig{InternalName: "vol1"}
+	mirrorConfig := &storage.VolumeConfig{
+		InternalName: "vol1", IsMirrorDestination: true, PeerVolumeHandle: "svm2:vol2",
+	}
+
+	tests := []struct {
+		name      string
+		volConfig *storage.VolumeConfig
+		setup     func(*mock_ontap.MockOntapAPI)
+		expected  storage.VolumeHealthCondition
+	}{
+		{"normal", volConfig, func(m *mock_ontap.MockOntapAPI) {
+			m.EXPECT().VolumeInfo(ctx, "vol1").Return(&api.Volume{State: "online", Size: "1000"}, nil)
+			m.EXPECT().VolumeUsedSize(ctx, "vol1").Return(500, nil)
+		}, storage.VolumeHealthNormal},
+		{"missing", volConfig, func(m *mock_ontap.MockOntapAPI) {
+			m.EXPECT().VolumeInfo(ctx, "vol1").Return(nil, fmt.Errorf("not found"))
+			m.EXPECT().VolumeExists(ctx, "vol1").Return(false, nil)
+		}, storage.VolumeHealthMissing},
+		{"offline", volConfig, func(m *mock_ontap.MockOntapAPI) {
+			m.EXPECT().VolumeInfo(ctx, "vol1").Return(&api.Volume{State: "offline"}, nil)
+		}, storage.VolumeHealthOffline},
+		{"readOnly", volConfig, func(m *mock_ontap.MockOntapAPI) {
+			m.EXPECT().VolumeInfo(ctx, "vol1").Return(&api.Volume{State: "online", DPVolume: true}, nil)
+		}, storage.VolumeHealthReadOnly},
+		{"mirrorMissing", mirrorConfig, func(m *mock_ontap.MockOntapAPI) {
+			m.EXPECT().VolumeInfo(ctx, "vol1").Return(&api.Volume{State: "online", DPVolume: true}, nil)
+			m.EXPECT().SVMName().Return("svm1")
+			m.EXPECT().SnapmirrorGet(ctx, "vol1", "svm1", "vol2", "svm2").Return(nil, api.NotFoundError("none"))
+		}, storage.VolumeHealthMirrorBroken},
+		{"mirrorUnhealthy", mirrorConfig, func(m *mock_ontap.MockOntapAPI) {
+			m.EXPECT().VolumeInfo(ctx, "vol1").Return(&api.Volume{State: "online", DPVolume: true}, nil)
+			m.EXPECT().SVMName().Return("svm1")
+			m.EXPECT().SnapmirrorGet(ctx, "vol1", "svm1", "vol2", "svm2").Return(
+				&api.Snapmirror{IsHealthy: false, UnhealthyReason: "transfer failed"}, nil)
+		}, storage.VolumeHealthMirrorBroken},
+		{"mirrorHealthy", mirrorConfig, func(m *mock_ontap.MockOntapAPI) {
+			m.EXPECT().VolumeInfo(ctx, "vol1").Return(&api.Volume{State: "online", DPVolume: true}, nil)
+			m.EXPECT().SVMName().Return("svm1")
+			m.EXPECT().SnapmirrorGet(ctx, "vol1", "svm1", "vol2", "svm2").Return(
+				&api.Snapmirror{IsHealthy: true}, nil)
+		}, storage.VolumeHealthNormal},
+		{"spaceExhausted", volConfig, func(m *mock_ontap.MockOntapAPI) {
+			m.EXPECT().VolumeInfo(ctx, "vol1").Return(
+				&api.Volume{State: "online", Size: "1000", SnapshotReserve: 10}, nil)
+			m.EXPECT().VolumeUsedSize(ctx, "vol1").Return(900, nil)
+		}, storage.VolumeHealthSpaceExhausted},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			mockOntapAPI := newMockOntapAPI(t)
+			test.setup(mockOntapAPI)
+
+			health, err := getVolumeHealthCommon(ctx, mockOntapAPI, test.volConfig, "vol1", true)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, health.Condition)
+			assert.Equal(t, test.expected != storage.VolumeHealthNormal, health.IsAbnormal())
+		})
+	}
+
+	// Errors other than a missing volume are returned
+	mockOntapAPI := newMockOntapAPI(t)
+	mockOntapAPI.EXPECT().VolumeInfo(ctx, "vol1").Return(nil, fmt.Errorf("failed"))
+	mockOntapAPI.EXPECT().VolumeExists(ctx, "vol1").Return(false, fmt.Errorf("failed"))
+	_, err := getVolumeHealthCommon(ctx, mockOntapAPI, volConfig, "vol1", true)
+	assert.Error(t, err)
+}
+
 func newTestOntapDriverConfig(
 	vserverAdminHost, vserverAdminPort, vserverAggrName string,
 ) *drivers.OntapStorageDriverConfig {
@@ -8,6 +8,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/RoaringBitmap/roaring"
@@ -55,6 +56,9 @@ type NASStorageDriver struct {
 	virtualPools  map[string]storage.Pool
 
 	cloneSplitTimers map[string]time.Time
+
+	// startedMoves records the aggregate to which each Flexvol was last asked to move
+	startedMoves *sync.Map
 }
 
 func (d *NASStorageDriver) GetConfig() *drivers.OntapStorageDriverConfig {
@@ -150,6 +154,7 @@ func (d *NASStorageDriver) Initialize(
 
 	// Set up the clone split timers
 	d.cloneSplitTimers = make(map[string]time.Time)
+	d.startedMoves = &sync.Map{}
 
 	d.initialized = true
 	return nil
@@ -315,6 +320,7 @@ func (d *NASStorageDriver) Create(
 	volConfig.Size = strconv.FormatUint(sizeBytes, 10)
 	volConfig.SpaceReserve = spaceReserve
 	volConfig.SnapshotPolicy = snapshotPolicy
+	volConfig.TieringPolicy = tieringPolicy
 	volConfig.SnapshotReserve = snapshotReserve
 	volConfig.UnixPermissions = unixPermissions
 	volConfig.SnapshotDir = snapshotDir
@@ -920,6 +926,23 @@ func (d *NASStorageDriver) CreateSnapshot(
 	return createFlexvolSnapshot(ctx, snapConfig, &d.Config, d.API, d.API.VolumeUsedSize)
 }
 
+// CreateGroupSnapshot creates a snapshot of each of the given volumes, all at the same point in time.
+func (d *NASStorageDriver) CreateGroupSnapshot(
+	ctx context.Context, groupSnapshotConfig *storage.GroupSnapshotConfig, snapConfigs []*storage.SnapshotConfig,
+	_ []*storage.VolumeConfig,
+) ([]*storage.Snapshot, error) {
+	fields := LogFields{
+		"Method":       "CreateGroupSnapshot",
+		"Type":         "NASStorageDriver",
+		"snapshotName": groupSnapshotConfig.InternalName,
+		"volumeCount":  len(snapConfigs),
+	}
+	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> CreateGroupSnapshot")
+	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< CreateGroupSnapshot")
+
+	return createFlexvolGroupSnapshot(ctx, groupSnapshotConfig, snapConfigs, &d.Config, d.API, d.API.VolumeUsedSize)
+}
+
 // RestoreSnapshot restores a volume (in place) from a snapshot.
 func (d *NASStorageDriver) RestoreSnapshot(
 	ctx context.Context, snapConfig *storage.SnapshotConfig, _ *storage.VolumeConfig,
@@ -979,12 +1002,23 @@ func (d *NASStorageDriver) Get(ctx context.Context, name string) error {
 	}
 	if !volExists {
 		Logc(ctx).WithField("Volume", name).Debug("Volume not found.")
-		return fmt.Errorf("volume %s does not exist", name)
+		return errors.NotFoundError("volume %s does not exist", name)
 	}
 
 	return nil
 }
 
+// GetVolumeHealth reports the condition of the Flexvol backing a volume
+func (d *NASStorageDriver) GetVolumeHealth(
+	ctx context.Context, volConfig *storage.VolumeConfig,
+) (*storage.VolumeHealth, error) {
+	fields := LogFields{"Method": "GetVolumeHealth", "Type": "NASStorageDriver", "name": volConfig.InternalName}
+	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> GetVolumeHealth")
+	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< GetVolumeHealth")
+
+	return getVolumeHealthCommon(ctx, d.API, volConfig, volConfig.InternalName, true)
+}
+
 // GetStorageBackendSpecs retrieves storage backend capabilities
 func (d *NASStorageDriver) GetStorageBackendSpecs(
 	_ context.Context, backend storage.Backend,
@@ -992,6 +1026,11 @@ func (d *NASStorageDriver) GetStorageBackendSpecs(
 	return getStorageBackendSpecsCommon(backend, d.physicalPools, d.virtualPools, d.BackendName())
 }
 
+// GetPoolCapacity returns the space available to the specified storage pool
+func (d *NASStorageDriver) GetPoolCapacity(ctx context.Context, pool storage.Pool) (*storage.PoolCapacity, error) {
+	return getPoolCapacityCommon(ctx, d.API, pool, d.physicalPools, d.virtualPools)
+}
+
 // GetStorageBackendPhysicalPoolNames retrieves storage backend physical pools
 func (d *NASStorageDriver) GetStorageBackendPhysicalPoolNames(context.Context) []string {
 	return getStorageBackendPhysicalPoolNamesCommon(d.physicalPools)
@@ -1221,6 +1260,77 @@ func (d *NASStorageDriver) GetUpdateType(ctx context.Context, driverOrig storage
 	return bitmap
 }
 
+// Update modifies the QoS, tiering and snapshot policies of an existing volume.
+func (d *NASStorageDriver) Update(
+	ctx context.Context, volConfig *storage.VolumeConfig,
+	updateInfo *utils.VolumeUpdateInfo, allVolumes map[string]*storage.Volume,
+) (map[string]*storage.Volume, error) {
+	name := volConfig.InternalName
+	fields := LogFields{
+		"Method":     "Update",
+		"Type":       "NASStorageDriver",
+		"name":       name,
+		"updateInfo": updateInfo,
+	}
+	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> Update")
+	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< Update")
+
+	updateGenericError := fmt.Sprintf("failed to update volume %v", volConfig.Name)
+
+	if updateInfo == nil {
+		err := errors.InvalidInputError(fmt.Sprintf("nothing to update for volume %v", volConfig.Name))
+		Logc(ctx).WithError(err).Error(updateGenericError)
+		return nil, err
+	}
+
+	if updateInfo.SnapshotDirectory != "" {
+		err := errors.UnsupportedError(fmt.Sprintf("snapshot directory may not be changed on %s volumes",
+			d.Config.StorageDriverName))
+		Logc(ctx).WithError(err).Error(updateGenericError)
+		return nil, err
+	}
+
+	volExists, err := d.API.VolumeExists(ctx, name)
+	if err != nil {
+		Logc(ctx).WithError(err).Error(updateGenericError)
+		return nil, err
+	} else if !volExists {
+		err = errors.NotFoundError("volume %s not found", name)
+		Logc(ctx).WithError(err).Error(updateGenericError)
+		return nil, err
+	}
+
+	if err = updateFlexvolPolicies(ctx, name, "", volConfig, updateInfo, &d.Config, d.API); err != nil {
+		Logc(ctx).WithError(err).Error(updateGenericError)
+		return nil, err
+	}
+
+	vol, ok := allVolumes[volConfig.Name]
+	if !ok {
+		err = errors.NotFoundError("volume %s not found", volConfig.Name)
+		Logc(ctx).WithError(err).Error(updateGenericError)
+		return nil, err
+	}
+	vol.Config = volConfig
+
+	return map[string]*storage.Volume{volConfig.Name: vol}, nil
+}
+
+// MoveVolume relocates the Flexvol backing a volume to the aggregate of another pool of this backend.
+func (d *NASStorageDriver) MoveVolume(ctx context.Context, volConfig *storage.VolumeConfig, poolName string) error {
+	name := volConfig.InternalName
+	fields := LogFields{
+		"Method": "MoveVolume",
+		"Type":   "NASStorageDriver",
+		"name":   name,
+		"pool":   poolName,
+	}
+	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> MoveVolume")
+	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< MoveVolume")
+
+	return moveFlexvol(ctx, name, poolName, d.physicalPools, d.API, d.startedMoves)
+}
+
 // Resize expands the volume size.
 func (d *NASStorageDriver) Resize(
 	ctx context.Context, volConfig *storage.VolumeConfig, requestedSizeBytes uint64,
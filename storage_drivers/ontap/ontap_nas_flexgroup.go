@@ -1347,7 +1347,7 @@ func (d *NASFlexGroupStorageDriver) Get(ctx context.Context, name string) error
 	}
 	if !volExists {
 		Logc(ctx).WithField("FlexGroup", name).Debug("FlexGroup not found.")
-		return fmt.Errorf("volume %s does not exist", name)
+		return errors.NotFoundError("volume %s does not exist", name)
 	}
 
 	return nil
@@ -1374,6 +1374,24 @@ func (d *NASFlexGroupStorageDriver) GetStorageBackendSpecs(_ context.Context, ba
 	return nil
 }
 
+// GetPoolCapacity returns the space available to the specified storage pool.  A FlexGroup may span all
+// of the SVM's aggregates, so every pool reports their combined space.
+func (d *NASFlexGroupStorageDriver) GetPoolCapacity(
+	ctx context.Context, pool storage.Pool,
+) (*storage.PoolCapacity, error) {
+	if pool.Name() != d.physicalPool.Name() {
+		if _, ok := d.virtualPools[pool.Name()]; !ok {
+			return nil, errors.NotFoundError("pool %s not found", pool.Name())
+		}
+	}
+
+	aggregates, err := d.API.GetSVMAggregateNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return getAggregatesCapacity(ctx, d.API, aggregates)
+}
+
 // GetStorageBackendPhysicalPoolNames retrieves storage backend physical pools
 func (d *NASFlexGroupStorageDriver) GetStorageBackendPhysicalPoolNames(context.Context) []string {
 	physicalPoolNames := make([]string, 0)
@@ -3447,6 +3447,7 @@ func TestOntapNasFlexgroupStorageDriverVolumeGet_Error(t *testing.T) {
 
 	assert.Error(t, result, "Flexgroup volume exists")
 	assert.Contains(t, result.Error(), "error checking for existing volume")
+	assert.False(t, errors.IsNotFoundError(result), "expected error other than not found")
 }
 
 func TestOntapNasFlexgroupStorageDriverVolumeGet_DoesNotExist(t *testing.T) {
@@ -3457,6 +3458,7 @@ func TestOntapNasFlexgroupStorageDriverVolumeGet_DoesNotExist(t *testing.T) {
 	result := driver.Get(ctx, "vol1")
 
 	assert.Error(t, result, "Flexgroup volume exists")
+	assert.True(t, errors.IsNotFoundError(result), "expected not found error")
 }
 
 func TestOntapNasFlexgroupStorageDriverGetStorageBackendSpecs(t *testing.T) {
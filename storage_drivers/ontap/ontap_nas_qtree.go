@@ -405,6 +405,7 @@ func (d *NASQtreeStorageDriver) Create(
 	volConfig.Size = strconv.FormatUint(sizeBytes, 10)
 	volConfig.SpaceReserve = spaceReserve
 	volConfig.SnapshotPolicy = snapshotPolicy
+	volConfig.TieringPolicy = tieringPolicy
 	volConfig.SnapshotReserve = snapshotReserve
 	volConfig.SnapshotDir = snapshotDir
 	volConfig.Encryption = configEncryption
@@ -1002,9 +1003,6 @@ func (d *NASQtreeStorageDriver) Get(ctx context.Context, name string) error {
 	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> Get")
 	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< Get")
 
-	// Generic user-facing message
-	getError := fmt.Errorf("volume %s not found", name)
-
 	volConfig := &storage.VolumeConfig{
 		InternalName: name,
 	}
@@ -1018,11 +1016,11 @@ func (d *NASQtreeStorageDriver) Get(ctx context.Context, name string) error {
 	exists, flexvol, err := d.API.QtreeExists(ctx, name, volumePattern)
 	if err != nil {
 		Logc(ctx).Errorf("Error checking for existing qtree. %s", err.Error())
-		return getError
+		return fmt.Errorf("error checking for existing volume %s: %v", name, err)
 	}
 	if !exists {
 		Logc(ctx).WithField("qtree", name).Debug("Qtree not found.")
-		return getError
+		return errors.NotFoundError("volume %s not found", name)
 	}
 
 	// If qtree exists, update the volConfig.InternalID in case it was not set
@@ -1594,6 +1592,11 @@ func (d *NASQtreeStorageDriver) GetStorageBackendSpecs(_ context.Context, backen
 	return getStorageBackendSpecsCommon(backend, d.physicalPools, d.virtualPools, d.BackendName())
 }
 
+// GetPoolCapacity returns the space available to the specified storage pool
+func (d *NASQtreeStorageDriver) GetPoolCapacity(ctx context.Context, pool storage.Pool) (*storage.PoolCapacity, error) {
+	return getPoolCapacityCommon(ctx, d.API, pool, d.physicalPools, d.virtualPools)
+}
+
 // GetStorageBackendPhysicalPoolNames retrieves storage backend physical pools
 func (d *NASQtreeStorageDriver) GetStorageBackendPhysicalPoolNames(context.Context) []string {
 	return getStorageBackendPhysicalPoolNamesCommon(d.physicalPools)
@@ -2387,16 +2390,53 @@ func (d *NASQtreeStorageDriver) Update(
 		return nil, fmt.Errorf("volume %s does not exist", name)
 	}
 
-	var updatedVols map[string]*storage.Volume
+	if updateInfo.ServiceLevel != "" {
+		err = errors.UnsupportedError(fmt.Sprintf("service level may not be changed on %s volumes",
+			d.Config.StorageDriverName))
+		Logc(ctx).WithError(err).Error(updateGenericError)
+		return nil, err
+	}
+
+	updatedVols := make(map[string]*storage.Volume)
 	var updateErr error
 
 	// Update snapshot directory
 	if updateInfo.SnapshotDirectory != "" {
-		updatedVols, updateErr = d.updateSnapshotDirectory(ctx, volConfig, updateInfo.SnapshotDirectory, updateInfo.PoolLevel, flexvol, allVolumes)
+		poolVols, updateErr := d.updateSnapshotDirectory(ctx, volConfig, updateInfo.SnapshotDirectory, updateInfo.PoolLevel, flexvol, allVolumes)
+		if updateErr != nil {
+			Logc(ctx).WithError(updateErr).Error(updateGenericError)
+			return nil, updateErr
+		}
+		for volName, vol := range poolVols {
+			updatedVols[volName] = vol
+		}
+	}
+
+	// Update QoS policy of the qtree
+	if updateInfo.QosPolicy != "" || updateInfo.AdaptiveQosPolicy != "" {
+		vol, updateErr := d.updateQosPolicy(ctx, volConfig, updateInfo.QosPolicy, updateInfo.AdaptiveQosPolicy,
+			name, flexvol, allVolumes)
 		if updateErr != nil {
 			Logc(ctx).WithError(updateErr).Error(updateGenericError)
 			return nil, updateErr
 		}
+		updatedVols[volConfig.Name] = vol
+	}
+
+	// Update tiering and snapshot policies of the parent flexvol
+	if updateInfo.TieringPolicy != "" || updateInfo.SnapshotPolicy != "" {
+		poolVols, updateErr := d.updateFlexvolPolicies(ctx, volConfig, updateInfo, flexvol, allVolumes)
+		if updateErr != nil {
+			Logc(ctx).WithError(updateErr).Error(updateGenericError)
+			return nil, updateErr
+		}
+		for volName, vol := range poolVols {
+			updatedVols[volName] = vol
+		}
+	}
+
+	if len(updatedVols) == 0 {
+		updatedVols = nil
 	}
 
 	// If qtree exists, update the volConfig.InternalID in case it was not set
@@ -2409,6 +2449,88 @@ func (d *NASQtreeStorageDriver) Update(
 	return updatedVols, updateErr
 }
 
+func (d *NASQtreeStorageDriver) updateQosPolicy(
+	ctx context.Context, volConfig *storage.VolumeConfig,
+	qosPolicy, adaptiveQosPolicy, name, flexvol string, allVolumes map[string]*storage.Volume,
+) (*storage.Volume, error) {
+	fields := LogFields{
+		"Method":            "updateQosPolicy",
+		"Type":              "NASQtreeStorageDriver",
+		"name":              volConfig.Name,
+		"qosPolicy":         qosPolicy,
+		"adaptiveQosPolicy": adaptiveQosPolicy,
+	}
+	Logc(ctx).WithFields(fields).Debug(">>>> updateQosPolicy")
+	defer Logc(ctx).WithFields(fields).Debug("<<<< updateQosPolicy")
+
+	if adaptiveQosPolicy != "" {
+		return nil, errors.InvalidInputError("qtrees do not support adaptive QoS policies")
+	}
+
+	if !d.API.SupportsFeature(ctx, api.QosPolicies) {
+		return nil, errors.UnsupportedError("trident does not support QoS policies for ONTAP version")
+	}
+
+	if err := d.API.QtreeModifyQosPolicy(ctx, name, flexvol, qosPolicy); err != nil {
+		return nil, fmt.Errorf("could not set QoS policy group of volume %s; %v", volConfig.Name, err)
+	}
+
+	vol, ok := allVolumes[volConfig.Name]
+	if !ok {
+		return nil, errors.NotFoundError("volume %s not found", volConfig.Name)
+	}
+	vol.Config.QosPolicy = qosPolicy
+
+	return vol, nil
+}
+
+// updateFlexvolPolicies changes the tiering and snapshot policies of the Flexvol containing a qtree.  As
+// these apply to every qtree in the Flexvol, the change must be requested at the pool level.
+func (d *NASQtreeStorageDriver) updateFlexvolPolicies(
+	ctx context.Context, volConfig *storage.VolumeConfig, updateInfo *utils.VolumeUpdateInfo,
+	poolName string, allVolumes map[string]*storage.Volume,
+) (map[string]*storage.Volume, error) {
+	fields := LogFields{
+		"Method":         "updateFlexvolPolicies",
+		"Type":           "NASQtreeStorageDriver",
+		"name":           volConfig.Name,
+		"tieringPolicy":  updateInfo.TieringPolicy,
+		"snapshotPolicy": updateInfo.SnapshotPolicy,
+		"poolLevel":      updateInfo.PoolLevel,
+		"poolName":       poolName,
+	}
+	Logc(ctx).WithFields(fields).Debug(">>>> updateFlexvolPolicies")
+	defer Logc(ctx).WithFields(fields).Debug("<<<< updateFlexvolPolicies")
+
+	if !updateInfo.PoolLevel {
+		return nil, errors.InvalidInputError(fmt.Sprintf(
+			"pool level must be set to true for updating tiering or snapshot policy of %v volume",
+			d.Config.StorageDriverName))
+	}
+
+	poolConfig := &storage.VolumeConfig{Name: volConfig.Name}
+	poolUpdateInfo := &utils.VolumeUpdateInfo{
+		TieringPolicy:  updateInfo.TieringPolicy,
+		SnapshotPolicy: updateInfo.SnapshotPolicy,
+	}
+	if err := updateFlexvolPolicies(ctx, poolName, "", poolConfig, poolUpdateInfo, &d.Config, d.API); err != nil {
+		return nil, err
+	}
+
+	// Every qtree in the pool shares the new policies
+	allQtreePoolVols := d.getQtreesInPool(poolName, allVolumes)
+	for _, vol := range allQtreePoolVols {
+		if poolConfig.TieringPolicy != "" {
+			vol.Config.TieringPolicy = poolConfig.TieringPolicy
+		}
+		if poolConfig.SnapshotPolicy != "" {
+			vol.Config.SnapshotPolicy = poolConfig.SnapshotPolicy
+		}
+	}
+
+	return allQtreePoolVols, nil
+}
+
 func (d *NASQtreeStorageDriver) updateSnapshotDirectory(
 	ctx context.Context, volConfig *storage.VolumeConfig,
 	snapshotDir string, poolLevel bool,
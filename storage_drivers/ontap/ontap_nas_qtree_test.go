@@ -1260,6 +1260,7 @@ func TestGet_WithErrorInApiOperation(t *testing.T) {
 
 	result1 := driver.Get(ctx, volNameInternal)
 	assert.Error(t, result1, "Expected error when api failed to check qtree existence, got nil")
+	assert.False(t, errors.IsNotFoundError(result1), "Expected error other than not found")
 
 	// CASE 2: Qtree does not exist
 	mockAPI, driver = newMockOntapNasQtreeDriver(t)
@@ -1268,6 +1269,7 @@ func TestGet_WithErrorInApiOperation(t *testing.T) {
 
 	result2 := driver.Get(ctx, volNameInternal)
 	assert.Error(t, result2, "Expected error when qtree does not exist, got nil")
+	assert.True(t, errors.IsNotFoundError(result2), "Expected not found error")
 }
 
 func TestEnsureFlexvolForQtree_Success_EligibleFlexvolFound(t *testing.T) {
@@ -5258,6 +5260,70 @@ func TestNASQtreeStorageDriver_UpdateVolume_Failure(t *testing.T) {
 	assert.Nil(t, result)
 }
 
+func TestNASQtreeStorageDriver_UpdateVolume_Policies(t *testing.T) {
+	mockAPI, driver := newMockOntapNasQtreeDriver(t)
+
+	internalID1 := "/svm/iscsi0/flexvol/trident_qtree_pool_trident_XHPULXSCYE/qtree/trident_pvc_99138d85_6259_4830_ada0_30e45e21f854"
+	internalID2 := "/svm/iscsi0/flexvol/trident_qtree_pool_trident_XHPULXSCYE/qtree/trident_pvc_99138d85_6259_4830_ada0_30e45e21f877"
+	internalID3 := "/svm/iscsi0/flexvol/trident_qtree_pool_trident_XHPULXSCQT/qtree/trident_pvc_99138d85_6259_4830_ada0_30e45e21f843"
+
+	mockVol1 := getMockVolume("pvc-99138d85-6259-4830-ada0-30e45e21f854", internalID1)
+	mockVol2 := getMockVolume("pvc-99138d85-6259-4830-ada0-30e45e21f877", internalID2)
+	mockVol3 := getMockVolume("pvc-99138d85-6259-4830-ada0-30e45e21f843", internalID3)
+
+	allVolumes := map[string]*storage.Volume{
+		"pvc-99138d85-6259-4830-ada0-30e45e21f854": mockVol1,
+		"pvc-99138d85-6259-4830-ada0-30e45e21f877": mockVol2,
+		"pvc-99138d85-6259-4830-ada0-30e45e21f843": mockVol3,
+	}
+	qtreeName := "trident_pvc_99138d85_6259_4830_ada0_30e45e21f854"
+	flexvol := "trident_qtree_pool_trident_XHPULXSCYE"
+
+	mockAPI.EXPECT().QtreeExists(gomock.Any(), qtreeName, gomock.Any()).Return(true, flexvol, nil).AnyTimes()
+	mockAPI.EXPECT().SupportsFeature(gomock.Any(), api.QosPolicies).Return(true).AnyTimes()
+
+	// QoS policy applies only to the qtree
+	mockAPI.EXPECT().QtreeModifyQosPolicy(gomock.Any(), qtreeName, flexvol, "gold").Return(nil)
+
+	result, err := driver.Update(ctx, mockVol1.Config, &utils.VolumeUpdateInfo{QosPolicy: "gold"}, allVolumes)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, "gold", mockVol1.Config.QosPolicy)
+
+	// Adaptive QoS policies are not supported on qtrees
+	_, err = driver.Update(ctx, mockVol1.Config, &utils.VolumeUpdateInfo{AdaptiveQosPolicy: "aqos"}, allVolumes)
+
+	assert.True(t, errors.IsInvalidInputError(err))
+
+	// Tiering and snapshot policies apply to every qtree in the Flexvol
+	_, err = driver.Update(ctx, mockVol1.Config, &utils.VolumeUpdateInfo{TieringPolicy: "auto"}, allVolumes)
+
+	assert.True(t, errors.IsInvalidInputError(err))
+
+	mockAPI.EXPECT().VolumeModifyTieringPolicy(gomock.Any(), flexvol, "auto").Return(nil)
+	mockAPI.EXPECT().VolumeModifySnapshotPolicy(gomock.Any(), flexvol, "hourly").Return(nil)
+
+	result, err = driver.Update(ctx, mockVol1.Config, &utils.VolumeUpdateInfo{
+		TieringPolicy:  "auto",
+		SnapshotPolicy: "hourly",
+		PoolLevel:      true,
+	}, allVolumes)
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+	for _, v := range result {
+		assert.Equal(t, "auto", v.Config.TieringPolicy)
+		assert.Equal(t, "hourly", v.Config.SnapshotPolicy)
+	}
+	assert.Empty(t, mockVol3.Config.TieringPolicy)
+
+	// Service level is not an ONTAP attribute
+	_, err = driver.Update(ctx, mockVol1.Config, &utils.VolumeUpdateInfo{ServiceLevel: "Premium"}, allVolumes)
+
+	assert.True(t, errors.IsUnsupportedError(err))
+}
+
 func TestNASQtreeStorageDriver_UpdateSnapshotDirectory_Success(t *testing.T) {
 	// Reset the package-level state after the test completes.
 	defer acp.SetAPI(acp.API())
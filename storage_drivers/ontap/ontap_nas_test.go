@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"sync"
 	"testing"
 	"time"
 
@@ -1861,6 +1862,72 @@ func TestOntapNasStorageDriverVolumeCreateSnapshot(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestOntapNasStorageDriverCreateGroupSnapshot(t *testing.T) {
+	mockAPI, driver := newMockOntapNASDriver(t)
+
+	groupSnapshotConfig := &storage.GroupSnapshotConfig{
+		InternalName: "groupsnap",
+		VolumeNames:  []string{"pvc1", "pvc2"},
+	}
+	snapConfigs := []*storage.SnapshotConfig{
+		{InternalName: "groupsnap", VolumeInternalName: "vol1"},
+		{InternalName: "groupsnap", VolumeInternalName: "vol2"},
+	}
+
+	mockAPI.EXPECT().SVMName().AnyTimes().Return("SVM1")
+	mockAPI.EXPECT().VolumeExists(ctx, "vol1").Return(true, nil)
+	mockAPI.EXPECT().VolumeExists(ctx, "vol2").Return(true, nil)
+	mockAPI.EXPECT().VolumeUsedSize(ctx, "vol1").Return(1024, nil)
+	mockAPI.EXPECT().VolumeUsedSize(ctx, "vol2").Return(2048, nil)
+	mockAPI.EXPECT().ConsistencyGroupSnapshot(ctx, "groupsnap", []string{"vol1", "vol2"}).Return(nil)
+	mockAPI.EXPECT().VolumeSnapshotInfo(ctx, "groupsnap", "vol1").
+		Return(api.Snapshot{CreateTime: "time", Name: "groupsnap"}, nil)
+	mockAPI.EXPECT().VolumeSnapshotInfo(ctx, "groupsnap", "vol2").
+		Return(api.Snapshot{CreateTime: "time", Name: "groupsnap"}, nil)
+
+	snaps, err := driver.CreateGroupSnapshot(ctx, groupSnapshotConfig, snapConfigs, nil)
+
+	assert.NoError(t, err)
+	if assert.Len(t, snaps, 2) {
+		assert.Equal(t, snapConfigs[1], snaps[1].Config)
+		assert.Equal(t, int64(2048), snaps[1].SizeBytes)
+		assert.Equal(t, storage.SnapshotStateOnline, snaps[1].State)
+	}
+}
+
+func TestOntapNasStorageDriverCreateGroupSnapshot_Failure(t *testing.T) {
+	groupSnapshotConfig := &storage.GroupSnapshotConfig{InternalName: "groupsnap"}
+	snapConfigs := []*storage.SnapshotConfig{
+		{InternalName: "groupsnap", VolumeInternalName: "vol1"},
+		{InternalName: "groupsnap", VolumeInternalName: "vol2"},
+	}
+
+	// A missing volume fails the whole group before anything is snapshotted
+	mockAPI, driver := newMockOntapNASDriver(t)
+	mockAPI.EXPECT().SVMName().AnyTimes().Return("SVM1")
+	mockAPI.EXPECT().VolumeExists(ctx, "vol1").Return(true, nil)
+	mockAPI.EXPECT().VolumeUsedSize(ctx, "vol1").Return(1024, nil)
+	mockAPI.EXPECT().VolumeExists(ctx, "vol2").Return(false, nil)
+
+	snaps, err := driver.CreateGroupSnapshot(ctx, groupSnapshotConfig, snapConfigs, nil)
+
+	assert.Nil(t, snaps)
+	assert.Error(t, err)
+
+	// A failed consistency group snapshot is returned
+	mockAPI, driver = newMockOntapNASDriver(t)
+	mockAPI.EXPECT().SVMName().AnyTimes().Return("SVM1")
+	mockAPI.EXPECT().VolumeExists(ctx, gomock.Any()).Times(2).Return(true, nil)
+	mockAPI.EXPECT().VolumeUsedSize(ctx, gomock.Any()).Times(2).Return(1024, nil)
+	mockAPI.EXPECT().ConsistencyGroupSnapshot(ctx, "groupsnap", []string{"vol1", "vol2"}).
+		Return(fmt.Errorf("cg-start failed"))
+
+	snaps, err = driver.CreateGroupSnapshot(ctx, groupSnapshotConfig, snapConfigs, nil)
+
+	assert.Nil(t, snaps)
+	assert.Error(t, err)
+}
+
 func TestOntapNasStorageDriverVolumeRestoreSnapshot(t *testing.T) {
 	mockAPI, driver := newMockOntapNASDriver(t)
 	volConfig := &storage.VolumeConfig{
@@ -1974,6 +2041,7 @@ func TestOntapNasStorageDriverVolumeGet_Error(t *testing.T) {
 	result := driver.Get(ctx, "vol1")
 
 	assert.Error(t, result)
+	assert.False(t, errors.IsNotFoundError(result))
 }
 
 func TestOntapNasStorageDriverVolumeGet_DoesNotExist(t *testing.T) {
@@ -1984,6 +2052,7 @@ func TestOntapNasStorageDriverVolumeGet_DoesNotExist(t *testing.T) {
 	result := driver.Get(ctx, "vol1")
 
 	assert.Error(t, result)
+	assert.True(t, errors.IsNotFoundError(result))
 }
 
 func TestOntapNasStorageDriverGetStorageBackendSpecs(t *testing.T) {
@@ -2905,6 +2974,152 @@ func TestNASStorageDriverGetBackendState(t *testing.T) {
 	assert.NotNil(t, changeMap, "should not be nil")
 }
 
+func TestOntapNasStorageDriverUpdate(t *testing.T) {
+	mockAPI, driver := newMockOntapNASDriver(t)
+	volConfig := &storage.VolumeConfig{
+		Name:           "pvc-1",
+		InternalName:   "vol1",
+		QosPolicy:      "silver",
+		TieringPolicy:  "none",
+		SnapshotPolicy: "default",
+	}
+	allVolumes := map[string]*storage.Volume{"pvc-1": {Config: volConfig}}
+	updateInfo := &utils.VolumeUpdateInfo{
+		AdaptiveQosPolicy: "aqos",
+		TieringPolicy:     "auto",
+		SnapshotPolicy:    "hourly",
+	}
+
+	mockAPI.EXPECT().VolumeExists(ctx, "vol1").Return(true, nil)
+	mockAPI.EXPECT().SupportsFeature(ctx, api.QosPolicies).Return(true)
+	mockAPI.EXPECT().VolumeSetQosPolicyGroupName(ctx, "vol1",
+		api.QosPolicyGroup{Name: "aqos", Kind: api.QosAdaptivePolicyGroupKind}).Return(nil)
+	mockAPI.EXPECT().VolumeModifyTieringPolicy(ctx, "vol1", "auto").Return(nil)
+	mockAPI.EXPECT().VolumeModifySnapshotPolicy(ctx, "vol1", "hourly").Return(nil)
+
+	result, err := driver.Update(ctx, volConfig, updateInfo, allVolumes)
+
+	assert.NoError(t, err)
+	if assert.Contains(t, result, "pvc-1") {
+		updatedConfig := result["pvc-1"].Config
+		assert.Empty(t, updatedConfig.QosPolicy)
+		assert.Equal(t, "aqos", updatedConfig.AdaptiveQosPolicy)
+		assert.Equal(t, "auto", updatedConfig.TieringPolicy)
+		assert.Equal(t, "hourly", updatedConfig.SnapshotPolicy)
+	}
+}
+
+func TestOntapNasStorageDriverUpdate_Failure(t *testing.T) {
+	mockAPI, driver := newMockOntapNASDriver(t)
+	volConfig := &storage.VolumeConfig{Name: "pvc-1", InternalName: "vol1"}
+	allVolumes := map[string]*storage.Volume{"pvc-1": {Config: volConfig}}
+
+	// Nothing to update
+	_, err := driver.Update(ctx, volConfig, nil, allVolumes)
+	assert.True(t, errors.IsInvalidInputError(err))
+
+	// Unsupported attributes
+	_, err = driver.Update(ctx, volConfig, &utils.VolumeUpdateInfo{SnapshotDirectory: "true"}, allVolumes)
+	assert.True(t, errors.IsUnsupportedError(err))
+
+	mockAPI.EXPECT().VolumeExists(ctx, "vol1").Return(true, nil)
+	_, err = driver.Update(ctx, volConfig, &utils.VolumeUpdateInfo{ServiceLevel: "Premium"}, allVolumes)
+	assert.True(t, errors.IsUnsupportedError(err))
+
+	// Volume does not exist
+	mockAPI.EXPECT().VolumeExists(ctx, "vol1").Return(false, nil)
+	_, err = driver.Update(ctx, volConfig, &utils.VolumeUpdateInfo{TieringPolicy: "auto"}, allVolumes)
+	assert.True(t, errors.IsNotFoundError(err))
+
+	// Invalid tiering policy
+	mockAPI.EXPECT().VolumeExists(ctx, "vol1").Return(true, nil)
+	_, err = driver.Update(ctx, volConfig, &utils.VolumeUpdateInfo{TieringPolicy: "sometimes"}, allVolumes)
+	assert.True(t, errors.IsInvalidInputError(err))
+
+	// ONTAP failure leaves the config unchanged
+	mockAPI.EXPECT().VolumeExists(ctx, "vol1").Return(true, nil)
+	mockAPI.EXPECT().VolumeModifySnapshotPolicy(ctx, "vol1", "hourly").Return(errors.New("failed"))
+	_, err = driver.Update(ctx, volConfig, &utils.VolumeUpdateInfo{SnapshotPolicy: "hourly"}, allVolumes)
+	assert.Error(t, err)
+	assert.Empty(t, volConfig.SnapshotPolicy)
+}
+
+func TestOntapNasStorageDriverMoveVolume(t *testing.T) {
+	mockAPI, driver := newMockOntapNASDriver(t)
+	driver.physicalPools = map[string]storage.Pool{
+		"aggr1": storage.NewStoragePool(nil, "aggr1"),
+		"aggr2": storage.NewStoragePool(nil, "aggr2"),
+	}
+	driver.startedMoves = &sync.Map{}
+	volConfig := &storage.VolumeConfig{Name: "pvc-1", InternalName: "vol1"}
+
+	// Start the move
+	mockAPI.EXPECT().VolumeInfo(ctx, "vol1").Return(&api.Volume{Aggregates: []string{"aggr1"}}, nil)
+	mockAPI.EXPECT().VolumeMove(ctx, "vol1", "aggr2").Return(nil)
+	err := driver.MoveVolume(ctx, volConfig, "aggr2")
+	assert.True(t, errors.IsInProgressError(err))
+
+	// Move still running
+	mockAPI.EXPECT().VolumeInfo(ctx, "vol1").
+		Return(&api.Volume{Aggregates: []string{"aggr1"}, MoveInProgress: true}, nil)
+	err = driver.MoveVolume(ctx, volConfig, "aggr2")
+	assert.True(t, errors.IsInProgressError(err))
+
+	// Move complete
+	mockAPI.EXPECT().VolumeInfo(ctx, "vol1").Return(&api.Volume{Aggregates: []string{"aggr2"}}, nil)
+	err = driver.MoveVolume(ctx, volConfig, "aggr2")
+	assert.NoError(t, err)
+}
+
+func TestOntapNasStorageDriverMoveVolume_Failure(t *testing.T) {
+	mockAPI, driver := newMockOntapNASDriver(t)
+	driver.physicalPools = map[string]storage.Pool{"aggr1": storage.NewStoragePool(nil, "aggr1")}
+	driver.startedMoves = &sync.Map{}
+	volConfig := &storage.VolumeConfig{Name: "pvc-1", InternalName: "vol1"}
+
+	// Unknown pool
+	err := driver.MoveVolume(ctx, volConfig, "aggr2")
+	assert.True(t, errors.IsInvalidInputError(err))
+
+	// Volume not found
+	mockAPI.EXPECT().VolumeInfo(ctx, "vol1").Return(nil, errors.NotFoundError("volume vol1 not found"))
+	err = driver.MoveVolume(ctx, volConfig, "aggr1")
+	assert.True(t, errors.IsNotFoundError(err))
+
+	// ONTAP refuses the move
+	mockAPI.EXPECT().VolumeInfo(ctx, "vol1").Return(&api.Volume{Aggregates: []string{"aggr3"}}, nil)
+	mockAPI.EXPECT().VolumeMove(ctx, "vol1", "aggr1").Return(errors.New("failed"))
+	err = driver.MoveVolume(ctx, volConfig, "aggr1")
+	assert.Error(t, err)
+	assert.False(t, errors.IsInProgressError(err))
+}
+
+func TestOntapNasStorageDriverMoveVolume_MoveFailed(t *testing.T) {
+	mockAPI, driver := newMockOntapNASDriver(t)
+	driver.physicalPools = map[string]storage.Pool{"aggr2": storage.NewStoragePool(nil, "aggr2")}
+	driver.startedMoves = &sync.Map{}
+	volConfig := &storage.VolumeConfig{Name: "pvc-1", InternalName: "vol1"}
+
+	// A move that failed before this one was requested does not stop it from starting
+	mockAPI.EXPECT().VolumeInfo(ctx, "vol1").Return(&api.Volume{Aggregates: []string{"aggr1"}, MoveState: "failed"}, nil)
+	mockAPI.EXPECT().VolumeMove(ctx, "vol1", "aggr2").Return(nil)
+	err := driver.MoveVolume(ctx, volConfig, "aggr2")
+	assert.True(t, errors.IsInProgressError(err))
+
+	// The move fails, which is reported rather than starting it again
+	mockAPI.EXPECT().VolumeInfo(ctx, "vol1").Return(&api.Volume{Aggregates: []string{"aggr1"}, MoveState: "failed"}, nil)
+	err = driver.MoveVolume(ctx, volConfig, "aggr2")
+	assert.Error(t, err)
+	assert.False(t, errors.IsInProgressError(err))
+	assert.Contains(t, err.Error(), "failed")
+
+	// Requesting the move again starts a new one
+	mockAPI.EXPECT().VolumeInfo(ctx, "vol1").Return(&api.Volume{Aggregates: []string{"aggr1"}, MoveState: "failed"}, nil)
+	mockAPI.EXPECT().VolumeMove(ctx, "vol1", "aggr2").Return(nil)
+	err = driver.MoveVolume(ctx, volConfig, "aggr2")
+	assert.True(t, errors.IsInProgressError(err))
+}
+
 func TestOntapNasStorageDriverResize(t *testing.T) {
 	mockAPI, driver := newMockOntapNASDriver(t)
 	aggr := make([]string, 0)
@@ -8,6 +8,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/RoaringBitmap/roaring"
@@ -40,6 +41,9 @@ type SANStorageDriver struct {
 	virtualPools  map[string]storage.Pool
 
 	cloneSplitTimers map[string]time.Time
+
+	// startedMoves records the aggregate to which each Flexvol was last asked to move
+	startedMoves *sync.Map
 }
 
 func (d *SANStorageDriver) GetConfig() *drivers.OntapStorageDriverConfig {
@@ -157,6 +161,7 @@ func (d *SANStorageDriver) Initialize(
 
 	// Set up the clone split timers
 	d.cloneSplitTimers = make(map[string]time.Time)
+	d.startedMoves = &sync.Map{}
 
 	d.initialized = true
 	return nil
@@ -381,6 +386,7 @@ func (d *SANStorageDriver) Create(
 	volConfig.Size = strconv.FormatUint(lunSizeBytes, 10)
 	volConfig.SpaceReserve = spaceReserve
 	volConfig.SnapshotPolicy = snapshotPolicy
+	volConfig.TieringPolicy = tieringPolicy
 	volConfig.SnapshotReserve = snapshotReserve
 	volConfig.UnixPermissions = unixPermissions
 	volConfig.ExportPolicy = exportPolicy
@@ -997,6 +1003,23 @@ func (d *SANStorageDriver) CreateSnapshot(
 	return createFlexvolSnapshot(ctx, snapConfig, &d.Config, d.API, d.API.LunSize)
 }
 
+// CreateGroupSnapshot creates a snapshot of each of the given volumes, all at the same point in time.
+func (d *SANStorageDriver) CreateGroupSnapshot(
+	ctx context.Context, groupSnapshotConfig *storage.GroupSnapshotConfig, snapConfigs []*storage.SnapshotConfig,
+	_ []*storage.VolumeConfig,
+) ([]*storage.Snapshot, error) {
+	fields := LogFields{
+		"Method":       "CreateGroupSnapshot",
+		"Type":         "SANStorageDriver",
+		"snapshotName": groupSnapshotConfig.InternalName,
+		"volumeCount":  len(snapConfigs),
+	}
+	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> CreateGroupSnapshot")
+	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< CreateGroupSnapshot")
+
+	return createFlexvolGroupSnapshot(ctx, groupSnapshotConfig, snapConfigs, &d.Config, d.API, d.API.LunSize)
+}
+
 // RestoreSnapshot restores a volume (in place) from a snapshot.
 func (d *SANStorageDriver) RestoreSnapshot(
 	ctx context.Context, snapConfig *storage.SnapshotConfig, _ *storage.VolumeConfig,
@@ -1057,17 +1080,51 @@ func (d *SANStorageDriver) Get(ctx context.Context, name string) error {
 	}
 	if !volExists {
 		Logc(ctx).WithField("Flexvol", name).Debug("Flexvol not found.")
-		return fmt.Errorf("volume %s does not exist", name)
+		return errors.NotFoundError("volume %s does not exist", name)
 	}
 
 	return nil
 }
 
+// GetVolumeHealth reports the condition of the Flexvol and LUN backing a volume.  The Flexvol space
+// check is skipped, since a Flexvol holding a space-reserved LUN always appears full.
+func (d *SANStorageDriver) GetVolumeHealth(
+	ctx context.Context, volConfig *storage.VolumeConfig,
+) (*storage.VolumeHealth, error) {
+	fields := LogFields{"Method": "GetVolumeHealth", "Type": "SANStorageDriver", "name": volConfig.InternalName}
+	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> GetVolumeHealth")
+	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< GetVolumeHealth")
+
+	health, err := getVolumeHealthCommon(ctx, d.API, volConfig, volConfig.InternalName, false)
+	if err != nil || health.IsAbnormal() {
+		return health, err
+	}
+
+	lun, err := d.API.LunGetByName(ctx, lunPath(volConfig.InternalName))
+	if err != nil {
+		return nil, fmt.Errorf("could not get LUN %s; %v", lunPath(volConfig.InternalName), err)
+	} else if lun == nil {
+		return storage.NewVolumeHealth(storage.VolumeHealthMissing,
+			fmt.Sprintf("LUN %s was not found on the backend", lunPath(volConfig.InternalName))), nil
+	}
+	if lun.State != "" && lun.State != "online" {
+		return storage.NewVolumeHealth(storage.VolumeHealthOffline,
+			fmt.Sprintf("LUN %s is %s", lun.Name, lun.State)), nil
+	}
+
+	return health, nil
+}
+
 // GetStorageBackendSpecs retrieves storage backend capabilities
 func (d *SANStorageDriver) GetStorageBackendSpecs(_ context.Context, backend storage.Backend) error {
 	return getStorageBackendSpecsCommon(backend, d.physicalPools, d.virtualPools, d.BackendName())
 }
 
+// GetPoolCapacity returns the space available to the specified storage pool
+func (d *SANStorageDriver) GetPoolCapacity(ctx context.Context, pool storage.Pool) (*storage.PoolCapacity, error) {
+	return getPoolCapacityCommon(ctx, d.API, pool, d.physicalPools, d.virtualPools)
+}
+
 // GetStorageBackendPhysicalPoolNames retrieves storage backend physical pools
 func (d *SANStorageDriver) GetStorageBackendPhysicalPoolNames(context.Context) []string {
 	return getStorageBackendPhysicalPoolNamesCommon(d.physicalPools)
@@ -1297,6 +1354,77 @@ func (d *SANStorageDriver) GetUpdateType(_ context.Context, driverOrig storage.D
 	return bitmap
 }
 
+// Update modifies the QoS, tiering and snapshot policies of an existing volume.
+func (d *SANStorageDriver) Update(
+	ctx context.Context, volConfig *storage.VolumeConfig,
+	updateInfo *utils.VolumeUpdateInfo, allVolumes map[string]*storage.Volume,
+) (map[string]*storage.Volume, error) {
+	name := volConfig.InternalName
+	fields := LogFields{
+		"Method":     "Update",
+		"Type":       "SANStorageDriver",
+		"name":       name,
+		"updateInfo": updateInfo,
+	}
+	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> Update")
+	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< Update")
+
+	updateGenericError := fmt.Sprintf("failed to update volume %v", volConfig.Name)
+
+	if updateInfo == nil {
+		err := errors.InvalidInputError(fmt.Sprintf("nothing to update for volume %v", volConfig.Name))
+		Logc(ctx).WithError(err).Error(updateGenericError)
+		return nil, err
+	}
+
+	if updateInfo.SnapshotDirectory != "" {
+		err := errors.UnsupportedError(fmt.Sprintf("snapshot directory may not be changed on %s volumes",
+			d.Config.StorageDriverName))
+		Logc(ctx).WithError(err).Error(updateGenericError)
+		return nil, err
+	}
+
+	volExists, err := d.API.VolumeExists(ctx, name)
+	if err != nil {
+		Logc(ctx).WithError(err).Error(updateGenericError)
+		return nil, err
+	} else if !volExists {
+		err = errors.NotFoundError("volume %s not found", name)
+		Logc(ctx).WithError(err).Error(updateGenericError)
+		return nil, err
+	}
+
+	if err = updateFlexvolPolicies(ctx, name, lunPath(name), volConfig, updateInfo, &d.Config, d.API); err != nil {
+		Logc(ctx).WithError(err).Error(updateGenericError)
+		return nil, err
+	}
+
+	vol, ok := allVolumes[volConfig.Name]
+	if !ok {
+		err = errors.NotFoundError("volume %s not found", volConfig.Name)
+		Logc(ctx).WithError(err).Error(updateGenericError)
+		return nil, err
+	}
+	vol.Config = volConfig
+
+	return map[string]*storage.Volume{volConfig.Name: vol}, nil
+}
+
+// MoveVolume relocates the Flexvol backing a volume to the aggregate of another pool of this backend.
+func (d *SANStorageDriver) MoveVolume(ctx context.Context, volConfig *storage.VolumeConfig, poolName string) error {
+	name := volConfig.InternalName
+	fields := LogFields{
+		"Method": "MoveVolume",
+		"Type":   "SANStorageDriver",
+		"name":   name,
+		"pool":   poolName,
+	}
+	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> MoveVolume")
+	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< MoveVolume")
+
+	return moveFlexvol(ctx, name, poolName, d.physicalPools, d.API, d.startedMoves)
+}
+
 // Resize expands the volume size.
 func (d *SANStorageDriver) Resize(
 	ctx context.Context, volConfig *storage.VolumeConfig, requestedSizeBytes uint64,
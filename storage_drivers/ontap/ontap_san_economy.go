@@ -188,6 +188,7 @@ type SANEconomyStorageDriver struct {
 	flexvolNamePrefix string
 	helper            *LUNHelper
 	lunsPerFlexvol    int
+	sharedLockID      string
 
 	physicalPools map[string]storage.Pool
 	virtualPools  map[string]storage.Pool
@@ -294,6 +295,7 @@ func (d *SANEconomyStorageDriver) Initialize(
 	// Set up internal driver state
 	d.flexvolNamePrefix = fmt.Sprintf("%s_lun_pool_%s_", artifactPrefix, *d.Config.StoragePrefix)
 	d.flexvolNamePrefix = strings.Replace(d.flexvolNamePrefix, "__", "_", -1)
+	d.sharedLockID = d.API.GetSVMUUID() + "-" + *d.Config.StoragePrefix
 
 	// ensure lun cap is valid
 	if config.LUNsPerFlexvol == "" {
@@ -318,6 +320,7 @@ func (d *SANEconomyStorageDriver) Initialize(
 		LogFields{
 			"FlexvolNamePrefix": d.flexvolNamePrefix,
 			"LUNsPerFlexvol":    d.lunsPerFlexvol,
+			"SharedLockID":      d.sharedLockID,
 		},
 	).Debugf("SAN Economy driver settings.")
 
@@ -416,6 +419,10 @@ func (d *SANEconomyStorageDriver) Create(
 	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> Create")
 	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< Create")
 
+	// Ensure concurrent creates do not place more LUNs on a Flexvol than it may hold
+	utils.Lock(ctx, "create", d.sharedLockID)
+	defer utils.Unlock(ctx, "create", d.sharedLockID)
+
 	// Generic user-facing message
 	createError := errors.New("error volume creation failed")
 
@@ -744,6 +751,10 @@ func (d *SANEconomyStorageDriver) CreateClone(
 	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> CreateClone")
 	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< CreateClone")
 
+	// Ensure concurrent creates do not place more LUNs on a Flexvol than it may hold
+	utils.Lock(ctx, "create", d.sharedLockID)
+	defer utils.Unlock(ctx, "create", d.sharedLockID)
+
 	qosPolicyGroup, err := api.NewQosPolicyGroup(qosPolicy, adaptiveQosPolicy)
 	if err != nil {
 		return err
@@ -976,6 +987,10 @@ func (d *SANEconomyStorageDriver) Destroy(ctx context.Context, volConfig *storag
 	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> Destroy")
 	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< Destroy")
 
+	// Ensure a Flexvol emptied by this delete is not chosen by a concurrent create
+	utils.Lock(ctx, "destroy", d.sharedLockID)
+	defer utils.Unlock(ctx, "destroy", d.sharedLockID)
+
 	var (
 		err           error
 		iSCSINodeName string
@@ -1605,17 +1620,14 @@ func (d *SANEconomyStorageDriver) Get(ctx context.Context, name string) error {
 	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> Get")
 	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< Get")
 
-	// Generic user-facing message
-	getError := fmt.Errorf("volume %s not found", name)
-
 	exists, bucketVol, err := d.LUNExists(ctx, name, d.FlexvolNamePrefix())
 	if err != nil {
 		Logc(ctx).Errorf("Error checking for existing LUN: %v", err)
-		return getError
+		return fmt.Errorf("error checking for existing volume %s: %v", name, err)
 	}
 	if !exists {
 		Logc(ctx).WithField("LUN", name).Debug("LUN not found.")
-		return getError
+		return errors.NotFoundError("volume %s not found", name)
 	}
 
 	Logc(ctx).WithFields(LogFields{"LUN": name, "bucketVol": bucketVol}).Debug("Volume found.")
@@ -1853,6 +1865,11 @@ func (d *SANEconomyStorageDriver) GetStorageBackendSpecs(_ context.Context, back
 	return getStorageBackendSpecsCommon(backend, d.physicalPools, d.virtualPools, d.BackendName())
 }
 
+// GetPoolCapacity returns the space available to the specified storage pool
+func (d *SANEconomyStorageDriver) GetPoolCapacity(ctx context.Context, pool storage.Pool) (*storage.PoolCapacity, error) {
+	return getPoolCapacityCommon(ctx, d.API, pool, d.physicalPools, d.virtualPools)
+}
+
 // GetStorageBackendPhysicalPoolNames retrieves storage backend physical pools
 func (d *SANEconomyStorageDriver) GetStorageBackendPhysicalPoolNames(context.Context) []string {
 	return getStorageBackendPhysicalPoolNamesCommon(d.physicalPools)
@@ -2152,6 +2169,10 @@ func (d *SANEconomyStorageDriver) Resize(ctx context.Context, volConfig *storage
 	Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace(">>>> Resize")
 	defer Logd(ctx, d.Name(), d.Config.DebugTraceFlags["method"]).WithFields(fields).Trace("<<<< Resize")
 
+	// Ensure concurrent resizes do not overcommit the Flexvol that holds this LUN
+	utils.Lock(ctx, "resize", d.sharedLockID)
+	defer utils.Unlock(ctx, "resize", d.sharedLockID)
+
 	// Generic user-facing message
 	resizeError := errors.New("storage driver failed to resize the volume")
 
@@ -3633,6 +3633,8 @@ func TestOntapSanEconomyGet_LUNDoesNotExist(t *testing.T) {
 			result := d.Get(ctx, "my_Bucket")
 
 			assert.Error(t, result)
+			// Only a LUN that is known to be absent is reported as not found
+			assert.Equal(t, !test.expectError, utilserrors.IsNotFoundError(result))
 		})
 	}
 }
@@ -4522,11 +4524,13 @@ func TestOntapSanEconomyInitialize(t *testing.T) {
 	mockAPI.EXPECT().IscsiInitiatorGetDefaultAuth(ctx).Return(authResponse, nil)
 	mockAPI.EXPECT().EmsAutosupportLog(ctx, "ontap-san-economy", "1", false, "heartbeat", hostname, string(message), 1,
 		"trident", 5).AnyTimes()
-	mockAPI.EXPECT().GetSVMUUID().Return("SVM1-uuid")
+	mockAPI.EXPECT().GetSVMUUID().AnyTimes().Return("SVM1-uuid")
 
 	result := d.Initialize(ctx, "csi", commonConfigJSON, commonConfig, secrets, BackendUUID)
 
 	assert.NoError(t, result)
+	// Backends on other SVMs must not share the lock that serializes flexvol changes
+	assert.Equal(t, "SVM1-uuid-"+*d.Config.StoragePrefix, d.sharedLockID)
 }
 
 func TestOntapSanEconomyInitialize_WithNameTemplate(t *testing.T) {
@@ -4577,7 +4581,7 @@ func TestOntapSanEconomyInitialize_WithNameTemplate(t *testing.T) {
 	)
 	mockAPI.EXPECT().IsSVMDRCapable(ctx).Return(true, nil).AnyTimes()
 	mockAPI.EXPECT().IscsiInitiatorGetDefaultAuth(ctx).Return(authResponse, nil)
-	mockAPI.EXPECT().GetSVMUUID().Return("SVM1-uuid")
+	mockAPI.EXPECT().GetSVMUUID().AnyTimes().Return("SVM1-uuid")
 
 	result := d.Initialize(ctx, "csi", commonConfigJSON, commonConfig, secrets, BackendUUID)
 	assert.NoError(t, result)
@@ -4639,7 +4643,7 @@ func TestOntapSanEconomyInitialize_NameTemplateDefineInStoragePool(t *testing.T)
 	)
 	mockAPI.EXPECT().IsSVMDRCapable(ctx).Return(true, nil).AnyTimes()
 	mockAPI.EXPECT().IscsiInitiatorGetDefaultAuth(ctx).Return(authResponse, nil)
-	mockAPI.EXPECT().GetSVMUUID().Return("SVM1-uuid")
+	mockAPI.EXPECT().GetSVMUUID().AnyTimes().Return("SVM1-uuid")
 
 	result := d.Initialize(ctx, "csi", commonConfigJSON, commonConfig, secrets, BackendUUID)
 	assert.NoError(t, result)
@@ -4706,7 +4710,7 @@ func TestOntapSanEconomyInitialize_NameTemplateDefineInBothPool(t *testing.T) {
 	)
 	mockAPI.EXPECT().IsSVMDRCapable(ctx).Return(true, nil).AnyTimes()
 	mockAPI.EXPECT().IscsiInitiatorGetDefaultAuth(ctx).Return(authResponse, nil)
-	mockAPI.EXPECT().GetSVMUUID().Return("SVM1-uuid")
+	mockAPI.EXPECT().GetSVMUUID().AnyTimes().Return("SVM1-uuid")
 
 	result := d.Initialize(ctx, "csi", commonConfigJSON, commonConfig, secrets, BackendUUID)
 	assert.NoError(t, result)
@@ -4832,6 +4836,7 @@ func TestOntapSanEconomyInitialize_NumOfLUNs(t *testing.T) {
 
 			mockAPI.EXPECT().SVMName().AnyTimes().Return("SVM1")
 			mockAPI.EXPECT().NetInterfaceGetDataLIFs(ctx, "iscsi").Return([]string{"10.0.207.7"}, nil)
+			mockAPI.EXPECT().GetSVMUUID().AnyTimes().Return("SVM1-uuid")
 			mockAPI.EXPECT().GetSVMAggregateNames(ctx).AnyTimes().Return([]string{ONTAPTEST_VSERVER_AGGR_NAME}, nil)
 			mockAPI.EXPECT().GetSVMAggregateAttributes(gomock.Any()).AnyTimes().Return(
 				map[string]string{ONTAPTEST_VSERVER_AGGR_NAME: "vmdisk"}, nil,
@@ -4841,7 +4846,6 @@ func TestOntapSanEconomyInitialize_NumOfLUNs(t *testing.T) {
 				"trident", 5).AnyTimes()
 			if !test.expectError {
 				mockAPI.EXPECT().IscsiInitiatorGetDefaultAuth(ctx).Return(authResponse, nil)
-				mockAPI.EXPECT().GetSVMUUID().Return("SVM1-uuid").AnyTimes()
 			}
 
 			result := d.Initialize(ctx, "csi", commonConfigJSON, commonConfig, secrets, BackendUUID)
@@ -4893,6 +4897,7 @@ func TestOntapSanEconomyInitialize_OtherContext(t *testing.T) {
 		t.Run(test.driverContext, func(t *testing.T) {
 			mockAPI.EXPECT().SVMName().AnyTimes().Return("SVM1")
 			mockAPI.EXPECT().NetInterfaceGetDataLIFs(ctx, "iscsi").Return([]string{"10.0.207.7"}, nil)
+			mockAPI.EXPECT().GetSVMUUID().AnyTimes().Return("SVM1-uuid")
 			mockAPI.EXPECT().GetSVMAggregateNames(ctx).AnyTimes().Return([]string{ONTAPTEST_VSERVER_AGGR_NAME}, nil)
 			mockAPI.EXPECT().GetSVMAggregateAttributes(gomock.Any()).AnyTimes().Return(
 				map[string]string{ONTAPTEST_VSERVER_AGGR_NAME: "vmdisk"}, nil,
@@ -4934,6 +4939,7 @@ func TestOntapSanEconomyInitialize_NoSVMAggregates(t *testing.T) {
 
 	mockAPI.EXPECT().SVMName().AnyTimes().Return("SVM1")
 	mockAPI.EXPECT().NetInterfaceGetDataLIFs(ctx, "iscsi").Return([]string{"10.0.207.7"}, nil)
+	mockAPI.EXPECT().GetSVMUUID().AnyTimes().Return("SVM1-uuid")
 	mockAPI.EXPECT().GetSVMAggregateNames(ctx).AnyTimes().Return(nil, fmt.Errorf("error getting svm aggregate names"))
 
 	result := d.Initialize(ctx, "csi", commonConfigJSON, commonConfig, secrets, BackendUUID)
@@ -988,7 +988,7 @@ func (d *NVMeStorageDriver) Get(ctx context.Context, name string) error {
 	}
 	if !volExists {
 		Logc(ctx).WithField("Flexvol", name).Debug("Flexvol not found.")
-		return fmt.Errorf("volume %s does not exist", name)
+		return errors.NotFoundError("volume %s does not exist", name)
 	}
 	return nil
 }
@@ -998,6 +998,11 @@ func (d *NVMeStorageDriver) GetStorageBackendSpecs(_ context.Context, backend st
 	return getStorageBackendSpecsCommon(backend, d.physicalPools, d.virtualPools, d.BackendName())
 }
 
+// GetPoolCapacity returns the space available to the specified storage pool.
+func (d *NVMeStorageDriver) GetPoolCapacity(ctx context.Context, pool storage.Pool) (*storage.PoolCapacity, error) {
+	return getPoolCapacityCommon(ctx, d.API, pool, d.physicalPools, d.virtualPools)
+}
+
 // GetStorageBackendPhysicalPoolNames retrieves storage backend physical pools.
 func (d *NVMeStorageDriver) GetStorageBackendPhysicalPoolNames(context.Context) []string {
 	return getStorageBackendPhysicalPoolNamesCommon(d.physicalPools)
@@ -754,6 +754,32 @@ func TestNVMeGetReplicationDetails_Error(t *testing.T) {
 	assert.ErrorContains(t, err, "invalid volume name")
 }
 
+func TestNVMeGet(t *testing.T) {
+	d, mAPI := newNVMeDriverAndMockApi(t)
+
+	// Case: Volume exists
+	mAPI.EXPECT().VolumeExists(ctx, "vol1").Return(true, nil)
+
+	err := d.Get(ctx, "vol1")
+
+	assert.NoError(t, err)
+
+	// Case: Volume does not exist
+	mAPI.EXPECT().VolumeExists(ctx, "vol1").Return(false, nil)
+
+	err = d.Get(ctx, "vol1")
+
+	assert.True(t, errors.IsNotFoundError(err), "Expected not found error.")
+
+	// Case: Error checking for the volume
+	mAPI.EXPECT().VolumeExists(ctx, "vol1").Return(false, fmt.Errorf("api invocation error"))
+
+	err = d.Get(ctx, "vol1")
+
+	assert.Error(t, err)
+	assert.False(t, errors.IsNotFoundError(err), "Expected error other than not found.")
+}
+
 func getNVMeCreateArgs(d *NVMeStorageDriver) (storage.Pool, *storage.VolumeConfig, map[string]sa.Request) {
 	pool1 := d.virtualPools["pool1"]
 	volConfig := &storage.VolumeConfig{InternalName: "vol1", Size: "200000000"}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"sync"
 	"testing"
 	"time"
 
@@ -2960,6 +2961,44 @@ func TestOntapSANStorageDriverGetUpdateType_Failure(t *testing.T) {
 	assert.Equal(t, expectedBitmap, result, "bitmap mismatch")
 }
 
+func TestOntapSANStorageDriverUpdate(t *testing.T) {
+	mockAPI, driver := newMockOntapSANDriver(t)
+	volConfig := getVolumeConfig()
+	allVolumes := map[string]*storage.Volume{volConfig.Name: {Config: volConfig}}
+	updateInfo := &utils.VolumeUpdateInfo{QosPolicy: "gold", TieringPolicy: "none"}
+
+	mockAPI.EXPECT().VolumeExists(ctx, "trident-pvc-1234").Return(true, nil)
+	mockAPI.EXPECT().SupportsFeature(ctx, api.QosPolicies).Return(true)
+	// QoS is set on the LUN rather than its Flexvol
+	mockAPI.EXPECT().LunSetQosPolicyGroup(ctx, "/vol/trident-pvc-1234/lun0",
+		api.QosPolicyGroup{Name: "gold", Kind: api.QosPolicyGroupKind}).Return(nil)
+	mockAPI.EXPECT().VolumeModifyTieringPolicy(ctx, "trident-pvc-1234", "none").Return(nil)
+
+	result, err := driver.Update(ctx, volConfig, updateInfo, allVolumes)
+
+	assert.NoError(t, err)
+	if assert.Contains(t, result, volConfig.Name) {
+		assert.Equal(t, "gold", result[volConfig.Name].Config.QosPolicy)
+		assert.Equal(t, "none", result[volConfig.Name].Config.TieringPolicy)
+	}
+}
+
+func TestOntapSANStorageDriverMoveVolume(t *testing.T) {
+	mockAPI, driver := newMockOntapSANDriver(t)
+	driver.physicalPools = map[string]storage.Pool{"aggr2": storage.NewStoragePool(nil, "aggr2")}
+	driver.startedMoves = &sync.Map{}
+	volConfig := getVolumeConfig()
+
+	mockAPI.EXPECT().VolumeInfo(ctx, "trident-pvc-1234").Return(&api.Volume{Aggregates: []string{"aggr1"}}, nil)
+	mockAPI.EXPECT().VolumeMove(ctx, "trident-pvc-1234", "aggr2").Return(nil)
+	err := driver.MoveVolume(ctx, volConfig, "aggr2")
+	assert.True(t, errors.IsInProgressError(err))
+
+	mockAPI.EXPECT().VolumeInfo(ctx, "trident-pvc-1234").Return(&api.Volume{Aggregates: []string{"aggr2"}}, nil)
+	err = driver.MoveVolume(ctx, volConfig, "aggr2")
+	assert.NoError(t, err)
+}
+
 func TestOntapSANStorageDriverResize(t *testing.T) {
 	mockAPI, driver := newMockOntapSANDriver(t)
 	aggr := make([]string, 0)
@@ -4715,6 +4754,32 @@ func TestOntapSanVolumeValidate_ValidateStoragePools(t *testing.T) {
 	assert.Error(t, err, "Unexpected error")
 }
 
+func TestOntapSanStorageDriverCreateGroupSnapshot(t *testing.T) {
+	mockAPI, driver := newMockOntapSANDriver(t)
+
+	groupSnapshotConfig := &storage.GroupSnapshotConfig{InternalName: "groupsnap"}
+	snapConfigs := []*storage.SnapshotConfig{
+		{InternalName: "groupsnap", VolumeInternalName: "vol1"},
+		{InternalName: "groupsnap", VolumeInternalName: "vol2"},
+	}
+
+	mockAPI.EXPECT().SVMName().AnyTimes().Return("SVM1")
+	mockAPI.EXPECT().VolumeExists(ctx, gomock.Any()).Times(2).Return(true, nil)
+	mockAPI.EXPECT().LunSize(ctx, "vol1").Return(1024, nil)
+	mockAPI.EXPECT().LunSize(ctx, "vol2").Return(2048, nil)
+	mockAPI.EXPECT().ConsistencyGroupSnapshot(ctx, "groupsnap", []string{"vol1", "vol2"}).Return(nil)
+	mockAPI.EXPECT().VolumeSnapshotInfo(ctx, "groupsnap", gomock.Any()).Times(2).
+		Return(api.Snapshot{CreateTime: "time", Name: "groupsnap"}, nil)
+
+	snaps, err := driver.CreateGroupSnapshot(ctx, groupSnapshotConfig, snapConfigs, nil)
+
+	assert.NoError(t, err)
+	if assert.Len(t, snaps, 2) {
+		assert.Equal(t, int64(1024), snaps[0].SizeBytes)
+		assert.Equal(t, int64(2048), snaps[1].SizeBytes)
+	}
+}
+
 func TestOntapSanStorageDriverVolumeRestoreSnapshot(t *testing.T) {
 	mockAPI, driver := newMockOntapSANDriver(t)
 	volConfig := &storage.VolumeConfig{
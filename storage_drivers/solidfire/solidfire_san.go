@@ -1480,7 +1480,7 @@ func (d *SANStorageDriver) Get(ctx context.Context, name string) error {
 	if err != nil {
 		return fmt.Errorf("could not locate volume %s; %v", name, err)
 	} else if !exists {
-		return fmt.Errorf("could not locate volume %s", name)
+		return errors.NotFoundError("could not locate volume %s", name)
 	}
 	return nil
 }
@@ -244,6 +244,16 @@ func (h *BlockOnFileReconcileHelper) GetLoopDeviceAttachedToFile(ctx context.Con
 	return false, nil, nil
 }
 
+// GetAllLoopDevices returns the loop devices attached on this host.
+func GetAllLoopDevices(ctx context.Context) ([]LoopDevice, error) {
+	GenerateRequestContextForLayer(ctx, LogLayerUtils)
+
+	Logc(ctx).Debug(">>>> bof.GetAllLoopDevices")
+	defer Logc(ctx).Debug("<<<< bof.GetAllLoopDevices")
+
+	return getLoopDeviceInfo(ctx)
+}
+
 func GetAllLoopDeviceBackFiles(ctx context.Context) ([]string, error) {
 	GenerateRequestContextForLayer(ctx, LogLayerUtils)
 
@@ -0,0 +1,210 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+// Package cron parses the schedules used by Trident's periodic, user-defined operations.
+package cron
+
+import (
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// searchLimit bounds the search for the next matching time, so that schedules that can never
+// fire (i.e. February 30) do not loop forever.
+const searchLimit = 5 * 365 * 24 * time.Hour
+
+// Schedule is a parsed schedule, which may be either a standard five-field cron expression
+// (minute, hour, day of month, month, day of week) or a fixed interval.  All times are evaluated in UTC.
+type Schedule struct {
+	spec string
+
+	minute, hour, dayOfMonth, month, dayOfWeek uint64
+
+	// Per cron convention, if either day field is unrestricted, both day fields must match,
+	// otherwise either day field may match.
+	dayOfMonthStar, dayOfWeekStar bool
+
+	// every is the interval of an "@every <duration>" schedule
+	every time.Duration
+}
+
+type field struct {
+	name     string
+	min, max int
+}
+
+var (
+	minuteField     = field{"minute", 0, 59}
+	hourField       = field{"hour", 0, 23}
+	dayOfMonthField = field{"day of month", 1, 31}
+	monthField      = field{"month", 1, 12}
+	dayOfWeekField  = field{"day of week", 0, 7}
+
+	macros = map[string]string{
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+		"@monthly":  "0 0 1 * *",
+		"@weekly":   "0 0 * * 0",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@hourly":   "0 * * * *",
+	}
+)
+
+// Parse parses a cron expression, one of the macros @yearly, @monthly, @weekly, @daily or @hourly,
+// or an interval of the form "@every <duration>".  Each cron field may be "*", a value, a range "a-b",
+// a step "*/n" or "a-b/n", or a comma-separated list of those.
+func Parse(spec string) (*Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	schedule := &Schedule{spec: spec}
+
+	if strings.HasPrefix(spec, "@every ") {
+		every, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(spec, "@every ")))
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule %s; %v", spec, err)
+		}
+		if every < time.Minute {
+			return nil, fmt.Errorf("invalid schedule %s; interval must be at least one minute", spec)
+		}
+		schedule.every = every
+		return schedule, nil
+	}
+
+	expression := spec
+	if macro, ok := macros[spec]; ok {
+		expression = macro
+	}
+
+	fields := strings.Fields(expression)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid schedule %s; expected 5 fields, found %d", spec, len(fields))
+	}
+
+	var err error
+	if schedule.minute, err = parseField(fields[0], minuteField); err != nil {
+		return nil, fmt.Errorf("invalid schedule %s; %v", spec, err)
+	}
+	if schedule.hour, err = parseField(fields[1], hourField); err != nil {
+		return nil, fmt.Errorf("invalid schedule %s; %v", spec, err)
+	}
+	if schedule.dayOfMonth, err = parseField(fields[2], dayOfMonthField); err != nil {
+		return nil, fmt.Errorf("invalid schedule %s; %v", spec, err)
+	}
+	if schedule.month, err = parseField(fields[3], monthField); err != nil {
+		return nil, fmt.Errorf("invalid schedule %s; %v", spec, err)
+	}
+	if schedule.dayOfWeek, err = parseField(fields[4], dayOfWeekField); err != nil {
+		return nil, fmt.Errorf("invalid schedule %s; %v", spec, err)
+	}
+	// Accept 7 as a synonym for Sunday
+	if schedule.dayOfWeek&(1<<7) != 0 {
+		schedule.dayOfWeek = schedule.dayOfWeek&^(1<<7) | 1
+	}
+	schedule.dayOfMonthStar = fields[2] == "*"
+	schedule.dayOfWeekStar = fields[4] == "*"
+
+	return schedule, nil
+}
+
+// parseField returns a bitset of the values allowed by a single cron field.
+func parseField(value string, f field) (uint64, error) {
+	var set uint64
+
+	for _, term := range strings.Split(value, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(term, "/")
+
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepPart); err != nil || step < 1 {
+				return 0, fmt.Errorf("invalid step %s in %s field", stepPart, f.name)
+			}
+		}
+
+		low, high := f.min, f.max
+		if rangePart != "*" {
+			lowPart, highPart, isRange := strings.Cut(rangePart, "-")
+
+			var err error
+			if low, err = strconv.Atoi(lowPart); err != nil {
+				return 0, fmt.Errorf("invalid value %s in %s field", lowPart, f.name)
+			}
+			high = low
+			if isRange {
+				if high, err = strconv.Atoi(highPart); err != nil {
+					return 0, fmt.Errorf("invalid value %s in %s field", highPart, f.name)
+				}
+			} else if hasStep {
+				high = f.max
+			}
+		}
+
+		if low < f.min || high > f.max || low > high {
+			return 0, fmt.Errorf("%s out of range in %s field", term, f.name)
+		}
+
+		for i := low; i <= high; i += step {
+			set |= 1 << uint(i)
+		}
+	}
+
+	return set, nil
+}
+
+// String returns the schedule as it was specified.
+func (s *Schedule) String() string {
+	return s.spec
+}
+
+// Next returns the first time after t at which the schedule fires, or the zero time if there
+// is no such time within the next several years.
+func (s *Schedule) Next(t time.Time) time.Time {
+	t = t.UTC()
+
+	if s.every > 0 {
+		return t.Add(s.every).Truncate(time.Minute)
+	}
+
+	// Start at the beginning of the next minute
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(searchLimit)
+
+	for t.Before(limit) {
+		if s.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if s.hour&(1<<uint(t.Hour())) == 0 {
+			t = t.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+		if s.minute&(1<<uint(t.Minute())) == 0 {
+			// Skip directly to the next allowed minute in this hour, if there is one
+			if next := s.minute >> uint(t.Minute()); next != 0 {
+				t = t.Add(time.Duration(bits.TrailingZeros64(next)) * time.Minute)
+			} else {
+				t = t.Truncate(time.Hour).Add(time.Hour)
+			}
+			continue
+		}
+		return t
+	}
+
+	return time.Time{}
+}
+
+func (s *Schedule) dayMatches(t time.Time) bool {
+	dayOfMonthMatch := s.dayOfMonth&(1<<uint(t.Day())) != 0
+	dayOfWeekMatch := s.dayOfWeek&(1<<uint(t.Weekday())) != 0
+
+	if s.dayOfMonthStar || s.dayOfWeekStar {
+		return dayOfMonthMatch && dayOfWeekMatch
+	}
+	return dayOfMonthMatch || dayOfWeekMatch
+}
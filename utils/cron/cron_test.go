@@ -0,0 +1,74 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"5-1 * * * *",
+		"*/0 * * * *",
+		"a * * * *",
+		"@every 10s",
+		"@every never",
+		"@reboot",
+	}
+
+	for _, spec := range tests {
+		t.Run(spec, func(t *testing.T) {
+			_, err := Parse(spec)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestScheduleNext(t *testing.T) {
+	// Wednesday, 16 October 2024
+	start := time.Date(2024, 10, 16, 10, 17, 30, 0, time.UTC)
+
+	tests := []struct {
+		spec     string
+		expected time.Time
+	}{
+		{"* * * * *", time.Date(2024, 10, 16, 10, 18, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2024, 10, 16, 10, 30, 0, 0, time.UTC)},
+		{"5,40 * * * *", time.Date(2024, 10, 16, 10, 40, 0, 0, time.UTC)},
+		{"5 * * * *", time.Date(2024, 10, 16, 11, 5, 0, 0, time.UTC)},
+		{"0 2 * * *", time.Date(2024, 10, 17, 2, 0, 0, 0, time.UTC)},
+		{"30 9-17/4 * * *", time.Date(2024, 10, 16, 13, 30, 0, 0, time.UTC)},
+		{"0 0 * * 0", time.Date(2024, 10, 20, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2024, 10, 20, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * 1-5", time.Date(2024, 10, 17, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1 * 6", time.Date(2024, 10, 19, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2024, 10, 16, 11, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2024, 10, 17, 0, 0, 0, 0, time.UTC)},
+		{"@weekly", time.Date(2024, 10, 20, 0, 0, 0, 0, time.UTC)},
+		{"@monthly", time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)},
+		{"@yearly", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"@every 90m", time.Date(2024, 10, 16, 11, 47, 0, 0, time.UTC)},
+		{"0 0 30 2 *", time.Time{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.spec, func(t *testing.T) {
+			schedule, err := Parse(test.spec)
+			assert.NoError(t, err)
+			assert.Equal(t, test.spec, schedule.String())
+			assert.Equal(t, test.expected, schedule.Next(start))
+		})
+	}
+}
@@ -0,0 +1,389 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package utils
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	. "github.com/netapp/trident/logging"
+)
+
+const (
+	// deviceEventBuffer is the number of device events held for each subscription
+	deviceEventBuffer = 16
+
+	// devicePollInterval is how often block devices are listed when uevents cannot be received
+	devicePollInterval = 500 * time.Millisecond
+
+	// deviceRecheckInterval is the longest a wait for devices goes without checking for them, in case an event
+	// was missed
+	deviceRecheckInterval = time.Second
+
+	// deviceArrivalTimeout is how long attach waits for a device to appear before giving up, leaving the caller
+	// to retry
+	deviceArrivalTimeout = 5 * time.Second
+
+	// deviceRemovalTimeout is how long detach waits for deleted devices to disappear
+	deviceRemovalTimeout = time.Second
+)
+
+// deviceWatcher is the node-wide watcher of block devices, started by its first subscription.
+var deviceWatcher = NewDeviceWatcher()
+
+// DeviceEventAction is what happened to a block device.
+type DeviceEventAction string
+
+const (
+	DeviceAdded   = DeviceEventAction("add")
+	DeviceRemoved = DeviceEventAction("remove")
+	DeviceChanged = DeviceEventAction("change")
+)
+
+// DeviceIdentity identifies the storage behind a block device.  Fields that could not be read are empty.
+type DeviceIdentity struct {
+	// Serial is the SCSI VPD page 80 serial number of a LUN, or of the paths to a multipath device
+	Serial string
+	// WWID is the world-wide identifier of the device as the kernel reports it
+	WWID string
+	// NamespaceUUID is the UUID of an NVMe namespace
+	NamespaceUUID string
+}
+
+// matches returns whether a device with this identity may be the one sought, which it is unless a field known
+// for both differs.
+func (i DeviceIdentity) matches(sought DeviceIdentity) bool {
+	differs := func(a, b string) bool {
+		return a != "" && b != "" && !strings.EqualFold(a, b)
+	}
+	return !differs(i.Serial, sought.Serial) && !differs(i.WWID, sought.WWID) &&
+		!differs(i.NamespaceUUID, sought.NamespaceUUID)
+}
+
+// DeviceEvent reports a block device appearing, changing or disappearing.
+type DeviceEvent struct {
+	Action DeviceEventAction
+	// Device is the kernel name of the device, such as sdb, dm-0 or nvme0n1
+	Device   string
+	Identity DeviceIdentity
+}
+
+// ueventSource delivers kernel uevent messages.  Receive returns no message, rather than blocking indefinitely,
+// if none arrives for a while.
+type ueventSource interface {
+	receive() ([]byte, error)
+	close() error
+}
+
+// DeviceWatcher reports block devices appearing, changing and disappearing on the host, as the kernel announces
+// them through uevents, or failing that, as seen by listing them periodically.
+type DeviceWatcher struct {
+	// root is the host's root path, set when the watcher starts if empty
+	root         string
+	pollInterval time.Duration
+	openUevents  func() (ueventSource, error)
+
+	mutex         sync.Mutex
+	running       bool
+	stop          chan struct{}
+	stopped       chan struct{}
+	subscriptions map[chan DeviceEvent]DeviceIdentity
+	devices       map[string]DeviceIdentity
+}
+
+// NewDeviceWatcher returns a watcher of the host's block devices, which starts with its first subscription.
+func NewDeviceWatcher() *DeviceWatcher {
+	return &DeviceWatcher{
+		pollInterval:  devicePollInterval,
+		openUevents:   openUevents,
+		subscriptions: make(map[chan DeviceEvent]DeviceIdentity),
+	}
+}
+
+// Subscribe returns a channel delivering events for the block devices that may match an identity, and a
+// function that ends the subscription.  An empty identity matches every device.  Events are dropped rather
+// than delivered late if the subscriber falls behind.
+func (w *DeviceWatcher) Subscribe(ctx context.Context, identity DeviceIdentity) (<-chan DeviceEvent, func()) {
+	events := make(chan DeviceEvent, deviceEventBuffer)
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if !w.running {
+		w.start(ctx)
+	}
+	w.subscriptions[events] = identity
+
+	return events, func() {
+		w.mutex.Lock()
+		defer w.mutex.Unlock()
+		delete(w.subscriptions, events)
+	}
+}
+
+// start lists the block devices already present and begins watching for changes.  The watcher must be locked.
+func (w *DeviceWatcher) start(ctx context.Context) {
+	if w.root == "" {
+		w.root = chrootPathPrefix
+	}
+	w.devices = make(map[string]DeviceIdentity)
+	for _, device := range w.listDevices() {
+		w.devices[device] = readDeviceIdentity(ctx, w.root, device)
+	}
+
+	w.running = true
+	w.stop = make(chan struct{})
+	w.stopped = make(chan struct{})
+	stop, stopped := w.stop, w.stopped
+
+	// The watcher outlives the request that starts it
+	watchCtx := GenerateRequestContext(nil, "", ContextSourceInternal, WorkflowNone, LogLayerUtils)
+
+	uevents, err := w.openUevents()
+	if err != nil {
+		Logc(ctx).WithError(err).Warning("Could not receive uevents; polling for block devices instead.")
+		go w.poll(watchCtx, stop, stopped)
+		return
+	}
+	Logc(ctx).Debug("Watching uevents for block devices.")
+	go w.receiveUevents(watchCtx, uevents, stop, stopped)
+}
+
+// Stop stops watching for block devices, until the next subscription.
+func (w *DeviceWatcher) Stop() {
+	w.mutex.Lock()
+	if !w.running {
+		w.mutex.Unlock()
+		return
+	}
+	w.running = false
+	close(w.stop)
+	stopped := w.stopped
+	w.mutex.Unlock()
+
+	<-stopped
+}
+
+// receiveUevents publishes the block device uevents the kernel announces until the watcher stops.
+func (w *DeviceWatcher) receiveUevents(
+	ctx context.Context, uevents ueventSource, stop <-chan struct{}, stopped chan<- struct{},
+) {
+	defer close(stopped)
+	defer uevents.close()
+
+	for {
+		select {
+		case <-stop:
+			return
+		default:
+		}
+
+		message, err := uevents.receive()
+		if err != nil {
+			Logc(ctx).WithError(err).Warning("Could not receive uevent; polling for block devices instead.")
+			w.pollUntilStopped(ctx, stop)
+			return
+		}
+		if action, device, ok := parseBlockDeviceUevent(message); ok {
+			w.update(ctx, action, device)
+		}
+	}
+}
+
+// poll publishes the block devices that appear and disappear between listings until the watcher stops.
+func (w *DeviceWatcher) poll(ctx context.Context, stop <-chan struct{}, stopped chan<- struct{}) {
+	defer close(stopped)
+	w.pollUntilStopped(ctx, stop)
+}
+
+func (w *DeviceWatcher) pollUntilStopped(ctx context.Context, stop <-chan struct{}) {
+	ticker := time.NewTicker(w.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+
+		present := make(map[string]struct{})
+		for _, device := range w.listDevices() {
+			present[device] = struct{}{}
+			w.mutex.Lock()
+			_, known := w.devices[device]
+			w.mutex.Unlock()
+			if !known {
+				w.update(ctx, DeviceAdded, device)
+			}
+		}
+
+		w.mutex.Lock()
+		removed := make([]string, 0)
+		for device := range w.devices {
+			if _, ok := present[device]; !ok {
+				removed = append(removed, device)
+			}
+		}
+		w.mutex.Unlock()
+		for _, device := range removed {
+			w.update(ctx, DeviceRemoved, device)
+		}
+	}
+}
+
+// update records what happened to a block device, and publishes it to the subscriptions it may match.  The
+// identity of a device is read as it appears or changes, and remembered so that it may be matched once the
+// device is gone.
+func (w *DeviceWatcher) update(ctx context.Context, action DeviceEventAction, device string) {
+	event := DeviceEvent{Action: action, Device: device}
+	if action != DeviceRemoved {
+		event.Identity = readDeviceIdentity(ctx, w.root, device)
+	}
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if action == DeviceRemoved {
+		event.Identity = w.devices[device]
+		delete(w.devices, device)
+	} else {
+		w.devices[device] = event.Identity
+	}
+
+	Logc(ctx).WithFields(LogFields{
+		"action":   event.Action,
+		"device":   event.Device,
+		"identity": event.Identity,
+	}).Trace("Block device event.")
+
+	for events, identity := range w.subscriptions {
+		if !event.Identity.matches(identity) {
+			continue
+		}
+		select {
+		case events <- event:
+		default:
+		}
+	}
+}
+
+// listDevices returns the names of the block devices on the host.
+func (w *DeviceWatcher) listDevices() []string {
+	entries, err := os.ReadDir(w.root + "/sys/block")
+	if err != nil {
+		return nil
+	}
+	devices := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		devices = append(devices, entry.Name())
+	}
+	return devices
+}
+
+// parseBlockDeviceUevent returns the action and device name of a kernel uevent about a whole block device, which
+// arrives as a header naming the action and device path, then NUL-separated KEY=value pairs.
+func parseBlockDeviceUevent(message []byte) (DeviceEventAction, string, bool) {
+	fields := bytes.Split(message, []byte{0})
+	if len(fields) < 2 || !bytes.Contains(fields[0], []byte("@")) {
+		return "", "", false
+	}
+
+	env := make(map[string]string)
+	for _, field := range fields[1:] {
+		if key, value, ok := strings.Cut(string(field), "="); ok {
+			env[key] = value
+		}
+	}
+	if env["SUBSYSTEM"] != "block" || env["DEVTYPE"] != "disk" || env["DEVNAME"] == "" {
+		return "", "", false
+	}
+
+	switch action := DeviceEventAction(env["ACTION"]); action {
+	case DeviceAdded, DeviceRemoved, DeviceChanged:
+		return action, filepath.Base(env["DEVNAME"]), true
+	default:
+		return "", "", false
+	}
+}
+
+// readDeviceIdentity reads the identity of a block device from sysfs: the serial number and WWID of a SCSI disk,
+// the WWID of a multipath device along with the serial number of its paths, or the UUID of an NVMe namespace.
+func readDeviceIdentity(ctx context.Context, root, device string) DeviceIdentity {
+	deviceDir := root + "/sys/block/" + device
+	readAttribute := func(path string) string {
+		value, err := os.ReadFile(deviceDir + "/" + path)
+		if err != nil {
+			return ""
+		}
+		return strings.TrimSpace(string(value))
+	}
+
+	identity := DeviceIdentity{}
+
+	if uuid := readAttribute("dm/uuid"); uuid != "" {
+		identity.WWID = strings.TrimPrefix(uuid, "mpath-")
+		if slaves, err := os.ReadDir(deviceDir + "/slaves"); err == nil && len(slaves) > 0 {
+			identity.Serial = readDeviceIdentity(ctx, root, slaves[0].Name()).Serial
+		}
+		return identity
+	}
+
+	if strings.HasPrefix(device, "nvme") {
+		identity.NamespaceUUID = readAttribute("uuid")
+		identity.WWID = readAttribute("wwid")
+		return identity
+	}
+
+	if serial, err := getLunSerial(ctx, deviceDir+"/device"); err == nil {
+		identity.Serial = serial
+	}
+	identity.WWID = readAttribute("device/wwid")
+	return identity
+}
+
+// waitForDeviceEvents runs a check until it succeeds, running it again each time a block device that may match
+// the identity appears, changes or disappears, and at least every deviceRecheckInterval.  If the check has not
+// succeeded once the timeout passes, its last error is returned.
+func waitForDeviceEvents(
+	ctx context.Context, identity DeviceIdentity, timeout time.Duration, check func() error,
+) error {
+	// Subscribe before the first check, so that no device may appear unnoticed in between
+	events, unsubscribe := deviceWatcher.Subscribe(ctx, identity)
+	defer unsubscribe()
+
+	err := check()
+	if err == nil {
+		return nil
+	}
+
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	recheck := time.NewTicker(deviceRecheckInterval)
+	defer recheck.Stop()
+
+	for {
+		select {
+		case event := <-events:
+			Logc(ctx).WithFields(LogFields{
+				"action": event.Action,
+				"device": event.Device,
+			}).Debug("Block device event; checking for devices.")
+		case <-recheck.C:
+		case <-deadline.C:
+			return err
+		case <-ctx.Done():
+			return err
+		}
+
+		if err = check(); err == nil {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,238 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeUevents delivers the uevent messages sent to it.
+type fakeUevents struct {
+	messages chan []byte
+}
+
+func (f *fakeUevents) receive() ([]byte, error) {
+	select {
+	case message := <-f.messages:
+		return message, nil
+	case <-time.After(10 * time.Millisecond):
+		return nil, nil
+	}
+}
+
+func (f *fakeUevents) close() error {
+	return nil
+}
+
+// ueventMessage formats a kernel uevent about a device.
+func ueventMessage(action, devPath string, env ...string) []byte {
+	fields := append([]string{action + "@" + devPath, "ACTION=" + action, "DEVPATH=" + devPath}, env...)
+	return []byte(strings.Join(fields, "\x00") + "\x00")
+}
+
+// newTestDeviceWatcher returns a watcher of the block devices under a root, which receives uevents from a
+// source, or polls if there is none.
+func newTestDeviceWatcher(t *testing.T, root string, uevents ueventSource) *DeviceWatcher {
+	w := NewDeviceWatcher()
+	w.root = root
+	w.pollInterval = 10 * time.Millisecond
+	w.openUevents = func() (ueventSource, error) {
+		if uevents == nil {
+			return nil, fmt.Errorf("uevents not available")
+		}
+		return uevents, nil
+	}
+	t.Cleanup(w.Stop)
+	return w
+}
+
+// blockDevice adds a block device to a sysfs fixture: a SCSI disk with a serial number, a multipath device
+// over the named paths, or an NVMe namespace.
+func (f *sysfsFixture) blockDevice(name, identity string, slaves ...string) {
+	switch {
+	case strings.HasPrefix(name, "dm-"):
+		f.write("block/"+name+"/dm/uuid", "mpath-"+identity)
+		for _, slave := range slaves {
+			f.mkdir("block/" + name + "/slaves/" + slave)
+		}
+	case strings.HasPrefix(name, "nvme"):
+		f.write("block/"+name+"/uuid", identity)
+	default:
+		vpd := append([]byte{0, 0x80, 0, byte(len(identity))}, identity...)
+		f.mkdir("block/" + name + "/device")
+		require.NoError(f.t, os.WriteFile(filepath.Join(f.root, "sys/block", name, "device/vpd_pg80"), vpd, 0o644))
+		f.write("block/"+name+"/device/wwid", "naa.600a0980"+identity)
+	}
+}
+
+func receiveDeviceEvent(t *testing.T, events <-chan DeviceEvent) DeviceEvent {
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "timed out waiting for device event")
+		return DeviceEvent{}
+	}
+}
+
+func TestParseBlockDeviceUevent(t *testing.T) {
+	disk := []string{"SUBSYSTEM=block", "DEVTYPE=disk"}
+
+	action, device, ok := parseBlockDeviceUevent(ueventMessage("add",
+		"/devices/platform/host3/session1/target3:0:0/3:0:0:0/block/sdb", append(disk, "DEVNAME=sdb")...))
+	assert.True(t, ok)
+	assert.Equal(t, DeviceAdded, action)
+	assert.Equal(t, "sdb", device)
+
+	action, device, ok = parseBlockDeviceUevent(ueventMessage("remove", "/devices/virtual/block/dm-0",
+		append(disk, "DEVNAME=/dev/dm-0")...))
+	assert.True(t, ok)
+	assert.Equal(t, DeviceRemoved, action)
+	assert.Equal(t, "dm-0", device)
+
+	ignored := map[string][]byte{
+		"partition": ueventMessage("add", "/devices/virtual/block/sdb/sdb1",
+			"SUBSYSTEM=block", "DEVTYPE=partition", "DEVNAME=sdb1"),
+		"other subsystem": ueventMessage("add", "/devices/virtual/net/eth1", "SUBSYSTEM=net", "INTERFACE=eth1"),
+		"other action":    ueventMessage("move", "/devices/virtual/block/sdb", append(disk, "DEVNAME=sdb")...),
+		"no device name":  ueventMessage("add", "/devices/virtual/block/sdb", disk...),
+		"no header":       []byte("ACTION=add\x00SUBSYSTEM=block\x00DEVTYPE=disk\x00DEVNAME=sdb\x00"),
+		"empty":           nil,
+	}
+	for name, message := range ignored {
+		t.Run(name, func(t *testing.T) {
+			_, _, ok := parseBlockDeviceUevent(message)
+			assert.False(t, ok)
+		})
+	}
+}
+
+func TestReadDeviceIdentity(t *testing.T) {
+	ctx := context.Background()
+	f := newSysfsFixture(t)
+	f.blockDevice("sdb", "80BLf$Rwc3Zt")
+	f.blockDevice("sdc", "80BLf$Rwc3Zt")
+	f.blockDevice("dm-0", "3600a098038303842462452772f4a4c6d", "sdb", "sdc")
+	f.blockDevice("nvme0n1", "a3c0e8f4-2d2f-4b6e-9d3c-6c1f3e0a8b21")
+	f.mkdir("block/loop0")
+
+	assert.Equal(t, DeviceIdentity{Serial: "80BLf$Rwc3Zt", WWID: "naa.600a098080BLf$Rwc3Zt"},
+		readDeviceIdentity(ctx, f.root, "sdb"))
+	assert.Equal(t, DeviceIdentity{Serial: "80BLf$Rwc3Zt", WWID: "3600a098038303842462452772f4a4c6d"},
+		readDeviceIdentity(ctx, f.root, "dm-0"))
+	assert.Equal(t, DeviceIdentity{NamespaceUUID: "a3c0e8f4-2d2f-4b6e-9d3c-6c1f3e0a8b21"},
+		readDeviceIdentity(ctx, f.root, "nvme0n1"))
+	assert.Equal(t, DeviceIdentity{}, readDeviceIdentity(ctx, f.root, "loop0"))
+	assert.Equal(t, DeviceIdentity{}, readDeviceIdentity(ctx, f.root, "sdz"))
+}
+
+func TestDeviceIdentityMatches(t *testing.T) {
+	lun := DeviceIdentity{Serial: "80BLf$Rwc3Zt", WWID: "naa.600a098080BLf$Rwc3Zt"}
+
+	assert.True(t, lun.matches(DeviceIdentity{}))
+	assert.True(t, lun.matches(DeviceIdentity{Serial: "80BLf$Rwc3Zt"}))
+	assert.True(t, lun.matches(DeviceIdentity{NamespaceUUID: "a3c0e8f4-2d2f-4b6e-9d3c-6c1f3e0a8b21"}))
+	assert.False(t, lun.matches(DeviceIdentity{Serial: "80BLf$Rwc3Zu"}))
+
+	// Devices whose identity could not be read may be the one sought
+	assert.True(t, DeviceIdentity{}.matches(DeviceIdentity{Serial: "80BLf$Rwc3Zt"}))
+
+	namespace := DeviceIdentity{NamespaceUUID: "A3C0E8F4-2D2F-4B6E-9D3C-6C1F3E0A8B21"}
+	assert.True(t, namespace.matches(DeviceIdentity{NamespaceUUID: "a3c0e8f4-2d2f-4b6e-9d3c-6c1f3e0a8b21"}))
+}
+
+func TestDeviceWatcher_Uevents(t *testing.T) {
+	ctx := context.Background()
+	f := newSysfsFixture(t)
+	f.blockDevice("sdb", "80BLf$Rwc3Zt")
+	uevents := &fakeUevents{messages: make(chan []byte)}
+	w := newTestDeviceWatcher(t, f.root, uevents)
+
+	lunEvents, unsubscribe := w.Subscribe(ctx, DeviceIdentity{Serial: "80BLf$Rwc3Zt"})
+	defer unsubscribe()
+	allEvents, unsubscribeAll := w.Subscribe(ctx, DeviceIdentity{})
+	defer unsubscribeAll()
+
+	// Another LUN appears
+	f.blockDevice("sdc", "80BLf$Rwc3Zu")
+	uevents.messages <- ueventMessage("add", "/devices/virtual/block/sdc", "SUBSYSTEM=block", "DEVTYPE=disk",
+		"DEVNAME=sdc")
+	assert.Equal(t, DeviceEvent{
+		Action: DeviceAdded, Device: "sdc",
+		Identity: DeviceIdentity{Serial: "80BLf$Rwc3Zu", WWID: "naa.600a098080BLf$Rwc3Zu"},
+	}, receiveDeviceEvent(t, allEvents))
+
+	// The sought LUN, already present, disappears; its identity is remembered from when the watcher started
+	require.NoError(t, os.RemoveAll(filepath.Join(f.root, "sys/block/sdb")))
+	uevents.messages <- ueventMessage("remove", "/devices/virtual/block/sdb", "SUBSYSTEM=block", "DEVTYPE=disk",
+		"DEVNAME=sdb")
+	expected := DeviceEvent{
+		Action: DeviceRemoved, Device: "sdb",
+		Identity: DeviceIdentity{Serial: "80BLf$Rwc3Zt", WWID: "naa.600a098080BLf$Rwc3Zt"},
+	}
+	assert.Equal(t, expected, receiveDeviceEvent(t, lunEvents))
+	assert.Equal(t, expected, receiveDeviceEvent(t, allEvents))
+	assert.Empty(t, lunEvents, "event for another LUN was delivered")
+}
+
+func TestDeviceWatcher_Poll(t *testing.T) {
+	ctx := context.Background()
+	f := newSysfsFixture(t)
+	f.mkdir("block")
+	w := newTestDeviceWatcher(t, f.root, nil)
+
+	events, unsubscribe := w.Subscribe(ctx, DeviceIdentity{NamespaceUUID: "a3c0e8f4-2d2f-4b6e-9d3c-6c1f3e0a8b21"})
+	defer unsubscribe()
+
+	f.blockDevice("nvme0n1", "a3c0e8f4-2d2f-4b6e-9d3c-6c1f3e0a8b21")
+	event := receiveDeviceEvent(t, events)
+	assert.Equal(t, DeviceAdded, event.Action)
+	assert.Equal(t, "nvme0n1", event.Device)
+
+	require.NoError(t, os.RemoveAll(filepath.Join(f.root, "sys/block/nvme0n1")))
+	event = receiveDeviceEvent(t, events)
+	assert.Equal(t, DeviceRemoved, event.Action)
+	assert.Equal(t, "nvme0n1", event.Device)
+
+	// Once stopped, the watcher restarts with the next subscription
+	w.Stop()
+	_, unsubscribe = w.Subscribe(ctx, DeviceIdentity{})
+	unsubscribe()
+}
+
+func TestWaitForDeviceEvents(t *testing.T) {
+	ctx := context.Background()
+	f := newSysfsFixture(t)
+	f.mkdir("block")
+
+	previous := deviceWatcher
+	deviceWatcher = newTestDeviceWatcher(t, f.root, nil)
+	defer func() { deviceWatcher = previous }()
+
+	devicePath := filepath.Join(f.root, "sys/block/sdb")
+	check := func() error {
+		if exists, _ := PathExists(devicePath); !exists {
+			return fmt.Errorf("device not yet present")
+		}
+		return nil
+	}
+
+	// The device never appears
+	err := waitForDeviceEvents(ctx, DeviceIdentity{}, 50*time.Millisecond, check)
+	assert.EqualError(t, err, "device not yet present")
+
+	// The wait ends as soon as the device appears, well before it would have checked again anyway
+	time.AfterFunc(50*time.Millisecond, func() { _ = os.MkdirAll(devicePath, 0o755) })
+	start := time.Now()
+	assert.NoError(t, waitForDeviceEvents(ctx, DeviceIdentity{Serial: "80BLf$Rwc3Zt"}, 5*time.Second, check))
+	assert.Less(t, time.Since(start), deviceRecheckInterval)
+}
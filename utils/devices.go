@@ -24,18 +24,23 @@ const (
 	devPrefix        = "/dev/"
 )
 
-// waitForDevice accepts a device name and checks if it is present
+// waitForDevice accepts a device name and waits for it to be present
 func waitForDevice(ctx context.Context, device string) error {
 	fields := LogFields{"device": device}
 	Logc(ctx).WithFields(fields).Debug(">>>> devices.waitForDevice")
 	defer Logc(ctx).WithFields(fields).Debug("<<<< devices.waitForDevice")
 
-	exists, err := PathExists(device)
-	if !exists || err != nil {
-		return errors.New("device not yet present")
-	} else {
-		Logc(ctx).WithField("device", device).Debug("Device found.")
+	err := waitForDeviceEvents(ctx, DeviceIdentity{}, deviceArrivalTimeout, func() error {
+		if exists, err := PathExists(device); !exists || err != nil {
+			return errors.New("device not yet present")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
+
+	Logc(ctx).WithField("device", device).Debug("Device found.")
 	return nil
 }
 
@@ -581,203 +586,73 @@ func GetISCSIDevices(ctx context.Context, getCredentials bool) ([]*ScsiDeviceInf
 	defer Logc(ctx).Debug("<<<< devices.GetISCSIDevices")
 
 	devices := make([]*ScsiDeviceInfo, 0)
-	hostSessionMapCache := make(map[string]map[int]int)
 
 	// Start by reading the sessions from /sys/class/iscsi_session
-	sysPath := chrootPathPrefix + "/sys/class/iscsi_session/"
-	sessionDirs, err := os.ReadDir(sysPath)
+	sysfs := newISCSISysfs()
+	sessions, err := sysfs.sessions(ctx)
 	if err != nil {
-		Logc(ctx).WithField("error", err).Errorf("Could not read %s", sysPath)
+		Logc(ctx).WithError(err).Error("Could not read iSCSI sessions.")
 		return nil, err
 	}
 
 	// Loop through each of the iSCSI sessions
-	for _, sessionDir := range sessionDirs {
+	for _, session := range sessions {
 
-		var sessionNumber int
 		var iscsiChapInfo IscsiChapInfo
-		sessionName := sessionDir.Name()
-
-		if !strings.HasPrefix(sessionName, "session") {
-			continue
-		} else if sessionNumber, err = strconv.Atoi(strings.TrimPrefix(sessionName, "session")); err != nil {
-			Logc(ctx).WithField("session", sessionName).Error("Could not parse session number")
-			return nil, err
-		}
-
-		// Find the target IQN and Credentials from the session at /sys/class/iscsi_session/sessionXXX/targetname
-		sessionPath := sysPath + sessionName
-		sessionFiles := map[string]string{"targetname": "targetIQN"}
 		if getCredentials {
-			sessionFiles["username"] = "IscsiUsername"
-			sessionFiles["username_in"] = "IscsiTargetUsername"
-			sessionFiles["password"] = "IscsiInitiatorSecret"
-			sessionFiles["password_in"] = "IscsiTargetSecret"
-		}
-
-		sessionValues := make(map[string]string, len(sessionFiles))
-		for file, value := range sessionFiles {
-			path := sessionPath + "/" + file
-			fileBytes, err := os.ReadFile(path)
-			if err != nil {
-				Logc(ctx).WithFields(LogFields{
-					"path":  path,
-					"error": err,
-				}).Errorf("Could not read %v file", file)
+			if iscsiChapInfo, err = sysfs.chapInfo(session); err != nil {
+				Logc(ctx).WithError(err).Error("Could not read iSCSI session credentials.")
 				return nil, err
 			}
-
-			// When CHAP not in use instead of empty
-			// credentials they are "(null)" in sysfs
-			fileContent := strings.TrimSpace(string(fileBytes))
-			if fileContent == "(null)" {
-				fileContent = ""
-			}
-
-			sessionValues[value] = fileContent
-		}
-
-		targetIQN := sessionValues["targetIQN"]
-
-		if getCredentials {
-			iscsiChapInfo = IscsiChapInfo{
-				IscsiUsername:        sessionValues["IscsiUsername"],
-				IscsiInitiatorSecret: sessionValues["IscsiInitiatorSecret"],
-				IscsiTargetUsername:  sessionValues["IscsiTargetUsername"],
-				IscsiTargetSecret:    sessionValues["IscsiTargetSecret"],
-			}
-
-			if iscsiChapInfo != (IscsiChapInfo{}) {
-				iscsiChapInfo.UseCHAP = true
-			}
 		}
 
 		Logc(ctx).WithFields(LogFields{
-			"targetIQN":   targetIQN,
-			"sessionName": sessionName,
+			"targetIQN":     session.TargetIQN,
+			"sessionNumber": session.Number,
 		}).Debug("Found iSCSI session / target IQN.")
 
-		// Find the one target at /sys/class/iscsi_session/sessionXXX/device/targetHH:BB:DD (host:bus:device)
-		sessionDevicePath := sessionPath + "/device/"
-		targetDirs, err := os.ReadDir(sessionDevicePath)
-		if err != nil {
-			Logc(ctx).WithField("error", err).Errorf("Could not read %s", sessionDevicePath)
-			return nil, err
-		}
-
-		// Get the one target directory
-		hostBusDeviceName := ""
-		targetDirName := ""
-		for _, targetDir := range targetDirs {
-
-			targetDirName = targetDir.Name()
-
-			if strings.HasPrefix(targetDirName, "target") {
-				hostBusDeviceName = strings.TrimPrefix(targetDirName, "target")
-				break
-			}
-		}
-
-		if hostBusDeviceName == "" {
-			Logc(ctx).Warningf("Could not find a host:bus:device directory at %s", sessionDevicePath)
-			continue
-		}
-
-		sessionDeviceHBDPath := sessionDevicePath + targetDirName + "/"
-
-		Logc(ctx).WithFields(LogFields{
-			"hbdPath": sessionDeviceHBDPath,
-			"hbdName": hostBusDeviceName,
-		}).Debug("Found host/bus/device path.")
-
-		// Find the devices at /sys/class/iscsi_session/sessionXXX/device/targetHH:BB:DD/HH:BB:DD:LL (host:bus:device:lun)
-		hostBusDeviceLunDirs, err := os.ReadDir(sessionDeviceHBDPath)
+		luns, err := sysfs.luns(ctx, session)
 		if err != nil {
-			Logc(ctx).WithField("error", err).Errorf("Could not read %s", sessionDeviceHBDPath)
+			Logc(ctx).WithError(err).Error("Could not read iSCSI session LUNs.")
 			return nil, err
 		}
+		hostSessionMap := hostSessionMapForTarget(sessions, session.TargetIQN)
 
-		for _, hostBusDeviceLunDir := range hostBusDeviceLunDirs {
-
-			hostBusDeviceLunDirName := hostBusDeviceLunDir.Name()
-			if !strings.HasPrefix(hostBusDeviceLunDirName, hostBusDeviceName) {
-				continue
-			}
-
-			sessionDeviceHBDLPath := sessionDeviceHBDPath + hostBusDeviceLunDirName + "/"
-
-			Logc(ctx).WithFields(LogFields{
-				"hbdlPath": sessionDeviceHBDLPath,
-				"hbdlName": hostBusDeviceLunDirName,
-			}).Debug("Found host/bus/device/LUN path.")
-
-			hbdlValues := strings.Split(hostBusDeviceLunDirName, ":")
-			if len(hbdlValues) != 4 {
-				Logc(ctx).Errorf("Could not parse values from %s", hostBusDeviceLunDirName)
-				return nil, err
-			}
-
-			hostNum := hbdlValues[0]
-			busNum := hbdlValues[1]
-			deviceNum := hbdlValues[2]
-			lunNum := hbdlValues[3]
-
-			blockPath := sessionDeviceHBDLPath + "/block/"
-
-			// Find the block device at /sys/class/iscsi_session/sessionXXX/device/targetHH:BB:DD/HH:BB:DD:LL/block
-			blockDeviceDirs, err := os.ReadDir(blockPath)
-			if err != nil {
-				Logc(ctx).WithField("error", err).Errorf("Could not read %s", blockPath)
-				return nil, err
-			}
-
-			for _, blockDeviceDir := range blockDeviceDirs {
-
-				blockDeviceName := blockDeviceDir.Name()
-
-				Logc(ctx).WithField("blockDeviceName", blockDeviceName).Debug("Found block device.")
+		for _, lun := range luns {
+			for _, blockDeviceName := range lun.BlockDevices {
 
 				// Find multipath device, if any
 				var slaveDevices []string
-				multipathDevice := findMultipathDeviceForDevice(ctx, blockDeviceName)
+				multipathDevice := sysfs.multipathDevice(blockDeviceName)
 				if multipathDevice != "" {
-					slaveDevices = findDevicesForMultipathDevice(ctx, multipathDevice)
+					slaveDevices = sysfs.multipathPaths(multipathDevice)
 				} else {
 					slaveDevices = []string{blockDeviceName}
 				}
 
-				// Get the host/session map, using a cached value if available
-				hostSessionMap, ok := hostSessionMapCache[targetIQN]
-				if !ok {
-					hostSessionMap = IscsiUtils.GetISCSIHostSessionMapForTarget(ctx, targetIQN)
-					hostSessionMapCache[targetIQN] = hostSessionMap
-				}
-
 				Logc(ctx).WithFields(LogFields{
-					"host":            hostNum,
-					"lun":             lunNum,
+					"host":            lun.Host,
+					"lun":             lun.LUN,
 					"devices":         slaveDevices,
 					"multipathDevice": multipathDevice,
-					"iqn":             targetIQN,
-					"sessionNumber":   sessionNumber,
+					"iqn":             session.TargetIQN,
+					"sessionNumber":   session.Number,
 					"CHAPInUse":       iscsiChapInfo.UseCHAP,
 					"hostSessionMap":  hostSessionMap,
 				}).Debug("Found iSCSI device.")
 
-				device := &ScsiDeviceInfo{
-					Host:            hostNum,
-					Channel:         busNum,
-					Target:          deviceNum,
-					LUN:             lunNum,
+				devices = append(devices, &ScsiDeviceInfo{
+					Host:            lun.Host,
+					Channel:         lun.Channel,
+					Target:          lun.Target,
+					LUN:             lun.LUN,
 					Devices:         slaveDevices,
 					MultipathDevice: multipathDevice,
-					IQN:             targetIQN,
-					SessionNumber:   sessionNumber,
+					IQN:             session.TargetIQN,
+					SessionNumber:   session.Number,
 					CHAPInfo:        iscsiChapInfo,
 					HostSessionMap:  hostSessionMap,
-				}
-
-				devices = append(devices, device)
+				})
 			}
 		}
 	}
@@ -1204,7 +1079,7 @@ func removeSCSIDevice(ctx context.Context, deviceInfo *ScsiDeviceInfo, ignoreErr
 	}
 
 	// Give the host a chance to fully process the removal
-	time.Sleep(time.Second)
+	waitForDevicesRemoval(ctx, deviceInfo.Devices)
 	listAllISCSIDevices(ctx)
 
 	// If ignoreErrors was set to true while entering into this function and
@@ -1218,6 +1093,21 @@ func removeSCSIDevice(ctx context.Context, deviceInfo *ScsiDeviceInfo, ignoreErr
 	return ignoreErrors || skipFlush, nil
 }
 
+// waitForDevicesRemoval waits briefly for deleted devices to disappear from the host.
+func waitForDevicesRemoval(ctx context.Context, devices []string) {
+	err := waitForDeviceEvents(ctx, DeviceIdentity{}, deviceRemovalTimeout, func() error {
+		for _, device := range devices {
+			if exists, _ := PathExists(chrootPathPrefix + "/sys/block/" + device); exists {
+				return fmt.Errorf("device %s is still present", device)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		Logc(ctx).WithError(err).Debug("Deleted devices have not yet disappeared.")
+	}
+}
+
 // ScsiDeviceInfo contains information about SCSI devices
 type ScsiDeviceInfo struct {
 	Host            string
@@ -1362,7 +1252,7 @@ func getDeviceInfoForMountPath(ctx context.Context, mountpath string) (*ScsiDevi
 // for the given LUN, this function waits for the associated multipath device to be present
 // first find the /dev/sd* devices assocaited with the LUN
 // Wait for the maultipath device dm-* for the /dev/sd* devices.
-func waitForMultipathDeviceForLUN(ctx context.Context, lunID int, iSCSINodeName string) error {
+func waitForMultipathDeviceForLUN(ctx context.Context, lunID int, iSCSINodeName, lunSerial string) error {
 	fields := LogFields{
 		"lunID":         lunID,
 		"iSCSINodeName": iSCSINodeName,
@@ -1377,14 +1267,15 @@ func waitForMultipathDeviceForLUN(ctx context.Context, lunID int, iSCSINodeName
 
 	paths := IscsiUtils.GetSysfsBlockDirsForLUN(lunID, hostSessionMap)
 
-	devices, err := IscsiUtils.GetDevicesForLUN(paths)
-	if err != nil {
+	// The multipath device appears after its paths, once multipathd has seen them
+	return waitForDeviceEvents(ctx, DeviceIdentity{Serial: lunSerial}, deviceArrivalTimeout, func() error {
+		devices, err := IscsiUtils.GetDevicesForLUN(paths)
+		if err != nil {
+			return err
+		}
+		_, err = waitForMultipathDeviceForDevices(ctx, devices)
 		return err
-	}
-
-	_, err = waitForMultipathDeviceForDevices(ctx, devices)
-
-	return err
+	})
 }
 
 func NewLUKSDevice(rawDevicePath, volumeId string) (*LUKSDevice, error) {
@@ -113,3 +113,8 @@ func (d *LUKSDevice) Resize(ctx context.Context, luksPassphrase string) error {
 	defer Logc(ctx).Debug("<<<< devices_darwin.Resize")
 	return errors.UnsupportedError("Resize is not supported for darwin")
 }
+
+// openUevents unused stub function
+func openUevents() (ueventSource, error) {
+	return nil, errors.UnsupportedError("uevents are not supported for darwin")
+}
@@ -547,3 +547,52 @@ func (d *LUKSDevice) CheckPassphrase(ctx context.Context, luksPassphrase string)
 	}
 	return true, nil
 }
+
+// ueventReceiveTimeout is the longest a receive from the uevent socket blocks, so that the watcher may stop
+const ueventReceiveTimeout = time.Second
+
+// netlinkUevents receives the uevents the kernel broadcasts on its netlink socket.
+type netlinkUevents struct {
+	fd     int
+	buffer []byte
+}
+
+// openUevents opens a netlink socket subscribed to the kernel's uevents.
+func openUevents() (ueventSource, error) {
+	fd, err := unix.Socket(unix.AF_NETLINK, unix.SOCK_RAW|unix.SOCK_CLOEXEC, unix.NETLINK_KOBJECT_UEVENT)
+	if err != nil {
+		return nil, fmt.Errorf("could not open uevent socket; %v", err)
+	}
+
+	// Group 1 carries the kernel's own uevents, rather than those udev rebroadcasts once it has handled them
+	if err = unix.Bind(fd, &unix.SockaddrNetlink{Family: unix.AF_NETLINK, Groups: 1}); err != nil {
+		_ = unix.Close(fd)
+		return nil, fmt.Errorf("could not bind uevent socket; %v", err)
+	}
+
+	timeout := unix.NsecToTimeval(ueventReceiveTimeout.Nanoseconds())
+	if err = unix.SetsockoptTimeval(fd, unix.SOL_SOCKET, unix.SO_RCVTIMEO, &timeout); err != nil {
+		_ = unix.Close(fd)
+		return nil, fmt.Errorf("could not set uevent socket timeout; %v", err)
+	}
+
+	return &netlinkUevents{fd: fd, buffer: make([]byte, os.Getpagesize()*2)}, nil
+}
+
+func (n *netlinkUevents) receive() ([]byte, error) {
+	size, _, err := unix.Recvfrom(n.fd, n.buffer, 0)
+	switch err {
+	case nil:
+		return n.buffer[:size], nil
+	case unix.EAGAIN, unix.EINTR, unix.ENOBUFS:
+		// Nothing arrived in time, or events were lost while the socket was full; those waiting on devices
+		// check for them periodically regardless
+		return nil, nil
+	default:
+		return nil, err
+	}
+}
+
+func (n *netlinkUevents) close() error {
+	return unix.Close(n.fd)
+}
@@ -113,3 +113,8 @@ func GetUnderlyingDevicePathForLUKSDevice(ctx context.Context, luksDevicePath st
 	defer Logc(ctx).Debug("<<<< devices_windows.GetUnderlyingDevicePathForLUKSDevice")
 	return "", errors.UnsupportedError("GetUnderlyingDevicePathForLUKSDevice is not supported for windows")
 }
+
+// openUevents unused stub function
+func openUevents() (ueventSource, error) {
+	return nil, errors.UnsupportedError("uevents are not supported for windows")
+}
@@ -40,6 +40,9 @@ const (
 	fsckSharedLibError          = 128
 )
 
+// fsfreezeTimeout is how long a filesystem may take to flush its writes and freeze
+const fsfreezeTimeout = 20 * time.Second
+
 var (
 	osFs             = afero.NewOsFs()
 	JsonReaderWriter = NewJSONReaderWriter()
@@ -342,3 +345,28 @@ func DeleteFile(ctx context.Context, filepath, fileDescription string) (string,
 
 	return filepath, nil
 }
+
+// FreezeFilesystem flushes the filesystem mounted at the specified path and blocks writes to it until it is
+// thawed.
+func FreezeFilesystem(ctx context.Context, mountpoint string) error {
+	Logc(ctx).WithField("mountpoint", mountpoint).Debug(">>>> filesystem.FreezeFilesystem")
+	defer Logc(ctx).WithField("mountpoint", mountpoint).Debug("<<<< filesystem.FreezeFilesystem")
+
+	if _, err := command.ExecuteWithTimeout(ctx, "fsfreeze", fsfreezeTimeout, true, "--freeze",
+		mountpoint); err != nil {
+		return fmt.Errorf("could not freeze filesystem at %s; %v", mountpoint, err)
+	}
+	return nil
+}
+
+// ThawFilesystem unblocks writes to the filesystem mounted at the specified path.
+func ThawFilesystem(ctx context.Context, mountpoint string) error {
+	Logc(ctx).WithField("mountpoint", mountpoint).Debug(">>>> filesystem.ThawFilesystem")
+	defer Logc(ctx).WithField("mountpoint", mountpoint).Debug("<<<< filesystem.ThawFilesystem")
+
+	if _, err := command.ExecuteWithTimeout(ctx, "fsfreeze", fsfreezeTimeout, true, "--unfreeze",
+		mountpoint); err != nil {
+		return fmt.Errorf("could not thaw filesystem at %s; %v", mountpoint, err)
+	}
+	return nil
+}
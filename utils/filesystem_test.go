@@ -3,12 +3,16 @@ package utils
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/spf13/afero"
 	"github.com/stretchr/testify/assert"
 
+	mockexec "github.com/netapp/trident/mocks/mock_utils/mock_exec"
 	"github.com/netapp/trident/utils/errors"
+	"github.com/netapp/trident/utils/exec"
 )
 
 func TestReadJSONFile_Succeeds(t *testing.T) {
@@ -179,3 +183,21 @@ func TestDeleteFile_FailsOnReadOnlyFilesystem(t *testing.T) {
 	_, err = DeleteFile(context.Background(), "foo.json", "")
 	assert.Error(t, err, "expected an error deleting a file on a read-only filesystem")
 }
+
+func TestFreezeAndThawFilesystem(t *testing.T) {
+	defer func(previousCommand exec.Command) {
+		command = previousCommand
+	}(command)
+
+	mockCtrl := gomock.NewController(t)
+	mockCommand := mockexec.NewMockCommand(mockCtrl)
+	command = mockCommand
+
+	mockCommand.EXPECT().ExecuteWithTimeout(gomock.Any(), "fsfreeze", fsfreezeTimeout, true, "--freeze",
+		"/pods/1/vol1").Return(nil, nil)
+	assert.NoError(t, FreezeFilesystem(context.Background(), "/pods/1/vol1"))
+
+	mockCommand.EXPECT().ExecuteWithTimeout(gomock.Any(), "fsfreeze", fsfreezeTimeout, true, "--unfreeze",
+		"/pods/1/vol1").Return(nil, fmt.Errorf("invalid argument"))
+	assert.ErrorContains(t, ThawFilesystem(context.Background(), "/pods/1/vol1"), "could not thaw filesystem")
+}
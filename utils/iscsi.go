@@ -178,7 +178,7 @@ func AttachISCSIVolume(
 	}
 
 	// Scan the target and wait for the device(s) to appear
-	err = waitForDeviceScan(ctx, lunID, publishInfo.IscsiTargetIQN)
+	err = waitForDeviceScan(ctx, lunID, publishInfo.IscsiTargetIQN, publishInfo.IscsiLunSerial)
 	if err != nil {
 		Logc(ctx).Errorf("Could not find iSCSI device: %+v", err)
 		return mpathSize, err
@@ -197,7 +197,7 @@ func AttachISCSIVolume(
 	}
 
 	// Wait for multipath device i.e. /dev/dm-* for the given LUN
-	err = waitForMultipathDeviceForLUN(ctx, lunID, publishInfo.IscsiTargetIQN)
+	err = waitForMultipathDeviceForLUN(ctx, lunID, publishInfo.IscsiTargetIQN, publishInfo.IscsiLunSerial)
 	if err != nil {
 		return mpathSize, err
 	}
@@ -533,9 +533,9 @@ func (h *IscsiReconcileHelper) GetMultipathDeviceBySerial(ctx context.Context, h
 	return "", errors.NotFoundError("no multipath device found")
 }
 
-// waitForDeviceScan scans all paths to a specific LUN and waits until all
+// waitForDeviceScan scans all paths to a specific LUN and waits until
 // SCSI disk-by-path devices for that LUN are present on the host.
-func waitForDeviceScan(ctx context.Context, lunID int, iSCSINodeName string) error {
+func waitForDeviceScan(ctx context.Context, lunID int, iSCSINodeName, lunSerial string) error {
 	fields := LogFields{
 		"lunID":         lunID,
 		"iSCSINodeName": iSCSINodeName,
@@ -560,24 +560,31 @@ func waitForDeviceScan(ctx context.Context, lunID int, iSCSINodeName string) err
 
 	paths := IscsiUtils.GetSysfsBlockDirsForLUN(lunID, hostSessionMap)
 	Logc(ctx).Debugf("Scanning paths: %v", paths)
-	found := make([]string, 0)
-
-	allDevicesExist := true
-
-	// Check if all paths present, and return nil (success) if so
-	for _, p := range paths {
-		dirname := p + "/block"
-		exists, err := PathExists(dirname)
-		if !exists || err != nil {
-			// Set flag to false as device is missing
-			allDevicesExist = false
-		} else {
-			found = append(found, dirname)
-			Logc(ctx).Debugf("Paths found: %v", dirname)
+	var found []string
+	var allDevicesExist bool
+
+	// Check which paths are present, waiting for the first to appear
+	findPaths := func() error {
+		found = make([]string, 0)
+		allDevicesExist = true
+		for _, p := range paths {
+			dirname := p + "/block"
+			exists, err := PathExists(dirname)
+			if !exists || err != nil {
+				// Set flag to false as device is missing
+				allDevicesExist = false
+			} else {
+				found = append(found, dirname)
+				Logc(ctx).Debugf("Paths found: %v", dirname)
+			}
+		}
+		if len(found) == 0 {
+			return errors.New("no devices present yet")
 		}
+		return nil
 	}
 
-	if len(found) == 0 {
+	if err := waitForDeviceEvents(ctx, DeviceIdentity{Serial: lunSerial}, deviceArrivalTimeout, findPaths); err != nil {
 
 		Logc(ctx).Warnf("Could not find any devices ")
 
@@ -601,8 +608,7 @@ func waitForDeviceScan(ctx context.Context, lunID int, iSCSINodeName string) err
 			Logc(ctx).Warnf("Could not run free: %v", err)
 		}
 
-		return errors.New("no devices present yet")
-
+		return err
 	}
 
 	if allDevicesExist {
@@ -698,67 +704,33 @@ type ISCSISessionInfo struct {
 	TargetName string
 }
 
-// getISCSISessionInfo parses output from 'iscsiadm -m session' and returns the parsed output.
+// getISCSISessionInfo returns the node's iSCSI sessions, as read from sysfs.
 func getISCSISessionInfo(ctx context.Context) ([]ISCSISessionInfo, error) {
 	Logc(ctx).Debug(">>>> iscsi.getISCSISessionInfo")
 	defer Logc(ctx).Debug("<<<< iscsi.getISCSISessionInfo")
 
-	out, err := execIscsiadmCommand(ctx, "-m", "session")
+	sessions, err := newISCSISysfs().sessions(ctx)
 	if err != nil {
-		exitErr, ok := err.(*exec.ExitError)
-		if ok && exitErr.ProcessState.Sys().(syscall.WaitStatus).ExitStatus() == iSCSIErrNoObjsFound {
-			Logc(ctx).Debug("No iSCSI session found.")
-			return []ISCSISessionInfo{}, nil
-		} else {
-			Logc(ctx).WithField("error", err).Error("Problem checking iSCSI sessions.")
-			return nil, err
-		}
+		Logc(ctx).WithError(err).Error("Problem checking iSCSI sessions.")
+		return nil, err
 	}
 
-	/*
-	   # iscsiadm -m session
-	   tcp: [3] 10.0.207.7:3260,1028 iqn.1992-08.com.netapp:sn.afbb1784f77411e582f8080027e22798:vs.3 (non-flash)
-	   tcp: [4] 10.0.207.9:3260,1029 iqn.1992-08.com.netapp:sn.afbb1784f77411e582f8080027e22798:vs.3 (non-flash)
-	   a[0]==tcp:
-	   a[1]==[4]
-	   a[2]==10.0.207.9:3260,1029
-	   a[3]==iqn.1992-08.com.netapp:sn.afbb1784f77411e582f8080027e22798:vs.3
-	   a[4]==(non-flash)
-	*/
-
-	var sessionInfo []ISCSISessionInfo
-
-	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
-	for _, l := range lines {
-
-		a := strings.Fields(l)
-		if len(a) > 3 {
-			sid := a[1]
-			sid = sid[1 : len(sid)-1]
-
-			portalIP := ""
-			if IPv6Check(a[2]) {
-				// This is an IPv6 address
-				portalIP = strings.Split(a[2], "]")[0]
-				portalIP += "]"
-			} else {
-				portalIP = strings.Split(a[2], ":")[0]
-			}
-
-			sessionInfo = append(sessionInfo, ISCSISessionInfo{
-				SID:        sid,
-				Portal:     a[2],
-				PortalIP:   portalIP,
-				TargetName: a[3],
-			})
-
-			Logc(ctx).WithFields(LogFields{
-				"SID":        sid,
-				"Portal":     a[2],
-				"PortalIP":   portalIP,
-				"TargetName": a[3],
-			}).Debug("Adding iSCSI session info.")
+	sessionInfo := make([]ISCSISessionInfo, 0, len(sessions))
+	for _, session := range sessions {
+		info := ISCSISessionInfo{
+			SID:        strconv.Itoa(session.Number),
+			Portal:     session.portal(),
+			PortalIP:   session.portalIP(),
+			TargetName: session.TargetIQN,
 		}
+		sessionInfo = append(sessionInfo, info)
+
+		Logc(ctx).WithFields(LogFields{
+			"SID":        info.SID,
+			"Portal":     info.Portal,
+			"PortalIP":   info.PortalIP,
+			"TargetName": info.TargetName,
+		}).Debug("Adding iSCSI session info.")
 	}
 
 	return sessionInfo, nil
@@ -1182,65 +1154,18 @@ func (h *IscsiReconcileHelper) GetISCSIHostSessionMapForTarget(ctx context.Conte
 	Logc(ctx).WithFields(fields).Debug(">>>> iscsi.GetISCSIHostSessionMapForTarget")
 	defer Logc(ctx).WithFields(fields).Debug("<<<< iscsi.GetISCSIHostSessionMapForTarget")
 
-	var (
-		hostNumber    int
-		sessionNumber int
-	)
-
-	hostSessionMap := make(map[int]int)
-
-	sysPath := chrootPathPrefix + "/sys/class/iscsi_host/"
-	if hostDirs, err := os.ReadDir(sysPath); err != nil {
-		Logc(ctx).WithField("error", err).Errorf("Could not read %s", sysPath)
-		return hostSessionMap
-	} else {
-		for _, hostDir := range hostDirs {
-
-			hostName := hostDir.Name()
-			if !strings.HasPrefix(hostName, "host") {
-				continue
-			} else if hostNumber, err = strconv.Atoi(strings.TrimPrefix(hostName, "host")); err != nil {
-				Logc(ctx).WithField("host", hostName).Error("Could not parse host number")
-				continue
-			}
+	sessions, err := newISCSISysfs().sessions(ctx)
+	if err != nil {
+		Logc(ctx).WithError(err).Error("Could not read iSCSI sessions.")
+		return make(map[int]int)
+	}
 
-			devicePath := sysPath + hostName + "/device/"
-			if deviceDirs, err := os.ReadDir(devicePath); err != nil {
-				Logc(ctx).WithFields(LogFields{
-					"error":         err,
-					"rawDevicePath": devicePath,
-				}).Error("Could not read device path.")
-				return hostSessionMap
-			} else {
-				for _, deviceDir := range deviceDirs {
-
-					sessionName := deviceDir.Name()
-					if !strings.HasPrefix(sessionName, "session") {
-						continue
-					} else if sessionNumber, err = strconv.Atoi(strings.TrimPrefix(sessionName,
-						"session")); err != nil {
-						Logc(ctx).WithField("session", sessionName).Error("Could not parse session number")
-						continue
-					}
-
-					targetNamePath := devicePath + sessionName + "/iscsi_session/" + sessionName + "/targetname"
-					if targetName, err := os.ReadFile(targetNamePath); err != nil {
-						Logc(ctx).WithFields(LogFields{
-							"path":  targetNamePath,
-							"error": err,
-						}).Error("Could not read targetname file")
-					} else if strings.TrimSpace(string(targetName)) == iSCSINodeName {
-
-						Logc(ctx).WithFields(LogFields{
-							"hostNumber":    hostNumber,
-							"sessionNumber": sessionNumber,
-						}).Debug("Found iSCSI host/session.")
-
-						hostSessionMap[hostNumber] = sessionNumber
-					}
-				}
-			}
-		}
+	hostSessionMap := hostSessionMapForTarget(sessions, iSCSINodeName)
+	for hostNumber, sessionNumber := range hostSessionMap {
+		Logc(ctx).WithFields(LogFields{
+			"hostNumber":    hostNumber,
+			"sessionNumber": sessionNumber,
+		}).Debug("Found iSCSI host/session.")
 	}
 
 	return hostSessionMap
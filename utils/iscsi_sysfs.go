@@ -0,0 +1,353 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	. "github.com/netapp/trident/logging"
+)
+
+// iSCSISysfsSession is an iSCSI session as described by sysfs.
+type iSCSISysfsSession struct {
+	Number    int
+	TargetIQN string
+	TPGT      string
+	Address   string
+	Port      string
+	State     string
+	// Host is the number of the SCSI host through which the session's LUNs are reached, or -1 if not known
+	Host int
+}
+
+// iSCSISysfsLUN is a LUN attached through an iSCSI session, and the block devices by which it is reached.
+type iSCSISysfsLUN struct {
+	Host         string
+	Channel      string
+	Target       string
+	LUN          string
+	BlockDevices []string
+}
+
+// iSCSISysfs reads the node's iSCSI sessions, the SCSI hosts through which they are reached, and the LUNs
+// attached through them from sysfs, rather than by parsing the output of iscsiadm, which is slow on nodes with
+// many LUNs.  iscsiadm is still used to log in, log out and otherwise change the sessions.  The root is the
+// path at which the host's filesystem is found.
+type iSCSISysfs struct {
+	root string
+}
+
+func newISCSISysfs() *iSCSISysfs {
+	return &iSCSISysfs{root: chrootPathPrefix}
+}
+
+func (s *iSCSISysfs) path(elements ...string) string {
+	return filepath.Join(append([]string{s.root, "/sys"}, elements...)...)
+}
+
+// readAttribute returns the trimmed contents of a sysfs attribute, with the "(null)" of unset attributes read
+// as empty.
+func (s *iSCSISysfs) readAttribute(path string) (string, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	value := strings.TrimSpace(string(contents))
+	if value == "(null)" {
+		value = ""
+	}
+	return value, nil
+}
+
+// sessions returns the node's iSCSI sessions, sorted by number.  There are none if the iSCSI transport is not
+// loaded.
+func (s *iSCSISysfs) sessions(ctx context.Context) ([]*iSCSISysfsSession, error) {
+	sessionsPath := s.path("class", "iscsi_session")
+	sessionDirs, err := os.ReadDir(sessionsPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			Logc(ctx).Debug("No iSCSI session found.")
+			return []*iSCSISysfsSession{}, nil
+		}
+		return nil, fmt.Errorf("could not read %s; %v", sessionsPath, err)
+	}
+
+	hosts, err := s.sessionHosts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	connections, err := s.sessionConnections(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]*iSCSISysfsSession, 0, len(sessionDirs))
+	for _, sessionDir := range sessionDirs {
+		number, ok := parseSysfsNumber(sessionDir.Name(), "session")
+		if !ok {
+			continue
+		}
+		sessionPath := filepath.Join(sessionsPath, sessionDir.Name())
+
+		targetIQN, err := s.readAttribute(filepath.Join(sessionPath, "targetname"))
+		if err != nil {
+			// The session may have ended while it was being read
+			Logc(ctx).WithField("session", sessionDir.Name()).WithError(err).Debug("Could not read session target.")
+			continue
+		}
+		tpgt, _ := s.readAttribute(filepath.Join(sessionPath, "tpgt"))
+		state, _ := s.readAttribute(filepath.Join(sessionPath, "state"))
+
+		session := &iSCSISysfsSession{
+			Number:    number,
+			TargetIQN: targetIQN,
+			TPGT:      tpgt,
+			State:     state,
+			Host:      -1,
+		}
+		if host, ok := hosts[number]; ok {
+			session.Host = host
+		}
+		if connection, ok := connections[number]; ok {
+			session.Address, session.Port = connection[0], connection[1]
+		}
+		sessions = append(sessions, session)
+	}
+
+	sort.Slice(sessions, func(i, j int) bool { return sessions[i].Number < sessions[j].Number })
+	return sessions, nil
+}
+
+// sessionHosts maps iSCSI session numbers to the numbers of the SCSI hosts through which they are reached, as
+// found at /sys/class/scsi_host/hostH/device/sessionN.
+func (s *iSCSISysfs) sessionHosts(ctx context.Context) (map[int]int, error) {
+	hostsPath := s.path("class", "scsi_host")
+	hostDirs, err := os.ReadDir(hostsPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return map[int]int{}, nil
+		}
+		return nil, fmt.Errorf("could not read %s; %v", hostsPath, err)
+	}
+
+	hosts := make(map[int]int)
+	for _, hostDir := range hostDirs {
+		host, ok := parseSysfsNumber(hostDir.Name(), "host")
+		if !ok {
+			continue
+		}
+		deviceDirs, err := os.ReadDir(filepath.Join(hostsPath, hostDir.Name(), "device"))
+		if err != nil {
+			// Hosts of other transports may have no device directory
+			continue
+		}
+		for _, deviceDir := range deviceDirs {
+			if session, ok := parseSysfsNumber(deviceDir.Name(), "session"); ok {
+				hosts[session] = host
+			}
+		}
+	}
+
+	Logc(ctx).WithField("sessionHosts", hosts).Trace("Read iSCSI session hosts.")
+	return hosts, nil
+}
+
+// sessionConnections maps iSCSI session numbers to the address and port of the portal through which each
+// session's first connection was established, as found at /sys/class/iscsi_connection/connectionN:C.
+func (s *iSCSISysfs) sessionConnections(ctx context.Context) (map[int][2]string, error) {
+	connectionsPath := s.path("class", "iscsi_connection")
+	connectionDirs, err := os.ReadDir(connectionsPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return map[int][2]string{}, nil
+		}
+		return nil, fmt.Errorf("could not read %s; %v", connectionsPath, err)
+	}
+
+	connections := make(map[int][2]string)
+	connectionNumbers := make(map[int]int)
+	for _, connectionDir := range connectionDirs {
+		sessionConnection, found := strings.CutPrefix(connectionDir.Name(), "connection")
+		if !found {
+			continue
+		}
+		sessionPart, connectionPart, found := strings.Cut(sessionConnection, ":")
+		if !found {
+			continue
+		}
+		session, err1 := strconv.Atoi(sessionPart)
+		connection, err2 := strconv.Atoi(connectionPart)
+		if err1 != nil || err2 != nil {
+			continue
+		}
+		if previous, ok := connectionNumbers[session]; ok && previous < connection {
+			continue
+		}
+
+		// The persistent address is the portal logged in to, which a target may since have redirected
+		connectionPath := filepath.Join(connectionsPath, connectionDir.Name())
+		address, _ := s.readAttribute(filepath.Join(connectionPath, "persistent_address"))
+		port, _ := s.readAttribute(filepath.Join(connectionPath, "persistent_port"))
+		if address == "" {
+			address, _ = s.readAttribute(filepath.Join(connectionPath, "address"))
+			port, _ = s.readAttribute(filepath.Join(connectionPath, "port"))
+		}
+		if address == "" {
+			Logc(ctx).WithField("connection", connectionDir.Name()).Debug("Could not read connection address.")
+			continue
+		}
+
+		connections[session] = [2]string{address, port}
+		connectionNumbers[session] = connection
+	}
+
+	return connections, nil
+}
+
+// luns returns the LUNs attached through an iSCSI session, as found at
+// /sys/class/iscsi_session/sessionN/device/targetH:C:T/H:C:T:L, with their block devices.
+func (s *iSCSISysfs) luns(ctx context.Context, session *iSCSISysfsSession) ([]*iSCSISysfsLUN, error) {
+	devicePath := s.path("class", "iscsi_session", fmt.Sprintf("session%d", session.Number), "device")
+	deviceDirs, err := os.ReadDir(devicePath)
+	if err != nil {
+		return nil, fmt.Errorf("could not read %s; %v", devicePath, err)
+	}
+
+	luns := make([]*iSCSISysfsLUN, 0)
+	for _, targetDir := range deviceDirs {
+		hostChannelTarget, found := strings.CutPrefix(targetDir.Name(), "target")
+		if !found {
+			continue
+		}
+		targetPath := filepath.Join(devicePath, targetDir.Name())
+		lunDirs, err := os.ReadDir(targetPath)
+		if err != nil {
+			return nil, fmt.Errorf("could not read %s; %v", targetPath, err)
+		}
+
+		for _, lunDir := range lunDirs {
+			if !strings.HasPrefix(lunDir.Name(), hostChannelTarget+":") {
+				continue
+			}
+			hctl := strings.Split(lunDir.Name(), ":")
+			if len(hctl) != 4 {
+				Logc(ctx).WithField("lun", lunDir.Name()).Debug("Could not parse LUN address.")
+				continue
+			}
+
+			lun := &iSCSISysfsLUN{Host: hctl[0], Channel: hctl[1], Target: hctl[2], LUN: hctl[3]}
+			blockDirs, err := os.ReadDir(filepath.Join(targetPath, lunDir.Name(), "block"))
+			if err != nil && !os.IsNotExist(err) {
+				return nil, fmt.Errorf("could not read block devices of LUN %s; %v", lunDir.Name(), err)
+			}
+			for _, blockDir := range blockDirs {
+				lun.BlockDevices = append(lun.BlockDevices, blockDir.Name())
+			}
+			luns = append(luns, lun)
+		}
+	}
+
+	return luns, nil
+}
+
+// chapInfo returns the CHAP credentials with which an iSCSI session was established.
+func (s *iSCSISysfs) chapInfo(session *iSCSISysfsSession) (IscsiChapInfo, error) {
+	sessionPath := s.path("class", "iscsi_session", fmt.Sprintf("session%d", session.Number))
+
+	var chapInfo IscsiChapInfo
+	for file, value := range map[string]*string{
+		"username":    &chapInfo.IscsiUsername,
+		"password":    &chapInfo.IscsiInitiatorSecret,
+		"username_in": &chapInfo.IscsiTargetUsername,
+		"password_in": &chapInfo.IscsiTargetSecret,
+	} {
+		var err error
+		if *value, err = s.readAttribute(filepath.Join(sessionPath, file)); err != nil {
+			return IscsiChapInfo{}, fmt.Errorf("could not read %s of session %d; %v", file, session.Number, err)
+		}
+	}
+	chapInfo.UseCHAP = chapInfo != IscsiChapInfo{}
+
+	return chapInfo, nil
+}
+
+// multipathDevice returns the device mapper device holding a block device, if any.
+func (s *iSCSISysfs) multipathDevice(blockDevice string) string {
+	holders, err := os.ReadDir(s.path("block", blockDevice, "holders"))
+	if err != nil {
+		return ""
+	}
+	for _, holder := range holders {
+		if strings.HasPrefix(holder.Name(), "dm-") {
+			return holder.Name()
+		}
+	}
+	return ""
+}
+
+// multipathPaths returns the SCSI block devices that make up a device mapper device.
+func (s *iSCSISysfs) multipathPaths(multipathDevice string) []string {
+	paths := make([]string, 0)
+	slaves, err := os.ReadDir(s.path("block", multipathDevice, "slaves"))
+	if err != nil {
+		return paths
+	}
+	for _, slave := range slaves {
+		if strings.HasPrefix(slave.Name(), "sd") {
+			paths = append(paths, slave.Name())
+		}
+	}
+	return paths
+}
+
+// portal returns the session's portal as iscsiadm reports it, i.e. address:port,tpgt.
+func (session *iSCSISysfsSession) portal() string {
+	portal := session.portalIP()
+	if session.Port != "" {
+		portal += ":" + session.Port
+	}
+	if session.TPGT != "" {
+		portal += "," + session.TPGT
+	}
+	return portal
+}
+
+// portalIP returns the address of the session's portal, bracketed if it is an IPv6 address.
+func (session *iSCSISysfsSession) portalIP() string {
+	if ip := net.ParseIP(session.Address); ip != nil && ip.To4() == nil {
+		return "[" + session.Address + "]"
+	}
+	return session.Address
+}
+
+// hostSessionMapForTarget maps the numbers of the SCSI hosts through which a target is reached to the numbers
+// of the sessions to it.
+func hostSessionMapForTarget(sessions []*iSCSISysfsSession, targetIQN string) map[int]int {
+	hostSessionMap := make(map[int]int)
+	for _, session := range sessions {
+		if session.TargetIQN == targetIQN && session.Host >= 0 {
+			hostSessionMap[session.Host] = session.Number
+		}
+	}
+	return hostSessionMap
+}
+
+// parseSysfsNumber returns the number in a sysfs name such as session12, given its prefix.
+func parseSysfsNumber(name, prefix string) (int, bool) {
+	numberString, found := strings.CutPrefix(name, prefix)
+	if !found {
+		return 0, false
+	}
+	number, err := strconv.Atoi(numberString)
+	if err != nil {
+		return 0, false
+	}
+	return number, true
+}
@@ -0,0 +1,218 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package utils
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	sysfsTestIQN1 = "iqn.1992-08.com.netapp:sn.afbb1784f77411e582f8080027e22798:vs.3"
+	sysfsTestIQN2 = "iqn.1992-08.com.netapp:sn.f4c1e5e1d76c11eea1b6005056b3e52b:vs.7"
+)
+
+// sysfsFixture builds a sysfs tree under a temporary root, as the kernel lays it out for iSCSI.
+type sysfsFixture struct {
+	t    *testing.T
+	root string
+}
+
+func newSysfsFixture(t *testing.T) *sysfsFixture {
+	return &sysfsFixture{t: t, root: t.TempDir()}
+}
+
+func (f *sysfsFixture) write(path, contents string) {
+	path = filepath.Join(f.root, "sys", path)
+	require.NoError(f.t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(f.t, os.WriteFile(path, []byte(contents+"\n"), 0o644))
+}
+
+func (f *sysfsFixture) mkdir(path string) {
+	require.NoError(f.t, os.MkdirAll(filepath.Join(f.root, "sys", path), 0o755))
+}
+
+// session adds an iSCSI session to a target through a portal, reached through a SCSI host.
+func (f *sysfsFixture) session(number, host, targetIQN, address, port, tpgt string) {
+	session := "class/iscsi_session/session" + number
+	f.write(session+"/targetname", targetIQN)
+	f.write(session+"/tpgt", tpgt)
+	f.write(session+"/state", "LOGGED_IN")
+	for _, file := range []string{"username", "password", "username_in", "password_in"} {
+		f.write(session+"/"+file, "(null)")
+	}
+	f.mkdir(session + "/device/target" + host + ":0:0")
+	f.write("class/iscsi_connection/connection"+number+":0/persistent_address", address)
+	f.write("class/iscsi_connection/connection"+number+":0/persistent_port", port)
+	f.mkdir("class/scsi_host/host" + host + "/device/session" + number)
+}
+
+// lun attaches a LUN through a session, as a block device held by an optional multipath device.
+func (f *sysfsFixture) lun(session, host, lun, blockDevice, multipathDevice string) {
+	f.mkdir("class/iscsi_session/session" + session + "/device/target" + host + ":0:0/" + host + ":0:0:" + lun +
+		"/block/" + blockDevice)
+	f.mkdir("block/" + blockDevice + "/holders")
+	if multipathDevice != "" {
+		f.mkdir("block/" + blockDevice + "/holders/" + multipathDevice)
+		f.mkdir("block/" + multipathDevice + "/slaves/" + blockDevice)
+	}
+}
+
+// useAsHostRoot points the iSCSI utilities at the fixture for the rest of the test.
+func (f *sysfsFixture) useAsHostRoot() {
+	previous := chrootPathPrefix
+	chrootPathPrefix = f.root
+	f.t.Cleanup(func() { chrootPathPrefix = previous })
+}
+
+func newTestSysfsFixture(t *testing.T) *sysfsFixture {
+	f := newSysfsFixture(t)
+	f.session("1", "3", sysfsTestIQN1, "10.0.207.7", "3260", "1028")
+	f.session("2", "4", sysfsTestIQN1, "10.0.207.9", "3260", "1029")
+	f.session("5", "7", sysfsTestIQN2, "fd20:8b1e:b258:2000::7", "3260", "1")
+	f.lun("1", "3", "0", "sdb", "dm-0")
+	f.lun("2", "4", "0", "sdc", "dm-0")
+	f.lun("5", "7", "2", "sdd", "")
+
+	// A SCSI host of another transport
+	f.mkdir("class/scsi_host/host0/device/target0:0:0")
+	return f
+}
+
+func TestISCSISysfs_Sessions(t *testing.T) {
+	ctx := context.Background()
+	f := newTestSysfsFixture(t)
+	sysfs := &iSCSISysfs{root: f.root}
+
+	sessions, err := sysfs.sessions(ctx)
+	require.NoError(t, err)
+	require.Len(t, sessions, 3)
+
+	assert.Equal(t, &iSCSISysfsSession{
+		Number:    1,
+		TargetIQN: sysfsTestIQN1,
+		TPGT:      "1028",
+		Address:   "10.0.207.7",
+		Port:      "3260",
+		State:     "LOGGED_IN",
+		Host:      3,
+	}, sessions[0])
+	assert.Equal(t, "10.0.207.7:3260,1028", sessions[0].portal())
+	assert.Equal(t, "10.0.207.7", sessions[0].portalIP())
+	assert.Equal(t, 5, sessions[2].Number)
+	assert.Equal(t, "[fd20:8b1e:b258:2000::7]:3260,1", sessions[2].portal())
+	assert.Equal(t, "[fd20:8b1e:b258:2000::7]", sessions[2].portalIP())
+
+	assert.Equal(t, map[int]int{3: 1, 4: 2}, hostSessionMapForTarget(sessions, sysfsTestIQN1))
+	assert.Empty(t, hostSessionMapForTarget(sessions, "iqn.unknown"))
+}
+
+func TestISCSISysfs_NoSessions(t *testing.T) {
+	sysfs := &iSCSISysfs{root: t.TempDir()}
+
+	sessions, err := sysfs.sessions(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, sessions)
+}
+
+func TestISCSISysfs_PreferFirstConnection(t *testing.T) {
+	f := newSysfsFixture(t)
+	f.session("1", "3", sysfsTestIQN1, "10.0.207.7", "3260", "1028")
+	f.write("class/iscsi_connection/connection1:1/persistent_address", "10.0.207.8")
+
+	// Connections without a persistent address fall back to their current address
+	f.session("2", "4", sysfsTestIQN1, "", "", "1029")
+	f.write("class/iscsi_connection/connection2:0/address", "10.0.207.9")
+	f.write("class/iscsi_connection/connection2:0/port", "3260")
+
+	sessions, err := (&iSCSISysfs{root: f.root}).sessions(context.Background())
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	assert.Equal(t, "10.0.207.7", sessions[0].Address)
+	assert.Equal(t, "10.0.207.9:3260,1029", sessions[1].portal())
+}
+
+func TestISCSISysfs_LUNs(t *testing.T) {
+	ctx := context.Background()
+	f := newTestSysfsFixture(t)
+	sysfs := &iSCSISysfs{root: f.root}
+
+	luns, err := sysfs.luns(ctx, &iSCSISysfsSession{Number: 5})
+	require.NoError(t, err)
+	assert.Equal(t, []*iSCSISysfsLUN{
+		{Host: "7", Channel: "0", Target: "0", LUN: "2", BlockDevices: []string{"sdd"}},
+	}, luns)
+
+	assert.Equal(t, "dm-0", sysfs.multipathDevice("sdb"))
+	assert.Equal(t, "", sysfs.multipathDevice("sdd"))
+	assert.Equal(t, []string{"sdb", "sdc"}, sysfs.multipathPaths("dm-0"))
+
+	_, err = sysfs.luns(ctx, &iSCSISysfsSession{Number: 9})
+	assert.Error(t, err)
+}
+
+func TestISCSISysfs_CHAPInfo(t *testing.T) {
+	f := newTestSysfsFixture(t)
+	sysfs := &iSCSISysfs{root: f.root}
+
+	chapInfo, err := sysfs.chapInfo(&iSCSISysfsSession{Number: 1})
+	require.NoError(t, err)
+	assert.Equal(t, IscsiChapInfo{}, chapInfo)
+
+	f.write("class/iscsi_session/session2/username", "user")
+	f.write("class/iscsi_session/session2/password", "secret")
+	chapInfo, err = sysfs.chapInfo(&iSCSISysfsSession{Number: 2})
+	require.NoError(t, err)
+	assert.Equal(t, IscsiChapInfo{UseCHAP: true, IscsiUsername: "user", IscsiInitiatorSecret: "secret"}, chapInfo)
+}
+
+func TestGetISCSISessionInfo_Sysfs(t *testing.T) {
+	newTestSysfsFixture(t).useAsHostRoot()
+
+	sessionInfo, err := getISCSISessionInfo(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []ISCSISessionInfo{
+		{SID: "1", Portal: "10.0.207.7:3260,1028", PortalIP: "10.0.207.7", TargetName: sysfsTestIQN1},
+		{SID: "2", Portal: "10.0.207.9:3260,1029", PortalIP: "10.0.207.9", TargetName: sysfsTestIQN1},
+		{
+			SID: "5", Portal: "[fd20:8b1e:b258:2000::7]:3260,1", PortalIP: "[fd20:8b1e:b258:2000::7]",
+			TargetName: sysfsTestIQN2,
+		},
+	}, sessionInfo)
+}
+
+func TestGetISCSIHostSessionMapForTarget_Sysfs(t *testing.T) {
+	newTestSysfsFixture(t).useAsHostRoot()
+	helper := &IscsiReconcileHelper{}
+
+	assert.Equal(t, map[int]int{3: 1, 4: 2}, helper.GetISCSIHostSessionMapForTarget(context.Background(),
+		sysfsTestIQN1))
+	assert.Equal(t, map[int]int{7: 5}, helper.GetISCSIHostSessionMapForTarget(context.Background(),
+		sysfsTestIQN2))
+}
+
+func TestGetISCSIDevices_Sysfs(t *testing.T) {
+	newTestSysfsFixture(t).useAsHostRoot()
+
+	devices, err := GetISCSIDevices(context.Background(), true)
+	require.NoError(t, err)
+	assert.Equal(t, []*ScsiDeviceInfo{
+		{
+			Host: "3", Channel: "0", Target: "0", LUN: "0", Devices: []string{"sdb", "sdc"}, MultipathDevice: "dm-0",
+			IQN: sysfsTestIQN1, SessionNumber: 1, HostSessionMap: map[int]int{3: 1, 4: 2},
+		},
+		{
+			Host: "4", Channel: "0", Target: "0", LUN: "0", Devices: []string{"sdb", "sdc"}, MultipathDevice: "dm-0",
+			IQN: sysfsTestIQN1, SessionNumber: 2, HostSessionMap: map[int]int{3: 1, 4: 2},
+		},
+		{
+			Host: "7", Channel: "0", Target: "0", LUN: "2", Devices: []string{"sdd"},
+			IQN: sysfsTestIQN2, SessionNumber: 5, HostSessionMap: map[int]int{7: 5},
+		},
+	}, devices)
+}
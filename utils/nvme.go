@@ -287,7 +287,13 @@ func AttachNVMeVolume(
 		}
 	}
 
-	nvmeDev, err := nvmeHandler.NewNVMeDevice(ctx, publishInfo.NVMeNamespaceUUID)
+	// The namespace's device appears once the kernel has discovered it through the connected subsystem
+	var nvmeDev NVMeDeviceInterface
+	namespace := DeviceIdentity{NamespaceUUID: publishInfo.NVMeNamespaceUUID}
+	err := waitForDeviceEvents(ctx, namespace, deviceArrivalTimeout, func() (err error) {
+		nvmeDev, err = nvmeHandler.NewNVMeDevice(ctx, publishInfo.NVMeNamespaceUUID)
+		return err
+	})
 	if err != nil {
 		return err
 	}
@@ -620,17 +626,18 @@ func (nh *NVMeHandler) InspectNVMeSessions(
 	return subsToFix
 }
 
-// RectifyNVMeSession applies the required remediation on the subsystemToFix to make it working again.
+// RectifyNVMeSession applies the required remediation on the subsystemToFix to make it working again, returning
+// an error if the remediation failed.
 func (nh *NVMeHandler) RectifyNVMeSession(
 	ctx context.Context, subsystemToFix NVMeSubsystem, pubSessions *NVMeSessions,
-) {
+) error {
 	if pubSessions == nil || pubSessions.IsEmpty() {
-		return
+		return nil
 	}
 
 	pubSessionData := pubSessions.Info[subsystemToFix.NQN]
 	if pubSessionData == nil {
-		return
+		return nil
 	}
 
 	// Updating the access time as we are trying to do some NVMeOperation on this subsystem.
@@ -639,8 +646,11 @@ func (nh *NVMeHandler) RectifyNVMeSession(
 	if pubSessionData.Remediation == ConnectOp {
 		if err := subsystemToFix.Connect(ctx, pubSessionData.NVMeTargetIPs, true); err != nil {
 			Logc(ctx).Errorf("NVMe Self healing failed for subsystem %s; %v", subsystemToFix.NQN, err)
+			return err
 		} else {
 			Logc(ctx).Infof("NVMe Self healing succeeded for %s", subsystemToFix.NQN)
 		}
 	}
+
+	return nil
 }
@@ -237,16 +237,16 @@ func TestNVMeHandler_RectifyNVMeSession(t *testing.T) {
 	pubSessions := NewNVMeSessions()
 
 	// Empty published sessions case.
-	nh.RectifyNVMeSession(ctx(), testSubsystem1, pubSessions)
+	assert.NoError(t, nh.RectifyNVMeSession(ctx(), testSubsystem1, pubSessions))
 
 	// Nil published session data case.
 	pubSessions.Info[testSubsystem1.NQN] = nil
-	nh.RectifyNVMeSession(ctx(), testSubsystem1, pubSessions)
+	assert.NoError(t, nh.RectifyNVMeSession(ctx(), testSubsystem1, pubSessions))
 
 	// NoOp remediation case.
 	pubSessions.RemoveNVMeSession(testSubsystem1.NQN)
 	pubSessions.AddNVMeSession(testSubsystem1, []string{})
-	nh.RectifyNVMeSession(ctx(), testSubsystem1, pubSessions)
+	assert.NoError(t, nh.RectifyNVMeSession(ctx(), testSubsystem1, pubSessions))
 }
 
 func TestNVMeHandler_PopulateCurrentNVMeSessions_NilCurrentSessions(t *testing.T) {
@@ -0,0 +1,102 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package tracing
+
+import (
+	"context"
+	"path"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// metadataCarrier carries trace context in gRPC metadata.
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	if values := metadata.MD(c).Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// StartGRPCServerSpan starts a span for a gRPC call to Trident, continuing any trace the caller started.
+func StartGRPCServerSpan(
+	ctx context.Context, method string, attributes ...attribute.KeyValue,
+) (context.Context, trace.Span) {
+	if !enabled.Load() {
+		return ctx, noop.Span{}
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		ctx = propagator.Extract(ctx, metadataCarrier(md))
+	}
+	service, name := splitMethod(method)
+	attributes = append(attributes, semconv.RPCSystemGRPC, semconv.RPCService(service), semconv.RPCMethod(name))
+	return startServerSpan(ctx, method, attributes...)
+}
+
+// EndGRPCSpan ends a gRPC call span, marking it failed if the call failed.
+func EndGRPCSpan(span trace.Span, err error) {
+	s, _ := status.FromError(err)
+	span.SetAttributes(semconv.RPCGRPCStatusCodeKey.Int(int(s.Code())))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, s.Message())
+	}
+	span.End()
+}
+
+// UnaryClientInterceptor traces each call the named client makes over a gRPC connection.
+func UnaryClientInterceptor(client string) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if !enabled.Load() {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		service, name := splitMethod(method)
+		ctx, span := start(ctx, client+" "+name,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(semconv.RPCSystemGRPC, semconv.RPCService(service), semconv.RPCMethod(name)),
+		)
+
+		md, ok := metadata.FromOutgoingContext(ctx)
+		if ok {
+			md = md.Copy()
+		} else {
+			md = metadata.MD{}
+		}
+		propagator.Inject(ctx, metadataCarrier(md))
+		ctx = metadata.NewOutgoingContext(ctx, md)
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		EndGRPCSpan(span, err)
+		return err
+	}
+}
+
+// splitMethod splits a full gRPC method name, i.e. "/csi.v1.Controller/CreateVolume", into its service and
+// method names.
+func splitMethod(fullMethod string) (service, method string) {
+	service, method = path.Split(fullMethod)
+	return path.Clean(service)[1:], method
+}
@@ -0,0 +1,93 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package tracing
+
+import (
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// StartHTTPServerSpan starts a span for a REST call to Trident, continuing any trace the caller started, and
+// returns the request with the span in its context.
+func StartHTTPServerSpan(
+	r *http.Request, route string, attributes ...attribute.KeyValue,
+) (*http.Request, trace.Span) {
+	if !enabled.Load() {
+		return r, noop.Span{}
+	}
+	ctx := propagator.Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+	attributes = append(attributes,
+		semconv.HTTPRequestMethodKey.String(r.Method),
+		semconv.HTTPRoute(route),
+		semconv.URLPath(r.URL.Path),
+	)
+	ctx, span := startServerSpan(ctx, r.Method+" "+route, attributes...)
+	return r.WithContext(ctx), span
+}
+
+// EndHTTPServerSpan ends a REST call span, marking it failed if the server failed.
+func EndHTTPServerSpan(span trace.Span, statusCode int) {
+	span.SetAttributes(semconv.HTTPResponseStatusCode(statusCode))
+	if statusCode >= http.StatusInternalServerError {
+		span.SetStatus(codes.Error, http.StatusText(statusCode))
+	}
+	span.End()
+}
+
+// StartHTTPClientSpan starts a span for a call by the named client, as a child of any span in the request's
+// context, and adds the trace context to the request headers.  The caller must own the request.
+func StartHTTPClientSpan(req *http.Request, client string) trace.Span {
+	_, span := start(req.Context(), client+" "+req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(req.Method),
+			semconv.ServerAddress(req.URL.Hostname()),
+			semconv.URLPath(req.URL.Path),
+		),
+	)
+	propagator.Inject(trace.ContextWithSpan(req.Context(), span), propagation.HeaderCarrier(req.Header))
+	return span
+}
+
+// EndHTTPClientSpan ends a client call span, marking it failed if the call failed or was refused.
+func EndHTTPClientSpan(span trace.Span, resp *http.Response, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	} else if resp != nil {
+		span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+		if resp.StatusCode >= http.StatusBadRequest {
+			span.SetStatus(codes.Error, http.StatusText(resp.StatusCode))
+		}
+	}
+	span.End()
+}
+
+// transport traces the calls made through an http.RoundTripper.
+type transport struct {
+	client string
+	base   http.RoundTripper
+}
+
+// NewTransport returns an http.RoundTripper that traces each call the named client makes through base.
+func NewTransport(client string, base http.RoundTripper) http.RoundTripper {
+	return &transport{client: client, base: base}
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !enabled.Load() {
+		return t.base.RoundTrip(req)
+	}
+	// RoundTrippers may not modify the requests they are given
+	req = req.Clone(req.Context())
+	span := StartHTTPClientSpan(req, t.client)
+	resp, err := t.base.RoundTrip(req)
+	EndHTTPClientSpan(span, resp, err)
+	return resp, err
+}
@@ -0,0 +1,156 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+// Package tracing creates the OpenTelemetry spans that break a Trident operation down from the CSI or REST call
+// that started it, through the orchestrator, to the storage API calls it made.  Until Init is called with an
+// endpoint, no spans are started and contexts are passed through untouched.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	. "github.com/netapp/trident/logging"
+)
+
+const instrumentationName = "github.com/netapp/trident"
+
+// Attribute keys recorded on spans
+const (
+	VolumeKey       = attribute.Key("trident.volume")
+	SnapshotKey     = attribute.Key("trident.snapshot")
+	BackendKey      = attribute.Key("trident.backend")
+	PoolKey         = attribute.Key("trident.pool")
+	NodeKey         = attribute.Key("trident.node")
+	StorageClassKey = attribute.Key("trident.storage_class")
+	RequestIDKey    = attribute.Key("trident.request_id")
+)
+
+// propagator carries trace context across the CSI, REST and storage API calls, as W3C trace context headers.
+var propagator propagation.TextMapPropagator = propagation.TraceContext{}
+
+// enabled is set once spans are exported; until then, contexts are passed through untouched.
+var enabled atomic.Bool
+
+// Config describes where spans are exported to.
+type Config struct {
+	// Endpoint is the URL of an OTLP/gRPC collector; collectors at http:// URLs are reached without TLS.
+	Endpoint string
+	// SampleRatio is the fraction of traces started by Trident that are recorded.  Traces started by a caller
+	// are recorded if the caller recorded them.
+	SampleRatio float64
+
+	ServiceName    string
+	ServiceVersion string
+}
+
+// Init exports spans as the config specifies, returning a function that flushes any spans not yet exported.
+// Nothing is exported if no endpoint is specified.
+func Init(ctx context.Context, config Config) (shutdown func(context.Context) error, err error) {
+	if config.Endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+	if config.SampleRatio < 0 || config.SampleRatio > 1 {
+		return nil, fmt.Errorf("trace sample ratio %v is not between 0 and 1", config.SampleRatio)
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, otlptracegrpc.WithEndpointURL(config.Endpoint))
+	if err != nil {
+		return nil, fmt.Errorf("could not create trace exporter; %v", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(config.SampleRatio))),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL,
+			semconv.ServiceName(config.ServiceName),
+			semconv.ServiceVersion(config.ServiceVersion),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagator)
+	enabled.Store(true)
+
+	Logc(ctx).WithFields(LogFields{
+		"endpoint":    config.Endpoint,
+		"sampleRatio": config.SampleRatio,
+	}).Info("Exporting traces.")
+
+	return provider.Shutdown, nil
+}
+
+// start starts a span if spans are exported, and otherwise returns the context as is with a span that does nothing.
+func start(ctx context.Context, name string, options ...trace.SpanStartOption) (context.Context, trace.Span) {
+	if !enabled.Load() {
+		return ctx, noop.Span{}
+	}
+	return otel.Tracer(instrumentationName).Start(ctx, name, options...)
+}
+
+// StartSpan starts a span for work done within Trident, as a child of any span in the context.
+func StartSpan(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// startServerSpan starts a span for a call made to Trident, recording the request ID under which the call is
+// logged so that the trace and the log may be read together.
+func startServerSpan(
+	ctx context.Context, name string, attributes ...attribute.KeyValue,
+) (context.Context, trace.Span) {
+	if requestID, ok := ctx.Value(ContextKeyRequestID).(string); ok && requestID != "" {
+		attributes = append(attributes, RequestIDKey.String(requestID))
+	}
+	return start(ctx, name, trace.WithSpanKind(trace.SpanKindServer), trace.WithAttributes(attributes...))
+}
+
+// EndSpan ends a span, marking it failed if the error it is passed points to an error.  It is meant to be
+// deferred by functions with a named error result, i.e.
+//
+//	defer tracing.EndSpan(span, &err)
+func EndSpan(span trace.Span, err *error) {
+	if err != nil && *err != nil {
+		span.RecordError(*err)
+		span.SetStatus(codes.Error, (*err).Error())
+	}
+	span.End()
+}
+
+// SetAttributes adds attributes to the span in the context, for things not known when it was started.
+func SetAttributes(ctx context.Context, attributes ...attribute.KeyValue) {
+	trace.SpanFromContext(ctx).SetAttributes(attributes...)
+}
+
+func Volume(name string) attribute.KeyValue {
+	return VolumeKey.String(name)
+}
+
+func Snapshot(name string) attribute.KeyValue {
+	return SnapshotKey.String(name)
+}
+
+func Backend(name string) attribute.KeyValue {
+	return BackendKey.String(name)
+}
+
+func Pool(name string) attribute.KeyValue {
+	return PoolKey.String(name)
+}
+
+func Node(name string) attribute.KeyValue {
+	return NodeKey.String(name)
+}
+
+func StorageClass(name string) attribute.KeyValue {
+	return StorageClassKey.String(name)
+}
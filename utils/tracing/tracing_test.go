@@ -0,0 +1,194 @@
+// Copyright 2024 NetApp, Inc. All Rights Reserved.
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+
+	. "github.com/netapp/trident/logging"
+)
+
+// recordSpans records the spans ended during a test.
+func recordSpans(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	enabled.Store(true)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(previous)
+		enabled.Store(false)
+	})
+
+	return recorder
+}
+
+func attributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	result := make(map[attribute.Key]attribute.Value)
+	for _, kv := range span.Attributes() {
+		result[kv.Key] = kv.Value
+	}
+	return result
+}
+
+func TestInit_NoEndpoint(t *testing.T) {
+	shutdown, err := Init(context.Background(), Config{})
+	assert.NoError(t, err)
+	assert.NoError(t, shutdown(context.Background()))
+
+	_, err = Init(context.Background(), Config{Endpoint: "http://localhost:4317", SampleRatio: 2})
+	assert.Error(t, err)
+}
+
+func TestStartSpan_Disabled(t *testing.T) {
+	ctx := context.Background()
+	spanCtx, span := StartSpan(ctx, "core.AddVolume")
+	assert.Equal(t, ctx, spanCtx)
+	assert.False(t, span.IsRecording())
+	span.End()
+}
+
+func TestStartSpan(t *testing.T) {
+	recorder := recordSpans(t)
+
+	f := func(ctx context.Context) (err error) {
+		ctx, span := StartSpan(ctx, "core.AddVolume", Volume("pvc-1"))
+		defer EndSpan(span, &err)
+
+		SetAttributes(ctx, Backend("ontap"), Pool("aggr1"))
+		return errors.New("no pool")
+	}
+	assert.Error(t, f(context.Background()))
+
+	spans := recorder.Ended()
+	if assert.Len(t, spans, 1) {
+		assert.Equal(t, "core.AddVolume", spans[0].Name())
+		assert.Equal(t, codes.Error, spans[0].Status().Code)
+		assert.Equal(t, map[attribute.Key]attribute.Value{
+			VolumeKey:  attribute.StringValue("pvc-1"),
+			BackendKey: attribute.StringValue("ontap"),
+			PoolKey:    attribute.StringValue("aggr1"),
+		}, attributes(spans[0]))
+	}
+}
+
+func TestHTTPSpans(t *testing.T) {
+	recorder := recordSpans(t)
+
+	// The server continues the trace begun by the client
+	var serverTraceID trace.TraceID
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r, span := StartHTTPServerSpan(r, "GetVolume")
+		serverTraceID = trace.SpanContextFromContext(r.Context()).TraceID()
+		w.WriteHeader(http.StatusNotFound)
+		EndHTTPServerSpan(span, http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewTransport("ontap-rest", http.DefaultTransport)}
+	ctx, parent := StartSpan(context.Background(), "core.GetVolume")
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/volume/vol1", nil)
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	_ = resp.Body.Close()
+	parent.End()
+
+	assert.Empty(t, req.Header.Get("traceparent"), "the caller's request was modified")
+	assert.Equal(t, parent.SpanContext().TraceID(), serverTraceID)
+
+	spans := recorder.Ended()
+	if assert.Len(t, spans, 3) {
+		assert.Equal(t, "GET GetVolume", spans[0].Name())
+		assert.Equal(t, trace.SpanKindServer, spans[0].SpanKind())
+		assert.Equal(t, codes.Unset, spans[0].Status().Code)
+
+		assert.Equal(t, "ontap-rest GET", spans[1].Name())
+		assert.Equal(t, trace.SpanKindClient, spans[1].SpanKind())
+		assert.Equal(t, codes.Error, spans[1].Status().Code)
+		assert.Equal(t, parent.SpanContext().SpanID(), spans[1].Parent().SpanID())
+	}
+}
+
+func TestGRPCSpans(t *testing.T) {
+	recorder := recordSpans(t)
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer(grpc.UnaryInterceptor(func(
+		ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		ctx, span := StartGRPCServerSpan(ctx, info.FullMethod)
+		resp, err := handler(ctx, req)
+		EndGRPCSpan(span, err)
+		return resp, err
+	}))
+	healthpb.RegisterHealthServer(server, health.NewServer())
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(UnaryClientInterceptor("gcnv")),
+	)
+	if !assert.NoError(t, err) {
+		t.FailNow()
+	}
+	defer func() { _ = conn.Close() }()
+
+	// Trace context is added to any metadata the caller sends
+	ctx := metadata.AppendToOutgoingContext(context.Background(), "x-goog-request-params", "name=vol1")
+	_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+	assert.NoError(t, err)
+
+	spans := recorder.Ended()
+	if assert.Len(t, spans, 2) {
+		assert.Equal(t, "/grpc.health.v1.Health/Check", spans[0].Name())
+		assert.Equal(t, trace.SpanKindServer, spans[0].SpanKind())
+		assert.Equal(t, "grpc.health.v1.Health", attributes(spans[0])["rpc.service"].AsString())
+
+		assert.Equal(t, "gcnv Check", spans[1].Name())
+		assert.Equal(t, trace.SpanKindClient, spans[1].SpanKind())
+		assert.Equal(t, spans[1].SpanContext().SpanID(), spans[0].Parent().SpanID())
+	}
+}
+
+func TestStartServerSpan_RequestID(t *testing.T) {
+	recorder := recordSpans(t)
+
+	ctx := context.WithValue(context.Background(), ContextKeyRequestID, "1234")
+	_, span := startServerSpan(ctx, "call")
+	span.End()
+
+	spans := recorder.Ended()
+	if assert.Len(t, spans, 1) {
+		assert.Equal(t, "1234", attributes(spans[0])[RequestIDKey].AsString())
+	}
+}
+
+func TestSplitMethod(t *testing.T) {
+	service, method := splitMethod("/csi.v1.Controller/CreateVolume")
+	assert.Equal(t, "csi.v1.Controller", service)
+	assert.Equal(t, "CreateVolume", method)
+}
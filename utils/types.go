@@ -156,6 +156,11 @@ func (v *VolumePublication) ConstructExternal() *VolumePublicationExternal {
 type VolumeUpdateInfo struct {
 	SnapshotDirectory string `json:"snapshotDirectory"`
 	PoolLevel         bool   `json:"poolLevel"`
+	QosPolicy         string `json:"qosPolicy,omitempty"`
+	AdaptiveQosPolicy string `json:"adaptiveQosPolicy,omitempty"`
+	TieringPolicy     string `json:"tieringPolicy,omitempty"`
+	SnapshotPolicy    string `json:"snapshotPolicy,omitempty"`
+	ServiceLevel      string `json:"serviceLevel,omitempty"`
 }
 
 type Node struct {